@@ -2,6 +2,8 @@ package main
 
 import (
 	"log"
+	"os"
+	_ "time/tzdata" // 會員偏好時區不依賴主機的 zoneinfo
 
 	_ "github.com/mattn/go-sqlite3" // or mysql, pgx, etc.
 	"github.com/tomoffice/go-clean-architecture/config"
@@ -27,6 +29,17 @@ func main() {
 			log.Printf("Error during cleanup: %v", err)
 		}
 	}()
+
+	// 子命令：api-server migrate <command>
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(cfg, appLogger, os.Args[2:]); err != nil {
+			log.Print(err)
+			_ = cleanup()
+			os.Exit(1)
+		}
+		return
+	}
+
 	// 3. 創建 tracer
 	tracerConfig := basic.NewConfig(cfg.Tracer.ServiceName, cfg.Tracer.Enabled)
 	appTracer := basic.NewTracer(tracerConfig)
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/tomoffice/go-clean-architecture/config"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxdriver"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxmigrate"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+)
+
+const migrateUsage = `用法: api-server migrate <command>
+
+  up              套用所有尚未套用的遷移
+  down [n]        回滾最近 n 個已套用的遷移（預設 1）
+  to <version>    遷移到指定版本，0 表示全部回滾
+  status          列出每個版本的狀態
+  force <version> 不執行腳本，直接把不超過 version 的版本記錄為已套用
+  seed            載入內嵌的種子資料`
+
+var errMigrateUsage = errors.New(migrateUsage)
+
+// runMigrate 執行 api-server migrate 子命令，依 database.dsn 選擇 SQLite 或 PostgreSQL 的內嵌腳本
+func runMigrate(cfg *config.Config, log logger.Logger, args []string) error {
+	if len(args) == 0 {
+		return errMigrateUsage
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	driver, err := sqlxdriver.DriverFromDSN(cfg.Database.DSN)
+	if err != nil {
+		return err
+	}
+	db, err := sqlxdriver.NewDBFromConfig(ctx, cfg.Database, log)
+	if err != nil {
+		return fmt.Errorf("DB 初始化失敗: %w", err)
+	}
+	defer db.Close()
+	migrator, err := sqlxmigrate.New(db, sqlxdriver.Migrations(driver))
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "up":
+		steps, err := migrator.Up(ctx)
+		printSteps(steps)
+		return err
+	case "down":
+		n := 1
+		if len(args) > 1 {
+			if n, err = strconv.Atoi(args[1]); err != nil {
+				return fmt.Errorf("回滾數量格式錯誤: %q", args[1])
+			}
+		}
+		steps, err := migrator.Down(ctx, n)
+		printSteps(steps)
+		return err
+	case "to":
+		version, err := parseVersion(args)
+		if err != nil {
+			return err
+		}
+		steps, err := migrator.To(ctx, version)
+		printSteps(steps)
+		return err
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		printStatuses(statuses)
+		return nil
+	case "force":
+		version, err := parseVersion(args)
+		if err != nil {
+			return err
+		}
+		if err := migrator.Force(ctx, version); err != nil {
+			return err
+		}
+		fmt.Printf("已將資料庫版本設為 %d\n", version)
+		return nil
+	case "seed":
+		seeded, err := sqlxmigrate.Seed(ctx, db, sqlxdriver.Seeds(driver))
+		for _, name := range seeded {
+			fmt.Printf("seed  %s\n", name)
+		}
+		return err
+	default:
+		return errMigrateUsage
+	}
+}
+
+func parseVersion(args []string) (uint64, error) {
+	if len(args) < 2 {
+		return 0, errMigrateUsage
+	}
+	version, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("版本格式錯誤: %q", args[1])
+	}
+	return version, nil
+}
+
+func printSteps(steps []sqlxmigrate.Step) {
+	if len(steps) == 0 {
+		fmt.Println("沒有需要執行的遷移")
+		return
+	}
+	for _, step := range steps {
+		fmt.Printf("%-4s  %06d_%s\n", step.Direction, step.Migration.Version, step.Migration.Name)
+	}
+}
+
+func printStatuses(statuses []sqlxmigrate.Status) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tSTATE\tAPPLIED AT")
+	for _, status := range statuses {
+		state := "pending"
+		switch {
+		case status.Missing:
+			state = "missing"
+		case status.Modified:
+			state = "modified"
+		case status.Applied:
+			state = "applied"
+		}
+		appliedAt := ""
+		if status.Applied {
+			appliedAt = status.AppliedAt.Format("2006-01-02 15:04:05")
+		}
+		fmt.Fprintf(w, "%06d\t%s\t%s\t%s\n", status.Version, status.Name, state, appliedAt)
+	}
+	_ = w.Flush()
+}
@@ -1,5 +1,7 @@
 package config
 
+import "time"
+
 // Config 是應用程式的設定結構
 type Config struct {
 	AppName  string         `envconfig:"APP_NAME" yaml:"app_name" validate:"required"`
@@ -9,6 +11,9 @@ type Config struct {
 	Auth     AuthConfig     `envconfig:"-"        yaml:"auth"     validate:"required"`
 	Logger   LoggerConfig   `envconfig:"-"        yaml:"logger"   validate:"required"`
 	Tracer   TracerConfig   `envconfig:"-"        yaml:"tracer"   validate:"required"`
+	Outbox   OutboxConfig   `envconfig:"-"        yaml:"outbox"`
+	Webhook  WebhookConfig  `envconfig:"-"        yaml:"webhook"`
+	Member   MemberConfig   `envconfig:"-"        yaml:"member"`
 }
 
 type ServerConfig struct {
@@ -20,8 +25,55 @@ type HTTPConfig struct {
 	Port string `envconfig:"SERVER_HTTP_PORT" yaml:"port" validate:"required"`
 }
 
+// DatabaseConfig 定義資料庫配置
+//   - Driver 會員模組 MemberDAO 的實作：sqlx（預設）或 ent，兩者共用同一個連線與交易；memory 只存在記憶體
+//   - MemorySnapshot Driver 為 memory 時的 JSON 快照檔，空字串表示重啟後清空
+//   - AutoMigrate 啟動時套用內嵌的遷移腳本；關閉時只檢查版本，有未套用的遷移時記錄警告
+//   - 連接池、逾時與 SQLite pragma 未設定（零值）時沿用 driver 預設值
+//   - ConnectRetries 啟動時連接失敗的重試次數，間隔從 RetryBackoff 開始加倍
+//   - StatementCacheSize 會員 DAO 快取的 prepared statement 數量，零值使用 64，負值表示不使用
 type DatabaseConfig struct {
-	DSN string `envconfig:"DB_DSN" yaml:"dsn" validate:"required"`
+	DSN                string                `envconfig:"DB_DSN"    yaml:"dsn" validate:"required"`
+	Driver             string                `envconfig:"DB_DRIVER" yaml:"driver"`
+	MemorySnapshot     string                `envconfig:"DB_MEMORY_SNAPSHOT" yaml:"memory_snapshot"`
+	AutoMigrate        bool                  `envconfig:"DB_AUTO_MIGRATE" yaml:"auto_migrate"`
+	MaxOpenConns       int                   `envconfig:"DB_MAX_OPEN_CONNS"     yaml:"max_open_conns"`
+	MaxIdleConns       int                   `envconfig:"DB_MAX_IDLE_CONNS"     yaml:"max_idle_conns"`
+	ConnMaxLifetime    time.Duration         `envconfig:"DB_CONN_MAX_LIFETIME"  yaml:"conn_max_lifetime"`
+	ConnMaxIdleTime    time.Duration         `envconfig:"DB_CONN_MAX_IDLE_TIME" yaml:"conn_max_idle_time"`
+	ConnectTimeout     time.Duration         `envconfig:"DB_CONNECT_TIMEOUT"    yaml:"connect_timeout"`
+	ConnectRetries     int                   `envconfig:"DB_CONNECT_RETRIES"    yaml:"connect_retries"`
+	RetryBackoff       time.Duration         `envconfig:"DB_RETRY_BACKOFF"      yaml:"retry_backoff"`
+	SQLite             SQLiteConfig          `envconfig:"-"                     yaml:"sqlite"`
+	Replicas           ReplicaConfig         `envconfig:"-"                     yaml:"replicas"`
+	Instrumentation    InstrumentationConfig `envconfig:"-"                     yaml:"instrumentation"`
+	StatementCacheSize int                   `envconfig:"DB_STATEMENT_CACHE_SIZE" yaml:"statement_cache_size"`
+}
+
+// InstrumentationConfig 定義 SQL 呼叫的觀測
+//   - SlowQueryThreshold 耗時達到此值的查詢以警告記錄（參數只記錄型別），零值使用 200ms
+//   - StatsInterval 定期記錄每個查詢名稱的延遲統計，零值表示不記錄
+type InstrumentationConfig struct {
+	SlowQueryThreshold time.Duration `envconfig:"DB_SLOW_QUERY_THRESHOLD"  yaml:"slow_query_threshold"`
+	StatsInterval      time.Duration `envconfig:"DB_QUERY_STATS_INTERVAL" yaml:"stats_interval"`
+}
+
+// ReplicaConfig 定義唯讀 replica；會員查詢分流到 replica，寫入與交易仍走 primary
+//   - DSNs 與 primary 使用相同的 driver 與連接池設定，未設定時不分流
+//   - ReadYourWritesWindow 同一個已認證的 actor 寫入後，這段時間內的查詢走 primary；匿名呼叫端不適用
+//   - HealthCheckInterval 健康檢查間隔，失敗的 replica 會暫時移出輪替
+type ReplicaConfig struct {
+	DSNs                 []string      `envconfig:"DB_REPLICA_DSNS"                  yaml:"dsns"`
+	ReadYourWritesWindow time.Duration `envconfig:"DB_READ_YOUR_WRITES_WINDOW"       yaml:"read_your_writes_window"`
+	HealthCheckInterval  time.Duration `envconfig:"DB_REPLICA_HEALTH_CHECK_INTERVAL" yaml:"health_check_interval"`
+}
+
+// SQLiteConfig 定義 SQLite 每個連接套用的 pragma；外鍵約束預設啟用
+type SQLiteConfig struct {
+	JournalMode        string        `envconfig:"DB_SQLITE_JOURNAL_MODE"         yaml:"journal_mode"`
+	BusyTimeout        time.Duration `envconfig:"DB_SQLITE_BUSY_TIMEOUT"         yaml:"busy_timeout"`
+	Synchronous        string        `envconfig:"DB_SQLITE_SYNCHRONOUS"          yaml:"synchronous"`
+	DisableForeignKeys bool          `envconfig:"DB_SQLITE_DISABLE_FOREIGN_KEYS" yaml:"disable_foreign_keys"`
 }
 
 type AuthConfig struct {
@@ -67,4 +119,144 @@ type SeqLoggerConfig struct {
 type TracerConfig struct {
 	Enabled     bool   `envconfig:"TRACER_ENABLED" yaml:"enabled" default:"true"`
 	ServiceName string `envconfig:"TRACER_SERVICE_NAME" yaml:"service_name" default:"api-server"`
-}
\ No newline at end of file
+}
+
+// OutboxConfig 定義領域事件 outbox 與 dispatcher 配置，零值欄位使用程式內預設值
+type OutboxConfig struct {
+	DispatcherEnabled bool                  `envconfig:"OUTBOX_DISPATCHER_ENABLED" yaml:"dispatcher_enabled"`
+	PollInterval      time.Duration         `envconfig:"OUTBOX_POLL_INTERVAL"      yaml:"poll_interval"`
+	BatchSize         int                   `envconfig:"OUTBOX_BATCH_SIZE"         yaml:"batch_size"`
+	MaxAttempts       int                   `envconfig:"OUTBOX_MAX_ATTEMPTS"       yaml:"max_attempts"`
+	BaseBackoff       time.Duration         `envconfig:"OUTBOX_BASE_BACKOFF"       yaml:"base_backoff"`
+	MaxBackoff        time.Duration         `envconfig:"OUTBOX_MAX_BACKOFF"        yaml:"max_backoff"`
+	StuckAfter        time.Duration         `envconfig:"OUTBOX_STUCK_AFTER"        yaml:"stuck_after"`
+	Publisher         OutboxPublisherConfig `envconfig:"-"                         yaml:"publisher"`
+}
+
+// OutboxPublisherConfig 定義事件發佈方式：log、bus（in-process）或 http
+type OutboxPublisherConfig struct {
+	Type        string            `envconfig:"OUTBOX_PUBLISHER_TYPE"         yaml:"type"         validate:"omitempty,oneof=log bus http"`
+	HTTPURL     string            `envconfig:"OUTBOX_PUBLISHER_HTTP_URL"     yaml:"http_url"`
+	HTTPTimeout time.Duration     `envconfig:"OUTBOX_PUBLISHER_HTTP_TIMEOUT" yaml:"http_timeout"`
+	HTTPHeaders map[string]string `envconfig:"-"                             yaml:"http_headers"`
+}
+
+// WebhookConfig 定義 webhook 投遞與重試配置，零值欄位使用程式內預設值
+//   - Admins 可管理訂閱、查詢與重送投遞紀錄的 actor（auth subject）；空值表示無人可使用 /webhooks
+type WebhookConfig struct {
+	DeliveryEnabled      bool          `envconfig:"WEBHOOK_DELIVERY_ENABLED"       yaml:"delivery_enabled"`
+	PollInterval         time.Duration `envconfig:"WEBHOOK_POLL_INTERVAL"          yaml:"poll_interval"`
+	BatchSize            int           `envconfig:"WEBHOOK_BATCH_SIZE"             yaml:"batch_size"`
+	MaxAttempts          int           `envconfig:"WEBHOOK_MAX_ATTEMPTS"           yaml:"max_attempts"`
+	BaseBackoff          time.Duration `envconfig:"WEBHOOK_BASE_BACKOFF"           yaml:"base_backoff"`
+	MaxBackoff           time.Duration `envconfig:"WEBHOOK_MAX_BACKOFF"            yaml:"max_backoff"`
+	DisableAfterFailures int           `envconfig:"WEBHOOK_DISABLE_AFTER_FAILURES" yaml:"disable_after_failures"`
+	Timeout              time.Duration `envconfig:"WEBHOOK_TIMEOUT"                yaml:"timeout"`
+	Admins               []string      `envconfig:"WEBHOOK_ADMINS"                 yaml:"admins"`
+}
+
+// MemberConfig 定義會員模組配置
+type MemberConfig struct {
+	Stream       MemberStreamConfig       `envconfig:"-" yaml:"stream"`
+	Privacy      MemberPrivacyConfig      `envconfig:"-" yaml:"privacy"`
+	Email        MemberEmailConfig        `envconfig:"-" yaml:"email"`
+	Password     MemberPasswordConfig     `envconfig:"-" yaml:"password"`
+	Status       MemberStatusConfig       `envconfig:"-" yaml:"status"`
+	Registration MemberRegistrationConfig `envconfig:"-" yaml:"registration"`
+	Preferences  MemberPreferencesConfig  `envconfig:"-" yaml:"preferences"`
+	Cache        MemberCacheConfig        `envconfig:"-" yaml:"cache"`
+}
+
+// MemberStreamConfig 定義會員異動串流（SSE）配置，零值欄位使用程式內預設值
+type MemberStreamConfig struct {
+	ReplayBufferSize     int           `envconfig:"MEMBER_STREAM_REPLAY_BUFFER_SIZE"     yaml:"replay_buffer_size"`
+	SubscriberBufferSize int           `envconfig:"MEMBER_STREAM_SUBSCRIBER_BUFFER_SIZE" yaml:"subscriber_buffer_size"`
+	HeartbeatInterval    time.Duration `envconfig:"MEMBER_STREAM_HEARTBEAT_INTERVAL"     yaml:"heartbeat_interval"`
+}
+
+// MemberPrivacyConfig 定義會員個資匯出/刪除配置
+//   - Officers 可處理任何會員個資的 actor（auth subject），會員本人不需列入；
+//     空值表示只有本人可以匯出/刪除自己的個資
+type MemberPrivacyConfig struct {
+	Officers []string `envconfig:"MEMBER_PRIVACY_OFFICERS" yaml:"officers"`
+}
+
+// MemberEmailConfig 定義會員 Email 正規化配置
+//   - 基本規則（去除空白、大小寫、IDN 轉 punycode）一律套用；
+//     業者規則只對列出的網域生效，網域別名先於業者規則轉換
+//   - BackfillOnStartup 啟動時依目前規則重算既有會員的 normalized_email，
+//     修改業者規則後需開啟一次
+type MemberEmailConfig struct {
+	BackfillOnStartup bool                    `envconfig:"MEMBER_EMAIL_BACKFILL_ON_STARTUP" yaml:"backfill_on_startup"`
+	IgnoreDotsDomains []string                `envconfig:"MEMBER_EMAIL_IGNORE_DOTS_DOMAINS"  yaml:"ignore_dots_domains"`
+	PlusTagDomains    []string                `envconfig:"MEMBER_EMAIL_PLUS_TAG_DOMAINS"     yaml:"plus_tag_domains"`
+	DomainAliases     map[string]string       `envconfig:"MEMBER_EMAIL_DOMAIN_ALIASES"       yaml:"domain_aliases"`
+	Policy            MemberEmailPolicyConfig `envconfig:"-"                                 yaml:"policy"`
+}
+
+// MemberEmailPolicyConfig 定義註冊與變更 Email 時的網域政策，零值欄位使用程式內預設值
+//   - Mode 為 open（預設，封鎖 blocklist 與拋棄式網域）或 allowlist（只允許 allowlist，適用內部部署）
+//   - DisposableListPath 拋棄式網域清單檔案，每行一個網域；檔案變更後於 ReloadInterval 內生效
+//   - CheckMX 開啟後會查詢網域的 MX/A 紀錄，DNS 故障時放行
+type MemberEmailPolicyConfig struct {
+	Mode               string        `envconfig:"MEMBER_EMAIL_POLICY_MODE"                 yaml:"mode"`
+	Allowlist          []string      `envconfig:"MEMBER_EMAIL_POLICY_ALLOWLIST"            yaml:"allowlist"`
+	Blocklist          []string      `envconfig:"MEMBER_EMAIL_POLICY_BLOCKLIST"            yaml:"blocklist"`
+	DisposableListPath string        `envconfig:"MEMBER_EMAIL_POLICY_DISPOSABLE_LIST_PATH" yaml:"disposable_list_path"`
+	ReloadInterval     time.Duration `envconfig:"MEMBER_EMAIL_POLICY_RELOAD_INTERVAL"      yaml:"reload_interval"`
+	CheckMX            bool          `envconfig:"MEMBER_EMAIL_POLICY_CHECK_MX"             yaml:"check_mx"`
+	LookupTimeout      time.Duration `envconfig:"MEMBER_EMAIL_POLICY_LOOKUP_TIMEOUT"       yaml:"lookup_timeout"`
+}
+
+// MemberPasswordConfig 定義註冊與變更密碼時的密碼政策，零值欄位使用程式內預設值
+//   - MinLength 預設 8、MaxLength 預設 128，長度以 Unicode 字元數計算
+//   - AllowPersonalInfo 為 false 時拒絕包含會員名稱或 Email local part 的密碼
+//   - BreachedListDir HIBP range 格式的外洩密碼前綴檔目錄，空字串表示不檢查
+type MemberPasswordConfig struct {
+	MinLength         int    `envconfig:"MEMBER_PASSWORD_MIN_LENGTH"          yaml:"min_length"`
+	MaxLength         int    `envconfig:"MEMBER_PASSWORD_MAX_LENGTH"          yaml:"max_length"`
+	RequireUppercase  bool   `envconfig:"MEMBER_PASSWORD_REQUIRE_UPPERCASE"   yaml:"require_uppercase"`
+	RequireLowercase  bool   `envconfig:"MEMBER_PASSWORD_REQUIRE_LOWERCASE"   yaml:"require_lowercase"`
+	RequireDigit      bool   `envconfig:"MEMBER_PASSWORD_REQUIRE_DIGIT"       yaml:"require_digit"`
+	RequireSymbol     bool   `envconfig:"MEMBER_PASSWORD_REQUIRE_SYMBOL"      yaml:"require_symbol"`
+	AllowPersonalInfo bool   `envconfig:"MEMBER_PASSWORD_ALLOW_PERSONAL_INFO" yaml:"allow_personal_info"`
+	BreachedListDir   string `envconfig:"MEMBER_PASSWORD_BREACHED_LIST_DIR"   yaml:"breached_list_dir"`
+}
+
+// MemberStatusConfig 定義會員帳號狀態配置
+//   - Admins 可啟用、停權、封鎖、恢復及合併會員，並管理會員標籤與分群的 actor（auth subject）；空值表示無人可變更狀態
+//   - RequireActivation 為 true 時新註冊會員為 pending，需管理者啟用後才能通過身分驗證
+type MemberStatusConfig struct {
+	Admins            []string `envconfig:"MEMBER_STATUS_ADMINS"             yaml:"admins"`
+	RequireActivation bool     `envconfig:"MEMBER_STATUS_REQUIRE_ACTIVATION" yaml:"require_activation"`
+}
+
+// MemberRegistrationConfig 定義會員註冊配置
+//   - Mode 為 open（預設，開放註冊）或 invite_only（註冊必須帶有效的邀請碼）；
+//     open 模式下帶了邀請碼仍會檢查並記錄推薦人
+//   - InvitationTTL 建立邀請碼未指定 expires_at 時的有效期間，零值使用預設 7 天
+type MemberRegistrationConfig struct {
+	Mode          string        `envconfig:"MEMBER_REGISTRATION_MODE"           yaml:"mode"`
+	InvitationTTL time.Duration `envconfig:"MEMBER_REGISTRATION_INVITATION_TTL" yaml:"invitation_ttl"`
+}
+
+// MemberPreferencesConfig 定義會員偏好設定的預設值，會員未設定時使用
+//   - DefaultLocale BCP 47 語系，例如 en、zh-TW
+//   - DefaultTimeZone IANA 時區名稱，例如 UTC、Asia/Taipei；不接受 Local
+type MemberPreferencesConfig struct {
+	DefaultLocale   string `envconfig:"MEMBER_PREFERENCES_DEFAULT_LOCALE"    yaml:"default_locale"`
+	DefaultTimeZone string `envconfig:"MEMBER_PREFERENCES_DEFAULT_TIME_ZONE" yaml:"default_time_zone"`
+}
+
+// MemberCacheConfig 定義會員查詢（GetByID/GetByEmail）的行程內快取，零值欄位使用程式內預設值
+//   - Size 最多快取的項目數，每位會員以 ID 與 Email 各佔一項，預設 10000
+//   - TTL 快取項目的存活時間，也是其他節點異動後最長可能讀到舊資料的時間，預設 1 分鐘
+//   - Operations 啟用快取的查詢：get_by_id、get_by_email，空值表示全部
+//   - StatsInterval 定期記錄各查詢命中與未命中次數的間隔，零值表示不記錄
+type MemberCacheConfig struct {
+	Enabled       bool          `envconfig:"MEMBER_CACHE_ENABLED"        yaml:"enabled"`
+	Size          int           `envconfig:"MEMBER_CACHE_SIZE"           yaml:"size"`
+	TTL           time.Duration `envconfig:"MEMBER_CACHE_TTL"            yaml:"ttl"`
+	Operations    []string      `envconfig:"MEMBER_CACHE_OPERATIONS"     yaml:"operations"`
+	StatsInterval time.Duration `envconfig:"MEMBER_CACHE_STATS_INTERVAL" yaml:"stats_interval"`
+}
@@ -1,16 +1,34 @@
 package bootstrap
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"github.com/gin-gonic/gin"
-	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/mcsqlite"
+	"github.com/jmoiron/sqlx"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxdriver"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxinstrument"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxmigrate"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxreplica"
 	"github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/middleware"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/middleware/auth"
+	"github.com/tomoffice/go-clean-architecture/internal/modules"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/emailpolicy"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/framework/publisher"
+	outboxusecase "github.com/tomoffice/go-clean-architecture/internal/modules/outbox/usecase"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/framework/subscriber"
+	webhookusecase "github.com/tomoffice/go-clean-architecture/internal/modules/webhook/usecase"
 	"github.com/tomoffice/go-clean-architecture/pkg/logger"
 	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
 
 	"github.com/tomoffice/go-clean-architecture/config"
 	"log"
+	"time"
 )
 
 type App struct {
@@ -18,6 +36,8 @@ type App struct {
 	MiddlewareContainer *middleware.Container
 	Logger              logger.Logger
 	Tracer              tracer.Tracer
+	// EventBus in-process 事件匯流排，outbox 發佈的事件一律會送到這裡
+	EventBus *publisher.Bus
 }
 
 func NewApp(cfg *config.Config, logger logger.Logger, tracer tracer.Tracer) *App {
@@ -26,17 +46,24 @@ func NewApp(cfg *config.Config, logger logger.Logger, tracer tracer.Tracer) *App
 		Logger:              logger,
 		Tracer:              tracer,
 		MiddlewareContainer: middleware.NewContainer(logger, tracer),
+		EventBus:            publisher.NewBus(),
 	}
 }
 
 func (a *App) Run() {
 	a.Logger.Debug("設定值", logger.NewField("config", a.Config))
-	// 初始化數據庫
-	db, err := mcsqlite.NewDB(a.Config.Database.DSN)
+	// 初始化數據庫，依 DSN 的 scheme 選擇 SQLite 或 PostgreSQL
+	db, err := sqlxdriver.NewDBFromConfig(context.Background(), a.Config.Database, a.Logger)
 	if err != nil {
 		log.Fatalf("DB 初始化失敗: %v", err)
 	}
-	a.Logger.Debug("DB 連接成功", logger.NewField("dsn", a.Config.Database.DSN))
+	defer a.closeDatabase(db)
+	a.migrateDatabase(db)
+	readRouter := a.newReadRouter(db)
+	defer readRouter.Close()
+	routerCtx, stopRouter := context.WithCancel(context.Background())
+	defer stopRouter()
+	go readRouter.Run(routerCtx)
 
 	// 設置 Gin 引擎
 	engine := gin.New()
@@ -45,12 +72,151 @@ func (a *App) Run() {
 	// middleware - logging middleware should be early in the chain
 	engine.Use(a.MiddlewareContainer.Logging())
 	engine.Use(a.MiddlewareContainer.CORS())
+	// 認證須在 RequestMeta 之前，actor 才會是 token 的 subject；沒帶 token 的請求以匿名身分繼續，
+	// 由 use case 依 actor 判斷權限（管理者、個資管理者、會員本人）
+	// 會員模組在路由組建立後才存在，subject 檢查在處理請求時才取用
+	var subjectAuthenticator *member.Module
+	authConfig := auth.AuthConfig{
+		Secret:   a.Config.Auth.JWT.Secret,
+		Optional: true,
+		ValidateSubject: func(ctx context.Context, subject string) error {
+			if subjectAuthenticator == nil {
+				return errors.New("member module not ready")
+			}
+			return subjectAuthenticator.AuthenticateSubject(ctx, subject)
+		},
+	}
+	if err := a.MiddlewareContainer.EnableAuth(authConfig); err != nil {
+		log.Fatalf("認證中間件初始化失敗: %v", err)
+	}
+	engine.Use(a.MiddlewareContainer.Auth())
+	engine.Use(a.MiddlewareContainer.RequestMeta())
 
 	// 設置 API 路由組
 	apiRouterGroup := engine.Group("/api/v1")
 
+	// 創建稽核模組（會員模組依賴其 input port 寫入稽核紀錄）
+	auditModule, err := audit.NewModuleFactory().CreateModule(db, apiRouterGroup, a.Logger, a.Tracer)
+	if err != nil {
+		log.Fatalf("創建稽核模組失敗: %v", err)
+	}
+	if err := auditModule.Setup(); err != nil {
+		a.Logger.Error("初始化稽核模組失敗", logger.NewField("error", err))
+	}
+	a.Logger.Debug("模組初始化成功", logger.NewField("module", auditModule.Name()))
+	concreteAuditModule, ok := auditModule.(*audit.Module)
+	if !ok {
+		log.Fatalf("稽核模組型別錯誤: %T", auditModule)
+	}
+
+	// 創建 webhook 模組，並在 outbox 建立 publisher 前訂閱領域事件
+	webhookModule, err := a.newWebhookModuleFactory().CreateModule(db, apiRouterGroup, a.Logger, a.Tracer)
+	if err != nil {
+		log.Fatalf("創建 webhook 模組失敗: %v", err)
+	}
+	if err := webhookModule.Setup(); err != nil {
+		a.Logger.Error("初始化 webhook 模組失敗", logger.NewField("error", err))
+	}
+	a.Logger.Debug("模組初始化成功", logger.NewField("module", webhookModule.Name()))
+	concreteWebhookModule, ok := webhookModule.(*webhook.Module)
+	if !ok {
+		log.Fatalf("webhook 模組型別錯誤: %T", webhookModule)
+	}
+	a.EventBus.Subscribe(publisher.WildcardEventType, subscriber.NewOutboxEventHandler(concreteWebhookModule.InputPort()))
+
+	// 創建 outbox 模組（會員模組在同一個交易中寫入領域事件）
+	outboxModule, err := a.newOutboxModuleFactory().CreateModule(db, apiRouterGroup, a.Logger, a.Tracer)
+	if err != nil {
+		log.Fatalf("創建 outbox 模組失敗: %v", err)
+	}
+	if err := outboxModule.Setup(); err != nil {
+		a.Logger.Error("初始化 outbox 模組失敗", logger.NewField("error", err))
+	}
+	a.Logger.Debug("模組初始化成功", logger.NewField("module", outboxModule.Name()))
+	concreteOutboxModule, ok := outboxModule.(*outbox.Module)
+	if !ok {
+		log.Fatalf("outbox 模組型別錯誤: %T", outboxModule)
+	}
+	dispatcherCtx, stopDispatcher := context.WithCancel(context.Background())
+	defer stopDispatcher()
+	if a.Config.Outbox.DispatcherEnabled {
+		go concreteOutboxModule.Dispatcher().Run(dispatcherCtx)
+	}
+	if a.Config.Webhook.DeliveryEnabled {
+		go concreteWebhookModule.Dispatcher().Run(dispatcherCtx)
+	}
+
 	// 創建會員模組
-	memberModuleFactory := member.NewModuleFactory()
+	memberOptions := member.Options{
+		Auth: member.AuthOptions{
+			Algorithm: a.Config.Auth.JWT.Algorithm,
+			Secret:    a.Config.Auth.JWT.Secret,
+			TokenTTL:  time.Duration(a.Config.Auth.JWT.Expire) * time.Second,
+		},
+		Stream: member.StreamOptions{
+			ReplayBufferSize:     a.Config.Member.Stream.ReplayBufferSize,
+			SubscriberBufferSize: a.Config.Member.Stream.SubscriberBufferSize,
+			Heartbeat:            a.Config.Member.Stream.HeartbeatInterval,
+		},
+		Privacy: member.PrivacyOptions{
+			Officers:     a.Config.Member.Privacy.Officers,
+			WebhookInput: concreteWebhookModule.InputPort(),
+		},
+		Email: member.EmailOptions{
+			IgnoreDotsDomains: a.Config.Member.Email.IgnoreDotsDomains,
+			PlusTagDomains:    a.Config.Member.Email.PlusTagDomains,
+			DomainAliases:     a.Config.Member.Email.DomainAliases,
+			Policy: emailpolicy.Options{
+				Mode:               a.Config.Member.Email.Policy.Mode,
+				Allowlist:          a.Config.Member.Email.Policy.Allowlist,
+				Blocklist:          a.Config.Member.Email.Policy.Blocklist,
+				DisposableListPath: a.Config.Member.Email.Policy.DisposableListPath,
+				ReloadInterval:     a.Config.Member.Email.Policy.ReloadInterval,
+				CheckMX:            a.Config.Member.Email.Policy.CheckMX,
+				LookupTimeout:      a.Config.Member.Email.Policy.LookupTimeout,
+			},
+		},
+		Password: member.PasswordOptions{
+			Policy: entity.PasswordPolicy{
+				MinLength:          a.Config.Member.Password.MinLength,
+				MaxLength:          a.Config.Member.Password.MaxLength,
+				RequireUppercase:   a.Config.Member.Password.RequireUppercase,
+				RequireLowercase:   a.Config.Member.Password.RequireLowercase,
+				RequireDigit:       a.Config.Member.Password.RequireDigit,
+				RequireSymbol:      a.Config.Member.Password.RequireSymbol,
+				RejectPersonalInfo: !a.Config.Member.Password.AllowPersonalInfo,
+			},
+			BreachedListDir: a.Config.Member.Password.BreachedListDir,
+		},
+		Status: member.StatusOptions{
+			Admins:            a.Config.Member.Status.Admins,
+			RequireActivation: a.Config.Member.Status.RequireActivation,
+		},
+		Registration: member.RegistrationOptions{
+			Mode:          a.Config.Member.Registration.Mode,
+			InvitationTTL: a.Config.Member.Registration.InvitationTTL,
+		},
+		Preferences: member.PreferenceOptions{
+			DefaultLocale:   a.Config.Member.Preferences.DefaultLocale,
+			DefaultTimeZone: a.Config.Member.Preferences.DefaultTimeZone,
+		},
+		Persistence: member.PersistenceOptions{
+			Driver:       a.Config.Database.Driver,
+			SnapshotPath: a.Config.Database.MemorySnapshot,
+			ReadRouter:   readRouter,
+			Instrumentation: sqlxinstrument.Options{
+				SlowThreshold: a.Config.Database.Instrumentation.SlowQueryThreshold,
+			},
+			StatementCacheSize: a.Config.Database.StatementCacheSize,
+			Cache: member.CacheOptions{
+				Enabled:    a.Config.Member.Cache.Enabled,
+				Size:       a.Config.Member.Cache.Size,
+				TTL:        a.Config.Member.Cache.TTL,
+				Operations: a.Config.Member.Cache.Operations,
+			},
+		},
+	}
+	memberModuleFactory := member.NewModuleFactory(concreteAuditModule.InputPort(), concreteOutboxModule.InputPort(), memberOptions)
 	memberModule, err := memberModuleFactory.CreateModule(db, apiRouterGroup, a.Logger, a.Tracer)
 	if err != nil {
 		//log.Fatalf("創建會員模組失敗: %v", err)
@@ -65,6 +231,26 @@ func (a *App) Run() {
 	}
 	//log.Printf("模組 %s 初始化成功", memberModule.Name())
 	a.Logger.Debug("模組初始化成功", logger.NewField("module", memberModule.Name()))
+	concreteMemberModule, ok := memberModule.(*member.Module)
+	if !ok {
+		log.Fatalf("會員模組型別錯誤: %T", memberModule)
+	}
+	subjectAuthenticator = concreteMemberModule
+	// 會員模組快取的 prepared statement 綁在主庫與 replica 上，須在它們關閉前先關閉（defer 依相反順序執行）
+	defer a.shutdownMemberModule(concreteMemberModule)
+	if a.Config.Member.Email.BackfillOnStartup {
+		a.backfillMemberEmails(memberModule)
+	}
+	if a.Config.Member.Cache.Enabled && a.Config.Member.Cache.StatsInterval > 0 {
+		if concreteMemberModule, ok := memberModule.(*member.Module); ok {
+			go concreteMemberModule.LogCacheStats(dispatcherCtx, a.Config.Member.Cache.StatsInterval, a.Logger)
+		}
+	}
+	if a.Config.Database.Instrumentation.StatsInterval > 0 {
+		if concreteMemberModule, ok := memberModule.(*member.Module); ok {
+			go concreteMemberModule.LogQueryStats(dispatcherCtx, a.Config.Database.Instrumentation.StatsInterval)
+		}
+	}
 
 	// 啟動服務器
 	addr := fmt.Sprintf("%s:%s", a.Config.Server.HTTP.Host, a.Config.Server.HTTP.Port)
@@ -75,3 +261,146 @@ func (a *App) Run() {
 		a.Logger.Error("啟動服務失敗", logger.NewField("error", err))
 	}
 }
+
+// shutdownMemberModule 中斷會員異動串流並關閉 statement 快取，失敗只記錄不中斷其餘的關閉流程
+func (a *App) shutdownMemberModule(memberModule *member.Module) {
+	if err := memberModule.Shutdown(); err != nil {
+		a.Logger.Error("關閉會員模組失敗", logger.NewField("error", err))
+	}
+}
+
+// closeDatabase 關閉主庫連接池，須在所有模組關閉後呼叫
+func (a *App) closeDatabase(db *sqlx.DB) {
+	if err := db.Close(); err != nil {
+		a.Logger.Error("關閉資料庫失敗", logger.NewField("error", err))
+	}
+}
+
+// backfillMemberEmails 回填會員正規化 Email，衝突由 use case 逐筆記錄，不中斷啟動
+func (a *App) backfillMemberEmails(memberModule modules.Module) {
+	concreteMemberModule, ok := memberModule.(*member.Module)
+	if !ok {
+		log.Fatalf("會員模組型別錯誤: %T", memberModule)
+	}
+	report, err := concreteMemberModule.BackfillNormalizedEmails(context.Background())
+	if err != nil {
+		a.Logger.Error("會員正規化 Email 回填失敗", logger.NewField("error", err))
+		return
+	}
+	a.Logger.Info("會員正規化 Email 回填完成",
+		logger.NewField("scanned", report.Scanned),
+		logger.NewField("updated", report.Updated),
+		logger.NewField("conflicts", len(report.Conflicts)),
+		logger.NewField("invalid_member_ids", report.InvalidMemberIDs),
+	)
+}
+
+// migrateDatabase 啟用 auto_migrate 時套用尚未執行的遷移，否則只檢查資料庫版本並提示
+func (a *App) migrateDatabase(db *sqlx.DB) {
+	migrator, err := sqlxmigrate.New(db, sqlxdriver.Migrations(sqlxdriver.Driver(db.DriverName())))
+	if err != nil {
+		log.Fatalf("載入遷移腳本失敗: %v", err)
+	}
+	if a.Config.Database.AutoMigrate {
+		steps, err := migrator.Up(context.Background())
+		for _, step := range steps {
+			a.Logger.Info("已套用資料庫遷移",
+				logger.NewField("version", step.Migration.Version),
+				logger.NewField("name", step.Migration.Name),
+			)
+		}
+		if err != nil {
+			log.Fatalf("資料庫遷移失敗: %v", err)
+		}
+		return
+	}
+	pending, err := migrator.Pending(context.Background())
+	if err != nil {
+		a.Logger.Error("資料庫版本檢查失敗", logger.NewField("error", err))
+		return
+	}
+	if len(pending) > 0 {
+		a.Logger.Warn("資料庫有尚未套用的遷移，請執行 api-server migrate up 或啟用 database.auto_migrate",
+			logger.NewField("pending", len(pending)),
+			logger.NewField("latest_version", pending[len(pending)-1].Version),
+		)
+	}
+}
+
+// newReadRouter 連接設定中的 replica 並組出讀寫分流 handle；連不上的 replica 略過，沒有 replica 時全部走 primary
+func (a *App) newReadRouter(db *sqlx.DB) *sqlxreplica.Router {
+	cfg := a.Config.Database
+	var replicas []sqlxreplica.Replica
+	for _, dsn := range cfg.Replicas.DSNs {
+		name := sqlxdriver.RedactDSN(dsn)
+		driver, err := sqlxdriver.DriverFromDSN(dsn)
+		if err != nil || string(driver) != db.DriverName() {
+			log.Fatalf("replica 必須與 primary 使用相同的 driver: %s", name)
+		}
+		replicaCfg := cfg
+		replicaCfg.DSN = dsn
+		replicaDB, err := sqlxdriver.NewDBFromConfig(context.Background(), replicaCfg, a.Logger)
+		if err != nil {
+			a.Logger.Error("replica 連接失敗，不加入讀取輪替",
+				logger.NewField("replica", name),
+				logger.NewField("error", err),
+			)
+			continue
+		}
+		replicas = append(replicas, sqlxreplica.Replica{Name: name, DB: replicaDB})
+	}
+	return sqlxreplica.NewRouter(db, replicas, sqlxreplica.Options{
+		ReadYourWritesWindow: cfg.Replicas.ReadYourWritesWindow,
+		HealthCheckInterval:  cfg.Replicas.HealthCheckInterval,
+	}, a.Logger)
+}
+
+// newOutboxModuleFactory 依設定組出 outbox 模組工廠，未設定的欄位沿用預設重試策略
+func (a *App) newOutboxModuleFactory() modules.ModuleFactory {
+	cfg := a.Config.Outbox
+	policy := outboxusecase.DefaultRetryPolicy()
+	if cfg.BatchSize > 0 {
+		policy.BatchSize = cfg.BatchSize
+	}
+	if cfg.MaxAttempts > 0 {
+		policy.MaxAttempts = cfg.MaxAttempts
+	}
+	if cfg.BaseBackoff > 0 {
+		policy.BaseBackoff = cfg.BaseBackoff
+	}
+	if cfg.MaxBackoff > 0 {
+		policy.MaxBackoff = cfg.MaxBackoff
+	}
+	if cfg.StuckAfter > 0 {
+		policy.StuckAfter = cfg.StuckAfter
+	}
+	publisherOptions := publisher.Options{
+		Type:        cfg.Publisher.Type,
+		HTTPURL:     cfg.Publisher.HTTPURL,
+		HTTPTimeout: cfg.Publisher.HTTPTimeout,
+		HTTPHeaders: cfg.Publisher.HTTPHeaders,
+	}
+	return outbox.NewModuleFactory(publisherOptions, a.EventBus, policy, cfg.PollInterval)
+}
+
+// newWebhookModuleFactory 依設定組出 webhook 模組工廠，未設定的欄位沿用預設重試策略
+func (a *App) newWebhookModuleFactory() modules.ModuleFactory {
+	cfg := a.Config.Webhook
+	policy := webhookusecase.DefaultRetryPolicy()
+	if cfg.BatchSize > 0 {
+		policy.BatchSize = cfg.BatchSize
+	}
+	if cfg.MaxAttempts > 0 {
+		policy.MaxAttempts = cfg.MaxAttempts
+	}
+	if cfg.BaseBackoff > 0 {
+		policy.BaseBackoff = cfg.BaseBackoff
+	}
+	if cfg.MaxBackoff > 0 {
+		policy.MaxBackoff = cfg.MaxBackoff
+	}
+	if cfg.DisableAfterFailures > 0 {
+		policy.DisableAfter = cfg.DisableAfterFailures
+	}
+	return webhook.NewModuleFactory(policy, cfg.PollInterval, cfg.Timeout, cfg.Admins)
+}
@@ -0,0 +1,102 @@
+// Package lrucache 提供行程內的 LRU 快取，每個項目各自帶有 TTL；
+// 容量滿時淘汰最久未使用的項目，過期項目在讀取時移除。
+package lrucache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultSize 未指定容量時的預設項目數
+const DefaultSize = 10000
+
+type entry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+// Cache 併發安全的 LRU 快取，值在寫入與讀取時都會複製，呼叫端可自由修改
+type Cache struct {
+	mu    sync.Mutex
+	size  int
+	order *list.List
+	items map[string]*list.Element
+	now   func() time.Time
+}
+
+// New 創建 LRU 快取，size <= 0 時使用 DefaultSize
+func New(size int) *Cache {
+	if size <= 0 {
+		size = DefaultSize
+	}
+	return &Cache{
+		size:  size,
+		order: list.New(),
+		items: make(map[string]*list.Element),
+		now:   time.Now,
+	}
+}
+
+// Get 回傳未過期的值並標記為最近使用
+func (c *Cache) Get(_ context.Context, key string) ([]byte, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.items[key]
+	if !ok {
+		return nil, false, nil
+	}
+	e := elem.Value.(*entry)
+	if !c.now().Before(e.expiresAt) {
+		c.remove(elem)
+		return nil, false, nil
+	}
+	c.order.MoveToFront(elem)
+	return append([]byte(nil), e.value...), true, nil
+}
+
+// Set 寫入或覆蓋 key，超過容量時淘汰最久未使用的項目；ttl <= 0 時不寫入
+func (c *Cache) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	if ttl <= 0 {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e := &entry{key: key, value: append([]byte(nil), value...), expiresAt: c.now().Add(ttl)}
+	if elem, ok := c.items[key]; ok {
+		elem.Value = e
+		c.order.MoveToFront(elem)
+		return nil
+	}
+	c.items[key] = c.order.PushFront(e)
+	for c.order.Len() > c.size {
+		c.remove(c.order.Back())
+	}
+	return nil
+}
+
+// Delete 刪除多個 key
+func (c *Cache) Delete(_ context.Context, keys ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, key := range keys {
+		if elem, ok := c.items[key]; ok {
+			c.remove(elem)
+		}
+	}
+	return nil
+}
+
+// Len 目前的項目數（含尚未清除的過期項目）
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+func (c *Cache) remove(elem *list.Element) {
+	c.order.Remove(elem)
+	delete(c.items, elem.Value.(*entry).key)
+}
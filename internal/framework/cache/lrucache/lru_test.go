@@ -0,0 +1,66 @@
+package lrucache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache_Eviction(t *testing.T) {
+	ctx := context.Background()
+	cache := New(2)
+	require.NoError(t, cache.Set(ctx, "a", []byte("1"), time.Minute))
+	require.NoError(t, cache.Set(ctx, "b", []byte("2"), time.Minute))
+
+	// 讀取 a 後 b 變成最久未使用，寫入 c 時淘汰 b
+	_, ok, _ := cache.Get(ctx, "a")
+	require.True(t, ok)
+	require.NoError(t, cache.Set(ctx, "c", []byte("3"), time.Minute))
+
+	_, ok, _ = cache.Get(ctx, "b")
+	assert.False(t, ok)
+	value, ok, _ := cache.Get(ctx, "a")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("1"), value)
+	assert.Equal(t, 2, cache.Len())
+}
+
+func TestCache_TTL(t *testing.T) {
+	ctx := context.Background()
+	cache := New(0)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache.now = func() time.Time { return now }
+	require.NoError(t, cache.Set(ctx, "a", []byte("1"), time.Minute))
+
+	now = now.Add(59 * time.Second)
+	_, ok, _ := cache.Get(ctx, "a")
+	assert.True(t, ok)
+	now = now.Add(time.Second)
+	_, ok, _ = cache.Get(ctx, "a")
+	assert.False(t, ok)
+	assert.Equal(t, 0, cache.Len())
+
+	require.NoError(t, cache.Set(ctx, "b", []byte("2"), 0))
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestCache_CopiesValues(t *testing.T) {
+	ctx := context.Background()
+	cache := New(0)
+	value := []byte("abc")
+	require.NoError(t, cache.Set(ctx, "a", value, time.Minute))
+	value[0] = 'x'
+
+	got, _, _ := cache.Get(ctx, "a")
+	assert.Equal(t, []byte("abc"), got)
+	got[0] = 'y'
+	got, _, _ = cache.Get(ctx, "a")
+	assert.Equal(t, []byte("abc"), got)
+
+	require.NoError(t, cache.Delete(ctx, "a", "missing"))
+	_, ok, _ := cache.Get(ctx, "a")
+	assert.False(t, ok)
+}
@@ -1,8 +1,12 @@
 package mcsqlite
 
 import (
+	"context"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/mattn/go-sqlite3"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -12,6 +16,21 @@ type ConnConfig struct {
 	MaxOpenConns    int
 	MaxIdleConns    int
 	ConnMaxLifetime time.Duration
+	ConnMaxIdleTime time.Duration
+	// ConnectTimeout 建立連接後 ping 的逾時
+	ConnectTimeout time.Duration
+	Pragmas        Pragmas
+}
+
+// Pragmas 每個連接開啟時套用的 pragma，透過 go-sqlite3 的 DSN 參數設定；DSN 已指定的參數優先
+type Pragmas struct {
+	// JournalMode 例如 WAL，空字串沿用 SQLite 預設
+	JournalMode string
+	// BusyTimeout 資料庫被鎖定時的等待時間，0 沿用 go-sqlite3 預設
+	BusyTimeout time.Duration
+	ForeignKeys bool
+	// Synchronous 例如 NORMAL、FULL，空字串沿用 SQLite 預設
+	Synchronous string
 }
 
 // DefaultConnConfig 創建預設連接配置
@@ -21,6 +40,18 @@ func DefaultConnConfig(dsn string) *ConnConfig {
 		MaxOpenConns:    25,
 		MaxIdleConns:    5,
 		ConnMaxLifetime: time.Hour,
+		ConnectTimeout:  5 * time.Second,
+		Pragmas:         DefaultPragmas(),
+	}
+}
+
+// DefaultPragmas 預設 pragma：WAL 讓讀寫不互相阻擋，並啟用外鍵約束
+func DefaultPragmas() Pragmas {
+	return Pragmas{
+		JournalMode: "WAL",
+		BusyTimeout: 5 * time.Second,
+		ForeignKeys: true,
+		Synchronous: "NORMAL",
 	}
 }
 
@@ -32,7 +63,7 @@ func NewDB(dsn string) (*sqlx.DB, error) {
 
 // NewDBWithConfig 使用自訂配置創建 SQLite 資料庫連接
 func NewDBWithConfig(cfg *ConnConfig) (*sqlx.DB, error) {
-	db, err := sqlx.Connect("sqlite3", cfg.DSN)
+	db, err := sqlx.Open("sqlite3", cfg.Pragmas.Apply(cfg.DSN))
 	if err != nil {
 		return nil, err
 	}
@@ -40,6 +71,50 @@ func NewDBWithConfig(cfg *ConnConfig) (*sqlx.DB, error) {
 	db.SetMaxOpenConns(cfg.MaxOpenConns)
 	db.SetMaxIdleConns(cfg.MaxIdleConns)
 	db.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
 
+	ctx := context.Background()
+	if cfg.ConnectTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.ConnectTimeout)
+		defer cancel()
+	}
+	if err := db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
 	return db, nil
 }
+
+// Apply 把 pragma 加到 DSN 的查詢參數；DSN 已指定（含 go-sqlite3 的簡寫別名）的參數不覆蓋
+func (p Pragmas) Apply(dsn string) string {
+	base, rawQuery, _ := strings.Cut(dsn, "?")
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		// 無法解析的參數交給 go-sqlite3 回報錯誤
+		return dsn
+	}
+	set := func(value string, keys ...string) {
+		for _, key := range keys {
+			if query.Has(key) {
+				return
+			}
+		}
+		query.Set(keys[0], value)
+	}
+	if p.JournalMode != "" {
+		set(p.JournalMode, "_journal_mode", "_journal")
+	}
+	if p.BusyTimeout > 0 {
+		set(strconv.FormatInt(p.BusyTimeout.Milliseconds(), 10), "_busy_timeout", "_timeout")
+	}
+	if p.ForeignKeys {
+		set("1", "_foreign_keys", "_fk")
+	} else {
+		set("0", "_foreign_keys", "_fk")
+	}
+	if p.Synchronous != "" {
+		set(p.Synchronous, "_synchronous", "_sync")
+	}
+	return base + "?" + query.Encode()
+}
@@ -0,0 +1,47 @@
+package mcsqlite
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPragmas_Apply(t *testing.T) {
+	tests := []struct {
+		name    string
+		pragmas Pragmas
+		dsn     string
+		want    string
+	}{
+		{
+			name:    "defaults on path",
+			pragmas: DefaultPragmas(),
+			dsn:     "./data/test.sqlite",
+			want:    "./data/test.sqlite?_busy_timeout=5000&_foreign_keys=1&_journal_mode=WAL&_synchronous=NORMAL",
+		},
+		{
+			name:    "keeps existing parameters",
+			pragmas: Pragmas{JournalMode: "WAL", ForeignKeys: true},
+			dsn:     "file:./data/test.sqlite?cache=shared",
+			want:    "file:./data/test.sqlite?_foreign_keys=1&_journal_mode=WAL&cache=shared",
+		},
+		{
+			name:    "dsn wins including aliases",
+			pragmas: Pragmas{JournalMode: "WAL", BusyTimeout: time.Second, ForeignKeys: true, Synchronous: "NORMAL"},
+			dsn:     "file:test.sqlite?_journal=DELETE&_timeout=100&_fk=0&_sync=FULL",
+			want:    "file:test.sqlite?_fk=0&_journal=DELETE&_sync=FULL&_timeout=100",
+		},
+		{
+			name:    "foreign keys disabled explicitly",
+			pragmas: Pragmas{},
+			dsn:     ":memory:",
+			want:    ":memory:?_foreign_keys=0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.pragmas.Apply(tt.dsn))
+		})
+	}
+}
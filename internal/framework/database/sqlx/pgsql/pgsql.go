@@ -0,0 +1,65 @@
+package pgsql
+
+import (
+	"context"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+// DriverName pgx 註冊到 database/sql 的 driver 名稱
+const DriverName = "pgx"
+
+// ConnConfig PostgreSQL 連接配置
+type ConnConfig struct {
+	DSN             string
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+	ConnMaxIdleTime time.Duration
+	// ConnectTimeout 建立連接後 ping 的逾時
+	ConnectTimeout time.Duration
+}
+
+// DefaultConnConfig 創建預設連接配置
+func DefaultConnConfig(dsn string) *ConnConfig {
+	return &ConnConfig{
+		DSN:             dsn,
+		MaxOpenConns:    25,
+		MaxIdleConns:    5,
+		ConnMaxLifetime: time.Hour,
+		ConnMaxIdleTime: 10 * time.Minute,
+		ConnectTimeout:  5 * time.Second,
+	}
+}
+
+// NewDB 使用預設配置創建 PostgreSQL 資料庫連接
+func NewDB(dsn string) (*sqlx.DB, error) {
+	cfg := DefaultConnConfig(dsn)
+	return NewDBWithConfig(cfg)
+}
+
+// NewDBWithConfig 使用自訂配置創建 PostgreSQL 資料庫連接
+func NewDBWithConfig(cfg *ConnConfig) (*sqlx.DB, error) {
+	db, err := sqlx.Open(DriverName, cfg.DSN)
+	if err != nil {
+		return nil, err
+	}
+
+	db.SetMaxOpenConns(cfg.MaxOpenConns)
+	db.SetMaxIdleConns(cfg.MaxIdleConns)
+	db.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+
+	ctx := context.Background()
+	if cfg.ConnectTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.ConnectTimeout)
+		defer cancel()
+	}
+	if err := db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	return db, nil
+}
@@ -0,0 +1,67 @@
+package sqlxdriver
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/mcsqlite"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/pgsql"
+	"github.com/tomoffice/go-clean-architecture/migrations"
+	"github.com/tomoffice/go-clean-architecture/seed"
+	"io/fs"
+	"strings"
+)
+
+// Driver 依 DSN 選出的資料庫 driver，值與註冊到 database/sql 的名稱一致，可與 db.DriverName() 比對
+type Driver string
+
+const (
+	DriverSQLite   Driver = "sqlite3"
+	DriverPostgres Driver = pgsql.DriverName
+)
+
+// DriverFromDSN 依 DSN 的 scheme 判斷 driver：postgres://、postgresql:// 為 PostgreSQL，
+// 檔案路徑與 file: URI 為 SQLite，其他 scheme 回傳錯誤
+func DriverFromDSN(dsn string) (Driver, error) {
+	scheme, _, found := strings.Cut(dsn, "://")
+	if !found {
+		return DriverSQLite, nil
+	}
+	switch strings.ToLower(scheme) {
+	case "postgres", "postgresql":
+		return DriverPostgres, nil
+	case "file":
+		return DriverSQLite, nil
+	default:
+		return "", fmt.Errorf("sqlxdriver: unsupported dsn scheme %q", scheme)
+	}
+}
+
+// NewDB 依 DSN 的 scheme 創建資料庫連接
+func NewDB(dsn string) (*sqlx.DB, error) {
+	driver, err := DriverFromDSN(dsn)
+	if err != nil {
+		return nil, err
+	}
+	switch driver {
+	case DriverPostgres:
+		return pgsql.NewDB(dsn)
+	default:
+		return mcsqlite.NewDB(dsn)
+	}
+}
+
+// Migrations 回傳 driver 對應的內嵌遷移腳本
+func Migrations(driver Driver) fs.FS {
+	if driver == DriverPostgres {
+		return migrations.Postgres()
+	}
+	return migrations.SQLite()
+}
+
+// Seeds 回傳 driver 對應的內嵌種子資料
+func Seeds(driver Driver) fs.FS {
+	if driver == DriverPostgres {
+		return seed.Postgres()
+	}
+	return seed.SQLite()
+}
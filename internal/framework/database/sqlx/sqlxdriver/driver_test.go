@@ -0,0 +1,35 @@
+package sqlxdriver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDriverFromDSN(t *testing.T) {
+	tests := []struct {
+		name    string
+		dsn     string
+		want    Driver
+		wantErr bool
+	}{
+		{name: "sqlite file uri", dsn: "file:./data/identifier.sqlite?cache=shared", want: DriverSQLite},
+		{name: "sqlite absolute file uri", dsn: "file:///tmp/identifier.sqlite", want: DriverSQLite},
+		{name: "sqlite path", dsn: "./data/identifier.sqlite", want: DriverSQLite},
+		{name: "sqlite memory", dsn: ":memory:", want: DriverSQLite},
+		{name: "postgres", dsn: "postgres://app:secret@db:5432/members?sslmode=disable", want: DriverPostgres},
+		{name: "postgresql upper case", dsn: "PostgreSQL://db/members", want: DriverPostgres},
+		{name: "unsupported scheme", dsn: "mysql://db/members", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DriverFromDSN(tt.dsn)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package sqlxdriver
+
+import (
+	"context"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/tomoffice/go-clean-architecture/config"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/mcsqlite"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/pgsql"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// defaultRetryBackoff 未設定 RetryBackoff 時第一次重試前的等待時間
+	defaultRetryBackoff = time.Second
+	// maxRetryBackoff 重試間隔每次加倍，最多等待這麼久
+	maxRetryBackoff = 30 * time.Second
+)
+
+// redactedPassword 記錄 DSN 時取代密碼的字串
+const redactedPassword = "xxxxx"
+
+// NewDBFromConfig 依 DSN 的 scheme 選擇 driver，套用連接池與 SQLite pragma 設定並創建資料庫連接
+//   - 未設定（零值）的欄位沿用各 driver 的預設值
+//   - 連接失敗時依 ConnectRetries 重試，間隔從 RetryBackoff 開始加倍
+//   - 成功後記錄實際生效的設定，DSN 中的密碼會遮蔽
+func NewDBFromConfig(ctx context.Context, cfg config.DatabaseConfig, log logger.Logger) (*sqlx.DB, error) {
+	driver, err := DriverFromDSN(cfg.DSN)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		connect func() (*sqlx.DB, error)
+		fields  []logger.Field
+	)
+	switch driver {
+	case DriverPostgres:
+		connCfg := pgsql.DefaultConnConfig(cfg.DSN)
+		applyPool(cfg, &connCfg.MaxOpenConns, &connCfg.MaxIdleConns, &connCfg.ConnMaxLifetime, &connCfg.ConnMaxIdleTime, &connCfg.ConnectTimeout)
+		connect = func() (*sqlx.DB, error) { return pgsql.NewDBWithConfig(connCfg) }
+		fields = poolFields(connCfg.MaxOpenConns, connCfg.MaxIdleConns, connCfg.ConnMaxLifetime, connCfg.ConnMaxIdleTime, connCfg.ConnectTimeout)
+	default:
+		connCfg := mcsqlite.DefaultConnConfig(cfg.DSN)
+		applyPool(cfg, &connCfg.MaxOpenConns, &connCfg.MaxIdleConns, &connCfg.ConnMaxLifetime, &connCfg.ConnMaxIdleTime, &connCfg.ConnectTimeout)
+		if cfg.SQLite.JournalMode != "" {
+			connCfg.Pragmas.JournalMode = cfg.SQLite.JournalMode
+		}
+		if cfg.SQLite.BusyTimeout > 0 {
+			connCfg.Pragmas.BusyTimeout = cfg.SQLite.BusyTimeout
+		}
+		if cfg.SQLite.Synchronous != "" {
+			connCfg.Pragmas.Synchronous = cfg.SQLite.Synchronous
+		}
+		connCfg.Pragmas.ForeignKeys = !cfg.SQLite.DisableForeignKeys
+		connect = func() (*sqlx.DB, error) { return mcsqlite.NewDBWithConfig(connCfg) }
+		fields = append(poolFields(connCfg.MaxOpenConns, connCfg.MaxIdleConns, connCfg.ConnMaxLifetime, connCfg.ConnMaxIdleTime, connCfg.ConnectTimeout),
+			logger.NewField("journal_mode", connCfg.Pragmas.JournalMode),
+			logger.NewField("busy_timeout", connCfg.Pragmas.BusyTimeout.String()),
+			logger.NewField("foreign_keys", connCfg.Pragmas.ForeignKeys),
+			logger.NewField("synchronous", connCfg.Pragmas.Synchronous),
+		)
+	}
+
+	backoff := cfg.RetryBackoff
+	if backoff <= 0 {
+		backoff = defaultRetryBackoff
+	}
+	dsn := RedactDSN(cfg.DSN)
+	db, err := connectWithRetry(ctx, connect, cfg.ConnectRetries, backoff, log.With(logger.NewField("dsn", dsn)))
+	if err != nil {
+		return nil, err
+	}
+	log.Info("DB 連接成功", append([]logger.Field{
+		logger.NewField("dsn", dsn),
+		logger.NewField("driver", driver),
+	}, fields...)...)
+	return db, nil
+}
+
+// connectWithRetry 連接失敗時最多重試 retries 次，ctx 取消時停止等待
+func connectWithRetry(ctx context.Context, connect func() (*sqlx.DB, error), retries int, backoff time.Duration, log logger.Logger) (*sqlx.DB, error) {
+	for attempt := 1; ; attempt++ {
+		db, err := connect()
+		if err == nil {
+			return db, nil
+		}
+		if attempt > retries {
+			return nil, fmt.Errorf("sqlxdriver: connect failed after %d attempt(s): %w", attempt, err)
+		}
+		log.Warn("DB 連接失敗，稍後重試",
+			logger.NewField("attempt", attempt),
+			logger.NewField("retry_in", backoff.String()),
+			logger.NewField("error", err),
+		)
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("sqlxdriver: connect canceled: %w", ctx.Err())
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxRetryBackoff)
+	}
+}
+
+// applyPool 以設定中非零的欄位覆蓋 driver 預設值
+func applyPool(cfg config.DatabaseConfig, maxOpen, maxIdle *int, lifetime, idleTime, connectTimeout *time.Duration) {
+	if cfg.MaxOpenConns > 0 {
+		*maxOpen = cfg.MaxOpenConns
+	}
+	if cfg.MaxIdleConns > 0 {
+		*maxIdle = cfg.MaxIdleConns
+	}
+	if cfg.ConnMaxLifetime > 0 {
+		*lifetime = cfg.ConnMaxLifetime
+	}
+	if cfg.ConnMaxIdleTime > 0 {
+		*idleTime = cfg.ConnMaxIdleTime
+	}
+	if cfg.ConnectTimeout > 0 {
+		*connectTimeout = cfg.ConnectTimeout
+	}
+}
+
+func poolFields(maxOpen, maxIdle int, lifetime, idleTime, connectTimeout time.Duration) []logger.Field {
+	return []logger.Field{
+		logger.NewField("max_open_conns", maxOpen),
+		logger.NewField("max_idle_conns", maxIdle),
+		logger.NewField("conn_max_lifetime", lifetime.String()),
+		logger.NewField("conn_max_idle_time", idleTime.String()),
+		logger.NewField("connect_timeout", connectTimeout.String()),
+	}
+}
+
+// RedactDSN 遮蔽 URL 形式 DSN 中的密碼（userinfo 與 password 參數），檔案路徑原樣回傳；
+// 無法解析的 URL 不回傳原字串，避免密碼寫進日誌
+func RedactDSN(dsn string) string {
+	if !strings.Contains(dsn, "://") {
+		return dsn
+	}
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return "<invalid dsn>"
+	}
+	query := u.Query()
+	redacted := false
+	for _, key := range []string{"password", "sslpassword"} {
+		if query.Has(key) {
+			query.Set(key, redactedPassword)
+			redacted = true
+		}
+	}
+	if redacted {
+		u.RawQuery = query.Encode()
+	}
+	if _, hasPassword := u.User.Password(); hasPassword {
+		u.User = url.UserPassword(u.User.Username(), redactedPassword)
+	}
+	return u.String()
+}
@@ -0,0 +1,142 @@
+package sqlxdriver
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tomoffice/go-clean-architecture/config"
+	mocklogger "github.com/tomoffice/go-clean-architecture/pkg/logger/mock"
+)
+
+func newTestLogger(t *testing.T) *mocklogger.MockLogger {
+	ctrl := gomock.NewController(t)
+	mockLogger := mocklogger.NewMockLogger(ctrl)
+	mockLogger.EXPECT().With(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	return mockLogger
+}
+
+func TestRedactDSN(t *testing.T) {
+	tests := []struct {
+		name string
+		dsn  string
+		want string
+	}{
+		{name: "sqlite path", dsn: "./data/identifier.sqlite", want: "./data/identifier.sqlite"},
+		{name: "sqlite file uri", dsn: "file:./data/identifier.sqlite?cache=shared", want: "file:./data/identifier.sqlite?cache=shared"},
+		{name: "userinfo password", dsn: "postgres://app:s3cr3t@db:5432/members?sslmode=disable", want: "postgres://app:xxxxx@db:5432/members?sslmode=disable"},
+		{name: "user without password", dsn: "postgres://app@db/members", want: "postgres://app@db/members"},
+		{name: "password parameter", dsn: "postgres://db/members?password=s3cr3t&user=app", want: "postgres://db/members?password=xxxxx&user=app"},
+		{name: "unparsable url", dsn: "postgres://app:s3cr3t@db:port/members", want: "<invalid dsn>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, RedactDSN(tt.dsn))
+		})
+	}
+}
+
+func TestNewDBFromConfig_SQLitePragmas(t *testing.T) {
+	tests := []struct {
+		name            string
+		cfg             config.DatabaseConfig
+		wantJournalMode string
+		wantForeignKeys int
+		wantBusyTimeout int
+		wantMaxOpen     int
+	}{
+		{
+			name:            "defaults",
+			cfg:             config.DatabaseConfig{},
+			wantJournalMode: "wal",
+			wantForeignKeys: 1,
+			wantBusyTimeout: 5000,
+			wantMaxOpen:     25,
+		},
+		{
+			name: "configured",
+			cfg: config.DatabaseConfig{
+				MaxOpenConns: 3,
+				SQLite:       config.SQLiteConfig{JournalMode: "DELETE", BusyTimeout: 250 * time.Millisecond, DisableForeignKeys: true},
+			},
+			wantJournalMode: "delete",
+			wantForeignKeys: 0,
+			wantBusyTimeout: 250,
+			wantMaxOpen:     3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.cfg.DSN = filepath.Join(t.TempDir(), "test.sqlite")
+			db, err := NewDBFromConfig(context.Background(), tt.cfg, newTestLogger(t))
+			require.NoError(t, err)
+			defer db.Close()
+
+			var journalMode string
+			var foreignKeys, busyTimeout int
+			require.NoError(t, db.Get(&journalMode, "PRAGMA journal_mode"))
+			require.NoError(t, db.Get(&foreignKeys, "PRAGMA foreign_keys"))
+			require.NoError(t, db.Get(&busyTimeout, "PRAGMA busy_timeout"))
+			assert.Equal(t, tt.wantJournalMode, journalMode)
+			assert.Equal(t, tt.wantForeignKeys, foreignKeys)
+			assert.Equal(t, tt.wantBusyTimeout, busyTimeout)
+			assert.Equal(t, tt.wantMaxOpen, db.Stats().MaxOpenConnections)
+		})
+	}
+}
+
+func TestConnectWithRetry(t *testing.T) {
+	errConnect := errors.New("connection refused")
+	tests := []struct {
+		name         string
+		failures     int
+		retries      int
+		wantAttempts int
+		wantErr      bool
+	}{
+		{name: "first attempt", failures: 0, retries: 3, wantAttempts: 1},
+		{name: "succeeds after retries", failures: 2, retries: 3, wantAttempts: 3},
+		{name: "retries exhausted", failures: 5, retries: 2, wantAttempts: 3, wantErr: true},
+		{name: "no retries", failures: 1, retries: 0, wantAttempts: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockLogger := newTestLogger(t)
+			mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).Times(min(tt.failures, tt.retries))
+			attempts := 0
+			connect := func() (*sqlx.DB, error) {
+				attempts++
+				if attempts <= tt.failures {
+					return nil, errConnect
+				}
+				return &sqlx.DB{}, nil
+			}
+			db, err := connectWithRetry(context.Background(), connect, tt.retries, time.Millisecond, mockLogger)
+			assert.Equal(t, tt.wantAttempts, attempts)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errConnect)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NotNil(t, db)
+		})
+	}
+}
+
+func TestConnectWithRetry_Canceled(t *testing.T) {
+	mockLogger := newTestLogger(t)
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).Times(1)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := connectWithRetry(ctx, func() (*sqlx.DB, error) {
+		return nil, errors.New("connection refused")
+	}, 5, time.Hour, mockLogger)
+	assert.ErrorIs(t, err, context.Canceled)
+}
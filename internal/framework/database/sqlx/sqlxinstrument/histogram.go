@@ -0,0 +1,137 @@
+package sqlxinstrument
+
+import (
+	"context"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"maps"
+	"math"
+	"slices"
+	"time"
+)
+
+// DefaultBuckets 預設的延遲直方圖上界，最後另有一格收超過最大上界的查詢
+var DefaultBuckets = []time.Duration{
+	time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+}
+
+// QueryStats 單一查詢名稱的累計統計
+type QueryStats struct {
+	Count int64
+	// Errors 依錯誤分類的次數
+	Errors map[string]int64
+	Slow   int64
+	Total  time.Duration
+	Max    time.Duration
+	// Buckets 與 Counts 對應，Counts 比 Buckets 多一格，記錄超過最大上界的查詢
+	Buckets []time.Duration
+	Counts  []int64
+}
+
+// Mean 平均耗時，沒有紀錄時為 0
+func (s QueryStats) Mean() time.Duration {
+	if s.Count == 0 {
+		return 0
+	}
+	return s.Total / time.Duration(s.Count)
+}
+
+// Quantile 以直方圖估計第 q 分位（0~1）的耗時，回傳所在區間的上界；落在最後一格時回傳 Max
+func (s QueryStats) Quantile(q float64) time.Duration {
+	if s.Count == 0 {
+		return 0
+	}
+	rank := max(int64(math.Ceil(q*float64(s.Count))), 1)
+	var seen int64
+	for i, count := range s.Counts {
+		seen += count
+		if seen >= rank {
+			if i < len(s.Buckets) {
+				return min(s.Buckets[i], s.Max)
+			}
+			return s.Max
+		}
+	}
+	return s.Max
+}
+
+func (i *Instrumenter) record(name string, duration time.Duration, class string, slow bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	s, ok := i.queries[name]
+	if !ok {
+		s = &QueryStats{
+			Errors:  make(map[string]int64),
+			Buckets: i.options.Buckets,
+			Counts:  make([]int64, len(i.options.Buckets)+1),
+		}
+		i.queries[name] = s
+	}
+	s.Count++
+	s.Total += duration
+	s.Max = max(s.Max, duration)
+	if class != "" {
+		s.Errors[class]++
+	}
+	if slow {
+		s.Slow++
+	}
+	// 上界含等於，超過最大上界時落在最後一格
+	bucket, _ := slices.BinarySearch(i.options.Buckets, duration)
+	s.Counts[bucket]++
+}
+
+// Stats 回傳每個查詢名稱的統計複本
+func (i *Instrumenter) Stats() map[string]QueryStats {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	stats := make(map[string]QueryStats, len(i.queries))
+	for name, s := range i.queries {
+		clone := *s
+		clone.Errors = maps.Clone(s.Errors)
+		clone.Counts = slices.Clone(s.Counts)
+		stats[name] = clone
+	}
+	return stats
+}
+
+// LogStats 定期依查詢名稱記錄延遲統計，直到 ctx 取消
+func (i *Instrumenter) LogStats(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			stats := i.Stats()
+			for _, name := range slices.Sorted(maps.Keys(stats)) {
+				s := stats[name]
+				var errorCount int64
+				for _, count := range s.Errors {
+					errorCount += count
+				}
+				i.logger.Info("SQL 查詢統計",
+					logger.NewField("query_name", name),
+					logger.NewField("count", s.Count),
+					logger.NewField("errors", errorCount),
+					logger.NewField("slow", s.Slow),
+					logger.NewField("mean_ms", s.Mean().Milliseconds()),
+					logger.NewField("p50_ms", s.Quantile(0.5).Milliseconds()),
+					logger.NewField("p95_ms", s.Quantile(0.95).Milliseconds()),
+					logger.NewField("p99_ms", s.Quantile(0.99).Milliseconds()),
+					logger.NewField("max_ms", s.Max.Milliseconds()),
+				)
+			}
+		}
+	}
+}
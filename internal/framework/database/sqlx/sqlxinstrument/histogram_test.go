@@ -0,0 +1,47 @@
+package sqlxinstrument
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	mocklogger "github.com/tomoffice/go-clean-architecture/pkg/logger/mock"
+)
+
+func TestInstrumenter_Histogram(t *testing.T) {
+	buckets := []time.Duration{time.Millisecond, 10 * time.Millisecond, 100 * time.Millisecond}
+	inst := newTestInstrumenter(t, mocklogger.NewMockLogger(gomock.NewController(t)), Options{Buckets: buckets}, 0)
+
+	durations := []time.Duration{
+		500 * time.Microsecond, time.Millisecond, // 上界含等於
+		2 * time.Millisecond, 5 * time.Millisecond, 10 * time.Millisecond,
+		50 * time.Millisecond,
+		time.Second, // 超過最大上界
+	}
+	for _, d := range durations {
+		inst.record("members.select_by_id", d, "", d >= 100*time.Millisecond)
+	}
+	inst.record("members.select_by_id", 3*time.Millisecond, ErrorClassTimeout, false)
+
+	stats := inst.Stats()["members.select_by_id"]
+	assert.Equal(t, int64(8), stats.Count)
+	assert.Equal(t, []int64{2, 4, 1, 1}, stats.Counts)
+	assert.Equal(t, int64(1), stats.Slow)
+	assert.Equal(t, map[string]int64{ErrorClassTimeout: 1}, stats.Errors)
+	assert.Equal(t, time.Second, stats.Max)
+	assert.Equal(t, (1071500*time.Microsecond)/8, stats.Mean())
+
+	assert.Equal(t, time.Millisecond, stats.Quantile(0.1))
+	assert.Equal(t, 10*time.Millisecond, stats.Quantile(0.5))
+	assert.Equal(t, 100*time.Millisecond, stats.Quantile(0.85))
+	assert.Equal(t, time.Second, stats.Quantile(0.99))
+	assert.Equal(t, time.Duration(0), QueryStats{}.Quantile(0.5))
+
+	// Stats 回傳複本，修改不影響累計中的統計
+	stats.Counts[0] = 100
+	stats.Errors[ErrorClassTimeout] = 100
+	again := inst.Stats()["members.select_by_id"]
+	assert.Equal(t, int64(2), again.Counts[0])
+	assert.Equal(t, int64(1), again.Errors[ErrorClassTimeout])
+}
@@ -0,0 +1,219 @@
+// Package sqlxinstrument 提供 SQL 呼叫的共用觀測：每次查詢建立子 span，記錄查詢名稱、
+// 正規化後的 SQL、影響或讀取的筆數、耗時與錯誤分類，超過慢查詢門檻時以警告記錄
+// （綁定參數只輸出型別），並累計到每個查詢名稱的延遲直方圖。
+package sqlxinstrument
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxtx"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultSlowThreshold 未設定慢查詢門檻時使用的值
+const DefaultSlowThreshold = 200 * time.Millisecond
+
+// 預設的錯誤分類，成功時為空字串
+const (
+	ErrorClassNotFound = "not_found"
+	ErrorClassTimeout  = "timeout"
+	ErrorClassCanceled = "canceled"
+	ErrorClassTxDone   = "tx_done"
+	ErrorClassOther    = "error"
+)
+
+// Options 觀測設定，零值欄位使用預設值
+type Options struct {
+	// SlowThreshold 耗時達到此值的查詢以警告記錄，零值使用 DefaultSlowThreshold
+	SlowThreshold time.Duration
+	// Buckets 延遲直方圖的上界，須遞增，零值使用 DefaultBuckets
+	Buckets []time.Duration
+	// Classify 將 driver 錯誤轉成分類名稱，nil 時使用 DefaultClassify；
+	// 回傳空字串時改用 DefaultClassify 的結果
+	Classify func(err error) string
+}
+
+// Instrumenter 記錄 SQL 呼叫，可由多個 DAO 共用
+type Instrumenter struct {
+	options Options
+	logger  logger.Logger
+	tracer  tracer.Tracer
+	now     func() time.Time
+
+	mu sync.Mutex
+	// queries 每個查詢名稱的累計統計與延遲直方圖
+	queries map[string]*QueryStats
+}
+
+// New 創建 Instrumenter
+func New(log logger.Logger, tr tracer.Tracer, options Options) *Instrumenter {
+	if options.SlowThreshold <= 0 {
+		options.SlowThreshold = DefaultSlowThreshold
+	}
+	if len(options.Buckets) == 0 {
+		options.Buckets = DefaultBuckets
+	}
+	return &Instrumenter{
+		options: options,
+		logger:  log.With(logger.NewField("component", "sqlxinstrument")),
+		tracer:  tr,
+		now:     time.Now,
+		queries: make(map[string]*QueryStats),
+	}
+}
+
+// Observe 以子 span 包住 fn 並記錄這次查詢；fn 回傳讀取或影響的筆數，錯誤原樣回傳給呼叫端
+func (i *Instrumenter) Observe(ctx context.Context, name, query string, args []any, fn func(ctx context.Context) (int64, error)) error {
+	spanCtx, span := i.tracer.Start(ctx, "SQL."+name)
+	defer span.End()
+
+	start := i.now()
+	rows, err := fn(spanCtx)
+	duration := i.now().Sub(start)
+	class := i.classify(err)
+	slow := duration >= i.options.SlowThreshold
+	i.record(name, duration, class, slow)
+
+	fields := []logger.Field{
+		logger.NewField("query_name", name),
+		logger.NewField("sql", NormalizeSQL(query)),
+		logger.NewField("rows", rows),
+		logger.NewField("duration_ms", duration.Milliseconds()),
+	}
+	if class != "" {
+		fields = append(fields, logger.NewField("error_class", class))
+	}
+	log := i.logger.WithContext(spanCtx)
+	if slow {
+		fields = append(fields,
+			logger.NewField("args", RedactArgs(args)),
+			logger.NewField("slow_threshold_ms", i.options.SlowThreshold.Milliseconds()),
+		)
+		log.Warn("SQL 慢查詢", fields...)
+	} else {
+		log.Debug("SQL 執行完成", fields...)
+	}
+	return err
+}
+
+func (i *Instrumenter) classify(err error) string {
+	if err == nil {
+		return ""
+	}
+	if i.options.Classify != nil {
+		if class := i.options.Classify(err); class != "" {
+			return class
+		}
+	}
+	return DefaultClassify(err)
+}
+
+// DefaultClassify 只辨識 database/sql 與 context 的共通錯誤，其餘歸類為 ErrorClassOther
+func DefaultClassify(err error) string {
+	switch {
+	case err == nil:
+		return ""
+	case errors.Is(err, sql.ErrNoRows):
+		return ErrorClassNotFound
+	case errors.Is(err, context.DeadlineExceeded):
+		return ErrorClassTimeout
+	case errors.Is(err, context.Canceled):
+		return ErrorClassCanceled
+	case errors.Is(err, sql.ErrTxDone):
+		return ErrorClassTxDone
+	default:
+		return ErrorClassOther
+	}
+}
+
+// Wrap 包裝 executor，讓 DAO 以查詢名稱呼叫並自動記錄
+func (i *Instrumenter) Wrap(exec sqlxtx.Querier) Executor {
+	return Executor{exec: exec, inst: i}
+}
+
+// Executor 帶有查詢名稱的 sqlxtx.Querier 包裝
+type Executor struct {
+	exec sqlxtx.Querier
+	inst *Instrumenter
+}
+
+// GetContext 讀取單筆，成功時筆數為 1
+func (e Executor) GetContext(ctx context.Context, name string, dest any, query string, args ...any) error {
+	return e.inst.Observe(ctx, name, query, args, func(ctx context.Context) (int64, error) {
+		if err := e.exec.GetContext(ctx, dest, query, args...); err != nil {
+			return 0, err
+		}
+		return 1, nil
+	})
+}
+
+// SelectContext 讀取多筆到 slice 指標，筆數為 slice 長度
+func (e Executor) SelectContext(ctx context.Context, name string, dest any, query string, args ...any) error {
+	return e.inst.Observe(ctx, name, query, args, func(ctx context.Context) (int64, error) {
+		if err := e.exec.SelectContext(ctx, dest, query, args...); err != nil {
+			return 0, err
+		}
+		return sliceLen(dest), nil
+	})
+}
+
+// ExecContext 執行寫入，筆數為 RowsAffected；driver 不支援 RowsAffected 時記為 0
+func (e Executor) ExecContext(ctx context.Context, name string, query string, args ...any) (sql.Result, error) {
+	var result sql.Result
+	err := e.inst.Observe(ctx, name, query, args, func(ctx context.Context) (int64, error) {
+		var err error
+		result, err = e.exec.ExecContext(ctx, query, args...)
+		if err != nil {
+			return 0, err
+		}
+		rows, _ := result.RowsAffected()
+		return rows, nil
+	})
+	return result, err
+}
+
+func sliceLen(dest any) int64 {
+	v := reflect.ValueOf(dest)
+	for v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Slice {
+		return 0
+	}
+	return int64(v.Len())
+}
+
+var (
+	whitespacePattern    = regexp.MustCompile(`\s+`)
+	stringLiteralPattern = regexp.MustCompile(`'(?:[^']|'')*'`)
+	// numericLiteralPattern 前一個字元不能是識別字或 $，避免改到 members_2024 或 Postgres 的 $1
+	numericLiteralPattern = regexp.MustCompile(`(^|[^\w$.])\d+(?:\.\d+)?\b`)
+)
+
+// NormalizeSQL 合併空白並把字串與數字常值換成 ?，讓日誌中的 SQL 不含資料且便於彙整
+func NormalizeSQL(query string) string {
+	query = stringLiteralPattern.ReplaceAllString(query, "?")
+	query = numericLiteralPattern.ReplaceAllString(query, "${1}?")
+	return strings.TrimSpace(whitespacePattern.ReplaceAllString(query, " "))
+}
+
+// RedactArgs 綁定參數只保留型別，NULL 以 "NULL" 表示，避免 Email、密碼等資料寫進日誌
+func RedactArgs(args []any) []string {
+	redacted := make([]string, len(args))
+	for i, arg := range args {
+		if arg == nil {
+			redacted[i] = "NULL"
+			continue
+		}
+		redacted[i] = fmt.Sprintf("%T", arg)
+	}
+	return redacted
+}
@@ -0,0 +1,176 @@
+package sqlxinstrument
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/mcsqlite"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	mocklogger "github.com/tomoffice/go-clean-architecture/pkg/logger/mock"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer/adapters/basic"
+)
+
+// newTestInstrumenter 每次呼叫 now 前進 step，讓耗時可預期
+func newTestInstrumenter(t *testing.T, mockLogger *mocklogger.MockLogger, options Options, step time.Duration) *Instrumenter {
+	t.Helper()
+	mockLogger.EXPECT().With(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
+	inst := New(mockLogger, basic.NewTracer(basic.NewConfig("test", false)), options)
+	current := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	inst.now = func() time.Time {
+		current = current.Add(step)
+		return current
+	}
+	return inst
+}
+
+// fieldMap 把日誌欄位轉成 map 方便比對
+func fieldMap(fields []logger.Field) map[string]any {
+	m := make(map[string]any, len(fields))
+	for _, f := range fields {
+		m[f.Key] = f.Value
+	}
+	return m
+}
+
+func TestInstrumenter_Observe(t *testing.T) {
+	query := "SELECT * FROM members WHERE email = ? AND status = 'active'"
+	args := []any{"alice@example.com", nil, 3}
+
+	tests := []struct {
+		name      string
+		step      time.Duration
+		err       error
+		wantWarn  bool
+		wantClass string
+	}{
+		{name: "fast query logs debug", step: time.Millisecond},
+		{name: "slow query logs warning with redacted args", step: 300 * time.Millisecond, wantWarn: true},
+		{name: "error is classified", step: time.Millisecond, err: fmt.Errorf("get: %w", sql.ErrNoRows), wantClass: ErrorClassNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockLogger := mocklogger.NewMockLogger(ctrl)
+			inst := newTestInstrumenter(t, mockLogger, Options{}, tt.step)
+
+			var got map[string]any
+			capture := func(msg string, fields ...logger.Field) { got = fieldMap(fields) }
+			if tt.wantWarn {
+				mockLogger.EXPECT().Warn("SQL 慢查詢", gomock.Any()).Do(capture)
+			} else {
+				mockLogger.EXPECT().Debug("SQL 執行完成", gomock.Any()).Do(capture)
+			}
+
+			err := inst.Observe(context.Background(), "members.select_by_email", query, args, func(ctx context.Context) (int64, error) {
+				return 1, tt.err
+			})
+			assert.Equal(t, tt.err, err)
+
+			assert.Equal(t, "members.select_by_email", got["query_name"])
+			assert.Equal(t, "SELECT * FROM members WHERE email = ? AND status = ?", got["sql"])
+			assert.Equal(t, int64(1), got["rows"])
+			assert.Equal(t, tt.step.Milliseconds(), got["duration_ms"])
+			if tt.wantClass != "" {
+				assert.Equal(t, tt.wantClass, got["error_class"])
+			} else {
+				assert.NotContains(t, got, "error_class")
+			}
+			if tt.wantWarn {
+				assert.Equal(t, []string{"string", "NULL", "int"}, got["args"])
+				assert.Equal(t, DefaultSlowThreshold.Milliseconds(), got["slow_threshold_ms"])
+			} else {
+				assert.NotContains(t, got, "args")
+			}
+
+			stats := inst.Stats()["members.select_by_email"]
+			assert.Equal(t, int64(1), stats.Count)
+			assert.Equal(t, tt.step, stats.Max)
+			if tt.wantWarn {
+				assert.Equal(t, int64(1), stats.Slow)
+			}
+			if tt.wantClass != "" {
+				assert.Equal(t, map[string]int64{tt.wantClass: 1}, stats.Errors)
+			}
+		})
+	}
+}
+
+func TestInstrumenter_Classify(t *testing.T) {
+	errDuplicate := errors.New("duplicate")
+	classify := func(err error) string {
+		if errors.Is(err, errDuplicate) {
+			return "duplicate_key"
+		}
+		return ""
+	}
+	inst := newTestInstrumenter(t, mocklogger.NewMockLogger(gomock.NewController(t)), Options{Classify: classify}, time.Millisecond)
+
+	assert.Equal(t, "", inst.classify(nil))
+	assert.Equal(t, "duplicate_key", inst.classify(fmt.Errorf("insert: %w", errDuplicate)))
+	// 自訂分類無法辨識時改用預設分類
+	assert.Equal(t, ErrorClassTimeout, inst.classify(context.DeadlineExceeded))
+	assert.Equal(t, ErrorClassCanceled, inst.classify(context.Canceled))
+	assert.Equal(t, ErrorClassTxDone, inst.classify(sql.ErrTxDone))
+	assert.Equal(t, ErrorClassOther, inst.classify(errors.New("boom")))
+}
+
+func TestExecutor_Rows(t *testing.T) {
+	db, err := mcsqlite.NewDB(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	db.SetMaxOpenConns(1)
+	db.MustExec("CREATE TABLE items (id INTEGER PRIMARY KEY, name TEXT)")
+
+	ctrl := gomock.NewController(t)
+	mockLogger := mocklogger.NewMockLogger(ctrl)
+	inst := newTestInstrumenter(t, mockLogger, Options{}, time.Millisecond)
+	rows := map[string]any{}
+	mockLogger.EXPECT().Debug("SQL 執行完成", gomock.Any()).Do(func(msg string, fields ...logger.Field) {
+		f := fieldMap(fields)
+		rows[f["query_name"].(string)] = f["rows"]
+	}).AnyTimes()
+
+	ctx := context.Background()
+	exec := inst.Wrap(db)
+	_, err = exec.ExecContext(ctx, "items.insert", "INSERT INTO items (name) VALUES (?), (?)", "a", "b")
+	require.NoError(t, err)
+	var names []string
+	require.NoError(t, exec.SelectContext(ctx, "items.select", &names, "SELECT name FROM items ORDER BY id"))
+	assert.Equal(t, []string{"a", "b"}, names)
+	var name string
+	require.NoError(t, exec.GetContext(ctx, "items.get", &name, "SELECT name FROM items WHERE id = ?", 2))
+	assert.Equal(t, "b", name)
+	err = exec.GetContext(ctx, "items.get_missing", &name, "SELECT name FROM items WHERE id = ?", 9)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	assert.Equal(t, map[string]any{
+		"items.insert":      int64(2),
+		"items.select":      int64(2),
+		"items.get":         int64(1),
+		"items.get_missing": int64(0),
+	}, rows)
+	assert.Equal(t, map[string]int64{ErrorClassNotFound: 1}, inst.Stats()["items.get_missing"].Errors)
+}
+
+func TestNormalizeSQL(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{query: "SELECT * FROM members WHERE id = ?", want: "SELECT * FROM members WHERE id = ?"},
+		{query: "SELECT *\n  FROM members\n\tWHERE  name = 'O''Brien'", want: "SELECT * FROM members WHERE name = ?"},
+		{query: "SELECT * FROM members LIMIT 10 OFFSET 2.5", want: "SELECT * FROM members LIMIT ? OFFSET ?"},
+		{query: "SELECT * FROM members_2024 WHERE id = $1", want: "SELECT * FROM members_2024 WHERE id = $1"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, NormalizeSQL(tt.query))
+	}
+}
@@ -0,0 +1,370 @@
+// Package sqlxmigrate 內建的資料庫遷移引擎，取代外部的 migrate CLI。
+// 已套用的版本記錄在 schema_migrations，每個版本在各自的交易中執行，
+// 並以 up 腳本的 checksum 偵測已套用的遷移被修改。
+package sqlxmigrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxtx"
+	"io/fs"
+	"sort"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrChecksumMismatch 已套用的遷移腳本被修改，需還原腳本或以 Force 重新記錄
+	ErrChecksumMismatch = errors.New("sqlxmigrate: applied migration has been modified")
+	// ErrMissingMigration 資料庫記錄已套用的版本在腳本中找不到
+	ErrMissingMigration = errors.New("sqlxmigrate: applied migration not found in source")
+	// ErrUnknownVersion 指定的版本不存在
+	ErrUnknownVersion = errors.New("sqlxmigrate: unknown migration version")
+	// ErrIrreversible 需要回滾的版本沒有 down 腳本
+	ErrIrreversible = errors.New("sqlxmigrate: migration has no down script")
+	// ErrDirty golang-migrate 留下的 schema_migrations 標記為 dirty，需先以 Force 指定實際版本
+	ErrDirty = errors.New("sqlxmigrate: legacy schema_migrations is dirty")
+)
+
+const createTableSQL = `CREATE TABLE IF NOT EXISTS schema_migrations
+(
+    version    BIGINT PRIMARY KEY,
+    name       TEXT      NOT NULL,
+    checksum   TEXT      NOT NULL,
+    applied_at TIMESTAMP NOT NULL
+)`
+
+// Direction 遷移方向
+type Direction string
+
+const (
+	DirectionUp   Direction = "up"
+	DirectionDown Direction = "down"
+)
+
+// Step 實際執行的一個遷移
+type Step struct {
+	Migration Migration
+	Direction Direction
+}
+
+// Status 單一版本的狀態
+type Status struct {
+	Version   uint64
+	Name      string
+	Applied   bool
+	AppliedAt time.Time
+	// Modified 套用後 up 腳本被修改
+	Modified bool
+	// Missing 資料庫記錄已套用，但腳本中已沒有這個版本
+	Missing bool
+}
+
+type appliedRecord struct {
+	Version   uint64    `db:"version"`
+	Name      string    `db:"name"`
+	Checksum  string    `db:"checksum"`
+	AppliedAt time.Time `db:"applied_at"`
+}
+
+// Migrator 對單一資料庫執行遷移
+type Migrator struct {
+	db         *sqlx.DB
+	txManager  *sqlxtx.TxManager
+	migrations []Migration
+	now        func() time.Time
+}
+
+// New 讀取 source 的遷移腳本並創建 Migrator
+func New(db *sqlx.DB, source fs.FS) (*Migrator, error) {
+	migrations, err := LoadMigrations(source)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{
+		db:         db,
+		txManager:  sqlxtx.NewTxManager(db),
+		migrations: migrations,
+		now:        time.Now,
+	}, nil
+}
+
+// Migrations 回傳依版本排序的所有遷移
+func (m *Migrator) Migrations() []Migration {
+	return append([]Migration(nil), m.migrations...)
+}
+
+// Up 套用所有尚未套用的版本
+func (m *Migrator) Up(ctx context.Context) ([]Step, error) {
+	if len(m.migrations) == 0 {
+		return nil, nil
+	}
+	return m.To(ctx, m.migrations[len(m.migrations)-1].Version)
+}
+
+// Down 依版本由新到舊回滾 steps 個已套用的版本
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Step, error) {
+	if steps < 1 {
+		return nil, fmt.Errorf("sqlxmigrate: rollback steps must be positive, got %d", steps)
+	}
+	applied, err := m.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	versions := appliedVersions(applied)
+	if steps > len(versions) {
+		steps = len(versions)
+	}
+	plan := make([]Step, 0, steps)
+	for i := len(versions) - 1; i >= len(versions)-steps; i-- {
+		plan = append(plan, Step{Migration: m.find(versions[i]), Direction: DirectionDown})
+	}
+	return m.execute(ctx, plan)
+}
+
+// To 遷移到指定版本：回滾比 version 新的版本，再套用不超過 version 的未套用版本；version 為 0 時全部回滾
+func (m *Migrator) To(ctx context.Context, version uint64) ([]Step, error) {
+	if version != 0 && m.find(version).Version == 0 {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownVersion, version)
+	}
+	applied, err := m.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var plan []Step
+	versions := appliedVersions(applied)
+	for i := len(versions) - 1; i >= 0 && versions[i] > version; i-- {
+		plan = append(plan, Step{Migration: m.find(versions[i]), Direction: DirectionDown})
+	}
+	for _, migration := range m.migrations {
+		if _, ok := applied[migration.Version]; !ok && migration.Version <= version {
+			plan = append(plan, Step{Migration: migration, Direction: DirectionUp})
+		}
+	}
+	return m.execute(ctx, plan)
+}
+
+// Force 不執行腳本，直接把 schema_migrations 改寫為「不超過 version 的版本皆已套用」，並以目前的腳本重新計算 checksum；
+// 用於修正 golang-migrate 的 dirty 狀態、接受修改過的腳本，或對齊手動調整過的 schema
+func (m *Migrator) Force(ctx context.Context, version uint64) error {
+	if version != 0 && m.find(version).Version == 0 {
+		return fmt.Errorf("%w: %d", ErrUnknownVersion, version)
+	}
+	if _, _, isLegacy := m.legacyVersion(ctx); isLegacy {
+		if _, err := m.db.ExecContext(ctx, "DROP TABLE schema_migrations"); err != nil {
+			return fmt.Errorf("sqlxmigrate: drop legacy schema_migrations: %w", err)
+		}
+	}
+	if _, err := m.db.ExecContext(ctx, createTableSQL); err != nil {
+		return fmt.Errorf("sqlxmigrate: create schema_migrations: %w", err)
+	}
+	return m.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		executor := sqlxtx.ExecutorFromContext(ctx, m.db)
+		if _, err := executor.ExecContext(ctx, "DELETE FROM schema_migrations"); err != nil {
+			return fmt.Errorf("sqlxmigrate: clear schema_migrations: %w", err)
+		}
+		return m.record(ctx, executor, version)
+	})
+}
+
+// Status 回傳每個版本的狀態，包含腳本中已不存在但資料庫記錄已套用的版本
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	applied, err := m.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := Status{Version: migration.Version, Name: migration.Name}
+		if record, ok := applied[migration.Version]; ok {
+			status.Applied = true
+			status.AppliedAt = record.AppliedAt
+			status.Modified = record.Checksum != migration.Checksum
+		}
+		statuses = append(statuses, status)
+	}
+	for version, record := range applied {
+		if m.find(version).Version == 0 {
+			statuses = append(statuses, Status{Version: version, Name: record.Name, Applied: true, AppliedAt: record.AppliedAt, Missing: true})
+		}
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Version < statuses[j].Version
+	})
+	return statuses, nil
+}
+
+// Pending 回傳尚未套用的版本；已套用的腳本被修改或找不到時回傳錯誤
+func (m *Migrator) Pending(ctx context.Context) ([]Migration, error) {
+	applied, err := m.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var pending []Migration
+	for _, migration := range m.migrations {
+		if _, ok := applied[migration.Version]; !ok {
+			pending = append(pending, migration)
+		}
+	}
+	return pending, nil
+}
+
+// prepare 載入已套用的版本，並確認都與目前的腳本一致
+func (m *Migrator) prepare(ctx context.Context) (map[uint64]appliedRecord, error) {
+	applied, err := m.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, version := range appliedVersions(applied) {
+		migration := m.find(version)
+		if migration.Version == 0 {
+			return nil, fmt.Errorf("%w: %d_%s", ErrMissingMigration, version, applied[version].Name)
+		}
+		if migration.Checksum != applied[version].Checksum {
+			return nil, fmt.Errorf("%w: %d_%s", ErrChecksumMismatch, version, migration.Name)
+		}
+	}
+	return applied, nil
+}
+
+// load 建立 schema_migrations（必要時轉換 golang-migrate 的格式）並讀出已套用的版本
+func (m *Migrator) load(ctx context.Context) (map[uint64]appliedRecord, error) {
+	if err := m.ensureTable(ctx); err != nil {
+		return nil, err
+	}
+	var records []appliedRecord
+	if err := m.db.SelectContext(ctx, &records, "SELECT version, name, checksum, applied_at FROM schema_migrations"); err != nil {
+		return nil, fmt.Errorf("sqlxmigrate: load schema_migrations: %w", err)
+	}
+	applied := make(map[uint64]appliedRecord, len(records))
+	for _, record := range records {
+		applied[record.Version] = record
+	}
+	return applied, nil
+}
+
+// ensureTable 建立 schema_migrations；若是 golang-migrate 留下的 (version, dirty) 格式，
+// 在同一個交易中換成新格式，並把不超過該版本的腳本記錄為已套用
+func (m *Migrator) ensureTable(ctx context.Context) error {
+	legacy, dirty, isLegacy := m.legacyVersion(ctx)
+	if !isLegacy {
+		if _, err := m.db.ExecContext(ctx, createTableSQL); err != nil {
+			return fmt.Errorf("sqlxmigrate: create schema_migrations: %w", err)
+		}
+		return nil
+	}
+	if dirty {
+		return fmt.Errorf("%w: version %d", ErrDirty, legacy)
+	}
+	if legacy != 0 && m.find(legacy).Version == 0 {
+		return fmt.Errorf("%w: legacy version %d", ErrMissingMigration, legacy)
+	}
+	return m.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		executor := sqlxtx.ExecutorFromContext(ctx, m.db)
+		if _, err := executor.ExecContext(ctx, "DROP TABLE schema_migrations"); err != nil {
+			return fmt.Errorf("sqlxmigrate: drop legacy schema_migrations: %w", err)
+		}
+		if _, err := executor.ExecContext(ctx, createTableSQL); err != nil {
+			return fmt.Errorf("sqlxmigrate: create schema_migrations: %w", err)
+		}
+		return m.record(ctx, executor, legacy)
+	})
+}
+
+// legacyVersion 讀取 golang-migrate 格式的 schema_migrations；資料表不存在或已是新格式時 isLegacy 為 false
+func (m *Migrator) legacyVersion(ctx context.Context) (version uint64, dirty bool, isLegacy bool) {
+	err := m.db.QueryRowxContext(ctx, "SELECT version, dirty FROM schema_migrations").Scan(&version, &dirty)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, true
+	}
+	return version, dirty, err == nil
+}
+
+// record 把不超過 version 的腳本記錄為已套用
+func (m *Migrator) record(ctx context.Context, executor sqlxtx.Executor, version uint64) error {
+	appliedAt := m.now().UTC()
+	for _, migration := range m.migrations {
+		if migration.Version > version {
+			break
+		}
+		if err := m.insert(ctx, executor, migration, appliedAt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Migrator) insert(ctx context.Context, executor sqlxtx.Executor, migration Migration, appliedAt time.Time) error {
+	query := m.db.Rebind("INSERT INTO schema_migrations (version, name, checksum, applied_at) VALUES (?, ?, ?, ?)")
+	if _, err := executor.ExecContext(ctx, query, migration.Version, migration.Name, migration.Checksum, appliedAt); err != nil {
+		return fmt.Errorf("sqlxmigrate: record version %d: %w", migration.Version, err)
+	}
+	return nil
+}
+
+// execute 依序執行，每個版本一個交易；失敗時停在該版本，之前完成的版本維持已提交
+func (m *Migrator) execute(ctx context.Context, plan []Step) ([]Step, error) {
+	// 先確認所有回滾都有 down 腳本，避免執行到一半才停下
+	for _, step := range plan {
+		if step.Direction == DirectionDown && !step.Migration.Reversible {
+			return nil, fmt.Errorf("%w: %d_%s", ErrIrreversible, step.Migration.Version, step.Migration.Name)
+		}
+	}
+	done := make([]Step, 0, len(plan))
+	for _, step := range plan {
+		if err := m.run(ctx, step); err != nil {
+			return done, err
+		}
+		done = append(done, step)
+	}
+	return done, nil
+}
+
+func (m *Migrator) run(ctx context.Context, step Step) error {
+	migration := step.Migration
+	return m.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		executor := sqlxtx.ExecutorFromContext(ctx, m.db)
+		script := migration.Up
+		if step.Direction == DirectionDown {
+			script = migration.Down
+		}
+		// 空白腳本只更新 schema_migrations
+		if strings.TrimSpace(script) != "" {
+			if _, err := executor.ExecContext(ctx, script); err != nil {
+				return fmt.Errorf("sqlxmigrate: %s %d_%s: %w", step.Direction, migration.Version, migration.Name, err)
+			}
+		}
+		if step.Direction == DirectionUp {
+			return m.insert(ctx, executor, migration, m.now().UTC())
+		}
+		if _, err := executor.ExecContext(ctx, m.db.Rebind("DELETE FROM schema_migrations WHERE version = ?"), migration.Version); err != nil {
+			return fmt.Errorf("sqlxmigrate: remove version %d: %w", migration.Version, err)
+		}
+		return nil
+	})
+}
+
+// find 依版本找出腳本，找不到時回傳零值
+func (m *Migrator) find(version uint64) Migration {
+	i := sort.Search(len(m.migrations), func(i int) bool {
+		return m.migrations[i].Version >= version
+	})
+	if i < len(m.migrations) && m.migrations[i].Version == version {
+		return m.migrations[i]
+	}
+	return Migration{}
+}
+
+func appliedVersions(applied map[uint64]appliedRecord) []uint64 {
+	versions := make([]uint64, 0, len(applied))
+	for version := range applied {
+		versions = append(versions, version)
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i] < versions[j]
+	})
+	return versions
+}
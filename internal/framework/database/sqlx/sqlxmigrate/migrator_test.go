@@ -0,0 +1,285 @@
+package sqlxmigrate
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/mcsqlite"
+	"github.com/tomoffice/go-clean-architecture/migrations"
+	"github.com/tomoffice/go-clean-architecture/seed"
+)
+
+func newTestDB(t *testing.T) *sqlx.DB {
+	t.Helper()
+	db, err := mcsqlite.NewDB(filepath.Join(t.TempDir(), "test.sqlite"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	return db
+}
+
+func testSource() fstest.MapFS {
+	return fstest.MapFS{
+		"000001_create_a.up.sql":   {Data: []byte("CREATE TABLE a (id INTEGER PRIMARY KEY);")},
+		"000001_create_a.down.sql": {Data: []byte("DROP TABLE a;")},
+		"000002_create_b.up.sql":   {Data: []byte("CREATE TABLE b (id INTEGER PRIMARY KEY); INSERT INTO b (id) VALUES (1);")},
+		"000002_create_b.down.sql": {Data: []byte("DROP TABLE b;")},
+		"000003_create_c.up.sql":   {Data: []byte("CREATE TABLE c (id INTEGER PRIMARY KEY);")},
+		"000003_create_c.down.sql": {Data: []byte("DROP TABLE c;")},
+		"README.md":                {Data: []byte("ignored")},
+	}
+}
+
+func stepVersions(steps []Step) []uint64 {
+	versions := make([]uint64, 0, len(steps))
+	for _, step := range steps {
+		versions = append(versions, step.Migration.Version)
+	}
+	return versions
+}
+
+func tableExists(t *testing.T, db *sqlx.DB, name string) bool {
+	t.Helper()
+	var count int
+	require.NoError(t, db.Get(&count, "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?", name))
+	return count == 1
+}
+
+func TestLoadMigrations(t *testing.T) {
+	tests := []struct {
+		name         string
+		source       fstest.MapFS
+		wantVersions []uint64
+		wantErr      bool
+	}{
+		{name: "sorted by version", source: testSource(), wantVersions: []uint64{1, 2, 3}},
+		{name: "down is optional", source: fstest.MapFS{"10_x.up.sql": {Data: []byte("SELECT 1;")}}, wantVersions: []uint64{10}},
+		{name: "empty", source: fstest.MapFS{}, wantVersions: []uint64{}},
+		{name: "missing up", source: fstest.MapFS{"000001_x.down.sql": {Data: []byte("SELECT 1;")}}, wantErr: true},
+		{name: "conflicting names", source: fstest.MapFS{"000001_x.up.sql": {}, "000001_y.down.sql": {}}, wantErr: true},
+		{name: "zero version", source: fstest.MapFS{"000000_x.up.sql": {}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LoadMigrations(tt.source)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			versions := make([]uint64, 0, len(got))
+			for _, migration := range got {
+				versions = append(versions, migration.Version)
+			}
+			assert.Equal(t, tt.wantVersions, versions)
+		})
+	}
+}
+
+func TestMigrator_UpDownTo(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	migrator, err := New(db, testSource())
+	require.NoError(t, err)
+
+	steps, err := migrator.To(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1, 2}, stepVersions(steps))
+	pending, err := migrator.Pending(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, uint64(3), pending[0].Version)
+
+	steps, err = migrator.Up(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{3}, stepVersions(steps))
+	steps, err = migrator.Up(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, steps)
+
+	steps, err = migrator.Down(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{3, 2}, stepVersions(steps))
+	assert.Equal(t, DirectionDown, steps[0].Direction)
+	assert.True(t, tableExists(t, db, "a"))
+	assert.False(t, tableExists(t, db, "b"))
+
+	_, err = migrator.Down(ctx, 0)
+	assert.Error(t, err)
+	_, err = migrator.To(ctx, 7)
+	assert.ErrorIs(t, err, ErrUnknownVersion)
+
+	steps, err = migrator.To(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1}, stepVersions(steps))
+	statuses, err := migrator.Status(ctx)
+	require.NoError(t, err)
+	require.Len(t, statuses, 3)
+	for _, status := range statuses {
+		assert.False(t, status.Applied)
+	}
+}
+
+func TestMigrator_FailedMigrationRollsBack(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	source := testSource()
+	source["000002_create_b.up.sql"] = &fstest.MapFile{Data: []byte("CREATE TABLE b (id INTEGER PRIMARY KEY); INSERT INTO missing VALUES (1);")}
+	migrator, err := New(db, source)
+	require.NoError(t, err)
+
+	steps, err := migrator.Up(ctx)
+	assert.Error(t, err)
+	assert.Equal(t, []uint64{1}, stepVersions(steps))
+	// 失敗的版本整個回滾，不會留下一半的 schema
+	assert.False(t, tableExists(t, db, "b"))
+	statuses, err := migrator.Status(ctx)
+	require.NoError(t, err)
+	assert.True(t, statuses[0].Applied)
+	assert.False(t, statuses[1].Applied)
+}
+
+func TestMigrator_ChecksumAndForce(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	migrator, err := New(db, testSource())
+	require.NoError(t, err)
+	_, err = migrator.To(ctx, 2)
+	require.NoError(t, err)
+
+	modified := testSource()
+	modified["000001_create_a.up.sql"] = &fstest.MapFile{Data: []byte("CREATE TABLE a (id INTEGER PRIMARY KEY, name TEXT);")}
+	migrator, err = New(db, modified)
+	require.NoError(t, err)
+	_, err = migrator.Up(ctx)
+	assert.ErrorIs(t, err, ErrChecksumMismatch)
+	_, err = migrator.Pending(ctx)
+	assert.ErrorIs(t, err, ErrChecksumMismatch)
+	statuses, err := migrator.Status(ctx)
+	require.NoError(t, err)
+	assert.True(t, statuses[0].Modified)
+	assert.False(t, statuses[1].Modified)
+
+	require.NoError(t, migrator.Force(ctx, 2))
+	steps, err := migrator.Up(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{3}, stepVersions(steps))
+	assert.ErrorIs(t, migrator.Force(ctx, 9), ErrUnknownVersion)
+
+	// 腳本中移除已套用的版本
+	removed := modified
+	delete(removed, "000003_create_c.up.sql")
+	delete(removed, "000003_create_c.down.sql")
+	migrator, err = New(db, removed)
+	require.NoError(t, err)
+	_, err = migrator.Up(ctx)
+	assert.ErrorIs(t, err, ErrMissingMigration)
+	statuses, err = migrator.Status(ctx)
+	require.NoError(t, err)
+	require.Len(t, statuses, 3)
+	assert.True(t, statuses[2].Missing)
+}
+
+func TestMigrator_Irreversible(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	source := testSource()
+	delete(source, "000002_create_b.down.sql")
+	migrator, err := New(db, source)
+	require.NoError(t, err)
+	_, err = migrator.Up(ctx)
+	require.NoError(t, err)
+
+	// 回滾前先檢查，不會先回滾 3 再停在 2
+	steps, err := migrator.To(ctx, 1)
+	assert.ErrorIs(t, err, ErrIrreversible)
+	assert.Empty(t, steps)
+	assert.True(t, tableExists(t, db, "c"))
+}
+
+func TestMigrator_AdoptLegacy(t *testing.T) {
+	tests := []struct {
+		name        string
+		legacy      string
+		wantErr     error
+		wantApplied []bool
+	}{
+		{name: "clean", legacy: "INSERT INTO schema_migrations (version, dirty) VALUES (2, 0)", wantApplied: []bool{true, true, false}},
+		{name: "no version", legacy: "", wantApplied: []bool{false, false, false}},
+		{name: "dirty", legacy: "INSERT INTO schema_migrations (version, dirty) VALUES (2, 1)", wantErr: ErrDirty},
+		{name: "unknown version", legacy: "INSERT INTO schema_migrations (version, dirty) VALUES (8, 0)", wantErr: ErrMissingMigration},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			db := newTestDB(t)
+			db.MustExec("CREATE TABLE schema_migrations (version uint64, dirty bool)")
+			if tt.legacy != "" {
+				db.MustExec(tt.legacy)
+			}
+			migrator, err := New(db, testSource())
+			require.NoError(t, err)
+
+			statuses, err := migrator.Status(ctx)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				// Force 可直接取代 golang-migrate 的紀錄
+				require.NoError(t, migrator.Force(ctx, 1))
+				statuses, err = migrator.Status(ctx)
+				require.NoError(t, err)
+				assert.True(t, statuses[0].Applied)
+				return
+			}
+			require.NoError(t, err)
+			applied := make([]bool, 0, len(statuses))
+			for _, status := range statuses {
+				applied = append(applied, status.Applied)
+			}
+			assert.Equal(t, tt.wantApplied, applied)
+		})
+	}
+}
+
+// TestEmbeddedSQLiteMigrations 內嵌的 SQLite 腳本可完整套用、回滾後再套用，並能載入種子資料
+func TestEmbeddedSQLiteMigrations(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	migrator, err := New(db, migrations.SQLite())
+	require.NoError(t, err)
+
+	steps, err := migrator.Up(ctx)
+	require.NoError(t, err)
+	assert.Len(t, steps, len(migrator.Migrations()))
+	seeded, err := Seed(ctx, db, seed.SQLite())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"001_seed_members.sql"}, seeded)
+	// 種子資料可重複執行
+	_, err = Seed(ctx, db, seed.SQLite())
+	require.NoError(t, err)
+	var count int
+	require.NoError(t, db.Get(&count, "SELECT COUNT(*) FROM members"))
+	assert.Equal(t, 15, count)
+
+	_, err = migrator.To(ctx, 0)
+	require.NoError(t, err)
+	assert.False(t, tableExists(t, db, "members"))
+	_, err = migrator.Up(ctx)
+	require.NoError(t, err)
+}
+
+func TestEmbeddedPostgresMigrations(t *testing.T) {
+	sqliteMigrations, err := LoadMigrations(migrations.SQLite())
+	require.NoError(t, err)
+	postgresMigrations, err := LoadMigrations(migrations.Postgres())
+	require.NoError(t, err)
+	require.Len(t, postgresMigrations, len(sqliteMigrations))
+	for i := range postgresMigrations {
+		assert.Equal(t, sqliteMigrations[i].Version, postgresMigrations[i].Version)
+		assert.Equal(t, sqliteMigrations[i].Name, postgresMigrations[i].Name)
+		assert.True(t, postgresMigrations[i].Reversible)
+	}
+}
@@ -0,0 +1,37 @@
+package sqlxmigrate
+
+import (
+	"context"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxtx"
+	"io/fs"
+	"sort"
+)
+
+// Seed 依檔名順序執行 source 根目錄下的 .sql 種子資料，每個檔案一個交易，回傳已執行的檔名；
+// 種子資料不記錄在 schema_migrations，重複執行的安全性由腳本自行處理（例如 INSERT OR IGNORE）
+func Seed(ctx context.Context, db *sqlx.DB, source fs.FS) ([]string, error) {
+	names, err := fs.Glob(source, "*.sql")
+	if err != nil {
+		return nil, fmt.Errorf("sqlxmigrate: list seeds: %w", err)
+	}
+	sort.Strings(names)
+	txManager := sqlxtx.NewTxManager(db)
+	done := make([]string, 0, len(names))
+	for _, name := range names {
+		script, err := fs.ReadFile(source, name)
+		if err != nil {
+			return done, fmt.Errorf("sqlxmigrate: read seed %s: %w", name, err)
+		}
+		err = txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+			_, err := sqlxtx.ExecutorFromContext(ctx, db).ExecContext(ctx, string(script))
+			return err
+		})
+		if err != nil {
+			return done, fmt.Errorf("sqlxmigrate: seed %s: %w", name, err)
+		}
+		done = append(done, name)
+	}
+	return done, nil
+}
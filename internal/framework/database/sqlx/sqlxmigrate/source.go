@@ -0,0 +1,86 @@
+package sqlxmigrate
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+// migrationFilePattern 與 golang-migrate 相同的檔名格式：<版本>_<名稱>.<up|down>.sql
+var migrationFilePattern = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+// Migration 一個版本的遷移腳本
+type Migration struct {
+	Version uint64
+	Name    string
+	Up      string
+	Down    string
+	// Reversible 是否有 down 腳本；空白的 down 腳本視為可回滾但不需執行任何語句
+	Reversible bool
+	// Checksum up 腳本的 SHA-256，套用時寫入 schema_migrations，用來偵測已套用的腳本被修改
+	Checksum string
+}
+
+// LoadMigrations 讀取 source 根目錄下的遷移腳本並依版本排序
+//   - 每個版本都必須有 up 腳本；down 腳本可省略，省略時該版本無法回滾，空白的 down 腳本則回滾時不執行任何語句
+//   - 不符合檔名格式的檔案忽略
+func LoadMigrations(source fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(source, ".")
+	if err != nil {
+		return nil, fmt.Errorf("sqlxmigrate: read migrations: %w", err)
+	}
+	byVersion := make(map[uint64]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		match := migrationFilePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+		version, err := strconv.ParseUint(match[1], 10, 64)
+		if err != nil || version == 0 {
+			return nil, fmt.Errorf("sqlxmigrate: invalid migration version in %s", entry.Name())
+		}
+		data, err := fs.ReadFile(source, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("sqlxmigrate: read %s: %w", entry.Name(), err)
+		}
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != match[2] {
+			return nil, fmt.Errorf("sqlxmigrate: version %d has conflicting names %q and %q", version, migration.Name, match[2])
+		}
+		if match[3] == "up" {
+			migration.Up = string(data)
+			migration.Checksum = checksum(data)
+		} else {
+			migration.Down = string(data)
+			migration.Reversible = true
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Checksum == "" {
+			return nil, fmt.Errorf("sqlxmigrate: version %d (%s) has no up migration", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,205 @@
+// Package sqlxreplica 提供 primary/replica 讀寫分流的資料庫 handle。
+// 寫入一律走 primary；讀取輪流分給健康的 replica，但在交易中、或同一個 actor
+// 剛寫入後的 read-your-writes 視窗內改走 primary，避免讀到尚未同步的資料。
+//
+// read-your-writes 只對通過認證的 actor 生效：未認證的呼叫端共用 anonymous，
+// 沒有可區分單一用戶端的身分，若以它記錄寫入，任何一筆匿名寫入（例如註冊）
+// 都會讓所有匿名讀取在視窗內改走 primary。匿名呼叫端的讀取因此一律照常分流，
+// 可能讀到尚未同步的資料。
+package sqlxreplica
+
+import (
+	"context"
+	"github.com/jmoiron/sqlx"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxtx"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/requestmeta"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Options 讀寫分流設定，零值欄位沿用 DefaultOptions
+type Options struct {
+	// ReadYourWritesWindow 同一個已認證的 actor 寫入後，這段時間內的讀取都走 primary
+	ReadYourWritesWindow time.Duration
+	// HealthCheckInterval replica 健康檢查的間隔
+	HealthCheckInterval time.Duration
+	// HealthCheckTimeout 單次 ping 的逾時
+	HealthCheckTimeout time.Duration
+}
+
+// DefaultOptions 預設讀寫分流設定
+func DefaultOptions() Options {
+	return Options{
+		ReadYourWritesWindow: 5 * time.Second,
+		HealthCheckInterval:  5 * time.Second,
+		HealthCheckTimeout:   time.Second,
+	}
+}
+
+// Replica 一個唯讀連線；Name 只用於日誌，不應包含密碼
+type Replica struct {
+	Name string
+	DB   *sqlx.DB
+}
+
+type replica struct {
+	Replica
+	healthy atomic.Bool
+}
+
+// Router 依 context 選擇 primary 或 replica
+type Router struct {
+	primary  *sqlx.DB
+	replicas []*replica
+	next     atomic.Uint64
+	options  Options
+	logger   logger.Logger
+	now      func() time.Time
+
+	mu sync.Mutex
+	// lastWrite 已認證 actor 最近一次寫入的時間，不含 anonymous
+	lastWrite map[string]time.Time
+}
+
+// NewRouter 創建讀寫分流 handle；沒有 replica 時所有操作都走 primary，replica 一開始視為健康
+func NewRouter(primary *sqlx.DB, replicas []Replica, options Options, log logger.Logger) *Router {
+	defaults := DefaultOptions()
+	if options.ReadYourWritesWindow <= 0 {
+		options.ReadYourWritesWindow = defaults.ReadYourWritesWindow
+	}
+	if options.HealthCheckInterval <= 0 {
+		options.HealthCheckInterval = defaults.HealthCheckInterval
+	}
+	if options.HealthCheckTimeout <= 0 {
+		options.HealthCheckTimeout = defaults.HealthCheckTimeout
+	}
+	router := &Router{
+		primary:   primary,
+		options:   options,
+		logger:    log.With(logger.NewField("component", "sqlxreplica")),
+		now:       time.Now,
+		lastWrite: make(map[string]time.Time),
+	}
+	for _, r := range replicas {
+		rep := &replica{Replica: r}
+		rep.healthy.Store(true)
+		router.replicas = append(router.replicas, rep)
+	}
+	return router
+}
+
+// Primary 回傳 primary 連線
+func (r *Router) Primary() *sqlx.DB {
+	return r.primary
+}
+
+// Writer 回傳寫入用的 executor（ctx 中的交易或 primary），並記錄已認證 actor 的寫入時間
+func (r *Router) Writer(ctx context.Context) sqlxtx.Executor {
+	if actor, ok := writerKey(ctx); ok && len(r.replicas) > 0 {
+		r.mu.Lock()
+		r.lastWrite[actor] = r.now()
+		r.mu.Unlock()
+	}
+	return sqlxtx.ExecutorFromContext(ctx, r.primary)
+}
+
+// Reader 回傳讀取用的 executor
+//   - ctx 中有交易時使用該交易
+//   - 已認證的 actor 在 read-your-writes 視窗內寫入過，或沒有健康的 replica 時使用 primary
+//   - 其他情況輪流使用健康的 replica
+func (r *Router) Reader(ctx context.Context) sqlxtx.Executor {
+	if tx, ok := sqlxtx.TxFromContext(ctx); ok {
+		return tx
+	}
+	if len(r.replicas) == 0 || r.recentlyWrote(ctx) {
+		return r.primary
+	}
+	start := r.next.Add(1)
+	for i := range r.replicas {
+		rep := r.replicas[(start+uint64(i))%uint64(len(r.replicas))]
+		if rep.healthy.Load() {
+			return rep.DB
+		}
+	}
+	return r.primary
+}
+
+// Run 定期檢查 replica 健康狀態並清除過期的寫入紀錄，直到 ctx 取消
+func (r *Router) Run(ctx context.Context) {
+	if len(r.replicas) == 0 {
+		return
+	}
+	ticker := time.NewTicker(r.options.HealthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.checkReplicas(ctx)
+			r.pruneWrites()
+		}
+	}
+}
+
+// Close 關閉所有 replica 連線，primary 由呼叫端管理
+func (r *Router) Close() error {
+	var firstErr error
+	for _, rep := range r.replicas {
+		if err := rep.DB.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// checkReplicas ping 每個 replica，失敗時移出輪替，恢復後重新加入
+func (r *Router) checkReplicas(ctx context.Context) {
+	for _, rep := range r.replicas {
+		pingCtx, cancel := context.WithTimeout(ctx, r.options.HealthCheckTimeout)
+		err := rep.DB.PingContext(pingCtx)
+		cancel()
+		healthy := err == nil
+		if rep.healthy.Swap(healthy) == healthy {
+			continue
+		}
+		if healthy {
+			r.logger.Info("replica 恢復，重新加入讀取輪替", logger.NewField("replica", rep.Name))
+		} else {
+			r.logger.Warn("replica 健康檢查失敗，移出讀取輪替",
+				logger.NewField("replica", rep.Name),
+				logger.NewField("error", err),
+			)
+		}
+	}
+}
+
+func (r *Router) recentlyWrote(ctx context.Context) bool {
+	actor, ok := writerKey(ctx)
+	if !ok {
+		return false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	wroteAt, ok := r.lastWrite[actor]
+	return ok && r.now().Sub(wroteAt) < r.options.ReadYourWritesWindow
+}
+
+// writerKey 回傳記錄寫入時間用的 actor；anonymous 由所有未認證的呼叫端共用，不適用 read-your-writes
+func writerKey(ctx context.Context) (string, bool) {
+	actor := requestmeta.FromContext(ctx).Actor
+	return actor, actor != requestmeta.AnonymousActor
+}
+
+func (r *Router) pruneWrites() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := r.now()
+	for actor, wroteAt := range r.lastWrite {
+		if now.Sub(wroteAt) >= r.options.ReadYourWritesWindow {
+			delete(r.lastWrite, actor)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package sqlxreplica
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/mcsqlite"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxtx"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/requestmeta"
+	mocklogger "github.com/tomoffice/go-clean-architecture/pkg/logger/mock"
+)
+
+// newNamedDB 以獨立的 SQLite 檔案模擬一個節點，node 資料表記錄節點名稱
+func newNamedDB(t *testing.T, name string) *sqlx.DB {
+	t.Helper()
+	db, err := mcsqlite.NewDB(filepath.Join(t.TempDir(), name+".sqlite"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	db.MustExec("CREATE TABLE node (name TEXT)")
+	db.MustExec("INSERT INTO node (name) VALUES (?)", name)
+	return db
+}
+
+func newTestRouter(t *testing.T, replicas ...string) *Router {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockLogger := mocklogger.NewMockLogger(ctrl)
+	mockLogger.EXPECT().With(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+	nodes := make([]Replica, 0, len(replicas))
+	for _, name := range replicas {
+		nodes = append(nodes, Replica{Name: name, DB: newNamedDB(t, name)})
+	}
+	return NewRouter(newNamedDB(t, "primary"), nodes, Options{ReadYourWritesWindow: time.Minute}, mockLogger)
+}
+
+func nodeName(t *testing.T, ctx context.Context, executor sqlxtx.Executor) string {
+	t.Helper()
+	var name string
+	require.NoError(t, executor.GetContext(ctx, &name, "SELECT name FROM node"))
+	return name
+}
+
+func withActor(actor string) context.Context {
+	return requestmeta.WithMeta(context.Background(), requestmeta.Meta{Actor: actor})
+}
+
+func TestRouter_ReaderRoundRobin(t *testing.T) {
+	router := newTestRouter(t, "replica-1", "replica-2")
+	ctx := withActor("alice")
+
+	seen := map[string]int{}
+	for i := 0; i < 4; i++ {
+		seen[nodeName(t, ctx, router.Reader(ctx))]++
+	}
+	assert.Equal(t, map[string]int{"replica-1": 2, "replica-2": 2}, seen)
+	assert.Equal(t, "primary", nodeName(t, ctx, router.Writer(ctx)))
+}
+
+func TestRouter_WithoutReplicas(t *testing.T) {
+	router := newTestRouter(t)
+	ctx := withActor("alice")
+	assert.Equal(t, "primary", nodeName(t, ctx, router.Reader(ctx)))
+	router.Writer(ctx)
+	// 沒有 replica 時不需要記錄寫入
+	assert.Empty(t, router.lastWrite)
+}
+
+func TestRouter_ReadYourWrites(t *testing.T) {
+	router := newTestRouter(t, "replica")
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	router.now = func() time.Time { return now }
+	alice, bob := withActor("alice"), withActor("bob")
+
+	router.Writer(alice)
+	assert.Equal(t, "primary", nodeName(t, alice, router.Reader(alice)))
+	assert.Equal(t, "replica", nodeName(t, bob, router.Reader(bob)))
+
+	now = now.Add(59 * time.Second)
+	assert.Equal(t, "primary", nodeName(t, alice, router.Reader(alice)))
+	now = now.Add(time.Second)
+	assert.Equal(t, "replica", nodeName(t, alice, router.Reader(alice)))
+
+	router.pruneWrites()
+	assert.Empty(t, router.lastWrite)
+}
+
+func TestRouter_AnonymousWritesDoNotPinReads(t *testing.T) {
+	router := newTestRouter(t, "replica")
+	anonymous, alice := context.Background(), withActor("alice")
+
+	// 匿名寫入（例如註冊）不記錄寫入時間，其他匿名讀取與已認證的 actor 都照常讀 replica
+	assert.Equal(t, "primary", nodeName(t, anonymous, router.Writer(anonymous)))
+	assert.Empty(t, router.lastWrite)
+	assert.Equal(t, "replica", nodeName(t, anonymous, router.Reader(anonymous)))
+	assert.Equal(t, "replica", nodeName(t, alice, router.Reader(alice)))
+
+	explicit := withActor(requestmeta.AnonymousActor)
+	router.Writer(explicit)
+	assert.Empty(t, router.lastWrite)
+	assert.Equal(t, "replica", nodeName(t, explicit, router.Reader(explicit)))
+}
+
+func TestRouter_TransactionUsesPrimary(t *testing.T) {
+	router := newTestRouter(t, "replica")
+	err := sqlxtx.NewTxManager(router.Primary()).WithinTransaction(withActor("alice"), func(ctx context.Context) error {
+		_, isTx := router.Reader(ctx).(*sqlx.Tx)
+		assert.True(t, isTx)
+		assert.Equal(t, "primary", nodeName(t, ctx, router.Reader(ctx)))
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestRouter_HealthCheck(t *testing.T) {
+	router := newTestRouter(t, "replica-1", "replica-2")
+	ctx := withActor("alice")
+
+	// replica-1 故障後移出輪替，全部故障時改讀 primary
+	require.NoError(t, router.replicas[0].DB.Close())
+	router.checkReplicas(context.Background())
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, "replica-2", nodeName(t, ctx, router.Reader(ctx)))
+	}
+	require.NoError(t, router.replicas[1].DB.Close())
+	router.checkReplicas(context.Background())
+	assert.Equal(t, "primary", nodeName(t, ctx, router.Reader(ctx)))
+
+	// 恢復後重新加入
+	router.replicas[1].DB = newNamedDB(t, "replica-2")
+	router.checkReplicas(context.Background())
+	assert.Equal(t, "replica-2", nodeName(t, ctx, router.Reader(ctx)))
+}
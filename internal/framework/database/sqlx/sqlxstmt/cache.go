@@ -0,0 +1,297 @@
+// Package sqlxstmt 提供 prepared statement 快取：同一段 SQL 在每個連接池只準備一次，
+// 之後的呼叫直接重用。database/sql 的 *sql.Stmt 會在連接被回收、換到新連接時自動重新準備；
+// 在交易中則以 tx.Stmtx 把快取的 statement 綁到交易的連接上。
+package sqlxstmt
+
+import (
+	"container/list"
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxtx"
+	"sync"
+)
+
+// DefaultCapacity 未設定容量時快取的 statement 數量
+const DefaultCapacity = 64
+
+// Stats 快取統計
+type Stats struct {
+	Hits      int64
+	Misses    int64
+	Evictions int64
+	// Size 目前快取中的 statement 數量
+	Size int
+}
+
+type cacheKey struct {
+	pool  *sqlx.DB
+	query string
+}
+
+// entry 一個連接池上的一段 SQL；refs 為正在使用的呼叫數，被淘汰後等最後一個使用者釋放才關閉
+type entry struct {
+	key     cacheKey
+	stmt    *sqlx.Stmt
+	err     error
+	ready   chan struct{}
+	refs    int
+	evicted bool
+}
+
+// Cache 以 LRU 保存 prepared statement，可由多個 goroutine 共用
+type Cache struct {
+	capacity int
+
+	mu      sync.Mutex
+	entries map[cacheKey]*list.Element
+	lru     *list.List
+	stats   Stats
+}
+
+// New 創建 statement 快取，capacity 小於等於 0 時使用 DefaultCapacity
+func New(capacity int) *Cache {
+	if capacity <= 0 {
+		capacity = DefaultCapacity
+	}
+	return &Cache{
+		capacity: capacity,
+		entries:  make(map[cacheKey]*list.Element),
+		lru:      list.New(),
+	}
+}
+
+// acquire 取得 pool 上 query 的 statement 並增加引用，用完須呼叫 release；
+// 同一段 SQL 同時有多個呼叫時只準備一次，其餘等待結果
+func (c *Cache) acquire(ctx context.Context, pool *sqlx.DB, query string) (*entry, error) {
+	key := cacheKey{pool: pool, query: query}
+	c.mu.Lock()
+	if elem, ok := c.entries[key]; ok {
+		e := elem.Value.(*entry)
+		e.refs++
+		c.lru.MoveToFront(elem)
+		c.stats.Hits++
+		c.mu.Unlock()
+		select {
+		case <-e.ready:
+		case <-ctx.Done():
+			c.release(e)
+			return nil, ctx.Err()
+		}
+		if e.err != nil {
+			c.release(e)
+			return nil, e.err
+		}
+		return e, nil
+	}
+	e := &entry{key: key, ready: make(chan struct{}), refs: 1}
+	c.entries[key] = c.lru.PushFront(e)
+	c.stats.Misses++
+	evicted := c.evictLocked()
+	c.mu.Unlock()
+	closeAll(evicted)
+
+	// 準備不受單次呼叫的取消影響，其他等待中的呼叫仍會使用這個結果
+	e.stmt, e.err = pool.PreparexContext(context.WithoutCancel(ctx), query)
+	if e.err != nil {
+		e.err = fmt.Errorf("sqlxstmt: prepare: %w", e.err)
+		c.mu.Lock()
+		c.removeLocked(e)
+		c.mu.Unlock()
+	}
+	close(e.ready)
+	if e.err != nil {
+		c.release(e)
+		return nil, e.err
+	}
+	return e, nil
+}
+
+// lookup 只取已準備好的 statement 並增加引用，不會準備或等待
+func (c *Cache) lookup(pool *sqlx.DB, query string) (*entry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[cacheKey{pool: pool, query: query}]
+	if !ok {
+		return nil, false
+	}
+	e := elem.Value.(*entry)
+	select {
+	case <-e.ready:
+	default:
+		return nil, false
+	}
+	if e.err != nil {
+		return nil, false
+	}
+	e.refs++
+	c.lru.MoveToFront(elem)
+	c.stats.Hits++
+	return e, true
+}
+
+// warm 在背景準備 statement，失敗時不保留，下次使用時再試
+func (c *Cache) warm(pool *sqlx.DB, query string) {
+	if e, err := c.acquire(context.Background(), pool, query); err == nil {
+		c.release(e)
+	}
+}
+
+// release 減少引用，已被淘汰且沒有其他使用者時關閉 statement
+func (c *Cache) release(e *entry) {
+	c.mu.Lock()
+	e.refs--
+	closeNow := e.evicted && e.refs == 0
+	c.mu.Unlock()
+	if closeNow && e.stmt != nil {
+		_ = e.stmt.Close()
+	}
+}
+
+// evictLocked 超過容量時從最久未使用的一端淘汰，回傳可以立即關閉的 statement；
+// 正在使用中的延後到最後一個使用者釋放時才關閉
+func (c *Cache) evictLocked() []*sqlx.Stmt {
+	var idle []*sqlx.Stmt
+	for c.lru.Len() > c.capacity {
+		e := c.lru.Back().Value.(*entry)
+		c.removeLocked(e)
+		c.stats.Evictions++
+		if e.refs == 0 && e.stmt != nil {
+			idle = append(idle, e.stmt)
+		}
+	}
+	return idle
+}
+
+func (c *Cache) removeLocked(e *entry) {
+	if elem, ok := c.entries[e.key]; ok && elem.Value == e {
+		c.lru.Remove(elem)
+		delete(c.entries, e.key)
+	}
+	e.evicted = true
+}
+
+// Stats 回傳快取統計
+func (c *Cache) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stats := c.stats
+	stats.Size = c.lru.Len()
+	return stats
+}
+
+// Close 清空快取並關閉沒有在使用中的 statement，使用中的等釋放時關閉
+func (c *Cache) Close() error {
+	c.mu.Lock()
+	var idle []*sqlx.Stmt
+	for c.lru.Len() > 0 {
+		e := c.lru.Back().Value.(*entry)
+		c.removeLocked(e)
+		if e.refs == 0 && e.stmt != nil {
+			idle = append(idle, e.stmt)
+		}
+	}
+	c.mu.Unlock()
+	return closeAll(idle)
+}
+
+// closeAll 關閉所有 statement，回傳第一個錯誤
+func closeAll(stmts []*sqlx.Stmt) error {
+	var firstErr error
+	for _, stmt := range stmts {
+		if err := stmt.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// Wrap 以快取的 statement 執行 SQL
+//   - exec 為 *sqlx.DB 時使用該連接池上的 statement
+//   - exec 為 *sqlx.Tx 時以 tx.Stmtx 把 txPool（開啟交易的連接池）上的 statement 綁到交易；
+//     尚未準備時這次直接在交易中執行，並在背景準備
+//   - c 為 nil 或 exec 為其他實作時直接以 exec 執行，不使用 prepared statement
+func (c *Cache) Wrap(exec sqlxtx.Querier, txPool *sqlx.DB) Executor {
+	return Executor{cache: c, exec: exec, txPool: txPool}
+}
+
+// Executor 與 sqlxtx.Querier 相同的呼叫方式，改以 prepared statement 執行
+type Executor struct {
+	cache  *Cache
+	exec   sqlxtx.Querier
+	txPool *sqlx.DB
+}
+
+// GetContext 讀取單筆
+func (e Executor) GetContext(ctx context.Context, dest any, query string, args ...any) error {
+	stmt, done, err := e.stmt(ctx, query)
+	if err != nil {
+		return err
+	}
+	if stmt == nil {
+		return e.exec.GetContext(ctx, dest, query, args...)
+	}
+	defer done()
+	return stmt.GetContext(ctx, dest, args...)
+}
+
+// SelectContext 讀取多筆
+func (e Executor) SelectContext(ctx context.Context, dest any, query string, args ...any) error {
+	stmt, done, err := e.stmt(ctx, query)
+	if err != nil {
+		return err
+	}
+	if stmt == nil {
+		return e.exec.SelectContext(ctx, dest, query, args...)
+	}
+	defer done()
+	return stmt.SelectContext(ctx, dest, args...)
+}
+
+// ExecContext 執行寫入
+func (e Executor) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	stmt, done, err := e.stmt(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if stmt == nil {
+		return e.exec.ExecContext(ctx, query, args...)
+	}
+	defer done()
+	return stmt.ExecContext(ctx, args...)
+}
+
+// stmt 取得這次呼叫要用的 statement；回傳 nil 表示直接以 exec 執行
+func (e Executor) stmt(ctx context.Context, query string) (*sqlx.Stmt, func(), error) {
+	if e.cache == nil {
+		return nil, nil, nil
+	}
+	switch exec := e.exec.(type) {
+	case *sqlx.DB:
+		cached, err := e.cache.acquire(ctx, exec, query)
+		if err != nil {
+			return nil, nil, err
+		}
+		return cached.stmt, func() { e.cache.release(cached) }, nil
+	case *sqlx.Tx:
+		if e.txPool == nil {
+			return nil, nil, nil
+		}
+		cached, ok := e.cache.lookup(e.txPool, query)
+		if !ok {
+			// 在連接池上準備需要另一個連接，交易持有連接時同步等待可能卡住（例如連接池只有一個連接），
+			// 這次直接在交易中執行，並在背景準備供之後的呼叫使用
+			go e.cache.warm(e.txPool, query)
+			return nil, nil, nil
+		}
+		// 交易用的 statement 關閉時不影響快取中的 statement，交易結束時也會自動關閉
+		txStmt := exec.StmtxContext(ctx, cached.stmt)
+		return txStmt, func() {
+			_ = txStmt.Close()
+			e.cache.release(cached)
+		}, nil
+	default:
+		return nil, nil, nil
+	}
+}
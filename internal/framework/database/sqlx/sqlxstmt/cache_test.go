@@ -0,0 +1,201 @@
+package sqlxstmt
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/mcsqlite"
+)
+
+// newTestDB 以檔案建立 SQLite，讓不同連接看到同一份資料
+func newTestDB(t *testing.T) *sqlx.DB {
+	t.Helper()
+	db, err := mcsqlite.NewDB(filepath.Join(t.TempDir(), "test.sqlite"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	db.MustExec("CREATE TABLE items (id INTEGER PRIMARY KEY, name TEXT NOT NULL)")
+	db.MustExec("INSERT INTO items (name) VALUES ('a'), ('b'), ('c')")
+	return db
+}
+
+func TestCache_PreparesOncePerPool(t *testing.T) {
+	db := newTestDB(t)
+	other := newTestDB(t)
+	cache := New(0)
+	t.Cleanup(func() { _ = cache.Close() })
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		var name string
+		require.NoError(t, cache.Wrap(db, nil).GetContext(ctx, &name, "SELECT name FROM items WHERE id = ?", 2))
+		assert.Equal(t, "b", name)
+	}
+	var name string
+	require.NoError(t, cache.Wrap(other, nil).GetContext(ctx, &name, "SELECT name FROM items WHERE id = ?", 1))
+	assert.Equal(t, "a", name)
+
+	assert.Equal(t, Stats{Hits: 2, Misses: 2, Size: 2}, cache.Stats())
+}
+
+func TestCache_ConcurrentPrepare(t *testing.T) {
+	db := newTestDB(t)
+	cache := New(0)
+	t.Cleanup(func() { _ = cache.Close() })
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var names []string
+			errs <- cache.Wrap(db, nil).SelectContext(context.Background(), &names, "SELECT name FROM items ORDER BY id")
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	stats := cache.Stats()
+	assert.Equal(t, int64(1), stats.Misses)
+	assert.Equal(t, int64(19), stats.Hits)
+}
+
+func TestCache_Eviction(t *testing.T) {
+	db := newTestDB(t)
+	cache := New(2)
+	t.Cleanup(func() { _ = cache.Close() })
+	ctx := context.Background()
+	queries := []string{
+		"SELECT name FROM items WHERE id = 1 AND id = ?",
+		"SELECT name FROM items WHERE id = 2 AND id = ?",
+		"SELECT name FROM items WHERE id = 3 AND id = ?",
+	}
+
+	// 使用中的 statement 被淘汰後仍可執行，釋放時才關閉
+	inUse, err := cache.acquire(ctx, db, queries[0])
+	require.NoError(t, err)
+	for i, query := range queries[1:] {
+		var name string
+		require.NoError(t, cache.Wrap(db, nil).GetContext(ctx, &name, query, i+2))
+	}
+	stats := cache.Stats()
+	assert.Equal(t, int64(1), stats.Evictions)
+	assert.Equal(t, 2, stats.Size)
+	var name string
+	require.NoError(t, inUse.stmt.GetContext(ctx, &name, 1))
+	assert.Equal(t, "a", name)
+	cache.release(inUse)
+	assert.Error(t, inUse.stmt.GetContext(ctx, &name, 1))
+
+	// 被淘汰的 SQL 再次使用時重新準備
+	require.NoError(t, cache.Wrap(db, nil).GetContext(ctx, &name, queries[0], 1))
+	assert.Equal(t, int64(4), cache.Stats().Misses)
+}
+
+func TestCache_ConnectionRecycling(t *testing.T) {
+	db := newTestDB(t)
+	// 每次呼叫後關閉連接，強迫 database/sql 在新連接上重新準備
+	db.SetMaxIdleConns(0)
+	db.SetConnMaxLifetime(time.Millisecond)
+	cache := New(0)
+	t.Cleanup(func() { _ = cache.Close() })
+	ctx := context.Background()
+
+	for i := 0; i < 5; i++ {
+		var name string
+		require.NoError(t, cache.Wrap(db, nil).GetContext(ctx, &name, "SELECT name FROM items WHERE id = ?", 3))
+		assert.Equal(t, "c", name)
+		time.Sleep(2 * time.Millisecond)
+	}
+	assert.Equal(t, int64(1), cache.Stats().Misses)
+	assert.Equal(t, 0, db.Stats().Idle)
+}
+
+func TestCache_Transaction(t *testing.T) {
+	db := newTestDB(t)
+	cache := New(0)
+	t.Cleanup(func() { _ = cache.Close() })
+	ctx := context.Background()
+	const (
+		insert = "INSERT INTO items (name) VALUES (?)"
+		count  = "SELECT COUNT(*) FROM items"
+	)
+	var n int
+	require.NoError(t, cache.Wrap(db, nil).GetContext(ctx, &n, count))
+	_, err := cache.Wrap(db, nil).ExecContext(ctx, insert, "d")
+	require.NoError(t, err)
+
+	tx, err := db.BeginTxx(ctx, nil)
+	require.NoError(t, err)
+	_, err = cache.Wrap(tx, db).ExecContext(ctx, insert, "e")
+	require.NoError(t, err)
+	require.NoError(t, cache.Wrap(tx, db).GetContext(ctx, &n, count))
+	assert.Equal(t, 5, n, "交易內看得到尚未提交的寫入")
+	require.NoError(t, tx.Rollback())
+	assert.Equal(t, Stats{Hits: 2, Misses: 2, Size: 2}, cache.Stats(), "交易使用快取中的 statement")
+
+	// 交易結束後，快取中的 statement 仍可在連接池上使用
+	require.NoError(t, cache.Wrap(db, nil).GetContext(ctx, &n, count))
+	assert.Equal(t, 4, n)
+
+	// 沒有指定開啟交易的連接池時直接以交易執行
+	tx, err = db.BeginTxx(ctx, nil)
+	require.NoError(t, err)
+	require.NoError(t, cache.Wrap(tx, nil).GetContext(ctx, &n, count))
+	require.NoError(t, tx.Rollback())
+	assert.Equal(t, int64(3), cache.Stats().Hits)
+}
+
+func TestCache_TransactionMissOnSingleConnection(t *testing.T) {
+	db := newTestDB(t)
+	db.SetMaxOpenConns(1)
+	cache := New(0)
+	t.Cleanup(func() { _ = cache.Close() })
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	const count = "SELECT COUNT(*) FROM items"
+
+	// 交易持有唯一的連接，未快取的 SQL 直接在交易中執行，不等待連接池
+	tx, err := db.BeginTxx(ctx, nil)
+	require.NoError(t, err)
+	var n int
+	require.NoError(t, cache.Wrap(tx, db).GetContext(ctx, &n, count))
+	assert.Equal(t, 3, n)
+	require.NoError(t, tx.Rollback())
+
+	// 交易結束釋放連接後背景準備完成，之後的交易改用快取的 statement
+	assert.Eventually(t, func() bool { return cache.Stats().Size == 1 }, time.Second, 5*time.Millisecond)
+	tx, err = db.BeginTxx(ctx, nil)
+	require.NoError(t, err)
+	require.NoError(t, cache.Wrap(tx, db).GetContext(ctx, &n, count))
+	require.NoError(t, tx.Rollback())
+	assert.Equal(t, int64(1), cache.Stats().Hits)
+}
+
+func TestCache_PrepareError(t *testing.T) {
+	db := newTestDB(t)
+	cache := New(0)
+	t.Cleanup(func() { _ = cache.Close() })
+
+	var name string
+	err := cache.Wrap(db, nil).GetContext(context.Background(), &name, "SELECT name FROM missing WHERE id = ?", 1)
+	assert.ErrorContains(t, err, "sqlxstmt: prepare")
+	assert.Equal(t, 0, cache.Stats().Size, "準備失敗不留在快取")
+}
+
+func TestCache_Nil(t *testing.T) {
+	db := newTestDB(t)
+	var cache *Cache
+
+	var name string
+	require.NoError(t, cache.Wrap(db, nil).GetContext(context.Background(), &name, "SELECT name FROM items WHERE id = ?", 1))
+	assert.Equal(t, "a", name)
+}
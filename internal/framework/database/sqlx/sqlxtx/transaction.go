@@ -0,0 +1,128 @@
+// Package sqlxtx 提供以 context 傳遞 *sqlx.Tx 的交易管理，
+// 讓不同模組的 repository 在同一個交易內執行（例如會員異動與 outbox 寫入）。
+package sqlxtx
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"sync"
+)
+
+// Executor 為 *sqlx.DB 與 *sqlx.Tx 的共同操作集合，repository 只依賴這個介面
+type Executor interface {
+	sqlx.ExtContext
+	GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+}
+
+// Querier 只執行 SQL 的最小操作集合，Executor 與包裝 Executor 的實作（觀測、prepared statement）都符合
+type Querier interface {
+	GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+type txKey struct{}
+
+type rollbackHooksKey struct{}
+
+// rollbackHooks 交易內登記的補償動作，回滾時由後往前執行
+type rollbackHooks struct {
+	mu  sync.Mutex
+	fns []func()
+}
+
+func (h *rollbackHooks) add(fn func()) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.fns = append(h.fns, fn)
+}
+
+func (h *rollbackHooks) run() {
+	h.mu.Lock()
+	fns := h.fns
+	h.fns = nil
+	h.mu.Unlock()
+	for i := len(fns) - 1; i >= 0; i-- {
+		fns[i]()
+	}
+}
+
+// TxManager 負責開啟、提交與回滾交易
+type TxManager struct {
+	db *sqlx.DB
+}
+
+// NewTxManager 創建交易管理器
+func NewTxManager(db *sqlx.DB) *TxManager {
+	return &TxManager{db: db}
+}
+
+// WithinTransaction 在交易中執行 fn，fn 回傳錯誤或 panic 時回滾
+//   - ctx 中已有交易時直接沿用（巢狀呼叫不另開交易），由最外層負責提交
+//   - 回滾或提交失敗時執行 OnRollback 登記的補償動作
+func (m *TxManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	if _, ok := TxFromContext(ctx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := m.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("sqlxtx: begin transaction: %w", err)
+	}
+	hooks := &rollbackHooks{}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			hooks.run()
+			panic(p)
+		}
+	}()
+
+	if err := fn(context.WithValue(WithTx(ctx, tx), rollbackHooksKey{}, hooks)); err != nil {
+		rbErr := tx.Rollback()
+		hooks.run()
+		if rbErr != nil && !errors.Is(rbErr, sql.ErrTxDone) {
+			return errors.Join(err, fmt.Errorf("sqlxtx: rollback: %w", rbErr))
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		hooks.run()
+		return fmt.Errorf("sqlxtx: commit transaction: %w", err)
+	}
+	return nil
+}
+
+// OnRollback 登記交易回滾時要執行的補償動作，讓不經過資料庫的寫入（例如記憶體 DAO）跟著交易復原；
+// ctx 中沒有 WithinTransaction 開啟的交易時不登記並回傳 false，呼叫端的寫入即為最終結果
+func OnRollback(ctx context.Context, fn func()) bool {
+	hooks, ok := ctx.Value(rollbackHooksKey{}).(*rollbackHooks)
+	if !ok {
+		return false
+	}
+	hooks.add(fn)
+	return true
+}
+
+// WithTx 將交易放入 context
+func WithTx(ctx context.Context, tx *sqlx.Tx) context.Context {
+	return context.WithValue(ctx, txKey{}, tx)
+}
+
+// TxFromContext 取出 context 中的交易
+func TxFromContext(ctx context.Context) (*sqlx.Tx, bool) {
+	tx, ok := ctx.Value(txKey{}).(*sqlx.Tx)
+	return tx, ok
+}
+
+// ExecutorFromContext ctx 中有交易時回傳交易，否則回傳 db
+func ExecutorFromContext(ctx context.Context, db *sqlx.DB) Executor {
+	if tx, ok := TxFromContext(ctx); ok {
+		return tx
+	}
+	return db
+}
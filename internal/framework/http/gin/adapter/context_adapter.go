@@ -3,6 +3,7 @@ package adapter
 import (
 	"context"
 	memberhttp "github.com/tomoffice/go-clean-architecture/internal/interface_adapter/transport/http"
+	"io"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -10,6 +11,9 @@ import (
 
 type ginContext struct{ c *gin.Context }
 
+// NewContext 將 *gin.Context 包裝成 transport 層的 Context
+func NewContext(c *gin.Context) memberhttp.Context { return ginContext{c} }
+
 // 綁定（沿用 Gin 的 ShouldBindXXX）
 func (g ginContext) BindJSON(v any) error  { return g.c.ShouldBindJSON(v) }
 func (g ginContext) BindQuery(v any) error { return g.c.ShouldBindQuery(v) }
@@ -25,6 +29,23 @@ func (g ginContext) Header(k, v string)      { g.c.Header(k, v) }
 func (g ginContext) Status(code int)         { g.c.Status(code) }
 func (g ginContext) JSON(code int, body any) { g.c.JSON(code, body) }
 
+// Stream 不使用 gin.Context.Stream：其依賴 http.CloseNotifier，改以 request context 偵測斷線
+func (g ginContext) Stream(step func(w io.Writer) bool) bool {
+	done := g.c.Request.Context().Done()
+	for {
+		select {
+		case <-done:
+			return true
+		default:
+		}
+		keepOpen := step(g.c.Writer)
+		g.c.Writer.Flush()
+		if !keepOpen {
+			return false
+		}
+	}
+}
+
 // 包裝 handler
 func wrap(h memberhttp.HandlerFunc) gin.HandlerFunc {
 	return func(c *gin.Context) { h(ginContext{c}) }
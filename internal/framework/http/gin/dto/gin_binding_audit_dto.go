@@ -0,0 +1,11 @@
+package dto
+
+// GinBindingListAuditEntriesQueryRequestDTO (GET /api/v1/audit?target=&action=&from=&to=&page=&limit=)
+type GinBindingListAuditEntriesQueryRequestDTO struct {
+	Page   int    `form:"page" binding:"required"`
+	Limit  int    `form:"limit" binding:"required"`
+	Target string `form:"target" binding:"omitempty"`
+	Action string `form:"action" binding:"omitempty"`
+	From   string `form:"from" binding:"omitempty"`
+	To     string `form:"to" binding:"omitempty"`
+}
@@ -1,10 +1,14 @@
 package dto
 
+import "time"
+
 // GinBindingRegisterMemberRequestDTO (POST /api/v1/members)
 type GinBindingRegisterMemberRequestDTO struct {
 	Name     string `json:"name" binding:"required"`
 	Email    string `json:"email" binding:"required"`
 	Password string `json:"password" binding:"required"`
+	// InvitationCode 僅限邀請註冊時必填，由 use case 檢查
+	InvitationCode string `json:"invitation_code" binding:"omitempty"`
 }
 
 // GinBindingGetMemberByIDURIRequestDTO (GET /api/v1/members/:id)
@@ -17,12 +21,16 @@ type GinBindingGetMemberByEmailQueryRequestDTO struct {
 	Email string `form:"email" binding:"required"`
 }
 
-// GinBindingListMemberQueryRequestDTO (GET /api/v1/members?page=&limit=&sort_by=&order_by=)
+// GinBindingListMemberQueryRequestDTO (GET /api/v1/members?page=&limit=&sort_by=&order_by=&status=&tags=&tag_match=)
+//   - tags 可重複帶入或以逗號分隔，tag_match 為 any 或 all
 type GinBindingListMemberQueryRequestDTO struct {
-	Page    int    `form:"page" binding:"required"`
-	Limit   int    `form:"limit" binding:"required"`
-	SortBy  string `form:"sort_by" binding:"omitempty"`
-	OrderBy string `form:"order_by" binding:"omitempty"`
+	Page     int      `form:"page" binding:"required"`
+	Limit    int      `form:"limit" binding:"required"`
+	SortBy   string   `form:"sort_by" binding:"omitempty"`
+	OrderBy  string   `form:"order_by" binding:"omitempty"`
+	Status   string   `form:"status" binding:"omitempty"`
+	Tags     []string `form:"tags" binding:"omitempty"`
+	TagMatch string   `form:"tag_match" binding:"omitempty"`
 }
 
 // GinBindingUpdateMemberURIRequestDTO (PATCH /api/v1/members/:id)
@@ -51,3 +59,101 @@ type GinBindingUpdateMemberPasswordBodyRequestDTO struct {
 type GinBindingDeleteMemberURIRequestDTO struct {
 	ID int `uri:"id" binding:"required"`
 }
+
+// GinBindingStreamMemberChangesQueryRequestDTO (GET /api/v1/members/stream?types=&last_event_id=)
+//   - types 可重複帶入或以逗號分隔
+//   - last_event_id 供無法自訂 header 的客戶端使用，Last-Event-ID header 優先
+type GinBindingStreamMemberChangesQueryRequestDTO struct {
+	Types       []string `form:"types" binding:"omitempty"`
+	LastEventID string   `form:"last_event_id" binding:"omitempty"`
+}
+
+// GinBindingPersonalDataURIRequestDTO (GET /api/v1/members/:id/personal-data, POST /api/v1/members/:id/erase)
+type GinBindingPersonalDataURIRequestDTO struct {
+	ID int `uri:"id" binding:"required"`
+}
+
+// GinBindingChangeMemberStatusBodyRequestDTO (POST /api/v1/members/:id/activate|suspend|ban|reinstate)
+type GinBindingChangeMemberStatusBodyRequestDTO struct {
+	Reason string `json:"reason" binding:"omitempty"`
+}
+
+// GinBindingMergeMembersBodyRequestDTO (POST /api/v1/members/:id/merge)
+type GinBindingMergeMembersBodyRequestDTO struct {
+	TargetID int  `json:"target_id" binding:"required"`
+	Preview  bool `json:"preview" binding:"omitempty"`
+}
+
+// GinBindingIssueMemberTokenBodyRequestDTO (POST /api/v1/members/token)
+type GinBindingIssueMemberTokenBodyRequestDTO struct {
+	Email    string `json:"email" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+// GinBindingCreateInvitationBodyRequestDTO (POST /api/v1/members/invitations)
+//   - expires_at 為 RFC 3339 時間，未帶時使用設定的有效期間
+type GinBindingCreateInvitationBodyRequestDTO struct {
+	Email     string     `json:"email" binding:"omitempty"`
+	MaxUses   int        `json:"max_uses" binding:"omitempty"`
+	ExpiresAt *time.Time `json:"expires_at" binding:"omitempty"`
+}
+
+// GinBindingListInvitationsQueryRequestDTO (GET /api/v1/members/invitations?page=&limit=)
+type GinBindingListInvitationsQueryRequestDTO struct {
+	Page  int `form:"page" binding:"required"`
+	Limit int `form:"limit" binding:"required"`
+}
+
+// GinBindingInvitationURIRequestDTO (DELETE /api/v1/members/invitations/:id)
+type GinBindingInvitationURIRequestDTO struct {
+	ID int `uri:"id" binding:"required"`
+}
+
+// GinBindingMemberTagURIRequestDTO (PUT/DELETE /api/v1/members/:id/tags/:tag)
+type GinBindingMemberTagURIRequestDTO struct {
+	ID  int    `uri:"id" binding:"required"`
+	Tag string `uri:"tag" binding:"required"`
+}
+
+// GinBindingBulkTagURIRequestDTO (POST /api/v1/members/tags/:tag)
+type GinBindingBulkTagURIRequestDTO struct {
+	Tag string `uri:"tag" binding:"required"`
+}
+
+// GinBindingBulkTagBodyRequestDTO (POST /api/v1/members/tags/:tag)
+type GinBindingBulkTagBodyRequestDTO struct {
+	MemberIDs []int `json:"member_ids" binding:"required"`
+}
+
+// GinBindingSegmentFilterDTO 分群條件，各條件之間為 AND
+type GinBindingSegmentFilterDTO struct {
+	Status     string   `json:"status" binding:"omitempty"`
+	TagsAny    []string `json:"tags_any" binding:"omitempty"`
+	TagsAll    []string `json:"tags_all" binding:"omitempty"`
+	ReferredBy int      `json:"referred_by" binding:"omitempty"`
+}
+
+// GinBindingCreateSegmentBodyRequestDTO (POST /api/v1/members/segments)
+type GinBindingCreateSegmentBodyRequestDTO struct {
+	Name   string                     `json:"name" binding:"required"`
+	Filter GinBindingSegmentFilterDTO `json:"filter" binding:"omitempty"`
+}
+
+// GinBindingListSegmentsQueryRequestDTO (GET /api/v1/members/segments?page=&limit=)
+type GinBindingListSegmentsQueryRequestDTO struct {
+	Page  int `form:"page" binding:"required"`
+	Limit int `form:"limit" binding:"required"`
+}
+
+// GinBindingSegmentURIRequestDTO (GET /api/v1/members/segments/:id/members|export, DELETE /api/v1/members/segments/:id)
+type GinBindingSegmentURIRequestDTO struct {
+	ID int `uri:"id" binding:"required"`
+}
+
+// GinBindingMemberPreferencesURIRequestDTO (GET/PATCH /api/v1/members/:id/preferences)
+type GinBindingMemberPreferencesURIRequestDTO struct {
+	ID int `uri:"id" binding:"required"`
+}
+
+// GinBindingPatchMemberPreferencesBodyRequestDTO JSON merge patch（RFC 7396），值為 null 表示回到預設值
+type GinBindingPatchMemberPreferencesBodyRequestDTO map[string]any
@@ -0,0 +1,8 @@
+package dto
+
+// GinBindingListStuckEventsQueryRequestDTO (GET /api/v1/admin/outbox/stuck?status=&page=&limit=)
+type GinBindingListStuckEventsQueryRequestDTO struct {
+	Page   int    `form:"page" binding:"required"`
+	Limit  int    `form:"limit" binding:"required"`
+	Status string `form:"status" binding:"omitempty"`
+}
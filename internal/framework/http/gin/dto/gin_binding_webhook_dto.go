@@ -0,0 +1,40 @@
+package dto
+
+// GinBindingCreateWebhookRequestDTO (POST /api/v1/webhooks)
+type GinBindingCreateWebhookRequestDTO struct {
+	URL        string   `json:"url" binding:"required"`
+	EventTypes []string `json:"event_types" binding:"required"`
+	Secret     string   `json:"secret" binding:"omitempty"`
+}
+
+// GinBindingWebhookURIRequestDTO (GET|PATCH|DELETE /api/v1/webhooks/:id, GET /api/v1/webhooks/:id/deliveries)
+type GinBindingWebhookURIRequestDTO struct {
+	ID int `uri:"id" binding:"required"`
+}
+
+// GinBindingListWebhooksQueryRequestDTO (GET /api/v1/webhooks?page=&limit=)
+type GinBindingListWebhooksQueryRequestDTO struct {
+	Page  int `form:"page" binding:"required"`
+	Limit int `form:"limit" binding:"required"`
+}
+
+// GinBindingUpdateWebhookBodyRequestDTO (PATCH /api/v1/webhooks/:id)
+type GinBindingUpdateWebhookBodyRequestDTO struct {
+	URL        *string  `json:"url,omitempty" binding:"omitempty"`
+	EventTypes []string `json:"event_types,omitempty" binding:"omitempty"`
+	Secret     *string  `json:"secret,omitempty" binding:"omitempty"`
+	Status     *string  `json:"status,omitempty" binding:"omitempty"`
+}
+
+// GinBindingListWebhookDeliveriesQueryRequestDTO (GET /api/v1/webhooks/:id/deliveries?status=&page=&limit=)
+type GinBindingListWebhookDeliveriesQueryRequestDTO struct {
+	Page   int    `form:"page" binding:"required"`
+	Limit  int    `form:"limit" binding:"required"`
+	Status string `form:"status" binding:"omitempty"`
+}
+
+// GinBindingRedeliverWebhookURIRequestDTO (POST /api/v1/webhooks/:id/deliveries/:deliveryId/redeliver)
+type GinBindingRedeliverWebhookURIRequestDTO struct {
+	ID         int `uri:"id" binding:"required"`
+	DeliveryID int `uri:"deliveryId" binding:"required"`
+}
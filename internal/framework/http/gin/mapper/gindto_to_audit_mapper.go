@@ -0,0 +1,17 @@
+package mapper
+
+import (
+	gindto "github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/dto"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/interface_adapter/dto"
+)
+
+func GinDTOToListAuditEntriesDTO(ginDTO gindto.GinBindingListAuditEntriesQueryRequestDTO) dto.ListAuditEntriesRequestDTO {
+	return dto.ListAuditEntriesRequestDTO{
+		Page:   ginDTO.Page,
+		Limit:  ginDTO.Limit,
+		Target: ginDTO.Target,
+		Action: ginDTO.Action,
+		From:   ginDTO.From,
+		To:     ginDTO.To,
+	}
+}
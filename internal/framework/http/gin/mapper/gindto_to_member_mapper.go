@@ -3,13 +3,15 @@ package mapper
 import (
 	gindto "github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/dto"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/dto"
+	"strings"
 )
 
 func GinDTOToRegisterMemberDTO(ginDTO gindto.GinBindingRegisterMemberRequestDTO) dto.RegisterMemberRequestDTO {
 	return dto.RegisterMemberRequestDTO{
-		Name:     ginDTO.Name,
-		Email:    ginDTO.Email,
-		Password: ginDTO.Password,
+		Name:           ginDTO.Name,
+		Email:          ginDTO.Email,
+		Password:       ginDTO.Password,
+		InvitationCode: ginDTO.InvitationCode,
 	}
 }
 func GinDTOToGetMemberByIDDTO(ginDTO gindto.GinBindingGetMemberByIDURIRequestDTO) dto.GetMemberByIDRequestDTO {
@@ -24,10 +26,13 @@ func GinDTOToGetMemberByEmailDTO(ginDTO gindto.GinBindingGetMemberByEmailQueryRe
 }
 func GinDTOtoListMemberDTO(ginDTO gindto.GinBindingListMemberQueryRequestDTO) dto.ListMemberRequestDTO {
 	return dto.ListMemberRequestDTO{
-		Page:    ginDTO.Page,
-		Limit:   ginDTO.Limit,
-		SortBy:  ginDTO.SortBy,
-		OrderBy: ginDTO.OrderBy,
+		Page:     ginDTO.Page,
+		Limit:    ginDTO.Limit,
+		SortBy:   ginDTO.SortBy,
+		OrderBy:  ginDTO.OrderBy,
+		Status:   ginDTO.Status,
+		Tags:     splitCommaValues(ginDTO.Tags),
+		TagMatch: ginDTO.TagMatch,
 	}
 }
 func GinDTOToUpdateMemberProfileDTO(ginURI gindto.GinBindingUpdateMemberURIRequestDTO, ginBody gindto.GinBindingUpdateMemberProfileBodyRequestDTO) dto.UpdateMemberProfileRequestDTO {
@@ -50,8 +55,129 @@ func GinDTOToUpdateMemberPasswordDTO(ginURI gindto.GinBindingUpdateMemberURIRequ
 		NewPassword: ginBody.NewPassword,
 	}
 }
+func GinDTOToChangeMemberStatusDTO(ginURI gindto.GinBindingUpdateMemberURIRequestDTO, ginBody gindto.GinBindingChangeMemberStatusBodyRequestDTO, status string) dto.ChangeMemberStatusRequestDTO {
+	return dto.ChangeMemberStatusRequestDTO{
+		ID:     ginURI.ID,
+		Status: status,
+		Reason: ginBody.Reason,
+	}
+}
+func GinDTOToMergeMembersDTO(ginURI gindto.GinBindingUpdateMemberURIRequestDTO, ginBody gindto.GinBindingMergeMembersBodyRequestDTO) dto.MergeMembersRequestDTO {
+	return dto.MergeMembersRequestDTO{
+		ID:       ginURI.ID,
+		TargetID: ginBody.TargetID,
+		Preview:  ginBody.Preview,
+	}
+}
 func GinDTOToDeleteMemberDTO(ginDTO gindto.GinBindingDeleteMemberURIRequestDTO) dto.DeleteMemberRequestDTO {
 	return dto.DeleteMemberRequestDTO{
 		ID: ginDTO.ID,
 	}
 }
+
+// GinDTOToStreamMemberChangesDTO 攤平逗號分隔的 types，lastEventIDHeader 不為空時優先於 query
+func GinDTOToStreamMemberChangesDTO(ginDTO gindto.GinBindingStreamMemberChangesQueryRequestDTO, lastEventIDHeader string) dto.StreamMemberChangesRequestDTO {
+	types := splitCommaValues(ginDTO.Types)
+	lastEventID := ginDTO.LastEventID
+	if lastEventIDHeader != "" {
+		lastEventID = lastEventIDHeader
+	}
+	return dto.StreamMemberChangesRequestDTO{
+		Types:       types,
+		LastEventID: lastEventID,
+	}
+}
+
+func GinDTOToPersonalDataDTO(ginDTO gindto.GinBindingPersonalDataURIRequestDTO) dto.PersonalDataRequestDTO {
+	return dto.PersonalDataRequestDTO{
+		ID: ginDTO.ID,
+	}
+}
+
+func GinDTOToIssueMemberTokenDTO(ginDTO gindto.GinBindingIssueMemberTokenBodyRequestDTO) dto.IssueMemberTokenRequestDTO {
+	return dto.IssueMemberTokenRequestDTO{
+		Email:    ginDTO.Email,
+		Password: ginDTO.Password,
+	}
+}
+func GinDTOToCreateInvitationDTO(ginDTO gindto.GinBindingCreateInvitationBodyRequestDTO) dto.CreateInvitationRequestDTO {
+	return dto.CreateInvitationRequestDTO{
+		Email:     ginDTO.Email,
+		MaxUses:   ginDTO.MaxUses,
+		ExpiresAt: ginDTO.ExpiresAt,
+	}
+}
+func GinDTOToListInvitationsDTO(ginDTO gindto.GinBindingListInvitationsQueryRequestDTO) dto.ListInvitationsRequestDTO {
+	return dto.ListInvitationsRequestDTO{
+		Page:  ginDTO.Page,
+		Limit: ginDTO.Limit,
+	}
+}
+func GinDTOToRevokeInvitationDTO(ginDTO gindto.GinBindingInvitationURIRequestDTO) dto.RevokeInvitationRequestDTO {
+	return dto.RevokeInvitationRequestDTO{
+		ID: ginDTO.ID,
+	}
+}
+func GinDTOToMemberTagDTO(ginDTO gindto.GinBindingMemberTagURIRequestDTO) dto.MemberTagRequestDTO {
+	return dto.MemberTagRequestDTO{
+		ID:  ginDTO.ID,
+		Tag: ginDTO.Tag,
+	}
+}
+func GinDTOToBulkTagMembersDTO(ginURI gindto.GinBindingBulkTagURIRequestDTO, ginBody gindto.GinBindingBulkTagBodyRequestDTO) dto.BulkTagMembersRequestDTO {
+	return dto.BulkTagMembersRequestDTO{
+		Tag:       ginURI.Tag,
+		MemberIDs: ginBody.MemberIDs,
+	}
+}
+func GinDTOToCreateSegmentDTO(ginDTO gindto.GinBindingCreateSegmentBodyRequestDTO) dto.CreateSegmentRequestDTO {
+	return dto.CreateSegmentRequestDTO{
+		Name:       ginDTO.Name,
+		Status:     ginDTO.Filter.Status,
+		TagsAny:    ginDTO.Filter.TagsAny,
+		TagsAll:    ginDTO.Filter.TagsAll,
+		ReferredBy: ginDTO.Filter.ReferredBy,
+	}
+}
+func GinDTOToListSegmentsDTO(ginDTO gindto.GinBindingListSegmentsQueryRequestDTO) dto.ListSegmentsRequestDTO {
+	return dto.ListSegmentsRequestDTO{
+		Page:  ginDTO.Page,
+		Limit: ginDTO.Limit,
+	}
+}
+func GinDTOToEvaluateSegmentDTO(ginURI gindto.GinBindingSegmentURIRequestDTO, ginQuery gindto.GinBindingListSegmentsQueryRequestDTO) dto.EvaluateSegmentRequestDTO {
+	return dto.EvaluateSegmentRequestDTO{
+		ID:    ginURI.ID,
+		Page:  ginQuery.Page,
+		Limit: ginQuery.Limit,
+	}
+}
+func GinDTOToSegmentDTO(ginDTO gindto.GinBindingSegmentURIRequestDTO) dto.SegmentRequestDTO {
+	return dto.SegmentRequestDTO{
+		ID: ginDTO.ID,
+	}
+}
+func GinDTOToMemberPreferencesDTO(ginDTO gindto.GinBindingMemberPreferencesURIRequestDTO) dto.MemberPreferencesRequestDTO {
+	return dto.MemberPreferencesRequestDTO{
+		ID: ginDTO.ID,
+	}
+}
+func GinDTOToPatchMemberPreferencesDTO(ginURI gindto.GinBindingMemberPreferencesURIRequestDTO, ginBody gindto.GinBindingPatchMemberPreferencesBodyRequestDTO) dto.PatchMemberPreferencesRequestDTO {
+	return dto.PatchMemberPreferencesRequestDTO{
+		ID:      ginURI.ID,
+		Changes: ginBody,
+	}
+}
+
+// splitCommaValues 攤平可重複帶入或以逗號分隔的 query 參數，忽略空值
+func splitCommaValues(raws []string) []string {
+	var values []string
+	for _, raw := range raws {
+		for _, v := range strings.Split(raw, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				values = append(values, v)
+			}
+		}
+	}
+	return values
+}
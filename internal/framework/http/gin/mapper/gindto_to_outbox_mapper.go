@@ -0,0 +1,14 @@
+package mapper
+
+import (
+	gindto "github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/dto"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/interface_adapter/dto"
+)
+
+func GinDTOToListStuckEventsDTO(ginDTO gindto.GinBindingListStuckEventsQueryRequestDTO) dto.ListStuckEventsRequestDTO {
+	return dto.ListStuckEventsRequestDTO{
+		Page:   ginDTO.Page,
+		Limit:  ginDTO.Limit,
+		Status: ginDTO.Status,
+	}
+}
@@ -0,0 +1,53 @@
+package mapper
+
+import (
+	gindto "github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/dto"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/interface_adapter/dto"
+)
+
+func GinDTOToCreateSubscriptionDTO(ginDTO gindto.GinBindingCreateWebhookRequestDTO) dto.CreateSubscriptionRequestDTO {
+	return dto.CreateSubscriptionRequestDTO{
+		URL:        ginDTO.URL,
+		EventTypes: ginDTO.EventTypes,
+		Secret:     ginDTO.Secret,
+	}
+}
+func GinDTOToGetSubscriptionDTO(ginDTO gindto.GinBindingWebhookURIRequestDTO) dto.GetSubscriptionRequestDTO {
+	return dto.GetSubscriptionRequestDTO{
+		ID: ginDTO.ID,
+	}
+}
+func GinDTOToListSubscriptionsDTO(ginDTO gindto.GinBindingListWebhooksQueryRequestDTO) dto.ListSubscriptionsRequestDTO {
+	return dto.ListSubscriptionsRequestDTO{
+		Page:  ginDTO.Page,
+		Limit: ginDTO.Limit,
+	}
+}
+func GinDTOToUpdateSubscriptionDTO(ginURI gindto.GinBindingWebhookURIRequestDTO, ginBody gindto.GinBindingUpdateWebhookBodyRequestDTO) dto.UpdateSubscriptionRequestDTO {
+	return dto.UpdateSubscriptionRequestDTO{
+		ID:         ginURI.ID,
+		URL:        ginBody.URL,
+		EventTypes: ginBody.EventTypes,
+		Secret:     ginBody.Secret,
+		Status:     ginBody.Status,
+	}
+}
+func GinDTOToDeleteSubscriptionDTO(ginDTO gindto.GinBindingWebhookURIRequestDTO) dto.DeleteSubscriptionRequestDTO {
+	return dto.DeleteSubscriptionRequestDTO{
+		ID: ginDTO.ID,
+	}
+}
+func GinDTOToListDeliveriesDTO(ginURI gindto.GinBindingWebhookURIRequestDTO, ginQuery gindto.GinBindingListWebhookDeliveriesQueryRequestDTO) dto.ListDeliveriesRequestDTO {
+	return dto.ListDeliveriesRequestDTO{
+		SubscriptionID: ginURI.ID,
+		Page:           ginQuery.Page,
+		Limit:          ginQuery.Limit,
+		Status:         ginQuery.Status,
+	}
+}
+func GinDTOToRedeliverDTO(ginDTO gindto.GinBindingRedeliverWebhookURIRequestDTO) dto.RedeliverRequestDTO {
+	return dto.RedeliverRequestDTO{
+		SubscriptionID: ginDTO.ID,
+		DeliveryID:     ginDTO.DeliveryID,
+	}
+}
@@ -18,6 +18,7 @@ var (
 	ErrSignatureInvalid     = errors.New("signature is invalid")          // 簽名錯誤可能被串改
 	ErrParseTokenFailed     = errors.New("failed to parse token")         // 解析 token 時發生錯誤（可能是格式錯誤或其他問題）
 	ErrInvalidToken         = errors.New("invalid token")                 // 解析成功但 token 無效（可能是格式錯誤或其他問題）
+	ErrSubjectRejected      = errors.New("token subject rejected")        // token 有效但 subject 已不可通過身分驗證（例如被停權）
 )
 
 // cors error
@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"github.com/gin-gonic/gin"
@@ -25,6 +26,10 @@ var allowedAlgs = map[string]struct{}{
 // AuthConfig JWT 認證配置
 type AuthConfig struct {
 	Secret string `validate:"required,min=32"` // 密鑰，最少 32 字符
+	// Optional 沒有 Authorization header 時不拒絕，由後續以匿名身分處理；有帶 token 時仍須通過驗證
+	Optional bool
+	// ValidateSubject token 驗證通過後再確認 subject 目前仍有效（例如會員未被停權），回傳錯誤即拒絕；nil 表示不檢查
+	ValidateSubject func(ctx context.Context, subject string) error
 }
 
 // AuthMiddleware JWT 認證中間件
@@ -61,6 +66,10 @@ func (m *AuthMiddleware[T]) HandlerFunc() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		// 檢查 Authorization header
 		authHeader := ctx.GetHeader("Authorization")
+		if authHeader == "" && m.config.Optional {
+			ctx.Next()
+			return
+		}
 		if authHeader == "" {
 			ctx.AbortWithStatusJSON(401, gin.H{"error": ErrAuthRequired.Error()})
 			return
@@ -79,6 +88,12 @@ func (m *AuthMiddleware[T]) HandlerFunc() gin.HandlerFunc {
 			ctx.AbortWithStatusJSON(401, gin.H{"error": err.Error()})
 			return
 		}
+		if m.config.ValidateSubject != nil {
+			if err := m.config.ValidateSubject(ctx.Request.Context(), claims.Subject); err != nil {
+				ctx.AbortWithStatusJSON(401, gin.H{"error": ErrSubjectRejected.Error()})
+				return
+			}
+		}
 
 		// 將 claims 存入 context
 		ctx.Set("claims", claims)
@@ -2,8 +2,10 @@ package middleware
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/middleware/auth"
 	"github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/middleware/cors"
 	"github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/middleware/logging"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/middleware/requestmeta"
 	"github.com/tomoffice/go-clean-architecture/pkg/logger"
 	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
 )
@@ -16,16 +18,19 @@ type Container struct {
 	auth      gin.HandlerFunc
 	rateLimit gin.HandlerFunc
 	logging   gin.HandlerFunc
+	// requestMeta 收集 actor/request id/trace id/IP 等呼叫端資訊
+	requestMeta gin.HandlerFunc
 	// 可以繼續添加其他middleware
 }
 
 // NewContainer 創建並初始化middleware容器
 func NewContainer(logger logger.Logger, tracer tracer.Tracer) *Container {
 	return &Container{
-		logger:  logger,
-		tracer:  tracer,
-		cors:    cors.NewCORSMiddleware(cors.DefaultCORSConfig()).HandlerFunc(),
-		logging: logging.NewLoggingMiddleware(logging.DefaultLoggingConfig(), logger, tracer).HandlerFunc(),
+		logger:      logger,
+		tracer:      tracer,
+		cors:        cors.NewCORSMiddleware(cors.DefaultCORSConfig()).HandlerFunc(),
+		logging:     logging.NewLoggingMiddleware(logging.DefaultLoggingConfig(), logger, tracer).HandlerFunc(),
+		requestMeta: requestmeta.NewRequestMetaMiddleware().HandlerFunc(),
 		// 暫時將其他中間件設為nil，之後實現時再添加
 		auth:      nil,
 		rateLimit: nil,
@@ -37,6 +42,16 @@ func (c *Container) CORS() gin.HandlerFunc {
 	return c.cors
 }
 
+// EnableAuth 以 JWT 設定建立認證中間件；驗證通過的 claims 存入 gin context，供 RequestMeta 取出 actor
+func (c *Container) EnableAuth(config auth.AuthConfig) error {
+	mw, err := auth.NewAuthMiddleware[struct{}](config)
+	if err != nil {
+		return err
+	}
+	c.auth = mw.HandlerFunc()
+	return nil
+}
+
 // Auth 返回認證中間件，如果不存在則返回nil
 func (c *Container) Auth() gin.HandlerFunc {
 	return c.auth
@@ -63,3 +78,11 @@ func (c *Container) Logging() gin.HandlerFunc {
 
 // HasLogging 檢查是否有日誌中間件
 func (c *Container) HasLogging() bool { return c.logging != nil }
+
+// RequestMeta 返回呼叫端資訊中間件，如果不存在則返回nil
+func (c *Container) RequestMeta() gin.HandlerFunc {
+	return c.requestMeta
+}
+
+// HasRequestMeta 檢查是否有呼叫端資訊中間件
+func (c *Container) HasRequestMeta() bool { return c.requestMeta != nil }
@@ -114,6 +114,7 @@ func (lm *LoggingMiddleware) HandlerFunc() gin.HandlerFunc {
 			responseBodyWriter = &ResponseBodyWriter{
 				ResponseWriter: c.Writer,
 				body:           bytes.NewBufferString(""),
+				limit:          lm.config.MaxBodySize,
 			}
 			c.Writer = responseBodyWriter
 		}
@@ -270,14 +271,18 @@ func (lm *LoggingMiddleware) readRequestBody(c *gin.Context) string {
 }
 
 // ResponseBodyWriter 自定義 ResponseWriter 用於攔截 response body
+//   - 最多保留 limit+1 bytes，足以判斷是否截斷，避免 SSE 等長連線回應無限累積
 type ResponseBodyWriter struct {
 	gin.ResponseWriter
-	body *bytes.Buffer
+	body  *bytes.Buffer
+	limit int
 }
 
 // Write 實作 ResponseWriter.Write 方法
 func (w *ResponseBodyWriter) Write(b []byte) (int, error) {
-	w.body.Write(b)
+	if remain := w.limit + 1 - w.body.Len(); remain > 0 {
+		w.body.Write(b[:min(remain, len(b))])
+	}
 	return w.ResponseWriter.Write(b)
 }
 
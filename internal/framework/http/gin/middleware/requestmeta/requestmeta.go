@@ -0,0 +1,69 @@
+package requestmeta
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/requestmeta"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RequestIDHeader 請求識別碼的 header 名稱，用戶端有帶就沿用，沒帶就產生新的
+const RequestIDHeader = "X-Request-Id"
+
+// claimsKey auth middleware 存放 claims 的 key
+const claimsKey = "claims"
+
+// subjectClaims 只需要 claims 能提供 subject（jwt.RegisteredClaims 已實作）
+type subjectClaims interface {
+	GetSubject() (string, error)
+}
+
+// RequestMetaMiddleware 收集呼叫端資訊並寫入 request context
+// 需放在 logging（產生 trace）與 auth（寫入 claims）之後
+type RequestMetaMiddleware struct{}
+
+// NewRequestMetaMiddleware 建立 request meta middleware
+func NewRequestMetaMiddleware() *RequestMetaMiddleware {
+	return &RequestMetaMiddleware{}
+}
+
+// HandlerFunc 返回 Gin 中間件函數
+func (m *RequestMetaMiddleware) HandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(RequestIDHeader)
+		if requestID == "" {
+			requestID = uuid.NewString()
+		}
+		c.Header(RequestIDHeader, requestID)
+
+		meta := requestmeta.Meta{
+			Actor:     actorFromClaims(c),
+			RequestID: requestID,
+			IP:        c.ClientIP(),
+			UserAgent: c.Request.UserAgent(),
+		}
+		if spanCtx := trace.SpanContextFromContext(c.Request.Context()); spanCtx.HasTraceID() {
+			meta.TraceID = spanCtx.TraceID().String()
+		}
+
+		c.Request = c.Request.WithContext(requestmeta.WithMeta(c.Request.Context(), meta))
+		c.Next()
+	}
+}
+
+// actorFromClaims 從 auth claims 取出 subject，取不到就當作匿名
+func actorFromClaims(c *gin.Context) string {
+	value, ok := c.Get(claimsKey)
+	if !ok {
+		return requestmeta.AnonymousActor
+	}
+	claims, ok := value.(subjectClaims)
+	if !ok {
+		return requestmeta.AnonymousActor
+	}
+	subject, err := claims.GetSubject()
+	if err != nil || subject == "" {
+		return requestmeta.AnonymousActor
+	}
+	return subject
+}
@@ -0,0 +1,57 @@
+package requestmeta
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/middleware/auth"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/requestmeta"
+)
+
+func TestRequestMetaMiddleware_ActorAfterAuth(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	secret := strings.Repeat("x", 32)
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": "42"}).SignedString([]byte(secret))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		header    string
+		wantActor string
+	}{
+		{name: "token subject becomes actor", header: "Bearer " + token, wantActor: "42"},
+		{name: "no token is anonymous", wantActor: requestmeta.AnonymousActor},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mw, err := auth.NewAuthMiddleware[struct{}](auth.AuthConfig{Secret: secret, Optional: true})
+			require.NoError(t, err)
+			engine := gin.New()
+			engine.Use(mw.HandlerFunc(), NewRequestMetaMiddleware().HandlerFunc())
+			var got requestmeta.Meta
+			engine.GET("/test", func(c *gin.Context) {
+				got = requestmeta.FromContext(c.Request.Context())
+				c.Status(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/test", nil)
+			req.Header.Set(RequestIDHeader, "req-1")
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			rec := httptest.NewRecorder()
+			engine.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, tt.wantActor, got.Actor)
+			assert.Equal(t, "req-1", got.RequestID)
+			assert.Equal(t, "req-1", rec.Header().Get(RequestIDHeader))
+		})
+	}
+}
@@ -2,6 +2,7 @@ package http
 
 import (
 	"context"
+	"io"
 	"net/http"
 )
 
@@ -23,4 +24,8 @@ type Context interface {
 	Header(key, val string)
 	Status(code int)
 	JSON(code int, body any)
+
+	// 串流回應：重複呼叫 step 並在每次呼叫後 flush，step 回傳 false 時結束；
+	// 客戶端斷線（request context 結束）時回傳 true
+	Stream(step func(w io.Writer) bool) bool
 }
@@ -0,0 +1,52 @@
+package http
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// SSEEvent Server-Sent Events 的單一事件，空欄位不輸出
+type SSEEvent struct {
+	ID    string
+	Event string
+	Data  []byte
+	Retry time.Duration
+}
+
+// WriteSSEEvent 依 text/event-stream 格式寫入事件，多行 data 逐行輸出
+func WriteSSEEvent(w io.Writer, event SSEEvent) error {
+	var buf bytes.Buffer
+	if event.ID != "" {
+		fmt.Fprintf(&buf, "id: %s\n", sanitizeSSEField(event.ID))
+	}
+	if event.Event != "" {
+		fmt.Fprintf(&buf, "event: %s\n", sanitizeSSEField(event.Event))
+	}
+	if event.Retry > 0 {
+		fmt.Fprintf(&buf, "retry: %d\n", event.Retry.Milliseconds())
+	}
+	if event.Data != nil {
+		for _, line := range bytes.Split(event.Data, []byte("\n")) {
+			buf.WriteString("data: ")
+			buf.Write(line)
+			buf.WriteByte('\n')
+		}
+	}
+	buf.WriteByte('\n')
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// WriteSSEComment 寫入註解行，客戶端會忽略，用於 heartbeat 保持連線
+func WriteSSEComment(w io.Writer, comment string) error {
+	_, err := fmt.Fprintf(w, ": %s\n\n", sanitizeSSEField(comment))
+	return err
+}
+
+// sanitizeSSEField 移除換行，避免單行欄位被拆成多個欄位
+func sanitizeSSEField(s string) string {
+	return strings.NewReplacer("\r", "", "\n", "").Replace(s)
+}
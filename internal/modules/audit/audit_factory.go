@@ -0,0 +1,55 @@
+package audit
+
+import (
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
+
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxdriver"
+	"github.com/tomoffice/go-clean-architecture/internal/modules"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/framework/persistence/sqlx/mcsqlite"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/framework/persistence/sqlx/pgsql"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/interface_adapter/controller"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/interface_adapter/dao"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/interface_adapter/gateway/repository"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/interface_adapter/presenter/http"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/interface_adapter/router"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/interface_adapter/validation"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/usecase"
+)
+
+// Factory 稽核模組工廠
+type Factory struct{}
+
+// NewModuleFactory 創建稽核模組工廠
+func NewModuleFactory() modules.ModuleFactory {
+	return &Factory{}
+}
+
+// CreateModule 創建稽核模組，注入 logger 和 tracer 到需要的組件中
+func (f *Factory) CreateModule(db *sqlx.DB, rg *gin.RouterGroup, log logger.Logger, tracer tracer.Tracer) (modules.Module, error) {
+	// 創建帶有模組標識的子 logger
+	moduleLogger := log.With(logger.NewField("module", "audit"))
+
+	// 組裝所有組件
+	validator := validation.NewAuditValidator()
+	var repo dao.AuditDAO
+	switch sqlxdriver.Driver(db.DriverName()) {
+	case sqlxdriver.DriverSQLite:
+		repo = mcsqlite.NewSqlxAuditSqlite(db, moduleLogger, tracer)
+	case sqlxdriver.DriverPostgres:
+		repo = pgsql.NewSqlxAuditPgsql(db, moduleLogger, tracer)
+	default:
+		return nil, fmt.Errorf("audit: unsupported database driver %q", db.DriverName())
+	}
+	gateway := repository.NewAuditRepoGateway(repo, moduleLogger, tracer)
+	useCase := usecase.NewAuditUseCase(gateway, moduleLogger, tracer)
+	presenter := http.NewAuditPresenter()
+	controller := controller.NewAuditController(useCase, presenter, validator, moduleLogger, tracer)
+	router := router.NewAuditRouter(controller, rg)
+
+	// 創建並返回模組實例
+	return NewModule(router, useCase), nil
+}
@@ -0,0 +1,42 @@
+package audit
+
+import (
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/interface_adapter/router"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/usecase/port/input"
+)
+
+// Module 稽核模組 - 具體產品
+type Module struct {
+	router  *router.AuditRouter
+	useCase input.AuditInputPort
+}
+
+// NewModule 創建稽核模組實例
+func NewModule(router *router.AuditRouter, useCase input.AuditInputPort) *Module {
+	return &Module{
+		router:  router,
+		useCase: useCase,
+	}
+}
+
+// Name 實現 Module 接口
+func (m *Module) Name() string {
+	return "audit"
+}
+
+// Setup 實現 Module 接口
+func (m *Module) Setup() error {
+	// 純粹的委派，不做任何組裝邏輯
+	return m.router.Register()
+}
+
+// InputPort 提供其他模組寫入稽核紀錄用的 input port
+func (m *Module) InputPort() input.AuditInputPort {
+	return m.useCase
+}
+
+// Shutdown 實現 Module 接口
+func (m *Module) Shutdown() error {
+	// 未來可能需要的清理邏輯
+	return nil
+}
@@ -0,0 +1,72 @@
+package entity
+
+import "time"
+
+// RedactedValue 個資刪除後，changes 中對應欄位的 before/after 以此取代
+const RedactedValue = "[redacted]"
+
+// AuditEntry 一筆稽核紀錄，寫入後除了個資遮蔽（Redact）之外不可修改
+//   - Actor : 執行異動的人（auth subject 或 anonymous）
+//   - Action : 異動種類，例如 member.email_updated
+//   - TargetType/TargetID : 被異動的對象，例如 member / 1
+//   - Changes : 欄位層級的 before/after，敏感欄位已遮罩
+type AuditEntry struct {
+	ID         int
+	Actor      string
+	Action     string
+	TargetType string
+	TargetID   string
+	Changes    map[string]FieldChange
+	RequestID  string
+	TraceID    string
+	IP         string
+	CreatedAt  time.Time
+}
+
+// FieldChange 單一欄位的異動前後值，新增時 Before 為 nil、刪除時 After 為 nil
+type FieldChange struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+// Target 回傳 "type:id" 格式的對象識別，與查詢參數 target 相同
+func (e *AuditEntry) Target() string {
+	return e.TargetType + ":" + e.TargetID
+}
+
+// Validate 檢查稽核紀錄的必要欄位
+func (e *AuditEntry) Validate() error {
+	if e.Action == "" {
+		return ErrEmptyAction
+	}
+	if e.TargetType == "" || e.TargetID == "" {
+		return ErrEmptyTarget
+	}
+	return nil
+}
+
+// Redact 將指定欄位的 before/after 改為 RedactedValue，nil 值（新增/刪除的一側）維持 nil，
+// 來源 IP 同屬個資，一併清除；回傳是否有任何值被改寫，已遮蔽過的紀錄再次呼叫會回傳 false
+func (e *AuditEntry) Redact(fields ...string) bool {
+	changed := false
+	if e.IP != "" {
+		e.IP = ""
+		changed = true
+	}
+	for _, field := range fields {
+		change, ok := e.Changes[field]
+		if !ok {
+			continue
+		}
+		if change.Before != nil && change.Before != RedactedValue {
+			change.Before = RedactedValue
+			changed = true
+		}
+		if change.After != nil && change.After != RedactedValue {
+			change.After = RedactedValue
+			changed = true
+		}
+		e.Changes[field] = change
+	}
+	return changed
+}
@@ -0,0 +1,8 @@
+package entity
+
+import "errors"
+
+var (
+	ErrEmptyAction = errors.New("audit action is empty")
+	ErrEmptyTarget = errors.New("audit target is empty")
+)
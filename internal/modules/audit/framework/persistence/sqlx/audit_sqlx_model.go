@@ -0,0 +1,14 @@
+package sqlx
+
+type AuditSQLXModel struct {
+	ID         int    `db:"id"`
+	Actor      string `db:"actor"`
+	Action     string `db:"action"`
+	TargetType string `db:"target_type"`
+	TargetID   string `db:"target_id"`
+	Changes    string `db:"changes"`
+	RequestID  string `db:"request_id"`
+	TraceID    string `db:"trace_id"`
+	IP         string `db:"ip"`
+	CreatedAt  string `db:"created_at"`
+}
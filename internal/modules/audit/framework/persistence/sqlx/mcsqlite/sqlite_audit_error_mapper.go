@@ -0,0 +1,41 @@
+package mcsqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+)
+
+// mapSQLError 將常見的 SQL 錯誤轉換為結構化錯誤
+func mapSQLError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return wrap(err, ErrDBRecordNotFound)
+	}
+	if errors.Is(err, sql.ErrConnDone) {
+		return wrap(err, ErrDBConnectionClosed)
+	}
+	if errors.Is(err, ErrMapperTimeParseFailed) {
+		return wrap(err, ErrMapperTimeParseFailed)
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return wrap(err, ErrDBContextTimeout)
+	}
+	if errors.Is(err, context.Canceled) {
+		return wrap(err, ErrDBContextCanceled)
+	}
+	// mcsqlite 特有：append-only trigger 的 RAISE(ABORT, ...)
+	if strings.Contains(err.Error(), "append-only") {
+		return wrap(err, ErrDBAppendOnly)
+	}
+	return wrap(err, ErrDBUnexpectedError)
+}
+func wrap(rawErr, customErr error) *DBError {
+	return &DBError{
+		CustomError: customErr,
+		RawError:    rawErr,
+	}
+}
@@ -0,0 +1,34 @@
+package mcsqlite
+
+import "time"
+
+const (
+	queryInsertAudit = `INSERT INTO audit_logs (actor, action, target_type, target_id, changes, request_id, trace_id, ip, created_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	// 只允許改寫 changes 與 ip，其餘欄位由 audit_logs_no_update trigger 保護
+	queryUpdateAuditRedacted = `UPDATE audit_logs SET changes = ?, ip = ? WHERE id = ?`
+	querySelectAuditBase     = `SELECT * FROM audit_logs`
+	queryCountAuditBase      = `SELECT COUNT(*) FROM audit_logs`
+	// 稽核紀錄固定依時間新到舊排序，id 作為同秒內的次序
+	queryAuditOrderAndPage = ` ORDER BY created_at DESC, id DESC LIMIT ? OFFSET ?`
+)
+
+// sqliteTimeLayout 與 members.created_at 的 CURRENT_TIMESTAMP 格式一致（UTC）
+const sqliteTimeLayout = "2006-01-02 15:04:05"
+
+// sqliteReadTimeLayouts 讀取時接受的格式；go-sqlite3 會把 DATETIME 欄位轉成 time.Time，
+// 掃進 string 時變成 RFC3339，直接讀原始文字時則是 sqliteTimeLayout
+var sqliteReadTimeLayouts = []string{time.RFC3339Nano, sqliteTimeLayout}
+
+// parseSQLiteTime 依序嘗試可接受的格式，一律回傳 UTC
+func parseSQLiteTime(value string) (time.Time, error) {
+	var lastErr error
+	for _, layout := range sqliteReadTimeLayouts {
+		t, err := time.Parse(layout, value)
+		if err == nil {
+			return t.UTC(), nil
+		}
+		lastErr = err
+	}
+	return time.Time{}, lastErr
+}
@@ -0,0 +1,29 @@
+package mcsqlite
+
+import (
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/interface_adapter/dao"
+
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/framework/persistence/sqlx"
+)
+
+func sqlxModelToDTO(model *sqlx.AuditSQLXModel) (*dao.AuditRecord, error) {
+	if model == nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	createdAt, err := parseSQLiteTime(model.CreatedAt)
+	if err != nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	return &dao.AuditRecord{
+		ID:         model.ID,
+		Actor:      model.Actor,
+		Action:     model.Action,
+		TargetType: model.TargetType,
+		TargetID:   model.TargetID,
+		Changes:    model.Changes,
+		RequestID:  model.RequestID,
+		TraceID:    model.TraceID,
+		IP:         model.IP,
+		CreatedAt:  createdAt,
+	}, nil
+}
@@ -0,0 +1,43 @@
+package pgsql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// mapSQLError 將常見的 SQL 錯誤轉換為結構化錯誤；Postgres 的錯誤以 SQLSTATE 判斷，不比對訊息字串
+func mapSQLError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return wrap(err, ErrDBRecordNotFound)
+	}
+	if errors.Is(err, sql.ErrConnDone) {
+		return wrap(err, ErrDBConnectionClosed)
+	}
+	if errors.Is(err, ErrMapperTimeParseFailed) {
+		return wrap(err, ErrMapperTimeParseFailed)
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return wrap(err, ErrDBContextTimeout)
+	}
+	if errors.Is(err, context.Canceled) {
+		return wrap(err, ErrDBContextCanceled)
+	}
+	// pgsql 特有：append-only trigger 的 RAISE EXCEPTION（SQLSTATE P0001）
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.RaiseException {
+		return wrap(err, ErrDBAppendOnly)
+	}
+	return wrap(err, ErrDBUnexpectedError)
+}
+func wrap(rawErr, customErr error) *DBError {
+	return &DBError{
+		CustomError: customErr,
+		RawError:    rawErr,
+	}
+}
@@ -0,0 +1,12 @@
+package pgsql
+
+const (
+	queryInsertAudit = `INSERT INTO audit_logs (actor, action, target_type, target_id, changes, request_id, trace_id, ip, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+	// 只允許改寫 changes 與 ip，其餘欄位由 audit_logs_no_update trigger 保護
+	queryUpdateAuditRedacted = `UPDATE audit_logs SET changes = $1, ip = $2 WHERE id = $3`
+	querySelectAuditBase     = `SELECT * FROM audit_logs`
+	queryCountAuditBase      = `SELECT COUNT(*) FROM audit_logs`
+	// 稽核紀錄固定依時間新到舊排序，id 作為同秒內的次序；%s 為 LIMIT、OFFSET 的 placeholder
+	queryAuditOrderAndPage = ` ORDER BY created_at DESC, id DESC LIMIT %s OFFSET %s`
+)
@@ -0,0 +1,212 @@
+package pgsql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxtx"
+	sqlx2 "github.com/tomoffice/go-clean-architecture/internal/modules/audit/framework/persistence/sqlx"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/interface_adapter/dao"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// sqlxAuditPgsql 實作 dao.AuditDAO
+type sqlxAuditPgsql struct {
+	db     *sqlx.DB
+	logger logger.Logger
+	tracer tracer.Tracer
+}
+
+func NewSqlxAuditPgsql(db *sqlx.DB, log logger.Logger, tracer tracer.Tracer) dao.AuditDAO {
+	baseLogger := log.With(logger.NewField("layer", "repository"))
+	return &sqlxAuditPgsql{
+		db:     db,
+		logger: baseLogger,
+		tracer: tracer,
+	}
+}
+func (s sqlxAuditPgsql) Append(ctx context.Context, r *dao.AuditRecord) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.Append")
+	defer span.End()
+
+	startTime := time.Now()
+
+	_, err := s.executor(repoCtx).ExecContext(repoCtx, queryInsertAudit,
+		r.Actor, r.Action, r.TargetType, r.TargetID, r.Changes,
+		r.RequestID, r.TraceID, r.IP, r.CreatedAt.UTC(),
+	)
+	duration := time.Since(startTime)
+
+	if err != nil {
+		contextLogger.Error("SQL 稽核紀錄插入失敗",
+			logger.NewField("error", err),
+			logger.NewField("action", r.Action),
+			logger.NewField("duration_ms", duration.Milliseconds()),
+		)
+		return mapSQLError(err)
+	}
+
+	contextLogger.Debug("SQL 稽核紀錄插入成功",
+		logger.NewField("action", r.Action),
+		logger.NewField("duration_ms", duration.Milliseconds()),
+	)
+	return nil
+}
+func (s sqlxAuditPgsql) GetAll(ctx context.Context, q dao.AuditQuery, p pagination.Pagination) ([]*dao.AuditRecord, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.GetAll")
+	defer span.End()
+	startTime := time.Now()
+
+	args := &queryArgs{}
+	where := buildWhere(q, args)
+	query := querySelectAuditBase + where + fmt.Sprintf(queryAuditOrderAndPage, args.add(p.Limit), args.add(p.Offset))
+
+	models := make([]*sqlx2.AuditSQLXModel, 0)
+	err := s.executor(repoCtx).SelectContext(repoCtx, &models, query, args.values...)
+	duration := time.Since(startTime)
+
+	if err != nil {
+		contextLogger.Error("SQL 稽核紀錄列表查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("limit", p.Limit),
+			logger.NewField("offset", p.Offset),
+			logger.NewField("duration_ms", duration.Milliseconds()),
+		)
+		return nil, mapSQLError(err)
+	}
+	records := make([]*dao.AuditRecord, 0, len(models))
+	for _, model := range models {
+		record, err := sqlxModelToDTO(model)
+		if err != nil {
+			contextLogger.Error("SQL 稽核紀錄列表查詢 DTO 轉換失敗",
+				logger.NewField("error", err),
+				logger.NewField("audit_id", model.ID),
+			)
+			return nil, mapSQLError(err)
+		}
+		records = append(records, record)
+	}
+	contextLogger.Debug("SQL 稽核紀錄列表查詢成功",
+		logger.NewField("count", len(records)),
+		logger.NewField("duration_ms", duration.Milliseconds()),
+	)
+	return records, nil
+}
+func (s sqlxAuditPgsql) CountAll(ctx context.Context, q dao.AuditQuery) (int, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.CountAll")
+	defer span.End()
+	startTime := time.Now()
+
+	args := &queryArgs{}
+	where := buildWhere(q, args)
+	var count int
+	err := s.executor(repoCtx).GetContext(repoCtx, &count, queryCountAuditBase+where, args.values...)
+	duration := time.Since(startTime)
+
+	if err != nil {
+		contextLogger.Error("SQL 稽核紀錄總數查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("duration_ms", duration.Milliseconds()),
+		)
+		return 0, mapSQLError(err)
+	}
+
+	contextLogger.Debug("SQL 稽核紀錄總數查詢成功",
+		logger.NewField("count", count),
+		logger.NewField("duration_ms", duration.Milliseconds()),
+	)
+	return count, nil
+}
+
+func (s sqlxAuditPgsql) UpdateRedacted(ctx context.Context, id int, changes, ip string) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.UpdateRedacted")
+	defer span.End()
+	startTime := time.Now()
+
+	result, err := s.executor(repoCtx).ExecContext(repoCtx, queryUpdateAuditRedacted, changes, ip, id)
+	duration := time.Since(startTime)
+
+	if err != nil {
+		contextLogger.Error("SQL 稽核紀錄遮蔽更新失敗",
+			logger.NewField("error", err),
+			logger.NewField("audit_id", id),
+			logger.NewField("duration_ms", duration.Milliseconds()),
+		)
+		return mapSQLError(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		contextLogger.Error("SQL 稽核紀錄遮蔽更新影響筆數讀取失敗",
+			logger.NewField("error", err),
+			logger.NewField("audit_id", id),
+		)
+		return mapSQLError(err)
+	}
+	if affected == 0 {
+		contextLogger.Warn("SQL 稽核紀錄遮蔽更新查無資料",
+			logger.NewField("audit_id", id),
+		)
+		return wrap(sql.ErrNoRows, ErrDBRecordNotFound)
+	}
+
+	contextLogger.Debug("SQL 稽核紀錄遮蔽更新成功",
+		logger.NewField("audit_id", id),
+		logger.NewField("duration_ms", duration.Milliseconds()),
+	)
+	return nil
+}
+
+// buildWhere 依查詢條件組出 WHERE 子句，只使用 placeholder 帶入值
+func buildWhere(q dao.AuditQuery, args *queryArgs) string {
+	conditions := make([]string, 0, 5)
+	if q.TargetType != "" {
+		conditions = append(conditions, "target_type = "+args.add(q.TargetType))
+	}
+	if q.TargetID != "" {
+		conditions = append(conditions, "target_id = "+args.add(q.TargetID))
+	}
+	if q.Action != "" {
+		conditions = append(conditions, "action = "+args.add(q.Action))
+	}
+	if q.From != nil {
+		conditions = append(conditions, "created_at >= "+args.add(q.From.UTC()))
+	}
+	if q.To != nil {
+		conditions = append(conditions, "created_at <= "+args.add(q.To.UTC()))
+	}
+	if len(conditions) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(conditions, " AND ")
+}
+
+// queryArgs 依序收集查詢參數，add 回傳對應的 $n placeholder
+type queryArgs struct {
+	values []any
+}
+
+func (a *queryArgs) add(value any) string {
+	a.values = append(a.values, value)
+	return "$" + strconv.Itoa(len(a.values))
+}
+
+// executor 有交易時使用 context 中的交易，讓同一個 use case 的寫入具原子性
+func (s sqlxAuditPgsql) executor(ctx context.Context) sqlxtx.Executor {
+	return sqlxtx.ExecutorFromContext(ctx, s.db)
+}
+
+func createTracedLogger(ctx context.Context, tr tracer.Tracer, log logger.Logger, operationName string) (context.Context, logger.Logger, tracer.Span) {
+	repoCtx, span := tr.Start(ctx, operationName)
+	lg := log.WithContext(repoCtx)
+	return repoCtx, lg, span
+}
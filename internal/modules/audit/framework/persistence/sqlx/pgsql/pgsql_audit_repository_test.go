@@ -0,0 +1,67 @@
+package pgsql
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/interface_adapter/dao"
+)
+
+func TestBuildWhere(t *testing.T) {
+	from := time.Date(2025, 3, 1, 8, 0, 0, 0, time.FixedZone("CST", 8*60*60))
+	to := time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		query     dao.AuditQuery
+		wantWhere string
+		wantArgs  []any
+	}{
+		{
+			name:      "no filter",
+			query:     dao.AuditQuery{},
+			wantWhere: "",
+		},
+		{
+			name:      "placeholders are numbered in order",
+			query:     dao.AuditQuery{TargetType: "member", TargetID: "7", Action: "member.deleted", From: &from, To: &to},
+			wantWhere: " WHERE target_type = $1 AND target_id = $2 AND action = $3 AND created_at >= $4 AND created_at <= $5",
+			wantArgs:  []any{"member", "7", "member.deleted", from.UTC(), to},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := &queryArgs{}
+			where := buildWhere(tt.query, args)
+			assert.Equal(t, tt.wantWhere, where)
+			assert.Equal(t, tt.wantArgs, args.values)
+		})
+	}
+}
+
+func TestMapSQLError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{name: "no rows", err: sql.ErrNoRows, want: ErrDBRecordNotFound},
+		{name: "append-only trigger", err: &pgconn.PgError{Code: "P0001", Message: "audit_logs is append-only"}, want: ErrDBAppendOnly},
+		{name: "unknown sqlstate", err: &pgconn.PgError{Code: "42601"}, want: ErrDBUnexpectedError},
+		{name: "context timeout", err: context.DeadlineExceeded, want: ErrDBContextTimeout},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mapSQLError(tt.err)
+			assert.ErrorIs(t, got, tt.want)
+			var dbErr *DBError
+			if assert.ErrorAs(t, got, &dbErr) {
+				assert.Equal(t, tt.err, dbErr.RawError)
+			}
+		})
+	}
+	assert.NoError(t, mapSQLError(nil))
+}
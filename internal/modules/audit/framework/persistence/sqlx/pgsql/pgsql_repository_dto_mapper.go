@@ -0,0 +1,39 @@
+package pgsql
+
+import (
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/interface_adapter/dao"
+	"time"
+
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/framework/persistence/sqlx"
+)
+
+func sqlxModelToDTO(model *sqlx.AuditSQLXModel) (*dao.AuditRecord, error) {
+	if model == nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	createdAt, err := parsePgTime(model.CreatedAt)
+	if err != nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	return &dao.AuditRecord{
+		ID:         model.ID,
+		Actor:      model.Actor,
+		Action:     model.Action,
+		TargetType: model.TargetType,
+		TargetID:   model.TargetID,
+		Changes:    model.Changes,
+		RequestID:  model.RequestID,
+		TraceID:    model.TraceID,
+		IP:         model.IP,
+		CreatedAt:  createdAt,
+	}, nil
+}
+
+// parsePgTime pgx 將 TIMESTAMPTZ 讀成 time.Time，database/sql 掃進 string 時格式為 RFC3339Nano，一律回傳 UTC
+func parsePgTime(value string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.UTC(), nil
+}
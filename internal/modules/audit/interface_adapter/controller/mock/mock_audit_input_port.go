@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: audit_input_port.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/tomoffice/go-clean-architecture/internal/modules/audit/entity"
+	inputmodel "github.com/tomoffice/go-clean-architecture/internal/modules/audit/usecase/inputmodel"
+	pagination "github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+)
+
+// MockAuditInputPort is a mock of AuditInputPort interface.
+type MockAuditInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditInputPortMockRecorder
+}
+
+// MockAuditInputPortMockRecorder is the mock recorder for MockAuditInputPort.
+type MockAuditInputPortMockRecorder struct {
+	mock *MockAuditInputPort
+}
+
+// NewMockAuditInputPort creates a new mock instance.
+func NewMockAuditInputPort(ctrl *gomock.Controller) *MockAuditInputPort {
+	mock := &MockAuditInputPort{ctrl: ctrl}
+	mock.recorder = &MockAuditInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditInputPort) EXPECT() *MockAuditInputPortMockRecorder {
+	return m.recorder
+}
+
+// ListEntries mocks base method.
+func (m *MockAuditInputPort) ListEntries(ctx context.Context, filter *inputmodel.ListAuditEntriesInputModel, pagination pagination.Pagination) ([]*entity.AuditEntry, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntries", ctx, filter, pagination)
+	ret0, _ := ret[0].([]*entity.AuditEntry)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListEntries indicates an expected call of ListEntries.
+func (mr *MockAuditInputPortMockRecorder) ListEntries(ctx, filter, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntries", reflect.TypeOf((*MockAuditInputPort)(nil).ListEntries), ctx, filter, pagination)
+}
+
+// RecordEntry mocks base method.
+func (m *MockAuditInputPort) RecordEntry(ctx context.Context, entry *entity.AuditEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordEntry", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordEntry indicates an expected call of RecordEntry.
+func (mr *MockAuditInputPortMockRecorder) RecordEntry(ctx, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordEntry", reflect.TypeOf((*MockAuditInputPort)(nil).RecordEntry), ctx, entry)
+}
+
+// RedactTarget mocks base method.
+func (m *MockAuditInputPort) RedactTarget(ctx context.Context, input *inputmodel.RedactAuditTargetInputModel) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedactTarget", ctx, input)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RedactTarget indicates an expected call of RedactTarget.
+func (mr *MockAuditInputPortMockRecorder) RedactTarget(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedactTarget", reflect.TypeOf((*MockAuditInputPort)(nil).RedactTarget), ctx, input)
+}
@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: audit_presenter.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/tomoffice/go-clean-architecture/internal/modules/audit/entity"
+	outputmodel "github.com/tomoffice/go-clean-architecture/internal/modules/audit/interface_adapter/outputmodel"
+	pagination "github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+)
+
+// MockAuditPresenter is a mock of AuditPresenter interface.
+type MockAuditPresenter struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditPresenterMockRecorder
+}
+
+// MockAuditPresenterMockRecorder is the mock recorder for MockAuditPresenter.
+type MockAuditPresenterMockRecorder struct {
+	mock *MockAuditPresenter
+}
+
+// NewMockAuditPresenter creates a new mock instance.
+func NewMockAuditPresenter(ctrl *gomock.Controller) *MockAuditPresenter {
+	mock := &MockAuditPresenter{ctrl: ctrl}
+	mock.recorder = &MockAuditPresenterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditPresenter) EXPECT() *MockAuditPresenterMockRecorder {
+	return m.recorder
+}
+
+// PresentBindingError mocks base method.
+func (m *MockAuditPresenter) PresentBindingError(errCode int, message string) outputmodel.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentBindingError", errCode, message)
+	ret0, _ := ret[0].(outputmodel.ErrorResponse)
+	return ret0
+}
+
+// PresentBindingError indicates an expected call of PresentBindingError.
+func (mr *MockAuditPresenterMockRecorder) PresentBindingError(errCode, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentBindingError", reflect.TypeOf((*MockAuditPresenter)(nil).PresentBindingError), errCode, message)
+}
+
+// PresentListAuditEntries mocks base method.
+func (m *MockAuditPresenter) PresentListAuditEntries(entries []*entity.AuditEntry, pagination pagination.Pagination, total int) outputmodel.ListAuditEntriesResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentListAuditEntries", entries, pagination, total)
+	ret0, _ := ret[0].(outputmodel.ListAuditEntriesResponse)
+	return ret0
+}
+
+// PresentListAuditEntries indicates an expected call of PresentListAuditEntries.
+func (mr *MockAuditPresenterMockRecorder) PresentListAuditEntries(entries, pagination, total interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentListAuditEntries", reflect.TypeOf((*MockAuditPresenter)(nil).PresentListAuditEntries), entries, pagination, total)
+}
+
+// PresentUseCaseError mocks base method.
+func (m *MockAuditPresenter) PresentUseCaseError(err error) (int, outputmodel.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentUseCaseError", err)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(outputmodel.ErrorResponse)
+	return ret0, ret1
+}
+
+// PresentUseCaseError indicates an expected call of PresentUseCaseError.
+func (mr *MockAuditPresenterMockRecorder) PresentUseCaseError(err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentUseCaseError", reflect.TypeOf((*MockAuditPresenter)(nil).PresentUseCaseError), err)
+}
+
+// PresentValidationError mocks base method.
+func (m *MockAuditPresenter) PresentValidationError(err error) (int, outputmodel.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentValidationError", err)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(outputmodel.ErrorResponse)
+	return ret0, ret1
+}
+
+// PresentValidationError indicates an expected call of PresentValidationError.
+func (mr *MockAuditPresenterMockRecorder) PresentValidationError(err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentValidationError", reflect.TypeOf((*MockAuditPresenter)(nil).PresentValidationError), err)
+}
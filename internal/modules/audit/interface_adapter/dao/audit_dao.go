@@ -0,0 +1,39 @@
+package dao
+
+//go:generate mockgen -source=audit_dao.go -destination=../../interface_adapter/gateway/mock/mock_audit_dao.go -package=mock
+
+import (
+	"context"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"time"
+)
+
+type AuditRecord struct {
+	ID         int
+	Actor      string
+	Action     string
+	TargetType string
+	TargetID   string
+	Changes    string // JSON 格式的欄位異動
+	RequestID  string
+	TraceID    string
+	IP         string
+	CreatedAt  time.Time
+}
+
+// AuditQuery 查詢條件，零值欄位表示不過濾
+type AuditQuery struct {
+	TargetType string
+	TargetID   string
+	Action     string
+	From       *time.Time
+	To         *time.Time
+}
+
+type AuditDAO interface {
+	Append(ctx context.Context, r *AuditRecord) error
+	GetAll(ctx context.Context, q AuditQuery, p pagination.Pagination) ([]*AuditRecord, error)
+	CountAll(ctx context.Context, q AuditQuery) (int, error)
+	// UpdateRedacted 只改寫 changes 與 ip 欄位，供個資遮蔽使用
+	UpdateRedacted(ctx context.Context, id int, changes, ip string) error
+}
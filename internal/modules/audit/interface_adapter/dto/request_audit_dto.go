@@ -0,0 +1,14 @@
+// Package dto 定義稽核模組的資料傳輸物件，負責接收外部查詢參數並以 validator 驗證格式。
+package dto
+
+// ListAuditEntriesRequestDTO 查詢稽核紀錄
+//   - Target 格式為 type:id，例如 member:1
+//   - From/To 為 RFC3339 時間
+type ListAuditEntriesRequestDTO struct {
+	Page   int    `validate:"required,min=1"`
+	Limit  int    `validate:"required,min=1,max=100"`
+	Target string `validate:"omitempty,audit_target"`
+	Action string `validate:"omitempty,max=64"`
+	From   string `validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	To     string `validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+}
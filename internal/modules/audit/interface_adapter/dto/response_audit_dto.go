@@ -0,0 +1,20 @@
+package dto
+
+type AuditFieldChangeDTO struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+type AuditEntryItemDTO struct {
+	ID        int                            `json:"id"`
+	Actor     string                         `json:"actor"`
+	Action    string                         `json:"action"`
+	Target    string                         `json:"target"`
+	Changes   map[string]AuditFieldChangeDTO `json:"changes"`
+	RequestID string                         `json:"request_id"`
+	TraceID   string                         `json:"trace_id"`
+	IP        string                         `json:"ip"`
+	CreatedAt string                         `json:"created_at"`
+}
+type ListAuditEntriesResponseDTO struct {
+	Entries []AuditEntryItemDTO `json:"entries"`
+}
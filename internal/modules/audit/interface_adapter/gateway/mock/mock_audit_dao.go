@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: audit_dao.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	dao "github.com/tomoffice/go-clean-architecture/internal/modules/audit/interface_adapter/dao"
+	pagination "github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+)
+
+// MockAuditDAO is a mock of AuditDAO interface.
+type MockAuditDAO struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditDAOMockRecorder
+}
+
+// MockAuditDAOMockRecorder is the mock recorder for MockAuditDAO.
+type MockAuditDAOMockRecorder struct {
+	mock *MockAuditDAO
+}
+
+// NewMockAuditDAO creates a new mock instance.
+func NewMockAuditDAO(ctrl *gomock.Controller) *MockAuditDAO {
+	mock := &MockAuditDAO{ctrl: ctrl}
+	mock.recorder = &MockAuditDAOMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditDAO) EXPECT() *MockAuditDAOMockRecorder {
+	return m.recorder
+}
+
+// Append mocks base method.
+func (m *MockAuditDAO) Append(ctx context.Context, r *dao.AuditRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Append", ctx, r)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Append indicates an expected call of Append.
+func (mr *MockAuditDAOMockRecorder) Append(ctx, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockAuditDAO)(nil).Append), ctx, r)
+}
+
+// CountAll mocks base method.
+func (m *MockAuditDAO) CountAll(ctx context.Context, q dao.AuditQuery) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAll", ctx, q)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAll indicates an expected call of CountAll.
+func (mr *MockAuditDAOMockRecorder) CountAll(ctx, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAll", reflect.TypeOf((*MockAuditDAO)(nil).CountAll), ctx, q)
+}
+
+// GetAll mocks base method.
+func (m *MockAuditDAO) GetAll(ctx context.Context, q dao.AuditQuery, p pagination.Pagination) ([]*dao.AuditRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, q, p)
+	ret0, _ := ret[0].([]*dao.AuditRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockAuditDAOMockRecorder) GetAll(ctx, q, p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockAuditDAO)(nil).GetAll), ctx, q, p)
+}
+
+// UpdateRedacted mocks base method.
+func (m *MockAuditDAO) UpdateRedacted(ctx context.Context, id int, changes, ip string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRedacted", ctx, id, changes, ip)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRedacted indicates an expected call of UpdateRedacted.
+func (mr *MockAuditDAOMockRecorder) UpdateRedacted(ctx, id, changes, ip interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRedacted", reflect.TypeOf((*MockAuditDAO)(nil).UpdateRedacted), ctx, id, changes, ip)
+}
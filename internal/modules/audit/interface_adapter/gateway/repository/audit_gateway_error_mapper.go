@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/framework/persistence/sqlx/mcsqlite"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/usecase"
+)
+
+// MapInfraErrorToUsecaseError 將底層 infra 錯誤直接轉為 usecase 定義的 sentinel error
+func MapInfraErrorToUsecaseError(err error) error {
+	if err == nil {
+		return nil
+	}
+	// 先處理 gateway 層的業務語意錯誤
+	if errors.Is(err, ErrGatewayAuditMappingError) || errors.Is(err, mcsqlite.ErrMapperTimeParseFailed) {
+		return usecase.ErrAuditMappingError
+	}
+	// 再處理 DBError 類型
+	var dbErr *mcsqlite.DBError
+	if errors.As(err, &dbErr) {
+		return fmt.Errorf("%w: %v", usecase.ErrAuditDBError, dbErr.RawError)
+	}
+	// fallback：其他未知錯誤
+	return usecase.ErrAuditUnexpectedError
+}
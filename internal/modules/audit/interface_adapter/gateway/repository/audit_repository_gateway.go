@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/interface_adapter/dao"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/usecase/inputmodel"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/usecase/port/output"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
+)
+
+type AuditRepoGateway struct {
+	dao    dao.AuditDAO
+	logger logger.Logger
+	tracer tracer.Tracer
+}
+
+func NewAuditRepoGateway(dao dao.AuditDAO, log logger.Logger, tracer tracer.Tracer) output.AuditPersistence {
+	baseLogger := log.With(logger.NewField("layer", "gateway"))
+	return AuditRepoGateway{
+		dao:    dao,
+		logger: baseLogger,
+		tracer: tracer,
+	}
+}
+
+func (g AuditRepoGateway) Append(ctx context.Context, e *entity.AuditEntry) error {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.Append")
+	defer span.End()
+
+	changes, err := json.Marshal(e.Changes)
+	if err != nil {
+		traceLogger.Error("稽核紀錄 changes 編碼失敗", logger.NewField("error", err), logger.NewField("action", e.Action))
+		return MapInfraErrorToUsecaseError(ErrGatewayAuditMappingError)
+	}
+	record := &dao.AuditRecord{
+		Actor:      e.Actor,
+		Action:     e.Action,
+		TargetType: e.TargetType,
+		TargetID:   e.TargetID,
+		Changes:    string(changes),
+		RequestID:  e.RequestID,
+		TraceID:    e.TraceID,
+		IP:         e.IP,
+		CreatedAt:  e.CreatedAt,
+	}
+	if err := g.dao.Append(gatewayCtx, record); err != nil {
+		traceLogger.Error("稽核紀錄資料庫寫入失敗", logger.NewField("error", err), logger.NewField("action", e.Action))
+		return MapInfraErrorToUsecaseError(err)
+	}
+	traceLogger.Debug("稽核紀錄資料庫寫入成功", logger.NewField("action", e.Action), logger.NewField("target", e.Target()))
+	return nil
+}
+
+func (g AuditRepoGateway) GetAll(ctx context.Context, filter *inputmodel.ListAuditEntriesInputModel, pagination pagination.Pagination) ([]*entity.AuditEntry, error) {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.GetAll")
+	defer span.End()
+
+	records, err := g.dao.GetAll(gatewayCtx, toAuditQuery(filter), pagination)
+	if err != nil {
+		traceLogger.Error("稽核紀錄資料庫列表查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("limit", pagination.Limit),
+			logger.NewField("offset", pagination.Offset),
+		)
+		return nil, MapInfraErrorToUsecaseError(err)
+	}
+	entries := make([]*entity.AuditEntry, 0, len(records))
+	for _, record := range records {
+		changes := map[string]entity.FieldChange{}
+		if err := json.Unmarshal([]byte(record.Changes), &changes); err != nil {
+			traceLogger.Error("稽核紀錄 changes 解碼失敗",
+				logger.NewField("error", err),
+				logger.NewField("audit_id", record.ID),
+			)
+			return nil, MapInfraErrorToUsecaseError(ErrGatewayAuditMappingError)
+		}
+		entries = append(entries, &entity.AuditEntry{
+			ID:         record.ID,
+			Actor:      record.Actor,
+			Action:     record.Action,
+			TargetType: record.TargetType,
+			TargetID:   record.TargetID,
+			Changes:    changes,
+			RequestID:  record.RequestID,
+			TraceID:    record.TraceID,
+			IP:         record.IP,
+			CreatedAt:  record.CreatedAt,
+		})
+	}
+	traceLogger.Debug("稽核紀錄資料庫列表查詢成功",
+		logger.NewField("count", len(entries)),
+	)
+	return entries, nil
+}
+
+func (g AuditRepoGateway) CountAll(ctx context.Context, filter *inputmodel.ListAuditEntriesInputModel) (int, error) {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.CountAll")
+	defer span.End()
+
+	count, err := g.dao.CountAll(gatewayCtx, toAuditQuery(filter))
+	if err != nil {
+		traceLogger.Error("稽核紀錄資料庫總數查詢失敗",
+			logger.NewField("error", err),
+		)
+		return 0, MapInfraErrorToUsecaseError(err)
+	}
+
+	traceLogger.Debug("稽核紀錄資料庫總數查詢成功",
+		logger.NewField("count", count),
+	)
+	return count, nil
+}
+
+func (g AuditRepoGateway) ReplaceRedacted(ctx context.Context, e *entity.AuditEntry) error {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.ReplaceRedacted")
+	defer span.End()
+
+	changes, err := json.Marshal(e.Changes)
+	if err != nil {
+		traceLogger.Error("稽核紀錄 changes 編碼失敗", logger.NewField("error", err), logger.NewField("audit_id", e.ID))
+		return MapInfraErrorToUsecaseError(ErrGatewayAuditMappingError)
+	}
+	if err := g.dao.UpdateRedacted(gatewayCtx, e.ID, string(changes), e.IP); err != nil {
+		traceLogger.Error("稽核紀錄遮蔽資料庫改寫失敗", logger.NewField("error", err), logger.NewField("audit_id", e.ID))
+		return MapInfraErrorToUsecaseError(err)
+	}
+	traceLogger.Debug("稽核紀錄遮蔽資料庫改寫成功", logger.NewField("audit_id", e.ID))
+	return nil
+}
+
+func toAuditQuery(filter *inputmodel.ListAuditEntriesInputModel) dao.AuditQuery {
+	if filter == nil {
+		return dao.AuditQuery{}
+	}
+	return dao.AuditQuery{
+		TargetType: filter.TargetType,
+		TargetID:   filter.TargetID,
+		Action:     filter.Action,
+		From:       filter.From,
+		To:         filter.To,
+	}
+}
+
+// createTraceLogger 在 Gateway 層建立帶 Trace 的 Logger
+func createTraceLogger(ctx context.Context, tr tracer.Tracer, log logger.Logger, operationName string) (context.Context, logger.Logger, tracer.Span) {
+	gatewayCtx, span := tr.Start(ctx, operationName)
+	lg := log.WithContext(gatewayCtx)
+	return gatewayCtx, lg, span
+}
@@ -0,0 +1,11 @@
+package repository
+
+import "errors"
+
+// 這裡定義的是 gateway 層會往 usecase 丟的錯誤型別，維護時常用 errors.Is 來判斷。
+
+var (
+	// ------- gateway 內部業務語意 -------
+	// ErrGatewayAuditMappingError changes 欄位 JSON 編碼/解碼失敗，或 repo model 轉 entity 失敗。
+	ErrGatewayAuditMappingError = errors.New("gateway: mapping audit repo model to entity failed")
+)
@@ -0,0 +1,37 @@
+// Package mapper 負責稽核模組 DTO 與 usecase 輸入、entity 與回應 DTO 之間的轉換。
+package mapper
+
+import (
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/interface_adapter/dto"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/usecase/inputmodel"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/enum"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"strings"
+	"time"
+)
+
+// ListAuditEntriesDTOToInputModel DTO 已通過驗證，這裡只做欄位拆解與時間轉換
+func ListAuditEntriesDTOToInputModel(request dto.ListAuditEntriesRequestDTO) *inputmodel.ListAuditEntriesInputModel {
+	input := &inputmodel.ListAuditEntriesInputModel{
+		Action: request.Action,
+	}
+	if targetType, targetID, ok := strings.Cut(request.Target, ":"); ok {
+		input.TargetType = targetType
+		input.TargetID = targetID
+	}
+	if from, err := time.Parse(time.RFC3339, request.From); err == nil {
+		input.From = &from
+	}
+	if to, err := time.Parse(time.RFC3339, request.To); err == nil {
+		input.To = &to
+	}
+	return input
+}
+func ListAuditEntriesDTOToPagination(request dto.ListAuditEntriesRequestDTO) *pagination.Pagination {
+	return &pagination.Pagination{
+		Limit:   request.Limit,
+		Offset:  (request.Page - 1) * request.Limit,
+		SortBy:  "created_at",
+		OrderBy: enum.OrderByDesc,
+	}
+}
@@ -0,0 +1,34 @@
+package mapper
+
+import (
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/interface_adapter/dto"
+	"time"
+)
+
+func EntityToListAuditEntriesResponseDTO(entries []*entity.AuditEntry) dto.ListAuditEntriesResponseDTO {
+	items := make([]dto.AuditEntryItemDTO, len(entries))
+	for i, e := range entries {
+		changes := make(map[string]dto.AuditFieldChangeDTO, len(e.Changes))
+		for field, change := range e.Changes {
+			changes[field] = dto.AuditFieldChangeDTO{
+				Before: change.Before,
+				After:  change.After,
+			}
+		}
+		items[i] = dto.AuditEntryItemDTO{
+			ID:        e.ID,
+			Actor:     e.Actor,
+			Action:    e.Action,
+			Target:    e.Target(),
+			Changes:   changes,
+			RequestID: e.RequestID,
+			TraceID:   e.TraceID,
+			IP:        e.IP,
+			CreatedAt: e.CreatedAt.Format(time.RFC3339),
+		}
+	}
+	return dto.ListAuditEntriesResponseDTO{
+		Entries: items,
+	}
+}
@@ -0,0 +1,12 @@
+package outputmodel
+
+import (
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/interface_adapter/dto"
+	sharedviewmodel "github.com/tomoffice/go-clean-architecture/internal/shared/viewmodel/http"
+)
+
+// mockgen 尚未支援泛型，所以用別名展開所有泛型返回類型
+type ListAuditEntriesResponse = sharedviewmodel.HTTPResponse[dto.ListAuditEntriesResponseDTO]
+
+// 為 any 的情況也必須別名化
+type ErrorResponse = sharedviewmodel.HTTPResponse[any]
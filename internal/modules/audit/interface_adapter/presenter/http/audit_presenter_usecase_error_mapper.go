@@ -0,0 +1,27 @@
+package http
+
+import (
+	"errors"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/usecase"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/errorcode"
+	sharederrors "github.com/tomoffice/go-clean-architecture/internal/shared/errordefs"
+)
+
+func MapAuditUseCaseToPresenterError(err error) (int, string) {
+	switch {
+	case errors.Is(err, usecase.ErrAuditInvalidTimeRange):
+		return errorcode.ErrAuditInvalidTimeRange, usecase.ErrAuditInvalidTimeRange.Error()
+	case errors.Is(err, usecase.ErrAuditInvalidEntry):
+		return errorcode.ErrAuditInvalidEntry, usecase.ErrAuditInvalidEntry.Error()
+	case errors.Is(err, usecase.ErrAuditInvalidRedaction):
+		return errorcode.ErrAuditInvalidRedaction, usecase.ErrAuditInvalidRedaction.Error()
+	case errors.Is(err, usecase.ErrAuditDBError):
+		return errorcode.ErrAuditDBError, usecase.ErrAuditDBError.Error()
+	case errors.Is(err, usecase.ErrAuditMappingError):
+		return errorcode.ErrAuditMappingError, usecase.ErrAuditMappingError.Error()
+	case errors.Is(err, usecase.ErrAuditUnexpectedError):
+		return errorcode.ErrUnexpectedAuditUseCaseError, usecase.ErrAuditUnexpectedError.Error()
+	default:
+		return errorcode.ErrInternalServer, sharederrors.ErrInternalServer.Error()
+	}
+}
@@ -0,0 +1,26 @@
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	ginadapter "github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/adapter"
+	memberhttp "github.com/tomoffice/go-clean-architecture/internal/interface_adapter/transport/http"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/interface_adapter/controller"
+)
+
+type AuditRouter struct {
+	controller *controller.AuditController
+	router     memberhttp.Router
+}
+
+func NewAuditRouter(ctrl *controller.AuditController, routerGroup *gin.RouterGroup) *AuditRouter {
+	moduleGroup := routerGroup.Group("/audit")
+	return &AuditRouter{
+		controller: ctrl,
+		router:     ginadapter.NewRouter(moduleGroup),
+	}
+}
+
+func (r *AuditRouter) Register() error {
+	r.router.GET("", r.controller.List)
+	return nil
+}
@@ -0,0 +1,30 @@
+package validation
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/interface_adapter/dto"
+	"regexp"
+)
+
+// auditTargetPattern target 查詢參數格式 type:id，例如 member:1
+var auditTargetPattern = regexp.MustCompile(`^[a-z_]+:[A-Za-z0-9_-]+$`)
+
+type AuditValidator struct {
+	validator *validator.Validate
+}
+
+func NewAuditValidator() *AuditValidator {
+	v := validator.New()
+	_ = v.RegisterValidation("audit_target", func(fl validator.FieldLevel) bool {
+		return auditTargetPattern.MatchString(fl.Field().String())
+	})
+	return &AuditValidator{
+		validator: v,
+	}
+}
+func (v *AuditValidator) ValidateListAuditEntries(dto dto.ListAuditEntriesRequestDTO) error {
+	if err := v.validator.Struct(dto); err != nil {
+		return err
+	}
+	return nil
+}
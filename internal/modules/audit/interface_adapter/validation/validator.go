@@ -0,0 +1,8 @@
+package validation
+
+//go:generate mockgen -source=validator.go -destination=../../interface_adapter/controller/mock/mock_validator.go -package=mock
+import "github.com/tomoffice/go-clean-architecture/internal/modules/audit/interface_adapter/dto"
+
+type Validator interface {
+	ValidateListAuditEntries(dto.ListAuditEntriesRequestDTO) error
+}
@@ -0,0 +1,173 @@
+// Package usecase 定義稽核模組的應用層業務邏輯，負責寫入與查詢稽核紀錄。
+//
+// 職責:
+// - 檢查稽核紀錄必要欄位並補上預設值（actor、時間）
+// - 查詢時檢查時間區間並回傳分頁結果
+// - 個資刪除時遮蔽指定對象的 changes 欄位
+// - 不依賴外部框架（如 HTTP、DB）
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/usecase/inputmodel"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/usecase/port/input"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/usecase/port/output"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/requestmeta"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
+	"time"
+)
+
+type AuditUseCase struct {
+	AuditGateway output.AuditPersistence
+	logger       logger.Logger
+	tracer       tracer.Tracer
+	now          func() time.Time
+}
+
+func NewAuditUseCase(auditRepo output.AuditPersistence, log logger.Logger, tracer tracer.Tracer) input.AuditInputPort {
+	baseLogger := log.With(logger.NewField("layer", "usecase"))
+	return &AuditUseCase{
+		AuditGateway: auditRepo,
+		logger:       baseLogger,
+		tracer:       tracer,
+		now:          time.Now,
+	}
+}
+
+func (a *AuditUseCase) RecordEntry(ctx context.Context, entry *entity.AuditEntry) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, a.tracer, a.logger)
+	defer span.End()
+
+	if err := entry.Validate(); err != nil {
+		contextLogger.Error("稽核紀錄欄位不完整",
+			logger.NewField("error", err),
+			logger.NewField("action", entry.Action),
+			logger.NewField("target", entry.Target()),
+		)
+		return errors.Join(ErrAuditInvalidEntry, err)
+	}
+	if entry.Actor == "" {
+		entry.Actor = requestmeta.AnonymousActor
+	}
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = a.now().UTC()
+	}
+
+	if err := a.AuditGateway.Append(transCtx, entry); err != nil {
+		contextLogger.Error("稽核紀錄寫入 Gateway 執行失敗",
+			logger.NewField("error", err),
+			logger.NewField("action", entry.Action),
+			logger.NewField("target", entry.Target()),
+		)
+		return err
+	}
+
+	contextLogger.Debug("稽核紀錄寫入成功",
+		logger.NewField("actor", entry.Actor),
+		logger.NewField("action", entry.Action),
+		logger.NewField("target", entry.Target()),
+	)
+	return nil
+}
+
+func (a *AuditUseCase) ListEntries(ctx context.Context, filter *inputmodel.ListAuditEntriesInputModel, pagination pagination.Pagination) ([]*entity.AuditEntry, int, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, a.tracer, a.logger)
+	defer span.End()
+
+	if filter.From != nil && filter.To != nil && filter.From.After(*filter.To) {
+		contextLogger.Error("稽核紀錄查詢區間錯誤",
+			logger.NewField("from", filter.From),
+			logger.NewField("to", filter.To),
+		)
+		return nil, 0, ErrAuditInvalidTimeRange
+	}
+
+	entries, err := a.AuditGateway.GetAll(transCtx, filter, pagination)
+	if err != nil {
+		contextLogger.Error("稽核紀錄列表查詢 Gateway 執行失敗",
+			logger.NewField("error", err),
+			logger.NewField("limit", pagination.Limit),
+			logger.NewField("offset", pagination.Offset),
+		)
+		return nil, 0, err
+	}
+	total, err := a.AuditGateway.CountAll(transCtx, filter)
+	if err != nil {
+		contextLogger.Error("稽核紀錄總數查詢 Gateway 執行失敗",
+			logger.NewField("error", err),
+		)
+		return nil, 0, err
+	}
+
+	contextLogger.Debug("稽核紀錄列表查詢成功",
+		logger.NewField("count", len(entries)),
+		logger.NewField("total", total),
+	)
+	return entries, total, nil
+}
+
+// redactPageSize 遮蔽個資時每次讀取的稽核紀錄筆數
+const redactPageSize = 100
+
+func (a *AuditUseCase) RedactTarget(ctx context.Context, input *inputmodel.RedactAuditTargetInputModel) (int, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, a.tracer, a.logger)
+	defer span.End()
+
+	if input.TargetType == "" || input.TargetID == "" || len(input.Fields) == 0 {
+		contextLogger.Error("稽核紀錄遮蔽條件不完整",
+			logger.NewField("target_type", input.TargetType),
+			logger.NewField("target_id", input.TargetID),
+			logger.NewField("fields", input.Fields),
+		)
+		return 0, ErrAuditInvalidRedaction
+	}
+
+	filter := &inputmodel.ListAuditEntriesInputModel{TargetType: input.TargetType, TargetID: input.TargetID}
+	redacted := 0
+	// 只改寫 changes 與 ip，不影響排序與筆數，offset 分頁在迴圈中保持穩定
+	for offset := 0; ; offset += redactPageSize {
+		entries, err := a.AuditGateway.GetAll(transCtx, filter, pagination.Pagination{Limit: redactPageSize, Offset: offset})
+		if err != nil {
+			contextLogger.Error("稽核紀錄遮蔽查詢 Gateway 執行失敗",
+				logger.NewField("error", err),
+				logger.NewField("offset", offset),
+			)
+			return redacted, err
+		}
+		for _, entry := range entries {
+			if !entry.Redact(input.Fields...) {
+				continue
+			}
+			if err := a.AuditGateway.ReplaceRedacted(transCtx, entry); err != nil {
+				contextLogger.Error("稽核紀錄遮蔽 Gateway 執行失敗",
+					logger.NewField("error", err),
+					logger.NewField("audit_id", entry.ID),
+				)
+				return redacted, err
+			}
+			redacted++
+		}
+		if len(entries) < redactPageSize {
+			break
+		}
+	}
+
+	contextLogger.Info("稽核紀錄個資遮蔽完成",
+		logger.NewField("target", input.TargetType+":"+input.TargetID),
+		logger.NewField("redacted", redacted),
+	)
+	return redacted, nil
+}
+
+func createTracedLogger(ctx context.Context, tr tracer.Tracer, log logger.Logger) (context.Context, logger.Logger, tracer.Span) {
+	transCtx, span := tr.Start(ctx, "")
+	lg := log.WithContext(transCtx)
+	return transCtx, lg, span
+}
@@ -0,0 +1,284 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/usecase/inputmodel"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/usecase/mock"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/requestmeta"
+	mocklogger "github.com/tomoffice/go-clean-architecture/pkg/logger/mock"
+	mocktracer "github.com/tomoffice/go-clean-architecture/pkg/tracer/mock"
+	"testing"
+	"time"
+)
+
+func TestAuditUseCase_RecordEntry(t *testing.T) {
+	ctrl, ctx, testTime, mockLogger, mockTracer := repoHelper(t)
+	tests := []struct {
+		name      string
+		entry     *entity.AuditEntry
+		repoSetup func(*mock.MockAuditPersistence)
+		want      *entity.AuditEntry
+		wantErr   error
+	}{
+		{
+			name:  "normal test fills actor and created_at",
+			entry: &entity.AuditEntry{Action: "member.deleted", TargetType: "member", TargetID: "1"},
+			repoSetup: func(r *mock.MockAuditPersistence) {
+				r.EXPECT().Append(ctx, gomock.Any()).Return(nil)
+			},
+			want: &entity.AuditEntry{
+				Actor:      requestmeta.AnonymousActor,
+				Action:     "member.deleted",
+				TargetType: "member",
+				TargetID:   "1",
+				CreatedAt:  testTime,
+			},
+		},
+		{
+			name:      "missing action",
+			entry:     &entity.AuditEntry{TargetType: "member", TargetID: "1"},
+			repoSetup: func(r *mock.MockAuditPersistence) {},
+			wantErr:   ErrAuditInvalidEntry,
+		},
+		{
+			name:      "missing target",
+			entry:     &entity.AuditEntry{Action: "member.deleted"},
+			repoSetup: func(r *mock.MockAuditPersistence) {},
+			wantErr:   ErrAuditInvalidEntry,
+		},
+		{
+			name:  "append error",
+			entry: &entity.AuditEntry{Actor: "7", Action: "member.deleted", TargetType: "member", TargetID: "1"},
+			repoSetup: func(r *mock.MockAuditPersistence) {
+				r.EXPECT().Append(ctx, gomock.Any()).Return(ErrAuditDBError)
+			},
+			wantErr: ErrAuditDBError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockAuditPersistence(ctrl)
+			a := &AuditUseCase{
+				AuditGateway: mockRepo,
+				logger:       mockLogger,
+				tracer:       mockTracer,
+				now:          func() time.Time { return testTime },
+			}
+			tt.repoSetup(mockRepo)
+			err := a.RecordEntry(ctx, tt.entry)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("RecordEntry() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.want != nil {
+				assert.Equal(t, tt.want, tt.entry)
+			}
+		})
+	}
+}
+
+func TestAuditUseCase_ListEntries(t *testing.T) {
+	ctrl, ctx, testTime, mockLogger, mockTracer := repoHelper(t)
+	earlier := testTime.Add(-time.Hour)
+	page := pagination.Pagination{Limit: 10, Offset: 0}
+	entries := []*entity.AuditEntry{
+		{ID: 1, Actor: "7", Action: "member.deleted", TargetType: "member", TargetID: "1", CreatedAt: testTime},
+	}
+	tests := []struct {
+		name      string
+		filter    *inputmodel.ListAuditEntriesInputModel
+		repoSetup func(*mock.MockAuditPersistence)
+		want      []*entity.AuditEntry
+		wantTotal int
+		wantErr   error
+	}{
+		{
+			name:   "normal test",
+			filter: &inputmodel.ListAuditEntriesInputModel{TargetType: "member", TargetID: "1", From: &earlier, To: &testTime},
+			repoSetup: func(r *mock.MockAuditPersistence) {
+				gomock.InOrder(
+					r.EXPECT().GetAll(ctx, gomock.Any(), page).Return(entries, nil),
+					r.EXPECT().CountAll(ctx, gomock.Any()).Return(1, nil),
+				)
+			},
+			want:      entries,
+			wantTotal: 1,
+		},
+		{
+			name:      "from after to",
+			filter:    &inputmodel.ListAuditEntriesInputModel{From: &testTime, To: &earlier},
+			repoSetup: func(r *mock.MockAuditPersistence) {},
+			wantErr:   ErrAuditInvalidTimeRange,
+		},
+		{
+			name:   "get all error",
+			filter: &inputmodel.ListAuditEntriesInputModel{},
+			repoSetup: func(r *mock.MockAuditPersistence) {
+				r.EXPECT().GetAll(ctx, gomock.Any(), page).Return(nil, ErrAuditDBError)
+			},
+			wantErr: ErrAuditDBError,
+		},
+		{
+			name:   "count error",
+			filter: &inputmodel.ListAuditEntriesInputModel{},
+			repoSetup: func(r *mock.MockAuditPersistence) {
+				gomock.InOrder(
+					r.EXPECT().GetAll(ctx, gomock.Any(), page).Return(entries, nil),
+					r.EXPECT().CountAll(ctx, gomock.Any()).Return(0, ErrAuditDBError),
+				)
+			},
+			wantErr: ErrAuditDBError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockAuditPersistence(ctrl)
+			a := &AuditUseCase{
+				AuditGateway: mockRepo,
+				logger:       mockLogger,
+				tracer:       mockTracer,
+			}
+			tt.repoSetup(mockRepo)
+			got, total, err := a.ListEntries(ctx, tt.filter, page)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ListEntries() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantTotal, total)
+		})
+	}
+}
+
+func TestAuditUseCase_RedactTarget(t *testing.T) {
+	ctrl, ctx, testTime, mockLogger, mockTracer := repoHelper(t)
+	input := &inputmodel.RedactAuditTargetInputModel{TargetType: "member", TargetID: "1", Fields: []string{"name", "email"}}
+	filter := &inputmodel.ListAuditEntriesInputModel{TargetType: "member", TargetID: "1"}
+	firstPage := pagination.Pagination{Limit: redactPageSize, Offset: 0}
+	newEntries := func() []*entity.AuditEntry {
+		return []*entity.AuditEntry{
+			{ID: 2, Action: "member.email_updated", TargetType: "member", TargetID: "1", IP: "203.0.113.7", CreatedAt: testTime,
+				Changes: map[string]entity.FieldChange{"email": {Before: "a@b.com", After: "c@d.com"}}},
+			{ID: 1, Action: "member.registered", TargetType: "member", TargetID: "1", CreatedAt: testTime,
+				Changes: map[string]entity.FieldChange{"id": {After: 1}, "name": {After: "tom"}}},
+			{ID: 3, Action: "member.password_updated", TargetType: "member", TargetID: "1", IP: "203.0.113.7", CreatedAt: testTime,
+				Changes: map[string]entity.FieldChange{"password": {Before: "******", After: "******"}}},
+		}
+	}
+	tests := []struct {
+		name      string
+		input     *inputmodel.RedactAuditTargetInputModel
+		repoSetup func(*mock.MockAuditPersistence)
+		want      int
+		wantErr   error
+	}{
+		{
+			name:  "normal test only rewrites entries with pii or ip",
+			input: input,
+			repoSetup: func(r *mock.MockAuditPersistence) {
+				entries := newEntries()
+				r.EXPECT().GetAll(ctx, filter, firstPage).Return(entries, nil)
+				r.EXPECT().ReplaceRedacted(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, e *entity.AuditEntry) error {
+					assert.Equal(t, entity.FieldChange{Before: entity.RedactedValue, After: entity.RedactedValue}, e.Changes["email"])
+					assert.Empty(t, e.IP)
+					return nil
+				})
+				r.EXPECT().ReplaceRedacted(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, e *entity.AuditEntry) error {
+					assert.Equal(t, entity.FieldChange{After: entity.RedactedValue}, e.Changes["name"])
+					assert.Equal(t, entity.FieldChange{After: 1}, e.Changes["id"])
+					return nil
+				})
+				r.EXPECT().ReplaceRedacted(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, e *entity.AuditEntry) error {
+					// 沒有個資欄位，只清除來源 IP
+					assert.Equal(t, entity.FieldChange{Before: "******", After: "******"}, e.Changes["password"])
+					assert.Empty(t, e.IP)
+					return nil
+				})
+			},
+			want: 3,
+		},
+		{
+			name:  "already redacted is no-op",
+			input: input,
+			repoSetup: func(r *mock.MockAuditPersistence) {
+				entries := newEntries()
+				for _, e := range entries {
+					e.Redact("name", "email")
+				}
+				r.EXPECT().GetAll(ctx, filter, firstPage).Return(entries, nil)
+			},
+			want: 0,
+		},
+		{
+			name:      "missing fields",
+			input:     &inputmodel.RedactAuditTargetInputModel{TargetType: "member", TargetID: "1"},
+			repoSetup: func(r *mock.MockAuditPersistence) {},
+			wantErr:   ErrAuditInvalidRedaction,
+		},
+		{
+			name:  "get all error",
+			input: input,
+			repoSetup: func(r *mock.MockAuditPersistence) {
+				r.EXPECT().GetAll(ctx, filter, firstPage).Return(nil, ErrAuditDBError)
+			},
+			wantErr: ErrAuditDBError,
+		},
+		{
+			name:  "replace redacted error",
+			input: input,
+			repoSetup: func(r *mock.MockAuditPersistence) {
+				r.EXPECT().GetAll(ctx, filter, firstPage).Return(newEntries(), nil)
+				r.EXPECT().ReplaceRedacted(ctx, gomock.Any()).Return(ErrAuditDBError)
+			},
+			wantErr: ErrAuditDBError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockAuditPersistence(ctrl)
+			a := &AuditUseCase{
+				AuditGateway: mockRepo,
+				logger:       mockLogger,
+				tracer:       mockTracer,
+			}
+			tt.repoSetup(mockRepo)
+			got, err := a.RedactTarget(ctx, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("RedactTarget() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func repoHelper(t *testing.T) (*gomock.Controller, context.Context, time.Time, *mocklogger.MockLogger, *mocktracer.MockTracer) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+	ctx := context.Background()
+	testTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockLogger := mocklogger.NewMockLogger(ctrl)
+	mockTracer := mocktracer.NewMockTracer(ctrl)
+
+	// 設置基本的 mock 行為
+	mockLogger.EXPECT().With(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	mockSpan := mocktracer.NewMockSpan(ctrl)
+	mockSpan.EXPECT().End().AnyTimes()
+	mockTracer.EXPECT().Start(gomock.Any(), gomock.Any()).Return(ctx, mockSpan).AnyTimes()
+
+	return ctrl, ctx, testTime, mockLogger, mockTracer
+}
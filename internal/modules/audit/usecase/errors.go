@@ -0,0 +1,22 @@
+package usecase
+
+import "errors"
+
+// AuditUseCase 錯誤碼
+var (
+	//------- gateway error mapping -------
+	// ErrAuditDBError 遇到 DB 或 infra 技術性問題。
+	ErrAuditDBError = errors.New("usecase: audit db operation error")
+	// ErrAuditMappingError 從 repo model 轉換到 entity 時發生錯誤，像是 changes JSON 壞掉、時間格式有誤等。
+	ErrAuditMappingError = errors.New("usecase: audit mapping repo model to entity failed")
+	// ErrAuditUnexpectedError 不知道怎麼歸類的錯誤就收斂到這個。
+	ErrAuditUnexpectedError = errors.New("usecase: audit usecase unexpected error")
+
+	// ------- usecase 內部的業務語意 -------
+	// ErrAuditInvalidEntry 稽核紀錄缺少 action 或 target。
+	ErrAuditInvalidEntry = errors.New("usecase: audit entry invalid")
+	// ErrAuditInvalidTimeRange 查詢區間 from 晚於 to。
+	ErrAuditInvalidTimeRange = errors.New("usecase: audit invalid time range")
+	// ErrAuditInvalidRedaction 遮蔽個資時缺少 target 或欄位。
+	ErrAuditInvalidRedaction = errors.New("usecase: audit redaction target or fields missing")
+)
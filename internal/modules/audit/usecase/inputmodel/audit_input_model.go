@@ -0,0 +1,24 @@
+// Package inputmodel 定義稽核 UseCase 專用的輸入資料模型。
+package inputmodel
+
+import "time"
+
+// ListAuditEntriesInputModel 為「查詢稽核紀錄」UseCase 的輸入模型。
+//   - 所有條件皆為選填，空字串 / nil 表示不過濾該條件。
+//   - From/To 為包含端點的時間區間。
+type ListAuditEntriesInputModel struct {
+	TargetType string
+	TargetID   string
+	Action     string
+	From       *time.Time
+	To         *time.Time
+}
+
+// RedactAuditTargetInputModel 為「遮蔽稽核紀錄個資」UseCase 的輸入模型。
+//   - TargetType/TargetID 指定要遮蔽的對象，皆為必填。
+//   - Fields 為 changes 中要遮蔽的欄位名稱，例如 name、email。
+type RedactAuditTargetInputModel struct {
+	TargetType string
+	TargetID   string
+	Fields     []string
+}
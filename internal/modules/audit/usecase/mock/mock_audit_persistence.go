@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: audit_persistence.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/tomoffice/go-clean-architecture/internal/modules/audit/entity"
+	inputmodel "github.com/tomoffice/go-clean-architecture/internal/modules/audit/usecase/inputmodel"
+	pagination "github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+)
+
+// MockAuditPersistence is a mock of AuditPersistence interface.
+type MockAuditPersistence struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditPersistenceMockRecorder
+}
+
+// MockAuditPersistenceMockRecorder is the mock recorder for MockAuditPersistence.
+type MockAuditPersistenceMockRecorder struct {
+	mock *MockAuditPersistence
+}
+
+// NewMockAuditPersistence creates a new mock instance.
+func NewMockAuditPersistence(ctrl *gomock.Controller) *MockAuditPersistence {
+	mock := &MockAuditPersistence{ctrl: ctrl}
+	mock.recorder = &MockAuditPersistenceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditPersistence) EXPECT() *MockAuditPersistenceMockRecorder {
+	return m.recorder
+}
+
+// Append mocks base method.
+func (m *MockAuditPersistence) Append(ctx context.Context, entry *entity.AuditEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Append", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Append indicates an expected call of Append.
+func (mr *MockAuditPersistenceMockRecorder) Append(ctx, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockAuditPersistence)(nil).Append), ctx, entry)
+}
+
+// CountAll mocks base method.
+func (m *MockAuditPersistence) CountAll(ctx context.Context, filter *inputmodel.ListAuditEntriesInputModel) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAll", ctx, filter)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAll indicates an expected call of CountAll.
+func (mr *MockAuditPersistenceMockRecorder) CountAll(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAll", reflect.TypeOf((*MockAuditPersistence)(nil).CountAll), ctx, filter)
+}
+
+// GetAll mocks base method.
+func (m *MockAuditPersistence) GetAll(ctx context.Context, filter *inputmodel.ListAuditEntriesInputModel, pagination pagination.Pagination) ([]*entity.AuditEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, filter, pagination)
+	ret0, _ := ret[0].([]*entity.AuditEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockAuditPersistenceMockRecorder) GetAll(ctx, filter, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockAuditPersistence)(nil).GetAll), ctx, filter, pagination)
+}
+
+// ReplaceRedacted mocks base method.
+func (m *MockAuditPersistence) ReplaceRedacted(ctx context.Context, entry *entity.AuditEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceRedacted", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceRedacted indicates an expected call of ReplaceRedacted.
+func (mr *MockAuditPersistenceMockRecorder) ReplaceRedacted(ctx, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceRedacted", reflect.TypeOf((*MockAuditPersistence)(nil).ReplaceRedacted), ctx, entry)
+}
@@ -0,0 +1,16 @@
+package input
+
+//go:generate mockgen -source=audit_input_port.go -destination=../../../interface_adapter/controller/mock/mock_audit_input_port.go -package=mock
+import (
+	"context"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/usecase/inputmodel"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+)
+
+type AuditInputPort interface {
+	RecordEntry(ctx context.Context, entry *entity.AuditEntry) error
+	ListEntries(ctx context.Context, filter *inputmodel.ListAuditEntriesInputModel, pagination pagination.Pagination) ([]*entity.AuditEntry, int, error)
+	// RedactTarget 遮蔽指定對象所有稽核紀錄中的個資欄位，回傳實際被改寫的筆數，可重複呼叫
+	RedactTarget(ctx context.Context, input *inputmodel.RedactAuditTargetInputModel) (int, error)
+}
@@ -0,0 +1,18 @@
+package output
+
+//go:generate mockgen -source=audit_persistence.go -destination=../../mock/mock_audit_persistence.go -package=mock
+import (
+	"context"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/usecase/inputmodel"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+)
+
+// AuditPersistence 稽核紀錄只能新增與查詢，不提供刪除；
+// 唯一的更新是個資遮蔽時改寫 changes 與 ip，其餘欄位由 DB trigger 保護
+type AuditPersistence interface {
+	Append(ctx context.Context, entry *entity.AuditEntry) error
+	GetAll(ctx context.Context, filter *inputmodel.ListAuditEntriesInputModel, pagination pagination.Pagination) ([]*entity.AuditEntry, error)
+	CountAll(ctx context.Context, filter *inputmodel.ListAuditEntriesInputModel) (int, error)
+	ReplaceRedacted(ctx context.Context, entry *entity.AuditEntry) error
+}
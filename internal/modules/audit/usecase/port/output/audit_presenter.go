@@ -0,0 +1,18 @@
+package output
+
+//go:generate mockgen -source=audit_presenter.go -destination=../../../interface_adapter/controller/mock/mock_audit_presenter.go -package=mock
+import (
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/audit/interface_adapter/outputmodel"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+)
+
+type AuditPresenter interface {
+	PresentListAuditEntries(entries []*entity.AuditEntry, pagination pagination.Pagination, total int) outputmodel.ListAuditEntriesResponse
+	// PresentBindingError 處理輸入綁定錯誤
+	PresentBindingError(errCode int, message string) outputmodel.ErrorResponse
+	// PresentValidationError 處理驗證錯誤
+	PresentValidationError(err error) (int, outputmodel.ErrorResponse)
+	// PresentUseCaseError 處理用例錯誤
+	PresentUseCaseError(err error) (int, outputmodel.ErrorResponse)
+}
@@ -5,4 +5,25 @@ import "errors"
 var (
 	ErrInvalidEmailFormat = errors.New("invalid email format")
 	ErrNameTooShort       = errors.New("name too short")
+	ErrNameTooLong        = errors.New("name too long")
+
+	ErrIllegalStatusTransition = errors.New("illegal member status transition")
+	ErrStatusReasonRequired    = errors.New("member status change reason required")
+	ErrMemberNotActive         = errors.New("member is not active")
+
+	ErrMergeIntoSelf = errors.New("member cannot be merged into itself")
+	ErrMemberMerged  = errors.New("member has been merged into another member")
+
+	ErrInvitationMaxUsesInvalid = errors.New("invitation max uses must be positive")
+	ErrInvitationExpired        = errors.New("invitation has expired")
+	ErrInvitationRevoked        = errors.New("invitation has been revoked")
+	ErrInvitationExhausted      = errors.New("invitation has no uses left")
+	ErrInvitationEmailMismatch  = errors.New("invitation is locked to another email")
+
+	ErrTagInvalid           = errors.New("invalid tag")
+	ErrSegmentNameInvalid   = errors.New("invalid segment name")
+	ErrSegmentFilterInvalid = errors.New("invalid segment filter")
+
+	ErrPreferenceUnknownKey   = errors.New("unknown preference key")
+	ErrPreferenceInvalidValue = errors.New("invalid preference value")
 )
@@ -1,11 +1,70 @@
 package entity
 
-import "time"
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// NameMinLength 會員名稱最短長度，以 Unicode 字元數計算
+	NameMinLength = 3
+	// NameMaxLength 會員名稱最長長度，以 Unicode 字元數計算
+	NameMaxLength = 20
+)
 
 type Member struct {
-	ID        int       ` json:"id"`
-	Name      string    ` json:"name"`
-	Email     string    ` json:"email"`
-	Password  string    ` json:"-"`
-	CreatedAt time.Time ` json:"created_at"`
+	ID    int    ` json:"id"`
+	Name  string ` json:"name"`
+	Email string ` json:"email"`
+	// NormalizedEmail 比對身分用的正規化 Email，見 EmailNormalizer
+	NormalizedEmail string ` json:"-"`
+	Password        string ` json:"-"`
+	// Status 帳號狀態，只能透過 ChangeStatus 依狀態機轉換
+	Status       MemberStatus ` json:"status"`
+	StatusReason string       ` json:"status_reason,omitempty"`
+	// MergedInto 重複帳號合併後指向保留的會員 ID，0 表示未被合併，見 MergeInto
+	MergedInto int ` json:"merged_into,omitempty"`
+	// ReferredBy 註冊時使用的邀請碼由哪位會員建立，0 表示沒有推薦人，見 Invitation.Redeem
+	ReferredBy int       ` json:"referred_by,omitempty"`
+	CreatedAt  time.Time ` json:"created_at"`
+	// UpdatedAt 最近一次寫入資料庫的時間，由儲存層維護
+	UpdatedAt time.Time ` json:"updated_at"`
+	// ReferralCount 透過此會員的邀請碼註冊的會員數，唯讀統計，只在查詢單一會員時填入
+	ReferralCount int ` json:"referral_count"`
+	// Tags 會員標籤，已正規化並排序，只在查詢單一會員時填入，見 NormalizeTag
+	Tags []string ` json:"tags,omitempty"`
+	// Preferences 會員偏好設定，只在查詢單一會員時填入，供呈現時依會員的語系與時區格式化時間
+	Preferences *MemberPreferences ` json:"-"`
+}
+
+// NewMember 建立新會員並檢查名稱與 Email 的不變條件，HTTP、匯入、CLI 等入口共用同一套規則；
+// 密碼規則由 PasswordPolicy 另外檢查；新會員預設為 active
+func NewMember(name, email, password string, n EmailNormalizer) (*Member, error) {
+	m := &Member{Password: password, Status: MemberStatusActive}
+	if err := m.Rename(name); err != nil {
+		return nil, err
+	}
+	if err := m.ChangeEmail(email, n); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Rename 變更會員名稱，名稱會去除前後空白，長度須介於 NameMinLength 與 NameMaxLength；
+// 已被合併的會員回傳 ErrMemberMerged
+func (m *Member) Rename(name string) error {
+	if m.IsMerged() {
+		return ErrMemberMerged
+	}
+	name = strings.TrimSpace(name)
+	length := utf8.RuneCountInString(name)
+	if length < NameMinLength {
+		return ErrNameTooShort
+	}
+	if length > NameMaxLength {
+		return ErrNameTooLong
+	}
+	m.Name = name
+	return nil
 }
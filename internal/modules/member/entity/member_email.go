@@ -0,0 +1,113 @@
+package entity
+
+import (
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+// EmailNormalizer 將 Email 轉為比對身分用的正規化形式（normalized email）
+//   - 零值只做基本規則：去除前後空白、整個地址轉小寫、網域轉為 punycode（IDN）
+//   - 各信箱業者的額外規則（例如 Gmail 忽略 local part 的點與 +tag）由設定決定，
+//     套用在網域別名轉換之後
+type EmailNormalizer struct {
+	// DomainAliases 網域別名，例如 googlemail.com -> gmail.com
+	DomainAliases map[string]string
+	// IgnoreDotsDomains local part 的點不影響收件的網域
+	IgnoreDotsDomains map[string]struct{}
+	// PlusTagDomains 支援 local+tag 子地址的網域，+ 之後的內容會被移除
+	PlusTagDomains map[string]struct{}
+}
+
+// NewEmailNormalizer 依設定建立 EmailNormalizer，設定中的網域一律轉小寫
+func NewEmailNormalizer(ignoreDotsDomains, plusTagDomains []string, domainAliases map[string]string) EmailNormalizer {
+	n := EmailNormalizer{
+		DomainAliases:     make(map[string]string, len(domainAliases)),
+		IgnoreDotsDomains: make(map[string]struct{}, len(ignoreDotsDomains)),
+		PlusTagDomains:    make(map[string]struct{}, len(plusTagDomains)),
+	}
+	for alias, domain := range domainAliases {
+		n.DomainAliases[strings.ToLower(alias)] = strings.ToLower(domain)
+	}
+	for _, domain := range ignoreDotsDomains {
+		n.IgnoreDotsDomains[strings.ToLower(domain)] = struct{}{}
+	}
+	for _, domain := range plusTagDomains {
+		n.PlusTagDomains[strings.ToLower(domain)] = struct{}{}
+	}
+	return n
+}
+
+// Normalize 回傳 Email 的正規化形式，格式不合法時回傳 ErrInvalidEmailFormat
+func (n EmailNormalizer) Normalize(email string) (string, error) {
+	local, domain, err := splitEmail(email)
+	if err != nil {
+		return "", err
+	}
+	local = strings.ToLower(local)
+	if alias, ok := n.DomainAliases[domain]; ok {
+		domain = alias
+	}
+	if _, ok := n.PlusTagDomains[domain]; ok {
+		local, _, _ = strings.Cut(local, "+")
+	}
+	if _, ok := n.IgnoreDotsDomains[domain]; ok {
+		local = strings.ReplaceAll(local, ".", "")
+	}
+	if local == "" {
+		return "", ErrInvalidEmailFormat
+	}
+	return local + "@" + domain, nil
+}
+
+// CanonicalEmail 回傳實際儲存的 Email：去除前後空白、網域轉小寫並轉為 punycode，
+// local part 保留使用者輸入的大小寫
+func CanonicalEmail(email string) (string, error) {
+	local, domain, err := splitEmail(email)
+	if err != nil {
+		return "", err
+	}
+	return local + "@" + domain, nil
+}
+
+// ChangeEmail 以正規化規則設定會員的 Email 與 NormalizedEmail，格式不合法或會員已被合併時不修改會員
+func (m *Member) ChangeEmail(email string, n EmailNormalizer) error {
+	if m.IsMerged() {
+		return ErrMemberMerged
+	}
+	canonical, err := CanonicalEmail(email)
+	if err != nil {
+		return err
+	}
+	normalized, err := n.Normalize(email)
+	if err != nil {
+		return err
+	}
+	m.Email = canonical
+	m.NormalizedEmail = normalized
+	return nil
+}
+
+// EmailDomain 回傳正規化 Email 的網域，尚未正規化時為空字串
+func (m *Member) EmailDomain() string {
+	at := strings.LastIndex(m.NormalizedEmail, "@")
+	if at < 0 {
+		return ""
+	}
+	return m.NormalizedEmail[at+1:]
+}
+
+// splitEmail 拆出 local part 與轉為 ASCII 小寫的網域
+func splitEmail(email string) (string, string, error) {
+	email = strings.TrimSpace(email)
+	at := strings.LastIndex(email, "@")
+	if at <= 0 || at == len(email)-1 {
+		return "", "", ErrInvalidEmailFormat
+	}
+	local := email[:at]
+	domain, err := idna.Lookup.ToASCII(strings.TrimSuffix(email[at+1:], "."))
+	if err != nil || domain == "" {
+		return "", "", ErrInvalidEmailFormat
+	}
+	return local, strings.ToLower(domain), nil
+}
@@ -0,0 +1,134 @@
+package entity
+
+import "time"
+
+// 會員領域事件名稱，對外發佈時作為 event type
+const (
+	EventMemberRegistered   = "member.registered"
+	EventMemberEmailChanged = "member.email_changed"
+	EventMemberDeleted      = "member.deleted"
+	EventMemberErased       = "member.erased"
+	// EventMemberStatusChanged 帳號狀態轉換，例如停權、封鎖、恢復
+	EventMemberStatusChanged = "member.status_changed"
+	// EventMemberMerged 重複帳號已合併到保留的會員，下游系統應將來源會員的資料轉到目標會員
+	EventMemberMerged = "member.merged"
+)
+
+// DomainEvent 會員聚合產生的領域事件
+type DomainEvent interface {
+	// EventName 事件名稱
+	EventName() string
+	// AggregateID 事件所屬的會員 ID
+	AggregateID() int
+	// OccurredAt 事件發生時間
+	OccurredAt() time.Time
+}
+
+// MemberRegistered 會員註冊完成，ReferredBy 為推薦人會員 ID（以邀請碼註冊時）
+type MemberRegistered struct {
+	MemberID   int       `json:"member_id"`
+	Name       string    `json:"name"`
+	Email      string    `json:"email"`
+	ReferredBy int       `json:"referred_by,omitempty"`
+	At         time.Time `json:"occurred_at"`
+}
+
+func (e MemberRegistered) EventName() string     { return EventMemberRegistered }
+func (e MemberRegistered) AggregateID() int      { return e.MemberID }
+func (e MemberRegistered) OccurredAt() time.Time { return e.At }
+
+// MemberEmailChanged 會員 Email 已變更
+type MemberEmailChanged struct {
+	MemberID int       `json:"member_id"`
+	OldEmail string    `json:"old_email"`
+	NewEmail string    `json:"new_email"`
+	At       time.Time `json:"occurred_at"`
+}
+
+func (e MemberEmailChanged) EventName() string     { return EventMemberEmailChanged }
+func (e MemberEmailChanged) AggregateID() int      { return e.MemberID }
+func (e MemberEmailChanged) OccurredAt() time.Time { return e.At }
+
+// MemberDeleted 會員已刪除
+type MemberDeleted struct {
+	MemberID int       `json:"member_id"`
+	Email    string    `json:"email"`
+	At       time.Time `json:"occurred_at"`
+}
+
+func (e MemberDeleted) EventName() string     { return EventMemberDeleted }
+func (e MemberDeleted) AggregateID() int      { return e.MemberID }
+func (e MemberDeleted) OccurredAt() time.Time { return e.At }
+
+// MemberErased 會員個資已刪除，只帶 ID，讓下游系統清除自己保存的個資
+type MemberErased struct {
+	MemberID int       `json:"member_id"`
+	At       time.Time `json:"occurred_at"`
+}
+
+func (e MemberErased) EventName() string     { return EventMemberErased }
+func (e MemberErased) AggregateID() int      { return e.MemberID }
+func (e MemberErased) OccurredAt() time.Time { return e.At }
+
+// MemberStatusChanged 會員帳號狀態已轉換
+type MemberStatusChanged struct {
+	MemberID int          `json:"member_id"`
+	From     MemberStatus `json:"from"`
+	To       MemberStatus `json:"to"`
+	Reason   string       `json:"reason,omitempty"`
+	Actor    string       `json:"actor"`
+	At       time.Time    `json:"occurred_at"`
+}
+
+func (e MemberStatusChanged) EventName() string     { return EventMemberStatusChanged }
+func (e MemberStatusChanged) AggregateID() int      { return e.MemberID }
+func (e MemberStatusChanged) OccurredAt() time.Time { return e.At }
+
+// MemberMerged 重複帳號已合併，MemberID 為被合併（tombstone）的來源會員
+type MemberMerged struct {
+	MemberID int       `json:"member_id"`
+	TargetID int       `json:"target_id"`
+	Actor    string    `json:"actor"`
+	At       time.Time `json:"occurred_at"`
+}
+
+func (e MemberMerged) EventName() string     { return EventMemberMerged }
+func (e MemberMerged) AggregateID() int      { return e.MemberID }
+func (e MemberMerged) OccurredAt() time.Time { return e.At }
+
+// NewMemberRegistered 由註冊完成的會員建立事件
+func NewMemberRegistered(m *Member, at time.Time) MemberRegistered {
+	return MemberRegistered{MemberID: m.ID, Name: m.Name, Email: m.Email, ReferredBy: m.ReferredBy, At: at}
+}
+
+// NewMemberEmailChanged 建立 Email 變更事件
+func NewMemberEmailChanged(m *Member, newEmail string, at time.Time) MemberEmailChanged {
+	return MemberEmailChanged{MemberID: m.ID, OldEmail: m.Email, NewEmail: newEmail, At: at}
+}
+
+// NewMemberDeleted 由被刪除的會員建立事件
+func NewMemberDeleted(m *Member, at time.Time) MemberDeleted {
+	return MemberDeleted{MemberID: m.ID, Email: m.Email, At: at}
+}
+
+// NewMemberErased 建立個資刪除事件
+func NewMemberErased(id int, at time.Time) MemberErased {
+	return MemberErased{MemberID: id, At: at}
+}
+
+// NewMemberStatusChanged 由狀態轉換紀錄建立事件
+func NewMemberStatusChanged(change StatusChange) MemberStatusChanged {
+	return MemberStatusChanged{
+		MemberID: change.MemberID,
+		From:     change.From,
+		To:       change.To,
+		Reason:   change.Reason,
+		Actor:    change.Actor,
+		At:       change.At,
+	}
+}
+
+// NewMemberMerged 由已標記合併的來源會員建立事件
+func NewMemberMerged(source *Member, actor string, at time.Time) MemberMerged {
+	return MemberMerged{MemberID: source.ID, TargetID: source.MergedInto, Actor: actor, At: at}
+}
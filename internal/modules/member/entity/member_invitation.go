@@ -0,0 +1,80 @@
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+// Invitation 註冊邀請碼，invite-only 部署時註冊必須帶有效的邀請碼
+//   - CreatedBy 為建立者的 actor；由會員建立時 ReferrerID 為該會員 ID，註冊者的 ReferredBy 會指向他
+//   - Email 不為空時只有該 Email（正規化後）可以使用
+//   - ExpiresAt、RevokedAt 為零值表示不會過期、尚未撤銷
+type Invitation struct {
+	ID         int       `json:"id"`
+	Code       string    `json:"code"`
+	CreatedBy  string    `json:"created_by"`
+	ReferrerID int       `json:"referrer_id,omitempty"`
+	Email      string    `json:"email,omitempty"`
+	MaxUses    int       `json:"max_uses"`
+	Uses       int       `json:"uses"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	RevokedAt  time.Time `json:"revoked_at"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// NewInvitation 建立邀請碼並檢查不變條件，email 須為正規化後的 Email 或空字串
+func NewInvitation(code, createdBy string, referrerID int, email string, maxUses int, expiresAt, now time.Time) (*Invitation, error) {
+	if maxUses < 1 {
+		return nil, ErrInvitationMaxUsesInvalid
+	}
+	if !expiresAt.IsZero() && !expiresAt.After(now) {
+		return nil, ErrInvitationExpired
+	}
+	return &Invitation{
+		Code:       NormalizeInvitationCode(code),
+		CreatedBy:  createdBy,
+		ReferrerID: referrerID,
+		Email:      email,
+		MaxUses:    maxUses,
+		ExpiresAt:  expiresAt,
+		CreatedAt:  now,
+	}, nil
+}
+
+// NormalizeInvitationCode 邀請碼不分大小寫，前後空白不計
+func NormalizeInvitationCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// IsRevoked 邀請碼是否已撤銷
+func (i *Invitation) IsRevoked() bool {
+	return !i.RevokedAt.IsZero()
+}
+
+// Redeem 以邀請碼註冊 member，成功時使用次數加一並記錄推薦人；不可用時不修改任何資料
+//   - 已撤銷回傳 ErrInvitationRevoked，已過期回傳 ErrInvitationExpired
+//   - 次數用完回傳 ErrInvitationExhausted，Email 不符回傳 ErrInvitationEmailMismatch
+func (i *Invitation) Redeem(member *Member, now time.Time) error {
+	switch {
+	case i.IsRevoked():
+		return ErrInvitationRevoked
+	case !i.ExpiresAt.IsZero() && !now.Before(i.ExpiresAt):
+		return ErrInvitationExpired
+	case i.Uses >= i.MaxUses:
+		return ErrInvitationExhausted
+	case i.Email != "" && i.Email != member.NormalizedEmail:
+		return ErrInvitationEmailMismatch
+	}
+	i.Uses++
+	member.ReferredBy = i.ReferrerID
+	return nil
+}
+
+// Revoke 撤銷邀請碼，已撤銷時回傳 ErrInvitationRevoked
+func (i *Invitation) Revoke(now time.Time) error {
+	if i.IsRevoked() {
+		return ErrInvitationRevoked
+	}
+	i.RevokedAt = now
+	return nil
+}
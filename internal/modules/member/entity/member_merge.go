@@ -0,0 +1,20 @@
+package entity
+
+// IsMerged 會員是否已被合併到其他會員（tombstone）
+func (m *Member) IsMerged() bool {
+	return m.MergedInto != 0
+}
+
+// MergeInto 將重複帳號標記為已合併到 target，合併後只保留指向 target 的指標
+//   - 不能合併到自己，回傳 ErrMergeIntoSelf
+//   - 來源或目標已被合併時回傳 ErrMemberMerged，避免形成合併鏈
+func (m *Member) MergeInto(target *Member) error {
+	if m.ID == target.ID {
+		return ErrMergeIntoSelf
+	}
+	if m.IsMerged() || target.IsMerged() {
+		return ErrMemberMerged
+	}
+	m.MergedInto = target.ID
+	return nil
+}
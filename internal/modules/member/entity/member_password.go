@@ -0,0 +1,98 @@
+package entity
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// PasswordRule 密碼政策的規則名稱，違反時回傳給呼叫端列出
+type PasswordRule string
+
+const (
+	PasswordRuleMinLength     PasswordRule = "min_length"
+	PasswordRuleMaxLength     PasswordRule = "max_length"
+	PasswordRuleUppercase     PasswordRule = "uppercase"
+	PasswordRuleLowercase     PasswordRule = "lowercase"
+	PasswordRuleDigit         PasswordRule = "digit"
+	PasswordRuleSymbol        PasswordRule = "symbol"
+	PasswordRuleContainsName  PasswordRule = "contains_name"
+	PasswordRuleContainsEmail PasswordRule = "contains_email"
+	// PasswordRuleBreached 密碼出現在外洩密碼清單，由 use case 透過外部清單檢查
+	PasswordRuleBreached PasswordRule = "breached"
+)
+
+// personalInfoMinLength 名稱或 Email local part 至少這麼長才檢查是否出現在密碼中，
+// 避免 "gg" 之類的短名稱誤擋大量密碼
+const personalInfoMinLength = 3
+
+// PasswordPolicy 密碼政策，零值不做任何限制
+//   - 長度以 Unicode 字元數計算
+//   - RejectPersonalInfo 不分大小寫檢查密碼是否包含會員名稱或 Email（local part）
+type PasswordPolicy struct {
+	MinLength          int
+	MaxLength          int
+	RequireUppercase   bool
+	RequireLowercase   bool
+	RequireDigit       bool
+	RequireSymbol      bool
+	RejectPersonalInfo bool
+}
+
+// Violations 回傳密碼違反的所有規則，member 為密碼的持有者，可為 nil
+func (p PasswordPolicy) Violations(password string, member *Member) []PasswordRule {
+	var rules []PasswordRule
+	length := utf8.RuneCountInString(password)
+	if p.MinLength > 0 && length < p.MinLength {
+		rules = append(rules, PasswordRuleMinLength)
+	}
+	if p.MaxLength > 0 && length > p.MaxLength {
+		rules = append(rules, PasswordRuleMaxLength)
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r):
+			hasSymbol = true
+		}
+	}
+	if p.RequireUppercase && !hasUpper {
+		rules = append(rules, PasswordRuleUppercase)
+	}
+	if p.RequireLowercase && !hasLower {
+		rules = append(rules, PasswordRuleLowercase)
+	}
+	if p.RequireDigit && !hasDigit {
+		rules = append(rules, PasswordRuleDigit)
+	}
+	if p.RequireSymbol && !hasSymbol {
+		rules = append(rules, PasswordRuleSymbol)
+	}
+
+	if p.RejectPersonalInfo && member != nil {
+		lowered := strings.ToLower(password)
+		if containsPersonalInfo(lowered, member.Name) {
+			rules = append(rules, PasswordRuleContainsName)
+		}
+		local, _, _ := strings.Cut(member.Email, "@")
+		if containsPersonalInfo(lowered, local) {
+			rules = append(rules, PasswordRuleContainsEmail)
+		}
+	}
+	return rules
+}
+
+func containsPersonalInfo(loweredPassword, info string) bool {
+	info = strings.ToLower(strings.TrimSpace(info))
+	if utf8.RuneCountInString(info) < personalInfoMinLength {
+		return false
+	}
+	return strings.Contains(loweredPassword, info)
+}
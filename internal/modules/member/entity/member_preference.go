@@ -0,0 +1,270 @@
+package entity
+
+import (
+	"fmt"
+	"golang.org/x/text/language"
+	"sort"
+	"time"
+)
+
+// 內建的會員偏好設定 key
+const (
+	PreferenceLocale               = "locale"
+	PreferenceTimeZone             = "time_zone"
+	PreferenceMarketingOptIn       = "marketing_opt_in"
+	PreferenceNotificationChannels = "notification_channels"
+)
+
+// PreferenceType 偏好設定值的型別
+type PreferenceType string
+
+const (
+	PreferenceTypeString     PreferenceType = "string"
+	PreferenceTypeBool       PreferenceType = "bool"
+	PreferenceTypeStringList PreferenceType = "string_list"
+)
+
+// NotificationChannels 可選的通知管道
+var NotificationChannels = []string{"email", "push", "sms"}
+
+// PreferenceSchema 單一偏好設定的型別與預設值
+type PreferenceSchema struct {
+	Key     string
+	Type    PreferenceType
+	Default any
+	// Check 型別正確後的額外檢查與正規化，nil 表示不檢查
+	Check func(value any) (any, error)
+}
+
+// Preference 已儲存的偏好設定，Version 從 1 開始，值有變更時加一
+type Preference struct {
+	Key       string
+	Value     any
+	Version   int
+	UpdatedAt time.Time
+}
+
+// MemberPreferences 會員完整的偏好設定
+type MemberPreferences struct {
+	MemberID int
+	// Values 每個已註冊 key 的值，未儲存的 key 為預設值
+	Values map[string]any
+	// Versions 已儲存 key 的版本，使用預設值的 key 不在其中
+	Versions map[string]int
+}
+
+// Locale 會員語系（BCP 47），nil 回傳空字串
+func (p *MemberPreferences) Locale() string {
+	if p == nil {
+		return ""
+	}
+	locale, _ := p.Values[PreferenceLocale].(string)
+	return locale
+}
+
+// Location 會員時區，nil 或無法載入時回傳 UTC
+func (p *MemberPreferences) Location() *time.Location {
+	if p == nil {
+		return time.UTC
+	}
+	name, _ := p.Values[PreferenceTimeZone].(string)
+	loc, err := time.LoadLocation(name)
+	if err != nil || name == "" {
+		return time.UTC
+	}
+	return loc
+}
+
+// PreferencePatch JSON merge patch 檢查後的結果：Set 為要寫入的正規化值，Reset 為要回到預設值的 key
+type PreferencePatch struct {
+	Set   map[string]any
+	Reset []string
+}
+
+// IsEmpty patch 沒有任何變更
+func (p *PreferencePatch) IsEmpty() bool {
+	return len(p.Set) == 0 && len(p.Reset) == 0
+}
+
+// PreferenceRegistry 偏好設定的 schema，負責檢查型別、正規化值與補上預設值
+type PreferenceRegistry struct {
+	schemas map[string]PreferenceSchema
+	keys    []string
+}
+
+// NewPreferenceRegistry 建立 schema，key 不可重複，預設值必須通過自己的檢查
+func NewPreferenceRegistry(schemas ...PreferenceSchema) (*PreferenceRegistry, error) {
+	r := &PreferenceRegistry{schemas: make(map[string]PreferenceSchema, len(schemas))}
+	for _, schema := range schemas {
+		if _, ok := r.schemas[schema.Key]; ok {
+			return nil, fmt.Errorf("duplicate preference key %s", schema.Key)
+		}
+		r.schemas[schema.Key] = schema
+		r.keys = append(r.keys, schema.Key)
+		normalized, err := r.Normalize(schema.Key, schema.Default)
+		if err != nil {
+			return nil, fmt.Errorf("default of %s: %w", schema.Key, err)
+		}
+		schema.Default = normalized
+		r.schemas[schema.Key] = schema
+	}
+	sort.Strings(r.keys)
+	return r, nil
+}
+
+// NewDefaultPreferenceRegistry 內建的語系、時區、行銷訊息同意與通知管道設定
+func NewDefaultPreferenceRegistry(defaultLocale, defaultTimeZone string) (*PreferenceRegistry, error) {
+	return NewPreferenceRegistry(
+		PreferenceSchema{Key: PreferenceLocale, Type: PreferenceTypeString, Default: defaultLocale, Check: checkLocale},
+		PreferenceSchema{Key: PreferenceTimeZone, Type: PreferenceTypeString, Default: defaultTimeZone, Check: checkTimeZone},
+		PreferenceSchema{Key: PreferenceMarketingOptIn, Type: PreferenceTypeBool, Default: false},
+		PreferenceSchema{Key: PreferenceNotificationChannels, Type: PreferenceTypeStringList, Default: []string{"email"}, Check: checkNotificationChannels},
+	)
+}
+
+// Normalize 檢查值的型別並正規化；JSON 解出的 []any 會轉為 []string
+func (r *PreferenceRegistry) Normalize(key string, value any) (any, error) {
+	schema, ok := r.schemas[key]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrPreferenceUnknownKey, key)
+	}
+	var typed any
+	switch schema.Type {
+	case PreferenceTypeString:
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s must be a string", ErrPreferenceInvalidValue, key)
+		}
+		typed = s
+	case PreferenceTypeBool:
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s must be a boolean", ErrPreferenceInvalidValue, key)
+		}
+		typed = b
+	case PreferenceTypeStringList:
+		list, ok := toStringList(value)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s must be a list of strings", ErrPreferenceInvalidValue, key)
+		}
+		typed = list
+	default:
+		return nil, fmt.Errorf("%w: %s has unsupported type %s", ErrPreferenceInvalidValue, key, schema.Type)
+	}
+	if schema.Check == nil {
+		return typed, nil
+	}
+	checked, err := schema.Check(typed)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", ErrPreferenceInvalidValue, key, err)
+	}
+	return checked, nil
+}
+
+// NewPatch 依 JSON merge patch（RFC 7396）語意檢查變更：null 表示回到預設值，其餘值須符合 schema
+func (r *PreferenceRegistry) NewPatch(changes map[string]any) (*PreferencePatch, error) {
+	keys := make([]string, 0, len(changes))
+	for key := range changes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	patch := &PreferencePatch{Set: make(map[string]any)}
+	for _, key := range keys {
+		value := changes[key]
+		if value == nil {
+			if _, ok := r.schemas[key]; !ok {
+				return nil, fmt.Errorf("%w: %s", ErrPreferenceUnknownKey, key)
+			}
+			patch.Reset = append(patch.Reset, key)
+			continue
+		}
+		normalized, err := r.Normalize(key, value)
+		if err != nil {
+			return nil, err
+		}
+		patch.Set[key] = normalized
+	}
+	return patch, nil
+}
+
+// Resolve 以已儲存的值覆蓋預設值；已不在 schema 或不再符合 schema 的舊資料視為未設定
+func (r *PreferenceRegistry) Resolve(memberID int, stored []Preference) *MemberPreferences {
+	prefs := &MemberPreferences{
+		MemberID: memberID,
+		Values:   make(map[string]any, len(r.keys)),
+		Versions: make(map[string]int),
+	}
+	for _, key := range r.keys {
+		prefs.Values[key] = r.schemas[key].Default
+	}
+	for _, pref := range stored {
+		value, err := r.Normalize(pref.Key, pref.Value)
+		if err != nil {
+			continue
+		}
+		prefs.Values[pref.Key] = value
+		prefs.Versions[pref.Key] = pref.Version
+	}
+	return prefs
+}
+
+func toStringList(value any) ([]string, bool) {
+	switch v := value.(type) {
+	case []string:
+		return append([]string{}, v...), true
+	case []any:
+		list := make([]string, 0, len(v))
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, false
+			}
+			list = append(list, s)
+		}
+		return list, true
+	}
+	return nil, false
+}
+
+// checkLocale 語系須為合法的 BCP 47 tag，回傳標準寫法，例如 zh-tw -> zh-TW
+func checkLocale(value any) (any, error) {
+	tag, err := language.Parse(value.(string))
+	if err != nil || tag == language.Und {
+		return nil, fmt.Errorf("invalid locale %q", value)
+	}
+	return tag.String(), nil
+}
+
+// checkTimeZone 時區須為 IANA 名稱，例如 Asia/Taipei；不接受依主機設定的 Local
+func checkTimeZone(value any) (any, error) {
+	name := value.(string)
+	if name == "" || name == "Local" {
+		return nil, fmt.Errorf("invalid time zone %q", name)
+	}
+	if _, err := time.LoadLocation(name); err != nil {
+		return nil, fmt.Errorf("invalid time zone %q", name)
+	}
+	return name, nil
+}
+
+// checkNotificationChannels 通知管道須在 NotificationChannels 之中，去除重複並排序；空清單表示不通知
+func checkNotificationChannels(value any) (any, error) {
+	allowed := make(map[string]struct{}, len(NotificationChannels))
+	for _, channel := range NotificationChannels {
+		allowed[channel] = struct{}{}
+	}
+	seen := make(map[string]struct{})
+	channels := make([]string, 0)
+	for _, channel := range value.([]string) {
+		if _, ok := allowed[channel]; !ok {
+			return nil, fmt.Errorf("unknown notification channel %q", channel)
+		}
+		if _, ok := seen[channel]; ok {
+			continue
+		}
+		seen[channel] = struct{}{}
+		channels = append(channels, channel)
+	}
+	sort.Strings(channels)
+	return channels, nil
+}
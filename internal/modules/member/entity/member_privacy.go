@@ -0,0 +1,32 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// AnonymizedName 個資刪除後的會員名稱
+	AnonymizedName = "Erased Member"
+	// anonymizedEmailPrefix/anonymizedEmailDomain 匿名 Email 的固定前後綴；
+	// .invalid 為 RFC 2606 保留的頂級網域，保證不會是真實可收信的地址
+	anonymizedEmailPrefix = "erased-"
+	anonymizedEmailDomain = "@anonymized.invalid"
+)
+
+// Anonymize 以匿名值取代會員的個資，ID 與建立時間保留以維持關聯與統計
+//   - Email 含會員 ID，members.email 的 UNIQUE 限制下不會與其他匿名會員衝突；
+//     token 為呼叫端產生的隨機值，讓他人無法預先註冊相同的匿名 Email
+//   - 密碼改為同一個 token，原密碼失效且無法再登入
+func (m *Member) Anonymize(token string) {
+	m.Name = AnonymizedName
+	m.Email = fmt.Sprintf("%s%d-%s%s", anonymizedEmailPrefix, m.ID, token, anonymizedEmailDomain)
+	m.NormalizedEmail = strings.ToLower(m.Email)
+	m.Password = token
+}
+
+// IsAnonymized 是否已做過個資刪除，用於讓刪除流程可重複呼叫
+func (m *Member) IsAnonymized() bool {
+	return strings.HasPrefix(m.Email, fmt.Sprintf("%s%d-", anonymizedEmailPrefix, m.ID)) &&
+		strings.HasSuffix(m.Email, anonymizedEmailDomain)
+}
@@ -0,0 +1,74 @@
+package entity
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// SegmentNameMaxLength 分群名稱最長長度，以 Unicode 字元數計算
+const SegmentNameMaxLength = 64
+
+// SegmentFilter 分群條件，各條件之間為 AND；以 JSON 儲存，隨時可重新計算
+//   - TagsAny 符合任一標籤，TagsAll 須同時具備所有標籤
+//   - 已合併的會員一律不列入
+type SegmentFilter struct {
+	Status     MemberStatus `json:"status,omitempty"`
+	TagsAny    []string     `json:"tags_any,omitempty"`
+	TagsAll    []string     `json:"tags_all,omitempty"`
+	ReferredBy int          `json:"referred_by,omitempty"`
+}
+
+// IsEmpty 是否沒有任何條件
+func (f SegmentFilter) IsEmpty() bool {
+	return f.Status == "" && len(f.TagsAny) == 0 && len(f.TagsAll) == 0 && f.ReferredBy == 0
+}
+
+// Segment 儲存的會員分群，只保存條件，成員在查詢時才計算
+type Segment struct {
+	ID        int           `json:"id"`
+	Name      string        `json:"name"`
+	Filter    SegmentFilter `json:"filter"`
+	CreatedBy string        `json:"created_by"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+// NewSegment 建立分群並檢查不變條件，標籤會經過 NormalizeTags；至少要有一個條件，避免誤建涵蓋全部會員的分群
+func NewSegment(name string, filter SegmentFilter, createdBy string, now time.Time) (*Segment, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > SegmentNameMaxLength {
+		return nil, ErrSegmentNameInvalid
+	}
+	if filter.Status != "" {
+		if _, ok := memberStatusTransitions[filter.Status]; !ok {
+			return nil, ErrSegmentFilterInvalid
+		}
+	}
+	if filter.ReferredBy < 0 {
+		return nil, ErrSegmentFilterInvalid
+	}
+	var err error
+	if filter.TagsAny, err = normalizeSegmentTags(filter.TagsAny); err != nil {
+		return nil, err
+	}
+	if filter.TagsAll, err = normalizeSegmentTags(filter.TagsAll); err != nil {
+		return nil, err
+	}
+	if filter.IsEmpty() {
+		return nil, ErrSegmentFilterInvalid
+	}
+	return &Segment{
+		Name:      name,
+		Filter:    filter,
+		CreatedBy: createdBy,
+		CreatedAt: now,
+	}, nil
+}
+
+// normalizeSegmentTags 空的標籤條件維持 nil，JSON 才不會出現空陣列
+func normalizeSegmentTags(tags []string) ([]string, error) {
+	if len(tags) == 0 {
+		return nil, nil
+	}
+	return NormalizeTags(tags)
+}
@@ -0,0 +1,86 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MemberStatus 會員帳號狀態
+type MemberStatus string
+
+const (
+	// MemberStatusPending 已註冊但尚未啟用，不能登入
+	MemberStatusPending MemberStatus = "pending"
+	// MemberStatusActive 正常使用中
+	MemberStatusActive MemberStatus = "active"
+	// MemberStatusSuspended 暫時停權，可恢復
+	MemberStatusSuspended MemberStatus = "suspended"
+	// MemberStatusBanned 永久封鎖，只能由管理者解除
+	MemberStatusBanned MemberStatus = "banned"
+)
+
+// memberStatusTransitions 合法的狀態轉換，key 為目前狀態
+var memberStatusTransitions = map[MemberStatus][]MemberStatus{
+	MemberStatusPending:   {MemberStatusActive, MemberStatusBanned},
+	MemberStatusActive:    {MemberStatusSuspended, MemberStatusBanned},
+	MemberStatusSuspended: {MemberStatusActive, MemberStatusBanned},
+	MemberStatusBanned:    {MemberStatusActive},
+}
+
+// CanTransitionTo 是否可以從目前狀態轉換到 to，相同狀態不算轉換
+func (s MemberStatus) CanTransitionTo(to MemberStatus) bool {
+	for _, next := range memberStatusTransitions[s] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// requiresReason 停權與封鎖必須附上原因
+func (s MemberStatus) requiresReason() bool {
+	return s == MemberStatusSuspended || s == MemberStatusBanned
+}
+
+// StatusChange 一次狀態轉換的紀錄
+type StatusChange struct {
+	MemberID int
+	From     MemberStatus
+	To       MemberStatus
+	Reason   string
+	Actor    string
+	At       time.Time
+}
+
+// ChangeStatus 依狀態機轉換會員狀態，不合法時不修改會員
+//   - 轉換不在 memberStatusTransitions 中回傳 ErrIllegalStatusTransition
+//   - 原因會去除前後空白，轉為 suspended/banned 沒有原因時回傳 ErrStatusReasonRequired
+//   - 已被合併的會員回傳 ErrMemberMerged
+func (m *Member) ChangeStatus(to MemberStatus, reason, actor string, at time.Time) (StatusChange, error) {
+	if m.IsMerged() {
+		return StatusChange{}, ErrMemberMerged
+	}
+	from := m.Status
+	reason = strings.TrimSpace(reason)
+	if !from.CanTransitionTo(to) {
+		return StatusChange{}, fmt.Errorf("%w: %s -> %s", ErrIllegalStatusTransition, from, to)
+	}
+	if to.requiresReason() && reason == "" {
+		return StatusChange{}, ErrStatusReasonRequired
+	}
+	m.Status = to
+	m.StatusReason = reason
+	return StatusChange{MemberID: m.ID, From: from, To: to, Reason: reason, Actor: actor, At: at}, nil
+}
+
+// CanAuthenticate 只有 active 且未被合併的會員可以通過身分驗證
+func (m *Member) CanAuthenticate() error {
+	if m.IsMerged() {
+		return ErrMemberMerged
+	}
+	if m.Status != MemberStatusActive {
+		return fmt.Errorf("%w: %s", ErrMemberNotActive, m.Status)
+	}
+	return nil
+}
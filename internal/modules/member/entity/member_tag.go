@@ -0,0 +1,41 @@
+package entity
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// TagMaxLength 標籤最長長度
+const TagMaxLength = 32
+
+// tagPattern 標籤只能是小寫英數字、底線與連字號，且以英數字開頭
+var tagPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// NormalizeTag 標籤不分大小寫，前後空白不計；不符合規則時回傳 ErrTagInvalid
+func NormalizeTag(tag string) (string, error) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if len(tag) > TagMaxLength || !tagPattern.MatchString(tag) {
+		return "", ErrTagInvalid
+	}
+	return tag, nil
+}
+
+// NormalizeTags 逐一正規化標籤，去除重複並排序，方便比對與儲存
+func NormalizeTags(tags []string) ([]string, error) {
+	seen := make(map[string]struct{}, len(tags))
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		t, err := NormalizeTag(tag)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[t]; ok {
+			continue
+		}
+		seen[t] = struct{}{}
+		normalized = append(normalized, t)
+	}
+	sort.Strings(normalized)
+	return normalized, nil
+}
@@ -0,0 +1,10 @@
+package breachedpassword
+
+import "errors"
+
+var (
+	// ErrListDirNotFound 外洩密碼清單目錄不存在或不是目錄。
+	ErrListDirNotFound = errors.New("breachedpassword: list directory not found")
+	// ErrListRead 外洩密碼前綴檔讀取失敗。
+	ErrListRead = errors.New("breachedpassword: read prefix file failed")
+)
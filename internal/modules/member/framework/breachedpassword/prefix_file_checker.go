@@ -0,0 +1,78 @@
+package breachedpassword
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// prefixLength SHA-1 前綴長度，與 HIBP range API 相同（k-anonymity）
+const prefixLength = 5
+
+// PrefixFileChecker 實作 output.BreachedPasswordChecker，從本機目錄讀取 HIBP range 格式的前綴檔，不連網
+//   - 檔名為密碼 SHA-1 大寫十六進位的前 5 碼，可有 .txt 副檔名，例如 5BAA6 或 5BAA6.txt
+//   - 每行為 `剩餘 35 碼:出現次數`，次數為 0 的行是 HIBP 的 padding，不視為外洩；省略次數視為外洩
+//   - 前綴檔不存在表示該前綴沒有外洩紀錄
+//
+// 每次檢查只讀取一個前綴檔，清單可在不重啟服務的情況下更新
+type PrefixFileChecker struct {
+	dir string
+}
+
+// NewPrefixFileChecker dir 為前綴檔所在目錄，目錄不存在時回傳錯誤
+func NewPrefixFileChecker(dir string) (*PrefixFileChecker, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrListDirNotFound, err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%w: %s is not a directory", ErrListDirNotFound, dir)
+	}
+	return &PrefixFileChecker{dir: dir}, nil
+}
+
+func (c *PrefixFileChecker) IsBreached(ctx context.Context, password string) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := hash[:prefixLength], hash[prefixLength:]
+
+	file, err := c.openPrefixFile(prefix)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("%w: %v", ErrListRead, err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		entry, count, hasCount := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if !strings.EqualFold(entry, suffix) {
+			continue
+		}
+		return !hasCount || strings.TrimLeft(count, "0") != "", nil
+	}
+	if err := scanner.Err(); err != nil {
+		return false, fmt.Errorf("%w: %v", ErrListRead, err)
+	}
+	return false, nil
+}
+
+func (c *PrefixFileChecker) openPrefixFile(prefix string) (*os.File, error) {
+	file, err := os.Open(filepath.Join(c.dir, prefix))
+	if errors.Is(err, fs.ErrNotExist) {
+		return os.Open(filepath.Join(c.dir, prefix+".txt"))
+	}
+	return file, err
+}
@@ -0,0 +1,48 @@
+package breachedpassword
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrefixFileChecker_IsBreached(t *testing.T) {
+	dir := t.TempDir()
+	// SHA-1("password") = 5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "5BAA6"), []byte(
+		"003D68EB55068C33ACE09247EE4C639306B:3\r\n1e4c9b93f3f0682250b6cf8331b7ee68fd8:9545824\r\n",
+	), 0o644))
+	// SHA-1("P@ssw0rd") = 21BD12DC183F740EE76F27B78EB39C8AD972A757，count 0 為 padding
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "21BD1.txt"), []byte(
+		"2DC183F740EE76F27B78EB39C8AD972A757:0\n",
+	), 0o644))
+
+	checker, err := NewPrefixFileChecker(dir)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		password string
+		want     bool
+	}{
+		{name: "suffix listed case-insensitively", password: "password", want: true},
+		{name: "padding entry is not breached", password: "P@ssw0rd", want: false},
+		{name: "missing prefix file is not breached", password: "correct horse battery staple", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := checker.IsBreached(context.Background(), tt.password)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewPrefixFileChecker_MissingDir(t *testing.T) {
+	_, err := NewPrefixFileChecker(filepath.Join(t.TempDir(), "missing"))
+	assert.ErrorIs(t, err, ErrListDirNotFound)
+}
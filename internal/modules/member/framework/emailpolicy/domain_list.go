@@ -0,0 +1,129 @@
+package emailpolicy
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// domainSet 網域集合，比對時子網域也算命中（mx.example.com 命中 example.com）
+type domainSet map[string]struct{}
+
+func newDomainSet(domains []string) domainSet {
+	set := make(domainSet, len(domains))
+	for _, domain := range domains {
+		if d := cleanDomain(domain); d != "" {
+			set[d] = struct{}{}
+		}
+	}
+	return set
+}
+
+func (s domainSet) contains(domain string) bool {
+	for {
+		if _, ok := s[domain]; ok {
+			return true
+		}
+		dot := strings.IndexByte(domain, '.')
+		if dot < 0 {
+			return false
+		}
+		domain = domain[dot+1:]
+	}
+}
+
+// cleanDomain 轉小寫並去除萬用字元前綴，讓 *.example.com 與 .example.com 都等同 example.com
+func cleanDomain(domain string) string {
+	domain = strings.ToLower(strings.TrimSpace(domain))
+	domain = strings.TrimPrefix(domain, "*")
+	return strings.Trim(domain, ".")
+}
+
+// domainListFile 從本機檔案載入的網域清單，每行一個網域，# 開頭為註解
+//   - 不另開 goroutine 監看檔案：contains 時若距上次檢查超過 reloadInterval，
+//     就比對檔案的修改時間與大小，有變更才重新載入
+//   - 重新載入失敗時保留舊清單，避免檔案寫到一半時清單被清空
+type domainListFile struct {
+	path           string
+	reloadInterval time.Duration
+	now            func() time.Time
+	onReloadError  func(err error)
+
+	mu        sync.Mutex
+	domains   domainSet
+	modTime   time.Time
+	size      int64
+	checkedAt time.Time
+}
+
+func loadDomainListFile(path string, reloadInterval time.Duration, onReloadError func(err error)) (*domainListFile, error) {
+	l := &domainListFile{
+		path:           path,
+		reloadInterval: reloadInterval,
+		now:            time.Now,
+		onReloadError:  onReloadError,
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := l.load(info); err != nil {
+		return nil, err
+	}
+	l.checkedAt = l.now()
+	return l, nil
+}
+
+func (l *domainListFile) contains(domain string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.reloadIfChanged()
+	return l.domains.contains(domain)
+}
+
+// reloadIfChanged 須持有 mu
+func (l *domainListFile) reloadIfChanged() {
+	now := l.now()
+	if now.Sub(l.checkedAt) < l.reloadInterval {
+		return
+	}
+	l.checkedAt = now
+	info, err := os.Stat(l.path)
+	if err != nil {
+		l.onReloadError(err)
+		return
+	}
+	if info.ModTime().Equal(l.modTime) && info.Size() == l.size {
+		return
+	}
+	if err := l.load(info); err != nil {
+		l.onReloadError(err)
+	}
+}
+
+func (l *domainListFile) load(info os.FileInfo) error {
+	f, err := os.Open(l.path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var domains []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		domains = append(domains, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	l.domains = newDomainSet(domains)
+	l.modTime = info.ModTime()
+	l.size = info.Size()
+	return nil
+}
@@ -0,0 +1,149 @@
+package emailpolicy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"net"
+	"time"
+)
+
+const (
+	// ModeOpen 預設模式：除 blocklist 與拋棄式網域外都允許
+	ModeOpen = "open"
+	// ModeAllowlist 只允許 allowlist 中的網域，適用內部部署
+	ModeAllowlist = "allowlist"
+
+	// DefaultReloadInterval 預設多久檢查一次拋棄式網域清單檔案是否變更
+	DefaultReloadInterval = 30 * time.Second
+	// DefaultLookupTimeout 預設 MX/DNS 查詢逾時
+	DefaultLookupTimeout = 3 * time.Second
+)
+
+// Resolver DNS 查詢介面，*net.Resolver 即符合，測試時可替換
+type Resolver interface {
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+// Options Email 網域政策設定，零值為 open 模式且不做任何限制
+type Options struct {
+	Mode      string
+	Allowlist []string
+	Blocklist []string
+	// DisposableListPath 拋棄式網域清單檔案，空字串表示不檢查
+	DisposableListPath string
+	ReloadInterval     time.Duration
+	// CheckMX 是否查詢網域的 MX（無 MX 時退回 A/AAAA）紀錄
+	CheckMX       bool
+	LookupTimeout time.Duration
+}
+
+// DomainPolicy 實作 output.EmailPolicy
+//   - allowlist 模式下，allowlist 中的網域視為可信任，不再做其它檢查
+//   - DNS 查詢逾時或暫時性錯誤時放行，只有確定沒有收信主機才拒絕，避免 DNS 故障擋下所有註冊
+type DomainPolicy struct {
+	mode          string
+	allowlist     domainSet
+	blocklist     domainSet
+	disposable    *domainListFile
+	checkMX       bool
+	lookupTimeout time.Duration
+	resolver      Resolver
+	logger        logger.Logger
+}
+
+// NewDomainPolicy 依設定建立政策，拋棄式網域清單在此時第一次載入，讀取失敗直接回傳錯誤
+func NewDomainPolicy(opts Options, resolver Resolver, log logger.Logger) (*DomainPolicy, error) {
+	if opts.Mode == "" {
+		opts.Mode = ModeOpen
+	}
+	if opts.Mode != ModeOpen && opts.Mode != ModeAllowlist {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidMode, opts.Mode)
+	}
+	if opts.ReloadInterval <= 0 {
+		opts.ReloadInterval = DefaultReloadInterval
+	}
+	if opts.LookupTimeout <= 0 {
+		opts.LookupTimeout = DefaultLookupTimeout
+	}
+	p := &DomainPolicy{
+		mode:          opts.Mode,
+		allowlist:     newDomainSet(opts.Allowlist),
+		blocklist:     newDomainSet(opts.Blocklist),
+		checkMX:       opts.CheckMX,
+		lookupTimeout: opts.LookupTimeout,
+		resolver:      resolver,
+		logger:        log.With(logger.NewField("layer", "emailpolicy")),
+	}
+	if p.mode == ModeAllowlist && len(p.allowlist) == 0 {
+		return nil, ErrEmptyAllowlist
+	}
+	if opts.DisposableListPath != "" {
+		disposable, err := loadDomainListFile(opts.DisposableListPath, opts.ReloadInterval, func(err error) {
+			p.logger.Warn("拋棄式網域清單重新載入失敗，沿用舊清單",
+				logger.NewField("error", err),
+				logger.NewField("path", opts.DisposableListPath),
+			)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrDisposableListLoad, err)
+		}
+		p.disposable = disposable
+	}
+	return p, nil
+}
+
+func (p *DomainPolicy) Check(ctx context.Context, domain string) error {
+	if p.mode == ModeAllowlist {
+		if p.allowlist.contains(domain) {
+			return nil
+		}
+		return &output.EmailPolicyViolation{Domain: domain, Reason: output.EmailPolicyReasonNotAllowed}
+	}
+	if p.blocklist.contains(domain) {
+		return &output.EmailPolicyViolation{Domain: domain, Reason: output.EmailPolicyReasonBlocked}
+	}
+	if p.disposable != nil && p.disposable.contains(domain) {
+		return &output.EmailPolicyViolation{Domain: domain, Reason: output.EmailPolicyReasonDisposable}
+	}
+	if p.checkMX && !p.acceptsMail(ctx, domain) {
+		return &output.EmailPolicyViolation{Domain: domain, Reason: output.EmailPolicyReasonNoMailServer}
+	}
+	return nil
+}
+
+// acceptsMail 網域有 MX 紀錄，或沒有 MX 但有 A/AAAA 紀錄（RFC 5321 implicit MX）；
+// 只有 null MX（RFC 7505）或確定查無紀錄時回傳 false
+func (p *DomainPolicy) acceptsMail(ctx context.Context, domain string) bool {
+	lookupCtx, cancel := context.WithTimeout(ctx, p.lookupTimeout)
+	defer cancel()
+
+	records, err := p.resolver.LookupMX(lookupCtx, domain)
+	if err == nil && len(records) > 0 {
+		return !(len(records) == 1 && records[0].Host == ".")
+	}
+	if err != nil && !isNotFound(err) {
+		p.logger.Warn("Email 網域 MX 查詢失敗，略過檢查",
+			logger.NewField("error", err),
+			logger.NewField("domain", domain),
+		)
+		return true
+	}
+	hosts, err := p.resolver.LookupHost(lookupCtx, domain)
+	if err != nil && !isNotFound(err) {
+		p.logger.Warn("Email 網域主機查詢失敗，略過檢查",
+			logger.NewField("error", err),
+			logger.NewField("domain", domain),
+		)
+		return true
+	}
+	return len(hosts) > 0
+}
+
+func isNotFound(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
@@ -0,0 +1,164 @@
+package emailpolicy
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
+	mocklogger "github.com/tomoffice/go-clean-architecture/pkg/logger/mock"
+)
+
+// fakeResolver 以 map 模擬 DNS，未列出的網域回傳 NXDOMAIN
+type fakeResolver struct {
+	mx    map[string][]*net.MX
+	hosts map[string][]string
+	err   error
+}
+
+func (r fakeResolver) LookupMX(_ context.Context, name string) ([]*net.MX, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	if records, ok := r.mx[name]; ok {
+		return records, nil
+	}
+	return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+}
+
+func (r fakeResolver) LookupHost(_ context.Context, host string) ([]string, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	if addrs, ok := r.hosts[host]; ok {
+		return addrs, nil
+	}
+	return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+}
+
+func policyHelper(t *testing.T, opts Options, resolver Resolver) *DomainPolicy {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockLogger := mocklogger.NewMockLogger(ctrl)
+	mockLogger.EXPECT().With(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+	p, err := NewDomainPolicy(opts, resolver, mockLogger)
+	require.NoError(t, err)
+	return p
+}
+
+func writeList(t *testing.T, path, content string, modTime time.Time) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func reason(err error) output.EmailPolicyReason {
+	var violation *output.EmailPolicyViolation
+	if errors.As(err, &violation) {
+		return violation.Reason
+	}
+	return ""
+}
+
+func TestDomainPolicy_Check(t *testing.T) {
+	ctx := context.Background()
+	listPath := filepath.Join(t.TempDir(), "disposable.txt")
+	writeList(t, listPath, "# comment\n\nMailinator.com\n*.trash.example\n", time.Now())
+	resolver := fakeResolver{
+		mx: map[string][]*net.MX{
+			"example.com": {{Host: "mx.example.com.", Pref: 10}},
+			"nullmx.test": {{Host: ".", Pref: 0}},
+		},
+		hosts: map[string][]string{"a-only.test": {"192.0.2.1"}},
+	}
+	tests := []struct {
+		name       string
+		opts       Options
+		resolver   Resolver
+		domain     string
+		wantReason output.EmailPolicyReason
+	}{
+		{name: "zero options allow everything", domain: "anything.test"},
+		{name: "blocklist matches subdomain", opts: Options{Blocklist: []string{"Spam.test"}}, domain: "mx.spam.test", wantReason: output.EmailPolicyReasonBlocked},
+		{name: "disposable list from file", opts: Options{DisposableListPath: listPath}, domain: "mailinator.com", wantReason: output.EmailPolicyReasonDisposable},
+		{name: "disposable wildcard entry", opts: Options{DisposableListPath: listPath}, domain: "a.trash.example", wantReason: output.EmailPolicyReasonDisposable},
+		{name: "allowlist mode allows listed domain", opts: Options{Mode: ModeAllowlist, Allowlist: []string{"corp.example"}}, domain: "corp.example"},
+		{name: "allowlist mode rejects others", opts: Options{Mode: ModeAllowlist, Allowlist: []string{"corp.example"}}, domain: "gmail.com", wantReason: output.EmailPolicyReasonNotAllowed},
+		{name: "mx record accepts", opts: Options{CheckMX: true}, resolver: resolver, domain: "example.com"},
+		{name: "implicit mx via A record accepts", opts: Options{CheckMX: true}, resolver: resolver, domain: "a-only.test"},
+		{name: "null mx rejects", opts: Options{CheckMX: true}, resolver: resolver, domain: "nullmx.test", wantReason: output.EmailPolicyReasonNoMailServer},
+		{name: "nxdomain rejects", opts: Options{CheckMX: true}, resolver: resolver, domain: "missing.test", wantReason: output.EmailPolicyReasonNoMailServer},
+		{
+			name:     "dns failure fails open",
+			opts:     Options{CheckMX: true},
+			resolver: fakeResolver{err: &net.DNSError{Err: "i/o timeout", Name: "example.com", IsTimeout: true}},
+			domain:   "example.com",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := policyHelper(t, tt.opts, tt.resolver)
+			err := p.Check(ctx, tt.domain)
+			if tt.wantReason == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, tt.wantReason, reason(err))
+		})
+	}
+}
+
+func TestDomainPolicy_ReloadsDisposableList(t *testing.T) {
+	ctx := context.Background()
+	listPath := filepath.Join(t.TempDir(), "disposable.txt")
+	start := time.Now().Add(-time.Hour)
+	writeList(t, listPath, "mailinator.com\n", start)
+
+	p := policyHelper(t, Options{DisposableListPath: listPath, ReloadInterval: time.Minute}, nil)
+	now := time.Now()
+	p.disposable.now = func() time.Time { return now }
+
+	assert.NoError(t, p.Check(ctx, "yopmail.com"))
+
+	// 檔案變更但還沒到檢查間隔，沿用舊清單
+	writeList(t, listPath, "mailinator.com\nyopmail.com\n", start.Add(time.Second))
+	assert.NoError(t, p.Check(ctx, "yopmail.com"))
+
+	now = now.Add(time.Minute)
+	assert.Equal(t, output.EmailPolicyReasonDisposable, reason(p.Check(ctx, "yopmail.com")))
+
+	// 檔案被移除時保留舊清單
+	require.NoError(t, os.Remove(listPath))
+	now = now.Add(time.Minute)
+	assert.Equal(t, output.EmailPolicyReasonDisposable, reason(p.Check(ctx, "yopmail.com")))
+}
+
+func TestNewDomainPolicy_InvalidOptions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockLogger := mocklogger.NewMockLogger(ctrl)
+	mockLogger.EXPECT().With(gomock.Any()).Return(mockLogger).AnyTimes()
+
+	tests := []struct {
+		name    string
+		opts    Options
+		wantErr error
+	}{
+		{name: "unknown mode", opts: Options{Mode: "strict"}, wantErr: ErrInvalidMode},
+		{name: "allowlist mode without domains", opts: Options{Mode: ModeAllowlist}, wantErr: ErrEmptyAllowlist},
+		{name: "missing disposable list", opts: Options{DisposableListPath: filepath.Join(t.TempDir(), "missing.txt")}, wantErr: ErrDisposableListLoad},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewDomainPolicy(tt.opts, nil, mockLogger)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package emailpolicy
+
+import "errors"
+
+var (
+	// ErrInvalidMode 政策模式不是 open 或 allowlist。
+	ErrInvalidMode = errors.New("emailpolicy: invalid mode")
+	// ErrEmptyAllowlist allowlist 模式卻沒有設定任何網域，所有 Email 都會被拒絕。
+	ErrEmptyAllowlist = errors.New("emailpolicy: allowlist mode requires at least one domain")
+	// ErrDisposableListLoad 拋棄式網域清單檔案讀取失敗。
+	ErrDisposableListLoad = errors.New("emailpolicy: load disposable domain list failed")
+)
@@ -13,6 +13,8 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/member"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/membertag"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/tag"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -73,7 +75,9 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			member.Table: member.ValidColumn,
+			member.Table:    member.ValidColumn,
+			membertag.Table: membertag.ValidColumn,
+			tag.Table:       tag.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -0,0 +1,33 @@
+package mcent
+
+import (
+	"errors"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/sqlx/mcsqlite"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/sqlx/pgsql"
+)
+
+// mapEntError 將 ent 錯誤轉換為與 mcsqlite 相同的 DBError，gateway 不需區分實作；
+// ent 的錯誤包著 driver 的原始錯誤，依 driver 交給 sqlx 實作的錯誤分類，不比對訊息字串
+func mapEntError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if ent.IsNotFound(err) {
+		return wrap(err, mcsqlite.ErrDBRecordNotFound)
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgsql.MapSQLError(err)
+	}
+	// SQLite 的 driver 錯誤與 database/sql、context 的通用錯誤都由 mcsqlite 分類
+	return mcsqlite.MapSQLError(err)
+}
+
+func wrap(rawErr, customErr error) *mcsqlite.DBError {
+	return &mcsqlite.DBError{
+		CustomError: customErr,
+		RawError:    rawErr,
+	}
+}
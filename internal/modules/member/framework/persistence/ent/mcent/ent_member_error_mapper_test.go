@@ -0,0 +1,51 @@
+package mcent
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/sqlx/mcsqlite"
+)
+
+func TestMapEntError(t *testing.T) {
+	// ent 以 %w 包著 driver 的原始錯誤
+	entWrap := func(err error) error { return fmt.Errorf("ent: constraint failed: %w", err) }
+	tests := []struct {
+		name           string
+		err            error
+		want           error
+		wantConstraint string
+	}{
+		{name: "ent not found", err: &ent.NotFoundError{}, want: mcsqlite.ErrDBRecordNotFound},
+		{name: "no rows", err: sql.ErrNoRows, want: mcsqlite.ErrDBRecordNotFound},
+		{name: "sqlite unique", err: entWrap(sqlite3.Error{Code: sqlite3.ErrConstraint, ExtendedCode: sqlite3.ErrConstraintUnique}), want: mcsqlite.ErrDBDuplicateKey},
+		{name: "sqlite foreign key", err: entWrap(sqlite3.Error{Code: sqlite3.ErrConstraint, ExtendedCode: sqlite3.ErrConstraintForeignKey}), want: mcsqlite.ErrDBForeignKeyViolation},
+		{name: "sqlite not null", err: entWrap(sqlite3.Error{Code: sqlite3.ErrConstraint, ExtendedCode: sqlite3.ErrConstraintNotNull}), want: mcsqlite.ErrDBNotNullViolation},
+		{name: "sqlite busy", err: entWrap(sqlite3.Error{Code: sqlite3.ErrBusy}), want: mcsqlite.ErrDBBusy},
+		{name: "sqlite read only", err: entWrap(sqlite3.Error{Code: sqlite3.ErrReadonly}), want: mcsqlite.ErrDBReadOnly},
+		{name: "postgres unique", err: entWrap(&pgconn.PgError{Code: "23505", ConstraintName: "members_email_key"}), want: mcsqlite.ErrDBDuplicateKey, wantConstraint: "members_email_key"},
+		{name: "postgres foreign key", err: entWrap(&pgconn.PgError{Code: "23503", ConstraintName: "members_referred_by_fkey"}), want: mcsqlite.ErrDBForeignKeyViolation, wantConstraint: "members_referred_by_fkey"},
+		{name: "postgres read only", err: entWrap(&pgconn.PgError{Code: "25006"}), want: mcsqlite.ErrDBReadOnly},
+		{name: "message is not matched", err: errors.New("UNIQUE constraint failed: members.email"), want: mcsqlite.ErrDBUnexpectedError},
+		{name: "context canceled", err: context.Canceled, want: mcsqlite.ErrDBContextCanceled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mapEntError(tt.err)
+			assert.ErrorIs(t, got, tt.want)
+			var dbErr *mcsqlite.DBError
+			if assert.ErrorAs(t, got, &dbErr) {
+				assert.Equal(t, tt.err, dbErr.RawError)
+				assert.Equal(t, tt.wantConstraint, dbErr.Constraint)
+			}
+		})
+	}
+	assert.NoError(t, mapEntError(nil))
+}
@@ -0,0 +1,555 @@
+package mcent
+
+import (
+	"context"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxtx"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/member"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/predicate"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/tag"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/sqlx/mcsqlite"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/dao"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/enum"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
+	"time"
+)
+
+// queryClearInvitationReferrer 以 ? 撰寫，執行前依 driver 轉換 placeholder
+const queryClearInvitationReferrer = `UPDATE member_invitations SET referrer_id = NULL WHERE referrer_id = ?`
+
+// entMember 以 ent client 實作 dao.MemberDAO
+type entMember struct {
+	dialect string
+	db      *sqlx.DB
+	client  *ent.Client
+	logger  logger.Logger
+	tracer  tracer.Tracer
+}
+
+// NewEntMember 以既有的資料庫連線建立 ent 版本的 MemberDAO，與 sqlx 版本共用連線與 sqlxtx 交易
+func NewEntMember(db *sqlx.DB, log logger.Logger, tracer tracer.Tracer) dao.MemberDAO {
+	baseLogger := log.With(logger.NewField("layer", "repository"))
+	dialectName := entDialect(db.DriverName())
+	return &entMember{
+		dialect: dialectName,
+		db:      db,
+		client:  ent.NewClient(ent.Driver(entsql.OpenDB(dialectName, db.DB))),
+		logger:  baseLogger,
+		tracer:  tracer,
+	}
+}
+
+func (e entMember) Create(ctx context.Context, m *dao.MemberRecord) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, e.tracer, e.logger, "Repository.Create")
+	defer span.End()
+
+	startTime := time.Now()
+
+	created, err := e.entClient(repoCtx).Member.Create().
+		SetName(m.Name).
+		SetEmail(m.Email).
+		SetNillableNormalizedEmail(nillableString(m.NormalizedEmail)).
+		SetPassword(m.Password).
+		SetStatus(member.Status(m.Status)).
+		SetNillableReferredBy(nillableID(m.ReferredBy)).
+		Save(repoCtx)
+	duration := time.Since(startTime)
+
+	if err != nil {
+		contextLogger.Error("ent 插入失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_email", m.Email),
+			logger.NewField("duration_ms", duration.Milliseconds()),
+		)
+		return mapEntError(err)
+	}
+	m.ID = created.ID
+
+	contextLogger.Debug("ent 插入成功",
+		logger.NewField("member_id", created.ID),
+		logger.NewField("member_email", m.Email),
+		logger.NewField("duration_ms", duration.Milliseconds()),
+	)
+	return nil
+}
+func (e entMember) GetByID(ctx context.Context, id int) (*dao.MemberRecord, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, e.tracer, e.logger, "Repository.GetByID")
+	defer span.End()
+	startTime := time.Now()
+
+	found, err := e.entClient(repoCtx).Member.Get(repoCtx, id)
+	duration := time.Since(startTime)
+	if err != nil {
+		contextLogger.Error("ent 查詢(ID)失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+			logger.NewField("duration_ms", duration.Milliseconds()),
+		)
+		return nil, mapEntError(err)
+	}
+	contextLogger.Debug("ent 查詢(ID)成功",
+		logger.NewField("member_id", found.ID),
+		logger.NewField("member_email", found.Email),
+		logger.NewField("duration_ms", duration.Milliseconds()),
+	)
+	return entModelToDTO(found), nil
+}
+func (e entMember) GetByEmail(ctx context.Context, normalizedEmail string) (*dao.MemberRecord, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, e.tracer, e.logger, "Repository.GetByEmail")
+	defer span.End()
+	startTime := time.Now()
+
+	found, err := e.entClient(repoCtx).Member.Query().
+		Where(member.NormalizedEmail(normalizedEmail)).
+		Only(repoCtx)
+	duration := time.Since(startTime)
+	if err != nil {
+		contextLogger.Error("ent 查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("normalized_email", normalizedEmail),
+			logger.NewField("duration_ms", duration.Milliseconds()),
+		)
+		return nil, mapEntError(err)
+	}
+	contextLogger.Debug("ent 查詢成功",
+		logger.NewField("member_id", found.ID),
+		logger.NewField("normalized_email", normalizedEmail),
+		logger.NewField("duration_ms", duration.Milliseconds()),
+	)
+	return entModelToDTO(found), nil
+}
+func (e entMember) GetAll(ctx context.Context, q dao.MemberQuery, p pagination.Pagination) ([]*dao.MemberRecord, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, e.tracer, e.logger, "Repository.GetAll")
+	defer span.End()
+	startTime := time.Now()
+
+	if !member.ValidColumn(p.SortBy) {
+		err := fmt.Errorf("mcent: invalid sort column %q", p.SortBy)
+		contextLogger.Error("ent 列表查詢排序欄位不合法",
+			logger.NewField("error", err),
+			logger.NewField("sort_by", p.SortBy),
+		)
+		return nil, mapEntError(err)
+	}
+	direction := entsql.OrderAsc()
+	if p.OrderBy == enum.OrderByDesc {
+		direction = entsql.OrderDesc()
+	}
+	members, err := e.entClient(repoCtx).Member.Query().
+		Where(memberPredicates(q)...).
+		Order(entsql.OrderByField(p.SortBy, direction).ToFunc()).
+		Limit(p.Limit).
+		Offset(p.Offset).
+		All(repoCtx)
+	duration := time.Since(startTime)
+
+	if err != nil {
+		contextLogger.Error("ent 列表查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("limit", p.Limit),
+			logger.NewField("offset", p.Offset),
+			logger.NewField("duration_ms", duration.Milliseconds()),
+		)
+		return nil, mapEntError(err)
+	}
+	records := make([]*dao.MemberRecord, 0, len(members))
+	for _, found := range members {
+		records = append(records, entModelToDTO(found))
+	}
+	contextLogger.Debug("ent 列表查詢成功",
+		logger.NewField("count", len(members)),
+		logger.NewField("duration_ms", duration.Milliseconds()),
+	)
+	return records, nil
+}
+func (e entMember) CountAll(ctx context.Context, q dao.MemberQuery) (int, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, e.tracer, e.logger, "Repository.CountAll")
+	defer span.End()
+
+	startTime := time.Now()
+
+	count, err := e.entClient(repoCtx).Member.Query().
+		Where(memberPredicates(q)...).
+		Count(repoCtx)
+	duration := time.Since(startTime)
+
+	if err != nil {
+		contextLogger.Error("ent 總數查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("duration_ms", duration.Milliseconds()),
+		)
+		return 0, mapEntError(err)
+	}
+
+	contextLogger.Debug("ent 總數查詢成功",
+		logger.NewField("count", count),
+		logger.NewField("duration_ms", duration.Milliseconds()),
+	)
+	return count, nil
+}
+func (e entMember) UpdateProfile(ctx context.Context, m *dao.MemberRecord) (*dao.MemberRecord, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, e.tracer, e.logger, "Repository.UpdateProfile")
+	defer span.End()
+
+	startTime := time.Now()
+
+	rowsAffected, err := e.entClient(repoCtx).Member.Update().
+		Where(member.ID(m.ID)).
+		SetName(m.Name).
+		Save(repoCtx)
+	duration := time.Since(startTime)
+
+	if err != nil {
+		contextLogger.Error("ent 資料更新失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", m.ID),
+			logger.NewField("duration_ms", duration.Milliseconds()),
+		)
+		return nil, mapEntError(err)
+	}
+	if rowsAffected == 0 {
+		contextLogger.Error("ent 資料更新未影響任何行",
+			logger.NewField("member_id", m.ID),
+		)
+		return nil, mcsqlite.ErrDBNoEffect
+	}
+
+	contextLogger.Debug("ent 資料更新成功",
+		logger.NewField("member_id", m.ID),
+		logger.NewField("rows_affected", rowsAffected),
+		logger.NewField("duration_ms", duration.Milliseconds()),
+	)
+	return m, nil
+}
+func (e entMember) UpdateEmail(ctx context.Context, id int, email, normalizedEmail string) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, e.tracer, e.logger, "Repository.UpdateEmail")
+	defer span.End()
+
+	startTime := time.Now()
+
+	update := e.entClient(repoCtx).Member.Update().
+		Where(member.ID(id)).
+		SetEmail(email)
+	setNormalizedEmail(update, normalizedEmail)
+	rowsAffected, err := update.Save(repoCtx)
+	duration := time.Since(startTime)
+
+	if err != nil {
+		contextLogger.Error("ent Email 更新失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+			logger.NewField("new_email", email),
+			logger.NewField("duration_ms", duration.Milliseconds()),
+		)
+		return mapEntError(err)
+	}
+	if rowsAffected == 0 {
+		contextLogger.Error("ent Email 更新未影響任何行",
+			logger.NewField("member_id", id),
+		)
+		return mcsqlite.ErrDBNoEffect
+	}
+
+	contextLogger.Debug("ent Email 更新成功",
+		logger.NewField("member_id", id),
+		logger.NewField("new_email", email),
+		logger.NewField("rows_affected", rowsAffected),
+		logger.NewField("duration_ms", duration.Milliseconds()),
+	)
+	return nil
+}
+func (e entMember) UpdateNormalizedEmail(ctx context.Context, id int, normalizedEmail string) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, e.tracer, e.logger, "Repository.UpdateNormalizedEmail")
+	defer span.End()
+
+	startTime := time.Now()
+
+	update := e.entClient(repoCtx).Member.Update().
+		Where(member.ID(id))
+	setNormalizedEmail(update, normalizedEmail)
+	rowsAffected, err := update.Save(repoCtx)
+	duration := time.Since(startTime)
+
+	if err != nil {
+		contextLogger.Error("ent 正規化 Email 更新失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+			logger.NewField("normalized_email", normalizedEmail),
+			logger.NewField("duration_ms", duration.Milliseconds()),
+		)
+		return mapEntError(err)
+	}
+	if rowsAffected == 0 {
+		contextLogger.Error("ent 正規化 Email 更新未影響任何行",
+			logger.NewField("member_id", id),
+		)
+		return mcsqlite.ErrDBNoEffect
+	}
+
+	contextLogger.Debug("ent 正規化 Email 更新成功",
+		logger.NewField("member_id", id),
+		logger.NewField("normalized_email", normalizedEmail),
+		logger.NewField("rows_affected", rowsAffected),
+		logger.NewField("duration_ms", duration.Milliseconds()),
+	)
+	return nil
+}
+func (e entMember) UpdatePassword(ctx context.Context, id int, password string) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, e.tracer, e.logger, "Repository.UpdatePassword")
+	defer span.End()
+
+	startTime := time.Now()
+
+	rowsAffected, err := e.entClient(repoCtx).Member.Update().
+		Where(member.ID(id)).
+		SetPassword(password).
+		Save(repoCtx)
+	duration := time.Since(startTime)
+
+	if err != nil {
+		contextLogger.Error("ent 密碼更新失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+			logger.NewField("duration_ms", duration.Milliseconds()),
+		)
+		return mapEntError(err)
+	}
+	if rowsAffected == 0 {
+		contextLogger.Error("ent 密碼更新未影響任何行",
+			logger.NewField("member_id", id),
+		)
+		return mcsqlite.ErrDBNoEffect
+	}
+
+	contextLogger.Debug("ent 密碼更新成功",
+		logger.NewField("member_id", id),
+		logger.NewField("rows_affected", rowsAffected),
+		logger.NewField("duration_ms", duration.Milliseconds()),
+	)
+	return nil
+}
+func (e entMember) UpdateStatus(ctx context.Context, id int, from, to, reason string) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, e.tracer, e.logger, "Repository.UpdateStatus")
+	defer span.End()
+
+	startTime := time.Now()
+
+	// 只在狀態仍為轉換前的值時更新，避免並行的狀態變更互相覆蓋
+	rowsAffected, err := e.entClient(repoCtx).Member.Update().
+		Where(member.ID(id), member.StatusEQ(member.Status(from))).
+		SetStatus(member.Status(to)).
+		SetStatusReason(reason).
+		Save(repoCtx)
+	duration := time.Since(startTime)
+
+	if err != nil {
+		contextLogger.Error("ent 狀態更新失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+			logger.NewField("duration_ms", duration.Milliseconds()),
+		)
+		return mapEntError(err)
+	}
+	if rowsAffected == 0 {
+		contextLogger.Error("ent 狀態更新未影響任何行",
+			logger.NewField("member_id", id),
+			logger.NewField("from_status", from),
+		)
+		return mcsqlite.ErrDBNoEffect
+	}
+
+	contextLogger.Debug("ent 狀態更新成功",
+		logger.NewField("member_id", id),
+		logger.NewField("from_status", from),
+		logger.NewField("to_status", to),
+		logger.NewField("duration_ms", duration.Milliseconds()),
+	)
+	return nil
+}
+func (e entMember) MarkMerged(ctx context.Context, sourceID, targetID int) (int, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, e.tracer, e.logger, "Repository.MarkMerged")
+	defer span.End()
+
+	startTime := time.Now()
+	client := e.entClient(repoCtx)
+
+	// 只標記尚未被合併的會員，避免並行合併互相覆蓋
+	rowsAffected, err := client.Member.Update().
+		Where(member.ID(sourceID), member.MergedIntoIsNil()).
+		SetMergedInto(targetID).
+		Save(repoCtx)
+	if err != nil {
+		contextLogger.Error("ent 合併標記失敗",
+			logger.NewField("error", err),
+			logger.NewField("source_id", sourceID),
+			logger.NewField("target_id", targetID),
+		)
+		return 0, mapEntError(err)
+	}
+	if rowsAffected == 0 {
+		contextLogger.Error("ent 合併標記未影響任何行",
+			logger.NewField("source_id", sourceID),
+			logger.NewField("target_id", targetID),
+		)
+		return 0, mcsqlite.ErrDBNoEffect
+	}
+
+	// 先前合併到來源會員的 tombstone 改指向新的目標，維持單層指標
+	redirected, err := client.Member.Update().
+		Where(member.MergedInto(sourceID)).
+		SetMergedInto(targetID).
+		Save(repoCtx)
+	if err != nil {
+		contextLogger.Error("ent 合併轉指失敗",
+			logger.NewField("error", err),
+			logger.NewField("source_id", sourceID),
+			logger.NewField("target_id", targetID),
+		)
+		return 0, mapEntError(err)
+	}
+	duration := time.Since(startTime)
+
+	contextLogger.Debug("ent 合併標記成功",
+		logger.NewField("source_id", sourceID),
+		logger.NewField("target_id", targetID),
+		logger.NewField("redirected", redirected),
+		logger.NewField("duration_ms", duration.Milliseconds()),
+	)
+	return redirected, nil
+}
+func (e entMember) Delete(ctx context.Context, id int) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, e.tracer, e.logger, "Repository.Delete")
+	defer span.End()
+
+	startTime := time.Now()
+
+	// 清除外鍵與刪除須在同一個交易，由 use case 的交易保證
+	if err := e.clearReferences(repoCtx, id); err != nil {
+		contextLogger.Error("ent 刪除前清除外鍵失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+		)
+		return mapEntError(err)
+	}
+
+	rows, err := e.entClient(repoCtx).Member.Delete().
+		Where(member.ID(id)).
+		Exec(repoCtx)
+	duration := time.Since(startTime)
+
+	if err != nil {
+		contextLogger.Error("ent 刪除失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+			logger.NewField("duration_ms", duration.Milliseconds()),
+		)
+		return mapEntError(err)
+	}
+	if rows != 1 {
+		contextLogger.Error("ent 刪除未影響預期行數",
+			logger.NewField("member_id", id),
+			logger.NewField("rows_affected", rows),
+		)
+		return mcsqlite.ErrDBNoEffect
+	}
+
+	contextLogger.Debug("ent 刪除成功",
+		logger.NewField("member_id", id),
+		logger.NewField("rows_affected", rows),
+		logger.NewField("duration_ms", duration.Milliseconds()),
+	)
+	return nil
+}
+
+// clearReferences 刪除會員前清除指向它的 merged_into、referred_by 與邀請碼的 referrer_id，效果等同 ON DELETE SET NULL；
+// member_invitations 不在 ent schema 內，直接以 SQL 更新
+func (e entMember) clearReferences(ctx context.Context, id int) error {
+	client := e.entClient(ctx)
+	if _, err := client.Member.Update().Where(member.MergedInto(id)).ClearMergedInto().Save(ctx); err != nil {
+		return err
+	}
+	if _, err := client.Member.Update().Where(member.ReferredBy(id)).ClearReferredBy().Save(ctx); err != nil {
+		return err
+	}
+	_, err := sqlxtx.ExecutorFromContext(ctx, e.db).ExecContext(ctx, e.db.Rebind(queryClearInvitationReferrer), id)
+	return err
+}
+
+// memberPredicates 依查詢條件組出篩選條件；除非指定 MergedInto 或 IncludeMerged，
+// 一律排除已被合併的會員
+func memberPredicates(q dao.MemberQuery) []predicate.Member {
+	var predicates []predicate.Member
+	if q.Status != "" {
+		predicates = append(predicates, member.StatusEQ(member.Status(q.Status)))
+	}
+	if q.ReferredBy != 0 {
+		predicates = append(predicates, member.ReferredBy(q.ReferredBy))
+	}
+	switch {
+	case q.MergedInto != 0:
+		predicates = append(predicates, member.MergedInto(q.MergedInto))
+	case !q.IncludeMerged:
+		predicates = append(predicates, member.MergedIntoIsNil())
+	}
+	if len(q.TagsAny) > 0 {
+		predicates = append(predicates, member.HasTagsWith(tag.NameIn(q.TagsAny...)))
+	}
+	for _, name := range q.TagsAll {
+		predicates = append(predicates, member.HasTagsWith(tag.Name(name)))
+	}
+	return predicates
+}
+
+// setNormalizedEmail 空字串寫入 NULL，避免多筆未正規化的資料撞到 UNIQUE 索引
+func setNormalizedEmail(update *ent.MemberUpdate, normalizedEmail string) {
+	if normalizedEmail == "" {
+		update.ClearNormalizedEmail()
+		return
+	}
+	update.SetNormalizedEmail(normalizedEmail)
+}
+
+// entClient 有交易時以 context 中的 sqlxtx 交易建立 client，讓 ent 與 sqlx 的寫入在同一個交易內
+func (e entMember) entClient(ctx context.Context) *ent.Client {
+	if tx, ok := sqlxtx.TxFromContext(ctx); ok {
+		return ent.NewClient(ent.Driver(entsql.NewDriver(e.dialect, entsql.Conn{ExecQuerier: tx})))
+	}
+	return e.client
+}
+
+// entDialect 將 database/sql 的 driver 名稱對應到 ent 的 dialect
+func entDialect(driverName string) string {
+	switch driverName {
+	case "sqlite3":
+		return dialect.SQLite
+	case "pgx", "postgres":
+		return dialect.Postgres
+	case "mysql":
+		return dialect.MySQL
+	}
+	return driverName
+}
+
+func createTracedLogger(ctx context.Context, tr tracer.Tracer, log logger.Logger, operationName string) (context.Context, logger.Logger, tracer.Span) {
+	repoCtx, span := tr.Start(ctx, operationName)
+	lg := log.WithContext(repoCtx)
+	return repoCtx, lg, span
+}
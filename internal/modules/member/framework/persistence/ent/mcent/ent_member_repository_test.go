@@ -0,0 +1,222 @@
+package mcent
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxtx"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/sqlx/mcsqlite"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/dao"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/enum"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	mocklogger "github.com/tomoffice/go-clean-architecture/pkg/logger/mock"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer/adapters/basic"
+)
+
+// migratedDB 以 migrations 目錄的 SQL 建立資料表，確認 ent schema 與 migration 一致
+func migratedDB(t *testing.T) *sqlx.DB {
+	t.Helper()
+	db := sqlx.MustOpen("sqlite3", ":memory:")
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = db.Close() })
+	files, err := filepath.Glob("../../../../../../../migrations/*.up.sql")
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+	sort.Strings(files)
+	for _, file := range files {
+		migration, err := os.ReadFile(file)
+		require.NoError(t, err)
+		_, err = db.Exec(string(migration))
+		require.NoError(t, err, file)
+	}
+	return db
+}
+
+func newTestEntMember(t *testing.T, db *sqlx.DB) dao.MemberDAO {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockLogger := mocklogger.NewMockLogger(ctrl)
+	mockLogger.EXPECT().With(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+	return NewEntMember(db, mockLogger, basic.NewTracer(basic.NewConfig("test", false)))
+}
+
+func TestEntMember_Members(t *testing.T) {
+	db := migratedDB(t)
+	repo := newTestEntMember(t, db)
+	ctx := context.Background()
+	page := pagination.Pagination{Limit: 10, SortBy: "id", OrderBy: enum.OrderByAsc}
+
+	for _, m := range []*dao.MemberRecord{
+		{Name: "alice", Email: "Alice@Example.com", NormalizedEmail: "alice@example.com", Password: "p", Status: "active"},
+		{Name: "bob", Email: "bob@example.com", NormalizedEmail: "bob@example.com", Password: "p", Status: "pending", ReferredBy: 1},
+		{Name: "carol", Email: "carol@example.com", Password: "p", Status: "active"},
+	} {
+		require.NoError(t, repo.Create(ctx, m))
+	}
+	err := repo.Create(ctx, &dao.MemberRecord{Name: "dup", Email: "x@example.com", NormalizedEmail: "alice@example.com", Password: "p", Status: "active"})
+	assert.ErrorIs(t, err, mcsqlite.ErrDBDuplicateKey)
+
+	got, err := repo.GetByEmail(ctx, "alice@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "Alice@Example.com", got.Email)
+	assert.False(t, got.CreatedAt.IsZero())
+	_, err = repo.GetByID(ctx, 99)
+	assert.ErrorIs(t, err, mcsqlite.ErrDBRecordNotFound)
+
+	tests := []struct {
+		name    string
+		query   dao.MemberQuery
+		wantIDs []int
+	}{
+		{name: "all", query: dao.MemberQuery{}, wantIDs: []int{1, 2, 3}},
+		{name: "status", query: dao.MemberQuery{Status: "pending"}, wantIDs: []int{2}},
+		{name: "referred by", query: dao.MemberQuery{ReferredBy: 1}, wantIDs: []int{2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			records, err := repo.GetAll(ctx, tt.query, page)
+			require.NoError(t, err)
+			ids := make([]int, 0, len(records))
+			for _, r := range records {
+				ids = append(ids, r.ID)
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+			count, err := repo.CountAll(ctx, tt.query)
+			require.NoError(t, err)
+			assert.Equal(t, len(tt.wantIDs), count)
+		})
+	}
+
+	assert.ErrorIs(t, repo.UpdateStatus(ctx, 2, "active", "suspended", ""), mcsqlite.ErrDBNoEffect)
+	assert.NoError(t, repo.UpdateStatus(ctx, 2, "pending", "active", "approved"))
+	assert.NoError(t, repo.UpdateEmail(ctx, 3, "carol@new.example.com", ""))
+	assert.NoError(t, repo.UpdatePassword(ctx, 3, "q"))
+	_, err = repo.UpdateProfile(ctx, &dao.MemberRecord{ID: 3, Name: "caroline"})
+	assert.NoError(t, err)
+	got, err = repo.GetByID(ctx, 3)
+	require.NoError(t, err)
+	assert.Equal(t, dao.MemberRecord{ID: 3, Name: "caroline", Email: "carol@new.example.com", Password: "q", Status: "active", CreatedAt: got.CreatedAt, UpdatedAt: got.UpdatedAt}, *got)
+	// updated_at 由 ent 的 UpdateDefault 在每次更新時改寫
+	assert.True(t, got.UpdatedAt.After(got.CreatedAt))
+
+	// 合併與標籤寫在 sqlxtx 交易內，回滾後不留下任何變更
+	txManager := sqlxtx.NewTxManager(db)
+	err = txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
+		redirected, err := repo.MarkMerged(txCtx, 2, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 0, redirected)
+		return assert.AnError
+	})
+	assert.ErrorIs(t, err, assert.AnError)
+	got, err = repo.GetByID(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 0, got.MergedInto)
+
+	redirected, err := repo.MarkMerged(ctx, 3, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 0, redirected)
+	redirected, err = repo.MarkMerged(ctx, 2, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, redirected)
+	_, err = repo.MarkMerged(ctx, 2, 1)
+	assert.ErrorIs(t, err, mcsqlite.ErrDBNoEffect)
+	merged, err := repo.GetAll(ctx, dao.MemberQuery{MergedInto: 1}, page)
+	require.NoError(t, err)
+	assert.Len(t, merged, 2)
+
+	assert.NoError(t, repo.Delete(ctx, 3))
+	assert.ErrorIs(t, repo.Delete(ctx, 3), mcsqlite.ErrDBNoEffect)
+}
+
+func TestEntMember_Tags(t *testing.T) {
+	db := migratedDB(t)
+	repo := newTestEntMember(t, db)
+	ctx := context.Background()
+	page := pagination.Pagination{Limit: 10, SortBy: "id", OrderBy: enum.OrderByAsc}
+	for _, name := range []string{"a", "b", "c"} {
+		require.NoError(t, repo.Create(ctx, &dao.MemberRecord{Name: name, Email: name + "@example.com", NormalizedEmail: name + "@example.com", Password: "p", Status: "active"}))
+	}
+
+	assert.NoError(t, repo.AddTag(ctx, 1, "vip"))
+	assert.ErrorIs(t, repo.AddTag(ctx, 1, "vip"), mcsqlite.ErrDBNoEffect)
+	assert.ErrorIs(t, repo.AddTag(ctx, 99, "vip"), mcsqlite.ErrDBNoEffect)
+	assert.NoError(t, repo.AddTag(ctx, 1, "beta"))
+	tagged, err := repo.AddTagToMembers(ctx, []int{1, 2, 99}, "vip")
+	require.NoError(t, err)
+	assert.Equal(t, 1, tagged)
+
+	tags, err := repo.ListTags(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"beta", "vip"}, tags)
+
+	count, err := repo.CountAll(ctx, dao.MemberQuery{TagsAny: []string{"vip", "beta"}})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	all, err := repo.GetAll(ctx, dao.MemberQuery{TagsAll: []string{"vip", "beta"}}, page)
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	assert.Equal(t, 1, all[0].ID)
+
+	carried, err := repo.MergeTags(ctx, 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 1, carried)
+	tags, err = repo.ListTags(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"beta", "vip"}, tags)
+
+	assert.NoError(t, repo.RemoveTag(ctx, 2, "vip"))
+	assert.ErrorIs(t, repo.RemoveTag(ctx, 2, "vip"), mcsqlite.ErrDBNoEffect)
+	assert.ErrorIs(t, repo.RemoveTag(ctx, 2, "missing"), mcsqlite.ErrDBNoEffect)
+}
+
+func TestEntMember_DeleteReferencedMember(t *testing.T) {
+	db := migratedDB(t)
+	// 與預設 pragma 相同開啟外鍵約束；migratedDB 只有一條連線，設定對之後的操作都有效
+	db.MustExec(`PRAGMA foreign_keys = ON`)
+	repo := newTestEntMember(t, db)
+	txManager := sqlxtx.NewTxManager(db)
+	ctx := context.Background()
+
+	create := func(name string, referredBy int) int {
+		record := &dao.MemberRecord{Name: name, Email: name + "@example.com", NormalizedEmail: name + "@example.com", Password: "p", Status: "active", ReferredBy: referredBy}
+		require.NoError(t, repo.Create(ctx, record))
+		return record.ID
+	}
+	referrer := create("referrer", 0)
+	referred := create("referred", referrer)
+	db.MustExec(`INSERT INTO member_invitations (code, created_by, referrer_id) VALUES ('INVITE', ?, ?)`, "referrer", referrer)
+	target := create("target", 0)
+	source := create("source", 0)
+	_, err := repo.MarkMerged(ctx, source, target)
+	require.NoError(t, err)
+
+	// 與 DeleteMember 相同，在交易中刪除
+	for _, id := range []int{referrer, target} {
+		err := txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
+			return repo.Delete(txCtx, id)
+		})
+		require.NoError(t, err, id)
+	}
+
+	got, err := repo.GetByID(ctx, referred)
+	require.NoError(t, err)
+	assert.Zero(t, got.ReferredBy)
+	got, err = repo.GetByID(ctx, source)
+	require.NoError(t, err)
+	assert.Zero(t, got.MergedInto)
+	var invitationReferrer sql.NullInt64
+	require.NoError(t, db.Get(&invitationReferrer, `SELECT referrer_id FROM member_invitations WHERE code = 'INVITE'`))
+	assert.False(t, invitationReferrer.Valid)
+}
@@ -0,0 +1,254 @@
+package mcent
+
+import (
+	"context"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/member"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/membertag"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/tag"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/sqlx/mcsqlite"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"time"
+)
+
+func (e entMember) AddTag(ctx context.Context, memberID int, name string) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, e.tracer, e.logger, "Repository.AddTag")
+	defer span.End()
+
+	startTime := time.Now()
+	client := e.entClient(repoCtx)
+
+	// 只為存在的會員加上標籤，與 sqlx 版本一致，不存在時回傳 no effect
+	exists, err := client.Member.Query().Where(member.ID(memberID)).Exist(repoCtx)
+	if err != nil {
+		contextLogger.Error("ent 會員加標籤查詢會員失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", memberID),
+		)
+		return mapEntError(err)
+	}
+	if !exists {
+		contextLogger.Debug("ent 會員加標籤未影響任何行",
+			logger.NewField("member_id", memberID),
+			logger.NewField("tag", name),
+		)
+		return mcsqlite.ErrDBNoEffect
+	}
+	tagID, err := ensureTag(repoCtx, client, name)
+	if err != nil {
+		contextLogger.Error("ent 標籤建立失敗",
+			logger.NewField("error", err),
+			logger.NewField("tag", name),
+		)
+		return mapEntError(err)
+	}
+	err = client.MemberTag.Create().SetMemberID(memberID).SetTagID(tagID).Exec(repoCtx)
+	duration := time.Since(startTime)
+
+	if ent.IsConstraintError(err) {
+		contextLogger.Debug("ent 會員加標籤未影響任何行",
+			logger.NewField("member_id", memberID),
+			logger.NewField("tag", name),
+		)
+		return mcsqlite.ErrDBNoEffect
+	}
+	if err != nil {
+		contextLogger.Error("ent 會員加標籤失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", memberID),
+			logger.NewField("tag", name),
+			logger.NewField("duration_ms", duration.Milliseconds()),
+		)
+		return mapEntError(err)
+	}
+
+	contextLogger.Debug("ent 會員加標籤成功",
+		logger.NewField("member_id", memberID),
+		logger.NewField("tag", name),
+		logger.NewField("duration_ms", duration.Milliseconds()),
+	)
+	return nil
+}
+
+func (e entMember) RemoveTag(ctx context.Context, memberID int, name string) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, e.tracer, e.logger, "Repository.RemoveTag")
+	defer span.End()
+
+	startTime := time.Now()
+
+	rowsAffected, err := e.entClient(repoCtx).MemberTag.Delete().
+		Where(membertag.MemberID(memberID), membertag.HasTagWith(tag.Name(name))).
+		Exec(repoCtx)
+	duration := time.Since(startTime)
+
+	if err != nil {
+		contextLogger.Error("ent 會員移除標籤失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", memberID),
+			logger.NewField("tag", name),
+			logger.NewField("duration_ms", duration.Milliseconds()),
+		)
+		return mapEntError(err)
+	}
+	if rowsAffected == 0 {
+		contextLogger.Debug("ent 會員移除標籤未影響任何行",
+			logger.NewField("member_id", memberID),
+			logger.NewField("tag", name),
+		)
+		return mcsqlite.ErrDBNoEffect
+	}
+
+	contextLogger.Debug("ent 會員移除標籤成功",
+		logger.NewField("member_id", memberID),
+		logger.NewField("tag", name),
+		logger.NewField("duration_ms", duration.Milliseconds()),
+	)
+	return nil
+}
+
+func (e entMember) AddTagToMembers(ctx context.Context, memberIDs []int, name string) (int, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, e.tracer, e.logger, "Repository.AddTagToMembers")
+	defer span.End()
+
+	if len(memberIDs) == 0 {
+		return 0, nil
+	}
+	startTime := time.Now()
+	client := e.entClient(repoCtx)
+
+	tagID, err := ensureTag(repoCtx, client, name)
+	if err != nil {
+		contextLogger.Error("ent 標籤建立失敗",
+			logger.NewField("error", err),
+			logger.NewField("tag", name),
+		)
+		return 0, mapEntError(err)
+	}
+	// 略過不存在、已合併或已有此標籤的會員
+	ids, err := client.Member.Query().
+		Where(
+			member.IDIn(memberIDs...),
+			member.MergedIntoIsNil(),
+			member.Not(member.HasTagsWith(tag.ID(tagID))),
+		).
+		IDs(repoCtx)
+	if err == nil && len(ids) > 0 {
+		builders := make([]*ent.MemberTagCreate, 0, len(ids))
+		for _, id := range ids {
+			builders = append(builders, client.MemberTag.Create().SetMemberID(id).SetTagID(tagID))
+		}
+		err = client.MemberTag.CreateBulk(builders...).Exec(repoCtx)
+	}
+	duration := time.Since(startTime)
+
+	if err != nil {
+		contextLogger.Error("ent 批次加標籤失敗",
+			logger.NewField("error", err),
+			logger.NewField("tag", name),
+			logger.NewField("count", len(memberIDs)),
+			logger.NewField("duration_ms", duration.Milliseconds()),
+		)
+		return 0, mapEntError(err)
+	}
+
+	contextLogger.Debug("ent 批次加標籤成功",
+		logger.NewField("tag", name),
+		logger.NewField("count", len(memberIDs)),
+		logger.NewField("tagged", len(ids)),
+		logger.NewField("duration_ms", duration.Milliseconds()),
+	)
+	return len(ids), nil
+}
+
+func (e entMember) ListTags(ctx context.Context, memberID int) ([]string, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, e.tracer, e.logger, "Repository.ListTags")
+	defer span.End()
+
+	startTime := time.Now()
+
+	tags, err := e.entClient(repoCtx).Tag.Query().
+		Where(tag.HasMembersWith(member.ID(memberID))).
+		Order(ent.Asc(tag.FieldName)).
+		Select(tag.FieldName).
+		Strings(repoCtx)
+	duration := time.Since(startTime)
+
+	if err != nil {
+		contextLogger.Error("ent 會員標籤查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", memberID),
+			logger.NewField("duration_ms", duration.Milliseconds()),
+		)
+		return nil, mapEntError(err)
+	}
+
+	contextLogger.Debug("ent 會員標籤查詢成功",
+		logger.NewField("member_id", memberID),
+		logger.NewField("count", len(tags)),
+		logger.NewField("duration_ms", duration.Milliseconds()),
+	)
+	return tags, nil
+}
+
+func (e entMember) MergeTags(ctx context.Context, sourceID, targetID int) (int, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, e.tracer, e.logger, "Repository.MergeTags")
+	defer span.End()
+
+	startTime := time.Now()
+	client := e.entClient(repoCtx)
+
+	// 目標已有的標籤忽略，保留原本加上的時間
+	carried, err := client.MemberTag.Query().
+		Where(
+			membertag.MemberID(sourceID),
+			membertag.Not(membertag.HasTagWith(tag.HasMembersWith(member.ID(targetID)))),
+		).
+		All(repoCtx)
+	if err == nil && len(carried) > 0 {
+		builders := make([]*ent.MemberTagCreate, 0, len(carried))
+		for _, mt := range carried {
+			builders = append(builders, client.MemberTag.Create().SetMemberID(targetID).SetTagID(mt.TagID).SetCreatedAt(mt.CreatedAt))
+		}
+		err = client.MemberTag.CreateBulk(builders...).Exec(repoCtx)
+	}
+	duration := time.Since(startTime)
+
+	if err != nil {
+		contextLogger.Error("ent 合併標籤失敗",
+			logger.NewField("error", err),
+			logger.NewField("source_id", sourceID),
+			logger.NewField("target_id", targetID),
+			logger.NewField("duration_ms", duration.Milliseconds()),
+		)
+		return 0, mapEntError(err)
+	}
+
+	contextLogger.Debug("ent 合併標籤成功",
+		logger.NewField("source_id", sourceID),
+		logger.NewField("target_id", targetID),
+		logger.NewField("carried", len(carried)),
+		logger.NewField("duration_ms", duration.Milliseconds()),
+	)
+	return len(carried), nil
+}
+
+// ensureTag 回傳標籤 ID，標籤不存在時建立；並行建立撞到 UNIQUE 時重新查詢
+func ensureTag(ctx context.Context, client *ent.Client, name string) (int, error) {
+	id, err := client.Tag.Query().Where(tag.Name(name)).OnlyID(ctx)
+	if err == nil || !ent.IsNotFound(err) {
+		return id, err
+	}
+	created, err := client.Tag.Create().SetName(name).Save(ctx)
+	if ent.IsConstraintError(err) {
+		return client.Tag.Query().Where(tag.Name(name)).OnlyID(ctx)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return created.ID, nil
+}
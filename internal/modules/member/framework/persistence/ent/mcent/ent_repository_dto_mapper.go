@@ -0,0 +1,49 @@
+package mcent
+
+import (
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/dao"
+)
+
+func entModelToDTO(model *ent.Member) *dao.MemberRecord {
+	record := &dao.MemberRecord{
+		ID:           model.ID,
+		Name:         model.Name,
+		Email:        model.Email,
+		Password:     model.Password,
+		Status:       string(model.Status),
+		StatusReason: model.StatusReason,
+		CreatedAt:    model.CreatedAt.UTC(),
+		UpdatedAt:    model.UpdatedAt.UTC(),
+	}
+	// SQLite 由其他工具新增的資料 updated_at 可能為 NULL，視同 created_at
+	if model.UpdatedAt.IsZero() {
+		record.UpdatedAt = record.CreatedAt
+	}
+	if model.NormalizedEmail != nil {
+		record.NormalizedEmail = *model.NormalizedEmail
+	}
+	if model.MergedInto != nil {
+		record.MergedInto = *model.MergedInto
+	}
+	if model.ReferredBy != nil {
+		record.ReferredBy = *model.ReferredBy
+	}
+	return record
+}
+
+// nillableString 空字串寫入 NULL，避免多筆未正規化的資料撞到 UNIQUE 索引
+func nillableString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// nillableID 0 寫入 NULL，供可為空的外鍵欄位使用
+func nillableID(id int) *int {
+	if id == 0 {
+		return nil
+	}
+	return &id
+}
@@ -21,23 +21,115 @@ type Member struct {
 	Name string `json:"name,omitempty"`
 	// Email holds the value of the "email" field.
 	Email string `json:"email,omitempty"`
+	// NormalizedEmail holds the value of the "normalized_email" field.
+	NormalizedEmail *string `json:"normalized_email,omitempty"`
 	// Password holds the value of the "password" field.
 	Password string `json:"password,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
-	CreatedAt    time.Time `json:"created_at,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Status holds the value of the "status" field.
+	Status member.Status `json:"status,omitempty"`
+	// StatusReason holds the value of the "status_reason" field.
+	StatusReason string `json:"status_reason,omitempty"`
+	// MergedInto holds the value of the "merged_into" field.
+	MergedInto *int `json:"merged_into,omitempty"`
+	// ReferredBy holds the value of the "referred_by" field.
+	ReferredBy *int `json:"referred_by,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MemberQuery when eager-loading is set.
+	Edges        MemberEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// MemberEdges holds the relations/edges for other nodes in the graph.
+type MemberEdges struct {
+	// MergedIntoMember holds the value of the merged_into_member edge.
+	MergedIntoMember *Member `json:"merged_into_member,omitempty"`
+	// MergedMembers holds the value of the merged_members edge.
+	MergedMembers []*Member `json:"merged_members,omitempty"`
+	// Referrer holds the value of the referrer edge.
+	Referrer *Member `json:"referrer,omitempty"`
+	// Referrals holds the value of the referrals edge.
+	Referrals []*Member `json:"referrals,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
+	// MemberTags holds the value of the member_tags edge.
+	MemberTags []*MemberTag `json:"member_tags,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [6]bool
+}
+
+// MergedIntoMemberOrErr returns the MergedIntoMember value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MemberEdges) MergedIntoMemberOrErr() (*Member, error) {
+	if e.MergedIntoMember != nil {
+		return e.MergedIntoMember, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: member.Label}
+	}
+	return nil, &NotLoadedError{edge: "merged_into_member"}
+}
+
+// MergedMembersOrErr returns the MergedMembers value or an error if the edge
+// was not loaded in eager-loading.
+func (e MemberEdges) MergedMembersOrErr() ([]*Member, error) {
+	if e.loadedTypes[1] {
+		return e.MergedMembers, nil
+	}
+	return nil, &NotLoadedError{edge: "merged_members"}
+}
+
+// ReferrerOrErr returns the Referrer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MemberEdges) ReferrerOrErr() (*Member, error) {
+	if e.Referrer != nil {
+		return e.Referrer, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: member.Label}
+	}
+	return nil, &NotLoadedError{edge: "referrer"}
+}
+
+// ReferralsOrErr returns the Referrals value or an error if the edge
+// was not loaded in eager-loading.
+func (e MemberEdges) ReferralsOrErr() ([]*Member, error) {
+	if e.loadedTypes[3] {
+		return e.Referrals, nil
+	}
+	return nil, &NotLoadedError{edge: "referrals"}
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e MemberEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[4] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
+// MemberTagsOrErr returns the MemberTags value or an error if the edge
+// was not loaded in eager-loading.
+func (e MemberEdges) MemberTagsOrErr() ([]*MemberTag, error) {
+	if e.loadedTypes[5] {
+		return e.MemberTags, nil
+	}
+	return nil, &NotLoadedError{edge: "member_tags"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Member) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case member.FieldID:
+		case member.FieldID, member.FieldMergedInto, member.FieldReferredBy:
 			values[i] = new(sql.NullInt64)
-		case member.FieldName, member.FieldEmail, member.FieldPassword:
+		case member.FieldName, member.FieldEmail, member.FieldNormalizedEmail, member.FieldPassword, member.FieldStatus, member.FieldStatusReason:
 			values[i] = new(sql.NullString)
-		case member.FieldCreatedAt:
+		case member.FieldCreatedAt, member.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -72,6 +164,13 @@ func (m *Member) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				m.Email = value.String
 			}
+		case member.FieldNormalizedEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field normalized_email", values[i])
+			} else if value.Valid {
+				m.NormalizedEmail = new(string)
+				*m.NormalizedEmail = value.String
+			}
 		case member.FieldPassword:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field password", values[i])
@@ -84,6 +183,38 @@ func (m *Member) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				m.CreatedAt = value.Time
 			}
+		case member.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				m.UpdatedAt = value.Time
+			}
+		case member.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				m.Status = member.Status(value.String)
+			}
+		case member.FieldStatusReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status_reason", values[i])
+			} else if value.Valid {
+				m.StatusReason = value.String
+			}
+		case member.FieldMergedInto:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field merged_into", values[i])
+			} else if value.Valid {
+				m.MergedInto = new(int)
+				*m.MergedInto = int(value.Int64)
+			}
+		case member.FieldReferredBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field referred_by", values[i])
+			} else if value.Valid {
+				m.ReferredBy = new(int)
+				*m.ReferredBy = int(value.Int64)
+			}
 		default:
 			m.selectValues.Set(columns[i], values[i])
 		}
@@ -97,6 +228,36 @@ func (m *Member) Value(name string) (ent.Value, error) {
 	return m.selectValues.Get(name)
 }
 
+// QueryMergedIntoMember queries the "merged_into_member" edge of the Member entity.
+func (m *Member) QueryMergedIntoMember() *MemberQuery {
+	return NewMemberClient(m.config).QueryMergedIntoMember(m)
+}
+
+// QueryMergedMembers queries the "merged_members" edge of the Member entity.
+func (m *Member) QueryMergedMembers() *MemberQuery {
+	return NewMemberClient(m.config).QueryMergedMembers(m)
+}
+
+// QueryReferrer queries the "referrer" edge of the Member entity.
+func (m *Member) QueryReferrer() *MemberQuery {
+	return NewMemberClient(m.config).QueryReferrer(m)
+}
+
+// QueryReferrals queries the "referrals" edge of the Member entity.
+func (m *Member) QueryReferrals() *MemberQuery {
+	return NewMemberClient(m.config).QueryReferrals(m)
+}
+
+// QueryTags queries the "tags" edge of the Member entity.
+func (m *Member) QueryTags() *TagQuery {
+	return NewMemberClient(m.config).QueryTags(m)
+}
+
+// QueryMemberTags queries the "member_tags" edge of the Member entity.
+func (m *Member) QueryMemberTags() *MemberTagQuery {
+	return NewMemberClient(m.config).QueryMemberTags(m)
+}
+
 // Update returns a builder for updating this Member.
 // Note that you need to call Member.Unwrap() before calling this method if this Member
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -126,11 +287,35 @@ func (m *Member) String() string {
 	builder.WriteString("email=")
 	builder.WriteString(m.Email)
 	builder.WriteString(", ")
+	if v := m.NormalizedEmail; v != nil {
+		builder.WriteString("normalized_email=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("password=")
 	builder.WriteString(m.Password)
 	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("status_reason=")
+	builder.WriteString(m.StatusReason)
+	builder.WriteString(", ")
+	if v := m.MergedInto; v != nil {
+		builder.WriteString("merged_into=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := m.ReferredBy; v != nil {
+		builder.WriteString("referred_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
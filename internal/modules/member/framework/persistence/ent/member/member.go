@@ -3,9 +3,11 @@
 package member
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 const (
@@ -17,12 +19,64 @@ const (
 	FieldName = "name"
 	// FieldEmail holds the string denoting the email field in the database.
 	FieldEmail = "email"
+	// FieldNormalizedEmail holds the string denoting the normalized_email field in the database.
+	FieldNormalizedEmail = "normalized_email"
 	// FieldPassword holds the string denoting the password field in the database.
 	FieldPassword = "password"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldStatusReason holds the string denoting the status_reason field in the database.
+	FieldStatusReason = "status_reason"
+	// FieldMergedInto holds the string denoting the merged_into field in the database.
+	FieldMergedInto = "merged_into"
+	// FieldReferredBy holds the string denoting the referred_by field in the database.
+	FieldReferredBy = "referred_by"
+	// EdgeMergedIntoMember holds the string denoting the merged_into_member edge name in mutations.
+	EdgeMergedIntoMember = "merged_into_member"
+	// EdgeMergedMembers holds the string denoting the merged_members edge name in mutations.
+	EdgeMergedMembers = "merged_members"
+	// EdgeReferrer holds the string denoting the referrer edge name in mutations.
+	EdgeReferrer = "referrer"
+	// EdgeReferrals holds the string denoting the referrals edge name in mutations.
+	EdgeReferrals = "referrals"
+	// EdgeTags holds the string denoting the tags edge name in mutations.
+	EdgeTags = "tags"
+	// EdgeMemberTags holds the string denoting the member_tags edge name in mutations.
+	EdgeMemberTags = "member_tags"
 	// Table holds the table name of the member in the database.
 	Table = "members"
+	// MergedIntoMemberTable is the table that holds the merged_into_member relation/edge.
+	MergedIntoMemberTable = "members"
+	// MergedIntoMemberColumn is the table column denoting the merged_into_member relation/edge.
+	MergedIntoMemberColumn = "merged_into"
+	// MergedMembersTable is the table that holds the merged_members relation/edge.
+	MergedMembersTable = "members"
+	// MergedMembersColumn is the table column denoting the merged_members relation/edge.
+	MergedMembersColumn = "merged_into"
+	// ReferrerTable is the table that holds the referrer relation/edge.
+	ReferrerTable = "members"
+	// ReferrerColumn is the table column denoting the referrer relation/edge.
+	ReferrerColumn = "referred_by"
+	// ReferralsTable is the table that holds the referrals relation/edge.
+	ReferralsTable = "members"
+	// ReferralsColumn is the table column denoting the referrals relation/edge.
+	ReferralsColumn = "referred_by"
+	// TagsTable is the table that holds the tags relation/edge. The primary key declared below.
+	TagsTable = "member_tags"
+	// TagsInverseTable is the table name for the Tag entity.
+	// It exists in this package in order to avoid circular dependency with the "tag" package.
+	TagsInverseTable = "tags"
+	// MemberTagsTable is the table that holds the member_tags relation/edge.
+	MemberTagsTable = "member_tags"
+	// MemberTagsInverseTable is the table name for the MemberTag entity.
+	// It exists in this package in order to avoid circular dependency with the "membertag" package.
+	MemberTagsInverseTable = "member_tags"
+	// MemberTagsColumn is the table column denoting the member_tags relation/edge.
+	MemberTagsColumn = "member_id"
 )
 
 // Columns holds all SQL columns for member fields.
@@ -30,10 +84,22 @@ var Columns = []string{
 	FieldID,
 	FieldName,
 	FieldEmail,
+	FieldNormalizedEmail,
 	FieldPassword,
 	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldStatus,
+	FieldStatusReason,
+	FieldMergedInto,
+	FieldReferredBy,
 }
 
+var (
+	// TagsPrimaryKey and TagsColumn2 are the table columns denoting the
+	// primary key for the tags relation (M2M).
+	TagsPrimaryKey = []string{"member_id", "tag_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -51,8 +117,42 @@ var (
 	PasswordValidator func(string) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultStatusReason holds the default value on creation for the "status_reason" field.
+	DefaultStatusReason string
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusActive is the default value of the Status enum.
+const DefaultStatus = StatusActive
+
+// Status values.
+const (
+	StatusPending   Status = "pending"
+	StatusActive    Status = "active"
+	StatusSuspended Status = "suspended"
+	StatusBanned    Status = "banned"
 )
 
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusActive, StatusSuspended, StatusBanned:
+		return nil
+	default:
+		return fmt.Errorf("member: invalid enum value for status field: %q", s)
+	}
+}
+
 // OrderOption defines the ordering options for the Member queries.
 type OrderOption func(*sql.Selector)
 
@@ -71,6 +171,11 @@ func ByEmail(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEmail, opts...).ToFunc()
 }
 
+// ByNormalizedEmail orders the results by the normalized_email field.
+func ByNormalizedEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNormalizedEmail, opts...).ToFunc()
+}
+
 // ByPassword orders the results by the password field.
 func ByPassword(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPassword, opts...).ToFunc()
@@ -80,3 +185,140 @@ func ByPassword(opts ...sql.OrderTermOption) OrderOption {
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
 }
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByStatusReason orders the results by the status_reason field.
+func ByStatusReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatusReason, opts...).ToFunc()
+}
+
+// ByMergedInto orders the results by the merged_into field.
+func ByMergedInto(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMergedInto, opts...).ToFunc()
+}
+
+// ByReferredBy orders the results by the referred_by field.
+func ByReferredBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReferredBy, opts...).ToFunc()
+}
+
+// ByMergedIntoMemberField orders the results by merged_into_member field.
+func ByMergedIntoMemberField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMergedIntoMemberStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByMergedMembersCount orders the results by merged_members count.
+func ByMergedMembersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMergedMembersStep(), opts...)
+	}
+}
+
+// ByMergedMembers orders the results by merged_members terms.
+func ByMergedMembers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMergedMembersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReferrerField orders the results by referrer field.
+func ByReferrerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReferrerStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByReferralsCount orders the results by referrals count.
+func ByReferralsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReferralsStep(), opts...)
+	}
+}
+
+// ByReferrals orders the results by referrals terms.
+func ByReferrals(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReferralsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTagsCount orders the results by tags count.
+func ByTagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTagsStep(), opts...)
+	}
+}
+
+// ByTags orders the results by tags terms.
+func ByTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMemberTagsCount orders the results by member_tags count.
+func ByMemberTagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMemberTagsStep(), opts...)
+	}
+}
+
+// ByMemberTags orders the results by member_tags terms.
+func ByMemberTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMemberTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newMergedIntoMemberStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, MergedIntoMemberTable, MergedIntoMemberColumn),
+	)
+}
+func newMergedMembersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MergedMembersTable, MergedMembersColumn),
+	)
+}
+func newReferrerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ReferrerTable, ReferrerColumn),
+	)
+}
+func newReferralsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReferralsTable, ReferralsColumn),
+	)
+}
+func newTagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TagsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, TagsTable, TagsPrimaryKey...),
+	)
+}
+func newMemberTagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MemberTagsInverseTable, MemberTagsColumn),
+		sqlgraph.Edge(sqlgraph.O2M, true, MemberTagsTable, MemberTagsColumn),
+	)
+}
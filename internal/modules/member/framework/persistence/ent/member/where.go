@@ -3,10 +3,11 @@
 package member
 
 import (
-	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/predicate"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/predicate"
 )
 
 // ID filters vertices based on their ID field.
@@ -64,6 +65,11 @@ func Email(v string) predicate.Member {
 	return predicate.Member(sql.FieldEQ(FieldEmail, v))
 }
 
+// NormalizedEmail applies equality check predicate on the "normalized_email" field. It's identical to NormalizedEmailEQ.
+func NormalizedEmail(v string) predicate.Member {
+	return predicate.Member(sql.FieldEQ(FieldNormalizedEmail, v))
+}
+
 // Password applies equality check predicate on the "password" field. It's identical to PasswordEQ.
 func Password(v string) predicate.Member {
 	return predicate.Member(sql.FieldEQ(FieldPassword, v))
@@ -74,6 +80,26 @@ func CreatedAt(v time.Time) predicate.Member {
 	return predicate.Member(sql.FieldEQ(FieldCreatedAt, v))
 }
 
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Member {
+	return predicate.Member(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// StatusReason applies equality check predicate on the "status_reason" field. It's identical to StatusReasonEQ.
+func StatusReason(v string) predicate.Member {
+	return predicate.Member(sql.FieldEQ(FieldStatusReason, v))
+}
+
+// MergedInto applies equality check predicate on the "merged_into" field. It's identical to MergedIntoEQ.
+func MergedInto(v int) predicate.Member {
+	return predicate.Member(sql.FieldEQ(FieldMergedInto, v))
+}
+
+// ReferredBy applies equality check predicate on the "referred_by" field. It's identical to ReferredByEQ.
+func ReferredBy(v int) predicate.Member {
+	return predicate.Member(sql.FieldEQ(FieldReferredBy, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Member {
 	return predicate.Member(sql.FieldEQ(FieldName, v))
@@ -204,6 +230,81 @@ func EmailContainsFold(v string) predicate.Member {
 	return predicate.Member(sql.FieldContainsFold(FieldEmail, v))
 }
 
+// NormalizedEmailEQ applies the EQ predicate on the "normalized_email" field.
+func NormalizedEmailEQ(v string) predicate.Member {
+	return predicate.Member(sql.FieldEQ(FieldNormalizedEmail, v))
+}
+
+// NormalizedEmailNEQ applies the NEQ predicate on the "normalized_email" field.
+func NormalizedEmailNEQ(v string) predicate.Member {
+	return predicate.Member(sql.FieldNEQ(FieldNormalizedEmail, v))
+}
+
+// NormalizedEmailIn applies the In predicate on the "normalized_email" field.
+func NormalizedEmailIn(vs ...string) predicate.Member {
+	return predicate.Member(sql.FieldIn(FieldNormalizedEmail, vs...))
+}
+
+// NormalizedEmailNotIn applies the NotIn predicate on the "normalized_email" field.
+func NormalizedEmailNotIn(vs ...string) predicate.Member {
+	return predicate.Member(sql.FieldNotIn(FieldNormalizedEmail, vs...))
+}
+
+// NormalizedEmailGT applies the GT predicate on the "normalized_email" field.
+func NormalizedEmailGT(v string) predicate.Member {
+	return predicate.Member(sql.FieldGT(FieldNormalizedEmail, v))
+}
+
+// NormalizedEmailGTE applies the GTE predicate on the "normalized_email" field.
+func NormalizedEmailGTE(v string) predicate.Member {
+	return predicate.Member(sql.FieldGTE(FieldNormalizedEmail, v))
+}
+
+// NormalizedEmailLT applies the LT predicate on the "normalized_email" field.
+func NormalizedEmailLT(v string) predicate.Member {
+	return predicate.Member(sql.FieldLT(FieldNormalizedEmail, v))
+}
+
+// NormalizedEmailLTE applies the LTE predicate on the "normalized_email" field.
+func NormalizedEmailLTE(v string) predicate.Member {
+	return predicate.Member(sql.FieldLTE(FieldNormalizedEmail, v))
+}
+
+// NormalizedEmailContains applies the Contains predicate on the "normalized_email" field.
+func NormalizedEmailContains(v string) predicate.Member {
+	return predicate.Member(sql.FieldContains(FieldNormalizedEmail, v))
+}
+
+// NormalizedEmailHasPrefix applies the HasPrefix predicate on the "normalized_email" field.
+func NormalizedEmailHasPrefix(v string) predicate.Member {
+	return predicate.Member(sql.FieldHasPrefix(FieldNormalizedEmail, v))
+}
+
+// NormalizedEmailHasSuffix applies the HasSuffix predicate on the "normalized_email" field.
+func NormalizedEmailHasSuffix(v string) predicate.Member {
+	return predicate.Member(sql.FieldHasSuffix(FieldNormalizedEmail, v))
+}
+
+// NormalizedEmailIsNil applies the IsNil predicate on the "normalized_email" field.
+func NormalizedEmailIsNil() predicate.Member {
+	return predicate.Member(sql.FieldIsNull(FieldNormalizedEmail))
+}
+
+// NormalizedEmailNotNil applies the NotNil predicate on the "normalized_email" field.
+func NormalizedEmailNotNil() predicate.Member {
+	return predicate.Member(sql.FieldNotNull(FieldNormalizedEmail))
+}
+
+// NormalizedEmailEqualFold applies the EqualFold predicate on the "normalized_email" field.
+func NormalizedEmailEqualFold(v string) predicate.Member {
+	return predicate.Member(sql.FieldEqualFold(FieldNormalizedEmail, v))
+}
+
+// NormalizedEmailContainsFold applies the ContainsFold predicate on the "normalized_email" field.
+func NormalizedEmailContainsFold(v string) predicate.Member {
+	return predicate.Member(sql.FieldContainsFold(FieldNormalizedEmail, v))
+}
+
 // PasswordEQ applies the EQ predicate on the "password" field.
 func PasswordEQ(v string) predicate.Member {
 	return predicate.Member(sql.FieldEQ(FieldPassword, v))
@@ -309,6 +410,329 @@ func CreatedAtLTE(v time.Time) predicate.Member {
 	return predicate.Member(sql.FieldLTE(FieldCreatedAt, v))
 }
 
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Member {
+	return predicate.Member(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Member {
+	return predicate.Member(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Member {
+	return predicate.Member(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Member {
+	return predicate.Member(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Member {
+	return predicate.Member(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Member {
+	return predicate.Member(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Member {
+	return predicate.Member(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Member {
+	return predicate.Member(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Member {
+	return predicate.Member(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Member {
+	return predicate.Member(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Member {
+	return predicate.Member(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Member {
+	return predicate.Member(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusReasonEQ applies the EQ predicate on the "status_reason" field.
+func StatusReasonEQ(v string) predicate.Member {
+	return predicate.Member(sql.FieldEQ(FieldStatusReason, v))
+}
+
+// StatusReasonNEQ applies the NEQ predicate on the "status_reason" field.
+func StatusReasonNEQ(v string) predicate.Member {
+	return predicate.Member(sql.FieldNEQ(FieldStatusReason, v))
+}
+
+// StatusReasonIn applies the In predicate on the "status_reason" field.
+func StatusReasonIn(vs ...string) predicate.Member {
+	return predicate.Member(sql.FieldIn(FieldStatusReason, vs...))
+}
+
+// StatusReasonNotIn applies the NotIn predicate on the "status_reason" field.
+func StatusReasonNotIn(vs ...string) predicate.Member {
+	return predicate.Member(sql.FieldNotIn(FieldStatusReason, vs...))
+}
+
+// StatusReasonGT applies the GT predicate on the "status_reason" field.
+func StatusReasonGT(v string) predicate.Member {
+	return predicate.Member(sql.FieldGT(FieldStatusReason, v))
+}
+
+// StatusReasonGTE applies the GTE predicate on the "status_reason" field.
+func StatusReasonGTE(v string) predicate.Member {
+	return predicate.Member(sql.FieldGTE(FieldStatusReason, v))
+}
+
+// StatusReasonLT applies the LT predicate on the "status_reason" field.
+func StatusReasonLT(v string) predicate.Member {
+	return predicate.Member(sql.FieldLT(FieldStatusReason, v))
+}
+
+// StatusReasonLTE applies the LTE predicate on the "status_reason" field.
+func StatusReasonLTE(v string) predicate.Member {
+	return predicate.Member(sql.FieldLTE(FieldStatusReason, v))
+}
+
+// StatusReasonContains applies the Contains predicate on the "status_reason" field.
+func StatusReasonContains(v string) predicate.Member {
+	return predicate.Member(sql.FieldContains(FieldStatusReason, v))
+}
+
+// StatusReasonHasPrefix applies the HasPrefix predicate on the "status_reason" field.
+func StatusReasonHasPrefix(v string) predicate.Member {
+	return predicate.Member(sql.FieldHasPrefix(FieldStatusReason, v))
+}
+
+// StatusReasonHasSuffix applies the HasSuffix predicate on the "status_reason" field.
+func StatusReasonHasSuffix(v string) predicate.Member {
+	return predicate.Member(sql.FieldHasSuffix(FieldStatusReason, v))
+}
+
+// StatusReasonEqualFold applies the EqualFold predicate on the "status_reason" field.
+func StatusReasonEqualFold(v string) predicate.Member {
+	return predicate.Member(sql.FieldEqualFold(FieldStatusReason, v))
+}
+
+// StatusReasonContainsFold applies the ContainsFold predicate on the "status_reason" field.
+func StatusReasonContainsFold(v string) predicate.Member {
+	return predicate.Member(sql.FieldContainsFold(FieldStatusReason, v))
+}
+
+// MergedIntoEQ applies the EQ predicate on the "merged_into" field.
+func MergedIntoEQ(v int) predicate.Member {
+	return predicate.Member(sql.FieldEQ(FieldMergedInto, v))
+}
+
+// MergedIntoNEQ applies the NEQ predicate on the "merged_into" field.
+func MergedIntoNEQ(v int) predicate.Member {
+	return predicate.Member(sql.FieldNEQ(FieldMergedInto, v))
+}
+
+// MergedIntoIn applies the In predicate on the "merged_into" field.
+func MergedIntoIn(vs ...int) predicate.Member {
+	return predicate.Member(sql.FieldIn(FieldMergedInto, vs...))
+}
+
+// MergedIntoNotIn applies the NotIn predicate on the "merged_into" field.
+func MergedIntoNotIn(vs ...int) predicate.Member {
+	return predicate.Member(sql.FieldNotIn(FieldMergedInto, vs...))
+}
+
+// MergedIntoIsNil applies the IsNil predicate on the "merged_into" field.
+func MergedIntoIsNil() predicate.Member {
+	return predicate.Member(sql.FieldIsNull(FieldMergedInto))
+}
+
+// MergedIntoNotNil applies the NotNil predicate on the "merged_into" field.
+func MergedIntoNotNil() predicate.Member {
+	return predicate.Member(sql.FieldNotNull(FieldMergedInto))
+}
+
+// ReferredByEQ applies the EQ predicate on the "referred_by" field.
+func ReferredByEQ(v int) predicate.Member {
+	return predicate.Member(sql.FieldEQ(FieldReferredBy, v))
+}
+
+// ReferredByNEQ applies the NEQ predicate on the "referred_by" field.
+func ReferredByNEQ(v int) predicate.Member {
+	return predicate.Member(sql.FieldNEQ(FieldReferredBy, v))
+}
+
+// ReferredByIn applies the In predicate on the "referred_by" field.
+func ReferredByIn(vs ...int) predicate.Member {
+	return predicate.Member(sql.FieldIn(FieldReferredBy, vs...))
+}
+
+// ReferredByNotIn applies the NotIn predicate on the "referred_by" field.
+func ReferredByNotIn(vs ...int) predicate.Member {
+	return predicate.Member(sql.FieldNotIn(FieldReferredBy, vs...))
+}
+
+// ReferredByIsNil applies the IsNil predicate on the "referred_by" field.
+func ReferredByIsNil() predicate.Member {
+	return predicate.Member(sql.FieldIsNull(FieldReferredBy))
+}
+
+// ReferredByNotNil applies the NotNil predicate on the "referred_by" field.
+func ReferredByNotNil() predicate.Member {
+	return predicate.Member(sql.FieldNotNull(FieldReferredBy))
+}
+
+// HasMergedIntoMember applies the HasEdge predicate on the "merged_into_member" edge.
+func HasMergedIntoMember() predicate.Member {
+	return predicate.Member(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MergedIntoMemberTable, MergedIntoMemberColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMergedIntoMemberWith applies the HasEdge predicate on the "merged_into_member" edge with a given conditions (other predicates).
+func HasMergedIntoMemberWith(preds ...predicate.Member) predicate.Member {
+	return predicate.Member(func(s *sql.Selector) {
+		step := newMergedIntoMemberStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMergedMembers applies the HasEdge predicate on the "merged_members" edge.
+func HasMergedMembers() predicate.Member {
+	return predicate.Member(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MergedMembersTable, MergedMembersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMergedMembersWith applies the HasEdge predicate on the "merged_members" edge with a given conditions (other predicates).
+func HasMergedMembersWith(preds ...predicate.Member) predicate.Member {
+	return predicate.Member(func(s *sql.Selector) {
+		step := newMergedMembersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReferrer applies the HasEdge predicate on the "referrer" edge.
+func HasReferrer() predicate.Member {
+	return predicate.Member(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ReferrerTable, ReferrerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReferrerWith applies the HasEdge predicate on the "referrer" edge with a given conditions (other predicates).
+func HasReferrerWith(preds ...predicate.Member) predicate.Member {
+	return predicate.Member(func(s *sql.Selector) {
+		step := newReferrerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReferrals applies the HasEdge predicate on the "referrals" edge.
+func HasReferrals() predicate.Member {
+	return predicate.Member(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReferralsTable, ReferralsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReferralsWith applies the HasEdge predicate on the "referrals" edge with a given conditions (other predicates).
+func HasReferralsWith(preds ...predicate.Member) predicate.Member {
+	return predicate.Member(func(s *sql.Selector) {
+		step := newReferralsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTags applies the HasEdge predicate on the "tags" edge.
+func HasTags() predicate.Member {
+	return predicate.Member(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, TagsTable, TagsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagsWith applies the HasEdge predicate on the "tags" edge with a given conditions (other predicates).
+func HasTagsWith(preds ...predicate.Tag) predicate.Member {
+	return predicate.Member(func(s *sql.Selector) {
+		step := newTagsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMemberTags applies the HasEdge predicate on the "member_tags" edge.
+func HasMemberTags() predicate.Member {
+	return predicate.Member(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, MemberTagsTable, MemberTagsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMemberTagsWith applies the HasEdge predicate on the "member_tags" edge with a given conditions (other predicates).
+func HasMemberTagsWith(preds ...predicate.MemberTag) predicate.Member {
+	return predicate.Member(func(s *sql.Selector) {
+		step := newMemberTagsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Member) predicate.Member {
 	return predicate.Member(sql.AndPredicates(predicates...))
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/member"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/tag"
 )
 
 // MemberCreate is the builder for creating a Member entity.
@@ -32,6 +33,20 @@ func (mc *MemberCreate) SetEmail(s string) *MemberCreate {
 	return mc
 }
 
+// SetNormalizedEmail sets the "normalized_email" field.
+func (mc *MemberCreate) SetNormalizedEmail(s string) *MemberCreate {
+	mc.mutation.SetNormalizedEmail(s)
+	return mc
+}
+
+// SetNillableNormalizedEmail sets the "normalized_email" field if the given value is not nil.
+func (mc *MemberCreate) SetNillableNormalizedEmail(s *string) *MemberCreate {
+	if s != nil {
+		mc.SetNormalizedEmail(*s)
+	}
+	return mc
+}
+
 // SetPassword sets the "password" field.
 func (mc *MemberCreate) SetPassword(s string) *MemberCreate {
 	mc.mutation.SetPassword(s)
@@ -52,6 +67,159 @@ func (mc *MemberCreate) SetNillableCreatedAt(t *time.Time) *MemberCreate {
 	return mc
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (mc *MemberCreate) SetUpdatedAt(t time.Time) *MemberCreate {
+	mc.mutation.SetUpdatedAt(t)
+	return mc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (mc *MemberCreate) SetNillableUpdatedAt(t *time.Time) *MemberCreate {
+	if t != nil {
+		mc.SetUpdatedAt(*t)
+	}
+	return mc
+}
+
+// SetStatus sets the "status" field.
+func (mc *MemberCreate) SetStatus(m member.Status) *MemberCreate {
+	mc.mutation.SetStatus(m)
+	return mc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (mc *MemberCreate) SetNillableStatus(m *member.Status) *MemberCreate {
+	if m != nil {
+		mc.SetStatus(*m)
+	}
+	return mc
+}
+
+// SetStatusReason sets the "status_reason" field.
+func (mc *MemberCreate) SetStatusReason(s string) *MemberCreate {
+	mc.mutation.SetStatusReason(s)
+	return mc
+}
+
+// SetNillableStatusReason sets the "status_reason" field if the given value is not nil.
+func (mc *MemberCreate) SetNillableStatusReason(s *string) *MemberCreate {
+	if s != nil {
+		mc.SetStatusReason(*s)
+	}
+	return mc
+}
+
+// SetMergedInto sets the "merged_into" field.
+func (mc *MemberCreate) SetMergedInto(i int) *MemberCreate {
+	mc.mutation.SetMergedInto(i)
+	return mc
+}
+
+// SetNillableMergedInto sets the "merged_into" field if the given value is not nil.
+func (mc *MemberCreate) SetNillableMergedInto(i *int) *MemberCreate {
+	if i != nil {
+		mc.SetMergedInto(*i)
+	}
+	return mc
+}
+
+// SetReferredBy sets the "referred_by" field.
+func (mc *MemberCreate) SetReferredBy(i int) *MemberCreate {
+	mc.mutation.SetReferredBy(i)
+	return mc
+}
+
+// SetNillableReferredBy sets the "referred_by" field if the given value is not nil.
+func (mc *MemberCreate) SetNillableReferredBy(i *int) *MemberCreate {
+	if i != nil {
+		mc.SetReferredBy(*i)
+	}
+	return mc
+}
+
+// SetMergedIntoMemberID sets the "merged_into_member" edge to the Member entity by ID.
+func (mc *MemberCreate) SetMergedIntoMemberID(id int) *MemberCreate {
+	mc.mutation.SetMergedIntoMemberID(id)
+	return mc
+}
+
+// SetNillableMergedIntoMemberID sets the "merged_into_member" edge to the Member entity by ID if the given value is not nil.
+func (mc *MemberCreate) SetNillableMergedIntoMemberID(id *int) *MemberCreate {
+	if id != nil {
+		mc = mc.SetMergedIntoMemberID(*id)
+	}
+	return mc
+}
+
+// SetMergedIntoMember sets the "merged_into_member" edge to the Member entity.
+func (mc *MemberCreate) SetMergedIntoMember(m *Member) *MemberCreate {
+	return mc.SetMergedIntoMemberID(m.ID)
+}
+
+// AddMergedMemberIDs adds the "merged_members" edge to the Member entity by IDs.
+func (mc *MemberCreate) AddMergedMemberIDs(ids ...int) *MemberCreate {
+	mc.mutation.AddMergedMemberIDs(ids...)
+	return mc
+}
+
+// AddMergedMembers adds the "merged_members" edges to the Member entity.
+func (mc *MemberCreate) AddMergedMembers(m ...*Member) *MemberCreate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mc.AddMergedMemberIDs(ids...)
+}
+
+// SetReferrerID sets the "referrer" edge to the Member entity by ID.
+func (mc *MemberCreate) SetReferrerID(id int) *MemberCreate {
+	mc.mutation.SetReferrerID(id)
+	return mc
+}
+
+// SetNillableReferrerID sets the "referrer" edge to the Member entity by ID if the given value is not nil.
+func (mc *MemberCreate) SetNillableReferrerID(id *int) *MemberCreate {
+	if id != nil {
+		mc = mc.SetReferrerID(*id)
+	}
+	return mc
+}
+
+// SetReferrer sets the "referrer" edge to the Member entity.
+func (mc *MemberCreate) SetReferrer(m *Member) *MemberCreate {
+	return mc.SetReferrerID(m.ID)
+}
+
+// AddReferralIDs adds the "referrals" edge to the Member entity by IDs.
+func (mc *MemberCreate) AddReferralIDs(ids ...int) *MemberCreate {
+	mc.mutation.AddReferralIDs(ids...)
+	return mc
+}
+
+// AddReferrals adds the "referrals" edges to the Member entity.
+func (mc *MemberCreate) AddReferrals(m ...*Member) *MemberCreate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mc.AddReferralIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (mc *MemberCreate) AddTagIDs(ids ...int) *MemberCreate {
+	mc.mutation.AddTagIDs(ids...)
+	return mc
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (mc *MemberCreate) AddTags(t ...*Tag) *MemberCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return mc.AddTagIDs(ids...)
+}
+
 // Mutation returns the MemberMutation object of the builder.
 func (mc *MemberCreate) Mutation() *MemberMutation {
 	return mc.mutation
@@ -91,6 +259,18 @@ func (mc *MemberCreate) defaults() {
 		v := member.DefaultCreatedAt()
 		mc.mutation.SetCreatedAt(v)
 	}
+	if _, ok := mc.mutation.UpdatedAt(); !ok {
+		v := member.DefaultUpdatedAt()
+		mc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := mc.mutation.Status(); !ok {
+		v := member.DefaultStatus
+		mc.mutation.SetStatus(v)
+	}
+	if _, ok := mc.mutation.StatusReason(); !ok {
+		v := member.DefaultStatusReason
+		mc.mutation.SetStatusReason(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -117,6 +297,20 @@ func (mc *MemberCreate) check() error {
 	if _, ok := mc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Member.created_at"`)}
 	}
+	if _, ok := mc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Member.updated_at"`)}
+	}
+	if _, ok := mc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Member.status"`)}
+	}
+	if v, ok := mc.mutation.Status(); ok {
+		if err := member.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Member.status": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.StatusReason(); !ok {
+		return &ValidationError{Name: "status_reason", err: errors.New(`ent: missing required field "Member.status_reason"`)}
+	}
 	return nil
 }
 
@@ -151,6 +345,10 @@ func (mc *MemberCreate) createSpec() (*Member, *sqlgraph.CreateSpec) {
 		_spec.SetField(member.FieldEmail, field.TypeString, value)
 		_node.Email = value
 	}
+	if value, ok := mc.mutation.NormalizedEmail(); ok {
+		_spec.SetField(member.FieldNormalizedEmail, field.TypeString, value)
+		_node.NormalizedEmail = &value
+	}
 	if value, ok := mc.mutation.Password(); ok {
 		_spec.SetField(member.FieldPassword, field.TypeString, value)
 		_node.Password = value
@@ -159,6 +357,104 @@ func (mc *MemberCreate) createSpec() (*Member, *sqlgraph.CreateSpec) {
 		_spec.SetField(member.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if value, ok := mc.mutation.UpdatedAt(); ok {
+		_spec.SetField(member.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := mc.mutation.Status(); ok {
+		_spec.SetField(member.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := mc.mutation.StatusReason(); ok {
+		_spec.SetField(member.FieldStatusReason, field.TypeString, value)
+		_node.StatusReason = value
+	}
+	if nodes := mc.mutation.MergedIntoMemberIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   member.MergedIntoMemberTable,
+			Columns: []string{member.MergedIntoMemberColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MergedInto = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.MergedMembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.MergedMembersTable,
+			Columns: []string{member.MergedMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.ReferrerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   member.ReferrerTable,
+			Columns: []string{member.ReferrerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ReferredBy = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.ReferralsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.ReferralsTable,
+			Columns: []string{member.ReferralsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   member.TagsTable,
+			Columns: member.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &MemberTagCreate{config: mc.config, mutation: newMemberTagMutation(mc.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
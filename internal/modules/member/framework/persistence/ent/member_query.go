@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -12,16 +13,24 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/member"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/membertag"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/predicate"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/tag"
 )
 
 // MemberQuery is the builder for querying Member entities.
 type MemberQuery struct {
 	config
-	ctx        *QueryContext
-	order      []member.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Member
+	ctx                  *QueryContext
+	order                []member.OrderOption
+	inters               []Interceptor
+	predicates           []predicate.Member
+	withMergedIntoMember *MemberQuery
+	withMergedMembers    *MemberQuery
+	withReferrer         *MemberQuery
+	withReferrals        *MemberQuery
+	withTags             *TagQuery
+	withMemberTags       *MemberTagQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -58,6 +67,138 @@ func (mq *MemberQuery) Order(o ...member.OrderOption) *MemberQuery {
 	return mq
 }
 
+// QueryMergedIntoMember chains the current query on the "merged_into_member" edge.
+func (mq *MemberQuery) QueryMergedIntoMember() *MemberQuery {
+	query := (&MemberClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(member.Table, member.FieldID, selector),
+			sqlgraph.To(member.Table, member.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, member.MergedIntoMemberTable, member.MergedIntoMemberColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMergedMembers chains the current query on the "merged_members" edge.
+func (mq *MemberQuery) QueryMergedMembers() *MemberQuery {
+	query := (&MemberClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(member.Table, member.FieldID, selector),
+			sqlgraph.To(member.Table, member.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, member.MergedMembersTable, member.MergedMembersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReferrer chains the current query on the "referrer" edge.
+func (mq *MemberQuery) QueryReferrer() *MemberQuery {
+	query := (&MemberClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(member.Table, member.FieldID, selector),
+			sqlgraph.To(member.Table, member.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, member.ReferrerTable, member.ReferrerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReferrals chains the current query on the "referrals" edge.
+func (mq *MemberQuery) QueryReferrals() *MemberQuery {
+	query := (&MemberClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(member.Table, member.FieldID, selector),
+			sqlgraph.To(member.Table, member.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, member.ReferralsTable, member.ReferralsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTags chains the current query on the "tags" edge.
+func (mq *MemberQuery) QueryTags() *TagQuery {
+	query := (&TagClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(member.Table, member.FieldID, selector),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, member.TagsTable, member.TagsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMemberTags chains the current query on the "member_tags" edge.
+func (mq *MemberQuery) QueryMemberTags() *MemberTagQuery {
+	query := (&MemberTagClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(member.Table, member.FieldID, selector),
+			sqlgraph.To(membertag.Table, membertag.MemberColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, member.MemberTagsTable, member.MemberTagsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Member entity from the query.
 // Returns a *NotFoundError when no Member was found.
 func (mq *MemberQuery) First(ctx context.Context) (*Member, error) {
@@ -245,17 +386,89 @@ func (mq *MemberQuery) Clone() *MemberQuery {
 		return nil
 	}
 	return &MemberQuery{
-		config:     mq.config,
-		ctx:        mq.ctx.Clone(),
-		order:      append([]member.OrderOption{}, mq.order...),
-		inters:     append([]Interceptor{}, mq.inters...),
-		predicates: append([]predicate.Member{}, mq.predicates...),
+		config:               mq.config,
+		ctx:                  mq.ctx.Clone(),
+		order:                append([]member.OrderOption{}, mq.order...),
+		inters:               append([]Interceptor{}, mq.inters...),
+		predicates:           append([]predicate.Member{}, mq.predicates...),
+		withMergedIntoMember: mq.withMergedIntoMember.Clone(),
+		withMergedMembers:    mq.withMergedMembers.Clone(),
+		withReferrer:         mq.withReferrer.Clone(),
+		withReferrals:        mq.withReferrals.Clone(),
+		withTags:             mq.withTags.Clone(),
+		withMemberTags:       mq.withMemberTags.Clone(),
 		// clone intermediate query.
 		sql:  mq.sql.Clone(),
 		path: mq.path,
 	}
 }
 
+// WithMergedIntoMember tells the query-builder to eager-load the nodes that are connected to
+// the "merged_into_member" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MemberQuery) WithMergedIntoMember(opts ...func(*MemberQuery)) *MemberQuery {
+	query := (&MemberClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withMergedIntoMember = query
+	return mq
+}
+
+// WithMergedMembers tells the query-builder to eager-load the nodes that are connected to
+// the "merged_members" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MemberQuery) WithMergedMembers(opts ...func(*MemberQuery)) *MemberQuery {
+	query := (&MemberClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withMergedMembers = query
+	return mq
+}
+
+// WithReferrer tells the query-builder to eager-load the nodes that are connected to
+// the "referrer" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MemberQuery) WithReferrer(opts ...func(*MemberQuery)) *MemberQuery {
+	query := (&MemberClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withReferrer = query
+	return mq
+}
+
+// WithReferrals tells the query-builder to eager-load the nodes that are connected to
+// the "referrals" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MemberQuery) WithReferrals(opts ...func(*MemberQuery)) *MemberQuery {
+	query := (&MemberClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withReferrals = query
+	return mq
+}
+
+// WithTags tells the query-builder to eager-load the nodes that are connected to
+// the "tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MemberQuery) WithTags(opts ...func(*TagQuery)) *MemberQuery {
+	query := (&TagClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withTags = query
+	return mq
+}
+
+// WithMemberTags tells the query-builder to eager-load the nodes that are connected to
+// the "member_tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MemberQuery) WithMemberTags(opts ...func(*MemberTagQuery)) *MemberQuery {
+	query := (&MemberTagClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withMemberTags = query
+	return mq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -332,8 +545,16 @@ func (mq *MemberQuery) prepareQuery(ctx context.Context) error {
 
 func (mq *MemberQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Member, error) {
 	var (
-		nodes = []*Member{}
-		_spec = mq.querySpec()
+		nodes       = []*Member{}
+		_spec       = mq.querySpec()
+		loadedTypes = [6]bool{
+			mq.withMergedIntoMember != nil,
+			mq.withMergedMembers != nil,
+			mq.withReferrer != nil,
+			mq.withReferrals != nil,
+			mq.withTags != nil,
+			mq.withMemberTags != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Member).scanValues(nil, columns)
@@ -341,6 +562,7 @@ func (mq *MemberQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Membe
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &Member{config: mq.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	for i := range hooks {
@@ -352,9 +574,271 @@ func (mq *MemberQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Membe
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := mq.withMergedIntoMember; query != nil {
+		if err := mq.loadMergedIntoMember(ctx, query, nodes, nil,
+			func(n *Member, e *Member) { n.Edges.MergedIntoMember = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withMergedMembers; query != nil {
+		if err := mq.loadMergedMembers(ctx, query, nodes,
+			func(n *Member) { n.Edges.MergedMembers = []*Member{} },
+			func(n *Member, e *Member) { n.Edges.MergedMembers = append(n.Edges.MergedMembers, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withReferrer; query != nil {
+		if err := mq.loadReferrer(ctx, query, nodes, nil,
+			func(n *Member, e *Member) { n.Edges.Referrer = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withReferrals; query != nil {
+		if err := mq.loadReferrals(ctx, query, nodes,
+			func(n *Member) { n.Edges.Referrals = []*Member{} },
+			func(n *Member, e *Member) { n.Edges.Referrals = append(n.Edges.Referrals, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withTags; query != nil {
+		if err := mq.loadTags(ctx, query, nodes,
+			func(n *Member) { n.Edges.Tags = []*Tag{} },
+			func(n *Member, e *Tag) { n.Edges.Tags = append(n.Edges.Tags, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withMemberTags; query != nil {
+		if err := mq.loadMemberTags(ctx, query, nodes,
+			func(n *Member) { n.Edges.MemberTags = []*MemberTag{} },
+			func(n *Member, e *MemberTag) { n.Edges.MemberTags = append(n.Edges.MemberTags, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (mq *MemberQuery) loadMergedIntoMember(ctx context.Context, query *MemberQuery, nodes []*Member, init func(*Member), assign func(*Member, *Member)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Member)
+	for i := range nodes {
+		if nodes[i].MergedInto == nil {
+			continue
+		}
+		fk := *nodes[i].MergedInto
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(member.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "merged_into" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (mq *MemberQuery) loadMergedMembers(ctx context.Context, query *MemberQuery, nodes []*Member, init func(*Member), assign func(*Member, *Member)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Member)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(member.FieldMergedInto)
+	}
+	query.Where(predicate.Member(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(member.MergedMembersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MergedInto
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "merged_into" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "merged_into" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (mq *MemberQuery) loadReferrer(ctx context.Context, query *MemberQuery, nodes []*Member, init func(*Member), assign func(*Member, *Member)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Member)
+	for i := range nodes {
+		if nodes[i].ReferredBy == nil {
+			continue
+		}
+		fk := *nodes[i].ReferredBy
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(member.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "referred_by" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (mq *MemberQuery) loadReferrals(ctx context.Context, query *MemberQuery, nodes []*Member, init func(*Member), assign func(*Member, *Member)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Member)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(member.FieldReferredBy)
+	}
+	query.Where(predicate.Member(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(member.ReferralsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ReferredBy
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "referred_by" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "referred_by" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (mq *MemberQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*Member, init func(*Member), assign func(*Member, *Tag)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Member)
+	nids := make(map[int]map[*Member]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(member.TagsTable)
+		s.Join(joinT).On(s.C(tag.FieldID), joinT.C(member.TagsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(member.TagsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(member.TagsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Member]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Tag](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "tags" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (mq *MemberQuery) loadMemberTags(ctx context.Context, query *MemberTagQuery, nodes []*Member, init func(*Member), assign func(*Member, *MemberTag)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Member)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(membertag.FieldMemberID)
+	}
+	query.Where(predicate.MemberTag(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(member.MemberTagsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MemberID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "member_id" returned %v for node %v`, fk, n)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
 func (mq *MemberQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := mq.querySpec()
 	_spec.Node.Columns = mq.ctx.Fields
@@ -380,6 +864,12 @@ func (mq *MemberQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if mq.withMergedIntoMember != nil {
+			_spec.Node.AddColumnOnce(member.FieldMergedInto)
+		}
+		if mq.withReferrer != nil {
+			_spec.Node.AddColumnOnce(member.FieldReferredBy)
+		}
 	}
 	if ps := mq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/member"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/predicate"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/tag"
 )
 
 // MemberUpdate is the builder for updating Member entities.
@@ -56,6 +57,26 @@ func (mu *MemberUpdate) SetNillableEmail(s *string) *MemberUpdate {
 	return mu
 }
 
+// SetNormalizedEmail sets the "normalized_email" field.
+func (mu *MemberUpdate) SetNormalizedEmail(s string) *MemberUpdate {
+	mu.mutation.SetNormalizedEmail(s)
+	return mu
+}
+
+// SetNillableNormalizedEmail sets the "normalized_email" field if the given value is not nil.
+func (mu *MemberUpdate) SetNillableNormalizedEmail(s *string) *MemberUpdate {
+	if s != nil {
+		mu.SetNormalizedEmail(*s)
+	}
+	return mu
+}
+
+// ClearNormalizedEmail clears the value of the "normalized_email" field.
+func (mu *MemberUpdate) ClearNormalizedEmail() *MemberUpdate {
+	mu.mutation.ClearNormalizedEmail()
+	return mu
+}
+
 // SetPassword sets the "password" field.
 func (mu *MemberUpdate) SetPassword(s string) *MemberUpdate {
 	mu.mutation.SetPassword(s)
@@ -84,13 +105,246 @@ func (mu *MemberUpdate) SetNillableCreatedAt(t *time.Time) *MemberUpdate {
 	return mu
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (mu *MemberUpdate) SetUpdatedAt(t time.Time) *MemberUpdate {
+	mu.mutation.SetUpdatedAt(t)
+	return mu
+}
+
+// SetStatus sets the "status" field.
+func (mu *MemberUpdate) SetStatus(m member.Status) *MemberUpdate {
+	mu.mutation.SetStatus(m)
+	return mu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (mu *MemberUpdate) SetNillableStatus(m *member.Status) *MemberUpdate {
+	if m != nil {
+		mu.SetStatus(*m)
+	}
+	return mu
+}
+
+// SetStatusReason sets the "status_reason" field.
+func (mu *MemberUpdate) SetStatusReason(s string) *MemberUpdate {
+	mu.mutation.SetStatusReason(s)
+	return mu
+}
+
+// SetNillableStatusReason sets the "status_reason" field if the given value is not nil.
+func (mu *MemberUpdate) SetNillableStatusReason(s *string) *MemberUpdate {
+	if s != nil {
+		mu.SetStatusReason(*s)
+	}
+	return mu
+}
+
+// SetMergedInto sets the "merged_into" field.
+func (mu *MemberUpdate) SetMergedInto(i int) *MemberUpdate {
+	mu.mutation.SetMergedInto(i)
+	return mu
+}
+
+// SetNillableMergedInto sets the "merged_into" field if the given value is not nil.
+func (mu *MemberUpdate) SetNillableMergedInto(i *int) *MemberUpdate {
+	if i != nil {
+		mu.SetMergedInto(*i)
+	}
+	return mu
+}
+
+// ClearMergedInto clears the value of the "merged_into" field.
+func (mu *MemberUpdate) ClearMergedInto() *MemberUpdate {
+	mu.mutation.ClearMergedInto()
+	return mu
+}
+
+// SetReferredBy sets the "referred_by" field.
+func (mu *MemberUpdate) SetReferredBy(i int) *MemberUpdate {
+	mu.mutation.SetReferredBy(i)
+	return mu
+}
+
+// SetNillableReferredBy sets the "referred_by" field if the given value is not nil.
+func (mu *MemberUpdate) SetNillableReferredBy(i *int) *MemberUpdate {
+	if i != nil {
+		mu.SetReferredBy(*i)
+	}
+	return mu
+}
+
+// ClearReferredBy clears the value of the "referred_by" field.
+func (mu *MemberUpdate) ClearReferredBy() *MemberUpdate {
+	mu.mutation.ClearReferredBy()
+	return mu
+}
+
+// SetMergedIntoMemberID sets the "merged_into_member" edge to the Member entity by ID.
+func (mu *MemberUpdate) SetMergedIntoMemberID(id int) *MemberUpdate {
+	mu.mutation.SetMergedIntoMemberID(id)
+	return mu
+}
+
+// SetNillableMergedIntoMemberID sets the "merged_into_member" edge to the Member entity by ID if the given value is not nil.
+func (mu *MemberUpdate) SetNillableMergedIntoMemberID(id *int) *MemberUpdate {
+	if id != nil {
+		mu = mu.SetMergedIntoMemberID(*id)
+	}
+	return mu
+}
+
+// SetMergedIntoMember sets the "merged_into_member" edge to the Member entity.
+func (mu *MemberUpdate) SetMergedIntoMember(m *Member) *MemberUpdate {
+	return mu.SetMergedIntoMemberID(m.ID)
+}
+
+// AddMergedMemberIDs adds the "merged_members" edge to the Member entity by IDs.
+func (mu *MemberUpdate) AddMergedMemberIDs(ids ...int) *MemberUpdate {
+	mu.mutation.AddMergedMemberIDs(ids...)
+	return mu
+}
+
+// AddMergedMembers adds the "merged_members" edges to the Member entity.
+func (mu *MemberUpdate) AddMergedMembers(m ...*Member) *MemberUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.AddMergedMemberIDs(ids...)
+}
+
+// SetReferrerID sets the "referrer" edge to the Member entity by ID.
+func (mu *MemberUpdate) SetReferrerID(id int) *MemberUpdate {
+	mu.mutation.SetReferrerID(id)
+	return mu
+}
+
+// SetNillableReferrerID sets the "referrer" edge to the Member entity by ID if the given value is not nil.
+func (mu *MemberUpdate) SetNillableReferrerID(id *int) *MemberUpdate {
+	if id != nil {
+		mu = mu.SetReferrerID(*id)
+	}
+	return mu
+}
+
+// SetReferrer sets the "referrer" edge to the Member entity.
+func (mu *MemberUpdate) SetReferrer(m *Member) *MemberUpdate {
+	return mu.SetReferrerID(m.ID)
+}
+
+// AddReferralIDs adds the "referrals" edge to the Member entity by IDs.
+func (mu *MemberUpdate) AddReferralIDs(ids ...int) *MemberUpdate {
+	mu.mutation.AddReferralIDs(ids...)
+	return mu
+}
+
+// AddReferrals adds the "referrals" edges to the Member entity.
+func (mu *MemberUpdate) AddReferrals(m ...*Member) *MemberUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.AddReferralIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (mu *MemberUpdate) AddTagIDs(ids ...int) *MemberUpdate {
+	mu.mutation.AddTagIDs(ids...)
+	return mu
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (mu *MemberUpdate) AddTags(t ...*Tag) *MemberUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return mu.AddTagIDs(ids...)
+}
+
 // Mutation returns the MemberMutation object of the builder.
 func (mu *MemberUpdate) Mutation() *MemberMutation {
 	return mu.mutation
 }
 
+// ClearMergedIntoMember clears the "merged_into_member" edge to the Member entity.
+func (mu *MemberUpdate) ClearMergedIntoMember() *MemberUpdate {
+	mu.mutation.ClearMergedIntoMember()
+	return mu
+}
+
+// ClearMergedMembers clears all "merged_members" edges to the Member entity.
+func (mu *MemberUpdate) ClearMergedMembers() *MemberUpdate {
+	mu.mutation.ClearMergedMembers()
+	return mu
+}
+
+// RemoveMergedMemberIDs removes the "merged_members" edge to Member entities by IDs.
+func (mu *MemberUpdate) RemoveMergedMemberIDs(ids ...int) *MemberUpdate {
+	mu.mutation.RemoveMergedMemberIDs(ids...)
+	return mu
+}
+
+// RemoveMergedMembers removes "merged_members" edges to Member entities.
+func (mu *MemberUpdate) RemoveMergedMembers(m ...*Member) *MemberUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.RemoveMergedMemberIDs(ids...)
+}
+
+// ClearReferrer clears the "referrer" edge to the Member entity.
+func (mu *MemberUpdate) ClearReferrer() *MemberUpdate {
+	mu.mutation.ClearReferrer()
+	return mu
+}
+
+// ClearReferrals clears all "referrals" edges to the Member entity.
+func (mu *MemberUpdate) ClearReferrals() *MemberUpdate {
+	mu.mutation.ClearReferrals()
+	return mu
+}
+
+// RemoveReferralIDs removes the "referrals" edge to Member entities by IDs.
+func (mu *MemberUpdate) RemoveReferralIDs(ids ...int) *MemberUpdate {
+	mu.mutation.RemoveReferralIDs(ids...)
+	return mu
+}
+
+// RemoveReferrals removes "referrals" edges to Member entities.
+func (mu *MemberUpdate) RemoveReferrals(m ...*Member) *MemberUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.RemoveReferralIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (mu *MemberUpdate) ClearTags() *MemberUpdate {
+	mu.mutation.ClearTags()
+	return mu
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (mu *MemberUpdate) RemoveTagIDs(ids ...int) *MemberUpdate {
+	mu.mutation.RemoveTagIDs(ids...)
+	return mu
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (mu *MemberUpdate) RemoveTags(t ...*Tag) *MemberUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return mu.RemoveTagIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (mu *MemberUpdate) Save(ctx context.Context) (int, error) {
+	mu.defaults()
 	return withHooks(ctx, mu.sqlSave, mu.mutation, mu.hooks)
 }
 
@@ -116,6 +370,14 @@ func (mu *MemberUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (mu *MemberUpdate) defaults() {
+	if _, ok := mu.mutation.UpdatedAt(); !ok {
+		v := member.UpdateDefaultUpdatedAt()
+		mu.mutation.SetUpdatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (mu *MemberUpdate) check() error {
 	if v, ok := mu.mutation.Name(); ok {
@@ -128,6 +390,11 @@ func (mu *MemberUpdate) check() error {
 			return &ValidationError{Name: "password", err: fmt.Errorf(`ent: validator failed for field "Member.password": %w`, err)}
 		}
 	}
+	if v, ok := mu.mutation.Status(); ok {
+		if err := member.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Member.status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -149,12 +416,232 @@ func (mu *MemberUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := mu.mutation.Email(); ok {
 		_spec.SetField(member.FieldEmail, field.TypeString, value)
 	}
+	if value, ok := mu.mutation.NormalizedEmail(); ok {
+		_spec.SetField(member.FieldNormalizedEmail, field.TypeString, value)
+	}
+	if mu.mutation.NormalizedEmailCleared() {
+		_spec.ClearField(member.FieldNormalizedEmail, field.TypeString)
+	}
 	if value, ok := mu.mutation.Password(); ok {
 		_spec.SetField(member.FieldPassword, field.TypeString, value)
 	}
 	if value, ok := mu.mutation.CreatedAt(); ok {
 		_spec.SetField(member.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := mu.mutation.UpdatedAt(); ok {
+		_spec.SetField(member.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := mu.mutation.Status(); ok {
+		_spec.SetField(member.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := mu.mutation.StatusReason(); ok {
+		_spec.SetField(member.FieldStatusReason, field.TypeString, value)
+	}
+	if mu.mutation.MergedIntoMemberCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   member.MergedIntoMemberTable,
+			Columns: []string{member.MergedIntoMemberColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.MergedIntoMemberIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   member.MergedIntoMemberTable,
+			Columns: []string{member.MergedIntoMemberColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.MergedMembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.MergedMembersTable,
+			Columns: []string{member.MergedMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedMergedMembersIDs(); len(nodes) > 0 && !mu.mutation.MergedMembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.MergedMembersTable,
+			Columns: []string{member.MergedMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.MergedMembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.MergedMembersTable,
+			Columns: []string{member.MergedMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.ReferrerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   member.ReferrerTable,
+			Columns: []string{member.ReferrerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.ReferrerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   member.ReferrerTable,
+			Columns: []string{member.ReferrerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.ReferralsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.ReferralsTable,
+			Columns: []string{member.ReferralsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedReferralsIDs(); len(nodes) > 0 && !mu.mutation.ReferralsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.ReferralsTable,
+			Columns: []string{member.ReferralsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.ReferralsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.ReferralsTable,
+			Columns: []string{member.ReferralsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   member.TagsTable,
+			Columns: member.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		createE := &MemberTagCreate{config: mu.config, mutation: newMemberTagMutation(mu.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedTagsIDs(); len(nodes) > 0 && !mu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   member.TagsTable,
+			Columns: member.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &MemberTagCreate{config: mu.config, mutation: newMemberTagMutation(mu.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   member.TagsTable,
+			Columns: member.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &MemberTagCreate{config: mu.config, mutation: newMemberTagMutation(mu.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{member.Label}
@@ -203,6 +690,26 @@ func (muo *MemberUpdateOne) SetNillableEmail(s *string) *MemberUpdateOne {
 	return muo
 }
 
+// SetNormalizedEmail sets the "normalized_email" field.
+func (muo *MemberUpdateOne) SetNormalizedEmail(s string) *MemberUpdateOne {
+	muo.mutation.SetNormalizedEmail(s)
+	return muo
+}
+
+// SetNillableNormalizedEmail sets the "normalized_email" field if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillableNormalizedEmail(s *string) *MemberUpdateOne {
+	if s != nil {
+		muo.SetNormalizedEmail(*s)
+	}
+	return muo
+}
+
+// ClearNormalizedEmail clears the value of the "normalized_email" field.
+func (muo *MemberUpdateOne) ClearNormalizedEmail() *MemberUpdateOne {
+	muo.mutation.ClearNormalizedEmail()
+	return muo
+}
+
 // SetPassword sets the "password" field.
 func (muo *MemberUpdateOne) SetPassword(s string) *MemberUpdateOne {
 	muo.mutation.SetPassword(s)
@@ -231,11 +738,243 @@ func (muo *MemberUpdateOne) SetNillableCreatedAt(t *time.Time) *MemberUpdateOne
 	return muo
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (muo *MemberUpdateOne) SetUpdatedAt(t time.Time) *MemberUpdateOne {
+	muo.mutation.SetUpdatedAt(t)
+	return muo
+}
+
+// SetStatus sets the "status" field.
+func (muo *MemberUpdateOne) SetStatus(m member.Status) *MemberUpdateOne {
+	muo.mutation.SetStatus(m)
+	return muo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillableStatus(m *member.Status) *MemberUpdateOne {
+	if m != nil {
+		muo.SetStatus(*m)
+	}
+	return muo
+}
+
+// SetStatusReason sets the "status_reason" field.
+func (muo *MemberUpdateOne) SetStatusReason(s string) *MemberUpdateOne {
+	muo.mutation.SetStatusReason(s)
+	return muo
+}
+
+// SetNillableStatusReason sets the "status_reason" field if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillableStatusReason(s *string) *MemberUpdateOne {
+	if s != nil {
+		muo.SetStatusReason(*s)
+	}
+	return muo
+}
+
+// SetMergedInto sets the "merged_into" field.
+func (muo *MemberUpdateOne) SetMergedInto(i int) *MemberUpdateOne {
+	muo.mutation.SetMergedInto(i)
+	return muo
+}
+
+// SetNillableMergedInto sets the "merged_into" field if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillableMergedInto(i *int) *MemberUpdateOne {
+	if i != nil {
+		muo.SetMergedInto(*i)
+	}
+	return muo
+}
+
+// ClearMergedInto clears the value of the "merged_into" field.
+func (muo *MemberUpdateOne) ClearMergedInto() *MemberUpdateOne {
+	muo.mutation.ClearMergedInto()
+	return muo
+}
+
+// SetReferredBy sets the "referred_by" field.
+func (muo *MemberUpdateOne) SetReferredBy(i int) *MemberUpdateOne {
+	muo.mutation.SetReferredBy(i)
+	return muo
+}
+
+// SetNillableReferredBy sets the "referred_by" field if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillableReferredBy(i *int) *MemberUpdateOne {
+	if i != nil {
+		muo.SetReferredBy(*i)
+	}
+	return muo
+}
+
+// ClearReferredBy clears the value of the "referred_by" field.
+func (muo *MemberUpdateOne) ClearReferredBy() *MemberUpdateOne {
+	muo.mutation.ClearReferredBy()
+	return muo
+}
+
+// SetMergedIntoMemberID sets the "merged_into_member" edge to the Member entity by ID.
+func (muo *MemberUpdateOne) SetMergedIntoMemberID(id int) *MemberUpdateOne {
+	muo.mutation.SetMergedIntoMemberID(id)
+	return muo
+}
+
+// SetNillableMergedIntoMemberID sets the "merged_into_member" edge to the Member entity by ID if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillableMergedIntoMemberID(id *int) *MemberUpdateOne {
+	if id != nil {
+		muo = muo.SetMergedIntoMemberID(*id)
+	}
+	return muo
+}
+
+// SetMergedIntoMember sets the "merged_into_member" edge to the Member entity.
+func (muo *MemberUpdateOne) SetMergedIntoMember(m *Member) *MemberUpdateOne {
+	return muo.SetMergedIntoMemberID(m.ID)
+}
+
+// AddMergedMemberIDs adds the "merged_members" edge to the Member entity by IDs.
+func (muo *MemberUpdateOne) AddMergedMemberIDs(ids ...int) *MemberUpdateOne {
+	muo.mutation.AddMergedMemberIDs(ids...)
+	return muo
+}
+
+// AddMergedMembers adds the "merged_members" edges to the Member entity.
+func (muo *MemberUpdateOne) AddMergedMembers(m ...*Member) *MemberUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.AddMergedMemberIDs(ids...)
+}
+
+// SetReferrerID sets the "referrer" edge to the Member entity by ID.
+func (muo *MemberUpdateOne) SetReferrerID(id int) *MemberUpdateOne {
+	muo.mutation.SetReferrerID(id)
+	return muo
+}
+
+// SetNillableReferrerID sets the "referrer" edge to the Member entity by ID if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillableReferrerID(id *int) *MemberUpdateOne {
+	if id != nil {
+		muo = muo.SetReferrerID(*id)
+	}
+	return muo
+}
+
+// SetReferrer sets the "referrer" edge to the Member entity.
+func (muo *MemberUpdateOne) SetReferrer(m *Member) *MemberUpdateOne {
+	return muo.SetReferrerID(m.ID)
+}
+
+// AddReferralIDs adds the "referrals" edge to the Member entity by IDs.
+func (muo *MemberUpdateOne) AddReferralIDs(ids ...int) *MemberUpdateOne {
+	muo.mutation.AddReferralIDs(ids...)
+	return muo
+}
+
+// AddReferrals adds the "referrals" edges to the Member entity.
+func (muo *MemberUpdateOne) AddReferrals(m ...*Member) *MemberUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.AddReferralIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (muo *MemberUpdateOne) AddTagIDs(ids ...int) *MemberUpdateOne {
+	muo.mutation.AddTagIDs(ids...)
+	return muo
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (muo *MemberUpdateOne) AddTags(t ...*Tag) *MemberUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return muo.AddTagIDs(ids...)
+}
+
 // Mutation returns the MemberMutation object of the builder.
 func (muo *MemberUpdateOne) Mutation() *MemberMutation {
 	return muo.mutation
 }
 
+// ClearMergedIntoMember clears the "merged_into_member" edge to the Member entity.
+func (muo *MemberUpdateOne) ClearMergedIntoMember() *MemberUpdateOne {
+	muo.mutation.ClearMergedIntoMember()
+	return muo
+}
+
+// ClearMergedMembers clears all "merged_members" edges to the Member entity.
+func (muo *MemberUpdateOne) ClearMergedMembers() *MemberUpdateOne {
+	muo.mutation.ClearMergedMembers()
+	return muo
+}
+
+// RemoveMergedMemberIDs removes the "merged_members" edge to Member entities by IDs.
+func (muo *MemberUpdateOne) RemoveMergedMemberIDs(ids ...int) *MemberUpdateOne {
+	muo.mutation.RemoveMergedMemberIDs(ids...)
+	return muo
+}
+
+// RemoveMergedMembers removes "merged_members" edges to Member entities.
+func (muo *MemberUpdateOne) RemoveMergedMembers(m ...*Member) *MemberUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.RemoveMergedMemberIDs(ids...)
+}
+
+// ClearReferrer clears the "referrer" edge to the Member entity.
+func (muo *MemberUpdateOne) ClearReferrer() *MemberUpdateOne {
+	muo.mutation.ClearReferrer()
+	return muo
+}
+
+// ClearReferrals clears all "referrals" edges to the Member entity.
+func (muo *MemberUpdateOne) ClearReferrals() *MemberUpdateOne {
+	muo.mutation.ClearReferrals()
+	return muo
+}
+
+// RemoveReferralIDs removes the "referrals" edge to Member entities by IDs.
+func (muo *MemberUpdateOne) RemoveReferralIDs(ids ...int) *MemberUpdateOne {
+	muo.mutation.RemoveReferralIDs(ids...)
+	return muo
+}
+
+// RemoveReferrals removes "referrals" edges to Member entities.
+func (muo *MemberUpdateOne) RemoveReferrals(m ...*Member) *MemberUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.RemoveReferralIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (muo *MemberUpdateOne) ClearTags() *MemberUpdateOne {
+	muo.mutation.ClearTags()
+	return muo
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (muo *MemberUpdateOne) RemoveTagIDs(ids ...int) *MemberUpdateOne {
+	muo.mutation.RemoveTagIDs(ids...)
+	return muo
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (muo *MemberUpdateOne) RemoveTags(t ...*Tag) *MemberUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return muo.RemoveTagIDs(ids...)
+}
+
 // Where appends a list predicates to the MemberUpdate builder.
 func (muo *MemberUpdateOne) Where(ps ...predicate.Member) *MemberUpdateOne {
 	muo.mutation.Where(ps...)
@@ -251,6 +990,7 @@ func (muo *MemberUpdateOne) Select(field string, fields ...string) *MemberUpdate
 
 // Save executes the query and returns the updated Member entity.
 func (muo *MemberUpdateOne) Save(ctx context.Context) (*Member, error) {
+	muo.defaults()
 	return withHooks(ctx, muo.sqlSave, muo.mutation, muo.hooks)
 }
 
@@ -276,6 +1016,14 @@ func (muo *MemberUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (muo *MemberUpdateOne) defaults() {
+	if _, ok := muo.mutation.UpdatedAt(); !ok {
+		v := member.UpdateDefaultUpdatedAt()
+		muo.mutation.SetUpdatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (muo *MemberUpdateOne) check() error {
 	if v, ok := muo.mutation.Name(); ok {
@@ -288,6 +1036,11 @@ func (muo *MemberUpdateOne) check() error {
 			return &ValidationError{Name: "password", err: fmt.Errorf(`ent: validator failed for field "Member.password": %w`, err)}
 		}
 	}
+	if v, ok := muo.mutation.Status(); ok {
+		if err := member.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Member.status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -326,12 +1079,232 @@ func (muo *MemberUpdateOne) sqlSave(ctx context.Context) (_node *Member, err err
 	if value, ok := muo.mutation.Email(); ok {
 		_spec.SetField(member.FieldEmail, field.TypeString, value)
 	}
+	if value, ok := muo.mutation.NormalizedEmail(); ok {
+		_spec.SetField(member.FieldNormalizedEmail, field.TypeString, value)
+	}
+	if muo.mutation.NormalizedEmailCleared() {
+		_spec.ClearField(member.FieldNormalizedEmail, field.TypeString)
+	}
 	if value, ok := muo.mutation.Password(); ok {
 		_spec.SetField(member.FieldPassword, field.TypeString, value)
 	}
 	if value, ok := muo.mutation.CreatedAt(); ok {
 		_spec.SetField(member.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := muo.mutation.UpdatedAt(); ok {
+		_spec.SetField(member.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := muo.mutation.Status(); ok {
+		_spec.SetField(member.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := muo.mutation.StatusReason(); ok {
+		_spec.SetField(member.FieldStatusReason, field.TypeString, value)
+	}
+	if muo.mutation.MergedIntoMemberCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   member.MergedIntoMemberTable,
+			Columns: []string{member.MergedIntoMemberColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.MergedIntoMemberIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   member.MergedIntoMemberTable,
+			Columns: []string{member.MergedIntoMemberColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.MergedMembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.MergedMembersTable,
+			Columns: []string{member.MergedMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedMergedMembersIDs(); len(nodes) > 0 && !muo.mutation.MergedMembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.MergedMembersTable,
+			Columns: []string{member.MergedMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.MergedMembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.MergedMembersTable,
+			Columns: []string{member.MergedMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.ReferrerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   member.ReferrerTable,
+			Columns: []string{member.ReferrerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.ReferrerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   member.ReferrerTable,
+			Columns: []string{member.ReferrerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.ReferralsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.ReferralsTable,
+			Columns: []string{member.ReferralsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedReferralsIDs(); len(nodes) > 0 && !muo.mutation.ReferralsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.ReferralsTable,
+			Columns: []string{member.ReferralsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.ReferralsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.ReferralsTable,
+			Columns: []string{member.ReferralsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   member.TagsTable,
+			Columns: member.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		createE := &MemberTagCreate{config: muo.config, mutation: newMemberTagMutation(muo.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedTagsIDs(); len(nodes) > 0 && !muo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   member.TagsTable,
+			Columns: member.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &MemberTagCreate{config: muo.config, mutation: newMemberTagMutation(muo.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   member.TagsTable,
+			Columns: member.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &MemberTagCreate{config: muo.config, mutation: newMemberTagMutation(muo.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Member{config: muo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,165 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/member"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/membertag"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/tag"
+)
+
+// MemberTag is the model entity for the MemberTag schema.
+type MemberTag struct {
+	config `json:"-"`
+	// MemberID holds the value of the "member_id" field.
+	MemberID int `json:"member_id,omitempty"`
+	// TagID holds the value of the "tag_id" field.
+	TagID int `json:"tag_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MemberTagQuery when eager-loading is set.
+	Edges        MemberTagEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MemberTagEdges holds the relations/edges for other nodes in the graph.
+type MemberTagEdges struct {
+	// Member holds the value of the member edge.
+	Member *Member `json:"member,omitempty"`
+	// Tag holds the value of the tag edge.
+	Tag *Tag `json:"tag,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// MemberOrErr returns the Member value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MemberTagEdges) MemberOrErr() (*Member, error) {
+	if e.Member != nil {
+		return e.Member, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: member.Label}
+	}
+	return nil, &NotLoadedError{edge: "member"}
+}
+
+// TagOrErr returns the Tag value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MemberTagEdges) TagOrErr() (*Tag, error) {
+	if e.Tag != nil {
+		return e.Tag, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: tag.Label}
+	}
+	return nil, &NotLoadedError{edge: "tag"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MemberTag) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case membertag.FieldMemberID, membertag.FieldTagID:
+			values[i] = new(sql.NullInt64)
+		case membertag.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MemberTag fields.
+func (mt *MemberTag) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case membertag.FieldMemberID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field member_id", values[i])
+			} else if value.Valid {
+				mt.MemberID = int(value.Int64)
+			}
+		case membertag.FieldTagID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tag_id", values[i])
+			} else if value.Valid {
+				mt.TagID = int(value.Int64)
+			}
+		case membertag.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				mt.CreatedAt = value.Time
+			}
+		default:
+			mt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MemberTag.
+// This includes values selected through modifiers, order, etc.
+func (mt *MemberTag) Value(name string) (ent.Value, error) {
+	return mt.selectValues.Get(name)
+}
+
+// QueryMember queries the "member" edge of the MemberTag entity.
+func (mt *MemberTag) QueryMember() *MemberQuery {
+	return NewMemberTagClient(mt.config).QueryMember(mt)
+}
+
+// QueryTag queries the "tag" edge of the MemberTag entity.
+func (mt *MemberTag) QueryTag() *TagQuery {
+	return NewMemberTagClient(mt.config).QueryTag(mt)
+}
+
+// Update returns a builder for updating this MemberTag.
+// Note that you need to call MemberTag.Unwrap() before calling this method if this MemberTag
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mt *MemberTag) Update() *MemberTagUpdateOne {
+	return NewMemberTagClient(mt.config).UpdateOne(mt)
+}
+
+// Unwrap unwraps the MemberTag entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mt *MemberTag) Unwrap() *MemberTag {
+	_tx, ok := mt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MemberTag is not a transactional entity")
+	}
+	mt.config.driver = _tx.drv
+	return mt
+}
+
+// String implements the fmt.Stringer.
+func (mt *MemberTag) String() string {
+	var builder strings.Builder
+	builder.WriteString("MemberTag(")
+	builder.WriteString("member_id=")
+	builder.WriteString(fmt.Sprintf("%v", mt.MemberID))
+	builder.WriteString(", ")
+	builder.WriteString("tag_id=")
+	builder.WriteString(fmt.Sprintf("%v", mt.TagID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(mt.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MemberTags is a parsable slice of MemberTag.
+type MemberTags []*MemberTag
@@ -0,0 +1,167 @@
+// Code generated by ent, DO NOT EDIT.
+
+package membertag
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/predicate"
+)
+
+// MemberID applies equality check predicate on the "member_id" field. It's identical to MemberIDEQ.
+func MemberID(v int) predicate.MemberTag {
+	return predicate.MemberTag(sql.FieldEQ(FieldMemberID, v))
+}
+
+// TagID applies equality check predicate on the "tag_id" field. It's identical to TagIDEQ.
+func TagID(v int) predicate.MemberTag {
+	return predicate.MemberTag(sql.FieldEQ(FieldTagID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.MemberTag {
+	return predicate.MemberTag(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// MemberIDEQ applies the EQ predicate on the "member_id" field.
+func MemberIDEQ(v int) predicate.MemberTag {
+	return predicate.MemberTag(sql.FieldEQ(FieldMemberID, v))
+}
+
+// MemberIDNEQ applies the NEQ predicate on the "member_id" field.
+func MemberIDNEQ(v int) predicate.MemberTag {
+	return predicate.MemberTag(sql.FieldNEQ(FieldMemberID, v))
+}
+
+// MemberIDIn applies the In predicate on the "member_id" field.
+func MemberIDIn(vs ...int) predicate.MemberTag {
+	return predicate.MemberTag(sql.FieldIn(FieldMemberID, vs...))
+}
+
+// MemberIDNotIn applies the NotIn predicate on the "member_id" field.
+func MemberIDNotIn(vs ...int) predicate.MemberTag {
+	return predicate.MemberTag(sql.FieldNotIn(FieldMemberID, vs...))
+}
+
+// TagIDEQ applies the EQ predicate on the "tag_id" field.
+func TagIDEQ(v int) predicate.MemberTag {
+	return predicate.MemberTag(sql.FieldEQ(FieldTagID, v))
+}
+
+// TagIDNEQ applies the NEQ predicate on the "tag_id" field.
+func TagIDNEQ(v int) predicate.MemberTag {
+	return predicate.MemberTag(sql.FieldNEQ(FieldTagID, v))
+}
+
+// TagIDIn applies the In predicate on the "tag_id" field.
+func TagIDIn(vs ...int) predicate.MemberTag {
+	return predicate.MemberTag(sql.FieldIn(FieldTagID, vs...))
+}
+
+// TagIDNotIn applies the NotIn predicate on the "tag_id" field.
+func TagIDNotIn(vs ...int) predicate.MemberTag {
+	return predicate.MemberTag(sql.FieldNotIn(FieldTagID, vs...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.MemberTag {
+	return predicate.MemberTag(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.MemberTag {
+	return predicate.MemberTag(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.MemberTag {
+	return predicate.MemberTag(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.MemberTag {
+	return predicate.MemberTag(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.MemberTag {
+	return predicate.MemberTag(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.MemberTag {
+	return predicate.MemberTag(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.MemberTag {
+	return predicate.MemberTag(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.MemberTag {
+	return predicate.MemberTag(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasMember applies the HasEdge predicate on the "member" edge.
+func HasMember() predicate.MemberTag {
+	return predicate.MemberTag(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, MemberColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, MemberTable, MemberColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMemberWith applies the HasEdge predicate on the "member" edge with a given conditions (other predicates).
+func HasMemberWith(preds ...predicate.Member) predicate.MemberTag {
+	return predicate.MemberTag(func(s *sql.Selector) {
+		step := newMemberStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTag applies the HasEdge predicate on the "tag" edge.
+func HasTag() predicate.MemberTag {
+	return predicate.MemberTag(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, TagColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, TagTable, TagColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagWith applies the HasEdge predicate on the "tag" edge with a given conditions (other predicates).
+func HasTagWith(preds ...predicate.Tag) predicate.MemberTag {
+	return predicate.MemberTag(func(s *sql.Selector) {
+		step := newTagStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MemberTag) predicate.MemberTag {
+	return predicate.MemberTag(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MemberTag) predicate.MemberTag {
+	return predicate.MemberTag(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MemberTag) predicate.MemberTag {
+	return predicate.MemberTag(sql.NotPredicates(p))
+}
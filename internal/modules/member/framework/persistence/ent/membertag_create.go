@@ -0,0 +1,263 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/member"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/membertag"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/tag"
+)
+
+// MemberTagCreate is the builder for creating a MemberTag entity.
+type MemberTagCreate struct {
+	config
+	mutation *MemberTagMutation
+	hooks    []Hook
+}
+
+// SetMemberID sets the "member_id" field.
+func (mtc *MemberTagCreate) SetMemberID(i int) *MemberTagCreate {
+	mtc.mutation.SetMemberID(i)
+	return mtc
+}
+
+// SetTagID sets the "tag_id" field.
+func (mtc *MemberTagCreate) SetTagID(i int) *MemberTagCreate {
+	mtc.mutation.SetTagID(i)
+	return mtc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mtc *MemberTagCreate) SetCreatedAt(t time.Time) *MemberTagCreate {
+	mtc.mutation.SetCreatedAt(t)
+	return mtc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mtc *MemberTagCreate) SetNillableCreatedAt(t *time.Time) *MemberTagCreate {
+	if t != nil {
+		mtc.SetCreatedAt(*t)
+	}
+	return mtc
+}
+
+// SetMember sets the "member" edge to the Member entity.
+func (mtc *MemberTagCreate) SetMember(m *Member) *MemberTagCreate {
+	return mtc.SetMemberID(m.ID)
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (mtc *MemberTagCreate) SetTag(t *Tag) *MemberTagCreate {
+	return mtc.SetTagID(t.ID)
+}
+
+// Mutation returns the MemberTagMutation object of the builder.
+func (mtc *MemberTagCreate) Mutation() *MemberTagMutation {
+	return mtc.mutation
+}
+
+// Save creates the MemberTag in the database.
+func (mtc *MemberTagCreate) Save(ctx context.Context) (*MemberTag, error) {
+	mtc.defaults()
+	return withHooks(ctx, mtc.sqlSave, mtc.mutation, mtc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mtc *MemberTagCreate) SaveX(ctx context.Context) *MemberTag {
+	v, err := mtc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mtc *MemberTagCreate) Exec(ctx context.Context) error {
+	_, err := mtc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mtc *MemberTagCreate) ExecX(ctx context.Context) {
+	if err := mtc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mtc *MemberTagCreate) defaults() {
+	if _, ok := mtc.mutation.CreatedAt(); !ok {
+		v := membertag.DefaultCreatedAt()
+		mtc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mtc *MemberTagCreate) check() error {
+	if _, ok := mtc.mutation.MemberID(); !ok {
+		return &ValidationError{Name: "member_id", err: errors.New(`ent: missing required field "MemberTag.member_id"`)}
+	}
+	if _, ok := mtc.mutation.TagID(); !ok {
+		return &ValidationError{Name: "tag_id", err: errors.New(`ent: missing required field "MemberTag.tag_id"`)}
+	}
+	if _, ok := mtc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MemberTag.created_at"`)}
+	}
+	if len(mtc.mutation.MemberIDs()) == 0 {
+		return &ValidationError{Name: "member", err: errors.New(`ent: missing required edge "MemberTag.member"`)}
+	}
+	if len(mtc.mutation.TagIDs()) == 0 {
+		return &ValidationError{Name: "tag", err: errors.New(`ent: missing required edge "MemberTag.tag"`)}
+	}
+	return nil
+}
+
+func (mtc *MemberTagCreate) sqlSave(ctx context.Context) (*MemberTag, error) {
+	if err := mtc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mtc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mtc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
+
+func (mtc *MemberTagCreate) createSpec() (*MemberTag, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MemberTag{config: mtc.config}
+		_spec = sqlgraph.NewCreateSpec(membertag.Table, nil)
+	)
+	if value, ok := mtc.mutation.CreatedAt(); ok {
+		_spec.SetField(membertag.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := mtc.mutation.MemberIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   membertag.MemberTable,
+			Columns: []string{membertag.MemberColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MemberID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mtc.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   membertag.TagTable,
+			Columns: []string{membertag.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TagID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MemberTagCreateBulk is the builder for creating many MemberTag entities in bulk.
+type MemberTagCreateBulk struct {
+	config
+	err      error
+	builders []*MemberTagCreate
+}
+
+// Save creates the MemberTag entities in the database.
+func (mtcb *MemberTagCreateBulk) Save(ctx context.Context) ([]*MemberTag, error) {
+	if mtcb.err != nil {
+		return nil, mtcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mtcb.builders))
+	nodes := make([]*MemberTag, len(mtcb.builders))
+	mutators := make([]Mutator, len(mtcb.builders))
+	for i := range mtcb.builders {
+		func(i int, root context.Context) {
+			builder := mtcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MemberTagMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mtcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mtcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mtcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mtcb *MemberTagCreateBulk) SaveX(ctx context.Context) []*MemberTag {
+	v, err := mtcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mtcb *MemberTagCreateBulk) Exec(ctx context.Context) error {
+	_, err := mtcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mtcb *MemberTagCreateBulk) ExecX(ctx context.Context) {
+	if err := mtcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,87 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/membertag"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/predicate"
+)
+
+// MemberTagDelete is the builder for deleting a MemberTag entity.
+type MemberTagDelete struct {
+	config
+	hooks    []Hook
+	mutation *MemberTagMutation
+}
+
+// Where appends a list predicates to the MemberTagDelete builder.
+func (mtd *MemberTagDelete) Where(ps ...predicate.MemberTag) *MemberTagDelete {
+	mtd.mutation.Where(ps...)
+	return mtd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mtd *MemberTagDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mtd.sqlExec, mtd.mutation, mtd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mtd *MemberTagDelete) ExecX(ctx context.Context) int {
+	n, err := mtd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mtd *MemberTagDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(membertag.Table, nil)
+	if ps := mtd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mtd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mtd.mutation.done = true
+	return affected, err
+}
+
+// MemberTagDeleteOne is the builder for deleting a single MemberTag entity.
+type MemberTagDeleteOne struct {
+	mtd *MemberTagDelete
+}
+
+// Where appends a list predicates to the MemberTagDelete builder.
+func (mtdo *MemberTagDeleteOne) Where(ps ...predicate.MemberTag) *MemberTagDeleteOne {
+	mtdo.mtd.mutation.Where(ps...)
+	return mtdo
+}
+
+// Exec executes the deletion query.
+func (mtdo *MemberTagDeleteOne) Exec(ctx context.Context) error {
+	n, err := mtdo.mtd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{membertag.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mtdo *MemberTagDeleteOne) ExecX(ctx context.Context) {
+	if err := mtdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,603 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/member"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/membertag"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/predicate"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/tag"
+)
+
+// MemberTagQuery is the builder for querying MemberTag entities.
+type MemberTagQuery struct {
+	config
+	ctx        *QueryContext
+	order      []membertag.OrderOption
+	inters     []Interceptor
+	predicates []predicate.MemberTag
+	withMember *MemberQuery
+	withTag    *TagQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MemberTagQuery builder.
+func (mtq *MemberTagQuery) Where(ps ...predicate.MemberTag) *MemberTagQuery {
+	mtq.predicates = append(mtq.predicates, ps...)
+	return mtq
+}
+
+// Limit the number of records to be returned by this query.
+func (mtq *MemberTagQuery) Limit(limit int) *MemberTagQuery {
+	mtq.ctx.Limit = &limit
+	return mtq
+}
+
+// Offset to start from.
+func (mtq *MemberTagQuery) Offset(offset int) *MemberTagQuery {
+	mtq.ctx.Offset = &offset
+	return mtq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mtq *MemberTagQuery) Unique(unique bool) *MemberTagQuery {
+	mtq.ctx.Unique = &unique
+	return mtq
+}
+
+// Order specifies how the records should be ordered.
+func (mtq *MemberTagQuery) Order(o ...membertag.OrderOption) *MemberTagQuery {
+	mtq.order = append(mtq.order, o...)
+	return mtq
+}
+
+// QueryMember chains the current query on the "member" edge.
+func (mtq *MemberTagQuery) QueryMember() *MemberQuery {
+	query := (&MemberClient{config: mtq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mtq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mtq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(membertag.Table, membertag.MemberColumn, selector),
+			sqlgraph.To(member.Table, member.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, membertag.MemberTable, membertag.MemberColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mtq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTag chains the current query on the "tag" edge.
+func (mtq *MemberTagQuery) QueryTag() *TagQuery {
+	query := (&TagClient{config: mtq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mtq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mtq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(membertag.Table, membertag.TagColumn, selector),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, membertag.TagTable, membertag.TagColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mtq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first MemberTag entity from the query.
+// Returns a *NotFoundError when no MemberTag was found.
+func (mtq *MemberTagQuery) First(ctx context.Context) (*MemberTag, error) {
+	nodes, err := mtq.Limit(1).All(setContextOp(ctx, mtq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{membertag.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mtq *MemberTagQuery) FirstX(ctx context.Context) *MemberTag {
+	node, err := mtq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// Only returns a single MemberTag entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MemberTag entity is found.
+// Returns a *NotFoundError when no MemberTag entities are found.
+func (mtq *MemberTagQuery) Only(ctx context.Context) (*MemberTag, error) {
+	nodes, err := mtq.Limit(2).All(setContextOp(ctx, mtq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{membertag.Label}
+	default:
+		return nil, &NotSingularError{membertag.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mtq *MemberTagQuery) OnlyX(ctx context.Context) *MemberTag {
+	node, err := mtq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// All executes the query and returns a list of MemberTags.
+func (mtq *MemberTagQuery) All(ctx context.Context) ([]*MemberTag, error) {
+	ctx = setContextOp(ctx, mtq.ctx, ent.OpQueryAll)
+	if err := mtq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MemberTag, *MemberTagQuery]()
+	return withInterceptors[[]*MemberTag](ctx, mtq, qr, mtq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mtq *MemberTagQuery) AllX(ctx context.Context) []*MemberTag {
+	nodes, err := mtq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// Count returns the count of the given query.
+func (mtq *MemberTagQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mtq.ctx, ent.OpQueryCount)
+	if err := mtq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mtq, querierCount[*MemberTagQuery](), mtq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mtq *MemberTagQuery) CountX(ctx context.Context) int {
+	count, err := mtq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mtq *MemberTagQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mtq.ctx, ent.OpQueryExist)
+	switch _, err := mtq.First(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mtq *MemberTagQuery) ExistX(ctx context.Context) bool {
+	exist, err := mtq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MemberTagQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mtq *MemberTagQuery) Clone() *MemberTagQuery {
+	if mtq == nil {
+		return nil
+	}
+	return &MemberTagQuery{
+		config:     mtq.config,
+		ctx:        mtq.ctx.Clone(),
+		order:      append([]membertag.OrderOption{}, mtq.order...),
+		inters:     append([]Interceptor{}, mtq.inters...),
+		predicates: append([]predicate.MemberTag{}, mtq.predicates...),
+		withMember: mtq.withMember.Clone(),
+		withTag:    mtq.withTag.Clone(),
+		// clone intermediate query.
+		sql:  mtq.sql.Clone(),
+		path: mtq.path,
+	}
+}
+
+// WithMember tells the query-builder to eager-load the nodes that are connected to
+// the "member" edge. The optional arguments are used to configure the query builder of the edge.
+func (mtq *MemberTagQuery) WithMember(opts ...func(*MemberQuery)) *MemberTagQuery {
+	query := (&MemberClient{config: mtq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mtq.withMember = query
+	return mtq
+}
+
+// WithTag tells the query-builder to eager-load the nodes that are connected to
+// the "tag" edge. The optional arguments are used to configure the query builder of the edge.
+func (mtq *MemberTagQuery) WithTag(opts ...func(*TagQuery)) *MemberTagQuery {
+	query := (&TagClient{config: mtq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mtq.withTag = query
+	return mtq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		MemberID int `json:"member_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MemberTag.Query().
+//		GroupBy(membertag.FieldMemberID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (mtq *MemberTagQuery) GroupBy(field string, fields ...string) *MemberTagGroupBy {
+	mtq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MemberTagGroupBy{build: mtq}
+	grbuild.flds = &mtq.ctx.Fields
+	grbuild.label = membertag.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		MemberID int `json:"member_id,omitempty"`
+//	}
+//
+//	client.MemberTag.Query().
+//		Select(membertag.FieldMemberID).
+//		Scan(ctx, &v)
+func (mtq *MemberTagQuery) Select(fields ...string) *MemberTagSelect {
+	mtq.ctx.Fields = append(mtq.ctx.Fields, fields...)
+	sbuild := &MemberTagSelect{MemberTagQuery: mtq}
+	sbuild.label = membertag.Label
+	sbuild.flds, sbuild.scan = &mtq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MemberTagSelect configured with the given aggregations.
+func (mtq *MemberTagQuery) Aggregate(fns ...AggregateFunc) *MemberTagSelect {
+	return mtq.Select().Aggregate(fns...)
+}
+
+func (mtq *MemberTagQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mtq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mtq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mtq.ctx.Fields {
+		if !membertag.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mtq.path != nil {
+		prev, err := mtq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mtq.sql = prev
+	}
+	return nil
+}
+
+func (mtq *MemberTagQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MemberTag, error) {
+	var (
+		nodes       = []*MemberTag{}
+		_spec       = mtq.querySpec()
+		loadedTypes = [2]bool{
+			mtq.withMember != nil,
+			mtq.withTag != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MemberTag).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MemberTag{config: mtq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mtq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := mtq.withMember; query != nil {
+		if err := mtq.loadMember(ctx, query, nodes, nil,
+			func(n *MemberTag, e *Member) { n.Edges.Member = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mtq.withTag; query != nil {
+		if err := mtq.loadTag(ctx, query, nodes, nil,
+			func(n *MemberTag, e *Tag) { n.Edges.Tag = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (mtq *MemberTagQuery) loadMember(ctx context.Context, query *MemberQuery, nodes []*MemberTag, init func(*MemberTag), assign func(*MemberTag, *Member)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*MemberTag)
+	for i := range nodes {
+		fk := nodes[i].MemberID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(member.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "member_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (mtq *MemberTagQuery) loadTag(ctx context.Context, query *TagQuery, nodes []*MemberTag, init func(*MemberTag), assign func(*MemberTag, *Tag)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*MemberTag)
+	for i := range nodes {
+		fk := nodes[i].TagID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(tag.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "tag_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (mtq *MemberTagQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mtq.querySpec()
+	_spec.Unique = false
+	_spec.Node.Columns = nil
+	return sqlgraph.CountNodes(ctx, mtq.driver, _spec)
+}
+
+func (mtq *MemberTagQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(membertag.Table, membertag.Columns, nil)
+	_spec.From = mtq.sql
+	if unique := mtq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mtq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mtq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		for i := range fields {
+			_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+		}
+		if mtq.withMember != nil {
+			_spec.Node.AddColumnOnce(membertag.FieldMemberID)
+		}
+		if mtq.withTag != nil {
+			_spec.Node.AddColumnOnce(membertag.FieldTagID)
+		}
+	}
+	if ps := mtq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mtq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mtq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mtq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mtq *MemberTagQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mtq.driver.Dialect())
+	t1 := builder.Table(membertag.Table)
+	columns := mtq.ctx.Fields
+	if len(columns) == 0 {
+		columns = membertag.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mtq.sql != nil {
+		selector = mtq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mtq.ctx.Unique != nil && *mtq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range mtq.predicates {
+		p(selector)
+	}
+	for _, p := range mtq.order {
+		p(selector)
+	}
+	if offset := mtq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mtq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MemberTagGroupBy is the group-by builder for MemberTag entities.
+type MemberTagGroupBy struct {
+	selector
+	build *MemberTagQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mtgb *MemberTagGroupBy) Aggregate(fns ...AggregateFunc) *MemberTagGroupBy {
+	mtgb.fns = append(mtgb.fns, fns...)
+	return mtgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mtgb *MemberTagGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mtgb.build.ctx, ent.OpQueryGroupBy)
+	if err := mtgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MemberTagQuery, *MemberTagGroupBy](ctx, mtgb.build, mtgb, mtgb.build.inters, v)
+}
+
+func (mtgb *MemberTagGroupBy) sqlScan(ctx context.Context, root *MemberTagQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mtgb.fns))
+	for _, fn := range mtgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mtgb.flds)+len(mtgb.fns))
+		for _, f := range *mtgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mtgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mtgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MemberTagSelect is the builder for selecting fields of MemberTag entities.
+type MemberTagSelect struct {
+	*MemberTagQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mts *MemberTagSelect) Aggregate(fns ...AggregateFunc) *MemberTagSelect {
+	mts.fns = append(mts.fns, fns...)
+	return mts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mts *MemberTagSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mts.ctx, ent.OpQuerySelect)
+	if err := mts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MemberTagQuery, *MemberTagSelect](ctx, mts.MemberTagQuery, mts, mts.inters, v)
+}
+
+func (mts *MemberTagSelect) sqlScan(ctx context.Context, root *MemberTagQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mts.fns))
+	for _, fn := range mts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,458 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/member"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/membertag"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/predicate"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/tag"
+)
+
+// MemberTagUpdate is the builder for updating MemberTag entities.
+type MemberTagUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MemberTagMutation
+}
+
+// Where appends a list predicates to the MemberTagUpdate builder.
+func (mtu *MemberTagUpdate) Where(ps ...predicate.MemberTag) *MemberTagUpdate {
+	mtu.mutation.Where(ps...)
+	return mtu
+}
+
+// SetMemberID sets the "member_id" field.
+func (mtu *MemberTagUpdate) SetMemberID(i int) *MemberTagUpdate {
+	mtu.mutation.SetMemberID(i)
+	return mtu
+}
+
+// SetNillableMemberID sets the "member_id" field if the given value is not nil.
+func (mtu *MemberTagUpdate) SetNillableMemberID(i *int) *MemberTagUpdate {
+	if i != nil {
+		mtu.SetMemberID(*i)
+	}
+	return mtu
+}
+
+// SetTagID sets the "tag_id" field.
+func (mtu *MemberTagUpdate) SetTagID(i int) *MemberTagUpdate {
+	mtu.mutation.SetTagID(i)
+	return mtu
+}
+
+// SetNillableTagID sets the "tag_id" field if the given value is not nil.
+func (mtu *MemberTagUpdate) SetNillableTagID(i *int) *MemberTagUpdate {
+	if i != nil {
+		mtu.SetTagID(*i)
+	}
+	return mtu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mtu *MemberTagUpdate) SetCreatedAt(t time.Time) *MemberTagUpdate {
+	mtu.mutation.SetCreatedAt(t)
+	return mtu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mtu *MemberTagUpdate) SetNillableCreatedAt(t *time.Time) *MemberTagUpdate {
+	if t != nil {
+		mtu.SetCreatedAt(*t)
+	}
+	return mtu
+}
+
+// SetMember sets the "member" edge to the Member entity.
+func (mtu *MemberTagUpdate) SetMember(m *Member) *MemberTagUpdate {
+	return mtu.SetMemberID(m.ID)
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (mtu *MemberTagUpdate) SetTag(t *Tag) *MemberTagUpdate {
+	return mtu.SetTagID(t.ID)
+}
+
+// Mutation returns the MemberTagMutation object of the builder.
+func (mtu *MemberTagUpdate) Mutation() *MemberTagMutation {
+	return mtu.mutation
+}
+
+// ClearMember clears the "member" edge to the Member entity.
+func (mtu *MemberTagUpdate) ClearMember() *MemberTagUpdate {
+	mtu.mutation.ClearMember()
+	return mtu
+}
+
+// ClearTag clears the "tag" edge to the Tag entity.
+func (mtu *MemberTagUpdate) ClearTag() *MemberTagUpdate {
+	mtu.mutation.ClearTag()
+	return mtu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mtu *MemberTagUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mtu.sqlSave, mtu.mutation, mtu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mtu *MemberTagUpdate) SaveX(ctx context.Context) int {
+	affected, err := mtu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mtu *MemberTagUpdate) Exec(ctx context.Context) error {
+	_, err := mtu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mtu *MemberTagUpdate) ExecX(ctx context.Context) {
+	if err := mtu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mtu *MemberTagUpdate) check() error {
+	if mtu.mutation.MemberCleared() && len(mtu.mutation.MemberIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MemberTag.member"`)
+	}
+	if mtu.mutation.TagCleared() && len(mtu.mutation.TagIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MemberTag.tag"`)
+	}
+	return nil
+}
+
+func (mtu *MemberTagUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mtu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(membertag.Table, membertag.Columns, sqlgraph.NewFieldSpec(membertag.FieldMemberID, field.TypeInt), sqlgraph.NewFieldSpec(membertag.FieldTagID, field.TypeInt))
+	if ps := mtu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mtu.mutation.CreatedAt(); ok {
+		_spec.SetField(membertag.FieldCreatedAt, field.TypeTime, value)
+	}
+	if mtu.mutation.MemberCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   membertag.MemberTable,
+			Columns: []string{membertag.MemberColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mtu.mutation.MemberIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   membertag.MemberTable,
+			Columns: []string{membertag.MemberColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mtu.mutation.TagCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   membertag.TagTable,
+			Columns: []string{membertag.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mtu.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   membertag.TagTable,
+			Columns: []string{membertag.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mtu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{membertag.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mtu.mutation.done = true
+	return n, nil
+}
+
+// MemberTagUpdateOne is the builder for updating a single MemberTag entity.
+type MemberTagUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MemberTagMutation
+}
+
+// SetMemberID sets the "member_id" field.
+func (mtuo *MemberTagUpdateOne) SetMemberID(i int) *MemberTagUpdateOne {
+	mtuo.mutation.SetMemberID(i)
+	return mtuo
+}
+
+// SetNillableMemberID sets the "member_id" field if the given value is not nil.
+func (mtuo *MemberTagUpdateOne) SetNillableMemberID(i *int) *MemberTagUpdateOne {
+	if i != nil {
+		mtuo.SetMemberID(*i)
+	}
+	return mtuo
+}
+
+// SetTagID sets the "tag_id" field.
+func (mtuo *MemberTagUpdateOne) SetTagID(i int) *MemberTagUpdateOne {
+	mtuo.mutation.SetTagID(i)
+	return mtuo
+}
+
+// SetNillableTagID sets the "tag_id" field if the given value is not nil.
+func (mtuo *MemberTagUpdateOne) SetNillableTagID(i *int) *MemberTagUpdateOne {
+	if i != nil {
+		mtuo.SetTagID(*i)
+	}
+	return mtuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mtuo *MemberTagUpdateOne) SetCreatedAt(t time.Time) *MemberTagUpdateOne {
+	mtuo.mutation.SetCreatedAt(t)
+	return mtuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mtuo *MemberTagUpdateOne) SetNillableCreatedAt(t *time.Time) *MemberTagUpdateOne {
+	if t != nil {
+		mtuo.SetCreatedAt(*t)
+	}
+	return mtuo
+}
+
+// SetMember sets the "member" edge to the Member entity.
+func (mtuo *MemberTagUpdateOne) SetMember(m *Member) *MemberTagUpdateOne {
+	return mtuo.SetMemberID(m.ID)
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (mtuo *MemberTagUpdateOne) SetTag(t *Tag) *MemberTagUpdateOne {
+	return mtuo.SetTagID(t.ID)
+}
+
+// Mutation returns the MemberTagMutation object of the builder.
+func (mtuo *MemberTagUpdateOne) Mutation() *MemberTagMutation {
+	return mtuo.mutation
+}
+
+// ClearMember clears the "member" edge to the Member entity.
+func (mtuo *MemberTagUpdateOne) ClearMember() *MemberTagUpdateOne {
+	mtuo.mutation.ClearMember()
+	return mtuo
+}
+
+// ClearTag clears the "tag" edge to the Tag entity.
+func (mtuo *MemberTagUpdateOne) ClearTag() *MemberTagUpdateOne {
+	mtuo.mutation.ClearTag()
+	return mtuo
+}
+
+// Where appends a list predicates to the MemberTagUpdate builder.
+func (mtuo *MemberTagUpdateOne) Where(ps ...predicate.MemberTag) *MemberTagUpdateOne {
+	mtuo.mutation.Where(ps...)
+	return mtuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mtuo *MemberTagUpdateOne) Select(field string, fields ...string) *MemberTagUpdateOne {
+	mtuo.fields = append([]string{field}, fields...)
+	return mtuo
+}
+
+// Save executes the query and returns the updated MemberTag entity.
+func (mtuo *MemberTagUpdateOne) Save(ctx context.Context) (*MemberTag, error) {
+	return withHooks(ctx, mtuo.sqlSave, mtuo.mutation, mtuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mtuo *MemberTagUpdateOne) SaveX(ctx context.Context) *MemberTag {
+	node, err := mtuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mtuo *MemberTagUpdateOne) Exec(ctx context.Context) error {
+	_, err := mtuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mtuo *MemberTagUpdateOne) ExecX(ctx context.Context) {
+	if err := mtuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mtuo *MemberTagUpdateOne) check() error {
+	if mtuo.mutation.MemberCleared() && len(mtuo.mutation.MemberIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MemberTag.member"`)
+	}
+	if mtuo.mutation.TagCleared() && len(mtuo.mutation.TagIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MemberTag.tag"`)
+	}
+	return nil
+}
+
+func (mtuo *MemberTagUpdateOne) sqlSave(ctx context.Context) (_node *MemberTag, err error) {
+	if err := mtuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(membertag.Table, membertag.Columns, sqlgraph.NewFieldSpec(membertag.FieldMemberID, field.TypeInt), sqlgraph.NewFieldSpec(membertag.FieldTagID, field.TypeInt))
+	if id, ok := mtuo.mutation.MemberID(); !ok {
+		return nil, &ValidationError{Name: "member_id", err: errors.New(`ent: missing "MemberTag.member_id" for update`)}
+	} else {
+		_spec.Node.CompositeID[0].Value = id
+	}
+	if id, ok := mtuo.mutation.TagID(); !ok {
+		return nil, &ValidationError{Name: "tag_id", err: errors.New(`ent: missing "MemberTag.tag_id" for update`)}
+	} else {
+		_spec.Node.CompositeID[1].Value = id
+	}
+	if fields := mtuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, len(fields))
+		for i, f := range fields {
+			if !membertag.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			_spec.Node.Columns[i] = f
+		}
+	}
+	if ps := mtuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mtuo.mutation.CreatedAt(); ok {
+		_spec.SetField(membertag.FieldCreatedAt, field.TypeTime, value)
+	}
+	if mtuo.mutation.MemberCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   membertag.MemberTable,
+			Columns: []string{membertag.MemberColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mtuo.mutation.MemberIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   membertag.MemberTable,
+			Columns: []string{membertag.MemberColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mtuo.mutation.TagCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   membertag.TagTable,
+			Columns: []string{membertag.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mtuo.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   membertag.TagTable,
+			Columns: []string{membertag.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &MemberTag{config: mtuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mtuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{membertag.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mtuo.mutation.done = true
+	return _node, nil
+}
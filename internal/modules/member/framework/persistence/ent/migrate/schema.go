@@ -13,20 +13,108 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "name", Type: field.TypeString},
 		{Name: "email", Type: field.TypeString, Unique: true},
+		{Name: "normalized_email", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "password", Type: field.TypeString},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "active", "suspended", "banned"}, Default: "active"},
+		{Name: "status_reason", Type: field.TypeString, Default: ""},
+		{Name: "merged_into", Type: field.TypeInt, Nullable: true},
+		{Name: "referred_by", Type: field.TypeInt, Nullable: true},
 	}
 	// MembersTable holds the schema information for the "members" table.
 	MembersTable = &schema.Table{
 		Name:       "members",
 		Columns:    MembersColumns,
 		PrimaryKey: []*schema.Column{MembersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "members_members_merged_members",
+				Columns:    []*schema.Column{MembersColumns[9]},
+				RefColumns: []*schema.Column{MembersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "members_members_referrals",
+				Columns:    []*schema.Column{MembersColumns[10]},
+				RefColumns: []*schema.Column{MembersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_members_status",
+				Unique:  false,
+				Columns: []*schema.Column{MembersColumns[7]},
+			},
+			{
+				Name:    "idx_members_merged_into",
+				Unique:  false,
+				Columns: []*schema.Column{MembersColumns[9]},
+			},
+			{
+				Name:    "idx_members_referred_by",
+				Unique:  false,
+				Columns: []*schema.Column{MembersColumns[10]},
+			},
+		},
+	}
+	// MemberTagsColumns holds the columns for the "member_tags" table.
+	MemberTagsColumns = []*schema.Column{
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "member_id", Type: field.TypeInt},
+		{Name: "tag_id", Type: field.TypeInt},
+	}
+	// MemberTagsTable holds the schema information for the "member_tags" table.
+	MemberTagsTable = &schema.Table{
+		Name:       "member_tags",
+		Columns:    MemberTagsColumns,
+		PrimaryKey: []*schema.Column{MemberTagsColumns[1], MemberTagsColumns[2]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "member_tags_members_member",
+				Columns:    []*schema.Column{MemberTagsColumns[1]},
+				RefColumns: []*schema.Column{MembersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "member_tags_tags_tag",
+				Columns:    []*schema.Column{MemberTagsColumns[2]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_member_tags_tag_id",
+				Unique:  false,
+				Columns: []*schema.Column{MemberTagsColumns[2], MemberTagsColumns[1]},
+			},
+		},
+	}
+	// TagsColumns holds the columns for the "tags" table.
+	TagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// TagsTable holds the schema information for the "tags" table.
+	TagsTable = &schema.Table{
+		Name:       "tags",
+		Columns:    TagsColumns,
+		PrimaryKey: []*schema.Column{TagsColumns[0]},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		MembersTable,
+		MemberTagsTable,
+		TagsTable,
 	}
 )
 
 func init() {
+	MembersTable.ForeignKeys[0].RefTable = MembersTable
+	MembersTable.ForeignKeys[1].RefTable = MembersTable
+	MemberTagsTable.ForeignKeys[0].RefTable = MembersTable
+	MemberTagsTable.ForeignKeys[1].RefTable = TagsTable
 }
@@ -8,3 +8,9 @@ import (
 
 // Member is the predicate function for member builders.
 type Member func(*sql.Selector)
+
+// MemberTag is the predicate function for membertag builders.
+type MemberTag func(*sql.Selector)
+
+// Tag is the predicate function for tag builders.
+type Tag func(*sql.Selector)
@@ -3,9 +3,12 @@
 package ent
 
 import (
+	"time"
+
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/member"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/membertag"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/schema"
-	"time"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/tag"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -19,11 +22,33 @@ func init() {
 	// member.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	member.NameValidator = memberDescName.Validators[0].(func(string) error)
 	// memberDescPassword is the schema descriptor for password field.
-	memberDescPassword := memberFields[2].Descriptor()
+	memberDescPassword := memberFields[3].Descriptor()
 	// member.PasswordValidator is a validator for the "password" field. It is called by the builders before save.
 	member.PasswordValidator = memberDescPassword.Validators[0].(func(string) error)
 	// memberDescCreatedAt is the schema descriptor for created_at field.
-	memberDescCreatedAt := memberFields[3].Descriptor()
+	memberDescCreatedAt := memberFields[4].Descriptor()
 	// member.DefaultCreatedAt holds the default value on creation for the created_at field.
 	member.DefaultCreatedAt = memberDescCreatedAt.Default.(func() time.Time)
+	// memberDescUpdatedAt is the schema descriptor for updated_at field.
+	memberDescUpdatedAt := memberFields[5].Descriptor()
+	// member.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	member.DefaultUpdatedAt = memberDescUpdatedAt.Default.(func() time.Time)
+	// member.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	member.UpdateDefaultUpdatedAt = memberDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// memberDescStatusReason is the schema descriptor for status_reason field.
+	memberDescStatusReason := memberFields[7].Descriptor()
+	// member.DefaultStatusReason holds the default value on creation for the status_reason field.
+	member.DefaultStatusReason = memberDescStatusReason.Default.(string)
+	membertagFields := schema.MemberTag{}.Fields()
+	_ = membertagFields
+	// membertagDescCreatedAt is the schema descriptor for created_at field.
+	membertagDescCreatedAt := membertagFields[2].Descriptor()
+	// membertag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	membertag.DefaultCreatedAt = membertagDescCreatedAt.Default.(func() time.Time)
+	tagFields := schema.Tag{}.Fields()
+	_ = tagFields
+	// tagDescCreatedAt is the schema descriptor for created_at field.
+	tagDescCreatedAt := tagFields[1].Descriptor()
+	// tag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tag.DefaultCreatedAt = tagDescCreatedAt.Default.(func() time.Time)
 }
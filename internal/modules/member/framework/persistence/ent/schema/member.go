@@ -2,11 +2,15 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"time"
 )
 
 // Member holds the schema definition for the Member entity.
+// 欄位與 migrations 的 members 資料表一致，資料表結構以 SQL migration 為準
 type Member struct {
 	ent.Schema
 }
@@ -16,12 +20,49 @@ func (Member) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("name").NotEmpty(),
 		field.String("email").Unique(),
+		// normalized_email 尚未回填時為 NULL，避免多筆未正規化的資料撞到 UNIQUE 索引
+		field.String("normalized_email").Optional().Nillable().Unique(),
 		field.String("password").NotEmpty(),
 		field.Time("created_at").Default(time.Now),
+		// updated_at 每次更新時由 UpdateDefault 改寫為目前時間，一律以 UTC 寫入
+		field.Time("updated_at").Default(nowUTC).UpdateDefault(nowUTC),
+		field.Enum("status").Values("pending", "active", "suspended", "banned").Default("active"),
+		field.String("status_reason").Default(""),
+		field.Int("merged_into").Optional().Nillable(),
+		field.Int("referred_by").Optional().Nillable(),
 	}
 }
 
 // Edges of the Member.
 func (Member) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		// merged_into 被合併到的會員，merged_members 為合併到此會員的 tombstone
+		edge.To("merged_members", Member.Type).
+			Annotations(entsql.OnDelete(entsql.NoAction)).
+			From("merged_into_member").
+			Field("merged_into").
+			Unique(),
+		// referred_by 推薦人，referrals 為此會員推薦註冊的會員
+		edge.To("referrals", Member.Type).
+			Annotations(entsql.OnDelete(entsql.NoAction)).
+			From("referrer").
+			Field("referred_by").
+			Unique(),
+		edge.To("tags", Tag.Type).
+			Through("member_tags", MemberTag.Type),
+	}
+}
+
+// Indexes of the Member.
+func (Member) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status").StorageKey("idx_members_status"),
+		index.Fields("merged_into").StorageKey("idx_members_merged_into"),
+		index.Fields("referred_by").StorageKey("idx_members_referred_by"),
+	}
+}
+
+// nowUTC 與 SQL 驅動的 CURRENT_TIMESTAMP 一致以 UTC 寫入
+func nowUTC() time.Time {
+	return time.Now().UTC()
 }
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// MemberTag holds the schema definition for the MemberTag entity.
+// 對應 member_tags 資料表，以 (member_id, tag_id) 為主鍵
+type MemberTag struct {
+	ent.Schema
+}
+
+// Annotations of the MemberTag.
+func (MemberTag) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		field.ID("member_id", "tag_id"),
+	}
+}
+
+// Fields of the MemberTag.
+func (MemberTag) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("member_id"),
+		field.Int("tag_id"),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the MemberTag.
+func (MemberTag) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("member", Member.Type).
+			Unique().
+			Required().
+			Field("member_id").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("tag", Tag.Type).
+			Unique().
+			Required().
+			Field("tag_id").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the MemberTag.
+func (MemberTag) Indexes() []ent.Index {
+	return []ent.Index{
+		// 依標籤篩選會員
+		index.Fields("tag_id", "member_id").StorageKey("idx_member_tags_tag_id"),
+	}
+}
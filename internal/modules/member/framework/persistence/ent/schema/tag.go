@@ -0,0 +1,31 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"time"
+)
+
+// Tag holds the schema definition for the Tag entity.
+// 對應 tags 資料表，name 為正規化後的標籤
+type Tag struct {
+	ent.Schema
+}
+
+// Fields of the Tag.
+func (Tag) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Unique(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the Tag.
+func (Tag) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("members", Member.Type).
+			Ref("tags").
+			Through("member_tags", MemberTag.Type),
+	}
+}
@@ -14,6 +14,10 @@ type Tx struct {
 	config
 	// Member is the client for interacting with the Member builders.
 	Member *MemberClient
+	// MemberTag is the client for interacting with the MemberTag builders.
+	MemberTag *MemberTagClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
 
 	// lazily loaded.
 	client     *Client
@@ -146,6 +150,8 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Member = NewMemberClient(tx.config)
+	tx.MemberTag = NewMemberTagClient(tx.config)
+	tx.Tag = NewTagClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -156,11 +162,11 @@ func (tx *Tx) init() {
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
 // applies a query, for example: Member.QueryXXX(), the query will be executed
-// through the framework which created this transaction.
+// through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
 type txDriver struct {
-	// the framework we started the transaction from.
+	// the driver we started the transaction from.
 	drv dialect.Driver
 	// tx is the underlying transaction.
 	tx dialect.Tx
@@ -170,7 +176,7 @@ type txDriver struct {
 	onRollback []RollbackHook
 }
 
-// newTx creates a new transactional framework.
+// newTx creates a new transactional driver.
 func newTx(ctx context.Context, drv dialect.Driver) (*txDriver, error) {
 	tx, err := drv.Tx(ctx)
 	if err != nil {
@@ -183,7 +189,7 @@ func newTx(ctx context.Context, drv dialect.Driver) (*txDriver, error) {
 // from the internal builders. Should be called only by the internal builders.
 func (tx *txDriver) Tx(context.Context) (dialect.Tx, error) { return tx, nil }
 
-// Dialect returns the dialect of the framework we started the transaction from.
+// Dialect returns the dialect of the driver we started the transaction from.
 func (tx *txDriver) Dialect() string { return tx.drv.Dialect() }
 
 // Close is a nop close.
@@ -0,0 +1,578 @@
+package mcmemory
+
+import (
+	"context"
+	"fmt"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxtx"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/sqlx/mcsqlite"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/dao"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/enum"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
+	"maps"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// memoryMember 以記憶體實作 dao.MemberDAO，語意與 mcsqlite 版本一致：
+//   - email 與非空的 normalized_email 唯一，違反時回傳 ErrDBDuplicateKey
+//   - ID 自增且不重複使用（同 AUTOINCREMENT），刪除後不回收
+//   - 更新或刪除不存在的資料回傳 ErrDBNoEffect
+//   - created_at 與 CURRENT_TIMESTAMP 一樣為 UTC、精確到秒
+//
+// 寫入立即生效，在 sqlxtx 交易內時另以 sqlxtx.OnRollback 登記還原，交易回滾後會員與標籤回到寫入前的狀態；
+// 並行交易之間沒有隔離，只適合測試與本機開發
+type memoryMember struct {
+	mu sync.RWMutex
+	// members 以 ID 為 key；回傳給呼叫端的一律是複本
+	members map[int]*dao.MemberRecord
+	// memberTags 會員 ID → 標籤名稱 → 加上標籤的時間
+	memberTags map[int]map[string]time.Time
+	lastID     int
+	// snapshotPath 非空時每次異動後寫入快照，建立時從快照還原
+	snapshotPath string
+	now          func() time.Time
+	logger       logger.Logger
+	tracer       tracer.Tracer
+}
+
+// NewMemoryMember 建立記憶體版本的 MemberDAO；snapshotPath 非空時從該 JSON 檔還原資料（檔案不存在視為空白），
+// 之後每次異動都會寫回，讓 demo 環境重啟後保留資料
+func NewMemoryMember(snapshotPath string, log logger.Logger, tracer tracer.Tracer) (dao.MemberDAO, error) {
+	baseLogger := log.With(logger.NewField("layer", "repository"))
+	m := &memoryMember{
+		members:      make(map[int]*dao.MemberRecord),
+		memberTags:   make(map[int]map[string]time.Time),
+		snapshotPath: snapshotPath,
+		now:          time.Now,
+		logger:       baseLogger,
+		tracer:       tracer,
+	}
+	if snapshotPath != "" {
+		if err := m.restore(snapshotPath); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+func (s *memoryMember) Create(ctx context.Context, m *dao.MemberRecord) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	_, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.Create")
+	defer span.End()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkUnique(0, m.Email, m.NormalizedEmail); err != nil {
+		contextLogger.Error("記憶體插入失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_email", m.Email),
+		)
+		return err
+	}
+	s.lastID++
+	s.forgetOnRollback(ctx, s.lastID)
+	record := *m
+	record.ID = s.lastID
+	record.MergedInto = 0
+	record.StatusReason = ""
+	record.CreatedAt = s.timestamp()
+	record.UpdatedAt = record.CreatedAt
+	s.members[record.ID] = &record
+	m.ID = record.ID
+
+	contextLogger.Debug("記憶體插入成功",
+		logger.NewField("member_id", record.ID),
+		logger.NewField("member_email", m.Email),
+	)
+	return s.persist(contextLogger)
+}
+
+func (s *memoryMember) GetByID(ctx context.Context, id int) (*dao.MemberRecord, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	_, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.GetByID")
+	defer span.End()
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	record, ok := s.members[id]
+	if !ok {
+		contextLogger.Error("記憶體查詢(ID)失敗",
+			logger.NewField("member_id", id),
+		)
+		return nil, mcsqlite.ErrDBRecordNotFound
+	}
+	contextLogger.Debug("記憶體查詢(ID)成功",
+		logger.NewField("member_id", id),
+	)
+	copied := *record
+	return &copied, nil
+}
+
+func (s *memoryMember) GetByEmail(ctx context.Context, normalizedEmail string) (*dao.MemberRecord, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	_, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.GetByEmail")
+	defer span.End()
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, record := range s.members {
+		// NULL 不等於任何值，空字串查不到資料
+		if normalizedEmail != "" && record.NormalizedEmail == normalizedEmail {
+			contextLogger.Debug("記憶體查詢成功",
+				logger.NewField("member_id", record.ID),
+				logger.NewField("normalized_email", normalizedEmail),
+			)
+			copied := *record
+			return &copied, nil
+		}
+	}
+	contextLogger.Error("記憶體查詢失敗",
+		logger.NewField("normalized_email", normalizedEmail),
+	)
+	return nil, mcsqlite.ErrDBRecordNotFound
+}
+
+func (s *memoryMember) GetAll(ctx context.Context, q dao.MemberQuery, p pagination.Pagination) ([]*dao.MemberRecord, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	_, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.GetAll")
+	defer span.End()
+
+	less, err := memberLess(p.SortBy, p.OrderBy)
+	if err != nil {
+		contextLogger.Error("記憶體列表查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("sort_by", p.SortBy),
+		)
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	matched := s.filter(q)
+	sort.SliceStable(matched, func(i, j int) bool {
+		return less(matched[i], matched[j])
+	})
+	records := make([]*dao.MemberRecord, 0, len(matched))
+	for _, record := range paginate(matched, p.Limit, p.Offset) {
+		copied := *record
+		records = append(records, &copied)
+	}
+
+	contextLogger.Debug("記憶體列表查詢成功",
+		logger.NewField("count", len(records)),
+	)
+	return records, nil
+}
+
+func (s *memoryMember) CountAll(ctx context.Context, q dao.MemberQuery) (int, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	_, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.CountAll")
+	defer span.End()
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	count := len(s.filter(q))
+
+	contextLogger.Debug("記憶體總數查詢成功",
+		logger.NewField("count", count),
+	)
+	return count, nil
+}
+
+func (s *memoryMember) UpdateProfile(ctx context.Context, m *dao.MemberRecord) (*dao.MemberRecord, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	_, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.UpdateProfile")
+	defer span.End()
+
+	err := s.update(ctx, m.ID, func(record *dao.MemberRecord) error {
+		record.Name = m.Name
+		return nil
+	})
+	if err != nil {
+		contextLogger.Error("記憶體資料更新失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", m.ID),
+		)
+		return nil, err
+	}
+	contextLogger.Debug("記憶體資料更新成功",
+		logger.NewField("member_id", m.ID),
+	)
+	return m, nil
+}
+
+func (s *memoryMember) UpdateEmail(ctx context.Context, id int, email, normalizedEmail string) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	_, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.UpdateEmail")
+	defer span.End()
+
+	err := s.update(ctx, id, func(record *dao.MemberRecord) error {
+		if err := s.checkUnique(id, email, normalizedEmail); err != nil {
+			return err
+		}
+		record.Email = email
+		record.NormalizedEmail = normalizedEmail
+		return nil
+	})
+	if err != nil {
+		contextLogger.Error("記憶體 Email 更新失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+			logger.NewField("new_email", email),
+		)
+		return err
+	}
+	contextLogger.Debug("記憶體 Email 更新成功",
+		logger.NewField("member_id", id),
+		logger.NewField("new_email", email),
+	)
+	return nil
+}
+
+func (s *memoryMember) UpdateNormalizedEmail(ctx context.Context, id int, normalizedEmail string) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	_, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.UpdateNormalizedEmail")
+	defer span.End()
+
+	err := s.update(ctx, id, func(record *dao.MemberRecord) error {
+		if err := s.checkUnique(id, "", normalizedEmail); err != nil {
+			return err
+		}
+		record.NormalizedEmail = normalizedEmail
+		return nil
+	})
+	if err != nil {
+		contextLogger.Error("記憶體正規化 Email 更新失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+			logger.NewField("normalized_email", normalizedEmail),
+		)
+		return err
+	}
+	contextLogger.Debug("記憶體正規化 Email 更新成功",
+		logger.NewField("member_id", id),
+		logger.NewField("normalized_email", normalizedEmail),
+	)
+	return nil
+}
+
+func (s *memoryMember) UpdatePassword(ctx context.Context, id int, password string) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	_, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.UpdatePassword")
+	defer span.End()
+
+	err := s.update(ctx, id, func(record *dao.MemberRecord) error {
+		record.Password = password
+		return nil
+	})
+	if err != nil {
+		contextLogger.Error("記憶體密碼更新失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+		)
+		return err
+	}
+	contextLogger.Debug("記憶體密碼更新成功",
+		logger.NewField("member_id", id),
+	)
+	return nil
+}
+
+func (s *memoryMember) UpdateStatus(ctx context.Context, id int, from, to, reason string) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	_, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.UpdateStatus")
+	defer span.End()
+
+	err := s.update(ctx, id, func(record *dao.MemberRecord) error {
+		// 只在狀態仍為轉換前的值時更新，與 SQL 版本的條件式 UPDATE 一致
+		if record.Status != from {
+			return mcsqlite.ErrDBNoEffect
+		}
+		record.Status = to
+		record.StatusReason = reason
+		return nil
+	})
+	if err != nil {
+		contextLogger.Error("記憶體狀態更新失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+			logger.NewField("from_status", from),
+		)
+		return err
+	}
+	contextLogger.Debug("記憶體狀態更新成功",
+		logger.NewField("member_id", id),
+		logger.NewField("from_status", from),
+		logger.NewField("to_status", to),
+	)
+	return nil
+}
+
+func (s *memoryMember) MarkMerged(ctx context.Context, sourceID, targetID int) (int, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	_, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.MarkMerged")
+	defer span.End()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	source, ok := s.members[sourceID]
+	if !ok || source.MergedInto != 0 {
+		contextLogger.Error("記憶體合併標記未影響任何行",
+			logger.NewField("source_id", sourceID),
+			logger.NewField("target_id", targetID),
+		)
+		return 0, mcsqlite.ErrDBNoEffect
+	}
+	affected := []int{sourceID}
+	for _, record := range s.members {
+		if record.MergedInto == sourceID {
+			affected = append(affected, record.ID)
+		}
+	}
+	s.rememberForRollback(ctx, affected...)
+	updatedAt := s.timestamp()
+	source.MergedInto = targetID
+	source.UpdatedAt = updatedAt
+	// 先前合併到來源會員的 tombstone 改指向新的目標，維持單層指標
+	redirected := 0
+	for _, record := range s.members {
+		if record.MergedInto == sourceID {
+			record.MergedInto = targetID
+			record.UpdatedAt = updatedAt
+			redirected++
+		}
+	}
+
+	contextLogger.Debug("記憶體合併標記成功",
+		logger.NewField("source_id", sourceID),
+		logger.NewField("target_id", targetID),
+		logger.NewField("redirected", redirected),
+	)
+	return redirected, s.persist(contextLogger)
+}
+
+func (s *memoryMember) Delete(ctx context.Context, id int) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	_, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.Delete")
+	defer span.End()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.members[id]; !ok {
+		contextLogger.Error("記憶體刪除未影響預期行數",
+			logger.NewField("member_id", id),
+		)
+		return mcsqlite.ErrDBNoEffect
+	}
+	// 與 SQL 實作一致，指向被刪除會員的 merged_into 與 referred_by 改為空值
+	affected := []int{id}
+	for otherID, record := range s.members {
+		if record.MergedInto == id || record.ReferredBy == id {
+			affected = append(affected, otherID)
+		}
+	}
+	s.rememberForRollback(ctx, affected...)
+	updatedAt := s.timestamp()
+	for _, record := range s.members {
+		if record.MergedInto == id {
+			record.MergedInto = 0
+			record.UpdatedAt = updatedAt
+		}
+		if record.ReferredBy == id {
+			record.ReferredBy = 0
+			record.UpdatedAt = updatedAt
+		}
+	}
+	delete(s.members, id)
+	// member_tags 以 ON DELETE CASCADE 跟著刪除
+	delete(s.memberTags, id)
+
+	contextLogger.Debug("記憶體刪除成功",
+		logger.NewField("member_id", id),
+	)
+	return s.persist(contextLogger)
+}
+
+// update 在寫鎖內修改單一會員並改寫 UpdatedAt，會員不存在時回傳 ErrDBNoEffect；fn 回傳錯誤時不寫入快照
+func (s *memoryMember) update(ctx context.Context, id int, fn func(record *dao.MemberRecord) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	record, ok := s.members[id]
+	if !ok {
+		return mcsqlite.ErrDBNoEffect
+	}
+	updated := *record
+	if err := fn(&updated); err != nil {
+		return err
+	}
+	s.rememberForRollback(ctx, id)
+	updated.UpdatedAt = s.timestamp()
+	*record = updated
+	return s.persist(s.logger)
+}
+
+// rememberForRollback 記下 ids 中現有會員的資料與標籤，ctx 的交易回滾時還原；
+// 呼叫端須持有寫鎖並在異動前呼叫，不在交易內時不做任何事
+func (s *memoryMember) rememberForRollback(ctx context.Context, ids ...int) {
+	records := make(map[int]dao.MemberRecord, len(ids))
+	tags := make(map[int]map[string]time.Time, len(ids))
+	for _, id := range ids {
+		record, ok := s.members[id]
+		if !ok {
+			continue
+		}
+		records[id] = *record
+		tags[id] = maps.Clone(s.memberTags[id])
+	}
+	if len(records) == 0 {
+		return
+	}
+	sqlxtx.OnRollback(ctx, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for id, record := range records {
+			restored := record
+			s.members[id] = &restored
+			if len(tags[id]) == 0 {
+				delete(s.memberTags, id)
+			} else {
+				s.memberTags[id] = tags[id]
+			}
+		}
+		// 快照寫入失敗已由 persist 記錄，回滾沒有呼叫端可以回報
+		_ = s.persist(s.logger)
+	})
+}
+
+// forgetOnRollback ctx 的交易回滾時刪除交易內新增的會員；呼叫端須持有寫鎖，不在交易內時不做任何事
+func (s *memoryMember) forgetOnRollback(ctx context.Context, id int) {
+	sqlxtx.OnRollback(ctx, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.members, id)
+		delete(s.memberTags, id)
+		// 快照寫入失敗已由 persist 記錄，回滾沒有呼叫端可以回報
+		_ = s.persist(s.logger)
+	})
+}
+
+// timestamp 與 SQLite 的 CURRENT_TIMESTAMP 一致，取 UTC 並截到秒
+func (s *memoryMember) timestamp() time.Time {
+	return s.now().UTC().Truncate(time.Second)
+}
+
+// checkUnique 檢查 email 與 normalized_email 的 UNIQUE 限制，空字串視為 NULL 不檢查；exceptID 為正在更新的會員
+func (s *memoryMember) checkUnique(exceptID int, email, normalizedEmail string) error {
+	for _, record := range s.members {
+		if record.ID == exceptID {
+			continue
+		}
+		if email != "" && record.Email == email {
+			return &mcsqlite.DBError{CustomError: mcsqlite.ErrDBDuplicateKey, RawError: fmt.Errorf("UNIQUE constraint failed: members.email"), Constraint: "members.email"}
+		}
+		if normalizedEmail != "" && record.NormalizedEmail == normalizedEmail {
+			return &mcsqlite.DBError{CustomError: mcsqlite.ErrDBDuplicateKey, RawError: fmt.Errorf("UNIQUE constraint failed: members.normalized_email"), Constraint: "members.normalized_email"}
+		}
+	}
+	return nil
+}
+
+// filter 依查詢條件篩選會員，依 ID 排序；除非指定 MergedInto 或 IncludeMerged，一律排除已被合併的會員
+func (s *memoryMember) filter(q dao.MemberQuery) []*dao.MemberRecord {
+	matched := make([]*dao.MemberRecord, 0, len(s.members))
+	for _, record := range s.members {
+		if q.Status != "" && record.Status != q.Status {
+			continue
+		}
+		if q.ReferredBy != 0 && record.ReferredBy != q.ReferredBy {
+			continue
+		}
+		switch {
+		case q.MergedInto != 0:
+			if record.MergedInto != q.MergedInto {
+				continue
+			}
+		case !q.IncludeMerged:
+			if record.MergedInto != 0 {
+				continue
+			}
+		}
+		if !s.hasTags(record.ID, q.TagsAny, q.TagsAll) {
+			continue
+		}
+		matched = append(matched, record)
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		return matched[i].ID < matched[j].ID
+	})
+	return matched
+}
+
+// memberLess 依排序欄位比較，與 SQLite 一樣 NULL（0、空字串）排在最前；未知欄位比照 SQL 回傳錯誤
+func memberLess(sortBy string, orderBy enum.OrderBy) (func(a, b *dao.MemberRecord) bool, error) {
+	var compare func(a, b *dao.MemberRecord) int
+	switch sortBy {
+	case "id":
+		compare = func(a, b *dao.MemberRecord) int { return compareInt(a.ID, b.ID) }
+	case "name":
+		compare = func(a, b *dao.MemberRecord) int { return strings.Compare(a.Name, b.Name) }
+	case "email":
+		compare = func(a, b *dao.MemberRecord) int { return strings.Compare(a.Email, b.Email) }
+	case "normalized_email":
+		compare = func(a, b *dao.MemberRecord) int { return strings.Compare(a.NormalizedEmail, b.NormalizedEmail) }
+	case "status":
+		compare = func(a, b *dao.MemberRecord) int { return strings.Compare(a.Status, b.Status) }
+	case "merged_into":
+		compare = func(a, b *dao.MemberRecord) int { return compareInt(a.MergedInto, b.MergedInto) }
+	case "referred_by":
+		compare = func(a, b *dao.MemberRecord) int { return compareInt(a.ReferredBy, b.ReferredBy) }
+	case "created_at":
+		compare = func(a, b *dao.MemberRecord) int { return a.CreatedAt.Compare(b.CreatedAt) }
+	default:
+		return nil, &mcsqlite.DBError{CustomError: mcsqlite.ErrDBUnexpectedError, RawError: fmt.Errorf("no such column: %s", sortBy)}
+	}
+	switch strings.ToLower(string(orderBy)) {
+	case string(enum.OrderByAsc):
+		return func(a, b *dao.MemberRecord) bool { return compare(a, b) < 0 }, nil
+	case string(enum.OrderByDesc):
+		return func(a, b *dao.MemberRecord) bool { return compare(a, b) > 0 }, nil
+	default:
+		return nil, &mcsqlite.DBError{CustomError: mcsqlite.ErrDBUnexpectedError, RawError: fmt.Errorf("syntax error near %q", orderBy)}
+	}
+}
+
+func compareInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// paginate 與 LIMIT ? OFFSET ? 一致，負的 limit 表示不限筆數
+func paginate(records []*dao.MemberRecord, limit, offset int) []*dao.MemberRecord {
+	if offset < 0 {
+		offset = 0
+	}
+	if offset >= len(records) {
+		return nil
+	}
+	records = records[offset:]
+	if limit >= 0 && limit < len(records) {
+		records = records[:limit]
+	}
+	return records
+}
+
+func createTracedLogger(ctx context.Context, tr tracer.Tracer, log logger.Logger, operationName string) (context.Context, logger.Logger, tracer.Span) {
+	repoCtx, span := tr.Start(ctx, operationName)
+	lg := log.WithContext(repoCtx)
+	return repoCtx, lg, span
+}
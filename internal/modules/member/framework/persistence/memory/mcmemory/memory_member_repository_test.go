@@ -0,0 +1,353 @@
+package mcmemory
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxtx"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/sqlx/mcsqlite"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/dao"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/enum"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	mocklogger "github.com/tomoffice/go-clean-architecture/pkg/logger/mock"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer/adapters/basic"
+)
+
+func newTestMemoryMember(t *testing.T, snapshotPath string) dao.MemberDAO {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockLogger := mocklogger.NewMockLogger(ctrl)
+	mockLogger.EXPECT().With(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+	repo, err := NewMemoryMember(snapshotPath, mockLogger, basic.NewTracer(basic.NewConfig("test", false)))
+	require.NoError(t, err)
+	return repo
+}
+
+func TestMemoryMember_Members(t *testing.T) {
+	repo := newTestMemoryMember(t, "")
+	ctx := context.Background()
+	page := pagination.Pagination{Limit: 10, SortBy: "id", OrderBy: enum.OrderByAsc}
+
+	for _, m := range []*dao.MemberRecord{
+		{Name: "alice", Email: "Alice@Example.com", NormalizedEmail: "alice@example.com", Password: "p", Status: "active"},
+		{Name: "bob", Email: "bob@example.com", NormalizedEmail: "bob@example.com", Password: "p", Status: "pending", ReferredBy: 1},
+		{Name: "carol", Email: "carol@example.com", Password: "p", Status: "active"},
+	} {
+		require.NoError(t, repo.Create(ctx, m))
+	}
+	err := repo.Create(ctx, &dao.MemberRecord{Name: "dup", Email: "x@example.com", NormalizedEmail: "alice@example.com", Password: "p", Status: "active"})
+	assert.ErrorIs(t, err, mcsqlite.ErrDBDuplicateKey)
+	err = repo.Create(ctx, &dao.MemberRecord{Name: "dup", Email: "bob@example.com", Password: "p", Status: "active"})
+	assert.ErrorIs(t, err, mcsqlite.ErrDBDuplicateKey)
+	// normalized_email 空字串寫入 NULL，不受 UNIQUE 限制
+	require.NoError(t, repo.Create(ctx, &dao.MemberRecord{Name: "dave", Email: "dave@example.com", Password: "p", Status: "active"}))
+
+	got, err := repo.GetByEmail(ctx, "alice@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "Alice@Example.com", got.Email)
+	assert.Equal(t, got.CreatedAt.Truncate(time.Second), got.CreatedAt)
+	_, err = repo.GetByID(ctx, 99)
+	assert.ErrorIs(t, err, mcsqlite.ErrDBRecordNotFound)
+	_, err = repo.GetByEmail(ctx, "")
+	assert.ErrorIs(t, err, mcsqlite.ErrDBRecordNotFound)
+
+	// 回傳的是複本，修改不影響儲存的資料
+	got.Name = "mallory"
+	got, err = repo.GetByID(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "alice", got.Name)
+
+	tests := []struct {
+		name    string
+		query   dao.MemberQuery
+		page    pagination.Pagination
+		wantIDs []int
+	}{
+		{name: "all", query: dao.MemberQuery{}, page: page, wantIDs: []int{1, 2, 3, 4}},
+		{name: "status", query: dao.MemberQuery{Status: "pending"}, page: page, wantIDs: []int{2}},
+		{name: "referred by", query: dao.MemberQuery{ReferredBy: 1}, page: page, wantIDs: []int{2}},
+		{name: "name desc", query: dao.MemberQuery{}, page: pagination.Pagination{Limit: 10, SortBy: "name", OrderBy: enum.OrderByDesc}, wantIDs: []int{4, 3, 2, 1}},
+		{name: "ties keep id order", query: dao.MemberQuery{}, page: pagination.Pagination{Limit: 10, SortBy: "status", OrderBy: enum.OrderByAsc}, wantIDs: []int{1, 3, 4, 2}},
+		{name: "null sorts first", query: dao.MemberQuery{}, page: pagination.Pagination{Limit: 10, SortBy: "normalized_email", OrderBy: enum.OrderByAsc}, wantIDs: []int{3, 4, 1, 2}},
+		{name: "limit and offset", query: dao.MemberQuery{}, page: pagination.Pagination{Limit: 2, Offset: 1, SortBy: "id", OrderBy: enum.OrderByAsc}, wantIDs: []int{2, 3}},
+		{name: "offset past end", query: dao.MemberQuery{}, page: pagination.Pagination{Limit: 2, Offset: 10, SortBy: "id", OrderBy: enum.OrderByAsc}, wantIDs: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			records, err := repo.GetAll(ctx, tt.query, tt.page)
+			require.NoError(t, err)
+			ids := make([]int, 0, len(records))
+			for _, r := range records {
+				ids = append(ids, r.ID)
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+		})
+	}
+	_, err = repo.GetAll(ctx, dao.MemberQuery{}, pagination.Pagination{Limit: 10, SortBy: "password; DROP", OrderBy: enum.OrderByAsc})
+	assert.ErrorIs(t, err, mcsqlite.ErrDBUnexpectedError)
+	count, err := repo.CountAll(ctx, dao.MemberQuery{Status: "active"})
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	assert.ErrorIs(t, repo.UpdateStatus(ctx, 2, "active", "suspended", ""), mcsqlite.ErrDBNoEffect)
+	assert.NoError(t, repo.UpdateStatus(ctx, 2, "pending", "active", "approved"))
+	assert.ErrorIs(t, repo.UpdateEmail(ctx, 3, "bob@example.com", ""), mcsqlite.ErrDBDuplicateKey)
+	assert.NoError(t, repo.UpdateEmail(ctx, 3, "carol@new.example.com", ""))
+	assert.ErrorIs(t, repo.UpdateEmail(ctx, 99, "nobody@example.com", ""), mcsqlite.ErrDBNoEffect)
+	assert.ErrorIs(t, repo.UpdateNormalizedEmail(ctx, 4, "alice@example.com"), mcsqlite.ErrDBDuplicateKey)
+	assert.NoError(t, repo.UpdatePassword(ctx, 3, "q"))
+	_, err = repo.UpdateProfile(ctx, &dao.MemberRecord{ID: 3, Name: "caroline"})
+	assert.NoError(t, err)
+	_, err = repo.UpdateProfile(ctx, &dao.MemberRecord{ID: 99, Name: "nobody"})
+	assert.ErrorIs(t, err, mcsqlite.ErrDBNoEffect)
+	got, err = repo.GetByID(ctx, 3)
+	require.NoError(t, err)
+	assert.Equal(t, dao.MemberRecord{ID: 3, Name: "caroline", Email: "carol@new.example.com", Password: "q", Status: "active", CreatedAt: got.CreatedAt, UpdatedAt: got.UpdatedAt}, *got)
+
+	redirected, err := repo.MarkMerged(ctx, 3, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 0, redirected)
+	redirected, err = repo.MarkMerged(ctx, 2, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, redirected)
+	_, err = repo.MarkMerged(ctx, 2, 1)
+	assert.ErrorIs(t, err, mcsqlite.ErrDBNoEffect)
+	merged, err := repo.GetAll(ctx, dao.MemberQuery{MergedInto: 1}, page)
+	require.NoError(t, err)
+	assert.Len(t, merged, 2)
+	count, err = repo.CountAll(ctx, dao.MemberQuery{IncludeMerged: true})
+	require.NoError(t, err)
+	assert.Equal(t, 4, count)
+
+	assert.NoError(t, repo.Delete(ctx, 4))
+	assert.ErrorIs(t, repo.Delete(ctx, 4), mcsqlite.ErrDBNoEffect)
+	// 與 AUTOINCREMENT 一樣，刪除的 ID 不會再被使用
+	created := &dao.MemberRecord{Name: "erin", Email: "erin@example.com", Password: "p", Status: "active"}
+	require.NoError(t, repo.Create(ctx, created))
+	assert.Equal(t, 5, created.ID)
+}
+
+func TestMemoryMember_Tags(t *testing.T) {
+	repo := newTestMemoryMember(t, "")
+	ctx := context.Background()
+	page := pagination.Pagination{Limit: 10, SortBy: "id", OrderBy: enum.OrderByAsc}
+	for _, name := range []string{"a", "b", "c"} {
+		require.NoError(t, repo.Create(ctx, &dao.MemberRecord{Name: name, Email: name + "@example.com", NormalizedEmail: name + "@example.com", Password: "p", Status: "active"}))
+	}
+
+	assert.NoError(t, repo.AddTag(ctx, 1, "vip"))
+	assert.ErrorIs(t, repo.AddTag(ctx, 1, "vip"), mcsqlite.ErrDBNoEffect)
+	assert.ErrorIs(t, repo.AddTag(ctx, 99, "vip"), mcsqlite.ErrDBNoEffect)
+	assert.NoError(t, repo.AddTag(ctx, 1, "beta"))
+	_, err := repo.MarkMerged(ctx, 3, 1)
+	require.NoError(t, err)
+	tagged, err := repo.AddTagToMembers(ctx, []int{1, 2, 3, 99}, "vip")
+	require.NoError(t, err)
+	assert.Equal(t, 1, tagged)
+
+	tags, err := repo.ListTags(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"beta", "vip"}, tags)
+	tags, err = repo.ListTags(ctx, 99)
+	require.NoError(t, err)
+	assert.Empty(t, tags)
+
+	count, err := repo.CountAll(ctx, dao.MemberQuery{TagsAny: []string{"vip", "beta"}})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	all, err := repo.GetAll(ctx, dao.MemberQuery{TagsAll: []string{"vip", "beta"}}, page)
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	assert.Equal(t, 1, all[0].ID)
+
+	carried, err := repo.MergeTags(ctx, 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 1, carried)
+	tags, err = repo.ListTags(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"beta", "vip"}, tags)
+
+	assert.NoError(t, repo.RemoveTag(ctx, 2, "vip"))
+	assert.ErrorIs(t, repo.RemoveTag(ctx, 2, "vip"), mcsqlite.ErrDBNoEffect)
+	assert.ErrorIs(t, repo.RemoveTag(ctx, 2, "missing"), mcsqlite.ErrDBNoEffect)
+
+	// 刪除會員時標籤一併刪除
+	assert.NoError(t, repo.Delete(ctx, 1))
+	tags, err = repo.ListTags(ctx, 1)
+	require.NoError(t, err)
+	assert.Empty(t, tags)
+}
+
+func TestMemoryMember_Snapshot(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "members.json")
+	ctx := context.Background()
+
+	repo := newTestMemoryMember(t, path)
+	require.NoError(t, repo.Create(ctx, &dao.MemberRecord{Name: "alice", Email: "alice@example.com", NormalizedEmail: "alice@example.com", Password: "p", Status: "active"}))
+	require.NoError(t, repo.Create(ctx, &dao.MemberRecord{Name: "bob", Email: "bob@example.com", Password: "p", Status: "pending", ReferredBy: 1}))
+	require.NoError(t, repo.AddTag(ctx, 1, "vip"))
+	require.NoError(t, repo.Delete(ctx, 2))
+	want, err := repo.GetByID(ctx, 1)
+	require.NoError(t, err)
+
+	restored := newTestMemoryMember(t, path)
+	got, err := restored.GetByID(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	tags, err := restored.ListTags(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"vip"}, tags)
+	_, err = restored.GetByID(ctx, 2)
+	assert.ErrorIs(t, err, mcsqlite.ErrDBRecordNotFound)
+	created := &dao.MemberRecord{Name: "carol", Email: "carol@example.com", Password: "p", Status: "active"}
+	require.NoError(t, restored.Create(ctx, created))
+	assert.Equal(t, 3, created.ID)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"version": 99}`), 0o600))
+	ctrl := gomock.NewController(t)
+	mockLogger := mocklogger.NewMockLogger(ctrl)
+	mockLogger.EXPECT().With(gomock.Any()).Return(mockLogger).AnyTimes()
+	_, err = NewMemoryMember(path, mockLogger, basic.NewTracer(basic.NewConfig("test", false)))
+	assert.Error(t, err)
+}
+
+func TestMemoryMember_UpdatedAt(t *testing.T) {
+	repo := newTestMemoryMember(t, "")
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 8, 0, 0, 500, time.FixedZone("CST", 8*60*60))
+	repo.(*memoryMember).now = func() time.Time { return now }
+	require.NoError(t, repo.Create(ctx, &dao.MemberRecord{Name: "alice", Email: "alice@example.com", Password: "p", Status: "active"}))
+	require.NoError(t, repo.Create(ctx, &dao.MemberRecord{Name: "bob", Email: "bob@example.com", Password: "p", Status: "active"}))
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	got, err := repo.GetByID(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, created, got.CreatedAt)
+	assert.Equal(t, created, got.UpdatedAt)
+
+	tests := []struct {
+		name   string
+		id     int
+		update func() error
+	}{
+		{name: "profile", id: 1, update: func() error {
+			_, err := repo.UpdateProfile(ctx, &dao.MemberRecord{ID: 1, Name: "alicia"})
+			return err
+		}},
+		{name: "email", id: 1, update: func() error { return repo.UpdateEmail(ctx, 1, "alicia@example.com", "") }},
+		{name: "password", id: 1, update: func() error { return repo.UpdatePassword(ctx, 1, "q") }},
+		{name: "status", id: 1, update: func() error { return repo.UpdateStatus(ctx, 1, "active", "suspended", "") }},
+		{name: "merged", id: 2, update: func() error {
+			_, err := repo.MarkMerged(ctx, 2, 1)
+			return err
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now = now.Add(time.Minute)
+			require.NoError(t, tt.update())
+			got, err := repo.GetByID(ctx, tt.id)
+			require.NoError(t, err)
+			assert.Equal(t, created, got.CreatedAt)
+			assert.Equal(t, now.UTC().Truncate(time.Second), got.UpdatedAt)
+		})
+	}
+
+	// 條件不符而沒有更新時不改寫 updated_at
+	before, err := repo.GetByID(ctx, 1)
+	require.NoError(t, err)
+	now = now.Add(time.Minute)
+	assert.ErrorIs(t, repo.UpdateStatus(ctx, 1, "active", "banned", ""), mcsqlite.ErrDBNoEffect)
+	after, err := repo.GetByID(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, before.UpdatedAt, after.UpdatedAt)
+}
+
+func TestMemoryMember_ConcurrentCreate(t *testing.T) {
+	repo := newTestMemoryMember(t, "")
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			// 每個 email 建立兩次，只有一次成功
+			email := fmt.Sprintf("m%d@example.com", i%25)
+			_ = repo.Create(ctx, &dao.MemberRecord{Name: "m", Email: email, NormalizedEmail: email, Password: "p", Status: "active"})
+		}(i)
+	}
+	wg.Wait()
+
+	records, err := repo.GetAll(ctx, dao.MemberQuery{}, pagination.Pagination{Limit: 100, SortBy: "id", OrderBy: enum.OrderByAsc})
+	require.NoError(t, err)
+	require.Len(t, records, 25)
+	for i, r := range records {
+		assert.Equal(t, i+1, r.ID)
+	}
+}
+
+func TestMemoryMember_TransactionRollback(t *testing.T) {
+	repo := newTestMemoryMember(t, "")
+	ctx := context.Background()
+	db := sqlx.MustOpen("sqlite3", ":memory:")
+	t.Cleanup(func() { _ = db.Close() })
+	txManager := sqlxtx.NewTxManager(db)
+	errAbort := fmt.Errorf("abort")
+
+	require.NoError(t, repo.Create(ctx, &dao.MemberRecord{Name: "alice", Email: "alice@example.com", NormalizedEmail: "alice@example.com", Password: "p", Status: "active"}))
+	require.NoError(t, repo.Create(ctx, &dao.MemberRecord{Name: "bob", Email: "bob@example.com", NormalizedEmail: "bob@example.com", Password: "p", Status: "active"}))
+	require.NoError(t, repo.AddTag(ctx, 2, "vip"))
+	before, err := repo.GetByID(ctx, 1)
+	require.NoError(t, err)
+
+	err = txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
+		require.NoError(t, repo.Create(txCtx, &dao.MemberRecord{Name: "carol", Email: "carol@example.com", NormalizedEmail: "carol@example.com", Password: "p", Status: "active"}))
+		require.NoError(t, repo.AddTag(txCtx, 3, "beta"))
+		_, err := repo.UpdateProfile(txCtx, &dao.MemberRecord{ID: 1, Name: "alice2"})
+		require.NoError(t, err)
+		require.NoError(t, repo.UpdateEmail(txCtx, 1, "alice2@example.com", "alice2@example.com"))
+		require.NoError(t, repo.AddTag(txCtx, 1, "vip"))
+		require.NoError(t, repo.Delete(txCtx, 2))
+		return errAbort
+	})
+	assert.ErrorIs(t, err, errAbort)
+
+	// 交易內新增的會員消失，修改與刪除回到交易前的狀態
+	_, err = repo.GetByID(ctx, 3)
+	assert.ErrorIs(t, err, mcsqlite.ErrDBRecordNotFound)
+	got, err := repo.GetByID(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, before, got)
+	tags, err := repo.ListTags(ctx, 1)
+	require.NoError(t, err)
+	assert.Empty(t, tags)
+	tags, err = repo.ListTags(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"vip"}, tags)
+	_, err = repo.GetByEmail(ctx, "carol@example.com")
+	assert.ErrorIs(t, err, mcsqlite.ErrDBRecordNotFound)
+
+	// 提交的交易保留寫入
+	err = txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
+		_, err := repo.UpdateProfile(txCtx, &dao.MemberRecord{ID: 1, Name: "alice3"})
+		return err
+	})
+	require.NoError(t, err)
+	got, err = repo.GetByID(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "alice3", got.Name)
+}
@@ -0,0 +1,173 @@
+package mcmemory
+
+import (
+	"context"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/sqlx/mcsqlite"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"sort"
+	"time"
+)
+
+func (s *memoryMember) AddTag(ctx context.Context, memberID int, tag string) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	_, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.AddTag")
+	defer span.End()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rememberForRollback(ctx, memberID)
+	// 只為存在的會員加上標籤；已有此標籤時忽略
+	if _, ok := s.members[memberID]; !ok || !s.addTag(memberID, tag, s.now()) {
+		contextLogger.Debug("記憶體會員加標籤未影響任何行",
+			logger.NewField("member_id", memberID),
+			logger.NewField("tag", tag),
+		)
+		return mcsqlite.ErrDBNoEffect
+	}
+
+	contextLogger.Debug("記憶體會員加標籤成功",
+		logger.NewField("member_id", memberID),
+		logger.NewField("tag", tag),
+	)
+	return s.persist(contextLogger)
+}
+
+func (s *memoryMember) RemoveTag(ctx context.Context, memberID int, tag string) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	_, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.RemoveTag")
+	defer span.End()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.memberTags[memberID][tag]; !ok {
+		contextLogger.Debug("記憶體會員移除標籤未影響任何行",
+			logger.NewField("member_id", memberID),
+			logger.NewField("tag", tag),
+		)
+		return mcsqlite.ErrDBNoEffect
+	}
+	s.rememberForRollback(ctx, memberID)
+	delete(s.memberTags[memberID], tag)
+	if len(s.memberTags[memberID]) == 0 {
+		delete(s.memberTags, memberID)
+	}
+
+	contextLogger.Debug("記憶體會員移除標籤成功",
+		logger.NewField("member_id", memberID),
+		logger.NewField("tag", tag),
+	)
+	return s.persist(contextLogger)
+}
+
+func (s *memoryMember) AddTagToMembers(ctx context.Context, memberIDs []int, tag string) (int, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	_, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.AddTagToMembers")
+	defer span.End()
+
+	if len(memberIDs) == 0 {
+		return 0, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rememberForRollback(ctx, memberIDs...)
+	// 略過不存在、已合併或已有此標籤的會員
+	now := s.now()
+	tagged := 0
+	for _, id := range memberIDs {
+		record, ok := s.members[id]
+		if !ok || record.MergedInto != 0 {
+			continue
+		}
+		if s.addTag(id, tag, now) {
+			tagged++
+		}
+	}
+
+	contextLogger.Debug("記憶體批次加標籤成功",
+		logger.NewField("tag", tag),
+		logger.NewField("count", len(memberIDs)),
+		logger.NewField("tagged", tagged),
+	)
+	return tagged, s.persist(contextLogger)
+}
+
+func (s *memoryMember) ListTags(ctx context.Context, memberID int) ([]string, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	_, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.ListTags")
+	defer span.End()
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	tags := make([]string, 0, len(s.memberTags[memberID]))
+	for tag := range s.memberTags[memberID] {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	contextLogger.Debug("記憶體會員標籤查詢成功",
+		logger.NewField("member_id", memberID),
+		logger.NewField("count", len(tags)),
+	)
+	return tags, nil
+}
+
+func (s *memoryMember) MergeTags(ctx context.Context, sourceID, targetID int) (int, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	_, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.MergeTags")
+	defer span.End()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rememberForRollback(ctx, targetID)
+	// 目標已有的標籤忽略，保留原本加上的時間
+	carried := 0
+	for tag, createdAt := range s.memberTags[sourceID] {
+		if s.addTag(targetID, tag, createdAt) {
+			carried++
+		}
+	}
+
+	contextLogger.Debug("記憶體合併標籤成功",
+		logger.NewField("source_id", sourceID),
+		logger.NewField("target_id", targetID),
+		logger.NewField("carried", carried),
+	)
+	return carried, s.persist(contextLogger)
+}
+
+// addTag 為會員加上標籤，已有此標籤時回傳 false；呼叫端須持有寫鎖
+func (s *memoryMember) addTag(memberID int, tag string, createdAt time.Time) bool {
+	tags, ok := s.memberTags[memberID]
+	if !ok {
+		tags = make(map[string]time.Time)
+		s.memberTags[memberID] = tags
+	}
+	if _, exists := tags[tag]; exists {
+		return false
+	}
+	tags[tag] = createdAt.UTC().Truncate(time.Second)
+	return true
+}
+
+// hasTags 標籤篩選，TagsAny 符合任一、TagsAll 須全部符合；呼叫端須持有讀鎖
+func (s *memoryMember) hasTags(memberID int, tagsAny, tagsAll []string) bool {
+	tags := s.memberTags[memberID]
+	if len(tagsAny) > 0 {
+		found := false
+		for _, tag := range tagsAny {
+			if _, ok := tags[tag]; ok {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	for _, tag := range tagsAll {
+		if _, ok := tags[tag]; !ok {
+			return false
+		}
+	}
+	return true
+}
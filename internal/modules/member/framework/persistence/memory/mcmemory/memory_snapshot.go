@@ -0,0 +1,158 @@
+package mcmemory
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/sqlx/mcsqlite"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/dao"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// snapshotVersion 快照格式版本，格式不相容時遞增
+const snapshotVersion = 1
+
+// memberSnapshot 快照的 JSON 格式；LastID 一併保存，還原後的 ID 不會與刪除過的會員重複
+type memberSnapshot struct {
+	Version int              `json:"version"`
+	LastID  int              `json:"last_id"`
+	Members []snapshotMember `json:"members"`
+}
+
+type snapshotMember struct {
+	ID              int           `json:"id"`
+	Name            string        `json:"name"`
+	Email           string        `json:"email"`
+	NormalizedEmail string        `json:"normalized_email,omitempty"`
+	Password        string        `json:"password"`
+	Status          string        `json:"status"`
+	StatusReason    string        `json:"status_reason,omitempty"`
+	MergedInto      int           `json:"merged_into,omitempty"`
+	ReferredBy      int           `json:"referred_by,omitempty"`
+	CreatedAt       time.Time     `json:"created_at"`
+	UpdatedAt       time.Time     `json:"updated_at"`
+	Tags            []snapshotTag `json:"tags,omitempty"`
+}
+
+type snapshotTag struct {
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// persist snapshotPath 非空時寫入快照；呼叫端須持有寫鎖。寫入失敗時記憶體中的變更已生效，
+// 回傳 DB 錯誤讓呼叫端知道重啟後會遺失這次異動
+func (s *memoryMember) persist(contextLogger logger.Logger) error {
+	if s.snapshotPath == "" {
+		return nil
+	}
+	if err := writeSnapshot(s.snapshotPath, s.snapshot()); err != nil {
+		contextLogger.Error("記憶體快照寫入失敗",
+			logger.NewField("error", err),
+			logger.NewField("path", s.snapshotPath),
+		)
+		return &mcsqlite.DBError{CustomError: mcsqlite.ErrDBUnexpectedError, RawError: fmt.Errorf("mcmemory: write snapshot: %w", err)}
+	}
+	return nil
+}
+
+// snapshot 依 ID 排序輸出，讓快照內容穩定、方便比對；呼叫端須持有鎖
+func (s *memoryMember) snapshot() memberSnapshot {
+	snap := memberSnapshot{Version: snapshotVersion, LastID: s.lastID, Members: make([]snapshotMember, 0, len(s.members))}
+	for _, record := range s.members {
+		member := snapshotMember{
+			ID:              record.ID,
+			Name:            record.Name,
+			Email:           record.Email,
+			NormalizedEmail: record.NormalizedEmail,
+			Password:        record.Password,
+			Status:          record.Status,
+			StatusReason:    record.StatusReason,
+			MergedInto:      record.MergedInto,
+			ReferredBy:      record.ReferredBy,
+			CreatedAt:       record.CreatedAt,
+			UpdatedAt:       record.UpdatedAt,
+		}
+		for name, createdAt := range s.memberTags[record.ID] {
+			member.Tags = append(member.Tags, snapshotTag{Name: name, CreatedAt: createdAt})
+		}
+		sort.Slice(member.Tags, func(i, j int) bool {
+			return member.Tags[i].Name < member.Tags[j].Name
+		})
+		snap.Members = append(snap.Members, member)
+	}
+	sort.Slice(snap.Members, func(i, j int) bool {
+		return snap.Members[i].ID < snap.Members[j].ID
+	})
+	return snap
+}
+
+// restore 從快照檔還原，檔案不存在時維持空白
+func (s *memoryMember) restore(path string) error {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("mcmemory: read snapshot: %w", err)
+	}
+	var snap memberSnapshot
+	if err := json.Unmarshal(data, &snap); err != nil {
+		return fmt.Errorf("mcmemory: decode snapshot %s: %w", path, err)
+	}
+	if snap.Version != snapshotVersion {
+		return fmt.Errorf("mcmemory: unsupported snapshot version %d", snap.Version)
+	}
+	s.lastID = snap.LastID
+	for _, member := range snap.Members {
+		s.members[member.ID] = &dao.MemberRecord{
+			ID:              member.ID,
+			Name:            member.Name,
+			Email:           member.Email,
+			NormalizedEmail: member.NormalizedEmail,
+			Password:        member.Password,
+			Status:          member.Status,
+			StatusReason:    member.StatusReason,
+			MergedInto:      member.MergedInto,
+			ReferredBy:      member.ReferredBy,
+			CreatedAt:       member.CreatedAt.UTC(),
+			UpdatedAt:       member.UpdatedAt.UTC(),
+		}
+		// 加入 updated_at 前的快照沒有此值，視同 created_at
+		if member.UpdatedAt.IsZero() {
+			s.members[member.ID].UpdatedAt = member.CreatedAt.UTC()
+		}
+		for _, tag := range member.Tags {
+			s.addTag(member.ID, tag.Name, tag.CreatedAt)
+		}
+		if member.ID > s.lastID {
+			s.lastID = member.ID
+		}
+	}
+	return nil
+}
+
+// writeSnapshot 先寫入同目錄的暫存檔再 rename，寫到一半中斷也不會留下損毀的快照
+func writeSnapshot(path string, snap memberSnapshot) error {
+	data, err := json.MarshalIndent(snap, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,18 @@
+package sqlx
+
+import "database/sql"
+
+type InvitationSQLXModel struct {
+	ID        int    `db:"id"`
+	Code      string `db:"code"`
+	CreatedBy string `db:"created_by"`
+	// ReferrerID 由管理者建立的邀請碼為 NULL
+	ReferrerID sql.NullInt64 `db:"referrer_id"`
+	Email      string        `db:"email"`
+	MaxUses    int           `db:"max_uses"`
+	Uses       int           `db:"uses"`
+	// ExpiresAt、RevokedAt 不會過期、尚未撤銷時為 NULL
+	ExpiresAt sql.NullString `db:"expires_at"`
+	RevokedAt sql.NullString `db:"revoked_at"`
+	CreatedAt string         `db:"created_at"`
+}
@@ -27,6 +27,33 @@ var (
 	// ErrDBTransactionDone 這個 transaction 已經 commit 或 rollback，不能再用。
 	ErrDBTransactionDone = errors.New("db: transaction done")
 
+	// ErrDBPrimaryKeyConflict 主鍵重複，通常是 INSERT 指定了已存在的 id。
+	ErrDBPrimaryKeyConflict = errors.New("db: primary key conflict")
+
+	// ErrDBForeignKeyViolation 違反外鍵，像是參照的資料不存在、或刪除仍被參照的資料。
+	ErrDBForeignKeyViolation = errors.New("db: foreign key violation")
+
+	// ErrDBNotNullViolation 必填欄位寫入 NULL。
+	ErrDBNotNullViolation = errors.New("db: not null violation")
+
+	// ErrDBCheckViolation 違反 CHECK 條件。
+	ErrDBCheckViolation = errors.New("db: check constraint violation")
+
+	// ErrDBBusy 資料庫被其他連線鎖住（SQLITE_BUSY/SQLITE_LOCKED），稍後重試即可。
+	ErrDBBusy = errors.New("db: database is busy or locked")
+
+	// ErrDBReadOnly 資料庫唯讀，像是檔案沒有寫入權限或連到唯讀 replica。
+	ErrDBReadOnly = errors.New("db: database is read-only")
+
+	// ErrDBFull 磁碟或資料庫空間已滿。
+	ErrDBFull = errors.New("db: database or disk is full")
+
+	// ErrDBCorrupt 資料庫檔案損毀或不是資料庫檔案，需要人工處理。
+	ErrDBCorrupt = errors.New("db: database is corrupt")
+
+	// ErrDBSerializationFailure 並行交易互相衝突被資料庫中止（Postgres SQLSTATE 40001），整個交易重試即可。
+	ErrDBSerializationFailure = errors.New("db: serialization failure")
+
 	// ErrDBUnexpectedError 不知道怎麼歸類的 DB 錯誤（像第三方套件 bug、panic 等）。
 	ErrDBUnexpectedError = errors.New("db: unexpected error")
 
@@ -0,0 +1,30 @@
+package mcsqlite
+
+import (
+	"time"
+
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/sqlx"
+)
+
+const (
+	queryInsertInvitation = `INSERT INTO member_invitations (code, created_by, referrer_id, email, max_uses, uses, expires_at, created_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+	querySelectInvitationByID   = `SELECT * FROM member_invitations WHERE id = ?`
+	querySelectInvitationByCode = `SELECT * FROM member_invitations WHERE code = ?`
+	querySelectInvitationBase   = `SELECT * FROM member_invitations`
+	queryCountInvitationBase    = `SELECT COUNT(*) FROM member_invitations`
+	// 邀請碼以最新的優先顯示
+	queryInvitationOrderAndPage = ` ORDER BY id DESC LIMIT ? OFFSET ?`
+	// queryRedeemInvitation 以讀到的使用次數做樂觀鎖，並再次確認未撤銷、未用完
+	queryRedeemInvitation = `UPDATE member_invitations SET uses = uses + 1
+WHERE id = ? AND uses = ? AND uses < max_uses AND revoked_at IS NULL`
+	queryRevokeInvitation = `UPDATE member_invitations SET revoked_at = ? WHERE id = ? AND revoked_at IS NULL`
+)
+
+// sqliteTimeLayout 與 CURRENT_TIMESTAMP 格式一致（UTC）
+const sqliteTimeLayout = "2006-01-02 15:04:05"
+
+// parseSQLiteTime 接受 CURRENT_TIMESTAMP、go-sqlite3 與 ent 寫入的格式，一律回傳 UTC，見 sqlx.ParseTimestamp
+func parseSQLiteTime(value string) (time.Time, error) {
+	return sqlx.ParseTimestamp(value)
+}
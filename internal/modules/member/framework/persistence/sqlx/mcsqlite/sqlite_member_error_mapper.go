@@ -4,6 +4,10 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"github.com/mattn/go-sqlite3"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxinstrument"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
 	"strings"
 )
 
@@ -32,15 +36,98 @@ func mapSQLError(err error) error {
 	if errors.Is(err, context.Canceled) {
 		return wrap(err, ErrDBContextCanceled)
 	}
-	// mcsqlite 特有
-	if strings.Contains(err.Error(), "UNIQUE constraint failed") {
-		return wrap(err, ErrDBDuplicateKey)
+	// mcsqlite 特有：依 driver 的錯誤碼分類，不比對訊息字串
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) {
+		if customErr := sqliteErrorToCustom(sqliteErr); customErr != nil {
+			dbErr := wrap(err, customErr)
+			dbErr.Constraint = constraintName(sqliteErr)
+			return dbErr
+		}
 	}
 	return wrap(err, ErrDBUnexpectedError)
 }
+
+// MapSQLError 供同樣連到 SQLite 的其他實作（例如 ent）沿用相同的錯誤分類
+func MapSQLError(err error) error {
+	return mapSQLError(err)
+}
+
+// sqliteErrorToCustom 先比對約束的 ExtendedCode，再比對主錯誤碼；無法分類時回傳 nil
+func sqliteErrorToCustom(err sqlite3.Error) error {
+	switch err.ExtendedCode {
+	case sqlite3.ErrConstraintUnique:
+		return ErrDBDuplicateKey
+	case sqlite3.ErrConstraintPrimaryKey, sqlite3.ErrConstraintRowID:
+		return ErrDBPrimaryKeyConflict
+	case sqlite3.ErrConstraintForeignKey:
+		return ErrDBForeignKeyViolation
+	case sqlite3.ErrConstraintNotNull:
+		return ErrDBNotNullViolation
+	case sqlite3.ErrConstraintCheck:
+		return ErrDBCheckViolation
+	}
+	switch err.Code {
+	case sqlite3.ErrBusy, sqlite3.ErrLocked:
+		return ErrDBBusy
+	case sqlite3.ErrReadonly:
+		return ErrDBReadOnly
+	case sqlite3.ErrFull:
+		return ErrDBFull
+	case sqlite3.ErrCorrupt, sqlite3.ErrNotADB:
+		return ErrDBCorrupt
+	}
+	return nil
+}
+
+// constraintName 取出 SQLite 約束錯誤訊息中的欄位或約束名稱，例如
+// "UNIQUE constraint failed: members.email" -> "members.email"；外鍵錯誤 SQLite 不提供名稱
+func constraintName(err sqlite3.Error) string {
+	if err.Code != sqlite3.ErrConstraint {
+		return ""
+	}
+	_, name, _ := strings.Cut(err.Error(), "constraint failed: ")
+	return name
+}
+
 func wrap(rawErr, customErr error) *DBError {
 	return &DBError{
 		CustomError: customErr,
 		RawError:    rawErr,
 	}
 }
+
+// NewInstrumenter 以 mcsqlite 的錯誤分類建立 SQL 觀測，未設定 Classify 時依 mapSQLError 的結果分類
+func NewInstrumenter(log logger.Logger, tr tracer.Tracer, options sqlxinstrument.Options) *sqlxinstrument.Instrumenter {
+	if options.Classify == nil {
+		options.Classify = errorClass
+	}
+	return sqlxinstrument.New(log, tr, options)
+}
+
+// errorClasses mapSQLError 的錯誤對應到觀測用的錯誤分類
+var errorClasses = map[error]string{
+	ErrDBRecordNotFound:      sqlxinstrument.ErrorClassNotFound,
+	ErrDBContextTimeout:      sqlxinstrument.ErrorClassTimeout,
+	ErrDBContextCanceled:     sqlxinstrument.ErrorClassCanceled,
+	ErrDBTransactionDone:     sqlxinstrument.ErrorClassTxDone,
+	ErrDBConnectionClosed:    "conn_done",
+	ErrDBDuplicateKey:        "duplicate_key",
+	ErrDBPrimaryKeyConflict:  "primary_key",
+	ErrDBForeignKeyViolation: "foreign_key",
+	ErrDBNotNullViolation:    "not_null",
+	ErrDBCheckViolation:      "check",
+	ErrDBBusy:                "busy",
+	ErrDBReadOnly:            "read_only",
+	ErrDBFull:                "full",
+	ErrDBCorrupt:             "corrupt",
+}
+
+// errorClass 無法分類時回傳空字串，交由 sqlxinstrument.DefaultClassify 處理
+func errorClass(err error) string {
+	var dbErr *DBError
+	if !errors.As(mapSQLError(err), &dbErr) {
+		return ""
+	}
+	return errorClasses[dbErr.CustomError]
+}
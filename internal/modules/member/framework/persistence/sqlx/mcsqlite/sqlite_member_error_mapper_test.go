@@ -0,0 +1,111 @@
+package mcsqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sqlitedb "github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/mcsqlite"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxinstrument"
+)
+
+func TestMapSQLError_Constraints(t *testing.T) {
+	db, err := sqlitedb.NewDB(filepath.Join(t.TempDir(), "test.sqlite"))
+	require.NoError(t, err)
+	defer db.Close()
+	db.MustExec(`CREATE TABLE parents (id INTEGER PRIMARY KEY, email TEXT UNIQUE)`)
+	db.MustExec(`CREATE TABLE children (
+		id INTEGER PRIMARY KEY,
+		parent_id INTEGER NOT NULL REFERENCES parents(id),
+		status TEXT NOT NULL CONSTRAINT children_status_check CHECK (status IN ('active', 'closed'))
+	)`)
+	db.MustExec(`CREATE TABLE codes (code TEXT PRIMARY KEY NOT NULL) WITHOUT ROWID`)
+	db.MustExec(`INSERT INTO parents (id, email) VALUES (1, 'a@example.com')`)
+	db.MustExec(`INSERT INTO codes (code) VALUES ('abc')`)
+
+	tests := []struct {
+		name           string
+		query          string
+		want           error
+		wantConstraint string
+	}{
+		{name: "unique", query: `INSERT INTO parents (id, email) VALUES (2, 'a@example.com')`, want: ErrDBDuplicateKey, wantConstraint: "parents.email"},
+		{name: "rowid primary key", query: `INSERT INTO parents (id, email) VALUES (1, 'b@example.com')`, want: ErrDBPrimaryKeyConflict, wantConstraint: "parents.id"},
+		{name: "primary key", query: `INSERT INTO codes (code) VALUES ('abc')`, want: ErrDBPrimaryKeyConflict, wantConstraint: "codes.code"},
+		{name: "foreign key", query: `INSERT INTO children (parent_id, status) VALUES (99, 'active')`, want: ErrDBForeignKeyViolation},
+		{name: "not null", query: `INSERT INTO children (parent_id, status) VALUES (NULL, 'active')`, want: ErrDBNotNullViolation, wantConstraint: "children.parent_id"},
+		{name: "check", query: `INSERT INTO children (parent_id, status) VALUES (1, 'unknown')`, want: ErrDBCheckViolation, wantConstraint: "children_status_check"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, execErr := db.ExecContext(context.Background(), tt.query)
+			require.Error(t, execErr)
+			got := mapSQLError(execErr)
+			assert.ErrorIs(t, got, tt.want)
+			var dbErr *DBError
+			if assert.ErrorAs(t, got, &dbErr) {
+				assert.Equal(t, execErr, dbErr.RawError)
+				assert.Equal(t, tt.wantConstraint, dbErr.Constraint)
+			}
+		})
+	}
+}
+
+func TestMapSQLError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{name: "no rows", err: sql.ErrNoRows, want: ErrDBRecordNotFound},
+		{name: "busy", err: sqlite3.Error{Code: sqlite3.ErrBusy}, want: ErrDBBusy},
+		{name: "locked", err: sqlite3.Error{Code: sqlite3.ErrLocked}, want: ErrDBBusy},
+		{name: "read only", err: sqlite3.Error{Code: sqlite3.ErrReadonly}, want: ErrDBReadOnly},
+		{name: "full", err: sqlite3.Error{Code: sqlite3.ErrFull}, want: ErrDBFull},
+		{name: "corrupt", err: sqlite3.Error{Code: sqlite3.ErrCorrupt}, want: ErrDBCorrupt},
+		{name: "not a database", err: sqlite3.Error{Code: sqlite3.ErrNotADB}, want: ErrDBCorrupt},
+		{name: "wrapped busy", err: fmt.Errorf("exec: %w", sqlite3.Error{Code: sqlite3.ErrBusy}), want: ErrDBBusy},
+		{name: "other sqlite error", err: sqlite3.Error{Code: sqlite3.ErrError}, want: ErrDBUnexpectedError},
+		{name: "message is not matched", err: errors.New("UNIQUE constraint failed: members.email"), want: ErrDBUnexpectedError},
+		{name: "context timeout", err: context.DeadlineExceeded, want: ErrDBContextTimeout},
+		{name: "tx done", err: sql.ErrTxDone, want: ErrDBTransactionDone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mapSQLError(tt.err)
+			assert.ErrorIs(t, got, tt.want)
+			var dbErr *DBError
+			if assert.ErrorAs(t, got, &dbErr) {
+				assert.Equal(t, tt.err, dbErr.RawError)
+				assert.Empty(t, dbErr.Constraint)
+			}
+		})
+	}
+	assert.NoError(t, mapSQLError(nil))
+}
+
+func TestErrorClass(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{name: "no rows", err: sql.ErrNoRows, want: sqlxinstrument.ErrorClassNotFound},
+		{name: "unique", err: sqlite3.Error{Code: sqlite3.ErrConstraint, ExtendedCode: sqlite3.ErrConstraintUnique}, want: "duplicate_key"},
+		{name: "busy", err: fmt.Errorf("exec: %w", sqlite3.Error{Code: sqlite3.ErrBusy}), want: "busy"},
+		{name: "context timeout", err: context.DeadlineExceeded, want: sqlxinstrument.ErrorClassTimeout},
+		// 無法分類時交給 sqlxinstrument.DefaultClassify
+		{name: "unexpected", err: errors.New("boom"), want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, errorClass(tt.err))
+		})
+	}
+}
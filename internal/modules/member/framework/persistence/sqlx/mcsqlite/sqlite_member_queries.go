@@ -1,13 +1,27 @@
 package mcsqlite
 
 const (
-	queryInsertMember         = `INSERT INTO members (name, email, password) VALUES (?, ?, ?)`
-	querySelectByID           = `SELECT * FROM members WHERE id = ?`
-	querySelectByEmail        = `SELECT * FROM members WHERE email = ?`
-	querySelectAllBase        = `SELECT * FROM members ORDER BY %s %s LIMIT ? OFFSET ?`
-	queryUpdateMemberProfile  = `UPDATE members SET name = ? WHERE id = ?`
-	queryUpdateMemberEmail    = `UPDATE members SET email = ? WHERE id = ?`
-	queryUpdateMemberPassword = `UPDATE members SET password = ? WHERE id = ?`
-	queryDeleteMember         = `DELETE FROM members WHERE id = ?`
-	queryCountMembers         = `SELECT COUNT(*) FROM members`
+	// queryInsertMember SQLite 的 updated_at 沒有預設值（ALTER TABLE 不能加非常數預設），新增時明確寫入
+	queryInsertMember          = `INSERT INTO members (name, email, normalized_email, password, status, referred_by, updated_at) VALUES (?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP)`
+	querySelectByID            = `SELECT * FROM members WHERE id = ?`
+	querySelectByEmail         = `SELECT * FROM members WHERE normalized_email = ?`
+	querySelectAll             = `SELECT * FROM members`
+	querySelectAllPage         = ` LIMIT ? OFFSET ?`
+	queryUpdateMemberProfile   = `UPDATE members SET name = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?`
+	queryUpdateMemberEmail     = `UPDATE members SET email = ?, normalized_email = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?`
+	queryUpdateNormalizedEmail = `UPDATE members SET normalized_email = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?`
+	queryUpdateMemberPassword  = `UPDATE members SET password = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?`
+	// queryUpdateMemberStatus 只在狀態仍為轉換前的值時更新，避免並行的狀態變更互相覆蓋
+	queryUpdateMemberStatus = `UPDATE members SET status = ?, status_reason = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ? AND status = ?`
+	// queryMarkMemberMerged 只標記尚未被合併的會員，避免並行合併互相覆蓋
+	queryMarkMemberMerged = `UPDATE members SET merged_into = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ? AND merged_into IS NULL`
+	// queryRedirectMergedMembers 先前合併到來源會員的 tombstone 改指向新的目標，維持單層指標
+	queryRedirectMergedMembers = `UPDATE members SET merged_into = ?, updated_at = CURRENT_TIMESTAMP WHERE merged_into = ?`
+	// queryClearMergedInto、queryClearReferredBy、queryClearInvitationReferrer 刪除會員前清除指向它的外鍵，
+	// 效果等同 ON DELETE SET NULL；migration 的外鍵沒有 ON DELETE 子句，開啟 foreign_keys 後直接刪除會失敗
+	queryClearMergedInto         = `UPDATE members SET merged_into = NULL, updated_at = CURRENT_TIMESTAMP WHERE merged_into = ?`
+	queryClearReferredBy         = `UPDATE members SET referred_by = NULL, updated_at = CURRENT_TIMESTAMP WHERE referred_by = ?`
+	queryClearInvitationReferrer = `UPDATE member_invitations SET referrer_id = NULL WHERE referrer_id = ?`
+	queryDeleteMember            = `DELETE FROM members WHERE id = ?`
+	queryCountMembers            = `SELECT COUNT(*) FROM members`
 )
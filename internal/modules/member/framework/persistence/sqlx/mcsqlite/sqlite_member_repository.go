@@ -2,29 +2,49 @@ package mcsqlite
 
 import (
 	"context"
-	"fmt"
+	"database/sql"
 	"github.com/jmoiron/sqlx"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxinstrument"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxreplica"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxstmt"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxtx"
 	sqlx2 "github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/sqlx"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/dao"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/enum"
 	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
 	"github.com/tomoffice/go-clean-architecture/pkg/logger"
 	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
-	"time"
+	"strings"
 )
 
 // sqlxMemberRepo 實作 dao.MemberDAO
 type sqlxMemberSqlite struct {
-	db     *sqlx.DB
-	logger logger.Logger
-	tracer tracer.Tracer
+	router     *sqlxreplica.Router
+	instrument *sqlxinstrument.Instrumenter
+	stmts      *sqlxstmt.Cache
+	logger     logger.Logger
+	tracer     tracer.Tracer
 }
 
+// NewSqlxMemberSqlite 不使用 prepared statement 快取；需要快取時由呼叫端建立並負責關閉，改用 NewSqlxMemberSqliteWithRouter
 func NewSqlxMemberSqlite(db *sqlx.DB, log logger.Logger, tracer tracer.Tracer) dao.MemberDAO {
+	return NewSqlxMemberSqliteWithRouter(sqlxreplica.NewRouter(db, nil, sqlxreplica.Options{}, log), nil, nil, log, tracer)
+}
+
+// NewSqlxMemberSqliteWithRouter GetByID、GetByEmail、GetAll、CountAll 經由 router 分流到 replica，其餘操作走 primary；
+// 每次 SQL 呼叫經由 instrument 記錄耗時、筆數與錯誤分類，nil 時使用預設慢查詢門檻；
+// stmts 快取每個連接池上的 prepared statement，nil 表示每次直接送出 SQL 文字；DAO 不關閉 stmts，由建立者在關閉資料庫時一併關閉
+func NewSqlxMemberSqliteWithRouter(router *sqlxreplica.Router, instrument *sqlxinstrument.Instrumenter, stmts *sqlxstmt.Cache, log logger.Logger, tracer tracer.Tracer) dao.MemberDAO {
+	if instrument == nil {
+		instrument = NewInstrumenter(log, tracer, sqlxinstrument.Options{})
+	}
 	baseLogger := log.With(logger.NewField("layer", "repository"))
 	return &sqlxMemberSqlite{
-		db:     db,
-		logger: baseLogger,
-		tracer: tracer,
+		router:     router,
+		instrument: instrument,
+		stmts:      stmts,
+		logger:     baseLogger,
+		tracer:     tracer,
 	}
 }
 func (s sqlxMemberSqlite) Create(ctx context.Context, m *dao.MemberRecord) error {
@@ -32,23 +52,17 @@ func (s sqlxMemberSqlite) Create(ctx context.Context, m *dao.MemberRecord) error
 	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.Create")
 	defer span.End()
 
-	startTime := time.Now()
-
-	_, err := s.db.ExecContext(repoCtx, queryInsertMember, m.Name, m.Email, m.Password)
-	duration := time.Since(startTime)
-
+	_, err := s.executor(repoCtx).ExecContext(repoCtx, "members.insert", queryInsertMember, m.Name, m.Email, nullableNormalizedEmail(m.NormalizedEmail), m.Password, m.Status, nullableID(m.ReferredBy))
 	if err != nil {
 		contextLogger.Error("SQL 插入失敗",
 			logger.NewField("error", err),
 			logger.NewField("member_email", m.Email),
-			logger.NewField("duration_ms", duration.Milliseconds()),
 		)
 		return mapSQLError(err)
 	}
 
 	contextLogger.Debug("SQL 插入成功",
 		logger.NewField("member_email", m.Email),
-		logger.NewField("duration_ms", duration.Milliseconds()),
 	)
 
 	//id, err := result.LastInsertId()
@@ -62,16 +76,13 @@ func (s sqlxMemberSqlite) GetByID(ctx context.Context, id int) (*dao.MemberRecor
 	// 創建帶有 context 的 logger 用於追蹤
 	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.GetByID")
 	defer span.End()
-	startTime := time.Now()
 
 	member := &sqlx2.MemberSQLXModel{}
-	err := s.db.GetContext(repoCtx, member, querySelectByID, id)
-	duration := time.Since(startTime)
+	err := s.reader(repoCtx).GetContext(repoCtx, "members.select_by_id", member, querySelectByID, id)
 	if err != nil {
 		contextLogger.Error("SQL 查詢(ID)失敗",
 			logger.NewField("error", err),
 			logger.NewField("member_id", id),
-			logger.NewField("duration_ms", duration.Milliseconds()),
 		)
 		return nil, mapSQLError(err)
 	}
@@ -80,31 +91,26 @@ func (s sqlxMemberSqlite) GetByID(ctx context.Context, id int) (*dao.MemberRecor
 		contextLogger.Error("SQL 查詢(ID) DTO 轉換失敗",
 			logger.NewField("error", err),
 			logger.NewField("member_id", id),
-			logger.NewField("duration_ms", duration.Milliseconds()),
 		)
 		return nil, err
 	}
 	contextLogger.Debug("SQL 查詢(ID)成功",
 		logger.NewField("member_id", member.ID),
 		logger.NewField("member_email", member.Email),
-		logger.NewField("duration_ms", duration.Milliseconds()),
 	)
 	return record, nil
 }
-func (s sqlxMemberSqlite) GetByEmail(ctx context.Context, email string) (*dao.MemberRecord, error) {
+func (s sqlxMemberSqlite) GetByEmail(ctx context.Context, normalizedEmail string) (*dao.MemberRecord, error) {
 	// 創建帶有 context 的 logger 用於追蹤
 	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.GetByEmail")
 	defer span.End()
-	startTime := time.Now()
 
 	member := &sqlx2.MemberSQLXModel{}
-	err := s.db.GetContext(repoCtx, member, querySelectByEmail, email)
-	duration := time.Since(startTime)
+	err := s.reader(repoCtx).GetContext(repoCtx, "members.select_by_email", member, querySelectByEmail, normalizedEmail)
 	if err != nil {
 		contextLogger.Error("SQL 查詢失敗",
 			logger.NewField("error", err),
-			logger.NewField("member_email", email),
-			logger.NewField("duration_ms", duration.Milliseconds()),
+			logger.NewField("normalized_email", normalizedEmail),
 		)
 		return nil, mapSQLError(err)
 	}
@@ -112,35 +118,31 @@ func (s sqlxMemberSqlite) GetByEmail(ctx context.Context, email string) (*dao.Me
 	if err != nil {
 		contextLogger.Error("SQL 查詢 DTO 轉換失敗",
 			logger.NewField("error", err),
-			logger.NewField("member_email", email),
-			logger.NewField("duration_ms", duration.Milliseconds()),
+			logger.NewField("normalized_email", normalizedEmail),
 		)
 		return nil, err
 	}
 	contextLogger.Debug("SQL 查詢成功",
 		logger.NewField("member_id", member.ID),
-		logger.NewField("member_email", email),
-		logger.NewField("duration_ms", duration.Milliseconds()),
+		logger.NewField("normalized_email", normalizedEmail),
 	)
 	return record, nil
 }
-func (s sqlxMemberSqlite) GetAll(ctx context.Context, pagination pagination.Pagination) ([]*dao.MemberRecord, error) {
+func (s sqlxMemberSqlite) GetAll(ctx context.Context, q dao.MemberQuery, pagination pagination.Pagination) ([]*dao.MemberRecord, error) {
 	// 創建帶有 context 的 logger 用於追蹤
 	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.GetAll")
 	defer span.End()
-	startTime := time.Now()
-	query := fmt.Sprintf(querySelectAllBase, pagination.SortBy, pagination.OrderBy)
+	where, args := buildMemberWhere(q)
+	query := selectAllQuery(where, pagination.SortBy, pagination.OrderBy)
+	args = append(args, pagination.Limit, pagination.Offset)
 
 	members := make([]*sqlx2.MemberSQLXModel, 0)
-	err := s.db.SelectContext(repoCtx, &members, query, pagination.Limit, pagination.Offset)
-	duration := time.Since(startTime)
-
+	err := s.reader(repoCtx).SelectContext(repoCtx, "members.select_all", &members, query, args...)
 	if err != nil {
 		contextLogger.Error("SQL 列表查詢失敗",
 			logger.NewField("error", err),
 			logger.NewField("limit", pagination.Limit),
 			logger.NewField("offset", pagination.Offset),
-			logger.NewField("duration_ms", duration.Milliseconds()),
 		)
 		return nil, mapSQLError(err)
 	}
@@ -151,7 +153,6 @@ func (s sqlxMemberSqlite) GetAll(ctx context.Context, pagination pagination.Pagi
 			contextLogger.Error("SQL 列表查詢 DTO 轉換失敗",
 				logger.NewField("error", err),
 				logger.NewField("member_id", member.ID),
-				logger.NewField("duration_ms", duration.Milliseconds()),
 			)
 			return nil, err
 		}
@@ -159,32 +160,26 @@ func (s sqlxMemberSqlite) GetAll(ctx context.Context, pagination pagination.Pagi
 	}
 	contextLogger.Debug("SQL 列表查詢成功",
 		logger.NewField("count", len(members)),
-		logger.NewField("duration_ms", duration.Milliseconds()),
 	)
 	return records, nil
 }
-func (s sqlxMemberSqlite) CountAll(ctx context.Context) (int, error) {
+func (s sqlxMemberSqlite) CountAll(ctx context.Context, q dao.MemberQuery) (int, error) {
 	// 創建帶有 context 的 logger 用於追蹤
 	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.CountAll")
 	defer span.End()
 
-	startTime := time.Now()
-
+	where, args := buildMemberWhere(q)
 	var count int
-	err := s.db.GetContext(repoCtx, &count, queryCountMembers)
-	duration := time.Since(startTime)
-
+	err := s.reader(repoCtx).GetContext(repoCtx, "members.count", &count, queryCountMembers+where, args...)
 	if err != nil {
 		contextLogger.Error("SQL 總數查詢失敗",
 			logger.NewField("error", err),
-			logger.NewField("duration_ms", duration.Milliseconds()),
 		)
 		return 0, mapSQLError(err)
 	}
 
 	contextLogger.Debug("SQL 總數查詢成功",
 		logger.NewField("count", count),
-		logger.NewField("duration_ms", duration.Milliseconds()),
 	)
 	return count, nil
 }
@@ -193,16 +188,11 @@ func (s sqlxMemberSqlite) UpdateProfile(ctx context.Context, m *dao.MemberRecord
 	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.UpdateProfile")
 	defer span.End()
 
-	startTime := time.Now()
-
-	result, err := s.db.ExecContext(repoCtx, queryUpdateMemberProfile, m.Name, m.Email, m.ID)
-	duration := time.Since(startTime)
-
+	result, err := s.executor(repoCtx).ExecContext(repoCtx, "members.update_profile", queryUpdateMemberProfile, m.Name, m.ID)
 	if err != nil {
 		contextLogger.Error("SQL 資料更新失敗",
 			logger.NewField("error", err),
 			logger.NewField("member_id", m.ID),
-			logger.NewField("duration_ms", duration.Milliseconds()),
 		)
 		return nil, mapSQLError(err)
 	}
@@ -227,26 +217,20 @@ func (s sqlxMemberSqlite) UpdateProfile(ctx context.Context, m *dao.MemberRecord
 		logger.NewField("member_id", m.ID),
 		logger.NewField("member_email", m.Email),
 		logger.NewField("rows_affected", rowsAffected),
-		logger.NewField("duration_ms", duration.Milliseconds()),
 	)
 	return m, nil
 }
-func (s sqlxMemberSqlite) UpdateEmail(ctx context.Context, id int, email string) error {
+func (s sqlxMemberSqlite) UpdateEmail(ctx context.Context, id int, email, normalizedEmail string) error {
 	// 創建帶有 context 的 logger 用於追蹤
 	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.UpdateEmail")
 	defer span.End()
 
-	startTime := time.Now()
-
-	result, err := s.db.ExecContext(repoCtx, queryUpdateMemberEmail, email, id)
-	duration := time.Since(startTime)
-
+	result, err := s.executor(repoCtx).ExecContext(repoCtx, "members.update_email", queryUpdateMemberEmail, email, nullableNormalizedEmail(normalizedEmail), id)
 	if err != nil {
 		contextLogger.Error("SQL Email 更新失敗",
 			logger.NewField("error", err),
 			logger.NewField("member_id", id),
 			logger.NewField("new_email", email),
-			logger.NewField("duration_ms", duration.Milliseconds()),
 		)
 		return mapSQLError(err)
 	}
@@ -271,25 +255,57 @@ func (s sqlxMemberSqlite) UpdateEmail(ctx context.Context, id int, email string)
 		logger.NewField("member_id", id),
 		logger.NewField("new_email", email),
 		logger.NewField("rows_affected", rowsAffected),
-		logger.NewField("duration_ms", duration.Milliseconds()),
 	)
 	return nil
 }
-func (s sqlxMemberSqlite) UpdatePassword(ctx context.Context, id int, password string) error {
+func (s sqlxMemberSqlite) UpdateNormalizedEmail(ctx context.Context, id int, normalizedEmail string) error {
 	// 創建帶有 context 的 logger 用於追蹤
-	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.UpdatePassword")
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.UpdateNormalizedEmail")
 	defer span.End()
 
-	startTime := time.Now()
+	result, err := s.executor(repoCtx).ExecContext(repoCtx, "members.update_normalized_email", queryUpdateNormalizedEmail, nullableNormalizedEmail(normalizedEmail), id)
+	if err != nil {
+		contextLogger.Error("SQL 正規化 Email 更新失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+			logger.NewField("normalized_email", normalizedEmail),
+		)
+		return mapSQLError(err)
+	}
 
-	result, err := s.db.ExecContext(repoCtx, queryUpdateMemberPassword, password, id)
-	duration := time.Since(startTime)
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		contextLogger.Error("SQL 正規化 Email 更新結果檢查失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+		)
+		return err
+	}
 
+	if rowsAffected == 0 {
+		contextLogger.Error("SQL 正規化 Email 更新未影響任何行",
+			logger.NewField("member_id", id),
+		)
+		return ErrDBNoEffect
+	}
+
+	contextLogger.Debug("SQL 正規化 Email 更新成功",
+		logger.NewField("member_id", id),
+		logger.NewField("normalized_email", normalizedEmail),
+		logger.NewField("rows_affected", rowsAffected),
+	)
+	return nil
+}
+func (s sqlxMemberSqlite) UpdatePassword(ctx context.Context, id int, password string) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.UpdatePassword")
+	defer span.End()
+
+	result, err := s.executor(repoCtx).ExecContext(repoCtx, "members.update_password", queryUpdateMemberPassword, password, id)
 	if err != nil {
 		contextLogger.Error("SQL 密碼更新失敗",
 			logger.NewField("error", err),
 			logger.NewField("member_id", id),
-			logger.NewField("duration_ms", duration.Milliseconds()),
 		)
 		return mapSQLError(err)
 	}
@@ -313,25 +329,128 @@ func (s sqlxMemberSqlite) UpdatePassword(ctx context.Context, id int, password s
 	contextLogger.Debug("SQL 密碼更新成功",
 		logger.NewField("member_id", id),
 		logger.NewField("rows_affected", rowsAffected),
-		logger.NewField("duration_ms", duration.Milliseconds()),
 	)
 	return nil
 }
+func (s sqlxMemberSqlite) UpdateStatus(ctx context.Context, id int, from, to, reason string) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.UpdateStatus")
+	defer span.End()
+
+	result, err := s.executor(repoCtx).ExecContext(repoCtx, "members.update_status", queryUpdateMemberStatus, to, reason, id, from)
+	if err != nil {
+		contextLogger.Error("SQL 狀態更新失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+		)
+		return mapSQLError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		contextLogger.Error("SQL 狀態更新結果檢查失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+		)
+		return err
+	}
+
+	if rowsAffected == 0 {
+		contextLogger.Error("SQL 狀態更新未影響任何行",
+			logger.NewField("member_id", id),
+			logger.NewField("from_status", from),
+		)
+		return ErrDBNoEffect
+	}
+
+	contextLogger.Debug("SQL 狀態更新成功",
+		logger.NewField("member_id", id),
+		logger.NewField("from_status", from),
+		logger.NewField("to_status", to),
+	)
+	return nil
+}
+func (s sqlxMemberSqlite) MarkMerged(ctx context.Context, sourceID, targetID int) (int, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.MarkMerged")
+	defer span.End()
+
+	result, err := s.executor(repoCtx).ExecContext(repoCtx, "members.mark_merged", queryMarkMemberMerged, targetID, sourceID)
+	if err != nil {
+		contextLogger.Error("SQL 合併標記失敗",
+			logger.NewField("error", err),
+			logger.NewField("source_id", sourceID),
+			logger.NewField("target_id", targetID),
+		)
+		return 0, mapSQLError(err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		contextLogger.Error("SQL 合併標記結果檢查失敗",
+			logger.NewField("error", err),
+			logger.NewField("source_id", sourceID),
+		)
+		return 0, err
+	}
+	if rowsAffected == 0 {
+		contextLogger.Error("SQL 合併標記未影響任何行",
+			logger.NewField("source_id", sourceID),
+			logger.NewField("target_id", targetID),
+		)
+		return 0, ErrDBNoEffect
+	}
+
+	result, err = s.executor(repoCtx).ExecContext(repoCtx, "members.redirect_merged", queryRedirectMergedMembers, targetID, sourceID)
+	if err != nil {
+		contextLogger.Error("SQL 合併轉指失敗",
+			logger.NewField("error", err),
+			logger.NewField("source_id", sourceID),
+			logger.NewField("target_id", targetID),
+		)
+		return 0, mapSQLError(err)
+	}
+	redirected, err := result.RowsAffected()
+	if err != nil {
+		contextLogger.Error("SQL 合併轉指結果檢查失敗",
+			logger.NewField("error", err),
+			logger.NewField("source_id", sourceID),
+		)
+		return 0, err
+	}
+
+	contextLogger.Debug("SQL 合併標記成功",
+		logger.NewField("source_id", sourceID),
+		logger.NewField("target_id", targetID),
+		logger.NewField("redirected", redirected),
+	)
+	return int(redirected), nil
+}
 func (s sqlxMemberSqlite) Delete(ctx context.Context, id int) error {
 	// 創建帶有 context 的 logger 用於追蹤
 	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.Delete")
 	defer span.End()
 
-	startTime := time.Now()
-
-	result, err := s.db.ExecContext(repoCtx, queryDeleteMember, id)
-	duration := time.Since(startTime)
+	// 清除外鍵與刪除須在同一個交易，由 use case 的交易保證
+	for _, clear := range []struct{ name, query string }{
+		{"members.clear_merged_into", queryClearMergedInto},
+		{"members.clear_referred_by", queryClearReferredBy},
+		{"member_invitations.clear_referrer", queryClearInvitationReferrer},
+	} {
+		if _, err := s.executor(repoCtx).ExecContext(repoCtx, clear.name, clear.query, id); err != nil {
+			contextLogger.Error("SQL 刪除前清除外鍵失敗",
+				logger.NewField("error", err),
+				logger.NewField("member_id", id),
+				logger.NewField("query", clear.name),
+			)
+			return mapSQLError(err)
+		}
+	}
 
+	result, err := s.executor(repoCtx).ExecContext(repoCtx, "members.delete", queryDeleteMember, id)
 	if err != nil {
 		contextLogger.Error("SQL 刪除失敗",
 			logger.NewField("error", err),
 			logger.NewField("member_id", id),
-			logger.NewField("duration_ms", duration.Milliseconds()),
 		)
 		return mapSQLError(err)
 	}
@@ -356,11 +475,74 @@ func (s sqlxMemberSqlite) Delete(ctx context.Context, id int) error {
 	contextLogger.Debug("SQL 刪除成功",
 		logger.NewField("member_id", id),
 		logger.NewField("rows_affected", rows),
-		logger.NewField("duration_ms", duration.Milliseconds()),
 	)
 	return nil
 }
 
+// buildMemberWhere 依查詢條件組出 WHERE 子句；除非指定 MergedInto 或 IncludeMerged，
+// 一律排除已被合併的會員
+func buildMemberWhere(q dao.MemberQuery) (string, []any) {
+	var conditions []string
+	var args []any
+	if q.Status != "" {
+		conditions = append(conditions, "status = ?")
+		args = append(args, q.Status)
+	}
+	if q.ReferredBy != 0 {
+		conditions = append(conditions, "referred_by = ?")
+		args = append(args, q.ReferredBy)
+	}
+	switch {
+	case q.MergedInto != 0:
+		conditions = append(conditions, "merged_into = ?")
+		args = append(args, q.MergedInto)
+	case !q.IncludeMerged:
+		conditions = append(conditions, "merged_into IS NULL")
+	}
+	tagConditions, tagArgs := buildTagConditions(q.TagsAny, q.TagsAll)
+	conditions = append(conditions, tagConditions...)
+	args = append(args, tagArgs...)
+	if len(conditions) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(conditions, " AND "), args
+}
+
+// selectAllQuery 依篩選條件與排序組出列表查詢；相同組合產生相同的 SQL 文字，共用同一個 prepared statement
+func selectAllQuery(where, sortBy string, orderBy enum.OrderBy) string {
+	return querySelectAll + where + " ORDER BY " + sortBy + " " + string(orderBy) + querySelectAllPage
+}
+
+// nullableNormalizedEmail 空字串寫入 NULL，避免多筆未正規化的資料撞到 UNIQUE 索引
+func nullableNormalizedEmail(normalizedEmail string) sql.NullString {
+	return sql.NullString{String: normalizedEmail, Valid: normalizedEmail != ""}
+}
+
+// nullableID 0 寫入 NULL，供可為空的外鍵欄位使用
+func nullableID(id int) sql.NullInt64 {
+	return sql.NullInt64{Int64: int64(id), Valid: id != 0}
+}
+
+// executor 寫入用：有交易時使用 context 中的交易，否則走 primary，並記錄已認證 actor 的寫入時間供 read-your-writes；經由 statement 快取與 instrument 包裝
+func (s sqlxMemberSqlite) executor(ctx context.Context) sqlxinstrument.Executor {
+	return s.wrap(s.router.Writer(ctx))
+}
+
+// reader 讀取用，交易中或沒有健康的 replica 時走 primary
+func (s sqlxMemberSqlite) reader(ctx context.Context) sqlxinstrument.Executor {
+	return s.wrap(s.router.Reader(ctx))
+}
+
+// primary 不記錄寫入時間的 primary 讀取，交易中使用該交易
+func (s sqlxMemberSqlite) primary(ctx context.Context) sqlxinstrument.Executor {
+	return s.wrap(sqlxtx.ExecutorFromContext(ctx, s.router.Primary()))
+}
+
+// wrap 以快取的 prepared statement 執行並記錄觀測；交易一律開在 primary 上
+func (s sqlxMemberSqlite) wrap(exec sqlxtx.Executor) sqlxinstrument.Executor {
+	return s.instrument.Wrap(s.stmts.Wrap(exec, s.router.Primary()))
+}
+
 func createTracedLogger(ctx context.Context, tr tracer.Tracer, log logger.Logger, operationName string) (context.Context, logger.Logger, tracer.Span) {
 	repoCtx, span := tr.Start(ctx, operationName)
 	lg := log.WithContext(repoCtx)
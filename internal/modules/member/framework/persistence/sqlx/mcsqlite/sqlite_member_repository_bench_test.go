@@ -0,0 +1,102 @@
+package mcsqlite
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	sqlitedb "github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/mcsqlite"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxreplica"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxstmt"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/dao"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/enum"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer/adapters/basic"
+)
+
+// nopLogger 壓測時不輸出日誌，避免 mock 的鎖影響並行結果
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...logger.Field)               {}
+func (nopLogger) Info(string, ...logger.Field)                {}
+func (nopLogger) Warn(string, ...logger.Field)                {}
+func (nopLogger) Error(string, ...logger.Field)               {}
+func (l nopLogger) With(...logger.Field) logger.Logger        { return l }
+func (l nopLogger) WithContext(context.Context) logger.Logger { return l }
+func (nopLogger) Sync() error                                 { return nil }
+
+// newBenchRepo 以檔案 SQLite（WAL）建立 1000 筆會員；stmts 為 nil 時每次直接送出 SQL 文字
+func newBenchRepo(b *testing.B, stmts *sqlxstmt.Cache) dao.MemberDAO {
+	b.Helper()
+	db, err := sqlitedb.NewDB(filepath.Join(b.TempDir(), "bench.sqlite"))
+	require.NoError(b, err)
+	b.Cleanup(func() { _ = db.Close() })
+	applyMigrations(b, db)
+	tx := db.MustBegin()
+	for i := 1; i <= 1000; i++ {
+		email := fmt.Sprintf("member%d@example.com", i)
+		tx.MustExec(`INSERT INTO members (name, email, normalized_email, password, updated_at) VALUES (?, ?, ?, 'p', CURRENT_TIMESTAMP)`, "member", email, email)
+	}
+	require.NoError(b, tx.Commit())
+	if stmts != nil {
+		b.Cleanup(func() { _ = stmts.Close() })
+	}
+	log := nopLogger{}
+	tr := basic.NewTracer(basic.NewConfig("bench", false))
+	return NewSqlxMemberSqliteWithRouter(sqlxreplica.NewRouter(db, nil, sqlxreplica.Options{}, log), nil, stmts, log, tr)
+}
+
+// benchmarkStatements 以 unprepared（每次送出 SQL 文字）與 prepared（statement 快取）並行執行 fn
+func benchmarkStatements(b *testing.B, fn func(ctx context.Context, repo dao.MemberDAO, i int) error) {
+	for _, bm := range []struct {
+		name  string
+		stmts func() *sqlxstmt.Cache
+	}{
+		{name: "unprepared", stmts: func() *sqlxstmt.Cache { return nil }},
+		{name: "prepared", stmts: func() *sqlxstmt.Cache { return sqlxstmt.New(0) }},
+	} {
+		b.Run(bm.name, func(b *testing.B) {
+			repo := newBenchRepo(b, bm.stmts())
+			ctx := context.Background()
+			b.ReportAllocs()
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				i := 0
+				for pb.Next() {
+					i++
+					if err := fn(ctx, repo, i); err != nil {
+						b.Error(err)
+						return
+					}
+				}
+			})
+		})
+	}
+}
+
+func BenchmarkSqlxMemberSqlite_GetByID(b *testing.B) {
+	benchmarkStatements(b, func(ctx context.Context, repo dao.MemberDAO, i int) error {
+		_, err := repo.GetByID(ctx, i%1000+1)
+		return err
+	})
+}
+
+func BenchmarkSqlxMemberSqlite_GetAll(b *testing.B) {
+	sorts := []string{"id", "name", "email", "created_at"}
+	orders := []enum.OrderBy{enum.OrderByAsc, enum.OrderByDesc}
+	benchmarkStatements(b, func(ctx context.Context, repo dao.MemberDAO, i int) error {
+		p := pagination.Pagination{Limit: 20, Offset: i % 50 * 20, SortBy: sorts[i%len(sorts)], OrderBy: orders[i%len(orders)]}
+		_, err := repo.GetAll(ctx, dao.MemberQuery{}, p)
+		return err
+	})
+}
+
+func BenchmarkSqlxMemberSqlite_UpdateProfile(b *testing.B) {
+	benchmarkStatements(b, func(ctx context.Context, repo dao.MemberDAO, i int) error {
+		_, err := repo.UpdateProfile(ctx, &dao.MemberRecord{ID: i%1000 + 1, Name: "renamed"})
+		return err
+	})
+}
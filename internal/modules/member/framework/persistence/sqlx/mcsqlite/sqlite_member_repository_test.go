@@ -0,0 +1,206 @@
+package mcsqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sqlitedb "github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/mcsqlite"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxreplica"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxstmt"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxtx"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/dao"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/enum"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	mocklogger "github.com/tomoffice/go-clean-architecture/pkg/logger/mock"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer/adapters/basic"
+)
+
+// migratedDB 以 migrations 目錄的 SQL 建立資料表
+func migratedDB(t *testing.T) *sqlx.DB {
+	t.Helper()
+	db := sqlx.MustOpen("sqlite3", ":memory:")
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = db.Close() })
+	applyMigrations(t, db)
+	return db
+}
+
+func applyMigrations(tb testing.TB, db *sqlx.DB) {
+	tb.Helper()
+	files, err := filepath.Glob("../../../../../../../migrations/*.up.sql")
+	require.NoError(tb, err)
+	require.NotEmpty(tb, files)
+	sort.Strings(files)
+	for _, file := range files {
+		migration, err := os.ReadFile(file)
+		require.NoError(tb, err)
+		_, err = db.Exec(string(migration))
+		require.NoError(tb, err, file)
+	}
+}
+
+func TestSqlxMemberSqlite_Timestamps(t *testing.T) {
+	db := migratedDB(t)
+	ctrl := gomock.NewController(t)
+	mockLogger := mocklogger.NewMockLogger(ctrl)
+	mockLogger.EXPECT().With(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+	repo := NewSqlxMemberSqlite(db, mockLogger, basic.NewTracer(basic.NewConfig("test", false)))
+	ctx := context.Background()
+
+	// 模擬 CURRENT_TIMESTAMP、ent、其他工具寫入的資料，updated_at 為 NULL 時視同 created_at
+	want := time.Date(2025, 3, 1, 0, 30, 0, 0, time.UTC)
+	rows := []struct {
+		createdAt string
+		updatedAt any
+	}{
+		{createdAt: "2025-03-01 00:30:00", updatedAt: "2025-03-01 00:30:00"},
+		{createdAt: "2025-03-01 08:30:00+08:00", updatedAt: "2025-03-01T00:30:00Z"},
+		{createdAt: "2025-03-01 00:30:00.000", updatedAt: nil},
+	}
+	for i, row := range rows {
+		db.MustExec(`INSERT INTO members (name, email, password, created_at, updated_at) VALUES (?, ?, 'p', ?, ?)`,
+			"member", "member"+string(rune('a'+i))+"@example.com", row.createdAt, row.updatedAt)
+	}
+
+	records, err := repo.GetAll(ctx, dao.MemberQuery{}, pagination.Pagination{Limit: 10, SortBy: "id", OrderBy: enum.OrderByAsc})
+	require.NoError(t, err)
+	require.Len(t, records, len(rows))
+	for _, r := range records {
+		assert.Equal(t, want, r.CreatedAt, r.ID)
+		assert.Equal(t, want, r.UpdatedAt, r.ID)
+	}
+
+	// 新增時寫入 updated_at，更新時改寫
+	require.NoError(t, repo.Create(ctx, &dao.MemberRecord{Name: "alice", Email: "alice@example.com", NormalizedEmail: "alice@example.com", Password: "p", Status: "active"}))
+	created, err := repo.GetByEmail(ctx, "alice@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, created.CreatedAt, created.UpdatedAt)
+	assert.WithinDuration(t, time.Now(), created.CreatedAt, time.Minute)
+
+	_, err = repo.UpdateProfile(ctx, &dao.MemberRecord{ID: 1, Name: "renamed"})
+	require.NoError(t, err)
+	got, err := repo.GetByID(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, want, got.CreatedAt)
+	assert.True(t, got.UpdatedAt.After(want))
+}
+
+func TestSqlxMemberSqlite_PreparedStatements(t *testing.T) {
+	db := migratedDB(t)
+	ctrl := gomock.NewController(t)
+	mockLogger := mocklogger.NewMockLogger(ctrl)
+	mockLogger.EXPECT().With(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+	tr := basic.NewTracer(basic.NewConfig("test", false))
+	stmts := sqlxstmt.New(0)
+	t.Cleanup(func() { _ = stmts.Close() })
+	repo := NewSqlxMemberSqliteWithRouter(sqlxreplica.NewRouter(db, nil, sqlxreplica.Options{}, mockLogger), nil, stmts, mockLogger, tr)
+	ctx := context.Background()
+
+	for _, name := range []string{"alice", "bob"} {
+		require.NoError(t, repo.Create(ctx, &dao.MemberRecord{Name: name, Email: name + "@example.com", NormalizedEmail: name + "@example.com", Password: "p", Status: "active"}))
+	}
+	// 列表查詢依排序組合各準備一次
+	pages := []pagination.Pagination{
+		{Limit: 10, SortBy: "name", OrderBy: enum.OrderByDesc},
+		{Limit: 10, SortBy: "id", OrderBy: enum.OrderByAsc},
+		{Limit: 10, SortBy: "name", OrderBy: enum.OrderByDesc},
+	}
+	for _, p := range pages {
+		records, err := repo.GetAll(ctx, dao.MemberQuery{}, p)
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		assert.Equal(t, map[string]int{"name": 2, "id": 1}[p.SortBy], records[0].ID)
+	}
+	stats := stmts.Stats()
+	assert.Equal(t, int64(3), stats.Misses)
+	assert.Equal(t, int64(2), stats.Hits)
+
+	// 交易中沿用快取的 statement，rollback 後寫入不保留；連接池只有一個連接也不會卡住
+	txManager := sqlxtx.NewTxManager(db)
+	errRollback := errors.New("rollback")
+	err := txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := repo.UpdateProfile(ctx, &dao.MemberRecord{ID: 1, Name: "renamed"}); err != nil {
+			return err
+		}
+		got, err := repo.GetByID(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, "renamed", got.Name)
+		return errRollback
+	})
+	require.ErrorIs(t, err, errRollback)
+	got, err := repo.GetByID(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "alice", got.Name)
+}
+
+func TestSqlxMemberSqlite_DeleteReferencedMember(t *testing.T) {
+	// 以預設 pragma（foreign_keys 開啟）建立資料庫，與正式環境相同
+	db, err := sqlitedb.NewDB(filepath.Join(t.TempDir(), "members.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	applyMigrations(t, db)
+	var foreignKeys int
+	require.NoError(t, db.Get(&foreignKeys, `PRAGMA foreign_keys`))
+	require.Equal(t, 1, foreignKeys)
+
+	ctrl := gomock.NewController(t)
+	mockLogger := mocklogger.NewMockLogger(ctrl)
+	mockLogger.EXPECT().With(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+	repo := NewSqlxMemberSqlite(db, mockLogger, basic.NewTracer(basic.NewConfig("test", false)))
+	txManager := sqlxtx.NewTxManager(db)
+	ctx := context.Background()
+
+	create := func(name string, referredBy int) int {
+		record := &dao.MemberRecord{Name: name, Email: name + "@example.com", NormalizedEmail: name + "@example.com", Password: "p", Status: "active", ReferredBy: referredBy}
+		require.NoError(t, repo.Create(ctx, record))
+		got, err := repo.GetByEmail(ctx, record.NormalizedEmail)
+		require.NoError(t, err)
+		return got.ID
+	}
+	referrer := create("referrer", 0)
+	referred := create("referred", referrer)
+	db.MustExec(`INSERT INTO member_invitations (code, created_by, referrer_id) VALUES ('INVITE', ?, ?)`, "referrer", referrer)
+	target := create("target", 0)
+	source := create("source", 0)
+	_, err = repo.MarkMerged(ctx, source, target)
+	require.NoError(t, err)
+
+	// 與 DeleteMember 相同，在交易中刪除
+	for _, id := range []int{referrer, target} {
+		err := txManager.WithinTransaction(ctx, func(txCtx context.Context) error {
+			return repo.Delete(txCtx, id)
+		})
+		require.NoError(t, err, id)
+		_, err = repo.GetByID(ctx, id)
+		assert.ErrorIs(t, err, ErrDBRecordNotFound, id)
+	}
+
+	got, err := repo.GetByID(ctx, referred)
+	require.NoError(t, err)
+	assert.Zero(t, got.ReferredBy)
+	got, err = repo.GetByID(ctx, source)
+	require.NoError(t, err)
+	assert.Zero(t, got.MergedInto)
+	var invitationReferrer sql.NullInt64
+	require.NoError(t, db.Get(&invitationReferrer, `SELECT referrer_id FROM member_invitations WHERE code = 'INVITE'`))
+	assert.False(t, invitationReferrer.Valid)
+}
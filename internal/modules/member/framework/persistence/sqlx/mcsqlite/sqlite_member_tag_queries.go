@@ -0,0 +1,22 @@
+package mcsqlite
+
+const (
+	queryInsertTag = `INSERT OR IGNORE INTO tags (name) VALUES (?)`
+	// queryInsertMemberTag 只為存在的會員加上標籤；已有此標籤時忽略，RowsAffected 為 0
+	queryInsertMemberTag = `INSERT OR IGNORE INTO member_tags (member_id, tag_id)
+SELECT m.id, t.id FROM members m JOIN tags t ON t.name = ? WHERE m.id = ?`
+	// queryInsertMembersTagBase 批次加標籤，略過已合併的會員，%s 為會員 ID 的 placeholder
+	queryInsertMembersTagBase = `INSERT OR IGNORE INTO member_tags (member_id, tag_id)
+SELECT m.id, t.id FROM members m JOIN tags t ON t.name = ? WHERE m.id IN (%s) AND m.merged_into IS NULL`
+	queryDeleteMemberTag = `DELETE FROM member_tags
+WHERE member_id = ? AND tag_id = (SELECT id FROM tags WHERE name = ?)`
+	querySelectMemberTags = `SELECT t.name FROM member_tags mt JOIN tags t ON t.id = mt.tag_id
+WHERE mt.member_id = ? ORDER BY t.name`
+	// queryMergeMemberTags 目標已有的標籤忽略，保留原本加上的時間
+	queryMergeMemberTags = `INSERT OR IGNORE INTO member_tags (member_id, tag_id, created_at)
+SELECT ?, tag_id, created_at FROM member_tags WHERE member_id = ?`
+	// 標籤篩選的子查詢，%s 為標籤名稱的 placeholder
+	queryMembersWithAnyTag = `id IN (SELECT mt.member_id FROM member_tags mt JOIN tags t ON t.id = mt.tag_id WHERE t.name IN (%s))`
+	queryMembersWithAllTag = `id IN (SELECT mt.member_id FROM member_tags mt JOIN tags t ON t.id = mt.tag_id WHERE t.name IN (%s)
+GROUP BY mt.member_id HAVING COUNT(DISTINCT t.id) = ?)`
+)
@@ -0,0 +1,215 @@
+package mcsqlite
+
+import (
+	"context"
+	"fmt"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"strings"
+)
+
+func (s sqlxMemberSqlite) AddTag(ctx context.Context, memberID int, tag string) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.AddTag")
+	defer span.End()
+
+	if _, err := s.executor(repoCtx).ExecContext(repoCtx, "tags.insert", queryInsertTag, tag); err != nil {
+		contextLogger.Error("SQL 標籤建立失敗",
+			logger.NewField("error", err),
+			logger.NewField("tag", tag),
+		)
+		return mapSQLError(err)
+	}
+	result, err := s.executor(repoCtx).ExecContext(repoCtx, "member_tags.insert", queryInsertMemberTag, tag, memberID)
+	if err != nil {
+		contextLogger.Error("SQL 會員加標籤失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", memberID),
+			logger.NewField("tag", tag),
+		)
+		return mapSQLError(err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		contextLogger.Error("SQL 會員加標籤結果檢查失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", memberID),
+		)
+		return err
+	}
+	if rowsAffected == 0 {
+		contextLogger.Debug("SQL 會員加標籤未影響任何行",
+			logger.NewField("member_id", memberID),
+			logger.NewField("tag", tag),
+		)
+		return ErrDBNoEffect
+	}
+
+	contextLogger.Debug("SQL 會員加標籤成功",
+		logger.NewField("member_id", memberID),
+		logger.NewField("tag", tag),
+	)
+	return nil
+}
+
+func (s sqlxMemberSqlite) RemoveTag(ctx context.Context, memberID int, tag string) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.RemoveTag")
+	defer span.End()
+
+	result, err := s.executor(repoCtx).ExecContext(repoCtx, "member_tags.delete", queryDeleteMemberTag, memberID, tag)
+	if err != nil {
+		contextLogger.Error("SQL 會員移除標籤失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", memberID),
+			logger.NewField("tag", tag),
+		)
+		return mapSQLError(err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		contextLogger.Error("SQL 會員移除標籤結果檢查失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", memberID),
+		)
+		return err
+	}
+	if rowsAffected == 0 {
+		contextLogger.Debug("SQL 會員移除標籤未影響任何行",
+			logger.NewField("member_id", memberID),
+			logger.NewField("tag", tag),
+		)
+		return ErrDBNoEffect
+	}
+
+	contextLogger.Debug("SQL 會員移除標籤成功",
+		logger.NewField("member_id", memberID),
+		logger.NewField("tag", tag),
+	)
+	return nil
+}
+
+func (s sqlxMemberSqlite) AddTagToMembers(ctx context.Context, memberIDs []int, tag string) (int, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.AddTagToMembers")
+	defer span.End()
+
+	if len(memberIDs) == 0 {
+		return 0, nil
+	}
+
+	if _, err := s.executor(repoCtx).ExecContext(repoCtx, "tags.insert", queryInsertTag, tag); err != nil {
+		contextLogger.Error("SQL 標籤建立失敗",
+			logger.NewField("error", err),
+			logger.NewField("tag", tag),
+		)
+		return 0, mapSQLError(err)
+	}
+	args := make([]any, 0, len(memberIDs)+1)
+	args = append(args, tag)
+	for _, id := range memberIDs {
+		args = append(args, id)
+	}
+	query := fmt.Sprintf(queryInsertMembersTagBase, placeholders(len(memberIDs)))
+	result, err := s.executor(repoCtx).ExecContext(repoCtx, "member_tags.insert_batch", query, args...)
+	if err != nil {
+		contextLogger.Error("SQL 批次加標籤失敗",
+			logger.NewField("error", err),
+			logger.NewField("tag", tag),
+			logger.NewField("count", len(memberIDs)),
+		)
+		return 0, mapSQLError(err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		contextLogger.Error("SQL 批次加標籤結果檢查失敗",
+			logger.NewField("error", err),
+			logger.NewField("tag", tag),
+		)
+		return 0, err
+	}
+
+	contextLogger.Debug("SQL 批次加標籤成功",
+		logger.NewField("tag", tag),
+		logger.NewField("count", len(memberIDs)),
+		logger.NewField("tagged", rowsAffected),
+	)
+	return int(rowsAffected), nil
+}
+
+func (s sqlxMemberSqlite) ListTags(ctx context.Context, memberID int) ([]string, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.ListTags")
+	defer span.End()
+
+	tags := make([]string, 0)
+	err := s.primary(repoCtx).SelectContext(repoCtx, "member_tags.select_by_member", &tags, querySelectMemberTags, memberID)
+	if err != nil {
+		contextLogger.Error("SQL 會員標籤查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", memberID),
+		)
+		return nil, mapSQLError(err)
+	}
+
+	contextLogger.Debug("SQL 會員標籤查詢成功",
+		logger.NewField("member_id", memberID),
+		logger.NewField("count", len(tags)),
+	)
+	return tags, nil
+}
+
+func (s sqlxMemberSqlite) MergeTags(ctx context.Context, sourceID, targetID int) (int, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.MergeTags")
+	defer span.End()
+
+	result, err := s.executor(repoCtx).ExecContext(repoCtx, "member_tags.merge", queryMergeMemberTags, targetID, sourceID)
+	if err != nil {
+		contextLogger.Error("SQL 合併標籤失敗",
+			logger.NewField("error", err),
+			logger.NewField("source_id", sourceID),
+			logger.NewField("target_id", targetID),
+		)
+		return 0, mapSQLError(err)
+	}
+	carried, err := result.RowsAffected()
+	if err != nil {
+		contextLogger.Error("SQL 合併標籤結果檢查失敗",
+			logger.NewField("error", err),
+			logger.NewField("source_id", sourceID),
+		)
+		return 0, err
+	}
+
+	contextLogger.Debug("SQL 合併標籤成功",
+		logger.NewField("source_id", sourceID),
+		logger.NewField("target_id", targetID),
+		logger.NewField("carried", carried),
+	)
+	return int(carried), nil
+}
+
+// buildTagConditions 依標籤篩選的子查詢，TagsAny 符合任一、TagsAll 須全部符合
+func buildTagConditions(tagsAny, tagsAll []string) ([]string, []any) {
+	var conditions []string
+	var args []any
+	if len(tagsAny) > 0 {
+		conditions = append(conditions, fmt.Sprintf(queryMembersWithAnyTag, placeholders(len(tagsAny))))
+		for _, tag := range tagsAny {
+			args = append(args, tag)
+		}
+	}
+	if len(tagsAll) > 0 {
+		conditions = append(conditions, fmt.Sprintf(queryMembersWithAllTag, placeholders(len(tagsAll))))
+		for _, tag := range tagsAll {
+			args = append(args, tag)
+		}
+		args = append(args, len(tagsAll))
+	}
+	return conditions, args
+}
+
+// placeholders 產生 n 個以逗號分隔的 ?
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
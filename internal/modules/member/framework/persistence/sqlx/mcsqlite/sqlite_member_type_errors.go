@@ -5,6 +5,8 @@ import "fmt"
 type DBError struct {
 	CustomError error
 	RawError    error
+	// Constraint 違反的欄位或約束名稱（例如 members.email），資料庫沒有提供時為空字串
+	Constraint string
 }
 
 func (e *DBError) Error() string {
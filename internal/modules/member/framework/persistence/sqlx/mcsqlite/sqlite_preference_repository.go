@@ -0,0 +1,178 @@
+package mcsqlite
+
+import (
+	"context"
+	"github.com/jmoiron/sqlx"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxtx"
+	sqlx2 "github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/sqlx"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/dao"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
+	"time"
+)
+
+// sqlxPreferenceSqlite 實作 dao.PreferenceDAO
+type sqlxPreferenceSqlite struct {
+	db     *sqlx.DB
+	logger logger.Logger
+	tracer tracer.Tracer
+}
+
+func NewSqlxPreferenceSqlite(db *sqlx.DB, log logger.Logger, tracer tracer.Tracer) dao.PreferenceDAO {
+	baseLogger := log.With(logger.NewField("layer", "repository"))
+	return &sqlxPreferenceSqlite{
+		db:     db,
+		logger: baseLogger,
+		tracer: tracer,
+	}
+}
+
+func (s sqlxPreferenceSqlite) GetByMember(ctx context.Context, memberID int) ([]*dao.PreferenceRecord, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.GetPreferencesByMember")
+	defer span.End()
+	startTime := time.Now()
+
+	models := make([]*sqlx2.PreferenceSQLXModel, 0)
+	err := s.executor(repoCtx).SelectContext(repoCtx, &models, querySelectPreferencesByMember, memberID)
+	duration := time.Since(startTime)
+	if err != nil {
+		contextLogger.Error("SQL 偏好設定查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", memberID),
+			logger.NewField("duration_ms", duration.Milliseconds()),
+		)
+		return nil, mapSQLError(err)
+	}
+	records := make([]*dao.PreferenceRecord, 0, len(models))
+	for _, model := range models {
+		record, err := preferenceModelToDTO(model)
+		if err != nil {
+			contextLogger.Error("SQL 偏好設定查詢 DTO 轉換失敗",
+				logger.NewField("error", err),
+				logger.NewField("member_id", memberID),
+				logger.NewField("key", model.Key),
+			)
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	contextLogger.Debug("SQL 偏好設定查詢成功",
+		logger.NewField("member_id", memberID),
+		logger.NewField("count", len(records)),
+		logger.NewField("duration_ms", duration.Milliseconds()),
+	)
+	return records, nil
+}
+
+func (s sqlxPreferenceSqlite) Upsert(ctx context.Context, r *dao.PreferenceRecord) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.UpsertPreference")
+	defer span.End()
+	startTime := time.Now()
+
+	result, err := s.executor(repoCtx).ExecContext(repoCtx, queryUpsertPreference,
+		r.MemberID, r.Key, r.Value, r.UpdatedAt.UTC().Format(sqliteTimeLayout),
+	)
+	duration := time.Since(startTime)
+	if err != nil {
+		contextLogger.Error("SQL 偏好設定寫入失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", r.MemberID),
+			logger.NewField("key", r.Key),
+			logger.NewField("duration_ms", duration.Milliseconds()),
+		)
+		return mapSQLError(err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		contextLogger.Error("SQL 偏好設定寫入結果檢查失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", r.MemberID),
+			logger.NewField("key", r.Key),
+		)
+		return err
+	}
+	if rowsAffected == 0 {
+		contextLogger.Debug("SQL 偏好設定值未變更",
+			logger.NewField("member_id", r.MemberID),
+			logger.NewField("key", r.Key),
+		)
+		return ErrDBNoEffect
+	}
+	contextLogger.Debug("SQL 偏好設定寫入成功",
+		logger.NewField("member_id", r.MemberID),
+		logger.NewField("key", r.Key),
+		logger.NewField("duration_ms", duration.Milliseconds()),
+	)
+	return nil
+}
+
+func (s sqlxPreferenceSqlite) Delete(ctx context.Context, memberID int, key string) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.DeletePreference")
+	defer span.End()
+
+	result, err := s.executor(repoCtx).ExecContext(repoCtx, queryDeletePreference, memberID, key)
+	if err != nil {
+		contextLogger.Error("SQL 偏好設定刪除失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", memberID),
+			logger.NewField("key", key),
+		)
+		return mapSQLError(err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		contextLogger.Error("SQL 偏好設定刪除結果檢查失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", memberID),
+			logger.NewField("key", key),
+		)
+		return err
+	}
+	if rowsAffected == 0 {
+		contextLogger.Debug("SQL 偏好設定不存在，未刪除",
+			logger.NewField("member_id", memberID),
+			logger.NewField("key", key),
+		)
+		return ErrDBNoEffect
+	}
+	contextLogger.Debug("SQL 偏好設定刪除成功",
+		logger.NewField("member_id", memberID),
+		logger.NewField("key", key),
+	)
+	return nil
+}
+
+func (s sqlxPreferenceSqlite) DeleteByMember(ctx context.Context, memberID int) (int, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.DeletePreferencesByMember")
+	defer span.End()
+
+	result, err := s.executor(repoCtx).ExecContext(repoCtx, queryDeletePreferencesByMember, memberID)
+	if err != nil {
+		contextLogger.Error("SQL 會員偏好設定刪除失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", memberID),
+		)
+		return 0, mapSQLError(err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		contextLogger.Error("SQL 會員偏好設定刪除結果檢查失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", memberID),
+		)
+		return 0, err
+	}
+	contextLogger.Debug("SQL 會員偏好設定刪除成功",
+		logger.NewField("member_id", memberID),
+		logger.NewField("deleted", rowsAffected),
+	)
+	return int(rowsAffected), nil
+}
+
+func (s sqlxPreferenceSqlite) executor(ctx context.Context) sqlxtx.Executor {
+	return sqlxtx.ExecutorFromContext(ctx, s.db)
+}
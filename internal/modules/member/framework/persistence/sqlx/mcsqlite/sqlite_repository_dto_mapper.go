@@ -1,6 +1,7 @@
 package mcsqlite
 
 import (
+	"database/sql"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/dao"
 	"time"
 
@@ -11,18 +12,109 @@ func sqlxModelToDTO(model *sqlx.MemberSQLXModel) (*dao.MemberRecord, error) {
 	if model == nil {
 		return nil, ErrMapperTimeParseFailed
 	}
-	if model.CreatedAt == "" {
+	// 時間格式由 sqlx.Timestamp 掃描時處理，這裡只檢查 NOT NULL 的 created_at
+	if !model.CreatedAt.Valid {
 		return nil, ErrMapperTimeParseFailed
 	}
-	daoCreateAt, err := time.Parse("2006-01-02 15:04:05", model.CreatedAt)
+	updatedAt := model.CreatedAt.Time
+	if model.UpdatedAt.Valid {
+		updatedAt = model.UpdatedAt.Time
+	}
+	return &dao.MemberRecord{
+		ID:              model.ID,
+		Name:            model.Name,
+		Email:           model.Email,
+		NormalizedEmail: model.NormalizedEmail.String,
+		Password:        model.Password,
+		Status:          model.Status,
+		StatusReason:    model.StatusReason,
+		MergedInto:      int(model.MergedInto.Int64),
+		ReferredBy:      int(model.ReferredBy.Int64),
+		CreatedAt:       model.CreatedAt.Time,
+		UpdatedAt:       updatedAt,
+	}, nil
+}
+
+func invitationModelToDTO(model *sqlx.InvitationSQLXModel) (*dao.InvitationRecord, error) {
+	if model == nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	createdAt, err := parseSQLiteTime(model.CreatedAt)
 	if err != nil {
 		return nil, ErrMapperTimeParseFailed
 	}
-	return &dao.MemberRecord{
+	expiresAt, err := parseNullableSQLiteTime(model.ExpiresAt)
+	if err != nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	revokedAt, err := parseNullableSQLiteTime(model.RevokedAt)
+	if err != nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	return &dao.InvitationRecord{
+		ID:         model.ID,
+		Code:       model.Code,
+		CreatedBy:  model.CreatedBy,
+		ReferrerID: int(model.ReferrerID.Int64),
+		Email:      model.Email,
+		MaxUses:    model.MaxUses,
+		Uses:       model.Uses,
+		ExpiresAt:  expiresAt,
+		RevokedAt:  revokedAt,
+		CreatedAt:  createdAt,
+	}, nil
+}
+
+// parseNullableSQLiteTime NULL 回傳 nil
+func parseNullableSQLiteTime(value sql.NullString) (*time.Time, error) {
+	if !value.Valid {
+		return nil, nil
+	}
+	t, err := parseSQLiteTime(value.String)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// nullableSQLiteTime nil 寫入 NULL，其餘以 sqliteTimeLayout 寫入
+func nullableSQLiteTime(t *time.Time) sql.NullString {
+	if t == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: t.UTC().Format(sqliteTimeLayout), Valid: true}
+}
+
+func segmentModelToDTO(model *sqlx.SegmentSQLXModel) (*dao.SegmentRecord, error) {
+	if model == nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	createdAt, err := parseSQLiteTime(model.CreatedAt)
+	if err != nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	return &dao.SegmentRecord{
 		ID:        model.ID,
 		Name:      model.Name,
-		Email:     model.Email,
-		Password:  model.Password,
-		CreatedAt: daoCreateAt,
+		Filter:    model.Filter,
+		CreatedBy: model.CreatedBy,
+		CreatedAt: createdAt,
+	}, nil
+}
+
+func preferenceModelToDTO(model *sqlx.PreferenceSQLXModel) (*dao.PreferenceRecord, error) {
+	if model == nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	updatedAt, err := parseSQLiteTime(model.UpdatedAt)
+	if err != nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	return &dao.PreferenceRecord{
+		MemberID:  model.MemberID,
+		Key:       model.Key,
+		Value:     model.Value,
+		Version:   model.Version,
+		UpdatedAt: updatedAt,
 	}, nil
 }
@@ -0,0 +1,10 @@
+package mcsqlite
+
+const (
+	queryInsertSegment          = `INSERT INTO member_segments (name, filter, created_by, created_at) VALUES (?, ?, ?, ?)`
+	querySelectSegmentByID      = `SELECT * FROM member_segments WHERE id = ?`
+	querySelectSegmentByName    = `SELECT * FROM member_segments WHERE name = ?`
+	querySelectSegmentsWithPage = `SELECT * FROM member_segments ORDER BY id DESC LIMIT ? OFFSET ?`
+	queryCountSegments          = `SELECT COUNT(*) FROM member_segments`
+	queryDeleteSegment          = `DELETE FROM member_segments WHERE id = ?`
+)
@@ -0,0 +1,189 @@
+package mcsqlite
+
+import (
+	"context"
+	"github.com/jmoiron/sqlx"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxtx"
+	sqlx2 "github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/sqlx"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/dao"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
+	"time"
+)
+
+// sqlxSegmentSqlite 實作 dao.SegmentDAO
+type sqlxSegmentSqlite struct {
+	db     *sqlx.DB
+	logger logger.Logger
+	tracer tracer.Tracer
+}
+
+func NewSqlxSegmentSqlite(db *sqlx.DB, log logger.Logger, tracer tracer.Tracer) dao.SegmentDAO {
+	baseLogger := log.With(logger.NewField("layer", "repository"))
+	return &sqlxSegmentSqlite{
+		db:     db,
+		logger: baseLogger,
+		tracer: tracer,
+	}
+}
+
+func (s sqlxSegmentSqlite) Create(ctx context.Context, r *dao.SegmentRecord) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.CreateSegment")
+	defer span.End()
+	startTime := time.Now()
+
+	_, err := s.executor(repoCtx).ExecContext(repoCtx, queryInsertSegment,
+		r.Name, r.Filter, r.CreatedBy, r.CreatedAt.UTC().Format(sqliteTimeLayout),
+	)
+	duration := time.Since(startTime)
+	if err != nil {
+		contextLogger.Error("SQL 分群插入失敗",
+			logger.NewField("error", err),
+			logger.NewField("segment_name", r.Name),
+			logger.NewField("duration_ms", duration.Milliseconds()),
+		)
+		return mapSQLError(err)
+	}
+	contextLogger.Debug("SQL 分群插入成功",
+		logger.NewField("segment_name", r.Name),
+		logger.NewField("duration_ms", duration.Milliseconds()),
+	)
+	return nil
+}
+
+func (s sqlxSegmentSqlite) GetByID(ctx context.Context, id int) (*dao.SegmentRecord, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.GetSegmentByID")
+	defer span.End()
+
+	record, err := s.getOne(repoCtx, querySelectSegmentByID, id)
+	if err != nil {
+		contextLogger.Error("SQL 分群查詢(ID)失敗",
+			logger.NewField("error", err),
+			logger.NewField("segment_id", id),
+		)
+		return nil, err
+	}
+	contextLogger.Debug("SQL 分群查詢(ID)成功",
+		logger.NewField("segment_id", id),
+	)
+	return record, nil
+}
+
+func (s sqlxSegmentSqlite) GetByName(ctx context.Context, name string) (*dao.SegmentRecord, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.GetSegmentByName")
+	defer span.End()
+
+	record, err := s.getOne(repoCtx, querySelectSegmentByName, name)
+	if err != nil {
+		contextLogger.Error("SQL 分群查詢(Name)失敗",
+			logger.NewField("error", err),
+			logger.NewField("segment_name", name),
+		)
+		return nil, err
+	}
+	contextLogger.Debug("SQL 分群查詢(Name)成功",
+		logger.NewField("segment_id", record.ID),
+	)
+	return record, nil
+}
+
+func (s sqlxSegmentSqlite) GetAll(ctx context.Context, p pagination.Pagination) ([]*dao.SegmentRecord, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.GetAllSegments")
+	defer span.End()
+	startTime := time.Now()
+
+	models := make([]*sqlx2.SegmentSQLXModel, 0)
+	err := s.executor(repoCtx).SelectContext(repoCtx, &models, querySelectSegmentsWithPage, p.Limit, p.Offset)
+	duration := time.Since(startTime)
+	if err != nil {
+		contextLogger.Error("SQL 分群列表查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("limit", p.Limit),
+			logger.NewField("offset", p.Offset),
+			logger.NewField("duration_ms", duration.Milliseconds()),
+		)
+		return nil, mapSQLError(err)
+	}
+	records := make([]*dao.SegmentRecord, 0, len(models))
+	for _, model := range models {
+		record, err := segmentModelToDTO(model)
+		if err != nil {
+			contextLogger.Error("SQL 分群列表查詢 DTO 轉換失敗",
+				logger.NewField("error", err),
+				logger.NewField("segment_id", model.ID),
+			)
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	contextLogger.Debug("SQL 分群列表查詢成功",
+		logger.NewField("count", len(records)),
+		logger.NewField("duration_ms", duration.Milliseconds()),
+	)
+	return records, nil
+}
+
+func (s sqlxSegmentSqlite) CountAll(ctx context.Context) (int, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.CountAllSegments")
+	defer span.End()
+
+	var count int
+	if err := s.executor(repoCtx).GetContext(repoCtx, &count, queryCountSegments); err != nil {
+		contextLogger.Error("SQL 分群總數查詢失敗",
+			logger.NewField("error", err),
+		)
+		return 0, mapSQLError(err)
+	}
+	return count, nil
+}
+
+func (s sqlxSegmentSqlite) Delete(ctx context.Context, id int) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.DeleteSegment")
+	defer span.End()
+
+	result, err := s.executor(repoCtx).ExecContext(repoCtx, queryDeleteSegment, id)
+	if err != nil {
+		contextLogger.Error("SQL 分群刪除失敗",
+			logger.NewField("error", err),
+			logger.NewField("segment_id", id),
+		)
+		return mapSQLError(err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		contextLogger.Error("SQL 分群刪除結果檢查失敗",
+			logger.NewField("error", err),
+			logger.NewField("segment_id", id),
+		)
+		return err
+	}
+	if rowsAffected == 0 {
+		contextLogger.Error("SQL 分群刪除未影響任何行",
+			logger.NewField("segment_id", id),
+		)
+		return ErrDBNoEffect
+	}
+	contextLogger.Debug("SQL 分群刪除成功",
+		logger.NewField("segment_id", id),
+	)
+	return nil
+}
+
+func (s sqlxSegmentSqlite) getOne(ctx context.Context, query string, arg any) (*dao.SegmentRecord, error) {
+	model := &sqlx2.SegmentSQLXModel{}
+	if err := s.executor(ctx).GetContext(ctx, model, query, arg); err != nil {
+		return nil, mapSQLError(err)
+	}
+	return segmentModelToDTO(model)
+}
+
+func (s sqlxSegmentSqlite) executor(ctx context.Context) sqlxtx.Executor {
+	return sqlxtx.ExecutorFromContext(ctx, s.db)
+}
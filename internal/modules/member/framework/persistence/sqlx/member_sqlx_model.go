@@ -1,9 +1,21 @@
 package sqlx
 
+import "database/sql"
+
 type MemberSQLXModel struct {
-	ID        int    `db:"id"`
-	Name      string `db:"name"`
-	Email     string `db:"email"`
-	Password  string `db:"password"`
-	CreatedAt string `db:"created_at"`
+	ID    int    `db:"id"`
+	Name  string `db:"name"`
+	Email string `db:"email"`
+	// NormalizedEmail 回填前的舊資料或回填衝突的會員為 NULL
+	NormalizedEmail sql.NullString `db:"normalized_email"`
+	Password        string         `db:"password"`
+	Status          string         `db:"status"`
+	StatusReason    string         `db:"status_reason"`
+	// MergedInto 未被合併的會員為 NULL
+	MergedInto sql.NullInt64 `db:"merged_into"`
+	// ReferredBy 沒有推薦人的會員為 NULL
+	ReferredBy sql.NullInt64 `db:"referred_by"`
+	CreatedAt  Timestamp     `db:"created_at"`
+	// UpdatedAt 加入欄位前或由其他工具寫入的資料可能為 NULL，此時視同 CreatedAt
+	UpdatedAt Timestamp `db:"updated_at"`
 }
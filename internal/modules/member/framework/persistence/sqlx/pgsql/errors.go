@@ -0,0 +1,30 @@
+package pgsql
+
+import (
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/sqlx/mcsqlite"
+)
+
+// 與 mcsqlite 共用同一組錯誤實例，gateway 的錯誤轉換不需要區分資料庫
+var (
+	ErrDBRecordNotFound       = mcsqlite.ErrDBRecordNotFound
+	ErrDBDuplicateKey         = mcsqlite.ErrDBDuplicateKey
+	ErrDBNoEffect             = mcsqlite.ErrDBNoEffect
+	ErrDBContextTimeout       = mcsqlite.ErrDBContextTimeout
+	ErrDBContextCanceled      = mcsqlite.ErrDBContextCanceled
+	ErrDBConnectionClosed     = mcsqlite.ErrDBConnectionClosed
+	ErrDBTransactionDone      = mcsqlite.ErrDBTransactionDone
+	ErrDBSerializationFailure = mcsqlite.ErrDBSerializationFailure
+	ErrDBPrimaryKeyConflict   = mcsqlite.ErrDBPrimaryKeyConflict
+	ErrDBForeignKeyViolation  = mcsqlite.ErrDBForeignKeyViolation
+	ErrDBNotNullViolation     = mcsqlite.ErrDBNotNullViolation
+	ErrDBCheckViolation       = mcsqlite.ErrDBCheckViolation
+	ErrDBBusy                 = mcsqlite.ErrDBBusy
+	ErrDBReadOnly             = mcsqlite.ErrDBReadOnly
+	ErrDBFull                 = mcsqlite.ErrDBFull
+	ErrDBCorrupt              = mcsqlite.ErrDBCorrupt
+	ErrDBUnexpectedError      = mcsqlite.ErrDBUnexpectedError
+	ErrMapperTimeParseFailed  = mcsqlite.ErrMapperTimeParseFailed
+)
+
+// DBError 與 mcsqlite.DBError 為同一型別，gateway 以 errors.As 取出原始錯誤
+type DBError = mcsqlite.DBError
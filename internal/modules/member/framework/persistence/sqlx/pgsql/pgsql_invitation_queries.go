@@ -0,0 +1,16 @@
+package pgsql
+
+const (
+	queryInsertInvitation = `INSERT INTO member_invitations (code, created_by, referrer_id, email, max_uses, uses, expires_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id`
+	querySelectInvitationByID   = `SELECT * FROM member_invitations WHERE id = $1`
+	querySelectInvitationByCode = `SELECT * FROM member_invitations WHERE code = $1`
+	querySelectInvitationBase   = `SELECT * FROM member_invitations`
+	queryCountInvitationBase    = `SELECT COUNT(*) FROM member_invitations`
+	// 邀請碼以最新的優先顯示，%s 為 LIMIT、OFFSET 的 placeholder
+	queryInvitationOrderAndPage = ` ORDER BY id DESC LIMIT %s OFFSET %s`
+	// queryRedeemInvitation 以讀到的使用次數做樂觀鎖，並再次確認未撤銷、未用完
+	queryRedeemInvitation = `UPDATE member_invitations SET uses = uses + 1
+WHERE id = $1 AND uses = $2 AND uses < max_uses AND revoked_at IS NULL`
+	queryRevokeInvitation = `UPDATE member_invitations SET revoked_at = $1 WHERE id = $2 AND revoked_at IS NULL`
+)
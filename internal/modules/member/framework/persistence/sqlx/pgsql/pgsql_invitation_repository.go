@@ -0,0 +1,228 @@
+package pgsql
+
+import (
+	"context"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxtx"
+	sqlx2 "github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/sqlx"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/dao"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
+	"time"
+)
+
+// sqlxInvitationPgsql 實作 dao.InvitationDAO
+type sqlxInvitationPgsql struct {
+	db     *sqlx.DB
+	logger logger.Logger
+	tracer tracer.Tracer
+}
+
+func NewSqlxInvitationPgsql(db *sqlx.DB, log logger.Logger, tracer tracer.Tracer) dao.InvitationDAO {
+	baseLogger := log.With(logger.NewField("layer", "repository"))
+	return &sqlxInvitationPgsql{
+		db:     db,
+		logger: baseLogger,
+		tracer: tracer,
+	}
+}
+
+func (s sqlxInvitationPgsql) Create(ctx context.Context, r *dao.InvitationRecord) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.CreateInvitation")
+	defer span.End()
+	startTime := time.Now()
+
+	var id int
+	err := s.executor(repoCtx).QueryRowxContext(repoCtx, queryInsertInvitation,
+		r.Code, r.CreatedBy, nullableID(r.ReferrerID), r.Email, r.MaxUses, r.Uses,
+		nullableTime(r.ExpiresAt), r.CreatedAt.UTC(),
+	).Scan(&id)
+	duration := time.Since(startTime)
+	if err != nil {
+		contextLogger.Error("SQL 邀請碼插入失敗",
+			logger.NewField("error", err),
+			logger.NewField("created_by", r.CreatedBy),
+			logger.NewField("duration_ms", duration.Milliseconds()),
+		)
+		return mapSQLError(err)
+	}
+	r.ID = id
+	contextLogger.Debug("SQL 邀請碼插入成功",
+		logger.NewField("invitation_id", id),
+		logger.NewField("created_by", r.CreatedBy),
+		logger.NewField("duration_ms", duration.Milliseconds()),
+	)
+	return nil
+}
+
+func (s sqlxInvitationPgsql) GetByID(ctx context.Context, id int) (*dao.InvitationRecord, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.GetInvitationByID")
+	defer span.End()
+
+	record, err := s.getOne(repoCtx, querySelectInvitationByID, id)
+	if err != nil {
+		contextLogger.Error("SQL 邀請碼查詢(ID)失敗",
+			logger.NewField("error", err),
+			logger.NewField("invitation_id", id),
+		)
+		return nil, err
+	}
+	contextLogger.Debug("SQL 邀請碼查詢(ID)成功",
+		logger.NewField("invitation_id", id),
+	)
+	return record, nil
+}
+
+func (s sqlxInvitationPgsql) GetByCode(ctx context.Context, code string) (*dao.InvitationRecord, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.GetInvitationByCode")
+	defer span.End()
+
+	// 邀請碼等同密碼，log 不記錄內容
+	record, err := s.getOne(repoCtx, querySelectInvitationByCode, code)
+	if err != nil {
+		contextLogger.Debug("SQL 邀請碼查詢(Code)失敗",
+			logger.NewField("error", err),
+		)
+		return nil, err
+	}
+	contextLogger.Debug("SQL 邀請碼查詢(Code)成功",
+		logger.NewField("invitation_id", record.ID),
+	)
+	return record, nil
+}
+
+func (s sqlxInvitationPgsql) GetAll(ctx context.Context, q dao.InvitationQuery, p pagination.Pagination) ([]*dao.InvitationRecord, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.GetAllInvitations")
+	defer span.End()
+	startTime := time.Now()
+
+	args := &queryArgs{}
+	where := buildInvitationWhere(q, args)
+	page := fmt.Sprintf(queryInvitationOrderAndPage, args.add(p.Limit), args.add(p.Offset))
+	models := make([]*sqlx2.InvitationSQLXModel, 0)
+	err := s.executor(repoCtx).SelectContext(repoCtx, &models, querySelectInvitationBase+where+page, args.values...)
+	duration := time.Since(startTime)
+	if err != nil {
+		contextLogger.Error("SQL 邀請碼列表查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("limit", p.Limit),
+			logger.NewField("offset", p.Offset),
+			logger.NewField("duration_ms", duration.Milliseconds()),
+		)
+		return nil, mapSQLError(err)
+	}
+	records := make([]*dao.InvitationRecord, 0, len(models))
+	for _, model := range models {
+		record, err := invitationModelToDTO(model)
+		if err != nil {
+			contextLogger.Error("SQL 邀請碼列表查詢 DTO 轉換失敗",
+				logger.NewField("error", err),
+				logger.NewField("invitation_id", model.ID),
+			)
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	contextLogger.Debug("SQL 邀請碼列表查詢成功",
+		logger.NewField("count", len(records)),
+		logger.NewField("duration_ms", duration.Milliseconds()),
+	)
+	return records, nil
+}
+
+func (s sqlxInvitationPgsql) CountAll(ctx context.Context, q dao.InvitationQuery) (int, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.CountAllInvitations")
+	defer span.End()
+
+	args := &queryArgs{}
+	where := buildInvitationWhere(q, args)
+	var count int
+	if err := s.executor(repoCtx).GetContext(repoCtx, &count, queryCountInvitationBase+where, args.values...); err != nil {
+		contextLogger.Error("SQL 邀請碼總數查詢失敗",
+			logger.NewField("error", err),
+		)
+		return 0, mapSQLError(err)
+	}
+	return count, nil
+}
+
+func (s sqlxInvitationPgsql) Redeem(ctx context.Context, id, uses int) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.RedeemInvitation")
+	defer span.End()
+
+	if err := s.execOne(repoCtx, queryRedeemInvitation, id, uses); err != nil {
+		contextLogger.Error("SQL 邀請碼使用失敗",
+			logger.NewField("error", err),
+			logger.NewField("invitation_id", id),
+			logger.NewField("uses", uses),
+		)
+		return err
+	}
+	contextLogger.Debug("SQL 邀請碼使用成功",
+		logger.NewField("invitation_id", id),
+		logger.NewField("uses", uses+1),
+	)
+	return nil
+}
+
+func (s sqlxInvitationPgsql) Revoke(ctx context.Context, id int, revokedAt time.Time) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.RevokeInvitation")
+	defer span.End()
+
+	if err := s.execOne(repoCtx, queryRevokeInvitation, revokedAt.UTC(), id); err != nil {
+		contextLogger.Error("SQL 邀請碼撤銷失敗",
+			logger.NewField("error", err),
+			logger.NewField("invitation_id", id),
+		)
+		return err
+	}
+	contextLogger.Debug("SQL 邀請碼撤銷成功",
+		logger.NewField("invitation_id", id),
+	)
+	return nil
+}
+
+func (s sqlxInvitationPgsql) getOne(ctx context.Context, query string, arg any) (*dao.InvitationRecord, error) {
+	model := &sqlx2.InvitationSQLXModel{}
+	if err := s.executor(ctx).GetContext(ctx, model, query, arg); err != nil {
+		return nil, mapSQLError(err)
+	}
+	return invitationModelToDTO(model)
+}
+
+// execOne 執行條件式更新，沒有更新任何資料時回傳 ErrDBNoEffect
+func (s sqlxInvitationPgsql) execOne(ctx context.Context, query string, args ...any) error {
+	result, err := s.executor(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return mapSQLError(err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrDBNoEffect
+	}
+	return nil
+}
+
+func buildInvitationWhere(q dao.InvitationQuery, args *queryArgs) string {
+	if q.CreatedBy == "" {
+		return ""
+	}
+	return " WHERE created_by = " + args.add(q.CreatedBy)
+}
+
+// executor 有交易時使用 context 中的交易，讓邀請碼使用與會員註冊在同一個交易
+func (s sqlxInvitationPgsql) executor(ctx context.Context) sqlxtx.Executor {
+	return sqlxtx.ExecutorFromContext(ctx, s.db)
+}
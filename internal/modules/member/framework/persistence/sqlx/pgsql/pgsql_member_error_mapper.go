@@ -0,0 +1,128 @@
+package pgsql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxinstrument"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
+	"strings"
+)
+
+// mapSQLError 將常見的 SQL 錯誤轉換為結構化錯誤；Postgres 的錯誤以 SQLSTATE 判斷，不比對訊息字串
+func mapSQLError(err error) error {
+	if err == nil {
+		return nil
+	}
+	// 檢查是否為自定義錯誤
+	if errors.Is(err, sql.ErrNoRows) {
+		return wrap(err, ErrDBRecordNotFound)
+	}
+	if errors.Is(err, sql.ErrConnDone) {
+		return wrap(err, ErrDBConnectionClosed)
+	}
+	if errors.Is(err, sql.ErrTxDone) {
+		return wrap(err, ErrDBTransactionDone)
+	}
+	if errors.Is(err, ErrMapperTimeParseFailed) {
+		return wrap(err, ErrMapperTimeParseFailed)
+	}
+	// 任何帶 context 的 DB 操作（ExecContext, QueryContext, QueryRowContext, GetContext, SelectContext 等）都可能收到以下錯誤
+	if errors.Is(err, context.DeadlineExceeded) {
+		return wrap(err, ErrDBContextTimeout)
+	}
+	if errors.Is(err, context.Canceled) {
+		return wrap(err, ErrDBContextCanceled)
+	}
+	// pgsql 特有
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		if customErr := pgErrorToCustom(pgErr); customErr != nil {
+			dbErr := wrap(err, customErr)
+			dbErr.Constraint = pgErr.ConstraintName
+			if customErr == ErrDBNotNullViolation {
+				dbErr.Constraint = pgErr.ColumnName
+			}
+			return dbErr
+		}
+	}
+	return wrap(err, ErrDBUnexpectedError)
+}
+
+// MapSQLError 供同樣連到 Postgres 的其他實作（例如 ent）沿用相同的錯誤分類
+func MapSQLError(err error) error {
+	return mapSQLError(err)
+}
+
+// pgErrorToCustom 依 SQLSTATE 分類，與 mcsqlite 對應到同一組錯誤；無法分類時回傳 nil
+func pgErrorToCustom(err *pgconn.PgError) error {
+	switch err.Code {
+	case pgerrcode.UniqueViolation:
+		if strings.HasSuffix(err.ConstraintName, "_pkey") {
+			return ErrDBPrimaryKeyConflict
+		}
+		return ErrDBDuplicateKey
+	case pgerrcode.ForeignKeyViolation:
+		return ErrDBForeignKeyViolation
+	case pgerrcode.NotNullViolation:
+		return ErrDBNotNullViolation
+	case pgerrcode.CheckViolation:
+		return ErrDBCheckViolation
+	case pgerrcode.SerializationFailure:
+		return ErrDBSerializationFailure
+	case pgerrcode.LockNotAvailable:
+		return ErrDBBusy
+	case pgerrcode.ReadOnlySQLTransaction:
+		return ErrDBReadOnly
+	case pgerrcode.DiskFull:
+		return ErrDBFull
+	case pgerrcode.DataCorrupted, pgerrcode.IndexCorrupted:
+		return ErrDBCorrupt
+	}
+	return nil
+}
+func wrap(rawErr, customErr error) *DBError {
+	return &DBError{
+		CustomError: customErr,
+		RawError:    rawErr,
+	}
+}
+
+// NewInstrumenter 以 pgsql 的錯誤分類建立 SQL 觀測，未設定 Classify 時依 mapSQLError 的結果分類
+func NewInstrumenter(log logger.Logger, tr tracer.Tracer, options sqlxinstrument.Options) *sqlxinstrument.Instrumenter {
+	if options.Classify == nil {
+		options.Classify = errorClass
+	}
+	return sqlxinstrument.New(log, tr, options)
+}
+
+// errorClasses mapSQLError 的錯誤對應到觀測用的錯誤分類，與 mcsqlite 使用相同的分類名稱
+var errorClasses = map[error]string{
+	ErrDBRecordNotFound:       sqlxinstrument.ErrorClassNotFound,
+	ErrDBContextTimeout:       sqlxinstrument.ErrorClassTimeout,
+	ErrDBContextCanceled:      sqlxinstrument.ErrorClassCanceled,
+	ErrDBTransactionDone:      sqlxinstrument.ErrorClassTxDone,
+	ErrDBConnectionClosed:     "conn_done",
+	ErrDBDuplicateKey:         "duplicate_key",
+	ErrDBPrimaryKeyConflict:   "primary_key",
+	ErrDBForeignKeyViolation:  "foreign_key",
+	ErrDBNotNullViolation:     "not_null",
+	ErrDBCheckViolation:       "check",
+	ErrDBSerializationFailure: "serialization",
+	ErrDBBusy:                 "busy",
+	ErrDBReadOnly:             "read_only",
+	ErrDBFull:                 "full",
+	ErrDBCorrupt:              "corrupt",
+}
+
+// errorClass 無法分類時回傳空字串，交由 sqlxinstrument.DefaultClassify 處理
+func errorClass(err error) string {
+	var dbErr *DBError
+	if !errors.As(mapSQLError(err), &dbErr) {
+		return ""
+	}
+	return errorClasses[dbErr.CustomError]
+}
@@ -0,0 +1,72 @@
+package pgsql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxinstrument"
+)
+
+func TestMapSQLError(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		want           error
+		wantConstraint string
+	}{
+		{name: "no rows", err: sql.ErrNoRows, want: ErrDBRecordNotFound},
+		{name: "unique violation", err: &pgconn.PgError{Code: "23505", ConstraintName: "members_email_key"}, want: ErrDBDuplicateKey, wantConstraint: "members_email_key"},
+		{name: "wrapped unique violation", err: fmt.Errorf("exec: %w", &pgconn.PgError{Code: "23505"}), want: ErrDBDuplicateKey},
+		{name: "primary key violation", err: &pgconn.PgError{Code: "23505", ConstraintName: "members_pkey"}, want: ErrDBPrimaryKeyConflict, wantConstraint: "members_pkey"},
+		{name: "serialization failure", err: &pgconn.PgError{Code: "40001"}, want: ErrDBSerializationFailure},
+		{name: "foreign key violation", err: &pgconn.PgError{Code: "23503", ConstraintName: "member_tags_member_id_fkey"}, want: ErrDBForeignKeyViolation, wantConstraint: "member_tags_member_id_fkey"},
+		{name: "not null violation", err: &pgconn.PgError{Code: "23502", ColumnName: "name"}, want: ErrDBNotNullViolation, wantConstraint: "name"},
+		{name: "check violation", err: &pgconn.PgError{Code: "23514", ConstraintName: "members_status_check"}, want: ErrDBCheckViolation, wantConstraint: "members_status_check"},
+		{name: "lock not available", err: &pgconn.PgError{Code: "55P03"}, want: ErrDBBusy},
+		{name: "read only transaction", err: &pgconn.PgError{Code: "25006"}, want: ErrDBReadOnly},
+		{name: "disk full", err: &pgconn.PgError{Code: "53100"}, want: ErrDBFull},
+		{name: "data corrupted", err: &pgconn.PgError{Code: "XX001"}, want: ErrDBCorrupt},
+		{name: "unknown sqlstate", err: &pgconn.PgError{Code: "42601"}, want: ErrDBUnexpectedError},
+		{name: "message is not matched", err: errors.New("UNIQUE constraint failed: members.email"), want: ErrDBUnexpectedError},
+		{name: "context timeout", err: context.DeadlineExceeded, want: ErrDBContextTimeout},
+		{name: "tx done", err: sql.ErrTxDone, want: ErrDBTransactionDone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mapSQLError(tt.err)
+			assert.ErrorIs(t, got, tt.want)
+			var dbErr *DBError
+			if assert.ErrorAs(t, got, &dbErr) {
+				assert.Equal(t, tt.err, dbErr.RawError)
+				assert.Equal(t, tt.wantConstraint, dbErr.Constraint)
+			}
+		})
+	}
+	assert.NoError(t, mapSQLError(nil))
+}
+
+func TestErrorClass(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{name: "no rows", err: sql.ErrNoRows, want: sqlxinstrument.ErrorClassNotFound},
+		{name: "unique violation", err: &pgconn.PgError{Code: "23505"}, want: "duplicate_key"},
+		{name: "serialization failure", err: fmt.Errorf("exec: %w", &pgconn.PgError{Code: "40001"}), want: "serialization"},
+		{name: "lock not available", err: &pgconn.PgError{Code: "55P03"}, want: "busy"},
+		{name: "context timeout", err: context.DeadlineExceeded, want: sqlxinstrument.ErrorClassTimeout},
+		// 無法分類時交給 sqlxinstrument.DefaultClassify
+		{name: "unexpected", err: errors.New("boom"), want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, errorClass(tt.err))
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package pgsql
+
+const (
+	// queryInsertMember Postgres 沒有 LastInsertId，以 RETURNING 取回自增 ID
+	queryInsertMember          = `INSERT INTO members (name, email, normalized_email, password, status, referred_by) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`
+	querySelectByID            = `SELECT * FROM members WHERE id = $1`
+	querySelectByEmail         = `SELECT * FROM members WHERE normalized_email = $1`
+	querySelectAllBase         = `SELECT * FROM members%s ORDER BY %s %s LIMIT %s OFFSET %s`
+	queryUpdateMemberProfile   = `UPDATE members SET name = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2`
+	queryUpdateMemberEmail     = `UPDATE members SET email = $1, normalized_email = $2, updated_at = CURRENT_TIMESTAMP WHERE id = $3`
+	queryUpdateNormalizedEmail = `UPDATE members SET normalized_email = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2`
+	queryUpdateMemberPassword  = `UPDATE members SET password = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2`
+	// queryUpdateMemberStatus 只在狀態仍為轉換前的值時更新，避免並行的狀態變更互相覆蓋
+	queryUpdateMemberStatus = `UPDATE members SET status = $1, status_reason = $2, updated_at = CURRENT_TIMESTAMP WHERE id = $3 AND status = $4`
+	// queryMarkMemberMerged 只標記尚未被合併的會員，避免並行合併互相覆蓋
+	queryMarkMemberMerged = `UPDATE members SET merged_into = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2 AND merged_into IS NULL`
+	// queryRedirectMergedMembers 先前合併到來源會員的 tombstone 改指向新的目標，維持單層指標
+	queryRedirectMergedMembers = `UPDATE members SET merged_into = $1, updated_at = CURRENT_TIMESTAMP WHERE merged_into = $2`
+	// queryClearMergedInto、queryClearReferredBy、queryClearInvitationReferrer 刪除會員前清除指向它的外鍵，
+	// 效果等同 ON DELETE SET NULL；migration 的外鍵沒有 ON DELETE 子句，開啟 foreign_keys 後直接刪除會失敗
+	queryClearMergedInto         = `UPDATE members SET merged_into = NULL, updated_at = CURRENT_TIMESTAMP WHERE merged_into = $1`
+	queryClearReferredBy         = `UPDATE members SET referred_by = NULL, updated_at = CURRENT_TIMESTAMP WHERE referred_by = $1`
+	queryClearInvitationReferrer = `UPDATE member_invitations SET referrer_id = NULL WHERE referrer_id = $1`
+	queryDeleteMember            = `DELETE FROM members WHERE id = $1`
+	queryCountMembers            = `SELECT COUNT(*) FROM members`
+)
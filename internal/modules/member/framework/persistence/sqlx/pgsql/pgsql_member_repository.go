@@ -0,0 +1,527 @@
+package pgsql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxinstrument"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxreplica"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxtx"
+	sqlx2 "github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/sqlx"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/dao"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
+	"strings"
+)
+
+// sqlxMemberRepo 實作 dao.MemberDAO
+type sqlxMemberPgsql struct {
+	router     *sqlxreplica.Router
+	instrument *sqlxinstrument.Instrumenter
+	logger     logger.Logger
+	tracer     tracer.Tracer
+}
+
+func NewSqlxMemberPgsql(db *sqlx.DB, log logger.Logger, tracer tracer.Tracer) dao.MemberDAO {
+	return NewSqlxMemberPgsqlWithRouter(sqlxreplica.NewRouter(db, nil, sqlxreplica.Options{}, log), nil, log, tracer)
+}
+
+// NewSqlxMemberPgsqlWithRouter GetByID、GetByEmail、GetAll、CountAll 經由 router 分流到 replica，其餘操作走 primary；
+// 每次 SQL 呼叫經由 instrument 記錄耗時、筆數與錯誤分類，nil 時使用預設慢查詢門檻
+func NewSqlxMemberPgsqlWithRouter(router *sqlxreplica.Router, instrument *sqlxinstrument.Instrumenter, log logger.Logger, tracer tracer.Tracer) dao.MemberDAO {
+	if instrument == nil {
+		instrument = NewInstrumenter(log, tracer, sqlxinstrument.Options{})
+	}
+	baseLogger := log.With(logger.NewField("layer", "repository"))
+	return &sqlxMemberPgsql{
+		router:     router,
+		instrument: instrument,
+		logger:     baseLogger,
+		tracer:     tracer,
+	}
+}
+func (s sqlxMemberPgsql) Create(ctx context.Context, m *dao.MemberRecord) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.Create")
+	defer span.End()
+
+	var id int
+	err := s.executor(repoCtx).GetContext(repoCtx, "members.insert", &id, queryInsertMember, m.Name, m.Email, nullableNormalizedEmail(m.NormalizedEmail), m.Password, m.Status, nullableID(m.ReferredBy))
+	if err != nil {
+		contextLogger.Error("SQL 插入失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_email", m.Email),
+		)
+		return mapSQLError(err)
+	}
+
+	m.ID = id
+	contextLogger.Debug("SQL 插入成功",
+		logger.NewField("member_id", id),
+		logger.NewField("member_email", m.Email),
+	)
+	return nil
+}
+func (s sqlxMemberPgsql) GetByID(ctx context.Context, id int) (*dao.MemberRecord, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.GetByID")
+	defer span.End()
+
+	member := &sqlx2.MemberSQLXModel{}
+	err := s.reader(repoCtx).GetContext(repoCtx, "members.select_by_id", member, querySelectByID, id)
+	if err != nil {
+		contextLogger.Error("SQL 查詢(ID)失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+		)
+		return nil, mapSQLError(err)
+	}
+	record, err := sqlxModelToDTO(member)
+	if err != nil {
+		contextLogger.Error("SQL 查詢(ID) DTO 轉換失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+		)
+		return nil, err
+	}
+	contextLogger.Debug("SQL 查詢(ID)成功",
+		logger.NewField("member_id", member.ID),
+		logger.NewField("member_email", member.Email),
+	)
+	return record, nil
+}
+func (s sqlxMemberPgsql) GetByEmail(ctx context.Context, normalizedEmail string) (*dao.MemberRecord, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.GetByEmail")
+	defer span.End()
+
+	member := &sqlx2.MemberSQLXModel{}
+	err := s.reader(repoCtx).GetContext(repoCtx, "members.select_by_email", member, querySelectByEmail, normalizedEmail)
+	if err != nil {
+		contextLogger.Error("SQL 查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("normalized_email", normalizedEmail),
+		)
+		return nil, mapSQLError(err)
+	}
+	record, err := sqlxModelToDTO(member)
+	if err != nil {
+		contextLogger.Error("SQL 查詢 DTO 轉換失敗",
+			logger.NewField("error", err),
+			logger.NewField("normalized_email", normalizedEmail),
+		)
+		return nil, err
+	}
+	contextLogger.Debug("SQL 查詢成功",
+		logger.NewField("member_id", member.ID),
+		logger.NewField("normalized_email", normalizedEmail),
+	)
+	return record, nil
+}
+func (s sqlxMemberPgsql) GetAll(ctx context.Context, q dao.MemberQuery, pagination pagination.Pagination) ([]*dao.MemberRecord, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.GetAll")
+	defer span.End()
+	args := &queryArgs{}
+	where := buildMemberWhere(q, args)
+	query := fmt.Sprintf(querySelectAllBase, where, pagination.SortBy, pagination.OrderBy, args.add(pagination.Limit), args.add(pagination.Offset))
+
+	members := make([]*sqlx2.MemberSQLXModel, 0)
+	err := s.reader(repoCtx).SelectContext(repoCtx, "members.select_all", &members, query, args.values...)
+	if err != nil {
+		contextLogger.Error("SQL 列表查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("limit", pagination.Limit),
+			logger.NewField("offset", pagination.Offset),
+		)
+		return nil, mapSQLError(err)
+	}
+	records := make([]*dao.MemberRecord, 0, len(members))
+	for _, member := range members {
+		record, err := sqlxModelToDTO(member)
+		if err != nil {
+			contextLogger.Error("SQL 列表查詢 DTO 轉換失敗",
+				logger.NewField("error", err),
+				logger.NewField("member_id", member.ID),
+			)
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	contextLogger.Debug("SQL 列表查詢成功",
+		logger.NewField("count", len(members)),
+	)
+	return records, nil
+}
+func (s sqlxMemberPgsql) CountAll(ctx context.Context, q dao.MemberQuery) (int, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.CountAll")
+	defer span.End()
+
+	args := &queryArgs{}
+	where := buildMemberWhere(q, args)
+	var count int
+	err := s.reader(repoCtx).GetContext(repoCtx, "members.count", &count, queryCountMembers+where, args.values...)
+	if err != nil {
+		contextLogger.Error("SQL 總數查詢失敗",
+			logger.NewField("error", err),
+		)
+		return 0, mapSQLError(err)
+	}
+
+	contextLogger.Debug("SQL 總數查詢成功",
+		logger.NewField("count", count),
+	)
+	return count, nil
+}
+func (s sqlxMemberPgsql) UpdateProfile(ctx context.Context, m *dao.MemberRecord) (*dao.MemberRecord, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.UpdateProfile")
+	defer span.End()
+
+	result, err := s.executor(repoCtx).ExecContext(repoCtx, "members.update_profile", queryUpdateMemberProfile, m.Name, m.ID)
+	if err != nil {
+		contextLogger.Error("SQL 資料更新失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", m.ID),
+		)
+		return nil, mapSQLError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		contextLogger.Error("SQL 資料更新結果檢查失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", m.ID),
+		)
+		return nil, err
+	}
+
+	if rowsAffected == 0 {
+		contextLogger.Error("SQL 資料更新未影響任何行",
+			logger.NewField("member_id", m.ID),
+		)
+		return nil, ErrDBNoEffect
+	}
+
+	contextLogger.Debug("SQL 資料更新成功",
+		logger.NewField("member_id", m.ID),
+		logger.NewField("member_email", m.Email),
+		logger.NewField("rows_affected", rowsAffected),
+	)
+	return m, nil
+}
+func (s sqlxMemberPgsql) UpdateEmail(ctx context.Context, id int, email, normalizedEmail string) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.UpdateEmail")
+	defer span.End()
+
+	result, err := s.executor(repoCtx).ExecContext(repoCtx, "members.update_email", queryUpdateMemberEmail, email, nullableNormalizedEmail(normalizedEmail), id)
+	if err != nil {
+		contextLogger.Error("SQL Email 更新失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+			logger.NewField("new_email", email),
+		)
+		return mapSQLError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		contextLogger.Error("SQL Email 更新結果檢查失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+		)
+		return err
+	}
+
+	if rowsAffected == 0 {
+		contextLogger.Error("SQL Email 更新未影響任何行",
+			logger.NewField("member_id", id),
+		)
+		return ErrDBNoEffect
+	}
+
+	contextLogger.Debug("SQL Email 更新成功",
+		logger.NewField("member_id", id),
+		logger.NewField("new_email", email),
+		logger.NewField("rows_affected", rowsAffected),
+	)
+	return nil
+}
+func (s sqlxMemberPgsql) UpdateNormalizedEmail(ctx context.Context, id int, normalizedEmail string) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.UpdateNormalizedEmail")
+	defer span.End()
+
+	result, err := s.executor(repoCtx).ExecContext(repoCtx, "members.update_normalized_email", queryUpdateNormalizedEmail, nullableNormalizedEmail(normalizedEmail), id)
+	if err != nil {
+		contextLogger.Error("SQL 正規化 Email 更新失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+			logger.NewField("normalized_email", normalizedEmail),
+		)
+		return mapSQLError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		contextLogger.Error("SQL 正規化 Email 更新結果檢查失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+		)
+		return err
+	}
+
+	if rowsAffected == 0 {
+		contextLogger.Error("SQL 正規化 Email 更新未影響任何行",
+			logger.NewField("member_id", id),
+		)
+		return ErrDBNoEffect
+	}
+
+	contextLogger.Debug("SQL 正規化 Email 更新成功",
+		logger.NewField("member_id", id),
+		logger.NewField("normalized_email", normalizedEmail),
+		logger.NewField("rows_affected", rowsAffected),
+	)
+	return nil
+}
+func (s sqlxMemberPgsql) UpdatePassword(ctx context.Context, id int, password string) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.UpdatePassword")
+	defer span.End()
+
+	result, err := s.executor(repoCtx).ExecContext(repoCtx, "members.update_password", queryUpdateMemberPassword, password, id)
+	if err != nil {
+		contextLogger.Error("SQL 密碼更新失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+		)
+		return mapSQLError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		contextLogger.Error("SQL 密碼更新結果檢查失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+		)
+		return err
+	}
+
+	if rowsAffected == 0 {
+		contextLogger.Error("SQL 密碼更新未影響任何行",
+			logger.NewField("member_id", id),
+		)
+		return ErrDBNoEffect
+	}
+
+	contextLogger.Debug("SQL 密碼更新成功",
+		logger.NewField("member_id", id),
+		logger.NewField("rows_affected", rowsAffected),
+	)
+	return nil
+}
+func (s sqlxMemberPgsql) UpdateStatus(ctx context.Context, id int, from, to, reason string) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.UpdateStatus")
+	defer span.End()
+
+	result, err := s.executor(repoCtx).ExecContext(repoCtx, "members.update_status", queryUpdateMemberStatus, to, reason, id, from)
+	if err != nil {
+		contextLogger.Error("SQL 狀態更新失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+		)
+		return mapSQLError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		contextLogger.Error("SQL 狀態更新結果檢查失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+		)
+		return err
+	}
+
+	if rowsAffected == 0 {
+		contextLogger.Error("SQL 狀態更新未影響任何行",
+			logger.NewField("member_id", id),
+			logger.NewField("from_status", from),
+		)
+		return ErrDBNoEffect
+	}
+
+	contextLogger.Debug("SQL 狀態更新成功",
+		logger.NewField("member_id", id),
+		logger.NewField("from_status", from),
+		logger.NewField("to_status", to),
+	)
+	return nil
+}
+func (s sqlxMemberPgsql) MarkMerged(ctx context.Context, sourceID, targetID int) (int, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.MarkMerged")
+	defer span.End()
+
+	result, err := s.executor(repoCtx).ExecContext(repoCtx, "members.mark_merged", queryMarkMemberMerged, targetID, sourceID)
+	if err != nil {
+		contextLogger.Error("SQL 合併標記失敗",
+			logger.NewField("error", err),
+			logger.NewField("source_id", sourceID),
+			logger.NewField("target_id", targetID),
+		)
+		return 0, mapSQLError(err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		contextLogger.Error("SQL 合併標記結果檢查失敗",
+			logger.NewField("error", err),
+			logger.NewField("source_id", sourceID),
+		)
+		return 0, err
+	}
+	if rowsAffected == 0 {
+		contextLogger.Error("SQL 合併標記未影響任何行",
+			logger.NewField("source_id", sourceID),
+			logger.NewField("target_id", targetID),
+		)
+		return 0, ErrDBNoEffect
+	}
+
+	result, err = s.executor(repoCtx).ExecContext(repoCtx, "members.redirect_merged", queryRedirectMergedMembers, targetID, sourceID)
+	if err != nil {
+		contextLogger.Error("SQL 合併轉指失敗",
+			logger.NewField("error", err),
+			logger.NewField("source_id", sourceID),
+			logger.NewField("target_id", targetID),
+		)
+		return 0, mapSQLError(err)
+	}
+	redirected, err := result.RowsAffected()
+	if err != nil {
+		contextLogger.Error("SQL 合併轉指結果檢查失敗",
+			logger.NewField("error", err),
+			logger.NewField("source_id", sourceID),
+		)
+		return 0, err
+	}
+
+	contextLogger.Debug("SQL 合併標記成功",
+		logger.NewField("source_id", sourceID),
+		logger.NewField("target_id", targetID),
+		logger.NewField("redirected", redirected),
+	)
+	return int(redirected), nil
+}
+func (s sqlxMemberPgsql) Delete(ctx context.Context, id int) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.Delete")
+	defer span.End()
+
+	// 清除外鍵與刪除須在同一個交易，由 use case 的交易保證
+	for _, clear := range []struct{ name, query string }{
+		{"members.clear_merged_into", queryClearMergedInto},
+		{"members.clear_referred_by", queryClearReferredBy},
+		{"member_invitations.clear_referrer", queryClearInvitationReferrer},
+	} {
+		if _, err := s.executor(repoCtx).ExecContext(repoCtx, clear.name, clear.query, id); err != nil {
+			contextLogger.Error("SQL 刪除前清除外鍵失敗",
+				logger.NewField("error", err),
+				logger.NewField("member_id", id),
+				logger.NewField("query", clear.name),
+			)
+			return mapSQLError(err)
+		}
+	}
+
+	result, err := s.executor(repoCtx).ExecContext(repoCtx, "members.delete", queryDeleteMember, id)
+	if err != nil {
+		contextLogger.Error("SQL 刪除失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+		)
+		return mapSQLError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		contextLogger.Error("SQL 刪除結果檢查失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+		)
+		return err
+	}
+
+	if rows != 1 {
+		contextLogger.Error("SQL 刪除未影響預期行數",
+			logger.NewField("member_id", id),
+			logger.NewField("rows_affected", rows),
+		)
+		return ErrDBNoEffect
+	}
+
+	contextLogger.Debug("SQL 刪除成功",
+		logger.NewField("member_id", id),
+		logger.NewField("rows_affected", rows),
+	)
+	return nil
+}
+
+// buildMemberWhere 依查詢條件組出 WHERE 子句，參數依序加入 args；除非指定 MergedInto 或 IncludeMerged，
+// 一律排除已被合併的會員
+func buildMemberWhere(q dao.MemberQuery, args *queryArgs) string {
+	var conditions []string
+	if q.Status != "" {
+		conditions = append(conditions, "status = "+args.add(q.Status))
+	}
+	if q.ReferredBy != 0 {
+		conditions = append(conditions, "referred_by = "+args.add(q.ReferredBy))
+	}
+	switch {
+	case q.MergedInto != 0:
+		conditions = append(conditions, "merged_into = "+args.add(q.MergedInto))
+	case !q.IncludeMerged:
+		conditions = append(conditions, "merged_into IS NULL")
+	}
+	conditions = append(conditions, buildTagConditions(q.TagsAny, q.TagsAll, args)...)
+	if len(conditions) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(conditions, " AND ")
+}
+
+// nullableNormalizedEmail 空字串寫入 NULL，避免多筆未正規化的資料撞到 UNIQUE 索引
+func nullableNormalizedEmail(normalizedEmail string) sql.NullString {
+	return sql.NullString{String: normalizedEmail, Valid: normalizedEmail != ""}
+}
+
+// nullableID 0 寫入 NULL，供可為空的外鍵欄位使用
+func nullableID(id int) sql.NullInt64 {
+	return sql.NullInt64{Int64: int64(id), Valid: id != 0}
+}
+
+// executor 寫入用：有交易時使用 context 中的交易，否則走 primary，並記錄已認證 actor 的寫入時間供 read-your-writes；經由 instrument 包裝
+func (s sqlxMemberPgsql) executor(ctx context.Context) sqlxinstrument.Executor {
+	return s.instrument.Wrap(s.router.Writer(ctx))
+}
+
+// reader 讀取用，交易中或沒有健康的 replica 時走 primary
+func (s sqlxMemberPgsql) reader(ctx context.Context) sqlxinstrument.Executor {
+	return s.instrument.Wrap(s.router.Reader(ctx))
+}
+
+// primary 不記錄寫入時間的 primary 讀取，交易中使用該交易
+func (s sqlxMemberPgsql) primary(ctx context.Context) sqlxinstrument.Executor {
+	return s.instrument.Wrap(sqlxtx.ExecutorFromContext(ctx, s.router.Primary()))
+}
+
+func createTracedLogger(ctx context.Context, tr tracer.Tracer, log logger.Logger, operationName string) (context.Context, logger.Logger, tracer.Span) {
+	repoCtx, span := tr.Start(ctx, operationName)
+	lg := log.WithContext(repoCtx)
+	return repoCtx, lg, span
+}
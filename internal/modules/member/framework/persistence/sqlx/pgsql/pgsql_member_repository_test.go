@@ -0,0 +1,65 @@
+package pgsql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/dao"
+)
+
+func TestBuildMemberWhere(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     dao.MemberQuery
+		wantWhere string
+		wantArgs  []any
+	}{
+		{
+			name:      "default excludes merged",
+			query:     dao.MemberQuery{},
+			wantWhere: " WHERE merged_into IS NULL",
+		},
+		{
+			name:      "include merged",
+			query:     dao.MemberQuery{IncludeMerged: true},
+			wantWhere: "",
+		},
+		{
+			name:      "placeholders are numbered in order",
+			query:     dao.MemberQuery{Status: "active", ReferredBy: 3, MergedInto: 7},
+			wantWhere: " WHERE status = $1 AND referred_by = $2 AND merged_into = $3",
+			wantArgs:  []any{"active", 3, 7},
+		},
+		{
+			name:  "tags are passed as arrays",
+			query: dao.MemberQuery{Status: "active", TagsAny: []string{"vip", "beta"}, TagsAll: []string{"gold"}},
+			wantWhere: " WHERE status = $1 AND merged_into IS NULL AND " + `id IN (SELECT mt.member_id FROM member_tags mt JOIN tags t ON t.id = mt.tag_id WHERE t.name = ANY($2))` + " AND " + `id IN (SELECT mt.member_id FROM member_tags mt JOIN tags t ON t.id = mt.tag_id WHERE t.name = ANY($3)
+GROUP BY mt.member_id HAVING COUNT(DISTINCT t.id) = $4)`,
+			wantArgs: []any{"active", []string{"vip", "beta"}, []string{"gold"}, 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := &queryArgs{}
+			where := buildMemberWhere(tt.query, args)
+			assert.Equal(t, tt.wantWhere, where)
+			assert.Equal(t, tt.wantArgs, args.values)
+		})
+	}
+}
+
+func TestParsePgTime(t *testing.T) {
+	taipei := time.FixedZone("CST", 8*60*60)
+	got, err := parsePgTime(time.Date(2025, 3, 1, 8, 30, 0, 123000, taipei).Format(time.RFC3339Nano))
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 3, 1, 0, 30, 0, 123000, time.UTC), got)
+
+	// 沒有時區的文字視為 UTC
+	got, err = parsePgTime("2025-03-01 00:30:00")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 3, 1, 0, 30, 0, 0, time.UTC), got)
+
+	_, err = parsePgTime("03/01/2025")
+	assert.Error(t, err)
+}
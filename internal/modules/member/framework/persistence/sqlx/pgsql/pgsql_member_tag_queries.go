@@ -0,0 +1,25 @@
+package pgsql
+
+const (
+	queryInsertTag = `INSERT INTO tags (name) VALUES ($1) ON CONFLICT (name) DO NOTHING`
+	// queryInsertMemberTag 只為存在的會員加上標籤；已有此標籤時忽略，RowsAffected 為 0
+	queryInsertMemberTag = `INSERT INTO member_tags (member_id, tag_id)
+SELECT m.id, t.id FROM members m JOIN tags t ON t.name = $1 WHERE m.id = $2
+ON CONFLICT (member_id, tag_id) DO NOTHING`
+	// queryInsertMembersTagBase 批次加標籤，略過已合併的會員；會員 ID 以陣列參數傳入
+	queryInsertMembersTagBase = `INSERT INTO member_tags (member_id, tag_id)
+SELECT m.id, t.id FROM members m JOIN tags t ON t.name = $1 WHERE m.id = ANY($2) AND m.merged_into IS NULL
+ON CONFLICT (member_id, tag_id) DO NOTHING`
+	queryDeleteMemberTag = `DELETE FROM member_tags
+WHERE member_id = $1 AND tag_id = (SELECT id FROM tags WHERE name = $2)`
+	querySelectMemberTags = `SELECT t.name FROM member_tags mt JOIN tags t ON t.id = mt.tag_id
+WHERE mt.member_id = $1 ORDER BY t.name`
+	// queryMergeMemberTags 目標已有的標籤忽略，保留原本加上的時間
+	queryMergeMemberTags = `INSERT INTO member_tags (member_id, tag_id, created_at)
+SELECT $1, tag_id, created_at FROM member_tags WHERE member_id = $2
+ON CONFLICT (member_id, tag_id) DO NOTHING`
+	// 標籤篩選的子查詢，%s 為標籤名稱陣列參數的 placeholder
+	queryMembersWithAnyTag = `id IN (SELECT mt.member_id FROM member_tags mt JOIN tags t ON t.id = mt.tag_id WHERE t.name = ANY(%s))`
+	queryMembersWithAllTag = `id IN (SELECT mt.member_id FROM member_tags mt JOIN tags t ON t.id = mt.tag_id WHERE t.name = ANY(%s)
+GROUP BY mt.member_id HAVING COUNT(DISTINCT t.id) = %s)`
+)
@@ -0,0 +1,14 @@
+package pgsql
+
+const (
+	querySelectPreferencesByMember = `SELECT * FROM member_preferences WHERE member_id = $1 ORDER BY pref_key`
+	// queryUpsertPreference 值相同時不更新，讓版本只在實際變更時增加
+	queryUpsertPreference = `INSERT INTO member_preferences (member_id, pref_key, value, version, updated_at) VALUES ($1, $2, $3, 1, $4)
+		ON CONFLICT (member_id, pref_key) DO UPDATE SET
+			value = excluded.value,
+			version = member_preferences.version + 1,
+			updated_at = excluded.updated_at
+		WHERE member_preferences.value <> excluded.value`
+	queryDeletePreference          = `DELETE FROM member_preferences WHERE member_id = $1 AND pref_key = $2`
+	queryDeletePreferencesByMember = `DELETE FROM member_preferences WHERE member_id = $1`
+)
@@ -0,0 +1,125 @@
+package pgsql
+
+import (
+	"database/sql"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/dao"
+	"time"
+
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/sqlx"
+)
+
+func sqlxModelToDTO(model *sqlx.MemberSQLXModel) (*dao.MemberRecord, error) {
+	if model == nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	// 時間格式由 sqlx.Timestamp 掃描時處理，這裡只檢查 NOT NULL 的 created_at
+	if !model.CreatedAt.Valid {
+		return nil, ErrMapperTimeParseFailed
+	}
+	updatedAt := model.CreatedAt.Time
+	if model.UpdatedAt.Valid {
+		updatedAt = model.UpdatedAt.Time
+	}
+	return &dao.MemberRecord{
+		ID:              model.ID,
+		Name:            model.Name,
+		Email:           model.Email,
+		NormalizedEmail: model.NormalizedEmail.String,
+		Password:        model.Password,
+		Status:          model.Status,
+		StatusReason:    model.StatusReason,
+		MergedInto:      int(model.MergedInto.Int64),
+		ReferredBy:      int(model.ReferredBy.Int64),
+		CreatedAt:       model.CreatedAt.Time,
+		UpdatedAt:       updatedAt,
+	}, nil
+}
+
+func invitationModelToDTO(model *sqlx.InvitationSQLXModel) (*dao.InvitationRecord, error) {
+	if model == nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	createdAt, err := parsePgTime(model.CreatedAt)
+	if err != nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	expiresAt, err := parseNullablePgTime(model.ExpiresAt)
+	if err != nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	revokedAt, err := parseNullablePgTime(model.RevokedAt)
+	if err != nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	return &dao.InvitationRecord{
+		ID:         model.ID,
+		Code:       model.Code,
+		CreatedBy:  model.CreatedBy,
+		ReferrerID: int(model.ReferrerID.Int64),
+		Email:      model.Email,
+		MaxUses:    model.MaxUses,
+		Uses:       model.Uses,
+		ExpiresAt:  expiresAt,
+		RevokedAt:  revokedAt,
+		CreatedAt:  createdAt,
+	}, nil
+}
+
+// parsePgTime pgx 將 TIMESTAMPTZ 讀成 time.Time，database/sql 掃進 string 時格式為 RFC3339Nano，一律回傳 UTC
+func parsePgTime(value string) (time.Time, error) {
+	return sqlx.ParseTimestamp(value)
+}
+
+// parseNullablePgTime NULL 回傳 nil
+func parseNullablePgTime(value sql.NullString) (*time.Time, error) {
+	if !value.Valid {
+		return nil, nil
+	}
+	t, err := parsePgTime(value.String)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// nullableTime nil 寫入 NULL，其餘以 UTC 寫入
+func nullableTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: t.UTC(), Valid: true}
+}
+
+func segmentModelToDTO(model *sqlx.SegmentSQLXModel) (*dao.SegmentRecord, error) {
+	if model == nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	createdAt, err := parsePgTime(model.CreatedAt)
+	if err != nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	return &dao.SegmentRecord{
+		ID:        model.ID,
+		Name:      model.Name,
+		Filter:    model.Filter,
+		CreatedBy: model.CreatedBy,
+		CreatedAt: createdAt,
+	}, nil
+}
+
+func preferenceModelToDTO(model *sqlx.PreferenceSQLXModel) (*dao.PreferenceRecord, error) {
+	if model == nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	updatedAt, err := parsePgTime(model.UpdatedAt)
+	if err != nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	return &dao.PreferenceRecord{
+		MemberID:  model.MemberID,
+		Key:       model.Key,
+		Value:     model.Value,
+		Version:   model.Version,
+		UpdatedAt: updatedAt,
+	}, nil
+}
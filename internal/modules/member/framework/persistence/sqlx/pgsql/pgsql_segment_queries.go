@@ -0,0 +1,10 @@
+package pgsql
+
+const (
+	queryInsertSegment          = `INSERT INTO member_segments (name, filter, created_by, created_at) VALUES ($1, $2, $3, $4) RETURNING id`
+	querySelectSegmentByID      = `SELECT * FROM member_segments WHERE id = $1`
+	querySelectSegmentByName    = `SELECT * FROM member_segments WHERE name = $1`
+	querySelectSegmentsWithPage = `SELECT * FROM member_segments ORDER BY id DESC LIMIT $1 OFFSET $2`
+	queryCountSegments          = `SELECT COUNT(*) FROM member_segments`
+	queryDeleteSegment          = `DELETE FROM member_segments WHERE id = $1`
+)
@@ -0,0 +1,10 @@
+package sqlx
+
+type PreferenceSQLXModel struct {
+	MemberID int    `db:"member_id"`
+	Key      string `db:"pref_key"`
+	// Value 偏好設定值的 JSON
+	Value     string `db:"value"`
+	Version   int    `db:"version"`
+	UpdatedAt string `db:"updated_at"`
+}
@@ -0,0 +1,10 @@
+package sqlx
+
+type SegmentSQLXModel struct {
+	ID   int    `db:"id"`
+	Name string `db:"name"`
+	// Filter 分群條件的 JSON
+	Filter    string `db:"filter"`
+	CreatedBy string `db:"created_by"`
+	CreatedAt string `db:"created_at"`
+}
@@ -0,0 +1,88 @@
+package sqlx
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrTimestampUnsupported 欄位值的型別或文字格式無法轉成時間
+var ErrTimestampUnsupported = errors.New("sqlx: unsupported timestamp value")
+
+// timestampLayouts 讀取時接受的文字格式，小數秒可有可無：
+//   - SQLite CURRENT_TIMESTAMP 寫入的 "2006-01-02 15:04:05"（UTC，沒有時區）
+//   - go-sqlite3 與 ent 寫入 time.Time 時帶時區的 "2006-01-02 15:04:05.999999999-07:00"
+//   - database/sql 把 time.Time 掃進 string 時的 RFC3339Nano
+//   - Postgres 文字輸出只有小時的時區 "2006-01-02 15:04:05.999999-07"
+var timestampLayouts = []string{
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02T15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z07",
+	"2006-01-02T15:04:05.999999999Z07",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// ParseTimestamp 依序嘗試 timestampLayouts，沒有時區的值視為 UTC，一律回傳 UTC
+func ParseTimestamp(value string) (time.Time, error) {
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.UTC(), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%w: %q", ErrTimestampUnsupported, value)
+}
+
+// Timestamp 時間欄位的 sql.Scanner 與 driver.Valuer，NULL 時 Valid 為 false；
+// 讀取時接受驅動回傳的 time.Time、Unix 秒數與 ParseTimestamp 支援的文字，寫入時一律轉成 UTC
+type Timestamp struct {
+	Time  time.Time
+	Valid bool
+}
+
+// NewTimestamp 以 UTC 建立有效的 Timestamp
+func NewTimestamp(t time.Time) Timestamp {
+	return Timestamp{Time: t.UTC(), Valid: true}
+}
+
+// Scan 實作 sql.Scanner
+func (t *Timestamp) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*t = Timestamp{}
+		return nil
+	case time.Time:
+		*t = NewTimestamp(v)
+		return nil
+	case int64:
+		*t = NewTimestamp(time.Unix(v, 0))
+		return nil
+	case string:
+		return t.scanText(v)
+	case []byte:
+		return t.scanText(string(v))
+	default:
+		return fmt.Errorf("%w: %T", ErrTimestampUnsupported, src)
+	}
+}
+
+func (t *Timestamp) scanText(value string) error {
+	parsed, err := ParseTimestamp(value)
+	if err != nil {
+		return err
+	}
+	*t = Timestamp{Time: parsed, Valid: true}
+	return nil
+}
+
+// Value 實作 driver.Valuer，由驅動依資料庫格式寫入 UTC 時間
+func (t Timestamp) Value() (driver.Value, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return t.Time.UTC(), nil
+}
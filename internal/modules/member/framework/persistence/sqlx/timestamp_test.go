@@ -0,0 +1,64 @@
+package sqlx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimestamp_Scan(t *testing.T) {
+	want := time.Date(2025, 3, 1, 0, 30, 0, 0, time.UTC)
+	withNanos := time.Date(2025, 3, 1, 0, 30, 0, 123456789, time.UTC)
+	taipei := time.FixedZone("CST", 8*60*60)
+
+	tests := []struct {
+		name    string
+		src     any
+		want    time.Time
+		invalid bool
+		wantErr bool
+	}{
+		{name: "sqlite current_timestamp", src: "2025-03-01 00:30:00", want: want},
+		{name: "bytes", src: []byte("2025-03-01 00:30:00"), want: want},
+		{name: "fractional seconds", src: "2025-03-01 00:30:00.123456789", want: withNanos},
+		{name: "go-sqlite3 and ent", src: "2025-03-01 08:30:00.123456789+08:00", want: withNanos},
+		{name: "rfc3339", src: "2025-03-01T00:30:00Z", want: want},
+		{name: "rfc3339 nano with offset", src: "2025-03-01T08:30:00.123456789+08:00", want: withNanos},
+		{name: "postgres hour offset", src: "2025-03-01 08:30:00+08", want: want},
+		{name: "date only", src: "2025-03-01", want: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "time value", src: time.Date(2025, 3, 1, 8, 30, 0, 0, taipei), want: want},
+		{name: "unix seconds", src: want.Unix(), want: want},
+		{name: "null", src: nil, invalid: true},
+		{name: "unknown text", src: "03/01/2025", wantErr: true},
+		{name: "unknown type", src: 1.5, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ts Timestamp
+			err := ts.Scan(tt.src)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrTimestampUnsupported)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, !tt.invalid, ts.Valid)
+			assert.Equal(t, tt.want, ts.Time)
+			if ts.Valid {
+				assert.Equal(t, time.UTC, ts.Time.Location())
+			}
+		})
+	}
+}
+
+func TestTimestamp_Value(t *testing.T) {
+	taipei := time.FixedZone("CST", 8*60*60)
+	value, err := NewTimestamp(time.Date(2025, 3, 1, 8, 30, 0, 0, taipei)).Value()
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 3, 1, 0, 30, 0, 0, time.UTC), value)
+
+	value, err = Timestamp{}.Value()
+	require.NoError(t, err)
+	assert.Nil(t, value)
+}
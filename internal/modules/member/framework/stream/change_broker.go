@@ -0,0 +1,176 @@
+package stream
+
+import (
+	"context"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"strconv"
+	"sync"
+)
+
+const (
+	// DefaultReplayBufferSize 預設保留最近幾筆異動供 Last-Event-ID 補送
+	DefaultReplayBufferSize = 256
+	// DefaultSubscriberBufferSize 預設每個訂閱者可堆積的異動數，超過即視為消費過慢
+	DefaultSubscriberBufferSize = 64
+)
+
+// Broker in-memory 會員異動 broker，實作 output.ChangeFeed
+//   - 事件 ID 為程序內遞增序號，重啟後歸零，客戶端帶入較大的 ID 時視為斷層
+//   - 只保留最近 replayBufferSize 筆異動，更早的 Last-Event-ID 無法補送
+//   - Publish 不會阻塞：訂閱者的 buffer 滿了就直接踢除並 close 其 channel，由客戶端帶 Last-Event-ID 重連
+type Broker struct {
+	mu                   sync.Mutex
+	seq                  uint64
+	history              []output.ChangeEvent
+	subscribers          map[*subscriber]struct{}
+	replayBufferSize     int
+	subscriberBufferSize int
+	closed               bool
+	logger               logger.Logger
+}
+
+type subscriber struct {
+	ch    chan output.ChangeEvent
+	done  chan struct{}
+	types map[output.ChangeType]struct{}
+}
+
+// matches types 為空表示訂閱全部
+func (s *subscriber) matches(t output.ChangeType) bool {
+	if len(s.types) == 0 {
+		return true
+	}
+	_, ok := s.types[t]
+	return ok
+}
+
+// NewBroker 建立 broker，size 小於等於 0 時使用預設值
+func NewBroker(replayBufferSize, subscriberBufferSize int, log logger.Logger) *Broker {
+	if replayBufferSize <= 0 {
+		replayBufferSize = DefaultReplayBufferSize
+	}
+	if subscriberBufferSize <= 0 {
+		subscriberBufferSize = DefaultSubscriberBufferSize
+	}
+	return &Broker{
+		subscribers:          make(map[*subscriber]struct{}),
+		replayBufferSize:     replayBufferSize,
+		subscriberBufferSize: subscriberBufferSize,
+		logger:               log.With(logger.NewField("layer", "stream")),
+	}
+}
+
+func (b *Broker) Publish(ctx context.Context, change output.MemberChange) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return
+	}
+
+	b.seq++
+	event := output.ChangeEvent{ID: strconv.FormatUint(b.seq, 10), MemberChange: change}
+	b.history = append(b.history, event)
+	if len(b.history) > b.replayBufferSize {
+		b.history = b.history[len(b.history)-b.replayBufferSize:]
+	}
+
+	for sub := range b.subscribers {
+		if !sub.matches(change.Type) {
+			continue
+		}
+		select {
+		case sub.ch <- event:
+		default:
+			b.remove(sub)
+			b.logger.WithContext(ctx).Warn("會員異動訂閱者消費過慢，已中斷訂閱",
+				logger.NewField("event_id", event.ID),
+				logger.NewField("buffer_size", b.subscriberBufferSize),
+			)
+		}
+	}
+}
+
+// Subscribe 註冊訂閱者並依 LastEventID 計算補送內容，ctx 結束時自動取消訂閱
+func (b *Broker) Subscribe(ctx context.Context, filter output.ChangeFilter) (*output.ChangeSubscription, error) {
+	sub := &subscriber{
+		ch:    make(chan output.ChangeEvent, b.subscriberBufferSize),
+		done:  make(chan struct{}),
+		types: make(map[output.ChangeType]struct{}, len(filter.Types)),
+	}
+	for _, t := range filter.Types {
+		sub.types[t] = struct{}{}
+	}
+
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return nil, ErrBrokerClosed
+	}
+	replay, gap := b.replay(filter.LastEventID, sub)
+	b.subscribers[sub] = struct{}{}
+	b.mu.Unlock()
+
+	closeFn := func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.remove(sub)
+	}
+	go func() {
+		select {
+		case <-ctx.Done():
+			closeFn()
+		case <-sub.done:
+		}
+	}()
+
+	return &output.ChangeSubscription{
+		Replay: replay,
+		Gap:    gap,
+		Events: sub.ch,
+		Close:  closeFn,
+	}, nil
+}
+
+// Close 關閉 broker 並中斷所有訂閱
+func (b *Broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	for sub := range b.subscribers {
+		b.remove(sub)
+	}
+}
+
+// replay 回傳 lastEventID 之後符合條件的異動；lastEventID 不合法或已超出 buffer 時回傳 gap，須持有鎖
+func (b *Broker) replay(lastEventID string, sub *subscriber) ([]output.ChangeEvent, bool) {
+	if lastEventID == "" {
+		return nil, false
+	}
+	last, err := strconv.ParseUint(lastEventID, 10, 64)
+	if err != nil || last > b.seq {
+		return nil, true
+	}
+	// history 中最舊一筆的序號
+	oldest := b.seq - uint64(len(b.history)) + 1
+	gap := last+1 < oldest
+
+	var events []output.ChangeEvent
+	for _, event := range b.history {
+		seq, _ := strconv.ParseUint(event.ID, 10, 64)
+		if seq > last && sub.matches(event.Type) {
+			events = append(events, event)
+		}
+	}
+	return events, gap
+}
+
+// remove 移除訂閱者並 close 其 channel，重複呼叫無副作用，須持有鎖
+func (b *Broker) remove(sub *subscriber) {
+	if _, ok := b.subscribers[sub]; !ok {
+		return
+	}
+	delete(b.subscribers, sub)
+	close(sub.ch)
+	close(sub.done)
+}
@@ -0,0 +1,160 @@
+package stream
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
+	mocklogger "github.com/tomoffice/go-clean-architecture/pkg/logger/mock"
+)
+
+func brokerHelper(t *testing.T, replayBufferSize, subscriberBufferSize int) *Broker {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockLogger := mocklogger.NewMockLogger(ctrl)
+	mockLogger.EXPECT().With(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+	return NewBroker(replayBufferSize, subscriberBufferSize, mockLogger)
+}
+
+func change(changeType output.ChangeType, id int) output.MemberChange {
+	return output.MemberChange{Type: changeType, Member: &entity.Member{ID: id}, At: time.Now()}
+}
+
+func eventIDs(events []output.ChangeEvent) []string {
+	ids := make([]string, len(events))
+	for i, e := range events {
+		ids[i] = e.ID
+	}
+	return ids
+}
+
+func TestBroker_PublishAndFilter(t *testing.T) {
+	ctx := context.Background()
+	b := brokerHelper(t, 10, 10)
+
+	all, err := b.Subscribe(ctx, output.ChangeFilter{})
+	require.NoError(t, err)
+	defer all.Close()
+	deletedOnly, err := b.Subscribe(ctx, output.ChangeFilter{Types: []output.ChangeType{output.ChangeTypeDeleted}})
+	require.NoError(t, err)
+	defer deletedOnly.Close()
+
+	b.Publish(ctx, change(output.ChangeTypeCreated, 1))
+	b.Publish(ctx, change(output.ChangeTypeDeleted, 1))
+
+	assert.Equal(t, "1", (<-all.Events).ID)
+	assert.Equal(t, "2", (<-all.Events).ID)
+	got := <-deletedOnly.Events
+	assert.Equal(t, "2", got.ID)
+	assert.Equal(t, output.ChangeTypeDeleted, got.Type)
+	assert.Len(t, deletedOnly.Events, 0)
+}
+
+func TestBroker_Replay(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name       string
+		published  int
+		filter     output.ChangeFilter
+		wantReplay []string
+		wantGap    bool
+	}{
+		{name: "no last event id", published: 3, filter: output.ChangeFilter{}, wantReplay: []string{}},
+		{name: "replays after last event id", published: 3, filter: output.ChangeFilter{LastEventID: "1"}, wantReplay: []string{"2", "3"}},
+		{name: "up to date", published: 3, filter: output.ChangeFilter{LastEventID: "3"}, wantReplay: []string{}},
+		{name: "last event id evicted", published: 8, filter: output.ChangeFilter{LastEventID: "2"}, wantReplay: []string{"4", "5", "6", "7", "8"}, wantGap: true},
+		{name: "oldest kept event is still contiguous", published: 8, filter: output.ChangeFilter{LastEventID: "3"}, wantReplay: []string{"4", "5", "6", "7", "8"}},
+		{name: "last event id from previous process", published: 2, filter: output.ChangeFilter{LastEventID: "99"}, wantReplay: []string{}, wantGap: true},
+		{name: "malformed last event id", published: 2, filter: output.ChangeFilter{LastEventID: "abc"}, wantReplay: []string{}, wantGap: true},
+		{name: "replay honours type filter", published: 4, filter: output.ChangeFilter{LastEventID: "0", Types: []output.ChangeType{output.ChangeTypeUpdated}}, wantReplay: []string{"2", "4"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := brokerHelper(t, 5, 10)
+			for i := 1; i <= tt.published; i++ {
+				changeType := output.ChangeTypeCreated
+				if i%2 == 0 {
+					changeType = output.ChangeTypeUpdated
+				}
+				b.Publish(ctx, change(changeType, i))
+			}
+
+			sub, err := b.Subscribe(ctx, tt.filter)
+			require.NoError(t, err)
+			defer sub.Close()
+			assert.Equal(t, tt.wantReplay, eventIDs(sub.Replay))
+			assert.Equal(t, tt.wantGap, sub.Gap)
+		})
+	}
+}
+
+func TestBroker_SlowConsumerIsDropped(t *testing.T) {
+	ctx := context.Background()
+	b := brokerHelper(t, 10, 2)
+	slow, err := b.Subscribe(ctx, output.ChangeFilter{})
+	require.NoError(t, err)
+	fast, err := b.Subscribe(ctx, output.ChangeFilter{})
+	require.NoError(t, err)
+	defer fast.Close()
+
+	for i := 1; i <= 3; i++ {
+		b.Publish(ctx, change(output.ChangeTypeCreated, i))
+		<-fast.Events
+	}
+
+	// buffer 內的兩筆仍可讀出，之後 channel 被 close
+	assert.Equal(t, "1", (<-slow.Events).ID)
+	assert.Equal(t, "2", (<-slow.Events).ID)
+	_, ok := <-slow.Events
+	assert.False(t, ok)
+	// 重複 Close 無副作用
+	slow.Close()
+
+	// 以 Last-Event-ID 重連可補送被丟棄的異動
+	resumed, err := b.Subscribe(ctx, output.ChangeFilter{LastEventID: "2"})
+	require.NoError(t, err)
+	defer resumed.Close()
+	assert.Equal(t, []string{"3"}, eventIDs(resumed.Replay))
+	assert.False(t, resumed.Gap)
+}
+
+func TestBroker_ContextCancelUnsubscribes(t *testing.T) {
+	b := brokerHelper(t, 10, 10)
+	ctx, cancel := context.WithCancel(context.Background())
+	sub, err := b.Subscribe(ctx, output.ChangeFilter{})
+	require.NoError(t, err)
+
+	cancel()
+	select {
+	case _, ok := <-sub.Events:
+		assert.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("subscription was not closed after context cancel")
+	}
+	b.mu.Lock()
+	assert.Empty(t, b.subscribers)
+	b.mu.Unlock()
+}
+
+func TestBroker_Close(t *testing.T) {
+	ctx := context.Background()
+	b := brokerHelper(t, 10, 10)
+	sub, err := b.Subscribe(ctx, output.ChangeFilter{})
+	require.NoError(t, err)
+
+	b.Close()
+	_, ok := <-sub.Events
+	assert.False(t, ok)
+
+	_, err = b.Subscribe(ctx, output.ChangeFilter{})
+	assert.ErrorIs(t, err, ErrBrokerClosed)
+	// 關閉後發佈不會 panic
+	b.Publish(ctx, change(output.ChangeTypeCreated, 1))
+}
@@ -0,0 +1,8 @@
+package stream
+
+import "errors"
+
+var (
+	// ErrBrokerClosed broker 已關閉，不再接受新的訂閱。
+	ErrBrokerClosed = errors.New("stream: change broker closed")
+)
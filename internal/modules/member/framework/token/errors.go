@@ -0,0 +1,12 @@
+package token
+
+import "errors"
+
+var (
+	// ErrUnsupportedAlgorithm 只支援以共用密鑰簽名的 HS256、HS384、HS512，與驗證端的 auth middleware 一致。
+	ErrUnsupportedAlgorithm = errors.New("token: unsupported signing algorithm")
+	// ErrSecretTooShort 密鑰少於 32 字元。
+	ErrSecretTooShort = errors.New("token: secret must be at least 32 characters")
+	// ErrInvalidTTL 有效期間必須大於 0。
+	ErrInvalidTTL = errors.New("token: ttl must be positive")
+)
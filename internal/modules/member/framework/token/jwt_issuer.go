@@ -0,0 +1,61 @@
+package token
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
+)
+
+// minSecretLength 與 auth middleware 的密鑰長度下限相同
+const minSecretLength = 32
+
+// signingMethods 驗證端只以共用密鑰驗簽，所以只能用 HMAC 簽發
+var signingMethods = map[string]jwt.SigningMethod{
+	"HS256": jwt.SigningMethodHS256,
+	"HS384": jwt.SigningMethodHS384,
+	"HS512": jwt.SigningMethodHS512,
+}
+
+// JWTIssuer 實作 output.TokenIssuer，簽發只含 sub、iat、exp 的 JWT
+type JWTIssuer struct {
+	method jwt.SigningMethod
+	secret []byte
+	ttl    time.Duration
+	now    func() time.Time
+}
+
+// NewJWTIssuer algorithm 與 secret 必須與 auth middleware 驗證時使用的設定相同
+func NewJWTIssuer(algorithm, secret string, ttl time.Duration) (*JWTIssuer, error) {
+	method, ok := signingMethods[algorithm]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedAlgorithm, algorithm)
+	}
+	if len(secret) < minSecretLength {
+		return nil, ErrSecretTooShort
+	}
+	if ttl <= 0 {
+		return nil, ErrInvalidTTL
+	}
+	return &JWTIssuer{method: method, secret: []byte(secret), ttl: ttl, now: time.Now}, nil
+}
+
+func (i *JWTIssuer) Issue(ctx context.Context, subject string) (*output.AccessToken, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	issuedAt := i.now().UTC().Truncate(time.Second)
+	expiresAt := issuedAt.Add(i.ttl)
+	claims := jwt.RegisteredClaims{
+		Subject:   subject,
+		IssuedAt:  jwt.NewNumericDate(issuedAt),
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+	}
+	signed, err := jwt.NewWithClaims(i.method, claims).SignedString(i.secret)
+	if err != nil {
+		return nil, err
+	}
+	return &output.AccessToken{Token: signed, ExpiresAt: expiresAt}, nil
+}
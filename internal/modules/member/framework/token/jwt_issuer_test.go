@@ -0,0 +1,56 @@
+package token
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewJWTIssuer(t *testing.T) {
+	secret := strings.Repeat("s", 32)
+	tests := []struct {
+		name      string
+		algorithm string
+		secret    string
+		ttl       time.Duration
+		wantErr   error
+	}{
+		{name: "valid", algorithm: "HS256", secret: secret, ttl: time.Hour},
+		{name: "asymmetric algorithm", algorithm: "RS256", secret: secret, ttl: time.Hour, wantErr: ErrUnsupportedAlgorithm},
+		{name: "short secret", algorithm: "HS256", secret: "short", ttl: time.Hour, wantErr: ErrSecretTooShort},
+		{name: "zero ttl", algorithm: "HS256", secret: secret, wantErr: ErrInvalidTTL},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewJWTIssuer(tt.algorithm, tt.secret, tt.ttl)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestJWTIssuer_Issue(t *testing.T) {
+	secret := strings.Repeat("s", 32)
+	issuer, err := NewJWTIssuer("HS384", secret, time.Hour)
+	require.NoError(t, err)
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	issuer.now = func() time.Time { return now }
+
+	got, err := issuer.Issue(context.Background(), "42")
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(time.Hour), got.ExpiresAt)
+
+	claims := jwt.RegisteredClaims{}
+	_, err = jwt.ParseWithClaims(got.Token, &claims, func(token *jwt.Token) (any, error) {
+		assert.Equal(t, "HS384", token.Method.Alg())
+		return []byte(secret), nil
+	}, jwt.WithTimeFunc(func() time.Time { return now }))
+	require.NoError(t, err)
+	assert.Equal(t, "42", claims.Subject)
+	assert.Equal(t, now, claims.IssuedAt.Time.UTC())
+	assert.Equal(t, now.Add(time.Hour), claims.ExpiresAt.Time.UTC())
+}
@@ -2,36 +2,60 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	memberhttp "github.com/tomoffice/go-clean-architecture/internal/interface_adapter/transport/http"
+	"io"
 	"net/http"
+	"path"
+	"strconv"
+	"time"
 
 	gindto "github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/dto"
 	"github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/errordefs"
 	ginmapper "github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/mapper"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/mapper"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/validation"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/input"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
 	"github.com/tomoffice/go-clean-architecture/pkg/logger"
 	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
 )
 
+const (
+	// DefaultStreamHeartbeat 會員異動串流預設 heartbeat 間隔，需短於代理伺服器的閒置逾時
+	DefaultStreamHeartbeat = 15 * time.Second
+	// streamRetry 建議客戶端斷線後的重連間隔
+	streamRetry = 3 * time.Second
+	// streamResetEvent Last-Event-ID 已無法補送時通知客戶端重新取得完整資料
+	streamResetEvent = "reset"
+)
+
 type MemberController struct {
-	usecase      input.MemberInputPort
-	presenter    output.MemberPresenter
-	dtoValidator validation.Validator
-	logger       logger.Logger
-	tracer       tracer.Tracer
+	usecase         input.MemberInputPort
+	presenter       output.MemberPresenter
+	dtoValidator    validation.Validator
+	streamHeartbeat time.Duration
+	logger          logger.Logger
+	tracer          tracer.Tracer
 }
 
-func NewMemberController(memberUseCase input.MemberInputPort, presenter output.MemberPresenter, dtoValidator validation.Validator, log logger.Logger, tracer tracer.Tracer) *MemberController {
+// NewMemberController streamHeartbeat 小於等於 0 時使用 DefaultStreamHeartbeat
+func NewMemberController(memberUseCase input.MemberInputPort, presenter output.MemberPresenter, dtoValidator validation.Validator, streamHeartbeat time.Duration, log logger.Logger, tracer tracer.Tracer) *MemberController {
 	baseLogger := log.With(logger.NewField("layer", "controller"))
+	if streamHeartbeat <= 0 {
+		streamHeartbeat = DefaultStreamHeartbeat
+	}
 	return &MemberController{
-		usecase:      memberUseCase,
-		presenter:    presenter,
-		dtoValidator: dtoValidator,
-		logger:       baseLogger,
-		tracer:       tracer,
+		usecase:         memberUseCase,
+		presenter:       presenter,
+		dtoValidator:    dtoValidator,
+		streamHeartbeat: streamHeartbeat,
+		logger:          baseLogger,
+		tracer:          tracer,
 	}
 }
 
@@ -64,7 +88,7 @@ func (c *MemberController) Register(ctx memberhttp.Context) {
 		return
 	}
 	entity := mapper.RegisterMemberDTOToEntity(reqDTO)
-	member, err := c.usecase.RegisterMember(requestCtx, entity)
+	member, err := c.usecase.RegisterMember(requestCtx, entity, reqDTO.InvitationCode)
 	if err != nil {
 		errCode, resp := c.presenter.PresentUseCaseError(err)
 		httpStatus := MapErrorCodeToHTTPStatus(errCode)
@@ -109,6 +133,19 @@ func (c *MemberController) GetByID(ctx memberhttp.Context) {
 	}
 	entity := mapper.GetMemberByIDDTOToEntity(reqDTO)
 	member, err := c.usecase.GetMemberByID(requestCtx, entity.ID)
+	var merged *usecase.MemberMergedError
+	if errors.As(err, &merged) {
+		// 已合併的會員永久導向保留的會員
+		location := path.Join(path.Dir(ctx.Request().URL.Path), strconv.Itoa(merged.TargetID))
+		contextLogger.Info("會員查詢(ID)導向合併後的會員",
+			logger.NewField("member_id", entity.ID),
+			logger.NewField("merged_into", merged.TargetID),
+		)
+		_, resp := c.presenter.PresentUseCaseError(err)
+		ctx.Header("Location", location)
+		ctx.JSON(http.StatusMovedPermanently, resp)
+		return
+	}
 	if err != nil {
 		contextLogger.Error("會員查詢(ID) UseCase 執行錯誤",
 			logger.NewField("error", err.Error()),
@@ -194,13 +231,15 @@ func (c *MemberController) List(ctx memberhttp.Context) {
 		ctx.JSON(httpStatus, resp)
 		return
 	}
+	inputModel := mapper.ListMemberDTOToInputModel(reqDTO)
 	pagination := mapper.ListMemberDTOToPagination(reqDTO)
-	members, total, err := c.usecase.ListMembers(requestCtx, *pagination)
+	members, total, err := c.usecase.ListMembers(requestCtx, inputModel, *pagination)
 	if err != nil {
 		contextLogger.Error("會員列表查詢 UseCase 執行錯誤",
 			logger.NewField("error", err.Error()),
 			logger.NewField("offset", pagination.Offset),
 			logger.NewField("limit", pagination.Limit),
+			logger.NewField("status", inputModel.Status),
 		)
 		errCode, resp := c.presenter.PresentUseCaseError(err)
 		httpStatus := MapErrorCodeToHTTPStatus(errCode)
@@ -375,6 +414,144 @@ func (c *MemberController) UpdatePassword(ctx memberhttp.Context) {
 	resp := c.presenter.PresentUpdateMemberPassword()
 	ctx.JSON(http.StatusOK, resp)
 }
+
+// Activate 啟用待啟用或被封鎖的會員
+func (c *MemberController) Activate(ctx memberhttp.Context) {
+	c.changeStatus(ctx, string(entity.MemberStatusActive))
+}
+
+// Suspend 停權會員，body 需帶 reason
+func (c *MemberController) Suspend(ctx memberhttp.Context) {
+	c.changeStatus(ctx, string(entity.MemberStatusSuspended))
+}
+
+// Ban 封鎖會員，body 需帶 reason
+func (c *MemberController) Ban(ctx memberhttp.Context) {
+	c.changeStatus(ctx, string(entity.MemberStatusBanned))
+}
+
+// Reinstate 恢復被停權的會員
+func (c *MemberController) Reinstate(ctx memberhttp.Context) {
+	c.changeStatus(ctx, string(entity.MemberStatusActive))
+}
+
+// changeStatus 各狀態變更端點共用，目標狀態由路由決定；body 可省略
+func (c *MemberController) changeStatus(ctx memberhttp.Context, status string) {
+	// 創建帶有 context 的 logger 用於追蹤
+	requestCtx, contextLogger, span := createTracedLogger(ctx.RequestCtx(), c.tracer, c.logger)
+	defer span.End()
+
+	var ginURI gindto.GinBindingUpdateMemberURIRequestDTO
+	if err := ctx.BindURI(&ginURI); err != nil {
+		contextLogger.Error("會員狀態變更 URI 參數綁定錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("uri", ctx.Request().RequestURI),
+		)
+		errCode, errMsg := errordefs.MapGinBindingError(err)
+		resp := c.presenter.PresentBindingError(errCode, errMsg)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	var ginBody gindto.GinBindingChangeMemberStatusBodyRequestDTO
+	if ctx.Request().ContentLength != 0 {
+		if err := ctx.BindJSON(&ginBody); err != nil {
+			contextLogger.Error("會員狀態變更 Body 參數綁定錯誤",
+				logger.NewField("error", err.Error()),
+				logger.NewField("content_type", ctx.GetHeader("Content-Type")),
+			)
+			errCode, errMsg := errordefs.MapGinBindingError(err)
+			resp := c.presenter.PresentBindingError(errCode, errMsg)
+			httpStatus := MapErrorCodeToHTTPStatus(errCode)
+			ctx.JSON(httpStatus, resp)
+			return
+		}
+	}
+	reqDTO := ginmapper.GinDTOToChangeMemberStatusDTO(ginURI, ginBody, status)
+	if err := c.dtoValidator.ValidateChangeMemberStatus(reqDTO); err != nil {
+		contextLogger.Error("會員狀態變更參數驗證錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("member_id", ginURI.ID),
+		)
+		errCode, resp := c.presenter.PresentValidationError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	inputModel := mapper.ChangeMemberStatusDTOToInputModel(reqDTO)
+	member, err := c.usecase.ChangeMemberStatus(requestCtx, inputModel)
+	if err != nil {
+		contextLogger.Error("會員狀態變更 UseCase 執行錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("member_id", inputModel.ID),
+			logger.NewField("to_status", inputModel.Status),
+		)
+		errCode, resp := c.presenter.PresentUseCaseError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	resp := c.presenter.PresentChangeMemberStatus(member)
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// Merge 將 :id 合併到 body 的 target_id，preview 為 true 時只回報會搬移的資料
+func (c *MemberController) Merge(ctx memberhttp.Context) {
+	// 創建帶有 context 的 logger 用於追蹤
+	requestCtx, contextLogger, span := createTracedLogger(ctx.RequestCtx(), c.tracer, c.logger)
+	defer span.End()
+
+	var ginURI gindto.GinBindingUpdateMemberURIRequestDTO
+	if err := ctx.BindURI(&ginURI); err != nil {
+		contextLogger.Error("會員合併 URI 參數綁定錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("uri", ctx.Request().RequestURI),
+		)
+		errCode, errMsg := errordefs.MapGinBindingError(err)
+		resp := c.presenter.PresentBindingError(errCode, errMsg)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	var ginBody gindto.GinBindingMergeMembersBodyRequestDTO
+	if err := ctx.BindJSON(&ginBody); err != nil {
+		contextLogger.Error("會員合併 Body 參數綁定錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("content_type", ctx.GetHeader("Content-Type")),
+		)
+		errCode, errMsg := errordefs.MapGinBindingError(err)
+		resp := c.presenter.PresentBindingError(errCode, errMsg)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	reqDTO := ginmapper.GinDTOToMergeMembersDTO(ginURI, ginBody)
+	if err := c.dtoValidator.ValidateMergeMembers(reqDTO); err != nil {
+		contextLogger.Error("會員合併參數驗證錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("member_id", ginURI.ID),
+		)
+		errCode, resp := c.presenter.PresentValidationError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	inputModel := mapper.MergeMembersDTOToInputModel(reqDTO)
+	result, err := c.usecase.MergeMembers(requestCtx, inputModel)
+	if err != nil {
+		contextLogger.Error("會員合併 UseCase 執行錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("source_id", inputModel.SourceID),
+			logger.NewField("target_id", inputModel.TargetID),
+		)
+		errCode, resp := c.presenter.PresentUseCaseError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	resp := c.presenter.PresentMergeMembers(result)
+	ctx.JSON(http.StatusOK, resp)
+}
 func (c *MemberController) Delete(ctx memberhttp.Context) {
 	// 創建帶有 context 的 logger 用於追蹤
 	requestCtx, contextLogger, span := createTracedLogger(ctx.RequestCtx(), c.tracer, c.logger)
@@ -419,6 +596,209 @@ func (c *MemberController) Delete(ctx memberhttp.Context) {
 	ctx.JSON(http.StatusOK, resp)
 }
 
+// ExportPersonalData 匯出會員個資，以附件下載並禁止快取
+func (c *MemberController) ExportPersonalData(ctx memberhttp.Context) {
+	// 創建帶有 context 的 logger 用於追蹤
+	requestCtx, contextLogger, span := createTracedLogger(ctx.RequestCtx(), c.tracer, c.logger)
+	defer span.End()
+
+	var ginReqDTO gindto.GinBindingPersonalDataURIRequestDTO
+	if err := ctx.BindURI(&ginReqDTO); err != nil {
+		contextLogger.Error("會員個資匯出參數綁定錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("uri", ctx.Request().RequestURI),
+		)
+		errCode, errMsg := errordefs.MapGinBindingError(err)
+		resp := c.presenter.PresentBindingError(errCode, errMsg)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	reqDTO := ginmapper.GinDTOToPersonalDataDTO(ginReqDTO)
+	if err := c.dtoValidator.ValidatePersonalData(reqDTO); err != nil {
+		contextLogger.Error("會員個資匯出參數驗證錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("member_id", ginReqDTO.ID),
+		)
+		errCode, resp := c.presenter.PresentValidationError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	entity := mapper.PersonalDataDTOToEntity(reqDTO)
+	archive, err := c.usecase.ExportPersonalData(requestCtx, entity.ID)
+	if err != nil {
+		contextLogger.Error("會員個資匯出 UseCase 執行錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("member_id", entity.ID),
+		)
+		errCode, resp := c.presenter.PresentUseCaseError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	resp := c.presenter.PresentExportPersonalData(archive)
+	ctx.Header("Cache-Control", "no-store")
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="member-%d-personal-data.json"`, entity.ID))
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// ErasePersonalData 不可逆地匿名化會員個資，重複呼叫回傳 already_erased=true
+func (c *MemberController) ErasePersonalData(ctx memberhttp.Context) {
+	// 創建帶有 context 的 logger 用於追蹤
+	requestCtx, contextLogger, span := createTracedLogger(ctx.RequestCtx(), c.tracer, c.logger)
+	defer span.End()
+
+	var ginReqDTO gindto.GinBindingPersonalDataURIRequestDTO
+	if err := ctx.BindURI(&ginReqDTO); err != nil {
+		contextLogger.Error("會員個資刪除參數綁定錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("uri", ctx.Request().RequestURI),
+		)
+		errCode, errMsg := errordefs.MapGinBindingError(err)
+		resp := c.presenter.PresentBindingError(errCode, errMsg)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	reqDTO := ginmapper.GinDTOToPersonalDataDTO(ginReqDTO)
+	if err := c.dtoValidator.ValidatePersonalData(reqDTO); err != nil {
+		contextLogger.Error("會員個資刪除參數驗證錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("member_id", ginReqDTO.ID),
+		)
+		errCode, resp := c.presenter.PresentValidationError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	entity := mapper.PersonalDataDTOToEntity(reqDTO)
+	result, err := c.usecase.ErasePersonalData(requestCtx, entity.ID)
+	if err != nil {
+		contextLogger.Error("會員個資刪除 UseCase 執行錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("member_id", entity.ID),
+		)
+		errCode, resp := c.presenter.PresentUseCaseError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	resp := c.presenter.PresentErasePersonalData(result)
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// Stream 以 Server-Sent Events 推送會員異動
+//   - 連線建立後先送 retry 建議值，再補送 Last-Event-ID 之後的異動
+//   - 訂閱因消費過慢被中斷時結束回應，由客戶端帶 Last-Event-ID 重連補送
+func (c *MemberController) Stream(ctx memberhttp.Context) {
+	// 創建帶有 context 的 logger 用於追蹤
+	requestCtx, contextLogger, span := createTracedLogger(ctx.RequestCtx(), c.tracer, c.logger)
+	defer span.End()
+
+	var ginReqDTO gindto.GinBindingStreamMemberChangesQueryRequestDTO
+	if err := ctx.BindQuery(&ginReqDTO); err != nil {
+		contextLogger.Error("會員異動串流參數綁定錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("query", ctx.Request().URL.RawQuery),
+		)
+		errCode, errMsg := errordefs.MapGinBindingError(err)
+		resp := c.presenter.PresentBindingError(errCode, errMsg)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	reqDTO := ginmapper.GinDTOToStreamMemberChangesDTO(ginReqDTO, ctx.GetHeader("Last-Event-ID"))
+	if err := c.dtoValidator.ValidateStreamMemberChanges(reqDTO); err != nil {
+		contextLogger.Error("會員異動串流參數驗證錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("types", reqDTO.Types),
+			logger.NewField("last_event_id", reqDTO.LastEventID),
+		)
+		errCode, resp := c.presenter.PresentValidationError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	inputModel := mapper.StreamMemberChangesDTOToInputModel(reqDTO)
+	// 訂閱跟隨 request context，客戶端斷線時自動取消
+	subscription, err := c.usecase.StreamMemberChanges(requestCtx, inputModel)
+	if err != nil {
+		contextLogger.Error("會員異動串流 UseCase 執行錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("types", reqDTO.Types),
+		)
+		errCode, resp := c.presenter.PresentUseCaseError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	defer subscription.Close()
+
+	ctx.Header("Content-Type", "text/event-stream")
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("Connection", "keep-alive")
+	ctx.Header("X-Accel-Buffering", "no")
+	ctx.Status(http.StatusOK)
+
+	heartbeat := time.NewTicker(c.streamHeartbeat)
+	defer heartbeat.Stop()
+	started := false
+	clientGone := ctx.Stream(func(w io.Writer) bool {
+		if !started {
+			started = true
+			return c.writeStreamPreamble(w, subscription)
+		}
+		select {
+		case <-requestCtx.Done():
+			return false
+		case event, ok := <-subscription.Events:
+			if !ok {
+				contextLogger.Warn("會員異動串流訂閱已中斷，等待客戶端重連")
+				return false
+			}
+			return c.writeChangeEvent(w, event) == nil
+		case <-heartbeat.C:
+			return memberhttp.WriteSSEComment(w, "heartbeat") == nil
+		}
+	})
+
+	contextLogger.Debug("會員異動串流結束",
+		logger.NewField("client_gone", clientGone),
+		logger.NewField("types", reqDTO.Types),
+	)
+}
+
+// writeStreamPreamble 寫入 retry 建議值、斷層通知與補送的異動
+func (c *MemberController) writeStreamPreamble(w io.Writer, subscription *output.ChangeSubscription) bool {
+	if err := memberhttp.WriteSSEEvent(w, memberhttp.SSEEvent{Retry: streamRetry}); err != nil {
+		return false
+	}
+	if subscription.Gap {
+		if err := memberhttp.WriteSSEEvent(w, memberhttp.SSEEvent{Event: streamResetEvent, Data: []byte("{}")}); err != nil {
+			return false
+		}
+	}
+	for _, event := range subscription.Replay {
+		if err := c.writeChangeEvent(w, event); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *MemberController) writeChangeEvent(w io.Writer, event output.ChangeEvent) error {
+	data, err := json.Marshal(c.presenter.PresentMemberChangeEvent(event))
+	if err != nil {
+		return err
+	}
+	return memberhttp.WriteSSEEvent(w, memberhttp.SSEEvent{
+		ID:    event.ID,
+		Event: string(event.Type),
+		Data:  data,
+	})
+}
+
 func createTracedLogger(ctx context.Context, tr tracer.Tracer, log logger.Logger) (context.Context, logger.Logger, tracer.Span) {
 	requestCtx, span := tr.Start(ctx, "")
 	lg := log.WithContext(requestCtx)
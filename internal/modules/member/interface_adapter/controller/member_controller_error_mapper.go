@@ -15,7 +15,7 @@ func MapErrorCodeToHTTPStatus(code int) int {
 	case code >= 2000 && code < 3000:
 		return http.StatusBadRequest
 
-	// UseCase → 404, 409, or 500
+	// UseCase → 400, 403, 404, 409, 422, or 500
 	case code == errorcode.ErrMemberNotFound:
 		return http.StatusNotFound
 	case code == errorcode.ErrMemberAlreadyExists:
@@ -30,6 +30,66 @@ func MapErrorCodeToHTTPStatus(code int) int {
 		return http.StatusUnauthorized
 	case code == errorcode.ErrMemberUpdateSamePassword:
 		return http.StatusConflict
+	case code == errorcode.ErrMemberChangeStreamUnavailable:
+		return http.StatusServiceUnavailable
+	case code == errorcode.ErrMemberInvalidEmail:
+		return http.StatusBadRequest
+	case code == errorcode.ErrMemberInvalidName:
+		return http.StatusBadRequest
+	case code == errorcode.ErrMemberEmailPolicyViolation:
+		return http.StatusUnprocessableEntity
+	case code == errorcode.ErrMemberPasswordPolicyViolation:
+		return http.StatusUnprocessableEntity
+	case code == errorcode.ErrMemberStatusTransition:
+		return http.StatusConflict
+	case code == errorcode.ErrMemberStatusReasonRequired:
+		return http.StatusBadRequest
+	case code == errorcode.ErrMemberStatusForbidden:
+		return http.StatusForbidden
+	case code == errorcode.ErrMemberNotActive:
+		return http.StatusForbidden
+	case code == errorcode.ErrMemberMerged:
+		return http.StatusConflict
+	case code == errorcode.ErrMemberInvalidMerge:
+		return http.StatusBadRequest
+	case code == errorcode.ErrMemberMergeForbidden:
+		return http.StatusForbidden
+	case code == errorcode.ErrInvitationNotFound:
+		return http.StatusNotFound
+	case code == errorcode.ErrInvitationAlreadyExists:
+		return http.StatusConflict
+	case code == errorcode.ErrInvitationRequired:
+		return http.StatusBadRequest
+	case code == errorcode.ErrInvitationInvalid:
+		return http.StatusUnprocessableEntity
+	case code == errorcode.ErrInvitationForbidden:
+		return http.StatusForbidden
+	case code == errorcode.ErrMemberInvalidTag:
+		return http.StatusBadRequest
+	case code == errorcode.ErrMemberTagForbidden:
+		return http.StatusForbidden
+	case code == errorcode.ErrSegmentNotFound:
+		return http.StatusNotFound
+	case code == errorcode.ErrSegmentAlreadyExists:
+		return http.StatusConflict
+	case code == errorcode.ErrSegmentInvalid:
+		return http.StatusBadRequest
+	case code == errorcode.ErrMemberInvalidPreference:
+		return http.StatusBadRequest
+	case code == errorcode.ErrMemberConcurrentUpdate:
+		return http.StatusConflict
+	case code == errorcode.ErrMemberReferenceConflict:
+		return http.StatusConflict
+	case code == errorcode.ErrMemberConstraintViolation:
+		return http.StatusUnprocessableEntity
+	case code == errorcode.ErrMemberStorageUnavailable:
+		return http.StatusServiceUnavailable
+	case code == errorcode.ErrMemberInvalidCredentials:
+		return http.StatusUnauthorized
+	case code == errorcode.ErrMemberTokenUnavailable:
+		return http.StatusServiceUnavailable
+	case code == errorcode.ErrMemberPrivacyForbidden:
+		return http.StatusForbidden
 	case code >= 3000 && code < 4000:
 		return http.StatusInternalServerError
 
@@ -51,6 +51,174 @@ func TestMapErrorCodeToHTTPStatus(t *testing.T) {
 			},
 			want: http.StatusConflict,
 		},
+		{
+			name: "UseCase Error - Privacy Forbidden",
+			args: args{
+				code: errorcode.ErrMemberPrivacyForbidden,
+			},
+			want: http.StatusForbidden,
+		},
+		{
+			name: "UseCase Error - Invalid Email",
+			args: args{
+				code: errorcode.ErrMemberInvalidEmail,
+			},
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "UseCase Error - Invalid Name",
+			args: args{
+				code: errorcode.ErrMemberInvalidName,
+			},
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "UseCase Error - Email Policy Violation",
+			args: args{
+				code: errorcode.ErrMemberEmailPolicyViolation,
+			},
+			want: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "UseCase Error - Password Policy Violation",
+			args: args{
+				code: errorcode.ErrMemberPasswordPolicyViolation,
+			},
+			want: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "UseCase Error - Status Transition Not Allowed",
+			args: args{
+				code: errorcode.ErrMemberStatusTransition,
+			},
+			want: http.StatusConflict,
+		},
+		{
+			name: "UseCase Error - Status Reason Required",
+			args: args{
+				code: errorcode.ErrMemberStatusReasonRequired,
+			},
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "UseCase Error - Status Forbidden",
+			args: args{
+				code: errorcode.ErrMemberStatusForbidden,
+			},
+			want: http.StatusForbidden,
+		},
+		{
+			name: "UseCase Error - Not Active",
+			args: args{
+				code: errorcode.ErrMemberNotActive,
+			},
+			want: http.StatusForbidden,
+		},
+		{
+			name: "UseCase Error - Member Merged",
+			args: args{
+				code: errorcode.ErrMemberMerged,
+			},
+			want: http.StatusConflict,
+		},
+		{
+			name: "UseCase Error - Invalid Merge",
+			args: args{
+				code: errorcode.ErrMemberInvalidMerge,
+			},
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "UseCase Error - Merge Forbidden",
+			args: args{
+				code: errorcode.ErrMemberMergeForbidden,
+			},
+			want: http.StatusForbidden,
+		},
+		{
+			name: "UseCase Error - Invitation Not Found",
+			args: args{
+				code: errorcode.ErrInvitationNotFound,
+			},
+			want: http.StatusNotFound,
+		},
+		{
+			name: "UseCase Error - Invitation Already Exists",
+			args: args{
+				code: errorcode.ErrInvitationAlreadyExists,
+			},
+			want: http.StatusConflict,
+		},
+		{
+			name: "UseCase Error - Invitation Required",
+			args: args{
+				code: errorcode.ErrInvitationRequired,
+			},
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "UseCase Error - Invitation Invalid",
+			args: args{
+				code: errorcode.ErrInvitationInvalid,
+			},
+			want: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "UseCase Error - Invitation Forbidden",
+			args: args{
+				code: errorcode.ErrInvitationForbidden,
+			},
+			want: http.StatusForbidden,
+		},
+		{
+			name: "UseCase Error - Invalid Tag",
+			args: args{
+				code: errorcode.ErrMemberInvalidTag,
+			},
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "UseCase Error - Tag Forbidden",
+			args: args{
+				code: errorcode.ErrMemberTagForbidden,
+			},
+			want: http.StatusForbidden,
+		},
+		{
+			name: "UseCase Error - Segment Not Found",
+			args: args{
+				code: errorcode.ErrSegmentNotFound,
+			},
+			want: http.StatusNotFound,
+		},
+		{
+			name: "UseCase Error - Segment Already Exists",
+			args: args{
+				code: errorcode.ErrSegmentAlreadyExists,
+			},
+			want: http.StatusConflict,
+		},
+		{
+			name: "UseCase Error - Segment Invalid",
+			args: args{
+				code: errorcode.ErrSegmentInvalid,
+			},
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "UseCase Error - Invalid Preference",
+			args: args{
+				code: errorcode.ErrMemberInvalidPreference,
+			},
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "UseCase Error - Concurrent Update",
+			args: args{
+				code: errorcode.ErrMemberConcurrentUpdate,
+			},
+			want: http.StatusConflict,
+		},
 		{
 			name: "UseCase Error - No Effect",
 			args: args{
@@ -86,6 +254,27 @@ func TestMapErrorCodeToHTTPStatus(t *testing.T) {
 			},
 			want: http.StatusConflict,
 		},
+		{
+			name: "UseCase Error - Reference Conflict",
+			args: args{
+				code: errorcode.ErrMemberReferenceConflict,
+			},
+			want: http.StatusConflict,
+		},
+		{
+			name: "UseCase Error - Constraint Violation",
+			args: args{
+				code: errorcode.ErrMemberConstraintViolation,
+			},
+			want: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "UseCase Error - Storage Unavailable",
+			args: args{
+				code: errorcode.ErrMemberStorageUnavailable,
+			},
+			want: http.StatusServiceUnavailable,
+		},
 		{
 			name: "UseCase Error - Unexpected Error",
 			args: args{
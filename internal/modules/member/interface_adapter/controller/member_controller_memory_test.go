@@ -0,0 +1,119 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxtx"
+	ginadapter "github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/adapter"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/memory/mcmemory"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/gateway/repository"
+	presenter "github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/presenter/http"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/validation"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase"
+	usecasemock "github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/mock"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
+	mocklogger "github.com/tomoffice/go-clean-architecture/pkg/logger/mock"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer/adapters/basic"
+)
+
+// newMemoryMemberController 以記憶體 DAO 組出真正的 gateway、use case、presenter 與 validator，
+// 只有 outbox 以 mock 控制成功或失敗；交易由 SQLite 記憶體資料庫的 sqlxtx 管理
+func newMemoryMemberController(t *testing.T, eventOutbox output.EventOutbox) *MemberController {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockLogger := mocklogger.NewMockLogger(ctrl)
+	mockLogger.EXPECT().With(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+	tr := basic.NewTracer(basic.NewConfig("test", false))
+
+	db := sqlx.MustOpen("sqlite3", ":memory:")
+	t.Cleanup(func() { _ = db.Close() })
+	dao, err := mcmemory.NewMemoryMember("", mockLogger, tr)
+	require.NoError(t, err)
+	useCase := usecase.NewMemberUseCase(usecase.Dependencies{
+		Members:         repository.NewMemberRepoGateway(dao, mockLogger, tr),
+		TxManager:       sqlxtx.NewTxManager(db),
+		EventOutbox:     eventOutbox,
+		EmailNormalizer: entity.NewEmailNormalizer(nil, nil, nil),
+	}, usecase.Options{
+		PasswordPolicy: entity.PasswordPolicy{MinLength: 8, MaxLength: 72},
+	}, mockLogger, tr)
+	return NewMemberController(useCase, presenter.NewMemberPresenter(), validation.NewMemberValidator(), 0, mockLogger, tr)
+}
+
+func registerMemoryMember(t *testing.T, c *MemberController, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	ginCtx, responseWriter := GinCtxHelper(t)
+	ginCtx.Request = httptest.NewRequest(http.MethodPost, "/api/v1/members", strings.NewReader(body))
+	ginCtx.Request.Header.Set("Content-Type", "application/json")
+	c.Register(ginadapter.NewContext(ginCtx))
+	return responseWriter
+}
+
+func getMemoryMember(t *testing.T, c *MemberController, id string) *httptest.ResponseRecorder {
+	t.Helper()
+	ginCtx, responseWriter := GinCtxHelper(t)
+	ginCtx.Params = gin.Params{gin.Param{Key: "id", Value: id}}
+	ginCtx.Request = httptest.NewRequest(http.MethodGet, "/api/v1/members/"+id, nil)
+	c.GetByID(ginadapter.NewContext(ginCtx))
+	return responseWriter
+}
+
+func TestMemberController_WithMemoryDAO(t *testing.T) {
+	body := `{"name":"alice","email":"Alice@Example.com","password":"Passw0rd!23"}`
+
+	t.Run("register then get", func(t *testing.T) {
+		eventOutbox := usecasemock.NewMockEventOutbox(gomock.NewController(t))
+		eventOutbox.EXPECT().Add(gomock.Any(), gomock.Any()).Return(nil)
+		c := newMemoryMemberController(t, eventOutbox)
+
+		resp := registerMemoryMember(t, c, body)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+
+		resp = getMemoryMember(t, c, "1")
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		var got struct {
+			Data struct {
+				ID    int    `json:"id"`
+				Name  string `json:"name"`
+				Email string `json:"email"`
+			} `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &got))
+		assert.Equal(t, 1, got.Data.ID)
+		assert.Equal(t, "alice", got.Data.Name)
+		// Email 網域一律轉成小寫
+		assert.Equal(t, "Alice@example.com", got.Data.Email)
+
+		// 正規化後相同的 Email 視為重複
+		resp = registerMemoryMember(t, c, `{"name":"alice2","email":"alice@example.com","password":"Passw0rd!23"}`)
+		assert.Equal(t, http.StatusConflict, resp.Code, resp.Body.String())
+	})
+
+	t.Run("outbox failure rolls back the memory write", func(t *testing.T) {
+		eventOutbox := usecasemock.NewMockEventOutbox(gomock.NewController(t))
+		eventOutbox.EXPECT().Add(gomock.Any(), gomock.Any()).Return(usecase.ErrMemberEventOutboxError)
+		c := newMemoryMemberController(t, eventOutbox)
+
+		resp := registerMemoryMember(t, c, body)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code, resp.Body.String())
+
+		resp = getMemoryMember(t, c, "1")
+		assert.Equal(t, http.StatusNotFound, resp.Code, resp.Body.String())
+	})
+}
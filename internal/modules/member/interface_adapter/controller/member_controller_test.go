@@ -1,12 +1,14 @@
 package controller
 
 import (
+	"bufio"
 	"context"
 	"encoding/json"
 	"errors"
 	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	ginadapter "github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/adapter"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/controller/mock"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/dto"
@@ -449,7 +451,7 @@ func TestMemberController_Delete(t *testing.T) {
 			ginCtx, responseWriter := GinCtxHelper(t)
 			tt.setupPort(mockUseCase, mockPresenter, mockValidator)
 			tt.setupGinCtx(ginCtx)
-			c.Delete(ginCtx)
+			c.Delete(ginadapter.NewContext(ginCtx))
 			response := responseWriter.Body.String()
 			gotStatus := responseWriter.Code
 			t.Logf("\n\tgotStatus:%d,wantStatus:%d", gotStatus, tt.wantStatus)
@@ -779,7 +781,7 @@ func TestMemberController_GetByEmail(t *testing.T) {
 			ginCtx, responseWriter := GinCtxHelper(t)
 			tt.setupGinCtx(ginCtx)
 			tt.setupPort(mockUseCase, mockPresenter, mockValidator)
-			c.GetByEmail(ginCtx)
+			c.GetByEmail(ginadapter.NewContext(ginCtx))
 			response := responseWriter.Body.String()
 			gotStatus := responseWriter.Code
 			t.Logf("\n\tgotStatus:%d,wantStatus:%d", gotStatus, tt.wantStatus)
@@ -1078,7 +1080,7 @@ func TestMemberController_GetByID(t *testing.T) {
 			ginCtx, responseWriter := GinCtxHelper(t)
 			tt.setupGinCtx(ginCtx)
 			tt.setupPort(mockUseCase, mockPresenter, mockValidator)
-			c.GetByID(ginCtx)
+			c.GetByID(ginadapter.NewContext(ginCtx))
 			response := responseWriter.Body.String()
 			gotStatus := responseWriter.Code
 			t.Logf("\n\tgotStatus:%d,wantStatus:%d", gotStatus, tt.wantStatus)
@@ -1178,7 +1180,7 @@ func TestMemberController_List(t *testing.T) {
 						Email: member.Email,
 					})
 				}
-				uc.EXPECT().ListMembers(gomock.Any(), gomock.Any()).Return(
+				uc.EXPECT().ListMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return(
 					members, len(members), nil)
 				p.EXPECT().PresentListMembers(gomock.Any(), gomock.Any()).Return(
 					outputmodel.ListMemberResponse{
@@ -1330,7 +1332,7 @@ func TestMemberController_List(t *testing.T) {
 			},
 			setupPort: func(uc *mock.MockMemberInputPort, p *mock.MockMemberPresenter, v *mock.MockValidator, testArgs testPagination) {
 				v.EXPECT().ValidateListMember(gomock.Any()).Return(nil)
-				uc.EXPECT().ListMembers(gomock.Any(), gomock.Any()).Return(nil, 0, usecase.ErrMemberDBError)
+				uc.EXPECT().ListMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, 0, usecase.ErrMemberDBError)
 				p.EXPECT().PresentUseCaseError(gomock.Any()).Return(
 					errorcode.ErrMemberDBError,
 					outputmodel.ErrorResponse{
@@ -1380,7 +1382,7 @@ func TestMemberController_List(t *testing.T) {
 			ginCtx, responseWriter := GinCtxHelper(t)
 			tt.setupGinCtx(ginCtx, tt.setupPagination)
 			tt.setupPort(mockUseCase, mockPresenter, mockValidator, tt.setupPagination)
-			c.List(ginCtx)
+			c.List(ginadapter.NewContext(ginCtx))
 			response := responseWriter.Body.String()
 			gotStatus := responseWriter.Code
 			t.Logf("\n\tgotStatus:%d,wantStatus:%d", gotStatus, tt.wantStatus)
@@ -1449,7 +1451,7 @@ func TestMemberController_Register(t *testing.T) {
 			},
 			setupPort: func(uc *mock.MockMemberInputPort, p *mock.MockMemberPresenter, v *mock.MockValidator) {
 				v.EXPECT().ValidateRegisterMember(gomock.Any()).Return(nil)
-				uc.EXPECT().RegisterMember(gomock.Any(), gomock.Any()).Return(
+				uc.EXPECT().RegisterMember(gomock.Any(), gomock.Any(), gomock.Any()).Return(
 					&entity.Member{
 						ID:        1,
 						Name:      "test",
@@ -1586,7 +1588,7 @@ func TestMemberController_Register(t *testing.T) {
 			},
 			setupPort: func(uc *mock.MockMemberInputPort, p *mock.MockMemberPresenter, v *mock.MockValidator) {
 				v.EXPECT().ValidateRegisterMember(gomock.Any()).Return(nil)
-				uc.EXPECT().RegisterMember(gomock.Any(), gomock.Any()).Return(
+				uc.EXPECT().RegisterMember(gomock.Any(), gomock.Any(), gomock.Any()).Return(
 					nil,
 					usecase.ErrMemberAlreadyExists,
 				)
@@ -1634,7 +1636,7 @@ func TestMemberController_Register(t *testing.T) {
 			},
 			setupPort: func(uc *mock.MockMemberInputPort, p *mock.MockMemberPresenter, v *mock.MockValidator) {
 				v.EXPECT().ValidateRegisterMember(gomock.Any()).Return(nil)
-				uc.EXPECT().RegisterMember(gomock.Any(), gomock.Any()).Return(
+				uc.EXPECT().RegisterMember(gomock.Any(), gomock.Any(), gomock.Any()).Return(
 					nil,
 					usecase.ErrMemberDBError,
 				)
@@ -1683,7 +1685,7 @@ func TestMemberController_Register(t *testing.T) {
 			},
 			setupPort: func(uc *mock.MockMemberInputPort, p *mock.MockMemberPresenter, v *mock.MockValidator) {
 				v.EXPECT().ValidateRegisterMember(gomock.Any()).Return(nil)
-				uc.EXPECT().RegisterMember(gomock.Any(), gomock.Any()).Return(
+				uc.EXPECT().RegisterMember(gomock.Any(), gomock.Any(), gomock.Any()).Return(
 					nil,
 					usecase.ErrMemberNotFound,
 				)
@@ -1735,7 +1737,7 @@ func TestMemberController_Register(t *testing.T) {
 			ginCtx, responseWriter := GinCtxHelper(t)
 			tt.setupGinCtx(ginCtx)
 			tt.setupPort(mockUseCase, mockPresenter, mockValidator)
-			c.Register(ginCtx)
+			c.Register(ginadapter.NewContext(ginCtx))
 			response := responseWriter.Body.String()
 			gotStatus := responseWriter.Code
 			t.Logf("\n\tgotStatus:%d,wantStatus:%d", gotStatus, tt.wantStatus)
@@ -2196,7 +2198,7 @@ func TestMemberController_UpdateProfile(t *testing.T) {
 			ginCtx, responseWriter := GinCtxHelper(t)
 			tt.setupGinCtx(ginCtx)
 			tt.setupPort(mockUseCase, mockPresenter, mockValidator)
-			c.UpdateProfile(ginCtx)
+			c.UpdateProfile(ginadapter.NewContext(ginCtx))
 			response := responseWriter.Body.String()
 			gotStatus := responseWriter.Code
 			t.Logf("\n\tgotStatus:%d,wantStatus:%d", gotStatus, tt.wantStatus)
@@ -2857,7 +2859,7 @@ func TestMemberController_UpdateEmail(t *testing.T) {
 			ginCtx, responseWriter := GinCtxHelper(t)
 			tt.setupGinCtx(ginCtx)
 			tt.setupPort(mockUseCase, mockPresenter, mockValidator)
-			c.UpdateEmail(ginCtx)
+			c.UpdateEmail(ginadapter.NewContext(ginCtx))
 			response := responseWriter.Body.String()
 			gotStatus := responseWriter.Code
 			t.Logf("\n\tgotStatus:%d,wantStatus:%d", gotStatus, tt.wantStatus)
@@ -3425,7 +3427,7 @@ func TestMemberController_UpdatePassword(t *testing.T) {
 			ginCtx, responseWriter := GinCtxHelper(t)
 			tt.setupGinCtx(ginCtx)
 			tt.setupPort(mockUseCase, mockPresenter, mockValidator)
-			c.UpdatePassword(ginCtx)
+			c.UpdatePassword(ginadapter.NewContext(ginCtx))
 			response := responseWriter.Body.String()
 			gotStatus := responseWriter.Code
 			t.Logf("\n\tgotStatus:%d,wantStatus:%d", gotStatus, tt.wantStatus)
@@ -3454,6 +3456,157 @@ func TestMemberController_UpdatePassword(t *testing.T) {
 	}
 }
 
+func TestMemberController_Stream_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		setupPort  func(*mock.MockMemberInputPort, *mock.MockMemberPresenter, *mock.MockValidator)
+		wantStatus int
+	}{
+		{
+			name:  "validation error",
+			query: "types=member.unknown",
+			setupPort: func(u *mock.MockMemberInputPort, p *mock.MockMemberPresenter, v *mock.MockValidator) {
+				v.EXPECT().ValidateStreamMemberChanges(dto.StreamMemberChangesRequestDTO{Types: []string{"member.unknown"}}).Return(errors.New("invalid type"))
+				p.EXPECT().PresentValidationError(gomock.Any()).Return(errorcode.ErrValidationFailed, outputmodel.ErrorResponse{})
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "stream unavailable",
+			query: "",
+			setupPort: func(u *mock.MockMemberInputPort, p *mock.MockMemberPresenter, v *mock.MockValidator) {
+				v.EXPECT().ValidateStreamMemberChanges(gomock.Any()).Return(nil)
+				u.EXPECT().StreamMemberChanges(gomock.Any(), gomock.Any()).Return(nil, usecase.ErrMemberChangeStreamUnavailable)
+				p.EXPECT().PresentUseCaseError(usecase.ErrMemberChangeStreamUnavailable).Return(errorcode.ErrMemberChangeStreamUnavailable, outputmodel.ErrorResponse{})
+			},
+			wantStatus: http.StatusServiceUnavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUseCase := mock.NewMockMemberInputPort(ctrl)
+			mockPresenter := mock.NewMockMemberPresenter(ctrl)
+			mockValidator := mock.NewMockValidator(ctrl)
+			mockLogger := mocklogger.NewMockLogger(ctrl)
+			mockTracer := mocktracer.NewMockTracer(ctrl)
+			setupDefaultMockExpectations(ctrl, mockLogger, mockTracer)
+
+			c := &MemberController{
+				usecase:         mockUseCase,
+				presenter:       mockPresenter,
+				dtoValidator:    mockValidator,
+				streamHeartbeat: time.Minute,
+				logger:          mockLogger,
+				tracer:          mockTracer,
+			}
+			ginCtx, responseWriter := GinCtxHelper(t)
+			ginCtx.Request = httptest.NewRequest(http.MethodGet, "/api/v1/members/stream?"+tt.query, nil)
+			tt.setupPort(mockUseCase, mockPresenter, mockValidator)
+			c.Stream(ginadapter.NewContext(ginCtx))
+			assert.Equal(t, tt.wantStatus, responseWriter.Code)
+		})
+	}
+}
+
+func TestMemberController_Stream(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUseCase := mock.NewMockMemberInputPort(ctrl)
+	mockPresenter := mock.NewMockMemberPresenter(ctrl)
+	mockValidator := mock.NewMockValidator(ctrl)
+	mockLogger := mocklogger.NewMockLogger(ctrl)
+	mockTracer := mocktracer.NewMockTracer(ctrl)
+	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+	mockSpan := mocktracer.NewMockSpan(ctrl)
+	mockSpan.EXPECT().End().AnyTimes()
+	// 串流需沿用 request context 才能偵測斷線
+	mockTracer.EXPECT().Start(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, _ string) (context.Context, *mocktracer.MockSpan) {
+		return ctx, mockSpan
+	}).AnyTimes()
+
+	events := make(chan output.ChangeEvent, 1)
+	closed := make(chan struct{})
+	member := &entity.Member{ID: 7, Name: "gg", Email: "gg@gmail.com"}
+	replayed := output.ChangeEvent{ID: "6", MemberChange: output.MemberChange{Type: output.ChangeTypeCreated, Member: member}}
+	live := output.ChangeEvent{ID: "7", MemberChange: output.MemberChange{Type: output.ChangeTypeDeleted, Member: member}}
+
+	mockValidator.EXPECT().ValidateStreamMemberChanges(dto.StreamMemberChangesRequestDTO{
+		Types:       []string{"member.created", "member.deleted"},
+		LastEventID: "5",
+	}).Return(nil)
+	mockUseCase.EXPECT().StreamMemberChanges(gomock.Any(), gomock.Any()).Return(&output.ChangeSubscription{
+		Replay: []output.ChangeEvent{replayed},
+		Gap:    true,
+		Events: events,
+		Close:  func() { close(closed) },
+	}, nil)
+	mockPresenter.EXPECT().PresentMemberChangeEvent(gomock.Any()).DoAndReturn(func(event output.ChangeEvent) outputmodel.MemberChangeEventResponse {
+		return dto.MemberChangeEventResponseDTO{Type: string(event.Type), Member: dto.MemberChangeItemDTO{ID: event.Member.ID}}
+	}).Times(2)
+
+	c := &MemberController{
+		usecase:         mockUseCase,
+		presenter:       mockPresenter,
+		dtoValidator:    mockValidator,
+		streamHeartbeat: 20 * time.Millisecond,
+		logger:          mockLogger,
+		tracer:          mockTracer,
+	}
+	engine := gin.New()
+	engine.GET("/members/stream", func(ginCtx *gin.Context) { c.Stream(ginadapter.NewContext(ginCtx)) })
+	server := httptest.NewServer(engine)
+	defer server.Close()
+
+	reqCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	req, _ := http.NewRequestWithContext(reqCtx, http.MethodGet, server.URL+"/members/stream?types=member.created,member.deleted", nil)
+	req.Header.Set("Last-Event-ID", "5")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	reader := bufio.NewReader(resp.Body)
+	readFrame := func() string {
+		var lines []string
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				t.Fatalf("read stream failed: %v", err)
+			}
+			if line == "\n" {
+				return strings.Join(lines, "")
+			}
+			lines = append(lines, line)
+		}
+	}
+
+	assert.Equal(t, "retry: 3000\n", readFrame())
+	assert.Equal(t, "event: reset\ndata: {}\n", readFrame())
+	assert.Equal(t, "id: 6\nevent: member.created\ndata: {\"type\":\"member.created\",\"member\":{\"id\":7,\"name\":\"\",\"email\":\"\",\"created_at\":\"\"},\"occurred_at\":\"\"}\n", readFrame())
+	events <- live
+	frame := readFrame()
+	for frame == ": heartbeat\n" {
+		frame = readFrame()
+	}
+	assert.Contains(t, frame, "id: 7\nevent: member.deleted\n")
+	assert.Equal(t, ": heartbeat\n", readFrame())
+
+	// 客戶端斷線後訂閱必須被釋放
+	cancel()
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		t.Fatal("subscription was not closed after client disconnect")
+	}
+}
+
 func TestNewMemberController(t *testing.T) {
 	ctrl, _ := portHelper(t)
 	usecaseGateway := mock.NewMockMemberInputPort(ctrl)
@@ -3465,10 +3618,11 @@ func TestNewMemberController(t *testing.T) {
 	// 設置預期的 logger.With 調用
 	mockLogger.EXPECT().With(gomock.Any()).Return(mockLogger).Times(1)
 
-	got := NewMemberController(usecaseGateway, presenterGateway, mockValidator, mockLogger, mockTracer)
+	got := NewMemberController(usecaseGateway, presenterGateway, mockValidator, 0, mockLogger, mockTracer)
 	assert.NotNil(t, got)
 	assert.Equal(t, usecaseGateway, got.usecase)
 	assert.Equal(t, presenterGateway, got.presenter)
+	assert.Equal(t, DefaultStreamHeartbeat, got.streamHeartbeat)
 }
 func portHelper(t *testing.T) (*gomock.Controller, time.Time) {
 	t.Helper()
@@ -3505,3 +3659,209 @@ func setupDefaultMockExpectations(ctrl *gomock.Controller, mockLogger *mocklogge
 
 	return mockSpan
 }
+
+func TestMemberController_PersonalData(t *testing.T) {
+	testTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	archive := &output.PersonalDataArchive{
+		Member:     &entity.Member{ID: 1, Name: "test", Email: "test@gmail.com", CreatedAt: testTime},
+		ExportedAt: testTime,
+	}
+	erasure := &output.ErasureResult{
+		Member:               &entity.Member{ID: 1, Name: entity.AnonymizedName, Email: "erased-1-x@anonymized.invalid"},
+		RedactedAuditRecords: 2,
+		ErasedAt:             testTime,
+	}
+	tests := []struct {
+		name       string
+		method     string
+		id         string
+		setupPort  func(*mock.MockMemberInputPort, *mock.MockMemberPresenter, *mock.MockValidator)
+		wantStatus int
+		wantHeader map[string]string
+	}{
+		{
+			name:   "export success",
+			method: http.MethodGet,
+			id:     "1",
+			setupPort: func(u *mock.MockMemberInputPort, p *mock.MockMemberPresenter, v *mock.MockValidator) {
+				v.EXPECT().ValidatePersonalData(dto.PersonalDataRequestDTO{ID: 1}).Return(nil)
+				u.EXPECT().ExportPersonalData(gomock.Any(), 1).Return(archive, nil)
+				p.EXPECT().PresentExportPersonalData(archive).Return(outputmodel.ExportPersonalDataResponse{})
+			},
+			wantStatus: http.StatusOK,
+			wantHeader: map[string]string{
+				"Cache-Control":       "no-store",
+				"Content-Disposition": `attachment; filename="member-1-personal-data.json"`,
+			},
+		},
+		{
+			name:   "export forbidden",
+			method: http.MethodGet,
+			id:     "1",
+			setupPort: func(u *mock.MockMemberInputPort, p *mock.MockMemberPresenter, v *mock.MockValidator) {
+				v.EXPECT().ValidatePersonalData(gomock.Any()).Return(nil)
+				u.EXPECT().ExportPersonalData(gomock.Any(), 1).Return(nil, usecase.ErrMemberPrivacyForbidden)
+				p.EXPECT().PresentUseCaseError(usecase.ErrMemberPrivacyForbidden).Return(errorcode.ErrMemberPrivacyForbidden, outputmodel.ErrorResponse{})
+			},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:   "export binding error",
+			method: http.MethodGet,
+			id:     "abc",
+			setupPort: func(u *mock.MockMemberInputPort, p *mock.MockMemberPresenter, v *mock.MockValidator) {
+				p.EXPECT().PresentBindingError(gomock.Any(), gomock.Any()).Return(outputmodel.ErrorResponse{})
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "erase success",
+			method: http.MethodPost,
+			id:     "1",
+			setupPort: func(u *mock.MockMemberInputPort, p *mock.MockMemberPresenter, v *mock.MockValidator) {
+				v.EXPECT().ValidatePersonalData(dto.PersonalDataRequestDTO{ID: 1}).Return(nil)
+				u.EXPECT().ErasePersonalData(gomock.Any(), 1).Return(erasure, nil)
+				p.EXPECT().PresentErasePersonalData(erasure).Return(outputmodel.ErasePersonalDataResponse{})
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:   "erase validation error",
+			method: http.MethodPost,
+			id:     "-1",
+			setupPort: func(u *mock.MockMemberInputPort, p *mock.MockMemberPresenter, v *mock.MockValidator) {
+				v.EXPECT().ValidatePersonalData(dto.PersonalDataRequestDTO{ID: -1}).Return(errors.New("validation error"))
+				p.EXPECT().PresentValidationError(gomock.Any()).Return(errorcode.ErrValidationFailed, outputmodel.ErrorResponse{})
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "erase member not found",
+			method: http.MethodPost,
+			id:     "1",
+			setupPort: func(u *mock.MockMemberInputPort, p *mock.MockMemberPresenter, v *mock.MockValidator) {
+				v.EXPECT().ValidatePersonalData(gomock.Any()).Return(nil)
+				u.EXPECT().ErasePersonalData(gomock.Any(), 1).Return(nil, usecase.ErrMemberNotFound)
+				p.EXPECT().PresentUseCaseError(usecase.ErrMemberNotFound).Return(errorcode.ErrMemberNotFound, outputmodel.ErrorResponse{})
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUseCase := mock.NewMockMemberInputPort(ctrl)
+			mockPresenter := mock.NewMockMemberPresenter(ctrl)
+			mockValidator := mock.NewMockValidator(ctrl)
+			mockLogger := mocklogger.NewMockLogger(ctrl)
+			mockTracer := mocktracer.NewMockTracer(ctrl)
+			setupDefaultMockExpectations(ctrl, mockLogger, mockTracer)
+
+			c := &MemberController{
+				usecase:      mockUseCase,
+				presenter:    mockPresenter,
+				dtoValidator: mockValidator,
+				logger:       mockLogger,
+				tracer:       mockTracer,
+			}
+			ginCtx, responseWriter := GinCtxHelper(t)
+			ginCtx.Params = gin.Params{gin.Param{Key: "id", Value: tt.id}}
+			tt.setupPort(mockUseCase, mockPresenter, mockValidator)
+			if tt.method == http.MethodGet {
+				ginCtx.Request = httptest.NewRequest(tt.method, "/api/v1/members/"+tt.id+"/personal-data", nil)
+				c.ExportPersonalData(ginadapter.NewContext(ginCtx))
+			} else {
+				ginCtx.Request = httptest.NewRequest(tt.method, "/api/v1/members/"+tt.id+"/erase", nil)
+				c.ErasePersonalData(ginadapter.NewContext(ginCtx))
+			}
+			assert.Equal(t, tt.wantStatus, responseWriter.Code)
+			for key, want := range tt.wantHeader {
+				assert.Equal(t, want, responseWriter.Header().Get(key))
+			}
+		})
+	}
+}
+
+func TestMemberController_IssueToken(t *testing.T) {
+	token := &output.AccessToken{Token: "signed", ExpiresAt: time.Date(2025, 1, 1, 1, 0, 0, 0, time.UTC)}
+	reqDTO := dto.IssueMemberTokenRequestDTO{Email: "test@gmail.com", Password: "secret"}
+	tests := []struct {
+		name       string
+		body       string
+		setupPort  func(*mock.MockMemberInputPort, *mock.MockMemberPresenter, *mock.MockValidator)
+		wantStatus int
+	}{
+		{
+			name: "success",
+			body: `{"email":"test@gmail.com","password":"secret"}`,
+			setupPort: func(u *mock.MockMemberInputPort, p *mock.MockMemberPresenter, v *mock.MockValidator) {
+				v.EXPECT().ValidateIssueMemberToken(reqDTO).Return(nil)
+				u.EXPECT().IssueMemberToken(gomock.Any(), "test@gmail.com", "secret").Return(token, nil)
+				p.EXPECT().PresentIssueMemberToken(token).Return(outputmodel.IssueMemberTokenResponse{})
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "binding error",
+			body: `{"email":"test@gmail.com"}`,
+			setupPort: func(u *mock.MockMemberInputPort, p *mock.MockMemberPresenter, v *mock.MockValidator) {
+				p.EXPECT().PresentBindingError(gomock.Any(), gomock.Any()).Return(outputmodel.ErrorResponse{})
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "validation error",
+			body: `{"email":"not-an-email","password":"secret"}`,
+			setupPort: func(u *mock.MockMemberInputPort, p *mock.MockMemberPresenter, v *mock.MockValidator) {
+				v.EXPECT().ValidateIssueMemberToken(gomock.Any()).Return(errors.New("validation error"))
+				p.EXPECT().PresentValidationError(gomock.Any()).Return(errorcode.ErrValidationFailed, outputmodel.ErrorResponse{})
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "invalid credentials",
+			body: `{"email":"test@gmail.com","password":"secret"}`,
+			setupPort: func(u *mock.MockMemberInputPort, p *mock.MockMemberPresenter, v *mock.MockValidator) {
+				v.EXPECT().ValidateIssueMemberToken(gomock.Any()).Return(nil)
+				u.EXPECT().IssueMemberToken(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, usecase.ErrMemberInvalidCredentials)
+				p.EXPECT().PresentUseCaseError(usecase.ErrMemberInvalidCredentials).Return(errorcode.ErrMemberInvalidCredentials, outputmodel.ErrorResponse{})
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "member not active",
+			body: `{"email":"test@gmail.com","password":"secret"}`,
+			setupPort: func(u *mock.MockMemberInputPort, p *mock.MockMemberPresenter, v *mock.MockValidator) {
+				v.EXPECT().ValidateIssueMemberToken(gomock.Any()).Return(nil)
+				u.EXPECT().IssueMemberToken(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, usecase.ErrMemberNotActive)
+				p.EXPECT().PresentUseCaseError(usecase.ErrMemberNotActive).Return(errorcode.ErrMemberNotActive, outputmodel.ErrorResponse{})
+			},
+			wantStatus: MapErrorCodeToHTTPStatus(errorcode.ErrMemberNotActive),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUseCase := mock.NewMockMemberInputPort(ctrl)
+			mockPresenter := mock.NewMockMemberPresenter(ctrl)
+			mockValidator := mock.NewMockValidator(ctrl)
+			mockLogger := mocklogger.NewMockLogger(ctrl)
+			mockTracer := mocktracer.NewMockTracer(ctrl)
+			setupDefaultMockExpectations(ctrl, mockLogger, mockTracer)
+
+			c := &MemberController{
+				usecase:      mockUseCase,
+				presenter:    mockPresenter,
+				dtoValidator: mockValidator,
+				logger:       mockLogger,
+				tracer:       mockTracer,
+			}
+			ginCtx, responseWriter := GinCtxHelper(t)
+			ginCtx.Request = httptest.NewRequest(http.MethodPost, "/api/v1/members/token", strings.NewReader(tt.body))
+			ginCtx.Request.Header.Set("Content-Type", "application/json")
+			tt.setupPort(mockUseCase, mockPresenter, mockValidator)
+			c.IssueToken(ginadapter.NewContext(ginCtx))
+			assert.Equal(t, tt.wantStatus, responseWriter.Code)
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package controller
+
+import (
+	memberhttp "github.com/tomoffice/go-clean-architecture/internal/interface_adapter/transport/http"
+	"net/http"
+
+	gindto "github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/dto"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/errordefs"
+	ginmapper "github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/mapper"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/mapper"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+)
+
+// CreateInvitation 建立註冊邀請碼，邀請碼只回傳給建立者與狀態管理者
+func (c *MemberController) CreateInvitation(ctx memberhttp.Context) {
+	// 創建帶有 context 的 logger 用於追蹤
+	requestCtx, contextLogger, span := createTracedLogger(ctx.RequestCtx(), c.tracer, c.logger)
+	defer span.End()
+
+	var ginReqDTO gindto.GinBindingCreateInvitationBodyRequestDTO
+	if err := ctx.BindJSON(&ginReqDTO); err != nil {
+		contextLogger.Error("邀請碼建立參數綁定錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("content_type", ctx.GetHeader("Content-Type")),
+		)
+		errCode, errMsg := errordefs.MapGinBindingError(err)
+		resp := c.presenter.PresentBindingError(errCode, errMsg)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	reqDTO := ginmapper.GinDTOToCreateInvitationDTO(ginReqDTO)
+	if err := c.dtoValidator.ValidateCreateInvitation(reqDTO); err != nil {
+		contextLogger.Error("邀請碼建立參數驗證錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("max_uses", ginReqDTO.MaxUses),
+		)
+		errCode, resp := c.presenter.PresentValidationError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	inputModel := mapper.CreateInvitationDTOToInputModel(reqDTO)
+	invitation, err := c.usecase.CreateInvitation(requestCtx, inputModel)
+	if err != nil {
+		contextLogger.Error("邀請碼建立 UseCase 執行錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("max_uses", inputModel.MaxUses),
+		)
+		errCode, resp := c.presenter.PresentUseCaseError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	resp := c.presenter.PresentCreateInvitation(invitation)
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (c *MemberController) ListInvitations(ctx memberhttp.Context) {
+	// 創建帶有 context 的 logger 用於追蹤
+	requestCtx, contextLogger, span := createTracedLogger(ctx.RequestCtx(), c.tracer, c.logger)
+	defer span.End()
+
+	var ginReqDTO gindto.GinBindingListInvitationsQueryRequestDTO
+	if err := ctx.BindQuery(&ginReqDTO); err != nil {
+		contextLogger.Error("邀請碼列表查詢參數綁定錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("query", ctx.Request().URL.RawQuery),
+		)
+		errCode, errMsg := errordefs.MapGinBindingError(err)
+		resp := c.presenter.PresentBindingError(errCode, errMsg)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	reqDTO := ginmapper.GinDTOToListInvitationsDTO(ginReqDTO)
+	if err := c.dtoValidator.ValidateListInvitations(reqDTO); err != nil {
+		contextLogger.Error("邀請碼列表查詢參數驗證錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("page", ginReqDTO.Page),
+			logger.NewField("limit", ginReqDTO.Limit),
+		)
+		errCode, resp := c.presenter.PresentValidationError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	pagination := mapper.ListInvitationsDTOToPagination(reqDTO)
+	invitations, total, err := c.usecase.ListInvitations(requestCtx, *pagination)
+	if err != nil {
+		contextLogger.Error("邀請碼列表查詢 UseCase 執行錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("offset", pagination.Offset),
+			logger.NewField("limit", pagination.Limit),
+		)
+		errCode, resp := c.presenter.PresentUseCaseError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	resp := c.presenter.PresentListInvitations(invitations, total)
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (c *MemberController) RevokeInvitation(ctx memberhttp.Context) {
+	// 創建帶有 context 的 logger 用於追蹤
+	requestCtx, contextLogger, span := createTracedLogger(ctx.RequestCtx(), c.tracer, c.logger)
+	defer span.End()
+
+	var ginReqDTO gindto.GinBindingInvitationURIRequestDTO
+	if err := ctx.BindURI(&ginReqDTO); err != nil {
+		contextLogger.Error("邀請碼撤銷參數綁定錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("uri", ctx.Request().RequestURI),
+		)
+		errCode, errMsg := errordefs.MapGinBindingError(err)
+		resp := c.presenter.PresentBindingError(errCode, errMsg)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	reqDTO := ginmapper.GinDTOToRevokeInvitationDTO(ginReqDTO)
+	if err := c.dtoValidator.ValidateRevokeInvitation(reqDTO); err != nil {
+		contextLogger.Error("邀請碼撤銷參數驗證錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("invitation_id", ginReqDTO.ID),
+		)
+		errCode, resp := c.presenter.PresentValidationError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	invitation, err := c.usecase.RevokeInvitation(requestCtx, reqDTO.ID)
+	if err != nil {
+		contextLogger.Error("邀請碼撤銷 UseCase 執行錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("invitation_id", reqDTO.ID),
+		)
+		errCode, resp := c.presenter.PresentUseCaseError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	resp := c.presenter.PresentRevokeInvitation(invitation)
+	ctx.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,112 @@
+package controller
+
+import (
+	memberhttp "github.com/tomoffice/go-clean-architecture/internal/interface_adapter/transport/http"
+	"net/http"
+
+	gindto "github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/dto"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/errordefs"
+	ginmapper "github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/mapper"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/mapper"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+)
+
+func (c *MemberController) GetPreferences(ctx memberhttp.Context) {
+	// 創建帶有 context 的 logger 用於追蹤
+	requestCtx, contextLogger, span := createTracedLogger(ctx.RequestCtx(), c.tracer, c.logger)
+	defer span.End()
+
+	var ginReqDTO gindto.GinBindingMemberPreferencesURIRequestDTO
+	if err := ctx.BindURI(&ginReqDTO); err != nil {
+		contextLogger.Error("會員偏好設定查詢參數綁定錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("uri", ctx.Request().RequestURI),
+		)
+		errCode, errMsg := errordefs.MapGinBindingError(err)
+		resp := c.presenter.PresentBindingError(errCode, errMsg)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	reqDTO := ginmapper.GinDTOToMemberPreferencesDTO(ginReqDTO)
+	if err := c.dtoValidator.ValidateMemberPreferences(reqDTO); err != nil {
+		contextLogger.Error("會員偏好設定查詢參數驗證錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("member_id", ginReqDTO.ID),
+		)
+		errCode, resp := c.presenter.PresentValidationError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	prefs, err := c.usecase.GetMemberPreferences(requestCtx, reqDTO.ID)
+	if err != nil {
+		contextLogger.Error("會員偏好設定查詢 UseCase 執行錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("member_id", reqDTO.ID),
+		)
+		errCode, resp := c.presenter.PresentUseCaseError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	resp := c.presenter.PresentMemberPreferences(prefs)
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// UpdatePreferences body 為 JSON merge patch（application/merge-patch+json 或 application/json）
+func (c *MemberController) UpdatePreferences(ctx memberhttp.Context) {
+	// 創建帶有 context 的 logger 用於追蹤
+	requestCtx, contextLogger, span := createTracedLogger(ctx.RequestCtx(), c.tracer, c.logger)
+	defer span.End()
+
+	var ginURI gindto.GinBindingMemberPreferencesURIRequestDTO
+	if err := ctx.BindURI(&ginURI); err != nil {
+		contextLogger.Error("會員偏好設定更新 URI 參數綁定錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("uri", ctx.Request().RequestURI),
+		)
+		errCode, errMsg := errordefs.MapGinBindingError(err)
+		resp := c.presenter.PresentBindingError(errCode, errMsg)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	var ginBody gindto.GinBindingPatchMemberPreferencesBodyRequestDTO
+	if err := ctx.BindJSON(&ginBody); err != nil {
+		contextLogger.Error("會員偏好設定更新 Body 參數綁定錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("content_type", ctx.GetHeader("Content-Type")),
+		)
+		errCode, errMsg := errordefs.MapGinBindingError(err)
+		resp := c.presenter.PresentBindingError(errCode, errMsg)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	reqDTO := ginmapper.GinDTOToPatchMemberPreferencesDTO(ginURI, ginBody)
+	if err := c.dtoValidator.ValidatePatchMemberPreferences(reqDTO); err != nil {
+		contextLogger.Error("會員偏好設定更新參數驗證錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("member_id", ginURI.ID),
+		)
+		errCode, resp := c.presenter.PresentValidationError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	inputModel := mapper.PatchMemberPreferencesDTOToInputModel(reqDTO)
+	prefs, err := c.usecase.UpdateMemberPreferences(requestCtx, inputModel)
+	if err != nil {
+		contextLogger.Error("會員偏好設定更新 UseCase 執行錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("member_id", inputModel.ID),
+		)
+		errCode, resp := c.presenter.PresentUseCaseError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	resp := c.presenter.PresentMemberPreferences(prefs)
+	ctx.JSON(http.StatusOK, resp)
+}
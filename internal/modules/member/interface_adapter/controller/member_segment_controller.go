@@ -0,0 +1,240 @@
+package controller
+
+import (
+	"fmt"
+	memberhttp "github.com/tomoffice/go-clean-architecture/internal/interface_adapter/transport/http"
+	"net/http"
+
+	gindto "github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/dto"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/errordefs"
+	ginmapper "github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/mapper"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/dto"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/mapper"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+)
+
+func (c *MemberController) CreateSegment(ctx memberhttp.Context) {
+	// 創建帶有 context 的 logger 用於追蹤
+	requestCtx, contextLogger, span := createTracedLogger(ctx.RequestCtx(), c.tracer, c.logger)
+	defer span.End()
+
+	var ginReqDTO gindto.GinBindingCreateSegmentBodyRequestDTO
+	if err := ctx.BindJSON(&ginReqDTO); err != nil {
+		contextLogger.Error("分群建立參數綁定錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("content_type", ctx.GetHeader("Content-Type")),
+		)
+		errCode, errMsg := errordefs.MapGinBindingError(err)
+		resp := c.presenter.PresentBindingError(errCode, errMsg)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	reqDTO := ginmapper.GinDTOToCreateSegmentDTO(ginReqDTO)
+	if err := c.dtoValidator.ValidateCreateSegment(reqDTO); err != nil {
+		contextLogger.Error("分群建立參數驗證錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("segment_name", ginReqDTO.Name),
+		)
+		errCode, resp := c.presenter.PresentValidationError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	inputModel := mapper.CreateSegmentDTOToInputModel(reqDTO)
+	segment, err := c.usecase.CreateSegment(requestCtx, inputModel)
+	if err != nil {
+		contextLogger.Error("分群建立 UseCase 執行錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("segment_name", inputModel.Name),
+		)
+		errCode, resp := c.presenter.PresentUseCaseError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	resp := c.presenter.PresentSegment(segment)
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (c *MemberController) ListSegments(ctx memberhttp.Context) {
+	// 創建帶有 context 的 logger 用於追蹤
+	requestCtx, contextLogger, span := createTracedLogger(ctx.RequestCtx(), c.tracer, c.logger)
+	defer span.End()
+
+	var ginReqDTO gindto.GinBindingListSegmentsQueryRequestDTO
+	if err := ctx.BindQuery(&ginReqDTO); err != nil {
+		contextLogger.Error("分群列表查詢參數綁定錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("query", ctx.Request().URL.RawQuery),
+		)
+		errCode, errMsg := errordefs.MapGinBindingError(err)
+		resp := c.presenter.PresentBindingError(errCode, errMsg)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	reqDTO := ginmapper.GinDTOToListSegmentsDTO(ginReqDTO)
+	if err := c.dtoValidator.ValidateListSegments(reqDTO); err != nil {
+		contextLogger.Error("分群列表查詢參數驗證錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("page", ginReqDTO.Page),
+			logger.NewField("limit", ginReqDTO.Limit),
+		)
+		errCode, resp := c.presenter.PresentValidationError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	pagination := mapper.ListSegmentsDTOToPagination(reqDTO)
+	segments, total, err := c.usecase.ListSegments(requestCtx, *pagination)
+	if err != nil {
+		contextLogger.Error("分群列表查詢 UseCase 執行錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("offset", pagination.Offset),
+			logger.NewField("limit", pagination.Limit),
+		)
+		errCode, resp := c.presenter.PresentUseCaseError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	resp := c.presenter.PresentListSegments(segments, total)
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// EvaluateSegment 依分群條件即時查詢會員，回應格式與會員列表相同
+func (c *MemberController) EvaluateSegment(ctx memberhttp.Context) {
+	// 創建帶有 context 的 logger 用於追蹤
+	requestCtx, contextLogger, span := createTracedLogger(ctx.RequestCtx(), c.tracer, c.logger)
+	defer span.End()
+
+	var ginURI gindto.GinBindingSegmentURIRequestDTO
+	if err := ctx.BindURI(&ginURI); err != nil {
+		contextLogger.Error("分群會員查詢 URI 參數綁定錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("uri", ctx.Request().RequestURI),
+		)
+		errCode, errMsg := errordefs.MapGinBindingError(err)
+		resp := c.presenter.PresentBindingError(errCode, errMsg)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	var ginQuery gindto.GinBindingListSegmentsQueryRequestDTO
+	if err := ctx.BindQuery(&ginQuery); err != nil {
+		contextLogger.Error("分群會員查詢參數綁定錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("query", ctx.Request().URL.RawQuery),
+		)
+		errCode, errMsg := errordefs.MapGinBindingError(err)
+		resp := c.presenter.PresentBindingError(errCode, errMsg)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	reqDTO := ginmapper.GinDTOToEvaluateSegmentDTO(ginURI, ginQuery)
+	if err := c.dtoValidator.ValidateEvaluateSegment(reqDTO); err != nil {
+		contextLogger.Error("分群會員查詢參數驗證錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("segment_id", ginURI.ID),
+		)
+		errCode, resp := c.presenter.PresentValidationError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	pagination := mapper.EvaluateSegmentDTOToPagination(reqDTO)
+	members, total, err := c.usecase.EvaluateSegment(requestCtx, reqDTO.ID, *pagination)
+	if err != nil {
+		contextLogger.Error("分群會員查詢 UseCase 執行錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("segment_id", reqDTO.ID),
+		)
+		errCode, resp := c.presenter.PresentUseCaseError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	resp := c.presenter.PresentListMembers(members, total)
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// ExportSegment 匯出分群目前的所有會員，以附件下載並禁止快取
+func (c *MemberController) ExportSegment(ctx memberhttp.Context) {
+	// 創建帶有 context 的 logger 用於追蹤
+	requestCtx, contextLogger, span := createTracedLogger(ctx.RequestCtx(), c.tracer, c.logger)
+	defer span.End()
+
+	reqDTO, ok := c.bindSegmentURI(ctx, contextLogger, "分群匯出")
+	if !ok {
+		return
+	}
+	export, err := c.usecase.ExportSegment(requestCtx, reqDTO.ID)
+	if err != nil {
+		contextLogger.Error("分群匯出 UseCase 執行錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("segment_id", reqDTO.ID),
+		)
+		errCode, resp := c.presenter.PresentUseCaseError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	resp := c.presenter.PresentExportSegment(export)
+	ctx.Header("Cache-Control", "no-store")
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="segment-%d-members.json"`, reqDTO.ID))
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (c *MemberController) DeleteSegment(ctx memberhttp.Context) {
+	// 創建帶有 context 的 logger 用於追蹤
+	requestCtx, contextLogger, span := createTracedLogger(ctx.RequestCtx(), c.tracer, c.logger)
+	defer span.End()
+
+	reqDTO, ok := c.bindSegmentURI(ctx, contextLogger, "分群刪除")
+	if !ok {
+		return
+	}
+	segment, err := c.usecase.DeleteSegment(requestCtx, reqDTO.ID)
+	if err != nil {
+		contextLogger.Error("分群刪除 UseCase 執行錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("segment_id", reqDTO.ID),
+		)
+		errCode, resp := c.presenter.PresentUseCaseError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	resp := c.presenter.PresentSegment(segment)
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// bindSegmentURI 綁定並驗證分群 ID，失敗時已寫入錯誤回應，action 只用於 log
+func (c *MemberController) bindSegmentURI(ctx memberhttp.Context, contextLogger logger.Logger, action string) (dto.SegmentRequestDTO, bool) {
+	var ginReqDTO gindto.GinBindingSegmentURIRequestDTO
+	if err := ctx.BindURI(&ginReqDTO); err != nil {
+		contextLogger.Error(action+"參數綁定錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("uri", ctx.Request().RequestURI),
+		)
+		errCode, errMsg := errordefs.MapGinBindingError(err)
+		resp := c.presenter.PresentBindingError(errCode, errMsg)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return dto.SegmentRequestDTO{}, false
+	}
+	reqDTO := ginmapper.GinDTOToSegmentDTO(ginReqDTO)
+	if err := c.dtoValidator.ValidateSegment(reqDTO); err != nil {
+		contextLogger.Error(action+"參數驗證錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("segment_id", ginReqDTO.ID),
+		)
+		errCode, resp := c.presenter.PresentValidationError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return dto.SegmentRequestDTO{}, false
+	}
+	return reqDTO, true
+}
@@ -0,0 +1,131 @@
+package controller
+
+import (
+	memberhttp "github.com/tomoffice/go-clean-architecture/internal/interface_adapter/transport/http"
+	"net/http"
+
+	gindto "github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/dto"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/errordefs"
+	ginmapper "github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/mapper"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/mapper"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+)
+
+// TagMember 為會員加上標籤，已有此標籤時同樣回傳 200
+func (c *MemberController) TagMember(ctx memberhttp.Context) {
+	c.changeMemberTag(ctx, "加標籤", false)
+}
+
+// UntagMember 移除會員的標籤，沒有此標籤時同樣回傳 200
+func (c *MemberController) UntagMember(ctx memberhttp.Context) {
+	c.changeMemberTag(ctx, "移除標籤", true)
+}
+
+// changeMemberTag 加上與移除標籤共用的流程，action 只用於 log
+func (c *MemberController) changeMemberTag(ctx memberhttp.Context, action string, remove bool) {
+	// 創建帶有 context 的 logger 用於追蹤
+	requestCtx, contextLogger, span := createTracedLogger(ctx.RequestCtx(), c.tracer, c.logger)
+	defer span.End()
+
+	var ginReqDTO gindto.GinBindingMemberTagURIRequestDTO
+	if err := ctx.BindURI(&ginReqDTO); err != nil {
+		contextLogger.Error("會員"+action+"參數綁定錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("uri", ctx.Request().RequestURI),
+		)
+		errCode, errMsg := errordefs.MapGinBindingError(err)
+		resp := c.presenter.PresentBindingError(errCode, errMsg)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	reqDTO := ginmapper.GinDTOToMemberTagDTO(ginReqDTO)
+	if err := c.dtoValidator.ValidateMemberTag(reqDTO); err != nil {
+		contextLogger.Error("會員"+action+"參數驗證錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("member_id", ginReqDTO.ID),
+			logger.NewField("tag", ginReqDTO.Tag),
+		)
+		errCode, resp := c.presenter.PresentValidationError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	changeTag := c.usecase.TagMember
+	if remove {
+		changeTag = c.usecase.UntagMember
+	}
+	member, err := changeTag(requestCtx, reqDTO.ID, reqDTO.Tag)
+	if err != nil {
+		contextLogger.Error("會員"+action+" UseCase 執行錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("member_id", reqDTO.ID),
+			logger.NewField("tag", reqDTO.Tag),
+		)
+		errCode, resp := c.presenter.PresentUseCaseError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	resp := c.presenter.PresentMemberTags(member)
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// BulkTagMembers 為多位會員加上同一個標籤，回傳實際新加上的數量
+func (c *MemberController) BulkTagMembers(ctx memberhttp.Context) {
+	// 創建帶有 context 的 logger 用於追蹤
+	requestCtx, contextLogger, span := createTracedLogger(ctx.RequestCtx(), c.tracer, c.logger)
+	defer span.End()
+
+	var ginURI gindto.GinBindingBulkTagURIRequestDTO
+	if err := ctx.BindURI(&ginURI); err != nil {
+		contextLogger.Error("會員批次加標籤 URI 參數綁定錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("uri", ctx.Request().RequestURI),
+		)
+		errCode, errMsg := errordefs.MapGinBindingError(err)
+		resp := c.presenter.PresentBindingError(errCode, errMsg)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	var ginBody gindto.GinBindingBulkTagBodyRequestDTO
+	if err := ctx.BindJSON(&ginBody); err != nil {
+		contextLogger.Error("會員批次加標籤 Body 參數綁定錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("content_type", ctx.GetHeader("Content-Type")),
+		)
+		errCode, errMsg := errordefs.MapGinBindingError(err)
+		resp := c.presenter.PresentBindingError(errCode, errMsg)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	reqDTO := ginmapper.GinDTOToBulkTagMembersDTO(ginURI, ginBody)
+	if err := c.dtoValidator.ValidateBulkTagMembers(reqDTO); err != nil {
+		contextLogger.Error("會員批次加標籤參數驗證錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("tag", ginURI.Tag),
+			logger.NewField("count", len(ginBody.MemberIDs)),
+		)
+		errCode, resp := c.presenter.PresentValidationError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	inputModel := mapper.BulkTagMembersDTOToInputModel(reqDTO)
+	tagged, err := c.usecase.BulkTagMembers(requestCtx, inputModel)
+	if err != nil {
+		contextLogger.Error("會員批次加標籤 UseCase 執行錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("tag", inputModel.Tag),
+			logger.NewField("count", len(inputModel.MemberIDs)),
+		)
+		errCode, resp := c.presenter.PresentUseCaseError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	resp := c.presenter.PresentBulkTagMembers(inputModel.Tag, len(inputModel.MemberIDs), tagged)
+	ctx.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,57 @@
+package controller
+
+import (
+	memberhttp "github.com/tomoffice/go-clean-architecture/internal/interface_adapter/transport/http"
+	"net/http"
+
+	gindto "github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/dto"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/errordefs"
+	ginmapper "github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/mapper"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+)
+
+// IssueToken 以 Email 與密碼換取 access token，只有可通過身分驗證的會員能取得
+func (c *MemberController) IssueToken(ctx memberhttp.Context) {
+	// 創建帶有 context 的 logger 用於追蹤
+	requestCtx, contextLogger, span := createTracedLogger(ctx.RequestCtx(), c.tracer, c.logger)
+	defer span.End()
+
+	var ginReqDTO gindto.GinBindingIssueMemberTokenBodyRequestDTO
+	if err := ctx.BindJSON(&ginReqDTO); err != nil {
+		contextLogger.Error("會員 token 簽發參數綁定錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("content_type", ctx.GetHeader("Content-Type")),
+		)
+		errCode, errMsg := errordefs.MapGinBindingError(err)
+		resp := c.presenter.PresentBindingError(errCode, errMsg)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	reqDTO := ginmapper.GinDTOToIssueMemberTokenDTO(ginReqDTO)
+	if err := c.dtoValidator.ValidateIssueMemberToken(reqDTO); err != nil {
+		// 不記錄密碼
+		contextLogger.Error("會員 token 簽發參數驗證錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("member_email", reqDTO.Email),
+		)
+		errCode, resp := c.presenter.PresentValidationError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	token, err := c.usecase.IssueMemberToken(requestCtx, reqDTO.Email, reqDTO.Password)
+	if err != nil {
+		errCode, resp := c.presenter.PresentUseCaseError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		contextLogger.Error("會員 token 簽發失敗",
+			logger.NewField("error", err),
+			logger.NewField("error_code", errCode),
+			logger.NewField("member_email", reqDTO.Email),
+		)
+		return
+	}
+	resp := c.presenter.PresentIssueMemberToken(token)
+	ctx.JSON(http.StatusOK, resp)
+}
@@ -6,6 +6,8 @@ package mock
 
 import (
 	context "context"
+	io "io"
+	http "net/http"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -114,32 +116,32 @@ func (mr *MockContextMockRecorder) JSON(code, body interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JSON", reflect.TypeOf((*MockContext)(nil).JSON), code, body)
 }
 
-// Param mocks base method.
-func (m *MockContext) Param(key string) string {
+// Request mocks base method.
+func (m *MockContext) Request() *http.Request {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Param", key)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "Request")
+	ret0, _ := ret[0].(*http.Request)
 	return ret0
 }
 
-// Param indicates an expected call of Param.
-func (mr *MockContextMockRecorder) Param(key interface{}) *gomock.Call {
+// Request indicates an expected call of Request.
+func (mr *MockContextMockRecorder) Request() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Param", reflect.TypeOf((*MockContext)(nil).Param), key)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Request", reflect.TypeOf((*MockContext)(nil).Request))
 }
 
-// RequestContext mocks base method.
-func (m *MockContext) RequestContext() context.Context {
+// RequestCtx mocks base method.
+func (m *MockContext) RequestCtx() context.Context {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RequestCtx")
 	ret0, _ := ret[0].(context.Context)
 	return ret0
 }
 
-// RequestContext indicates an expected call of RequestContext.
-func (mr *MockContextMockRecorder) RequestContext() *gomock.Call {
+// RequestCtx indicates an expected call of RequestCtx.
+func (mr *MockContextMockRecorder) RequestCtx() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestCtx", reflect.TypeOf((*MockContext)(nil).RequestContext))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestCtx", reflect.TypeOf((*MockContext)(nil).RequestCtx))
 }
 
 // Status mocks base method.
@@ -153,3 +155,17 @@ func (mr *MockContextMockRecorder) Status(code interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockContext)(nil).Status), code)
 }
+
+// Stream mocks base method.
+func (m *MockContext) Stream(step func(io.Writer) bool) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stream", step)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Stream indicates an expected call of Stream.
+func (mr *MockContextMockRecorder) Stream(step interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stream", reflect.TypeOf((*MockContext)(nil).Stream), step)
+}
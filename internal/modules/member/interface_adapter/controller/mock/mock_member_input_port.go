@@ -11,6 +11,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	entity "github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
 	inputmodel "github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/inputmodel"
+	output "github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
 	pagination "github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
 )
 
@@ -37,6 +38,96 @@ func (m *MockMemberInputPort) EXPECT() *MockMemberInputPortMockRecorder {
 	return m.recorder
 }
 
+// AuthenticateMember mocks base method.
+func (m *MockMemberInputPort) AuthenticateMember(ctx context.Context, id int) (*entity.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticateMember", ctx, id)
+	ret0, _ := ret[0].(*entity.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthenticateMember indicates an expected call of AuthenticateMember.
+func (mr *MockMemberInputPortMockRecorder) AuthenticateMember(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateMember", reflect.TypeOf((*MockMemberInputPort)(nil).AuthenticateMember), ctx, id)
+}
+
+// BackfillNormalizedEmails mocks base method.
+func (m *MockMemberInputPort) BackfillNormalizedEmails(ctx context.Context) (*output.EmailBackfillReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackfillNormalizedEmails", ctx)
+	ret0, _ := ret[0].(*output.EmailBackfillReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BackfillNormalizedEmails indicates an expected call of BackfillNormalizedEmails.
+func (mr *MockMemberInputPortMockRecorder) BackfillNormalizedEmails(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackfillNormalizedEmails", reflect.TypeOf((*MockMemberInputPort)(nil).BackfillNormalizedEmails), ctx)
+}
+
+// BulkTagMembers mocks base method.
+func (m *MockMemberInputPort) BulkTagMembers(ctx context.Context, input *inputmodel.BulkTagMembersInputModel) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkTagMembers", ctx, input)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkTagMembers indicates an expected call of BulkTagMembers.
+func (mr *MockMemberInputPortMockRecorder) BulkTagMembers(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkTagMembers", reflect.TypeOf((*MockMemberInputPort)(nil).BulkTagMembers), ctx, input)
+}
+
+// ChangeMemberStatus mocks base method.
+func (m *MockMemberInputPort) ChangeMemberStatus(ctx context.Context, input *inputmodel.ChangeMemberStatusInputModel) (*entity.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeMemberStatus", ctx, input)
+	ret0, _ := ret[0].(*entity.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeMemberStatus indicates an expected call of ChangeMemberStatus.
+func (mr *MockMemberInputPortMockRecorder) ChangeMemberStatus(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeMemberStatus", reflect.TypeOf((*MockMemberInputPort)(nil).ChangeMemberStatus), ctx, input)
+}
+
+// CreateInvitation mocks base method.
+func (m *MockMemberInputPort) CreateInvitation(ctx context.Context, input *inputmodel.CreateInvitationInputModel) (*entity.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvitation", ctx, input)
+	ret0, _ := ret[0].(*entity.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInvitation indicates an expected call of CreateInvitation.
+func (mr *MockMemberInputPortMockRecorder) CreateInvitation(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvitation", reflect.TypeOf((*MockMemberInputPort)(nil).CreateInvitation), ctx, input)
+}
+
+// CreateSegment mocks base method.
+func (m *MockMemberInputPort) CreateSegment(ctx context.Context, input *inputmodel.CreateSegmentInputModel) (*entity.Segment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSegment", ctx, input)
+	ret0, _ := ret[0].(*entity.Segment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSegment indicates an expected call of CreateSegment.
+func (mr *MockMemberInputPortMockRecorder) CreateSegment(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSegment", reflect.TypeOf((*MockMemberInputPort)(nil).CreateSegment), ctx, input)
+}
+
 // DeleteMember mocks base method.
 func (m *MockMemberInputPort) DeleteMember(ctx context.Context, id int) (*entity.Member, error) {
 	m.ctrl.T.Helper()
@@ -52,6 +143,82 @@ func (mr *MockMemberInputPortMockRecorder) DeleteMember(ctx, id interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMember", reflect.TypeOf((*MockMemberInputPort)(nil).DeleteMember), ctx, id)
 }
 
+// DeleteSegment mocks base method.
+func (m *MockMemberInputPort) DeleteSegment(ctx context.Context, id int) (*entity.Segment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSegment", ctx, id)
+	ret0, _ := ret[0].(*entity.Segment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSegment indicates an expected call of DeleteSegment.
+func (mr *MockMemberInputPortMockRecorder) DeleteSegment(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSegment", reflect.TypeOf((*MockMemberInputPort)(nil).DeleteSegment), ctx, id)
+}
+
+// ErasePersonalData mocks base method.
+func (m *MockMemberInputPort) ErasePersonalData(ctx context.Context, id int) (*output.ErasureResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ErasePersonalData", ctx, id)
+	ret0, _ := ret[0].(*output.ErasureResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ErasePersonalData indicates an expected call of ErasePersonalData.
+func (mr *MockMemberInputPortMockRecorder) ErasePersonalData(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ErasePersonalData", reflect.TypeOf((*MockMemberInputPort)(nil).ErasePersonalData), ctx, id)
+}
+
+// EvaluateSegment mocks base method.
+func (m *MockMemberInputPort) EvaluateSegment(ctx context.Context, id int, pagination pagination.Pagination) ([]*entity.Member, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EvaluateSegment", ctx, id, pagination)
+	ret0, _ := ret[0].([]*entity.Member)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// EvaluateSegment indicates an expected call of EvaluateSegment.
+func (mr *MockMemberInputPortMockRecorder) EvaluateSegment(ctx, id, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluateSegment", reflect.TypeOf((*MockMemberInputPort)(nil).EvaluateSegment), ctx, id, pagination)
+}
+
+// ExportPersonalData mocks base method.
+func (m *MockMemberInputPort) ExportPersonalData(ctx context.Context, id int) (*output.PersonalDataArchive, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportPersonalData", ctx, id)
+	ret0, _ := ret[0].(*output.PersonalDataArchive)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportPersonalData indicates an expected call of ExportPersonalData.
+func (mr *MockMemberInputPortMockRecorder) ExportPersonalData(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportPersonalData", reflect.TypeOf((*MockMemberInputPort)(nil).ExportPersonalData), ctx, id)
+}
+
+// ExportSegment mocks base method.
+func (m *MockMemberInputPort) ExportSegment(ctx context.Context, id int) (*output.SegmentExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportSegment", ctx, id)
+	ret0, _ := ret[0].(*output.SegmentExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportSegment indicates an expected call of ExportSegment.
+func (mr *MockMemberInputPortMockRecorder) ExportSegment(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportSegment", reflect.TypeOf((*MockMemberInputPort)(nil).ExportSegment), ctx, id)
+}
+
 // GetMemberByEmail mocks base method.
 func (m *MockMemberInputPort) GetMemberByEmail(ctx context.Context, email string) (*entity.Member, error) {
 	m.ctrl.T.Helper()
@@ -82,10 +249,56 @@ func (mr *MockMemberInputPortMockRecorder) GetMemberByID(ctx, id interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberByID", reflect.TypeOf((*MockMemberInputPort)(nil).GetMemberByID), ctx, id)
 }
 
+// GetMemberPreferences mocks base method.
+func (m *MockMemberInputPort) GetMemberPreferences(ctx context.Context, id int) (*entity.MemberPreferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMemberPreferences", ctx, id)
+	ret0, _ := ret[0].(*entity.MemberPreferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMemberPreferences indicates an expected call of GetMemberPreferences.
+func (mr *MockMemberInputPortMockRecorder) GetMemberPreferences(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberPreferences", reflect.TypeOf((*MockMemberInputPort)(nil).GetMemberPreferences), ctx, id)
+}
+
+// IssueMemberToken mocks base method.
+func (m *MockMemberInputPort) IssueMemberToken(ctx context.Context, email, password string) (*output.AccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueMemberToken", ctx, email, password)
+	ret0, _ := ret[0].(*output.AccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueMemberToken indicates an expected call of IssueMemberToken.
+func (mr *MockMemberInputPortMockRecorder) IssueMemberToken(ctx, email, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueMemberToken", reflect.TypeOf((*MockMemberInputPort)(nil).IssueMemberToken), ctx, email, password)
+}
+
+// ListInvitations mocks base method.
+func (m *MockMemberInputPort) ListInvitations(ctx context.Context, pagination pagination.Pagination) ([]*entity.Invitation, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInvitations", ctx, pagination)
+	ret0, _ := ret[0].([]*entity.Invitation)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListInvitations indicates an expected call of ListInvitations.
+func (mr *MockMemberInputPortMockRecorder) ListInvitations(ctx, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInvitations", reflect.TypeOf((*MockMemberInputPort)(nil).ListInvitations), ctx, pagination)
+}
+
 // ListMembers mocks base method.
-func (m *MockMemberInputPort) ListMembers(ctx context.Context, pagination pagination.Pagination) ([]*entity.Member, int, error) {
+func (m *MockMemberInputPort) ListMembers(ctx context.Context, input *inputmodel.ListMembersInputModel, pagination pagination.Pagination) ([]*entity.Member, int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListMembers", ctx, pagination)
+	ret := m.ctrl.Call(m, "ListMembers", ctx, input, pagination)
 	ret0, _ := ret[0].([]*entity.Member)
 	ret1, _ := ret[1].(int)
 	ret2, _ := ret[2].(error)
@@ -93,24 +306,115 @@ func (m *MockMemberInputPort) ListMembers(ctx context.Context, pagination pagina
 }
 
 // ListMembers indicates an expected call of ListMembers.
-func (mr *MockMemberInputPortMockRecorder) ListMembers(ctx, pagination interface{}) *gomock.Call {
+func (mr *MockMemberInputPortMockRecorder) ListMembers(ctx, input, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockMemberInputPort)(nil).ListMembers), ctx, input, pagination)
+}
+
+// ListSegments mocks base method.
+func (m *MockMemberInputPort) ListSegments(ctx context.Context, pagination pagination.Pagination) ([]*entity.Segment, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSegments", ctx, pagination)
+	ret0, _ := ret[0].([]*entity.Segment)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListSegments indicates an expected call of ListSegments.
+func (mr *MockMemberInputPortMockRecorder) ListSegments(ctx, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSegments", reflect.TypeOf((*MockMemberInputPort)(nil).ListSegments), ctx, pagination)
+}
+
+// MergeMembers mocks base method.
+func (m *MockMemberInputPort) MergeMembers(ctx context.Context, input *inputmodel.MergeMembersInputModel) (*output.MergeResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeMembers", ctx, input)
+	ret0, _ := ret[0].(*output.MergeResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeMembers indicates an expected call of MergeMembers.
+func (mr *MockMemberInputPortMockRecorder) MergeMembers(ctx, input interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockMemberInputPort)(nil).ListMembers), ctx, pagination)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeMembers", reflect.TypeOf((*MockMemberInputPort)(nil).MergeMembers), ctx, input)
 }
 
 // RegisterMember mocks base method.
-func (m *MockMemberInputPort) RegisterMember(ctx context.Context, member *entity.Member) (*entity.Member, error) {
+func (m *MockMemberInputPort) RegisterMember(ctx context.Context, member *entity.Member, invitationCode string) (*entity.Member, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RegisterMember", ctx, member)
+	ret := m.ctrl.Call(m, "RegisterMember", ctx, member, invitationCode)
 	ret0, _ := ret[0].(*entity.Member)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RegisterMember indicates an expected call of RegisterMember.
-func (mr *MockMemberInputPortMockRecorder) RegisterMember(ctx, member interface{}) *gomock.Call {
+func (mr *MockMemberInputPortMockRecorder) RegisterMember(ctx, member, invitationCode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterMember", reflect.TypeOf((*MockMemberInputPort)(nil).RegisterMember), ctx, member, invitationCode)
+}
+
+// RevokeInvitation mocks base method.
+func (m *MockMemberInputPort) RevokeInvitation(ctx context.Context, id int) (*entity.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeInvitation", ctx, id)
+	ret0, _ := ret[0].(*entity.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeInvitation indicates an expected call of RevokeInvitation.
+func (mr *MockMemberInputPortMockRecorder) RevokeInvitation(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeInvitation", reflect.TypeOf((*MockMemberInputPort)(nil).RevokeInvitation), ctx, id)
+}
+
+// StreamMemberChanges mocks base method.
+func (m *MockMemberInputPort) StreamMemberChanges(ctx context.Context, input *inputmodel.StreamMemberChangesInputModel) (*output.ChangeSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamMemberChanges", ctx, input)
+	ret0, _ := ret[0].(*output.ChangeSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamMemberChanges indicates an expected call of StreamMemberChanges.
+func (mr *MockMemberInputPortMockRecorder) StreamMemberChanges(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamMemberChanges", reflect.TypeOf((*MockMemberInputPort)(nil).StreamMemberChanges), ctx, input)
+}
+
+// TagMember mocks base method.
+func (m *MockMemberInputPort) TagMember(ctx context.Context, id int, tag string) (*entity.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagMember", ctx, id, tag)
+	ret0, _ := ret[0].(*entity.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TagMember indicates an expected call of TagMember.
+func (mr *MockMemberInputPortMockRecorder) TagMember(ctx, id, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagMember", reflect.TypeOf((*MockMemberInputPort)(nil).TagMember), ctx, id, tag)
+}
+
+// UntagMember mocks base method.
+func (m *MockMemberInputPort) UntagMember(ctx context.Context, id int, tag string) (*entity.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UntagMember", ctx, id, tag)
+	ret0, _ := ret[0].(*entity.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UntagMember indicates an expected call of UntagMember.
+func (mr *MockMemberInputPortMockRecorder) UntagMember(ctx, id, tag interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterMember", reflect.TypeOf((*MockMemberInputPort)(nil).RegisterMember), ctx, member)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagMember", reflect.TypeOf((*MockMemberInputPort)(nil).UntagMember), ctx, id, tag)
 }
 
 // UpdateMemberEmail mocks base method.
@@ -141,6 +445,21 @@ func (mr *MockMemberInputPortMockRecorder) UpdateMemberPassword(ctx, id, oldPass
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMemberPassword", reflect.TypeOf((*MockMemberInputPort)(nil).UpdateMemberPassword), ctx, id, oldPassword, newPassword)
 }
 
+// UpdateMemberPreferences mocks base method.
+func (m *MockMemberInputPort) UpdateMemberPreferences(ctx context.Context, input *inputmodel.PatchMemberPreferencesInputModel) (*entity.MemberPreferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMemberPreferences", ctx, input)
+	ret0, _ := ret[0].(*entity.MemberPreferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMemberPreferences indicates an expected call of UpdateMemberPreferences.
+func (mr *MockMemberInputPortMockRecorder) UpdateMemberPreferences(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMemberPreferences", reflect.TypeOf((*MockMemberInputPort)(nil).UpdateMemberPreferences), ctx, input)
+}
+
 // UpdateMemberProfile mocks base method.
 func (m *MockMemberInputPort) UpdateMemberProfile(ctx context.Context, patch *inputmodel.PatchUpdateMemberProfileInputModel) (*entity.Member, error) {
 	m.ctrl.T.Helper()
@@ -10,6 +10,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	entity "github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
 	outputmodel "github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/outputmodel"
+	output "github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
 )
 
 // MockMemberPresenter is a mock of MemberPresenter interface.
@@ -49,6 +50,48 @@ func (mr *MockMemberPresenterMockRecorder) PresentBindingError(errCode, message
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentBindingError", reflect.TypeOf((*MockMemberPresenter)(nil).PresentBindingError), errCode, message)
 }
 
+// PresentBulkTagMembers mocks base method.
+func (m *MockMemberPresenter) PresentBulkTagMembers(tag string, requested, tagged int) outputmodel.BulkTagMembersResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentBulkTagMembers", tag, requested, tagged)
+	ret0, _ := ret[0].(outputmodel.BulkTagMembersResponse)
+	return ret0
+}
+
+// PresentBulkTagMembers indicates an expected call of PresentBulkTagMembers.
+func (mr *MockMemberPresenterMockRecorder) PresentBulkTagMembers(tag, requested, tagged interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentBulkTagMembers", reflect.TypeOf((*MockMemberPresenter)(nil).PresentBulkTagMembers), tag, requested, tagged)
+}
+
+// PresentChangeMemberStatus mocks base method.
+func (m *MockMemberPresenter) PresentChangeMemberStatus(member *entity.Member) outputmodel.ChangeMemberStatusResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentChangeMemberStatus", member)
+	ret0, _ := ret[0].(outputmodel.ChangeMemberStatusResponse)
+	return ret0
+}
+
+// PresentChangeMemberStatus indicates an expected call of PresentChangeMemberStatus.
+func (mr *MockMemberPresenterMockRecorder) PresentChangeMemberStatus(member interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentChangeMemberStatus", reflect.TypeOf((*MockMemberPresenter)(nil).PresentChangeMemberStatus), member)
+}
+
+// PresentCreateInvitation mocks base method.
+func (m *MockMemberPresenter) PresentCreateInvitation(invitation *entity.Invitation) outputmodel.InvitationResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentCreateInvitation", invitation)
+	ret0, _ := ret[0].(outputmodel.InvitationResponse)
+	return ret0
+}
+
+// PresentCreateInvitation indicates an expected call of PresentCreateInvitation.
+func (mr *MockMemberPresenterMockRecorder) PresentCreateInvitation(invitation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentCreateInvitation", reflect.TypeOf((*MockMemberPresenter)(nil).PresentCreateInvitation), invitation)
+}
+
 // PresentDeleteMember mocks base method.
 func (m *MockMemberPresenter) PresentDeleteMember(member *entity.Member) outputmodel.DeleteMemberResponse {
 	m.ctrl.T.Helper()
@@ -63,6 +106,48 @@ func (mr *MockMemberPresenterMockRecorder) PresentDeleteMember(member interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentDeleteMember", reflect.TypeOf((*MockMemberPresenter)(nil).PresentDeleteMember), member)
 }
 
+// PresentErasePersonalData mocks base method.
+func (m *MockMemberPresenter) PresentErasePersonalData(result *output.ErasureResult) outputmodel.ErasePersonalDataResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentErasePersonalData", result)
+	ret0, _ := ret[0].(outputmodel.ErasePersonalDataResponse)
+	return ret0
+}
+
+// PresentErasePersonalData indicates an expected call of PresentErasePersonalData.
+func (mr *MockMemberPresenterMockRecorder) PresentErasePersonalData(result interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentErasePersonalData", reflect.TypeOf((*MockMemberPresenter)(nil).PresentErasePersonalData), result)
+}
+
+// PresentExportPersonalData mocks base method.
+func (m *MockMemberPresenter) PresentExportPersonalData(archive *output.PersonalDataArchive) outputmodel.ExportPersonalDataResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentExportPersonalData", archive)
+	ret0, _ := ret[0].(outputmodel.ExportPersonalDataResponse)
+	return ret0
+}
+
+// PresentExportPersonalData indicates an expected call of PresentExportPersonalData.
+func (mr *MockMemberPresenterMockRecorder) PresentExportPersonalData(archive interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentExportPersonalData", reflect.TypeOf((*MockMemberPresenter)(nil).PresentExportPersonalData), archive)
+}
+
+// PresentExportSegment mocks base method.
+func (m *MockMemberPresenter) PresentExportSegment(export *output.SegmentExport) outputmodel.ExportSegmentResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentExportSegment", export)
+	ret0, _ := ret[0].(outputmodel.ExportSegmentResponse)
+	return ret0
+}
+
+// PresentExportSegment indicates an expected call of PresentExportSegment.
+func (mr *MockMemberPresenterMockRecorder) PresentExportSegment(export interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentExportSegment", reflect.TypeOf((*MockMemberPresenter)(nil).PresentExportSegment), export)
+}
+
 // PresentGetMemberByEmail mocks base method.
 func (m *MockMemberPresenter) PresentGetMemberByEmail(member *entity.Member) outputmodel.GetMemberByEmailResponse {
 	m.ctrl.T.Helper()
@@ -91,6 +176,34 @@ func (mr *MockMemberPresenterMockRecorder) PresentGetMemberByID(member interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentGetMemberByID", reflect.TypeOf((*MockMemberPresenter)(nil).PresentGetMemberByID), member)
 }
 
+// PresentIssueMemberToken mocks base method.
+func (m *MockMemberPresenter) PresentIssueMemberToken(token *output.AccessToken) outputmodel.IssueMemberTokenResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentIssueMemberToken", token)
+	ret0, _ := ret[0].(outputmodel.IssueMemberTokenResponse)
+	return ret0
+}
+
+// PresentIssueMemberToken indicates an expected call of PresentIssueMemberToken.
+func (mr *MockMemberPresenterMockRecorder) PresentIssueMemberToken(token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentIssueMemberToken", reflect.TypeOf((*MockMemberPresenter)(nil).PresentIssueMemberToken), token)
+}
+
+// PresentListInvitations mocks base method.
+func (m *MockMemberPresenter) PresentListInvitations(invitations []*entity.Invitation, total int) outputmodel.ListInvitationsResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentListInvitations", invitations, total)
+	ret0, _ := ret[0].(outputmodel.ListInvitationsResponse)
+	return ret0
+}
+
+// PresentListInvitations indicates an expected call of PresentListInvitations.
+func (mr *MockMemberPresenterMockRecorder) PresentListInvitations(invitations, total interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentListInvitations", reflect.TypeOf((*MockMemberPresenter)(nil).PresentListInvitations), invitations, total)
+}
+
 // PresentListMembers mocks base method.
 func (m *MockMemberPresenter) PresentListMembers(members []*entity.Member, total int) outputmodel.ListMemberResponse {
 	m.ctrl.T.Helper()
@@ -105,6 +218,76 @@ func (mr *MockMemberPresenterMockRecorder) PresentListMembers(members, total int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentListMembers", reflect.TypeOf((*MockMemberPresenter)(nil).PresentListMembers), members, total)
 }
 
+// PresentListSegments mocks base method.
+func (m *MockMemberPresenter) PresentListSegments(segments []*entity.Segment, total int) outputmodel.ListSegmentsResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentListSegments", segments, total)
+	ret0, _ := ret[0].(outputmodel.ListSegmentsResponse)
+	return ret0
+}
+
+// PresentListSegments indicates an expected call of PresentListSegments.
+func (mr *MockMemberPresenterMockRecorder) PresentListSegments(segments, total interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentListSegments", reflect.TypeOf((*MockMemberPresenter)(nil).PresentListSegments), segments, total)
+}
+
+// PresentMemberChangeEvent mocks base method.
+func (m *MockMemberPresenter) PresentMemberChangeEvent(event output.ChangeEvent) outputmodel.MemberChangeEventResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentMemberChangeEvent", event)
+	ret0, _ := ret[0].(outputmodel.MemberChangeEventResponse)
+	return ret0
+}
+
+// PresentMemberChangeEvent indicates an expected call of PresentMemberChangeEvent.
+func (mr *MockMemberPresenterMockRecorder) PresentMemberChangeEvent(event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentMemberChangeEvent", reflect.TypeOf((*MockMemberPresenter)(nil).PresentMemberChangeEvent), event)
+}
+
+// PresentMemberPreferences mocks base method.
+func (m *MockMemberPresenter) PresentMemberPreferences(prefs *entity.MemberPreferences) outputmodel.MemberPreferencesResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentMemberPreferences", prefs)
+	ret0, _ := ret[0].(outputmodel.MemberPreferencesResponse)
+	return ret0
+}
+
+// PresentMemberPreferences indicates an expected call of PresentMemberPreferences.
+func (mr *MockMemberPresenterMockRecorder) PresentMemberPreferences(prefs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentMemberPreferences", reflect.TypeOf((*MockMemberPresenter)(nil).PresentMemberPreferences), prefs)
+}
+
+// PresentMemberTags mocks base method.
+func (m *MockMemberPresenter) PresentMemberTags(member *entity.Member) outputmodel.MemberTagsResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentMemberTags", member)
+	ret0, _ := ret[0].(outputmodel.MemberTagsResponse)
+	return ret0
+}
+
+// PresentMemberTags indicates an expected call of PresentMemberTags.
+func (mr *MockMemberPresenterMockRecorder) PresentMemberTags(member interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentMemberTags", reflect.TypeOf((*MockMemberPresenter)(nil).PresentMemberTags), member)
+}
+
+// PresentMergeMembers mocks base method.
+func (m *MockMemberPresenter) PresentMergeMembers(result *output.MergeResult) outputmodel.MergeMembersResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentMergeMembers", result)
+	ret0, _ := ret[0].(outputmodel.MergeMembersResponse)
+	return ret0
+}
+
+// PresentMergeMembers indicates an expected call of PresentMergeMembers.
+func (mr *MockMemberPresenterMockRecorder) PresentMergeMembers(result interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentMergeMembers", reflect.TypeOf((*MockMemberPresenter)(nil).PresentMergeMembers), result)
+}
+
 // PresentRegisterMember mocks base method.
 func (m *MockMemberPresenter) PresentRegisterMember(member *entity.Member) outputmodel.RegisterMemberResponse {
 	m.ctrl.T.Helper()
@@ -119,6 +302,34 @@ func (mr *MockMemberPresenterMockRecorder) PresentRegisterMember(member interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentRegisterMember", reflect.TypeOf((*MockMemberPresenter)(nil).PresentRegisterMember), member)
 }
 
+// PresentRevokeInvitation mocks base method.
+func (m *MockMemberPresenter) PresentRevokeInvitation(invitation *entity.Invitation) outputmodel.InvitationResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentRevokeInvitation", invitation)
+	ret0, _ := ret[0].(outputmodel.InvitationResponse)
+	return ret0
+}
+
+// PresentRevokeInvitation indicates an expected call of PresentRevokeInvitation.
+func (mr *MockMemberPresenterMockRecorder) PresentRevokeInvitation(invitation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentRevokeInvitation", reflect.TypeOf((*MockMemberPresenter)(nil).PresentRevokeInvitation), invitation)
+}
+
+// PresentSegment mocks base method.
+func (m *MockMemberPresenter) PresentSegment(segment *entity.Segment) outputmodel.SegmentResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentSegment", segment)
+	ret0, _ := ret[0].(outputmodel.SegmentResponse)
+	return ret0
+}
+
+// PresentSegment indicates an expected call of PresentSegment.
+func (mr *MockMemberPresenterMockRecorder) PresentSegment(segment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentSegment", reflect.TypeOf((*MockMemberPresenter)(nil).PresentSegment), segment)
+}
+
 // PresentUpdateMemberEmail mocks base method.
 func (m *MockMemberPresenter) PresentUpdateMemberEmail() outputmodel.UpdateMemberEmailResponse {
 	m.ctrl.T.Helper()
@@ -34,6 +34,62 @@ func (m *MockValidator) EXPECT() *MockValidatorMockRecorder {
 	return m.recorder
 }
 
+// ValidateBulkTagMembers mocks base method.
+func (m *MockValidator) ValidateBulkTagMembers(arg0 dto.BulkTagMembersRequestDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateBulkTagMembers", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateBulkTagMembers indicates an expected call of ValidateBulkTagMembers.
+func (mr *MockValidatorMockRecorder) ValidateBulkTagMembers(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateBulkTagMembers", reflect.TypeOf((*MockValidator)(nil).ValidateBulkTagMembers), arg0)
+}
+
+// ValidateChangeMemberStatus mocks base method.
+func (m *MockValidator) ValidateChangeMemberStatus(arg0 dto.ChangeMemberStatusRequestDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateChangeMemberStatus", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateChangeMemberStatus indicates an expected call of ValidateChangeMemberStatus.
+func (mr *MockValidatorMockRecorder) ValidateChangeMemberStatus(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateChangeMemberStatus", reflect.TypeOf((*MockValidator)(nil).ValidateChangeMemberStatus), arg0)
+}
+
+// ValidateCreateInvitation mocks base method.
+func (m *MockValidator) ValidateCreateInvitation(arg0 dto.CreateInvitationRequestDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateCreateInvitation", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateCreateInvitation indicates an expected call of ValidateCreateInvitation.
+func (mr *MockValidatorMockRecorder) ValidateCreateInvitation(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateCreateInvitation", reflect.TypeOf((*MockValidator)(nil).ValidateCreateInvitation), arg0)
+}
+
+// ValidateCreateSegment mocks base method.
+func (m *MockValidator) ValidateCreateSegment(arg0 dto.CreateSegmentRequestDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateCreateSegment", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateCreateSegment indicates an expected call of ValidateCreateSegment.
+func (mr *MockValidatorMockRecorder) ValidateCreateSegment(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateCreateSegment", reflect.TypeOf((*MockValidator)(nil).ValidateCreateSegment), arg0)
+}
+
 // ValidateDeleteMember mocks base method.
 func (m *MockValidator) ValidateDeleteMember(arg0 dto.DeleteMemberRequestDTO) error {
 	m.ctrl.T.Helper()
@@ -48,6 +104,20 @@ func (mr *MockValidatorMockRecorder) ValidateDeleteMember(arg0 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateDeleteMember", reflect.TypeOf((*MockValidator)(nil).ValidateDeleteMember), arg0)
 }
 
+// ValidateEvaluateSegment mocks base method.
+func (m *MockValidator) ValidateEvaluateSegment(arg0 dto.EvaluateSegmentRequestDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateEvaluateSegment", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateEvaluateSegment indicates an expected call of ValidateEvaluateSegment.
+func (mr *MockValidatorMockRecorder) ValidateEvaluateSegment(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateEvaluateSegment", reflect.TypeOf((*MockValidator)(nil).ValidateEvaluateSegment), arg0)
+}
+
 // ValidateGetMemberByEmail mocks base method.
 func (m *MockValidator) ValidateGetMemberByEmail(arg0 dto.GetMemberByEmailRequestDTO) error {
 	m.ctrl.T.Helper()
@@ -76,6 +146,34 @@ func (mr *MockValidatorMockRecorder) ValidateGetMemberByID(arg0 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateGetMemberByID", reflect.TypeOf((*MockValidator)(nil).ValidateGetMemberByID), arg0)
 }
 
+// ValidateIssueMemberToken mocks base method.
+func (m *MockValidator) ValidateIssueMemberToken(arg0 dto.IssueMemberTokenRequestDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateIssueMemberToken", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateIssueMemberToken indicates an expected call of ValidateIssueMemberToken.
+func (mr *MockValidatorMockRecorder) ValidateIssueMemberToken(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateIssueMemberToken", reflect.TypeOf((*MockValidator)(nil).ValidateIssueMemberToken), arg0)
+}
+
+// ValidateListInvitations mocks base method.
+func (m *MockValidator) ValidateListInvitations(arg0 dto.ListInvitationsRequestDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateListInvitations", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateListInvitations indicates an expected call of ValidateListInvitations.
+func (mr *MockValidatorMockRecorder) ValidateListInvitations(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateListInvitations", reflect.TypeOf((*MockValidator)(nil).ValidateListInvitations), arg0)
+}
+
 // ValidateListMember mocks base method.
 func (m *MockValidator) ValidateListMember(arg0 dto.ListMemberRequestDTO) error {
 	m.ctrl.T.Helper()
@@ -90,6 +188,90 @@ func (mr *MockValidatorMockRecorder) ValidateListMember(arg0 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateListMember", reflect.TypeOf((*MockValidator)(nil).ValidateListMember), arg0)
 }
 
+// ValidateListSegments mocks base method.
+func (m *MockValidator) ValidateListSegments(arg0 dto.ListSegmentsRequestDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateListSegments", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateListSegments indicates an expected call of ValidateListSegments.
+func (mr *MockValidatorMockRecorder) ValidateListSegments(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateListSegments", reflect.TypeOf((*MockValidator)(nil).ValidateListSegments), arg0)
+}
+
+// ValidateMemberPreferences mocks base method.
+func (m *MockValidator) ValidateMemberPreferences(arg0 dto.MemberPreferencesRequestDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateMemberPreferences", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateMemberPreferences indicates an expected call of ValidateMemberPreferences.
+func (mr *MockValidatorMockRecorder) ValidateMemberPreferences(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateMemberPreferences", reflect.TypeOf((*MockValidator)(nil).ValidateMemberPreferences), arg0)
+}
+
+// ValidateMemberTag mocks base method.
+func (m *MockValidator) ValidateMemberTag(arg0 dto.MemberTagRequestDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateMemberTag", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateMemberTag indicates an expected call of ValidateMemberTag.
+func (mr *MockValidatorMockRecorder) ValidateMemberTag(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateMemberTag", reflect.TypeOf((*MockValidator)(nil).ValidateMemberTag), arg0)
+}
+
+// ValidateMergeMembers mocks base method.
+func (m *MockValidator) ValidateMergeMembers(arg0 dto.MergeMembersRequestDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateMergeMembers", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateMergeMembers indicates an expected call of ValidateMergeMembers.
+func (mr *MockValidatorMockRecorder) ValidateMergeMembers(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateMergeMembers", reflect.TypeOf((*MockValidator)(nil).ValidateMergeMembers), arg0)
+}
+
+// ValidatePatchMemberPreferences mocks base method.
+func (m *MockValidator) ValidatePatchMemberPreferences(arg0 dto.PatchMemberPreferencesRequestDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidatePatchMemberPreferences", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidatePatchMemberPreferences indicates an expected call of ValidatePatchMemberPreferences.
+func (mr *MockValidatorMockRecorder) ValidatePatchMemberPreferences(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatePatchMemberPreferences", reflect.TypeOf((*MockValidator)(nil).ValidatePatchMemberPreferences), arg0)
+}
+
+// ValidatePersonalData mocks base method.
+func (m *MockValidator) ValidatePersonalData(arg0 dto.PersonalDataRequestDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidatePersonalData", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidatePersonalData indicates an expected call of ValidatePersonalData.
+func (mr *MockValidatorMockRecorder) ValidatePersonalData(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatePersonalData", reflect.TypeOf((*MockValidator)(nil).ValidatePersonalData), arg0)
+}
+
 // ValidateRegisterMember mocks base method.
 func (m *MockValidator) ValidateRegisterMember(arg0 dto.RegisterMemberRequestDTO) error {
 	m.ctrl.T.Helper()
@@ -104,6 +286,48 @@ func (mr *MockValidatorMockRecorder) ValidateRegisterMember(arg0 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateRegisterMember", reflect.TypeOf((*MockValidator)(nil).ValidateRegisterMember), arg0)
 }
 
+// ValidateRevokeInvitation mocks base method.
+func (m *MockValidator) ValidateRevokeInvitation(arg0 dto.RevokeInvitationRequestDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateRevokeInvitation", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateRevokeInvitation indicates an expected call of ValidateRevokeInvitation.
+func (mr *MockValidatorMockRecorder) ValidateRevokeInvitation(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateRevokeInvitation", reflect.TypeOf((*MockValidator)(nil).ValidateRevokeInvitation), arg0)
+}
+
+// ValidateSegment mocks base method.
+func (m *MockValidator) ValidateSegment(arg0 dto.SegmentRequestDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateSegment", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateSegment indicates an expected call of ValidateSegment.
+func (mr *MockValidatorMockRecorder) ValidateSegment(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateSegment", reflect.TypeOf((*MockValidator)(nil).ValidateSegment), arg0)
+}
+
+// ValidateStreamMemberChanges mocks base method.
+func (m *MockValidator) ValidateStreamMemberChanges(arg0 dto.StreamMemberChangesRequestDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateStreamMemberChanges", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateStreamMemberChanges indicates an expected call of ValidateStreamMemberChanges.
+func (mr *MockValidatorMockRecorder) ValidateStreamMemberChanges(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateStreamMemberChanges", reflect.TypeOf((*MockValidator)(nil).ValidateStreamMemberChanges), arg0)
+}
+
 // ValidateUpdateEmail mocks base method.
 func (m *MockValidator) ValidateUpdateEmail(arg0 dto.UpdateMemberEmailRequestDTO) error {
 	m.ctrl.T.Helper()
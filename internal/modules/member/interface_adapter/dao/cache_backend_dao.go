@@ -0,0 +1,18 @@
+package dao
+
+//go:generate mockgen -source=cache_backend_dao.go -destination=../../interface_adapter/gateway/mock/mock_cache_backend_dao.go -package=mock
+
+import (
+	"context"
+	"time"
+)
+
+// CacheBackend 會員查詢快取的儲存後端，值為已編碼的位元組，方便以 Redis 協定（GET/SET PX/DEL）實作
+type CacheBackend interface {
+	// Get 回傳 key 的值，不存在或已過期時 ok 為 false
+	Get(ctx context.Context, key string) (value []byte, ok bool, err error)
+	// Set 寫入 key，ttl 後過期
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Delete 刪除多個 key，不存在的 key 略過
+	Delete(ctx context.Context, keys ...string) error
+}
@@ -0,0 +1,42 @@
+package dao
+
+//go:generate mockgen -source=invitation_dao.go -destination=../../interface_adapter/gateway/mock/mock_invitation_dao.go -package=mock
+
+import (
+	"context"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"time"
+)
+
+type InvitationRecord struct {
+	ID        int
+	Code      string
+	CreatedBy string
+	// ReferrerID 建立者為會員時的會員 ID，0 表示沒有推薦人
+	ReferrerID int
+	// Email 鎖定的正規化 Email，空字串表示不限
+	Email   string
+	MaxUses int
+	Uses    int
+	// ExpiresAt、RevokedAt 為 nil 表示不會過期、尚未撤銷
+	ExpiresAt *time.Time
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+// InvitationQuery 邀請碼列表的查詢條件，零值欄位表示不篩選
+type InvitationQuery struct {
+	CreatedBy string
+}
+
+type InvitationDAO interface {
+	Create(ctx context.Context, r *InvitationRecord) error
+	GetByID(ctx context.Context, id int) (*InvitationRecord, error)
+	GetByCode(ctx context.Context, code string) (*InvitationRecord, error)
+	GetAll(ctx context.Context, q InvitationQuery, p pagination.Pagination) ([]*InvitationRecord, error)
+	CountAll(ctx context.Context, q InvitationQuery) (int, error)
+	// Redeem 只在使用次數仍為 uses、未撤銷且未用完時加一，否則回傳 no effect
+	Redeem(ctx context.Context, id, uses int) error
+	// Revoke 只撤銷尚未撤銷的邀請碼，否則回傳 no effect
+	Revoke(ctx context.Context, id int, revokedAt time.Time) error
+}
@@ -9,21 +9,62 @@ import (
 )
 
 type MemberRecord struct {
-	ID        int
-	Name      string
-	Email     string
-	Password  string
-	CreatedAt time.Time
+	ID    int
+	Name  string
+	Email string
+	// NormalizedEmail 比對身分用的正規化 Email，尚未回填時為空字串
+	NormalizedEmail string
+	Password        string
+	Status          string
+	StatusReason    string
+	// MergedInto 被合併到的會員 ID，0 表示未被合併
+	MergedInto int
+	// ReferredBy 推薦人會員 ID，0 表示沒有推薦人
+	ReferredBy int
+	CreatedAt  time.Time
+	// UpdatedAt 最近一次更新的時間，由資料庫在每次更新時改寫，一律為 UTC
+	UpdatedAt time.Time
+}
+
+// MemberQuery 會員列表的查詢條件，零值欄位表示不篩選
+//   - 預設排除已被合併的會員；MergedInto 只查合併到該會員的 tombstone，IncludeMerged 則一併列出
+//   - ReferredBy 只查由該會員推薦註冊的會員
+//   - TagsAny 具備任一標籤，TagsAll 須同時具備所有標籤；標籤須已正規化
+type MemberQuery struct {
+	Status        string
+	ReferredBy    int
+	MergedInto    int
+	IncludeMerged bool
+	TagsAny       []string
+	TagsAll       []string
 }
 
 type MemberDAO interface {
 	Create(ctx context.Context, m *MemberRecord) error
 	GetByID(ctx context.Context, id int) (*MemberRecord, error)
-	GetByEmail(ctx context.Context, email string) (*MemberRecord, error)
-	GetAll(ctx context.Context, p pagination.Pagination) ([]*MemberRecord, error)
+	// GetByEmail 以正規化後的 Email 查詢
+	GetByEmail(ctx context.Context, normalizedEmail string) (*MemberRecord, error)
+	GetAll(ctx context.Context, q MemberQuery, p pagination.Pagination) ([]*MemberRecord, error)
 	UpdateProfile(ctx context.Context, m *MemberRecord) (*MemberRecord, error)
-	UpdateEmail(ctx context.Context, id int, newEmail string) error
+	UpdateEmail(ctx context.Context, id int, newEmail, normalizedEmail string) error
+	// UpdateNormalizedEmail 只改寫正規化 Email，供回填使用
+	UpdateNormalizedEmail(ctx context.Context, id int, normalizedEmail string) error
 	UpdatePassword(ctx context.Context, id int, newPassword string) error
+	// UpdateStatus 只在目前狀態為 from 時改為 to，否則回傳 no effect
+	UpdateStatus(ctx context.Context, id int, from, to, reason string) error
+	// MarkMerged 將尚未合併的來源會員標記為合併到目標，並把先前合併到來源的會員改指向目標；
+	// 回傳改指向的會員數，來源已被合併時回傳 no effect
+	MarkMerged(ctx context.Context, sourceID, targetID int) (int, error)
 	Delete(ctx context.Context, id int) error
-	CountAll(ctx context.Context) (int, error)
+	CountAll(ctx context.Context, q MemberQuery) (int, error)
+	// AddTag 為會員加上標籤，標籤不存在時建立；會員已有此標籤時回傳 no effect
+	AddTag(ctx context.Context, memberID int, tag string) error
+	// RemoveTag 移除會員的標籤，會員沒有此標籤時回傳 no effect
+	RemoveTag(ctx context.Context, memberID int, tag string) error
+	// AddTagToMembers 為多位會員加上標籤，略過不存在、已合併或已有此標籤的會員，回傳新加上的數量
+	AddTagToMembers(ctx context.Context, memberIDs []int, tag string) (int, error)
+	// ListTags 依名稱排序列出會員的標籤
+	ListTags(ctx context.Context, memberID int) ([]string, error)
+	// MergeTags 將來源會員的標籤複製到目標，回傳目標新增的標籤數
+	MergeTags(ctx context.Context, sourceID, targetID int) (int, error)
 }
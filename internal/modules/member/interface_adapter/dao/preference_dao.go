@@ -0,0 +1,27 @@
+package dao
+
+//go:generate mockgen -source=preference_dao.go -destination=../../interface_adapter/gateway/mock/mock_preference_dao.go -package=mock
+
+import (
+	"context"
+	"time"
+)
+
+type PreferenceRecord struct {
+	MemberID int
+	Key      string
+	// Value 偏好設定值的 JSON
+	Value     string
+	Version   int
+	UpdatedAt time.Time
+}
+
+type PreferenceDAO interface {
+	GetByMember(ctx context.Context, memberID int) ([]*PreferenceRecord, error)
+	// Upsert 寫入偏好設定，已存在時版本加一；值沒有變更時回傳 ErrDBNoEffect
+	Upsert(ctx context.Context, r *PreferenceRecord) error
+	// Delete 刪除單一偏好設定，不存在時回傳 ErrDBNoEffect
+	Delete(ctx context.Context, memberID int, key string) error
+	// DeleteByMember 刪除會員所有偏好設定，回傳刪除筆數
+	DeleteByMember(ctx context.Context, memberID int) (int, error)
+}
@@ -0,0 +1,27 @@
+package dao
+
+//go:generate mockgen -source=segment_dao.go -destination=../../interface_adapter/gateway/mock/mock_segment_dao.go -package=mock
+
+import (
+	"context"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"time"
+)
+
+type SegmentRecord struct {
+	ID   int
+	Name string
+	// Filter 分群條件的 JSON
+	Filter    string
+	CreatedBy string
+	CreatedAt time.Time
+}
+
+type SegmentDAO interface {
+	Create(ctx context.Context, r *SegmentRecord) error
+	GetByID(ctx context.Context, id int) (*SegmentRecord, error)
+	GetByName(ctx context.Context, name string) (*SegmentRecord, error)
+	GetAll(ctx context.Context, p pagination.Pagination) ([]*SegmentRecord, error)
+	CountAll(ctx context.Context) (int, error)
+	Delete(ctx context.Context, id int) error
+}
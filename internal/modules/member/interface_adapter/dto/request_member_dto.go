@@ -8,10 +8,15 @@
 // - 不包含資料轉換、不依賴 domain 與 usecase
 package dto
 
+import "time"
+
+// RegisterMemberRequestDTO 會員註冊
+//   - InvitationCode 邀請碼，僅限邀請註冊時必填
 type RegisterMemberRequestDTO struct {
-	Name     string `json:"name" validate:"required"`
-	Email    string `json:"email" validate:"required,email"`
-	Password string `json:"password" validate:"required,min=6"`
+	Name           string `json:"name" validate:"required,min=3,max=20"`
+	Email          string `json:"email" validate:"required,email"`
+	Password       string `json:"password" validate:"required,min=6"`
+	InvitationCode string `json:"invitation_code" validate:"omitempty,max=64"`
 }
 
 type GetMemberByIDRequestDTO struct {
@@ -22,11 +27,16 @@ type GetMemberByEmailRequestDTO struct {
 	Email string `validate:"required,email"`
 }
 
+// ListMemberRequestDTO 會員列表
+//   - Tags 標籤篩選，TagMatch 為 any（預設，符合任一）或 all（全部符合）
 type ListMemberRequestDTO struct {
-	Page    int    `validate:"required,min=1"`
-	Limit   int    `validate:"required,min=1,max=100"`
-	SortBy  string `validate:"omitempty,oneof=id name email created_at"`
-	OrderBy string `validate:"omitempty,oneof=asc desc"`
+	Page     int      `validate:"required,min=1"`
+	Limit    int      `validate:"required,min=1,max=100"`
+	SortBy   string   `validate:"omitempty,oneof=id name email created_at"`
+	OrderBy  string   `validate:"omitempty,oneof=asc desc"`
+	Status   string   `validate:"omitempty,oneof=pending active suspended banned"`
+	Tags     []string `validate:"omitempty,max=10,dive,required,max=32"`
+	TagMatch string   `validate:"omitempty,oneof=any all"`
 }
 
 // UpdateMemberProfileRequestDTO 更新會員個人資料
@@ -53,6 +63,113 @@ type UpdateMemberPasswordRequestDTO struct {
 	NewPassword string `json:"new_password" validate:"required,min=6"`
 }
 
+// ChangeMemberStatusRequestDTO 變更會員狀態
+//   - Status 由路由決定，不接受客戶端指定
+//   - Reason 停權與封鎖時必填，由 use case 檢查
+type ChangeMemberStatusRequestDTO struct {
+	ID     int    `validate:"required,gte=1"`
+	Status string `validate:"required,oneof=active suspended banned"`
+	Reason string `validate:"omitempty,max=255"`
+}
+
+// MergeMembersRequestDTO 合併會員
+//   - ID 為要合併掉的重複帳號，TargetID 為保留的會員
+//   - Preview 為 true 時只回報會搬移的資料
+type MergeMembersRequestDTO struct {
+	ID       int  `validate:"required,gte=1"`
+	TargetID int  `validate:"required,gte=1"`
+	Preview  bool `validate:"omitempty"`
+}
+
 type DeleteMemberRequestDTO struct {
 	ID int `validate:"required,gte=1"`
 }
+
+// StreamMemberChangesRequestDTO 訂閱會員異動串流
+//   - Types 要訂閱的異動類型，空值表示全部
+//   - LastEventID 斷線前最後收到的事件 ID
+type StreamMemberChangesRequestDTO struct {
+	Types       []string `validate:"omitempty,dive,oneof=member.created member.updated member.deleted"`
+	LastEventID string   `validate:"omitempty,max=64"`
+}
+
+// PersonalDataRequestDTO 匯出或刪除會員個資
+type PersonalDataRequestDTO struct {
+	ID int `validate:"required,gte=1"`
+}
+
+// IssueMemberTokenRequestDTO 以 Email 與密碼換取 access token
+type IssueMemberTokenRequestDTO struct {
+	Email    string `validate:"required,email"`
+	Password string `validate:"required,max=128"`
+}
+
+// CreateInvitationRequestDTO 建立邀請碼
+//   - Email 不為空時只有該 Email 可以使用
+//   - MaxUses 為 0 時只能使用一次；ExpiresAt 為 nil 時使用設定的有效期間
+type CreateInvitationRequestDTO struct {
+	Email     string     `validate:"omitempty,email"`
+	MaxUses   int        `validate:"omitempty,min=1,max=1000"`
+	ExpiresAt *time.Time `validate:"omitempty"`
+}
+
+// ListInvitationsRequestDTO 邀請碼列表
+type ListInvitationsRequestDTO struct {
+	Page  int `validate:"required,min=1"`
+	Limit int `validate:"required,min=1,max=100"`
+}
+
+// RevokeInvitationRequestDTO 撤銷邀請碼
+type RevokeInvitationRequestDTO struct {
+	ID int `validate:"required,gte=1"`
+}
+
+// MemberTagRequestDTO 加上或移除會員標籤，標籤格式由 entity 檢查
+type MemberTagRequestDTO struct {
+	ID  int    `validate:"required,gte=1"`
+	Tag string `validate:"required,max=32"`
+}
+
+// BulkTagMembersRequestDTO 批次加標籤
+type BulkTagMembersRequestDTO struct {
+	Tag       string `validate:"required,max=32"`
+	MemberIDs []int  `validate:"required,min=1,max=1000,dive,gte=1"`
+}
+
+// CreateSegmentRequestDTO 建立分群，至少要有一個條件，由 entity 檢查
+type CreateSegmentRequestDTO struct {
+	Name       string   `validate:"required,max=64"`
+	Status     string   `validate:"omitempty,oneof=pending active suspended banned"`
+	TagsAny    []string `validate:"omitempty,max=10,dive,required,max=32"`
+	TagsAll    []string `validate:"omitempty,max=10,dive,required,max=32"`
+	ReferredBy int      `validate:"omitempty,gte=1"`
+}
+
+// ListSegmentsRequestDTO 分群列表
+type ListSegmentsRequestDTO struct {
+	Page  int `validate:"required,min=1"`
+	Limit int `validate:"required,min=1,max=100"`
+}
+
+// EvaluateSegmentRequestDTO 查詢分群目前的會員
+type EvaluateSegmentRequestDTO struct {
+	ID    int `validate:"required,gte=1"`
+	Page  int `validate:"required,min=1"`
+	Limit int `validate:"required,min=1,max=100"`
+}
+
+// SegmentRequestDTO 匯出或刪除分群
+type SegmentRequestDTO struct {
+	ID int `validate:"required,gte=1"`
+}
+
+// MemberPreferencesRequestDTO 查詢會員偏好設定
+type MemberPreferencesRequestDTO struct {
+	ID int `validate:"required,gte=1"`
+}
+
+// PatchMemberPreferencesRequestDTO 以 JSON merge patch 更新偏好設定，key 與值的型別由 entity 的 schema 檢查
+type PatchMemberPreferencesRequestDTO struct {
+	ID      int            `validate:"required,gte=1"`
+	Changes map[string]any `validate:"required,max=20"`
+}
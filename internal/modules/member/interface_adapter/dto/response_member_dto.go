@@ -6,21 +6,38 @@ type RegisterMemberResponseDTO struct {
 	Email string `json:"email"`
 }
 type GetMemberByIDResponseDTO struct {
-	ID        int    `json:"id"`
-	Name      string `json:"name"`
-	Email     string `json:"email"`
-	CreatedAt string `json:"created_at"`
+	ID            int      `json:"id"`
+	Name          string   `json:"name"`
+	Email         string   `json:"email"`
+	Status        string   `json:"status"`
+	ReferredBy    int      `json:"referred_by,omitempty"`
+	ReferralCount int      `json:"referral_count"`
+	Tags          []string `json:"tags"`
+	// CreatedAt 以會員時區表示的 RFC3339，CreatedAtLocal 依會員語系格式化供顯示
+	CreatedAt      string `json:"created_at"`
+	CreatedAtLocal string `json:"created_at_local"`
+	// UpdatedAt 最近一次更新的時間，以會員時區表示的 RFC3339
+	UpdatedAt string `json:"updated_at"`
 }
 type GetMemberByEmailResponseDTO struct {
-	ID        int    `json:"id"`
-	Name      string `json:"name"`
-	Email     string `json:"email"`
-	CreatedAt string `json:"created_at"`
+	ID            int      `json:"id"`
+	Name          string   `json:"name"`
+	Email         string   `json:"email"`
+	Status        string   `json:"status"`
+	ReferredBy    int      `json:"referred_by,omitempty"`
+	ReferralCount int      `json:"referral_count"`
+	Tags          []string `json:"tags"`
+	// CreatedAt 以會員時區表示的 RFC3339，CreatedAtLocal 依會員語系格式化供顯示
+	CreatedAt      string `json:"created_at"`
+	CreatedAtLocal string `json:"created_at_local"`
+	// UpdatedAt 最近一次更新的時間，以會員時區表示的 RFC3339
+	UpdatedAt string `json:"updated_at"`
 }
 type ListMemberItemDTO struct {
-	ID    int    `json:"id"`
-	Name  string `json:"name"`
-	Email string `json:"email"`
+	ID     int    `json:"id"`
+	Name   string `json:"name"`
+	Email  string `json:"email"`
+	Status string `json:"status"`
 }
 type ListMemberResponseDTO struct {
 	Members []ListMemberItemDTO `json:"members"`
@@ -29,6 +46,20 @@ type UpdateMemberProfileResponseDTO struct {
 	ID   int     `json:"id"`
 	Name string `json:"name,omitempty"`
 }
+type ChangeMemberStatusResponseDTO struct {
+	ID           int    `json:"id"`
+	Status       string `json:"status"`
+	StatusReason string `json:"status_reason,omitempty"`
+}
+type MergeMembersResponseDTO struct {
+	SourceID          int    `json:"source_id"`
+	TargetID          int    `json:"target_id"`
+	Preview           bool   `json:"preview"`
+	AuditRecords      int    `json:"audit_records"`
+	RedirectedMembers int    `json:"redirected_members"`
+	CarriedTags       int    `json:"carried_tags"`
+	MergedAt          string `json:"merged_at,omitempty"`
+}
 type UpdateMemberEmailResponseDTO struct{}
 type UpdateMemberPasswordResponseDTO struct{}
 type DeleteMemberResponseDTO struct {
@@ -37,3 +68,121 @@ type DeleteMemberResponseDTO struct {
 	Email     string `json:"email"`
 	CreatedAt string `json:"created_at"`
 }
+type MemberChangeItemDTO struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Email     string `json:"email"`
+	CreatedAt string `json:"created_at"`
+}
+type MemberChangeEventResponseDTO struct {
+	Type       string              `json:"type"`
+	Member     MemberChangeItemDTO `json:"member"`
+	OccurredAt string              `json:"occurred_at"`
+}
+type PersonalDataProfileDTO struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Email     string `json:"email"`
+	CreatedAt string `json:"created_at"`
+}
+type PersonalDataAuditChangeDTO struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+type PersonalDataAuditRecordDTO struct {
+	ID        int                                   `json:"id"`
+	Actor     string                                `json:"actor"`
+	Action    string                                `json:"action"`
+	Changes   map[string]PersonalDataAuditChangeDTO `json:"changes"`
+	RequestID string                                `json:"request_id"`
+	TraceID   string                                `json:"trace_id"`
+	IP        string                                `json:"ip"`
+	CreatedAt string                                `json:"created_at"`
+}
+type ExportPersonalDataResponseDTO struct {
+	ExportedAt   string                       `json:"exported_at"`
+	Profile      PersonalDataProfileDTO       `json:"profile"`
+	Preferences  map[string]any               `json:"preferences,omitempty"`
+	AuditRecords []PersonalDataAuditRecordDTO `json:"audit_records"`
+}
+type ErasePersonalDataResponseDTO struct {
+	ID                   int    `json:"id"`
+	AlreadyErased        bool   `json:"already_erased"`
+	RedactedAuditRecords int    `json:"redacted_audit_records"`
+	ErasedAt             string `json:"erased_at"`
+}
+
+// IssueMemberTokenResponseDTO 放在 Authorization: Bearer 後使用
+type IssueMemberTokenResponseDTO struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresAt   string `json:"expires_at"`
+}
+
+// InvitationResponseDTO 邀請碼，只回傳給建立者與狀態管理者
+type InvitationResponseDTO struct {
+	ID         int    `json:"id"`
+	Code       string `json:"code"`
+	CreatedBy  string `json:"created_by"`
+	ReferrerID int    `json:"referrer_id,omitempty"`
+	Email      string `json:"email,omitempty"`
+	MaxUses    int    `json:"max_uses"`
+	Uses       int    `json:"uses"`
+	ExpiresAt  string `json:"expires_at,omitempty"`
+	RevokedAt  string `json:"revoked_at,omitempty"`
+	CreatedAt  string `json:"created_at"`
+}
+type ListInvitationsResponseDTO struct {
+	Invitations []InvitationResponseDTO `json:"invitations"`
+}
+
+// MemberTagsResponseDTO 加上或移除標籤後會員目前的標籤
+type MemberTagsResponseDTO struct {
+	ID   int      `json:"id"`
+	Tags []string `json:"tags"`
+}
+
+// BulkTagMembersResponseDTO 批次加標籤結果，Tagged 為實際新加上的會員數
+type BulkTagMembersResponseDTO struct {
+	Tag       string `json:"tag"`
+	Requested int    `json:"requested"`
+	Tagged    int    `json:"tagged"`
+}
+type SegmentFilterDTO struct {
+	Status     string   `json:"status,omitempty"`
+	TagsAny    []string `json:"tags_any,omitempty"`
+	TagsAll    []string `json:"tags_all,omitempty"`
+	ReferredBy int      `json:"referred_by,omitempty"`
+}
+type SegmentResponseDTO struct {
+	ID        int              `json:"id"`
+	Name      string           `json:"name"`
+	Filter    SegmentFilterDTO `json:"filter"`
+	CreatedBy string           `json:"created_by"`
+	CreatedAt string           `json:"created_at"`
+}
+type ListSegmentsResponseDTO struct {
+	Segments []SegmentResponseDTO `json:"segments"`
+}
+type SegmentMemberDTO struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Email     string `json:"email"`
+	Status    string `json:"status"`
+	CreatedAt string `json:"created_at"`
+}
+
+// ExportSegmentResponseDTO 分群匯出檔，Members 為匯出當下符合條件的所有會員
+type ExportSegmentResponseDTO struct {
+	ExportedAt string             `json:"exported_at"`
+	Segment    SegmentResponseDTO `json:"segment"`
+	Members    []SegmentMemberDTO `json:"members"`
+}
+
+// MemberPreferencesResponseDTO Preferences 為所有偏好設定（未設定的 key 為預設值），
+// Versions 只列出已儲存的 key，PATCH 的 merge patch 以 Preferences 為對象
+type MemberPreferencesResponseDTO struct {
+	MemberID    int            `json:"member_id"`
+	Preferences map[string]any `json:"preferences"`
+	Versions    map[string]int `json:"versions"`
+}
@@ -0,0 +1,12 @@
+package audit
+
+import "errors"
+
+var (
+	// ErrGatewayAuditRecordFailed 呼叫稽核模組寫入紀錄失敗。
+	ErrGatewayAuditRecordFailed = errors.New("gateway: member audit record failed")
+	// ErrGatewayAuditListFailed 呼叫稽核模組查詢紀錄失敗。
+	ErrGatewayAuditListFailed = errors.New("gateway: member audit list failed")
+	// ErrGatewayAuditRedactFailed 呼叫稽核模組遮蔽個資失敗。
+	ErrGatewayAuditRedactFailed = errors.New("gateway: member audit redact failed")
+)
@@ -0,0 +1,91 @@
+package audit
+
+import (
+	auditentity "github.com/tomoffice/go-clean-architecture/internal/modules/audit/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"time"
+)
+
+// maskedValue 敏感欄位一律以此取代，與 logging middleware 的遮罩一致
+const maskedValue = "******"
+
+// sensitiveFields 只記錄「有變動」，不記錄值
+var sensitiveFields = map[string]struct{}{
+	"password": {},
+}
+
+// personalDataFields 個資刪除時需在稽核紀錄中遮蔽的欄位
+var personalDataFields = []string{"name", "email"}
+
+// auditedFields 稽核紀錄比對的欄位，memberFields 新增欄位時需一併列入
+var auditedFields = []string{"id", "name", "email", "password", "status", "status_reason", "merged_into", "referred_by", "created_at"}
+
+// diffMember 比對 before/after 產生欄位層級的異動，未變動的欄位不列入
+func diffMember(before, after *entity.Member) map[string]auditentity.FieldChange {
+	beforeFields := memberFields(before)
+	afterFields := memberFields(after)
+
+	changes := make(map[string]auditentity.FieldChange)
+	for _, key := range auditedFields {
+		b, hasBefore := beforeFields[key]
+		a, hasAfter := afterFields[key]
+		if !hasBefore && !hasAfter {
+			continue
+		}
+		if hasBefore && hasAfter && b == a {
+			continue
+		}
+		change := auditentity.FieldChange{}
+		if hasBefore {
+			change.Before = maskIfSensitive(key, b)
+		}
+		if hasAfter {
+			change.After = maskIfSensitive(key, a)
+		}
+		changes[key] = change
+	}
+	return changes
+}
+
+// memberFields 將 entity 攤平成欄位值，零值欄位視為不存在
+func memberFields(m *entity.Member) map[string]any {
+	fields := make(map[string]any)
+	if m == nil {
+		return fields
+	}
+	if m.ID != 0 {
+		fields["id"] = m.ID
+	}
+	if m.Name != "" {
+		fields["name"] = m.Name
+	}
+	if m.Email != "" {
+		fields["email"] = m.Email
+	}
+	if m.Password != "" {
+		fields["password"] = m.Password
+	}
+	if m.Status != "" {
+		fields["status"] = string(m.Status)
+	}
+	if m.StatusReason != "" {
+		fields["status_reason"] = m.StatusReason
+	}
+	if m.MergedInto != 0 {
+		fields["merged_into"] = m.MergedInto
+	}
+	if m.ReferredBy != 0 {
+		fields["referred_by"] = m.ReferredBy
+	}
+	if !m.CreatedAt.IsZero() {
+		fields["created_at"] = m.CreatedAt.UTC().Format(time.RFC3339)
+	}
+	return fields
+}
+
+func maskIfSensitive(key string, value any) any {
+	if _, ok := sensitiveFields[key]; ok {
+		return maskedValue
+	}
+	return value
+}
@@ -0,0 +1,146 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	auditentity "github.com/tomoffice/go-clean-architecture/internal/modules/audit/entity"
+	auditinputmodel "github.com/tomoffice/go-clean-architecture/internal/modules/audit/usecase/inputmodel"
+	auditinput "github.com/tomoffice/go-clean-architecture/internal/modules/audit/usecase/port/input"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/requestmeta"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
+	"strconv"
+)
+
+// auditTargetType 會員在稽核紀錄中的 target 類型，查詢時為 target=member:{id}
+const auditTargetType = "member"
+
+// listPageSize 匯出會員稽核紀錄時每次讀取的筆數
+const listPageSize = 100
+
+// MemberAuditGateway 透過稽核模組的 input port 實作 output.AuditTrail
+type MemberAuditGateway struct {
+	audit  auditinput.AuditInputPort
+	logger logger.Logger
+	tracer tracer.Tracer
+}
+
+func NewMemberAuditGateway(audit auditinput.AuditInputPort, log logger.Logger, tracer tracer.Tracer) output.AuditTrail {
+	baseLogger := log.With(logger.NewField("layer", "gateway"))
+	return MemberAuditGateway{
+		audit:  audit,
+		logger: baseLogger,
+		tracer: tracer,
+	}
+}
+
+func (g MemberAuditGateway) Record(ctx context.Context, action output.AuditAction, memberID int, before, after *entity.Member) error {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, span := g.tracer.Start(ctx, "Gateway.AuditRecord")
+	defer span.End()
+	traceLogger := g.logger.WithContext(gatewayCtx)
+
+	meta := requestmeta.FromContext(gatewayCtx)
+	entry := &auditentity.AuditEntry{
+		Actor:      meta.Actor,
+		Action:     string(action),
+		TargetType: auditTargetType,
+		TargetID:   strconv.Itoa(memberID),
+		Changes:    diffMember(before, after),
+		RequestID:  meta.RequestID,
+		TraceID:    meta.TraceID,
+		IP:         meta.IP,
+	}
+	if err := g.audit.RecordEntry(gatewayCtx, entry); err != nil {
+		traceLogger.Error("會員稽核紀錄寫入失敗",
+			logger.NewField("error", err),
+			logger.NewField("action", entry.Action),
+			logger.NewField("member_id", memberID),
+		)
+		return fmt.Errorf("%w: %w: %v", usecase.ErrMemberAuditTrailError, ErrGatewayAuditRecordFailed, err)
+	}
+	traceLogger.Debug("會員稽核紀錄寫入成功",
+		logger.NewField("action", entry.Action),
+		logger.NewField("member_id", memberID),
+		logger.NewField("actor", entry.Actor),
+	)
+	return nil
+}
+
+func (g MemberAuditGateway) ListByMember(ctx context.Context, memberID int) ([]output.AuditRecord, error) {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, span := g.tracer.Start(ctx, "Gateway.AuditListByMember")
+	defer span.End()
+	traceLogger := g.logger.WithContext(gatewayCtx)
+
+	filter := &auditinputmodel.ListAuditEntriesInputModel{TargetType: auditTargetType, TargetID: strconv.Itoa(memberID)}
+	records := make([]output.AuditRecord, 0)
+	for offset := 0; ; offset += listPageSize {
+		entries, _, err := g.audit.ListEntries(gatewayCtx, filter, pagination.Pagination{Limit: listPageSize, Offset: offset})
+		if err != nil {
+			traceLogger.Error("會員稽核紀錄查詢失敗",
+				logger.NewField("error", err),
+				logger.NewField("member_id", memberID),
+				logger.NewField("offset", offset),
+			)
+			return nil, fmt.Errorf("%w: %w: %v", usecase.ErrMemberAuditTrailError, ErrGatewayAuditListFailed, err)
+		}
+		for _, entry := range entries {
+			records = append(records, toAuditRecord(entry))
+		}
+		if len(entries) < listPageSize {
+			break
+		}
+	}
+	traceLogger.Debug("會員稽核紀錄查詢成功",
+		logger.NewField("member_id", memberID),
+		logger.NewField("count", len(records)),
+	)
+	return records, nil
+}
+
+func (g MemberAuditGateway) RedactMember(ctx context.Context, memberID int) (int, error) {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, span := g.tracer.Start(ctx, "Gateway.AuditRedactMember")
+	defer span.End()
+	traceLogger := g.logger.WithContext(gatewayCtx)
+
+	redacted, err := g.audit.RedactTarget(gatewayCtx, &auditinputmodel.RedactAuditTargetInputModel{
+		TargetType: auditTargetType,
+		TargetID:   strconv.Itoa(memberID),
+		Fields:     personalDataFields,
+	})
+	if err != nil {
+		traceLogger.Error("會員稽核紀錄個資遮蔽失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", memberID),
+		)
+		return 0, fmt.Errorf("%w: %w: %v", usecase.ErrMemberAuditTrailError, ErrGatewayAuditRedactFailed, err)
+	}
+	traceLogger.Debug("會員稽核紀錄個資遮蔽成功",
+		logger.NewField("member_id", memberID),
+		logger.NewField("redacted", redacted),
+	)
+	return redacted, nil
+}
+
+func toAuditRecord(entry *auditentity.AuditEntry) output.AuditRecord {
+	changes := make(map[string]output.AuditFieldChange, len(entry.Changes))
+	for field, change := range entry.Changes {
+		changes[field] = output.AuditFieldChange{Before: change.Before, After: change.After}
+	}
+	return output.AuditRecord{
+		ID:        entry.ID,
+		Actor:     entry.Actor,
+		Action:    entry.Action,
+		Changes:   changes,
+		RequestID: entry.RequestID,
+		TraceID:   entry.TraceID,
+		IP:        entry.IP,
+		CreatedAt: entry.CreatedAt,
+	}
+}
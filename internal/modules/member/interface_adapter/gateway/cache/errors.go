@@ -0,0 +1,8 @@
+package cache
+
+import "errors"
+
+var (
+	// ErrUnknownOperation 設定中的快取查詢名稱不存在。
+	ErrUnknownOperation = errors.New("gateway: unknown member cache operation")
+)
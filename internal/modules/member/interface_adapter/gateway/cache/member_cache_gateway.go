@@ -0,0 +1,428 @@
+// Package cache 以快取包裝 output.MemberPersistence，減少以 ID 與 Email 查詢單一會員時的資料庫存取。
+//   - 每位會員同時以 ID 與正規化 Email 兩個 key 存放，異動、刪除、變更 Email 時兩個 key 都會失效
+//   - 同一個 key 併發 miss 時只查詢一次資料庫
+//   - 交易中的查詢不讀也不寫快取，失效在交易結束後再做一次，避免快取到未提交或被回滾的資料
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/dao"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
+	"golang.org/x/sync/singleflight"
+	"slices"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Operation 可個別開關快取的查詢
+type Operation string
+
+const (
+	OperationGetByID    Operation = "get_by_id"
+	OperationGetByEmail Operation = "get_by_email"
+)
+
+// DefaultTTL 未設定 TTL 時快取項目的存活時間
+const DefaultTTL = time.Minute
+
+// AllOperations 回傳所有支援快取的查詢
+func AllOperations() []Operation {
+	return []Operation{OperationGetByID, OperationGetByEmail}
+}
+
+// ParseOperations 將設定中的查詢名稱轉為 Operation，空值表示全部
+func ParseOperations(names []string) ([]Operation, error) {
+	if len(names) == 0 {
+		return AllOperations(), nil
+	}
+	operations := make([]Operation, 0, len(names))
+	for _, name := range names {
+		op := Operation(name)
+		if !slices.Contains(AllOperations(), op) {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownOperation, name)
+		}
+		operations = append(operations, op)
+	}
+	return operations, nil
+}
+
+// Options 快取設定
+type Options struct {
+	// TTL 快取項目的存活時間，零值使用 DefaultTTL
+	TTL time.Duration
+	// Operations 啟用快取的查詢，空值表示全部
+	Operations []Operation
+}
+
+// Stats 單一查詢的快取命中統計
+type Stats struct {
+	Hits   uint64
+	Misses uint64
+}
+
+type counters struct {
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+// MemberCacheGateway 以 dao.CacheBackend 快取 GetByID 與 GetByEmail，其餘方法委派給被包裝的 gateway
+type MemberCacheGateway struct {
+	// MemberPersistence 被包裝的 gateway；沒有覆寫的方法不影響快取內容，直接委派
+	output.MemberPersistence
+	backend dao.CacheBackend
+	ttl     time.Duration
+	enabled map[Operation]bool
+	stats   map[Operation]*counters
+	group   singleflight.Group
+	logger  logger.Logger
+	tracer  tracer.Tracer
+}
+
+// NewMemberCacheGateway 創建會員查詢快取；use case 的交易管理需改用 TransactionManager 包裝後的版本
+func NewMemberCacheGateway(persistence output.MemberPersistence, backend dao.CacheBackend, options Options, log logger.Logger, tracer tracer.Tracer) *MemberCacheGateway {
+	if options.TTL <= 0 {
+		options.TTL = DefaultTTL
+	}
+	if len(options.Operations) == 0 {
+		options.Operations = AllOperations()
+	}
+	g := &MemberCacheGateway{
+		MemberPersistence: persistence,
+		backend:           backend,
+		ttl:               options.TTL,
+		enabled:           make(map[Operation]bool),
+		stats:             make(map[Operation]*counters),
+		logger:            log.With(logger.NewField("layer", "gateway"), logger.NewField("component", "member_cache")),
+		tracer:            tracer,
+	}
+	for _, op := range AllOperations() {
+		g.stats[op] = &counters{}
+	}
+	for _, op := range options.Operations {
+		g.enabled[op] = true
+	}
+	return g
+}
+
+// Stats 回傳各查詢的命中與未命中次數
+func (g *MemberCacheGateway) Stats() map[Operation]Stats {
+	stats := make(map[Operation]Stats, len(g.stats))
+	for op, c := range g.stats {
+		stats[op] = Stats{Hits: c.hits.Load(), Misses: c.misses.Load()}
+	}
+	return stats
+}
+
+func (g *MemberCacheGateway) GetByID(ctx context.Context, id int) (*entity.Member, error) {
+	if !g.enabled[OperationGetByID] || inTransaction(ctx) {
+		return g.MemberPersistence.GetByID(ctx, id)
+	}
+	gatewayCtx, span := g.tracer.Start(ctx, "Gateway.Cache.GetByID")
+	defer span.End()
+	return g.load(gatewayCtx, OperationGetByID, idKey(id), func(ctx context.Context) (*entity.Member, error) {
+		return g.MemberPersistence.GetByID(ctx, id)
+	})
+}
+
+func (g *MemberCacheGateway) GetByEmail(ctx context.Context, normalizedEmail string) (*entity.Member, error) {
+	if !g.enabled[OperationGetByEmail] || inTransaction(ctx) {
+		return g.MemberPersistence.GetByEmail(ctx, normalizedEmail)
+	}
+	gatewayCtx, span := g.tracer.Start(ctx, "Gateway.Cache.GetByEmail")
+	defer span.End()
+	return g.load(gatewayCtx, OperationGetByEmail, emailKey(normalizedEmail), func(ctx context.Context) (*entity.Member, error) {
+		return g.MemberPersistence.GetByEmail(ctx, normalizedEmail)
+	})
+}
+
+func (g *MemberCacheGateway) UpdateProfile(ctx context.Context, m *entity.Member) (*entity.Member, error) {
+	keys := g.memberKeys(ctx, m.ID)
+	updated, err := g.MemberPersistence.UpdateProfile(ctx, m)
+	g.invalidate(ctx, keys...)
+	return updated, err
+}
+
+func (g *MemberCacheGateway) UpdateEmail(ctx context.Context, id int, newEmail, normalizedEmail string) error {
+	keys := append(g.memberKeys(ctx, id), emailKey(normalizedEmail))
+	err := g.MemberPersistence.UpdateEmail(ctx, id, newEmail, normalizedEmail)
+	g.invalidate(ctx, keys...)
+	return err
+}
+
+func (g *MemberCacheGateway) UpdateNormalizedEmail(ctx context.Context, id int, normalizedEmail string) error {
+	keys := append(g.memberKeys(ctx, id), emailKey(normalizedEmail))
+	err := g.MemberPersistence.UpdateNormalizedEmail(ctx, id, normalizedEmail)
+	g.invalidate(ctx, keys...)
+	return err
+}
+
+func (g *MemberCacheGateway) UpdatePassword(ctx context.Context, id int, newPassword string) error {
+	keys := g.memberKeys(ctx, id)
+	err := g.MemberPersistence.UpdatePassword(ctx, id, newPassword)
+	g.invalidate(ctx, keys...)
+	return err
+}
+
+// VerifyPassword 不經過快取，快取的會員不帶密碼
+func (g *MemberCacheGateway) VerifyPassword(ctx context.Context, id int, password string) (bool, error) {
+	return g.MemberPersistence.VerifyPassword(ctx, id, password)
+}
+
+func (g *MemberCacheGateway) UpdateStatus(ctx context.Context, id int, from, to entity.MemberStatus, reason string) error {
+	keys := g.memberKeys(ctx, id)
+	err := g.MemberPersistence.UpdateStatus(ctx, id, from, to, reason)
+	g.invalidate(ctx, keys...)
+	return err
+}
+
+// MarkMerged 除了來源會員，先前合併到來源、被改指向目標的會員也要失效
+func (g *MemberCacheGateway) MarkMerged(ctx context.Context, sourceID, targetID int) (int, error) {
+	keys := g.memberKeys(ctx, sourceID)
+	redirected, err := g.MemberPersistence.MarkMerged(ctx, sourceID, targetID)
+	if err == nil && redirected > 0 {
+		keys = append(keys, g.mergedIntoKeys(ctx, targetID)...)
+	}
+	g.invalidate(ctx, keys...)
+	return redirected, err
+}
+
+func (g *MemberCacheGateway) Delete(ctx context.Context, id int) error {
+	keys := g.memberKeys(ctx, id)
+	err := g.MemberPersistence.Delete(ctx, id)
+	g.invalidate(ctx, keys...)
+	return err
+}
+
+// TransactionManager 包裝 use case 的交易管理：交易中的查詢略過快取，失效的 key 在交易結束後再刪除一次，
+// 避免交易期間其他請求把舊資料寫回快取
+func (g *MemberCacheGateway) TransactionManager(txManager output.TransactionManager) output.TransactionManager {
+	return cachedTransactionManager{TransactionManager: txManager, gateway: g}
+}
+
+// load 依序查詢快取與資料庫，資料庫查詢以 key 合併併發請求，查到後寫回快取；每個呼叫端拿到各自的副本
+func (g *MemberCacheGateway) load(ctx context.Context, op Operation, key string, fetch func(ctx context.Context) (*entity.Member, error)) (*entity.Member, error) {
+	traceLogger := g.logger.WithContext(ctx)
+	if member, ok := g.get(ctx, traceLogger, key); ok {
+		g.stats[op].hits.Add(1)
+		return member, nil
+	}
+	g.stats[op].misses.Add(1)
+	value, err, _ := g.group.Do(key, func() (any, error) {
+		// 共用的查詢不因第一個呼叫端取消而讓其他呼叫端失敗
+		fetchCtx := context.WithoutCancel(ctx)
+		member, err := fetch(fetchCtx)
+		if err != nil {
+			return nil, err
+		}
+		g.store(fetchCtx, traceLogger, member)
+		return member, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cloneMember(value.(*entity.Member)), nil
+}
+
+// get 讀取快取，後端錯誤或資料無法解析時視為未命中
+func (g *MemberCacheGateway) get(ctx context.Context, traceLogger logger.Logger, key string) (*entity.Member, bool) {
+	data, ok, err := g.backend.Get(ctx, key)
+	if err != nil {
+		traceLogger.Warn("會員快取讀取失敗，改查資料庫", logger.NewField("key", key), logger.NewField("error", err))
+		return nil, false
+	}
+	if !ok {
+		return nil, false
+	}
+	var record cacheRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		traceLogger.Warn("會員快取資料無法解析，改查資料庫", logger.NewField("key", key), logger.NewField("error", err))
+		return nil, false
+	}
+	return record.toEntity(), true
+}
+
+// store 以啟用快取的 ID 與 Email key 寫入會員
+func (g *MemberCacheGateway) store(ctx context.Context, traceLogger logger.Logger, member *entity.Member) {
+	data, err := json.Marshal(newCacheRecord(member))
+	if err != nil {
+		traceLogger.Warn("會員快取資料編碼失敗", logger.NewField("member_id", member.ID), logger.NewField("error", err))
+		return
+	}
+	var keys []string
+	if g.enabled[OperationGetByID] {
+		keys = append(keys, idKey(member.ID))
+	}
+	if g.enabled[OperationGetByEmail] && member.NormalizedEmail != "" {
+		keys = append(keys, emailKey(member.NormalizedEmail))
+	}
+	for _, key := range keys {
+		if err := g.backend.Set(ctx, key, data, g.ttl); err != nil {
+			traceLogger.Warn("會員快取寫入失敗", logger.NewField("key", key), logger.NewField("error", err))
+		}
+	}
+}
+
+// memberKeys 在異動前取得會員目前的快取 key；快取中沒有這位會員時查一次資料庫取得 Email
+func (g *MemberCacheGateway) memberKeys(ctx context.Context, id int) []string {
+	keys := []string{idKey(id)}
+	if !g.enabled[OperationGetByEmail] {
+		return keys
+	}
+	member, ok := g.get(ctx, g.logger.WithContext(ctx), idKey(id))
+	if !ok {
+		var err error
+		if member, err = g.MemberPersistence.GetByID(ctx, id); err != nil {
+			// 會員不存在時不會有 Email key 需要失效，異動本身會回傳錯誤
+			return keys
+		}
+	}
+	if member.NormalizedEmail != "" {
+		keys = append(keys, emailKey(member.NormalizedEmail))
+	}
+	return keys
+}
+
+// mergedIntoKeys 合併到 targetID 的所有會員的快取 key
+func (g *MemberCacheGateway) mergedIntoKeys(ctx context.Context, targetID int) []string {
+	filter := output.MemberFilter{MergedInto: targetID}
+	traceLogger := g.logger.WithContext(ctx)
+	count, err := g.MemberPersistence.CountAll(ctx, filter)
+	if err == nil && count > 0 {
+		var members []*entity.Member
+		if members, err = g.MemberPersistence.GetAll(ctx, filter, pagination.Pagination{Limit: count}); err == nil {
+			keys := make([]string, 0, len(members)*2)
+			for _, member := range members {
+				keys = append(keys, idKey(member.ID), emailKey(member.NormalizedEmail))
+			}
+			return keys
+		}
+	}
+	if err != nil {
+		traceLogger.Warn("查詢被改指向的會員失敗，其快取將在 TTL 後更新",
+			logger.NewField("target_id", targetID),
+			logger.NewField("error", err),
+		)
+	}
+	return nil
+}
+
+// invalidate 刪除快取 key；在交易中時記下 key，交易結束後再刪除一次
+func (g *MemberCacheGateway) invalidate(ctx context.Context, keys ...string) {
+	if scope, ok := ctx.Value(txScopeKey{}).(*txScope); ok {
+		scope.add(keys...)
+	}
+	g.deleteKeys(ctx, keys)
+}
+
+func (g *MemberCacheGateway) deleteKeys(ctx context.Context, keys []string) {
+	if err := g.backend.Delete(ctx, keys...); err != nil {
+		g.logger.WithContext(ctx).Error("會員快取失效失敗，資料可能在 TTL 內過期",
+			logger.NewField("keys", keys),
+			logger.NewField("error", err),
+		)
+	}
+}
+
+type txScopeKey struct{}
+
+// txScope 一個交易中需要失效的快取 key
+type txScope struct {
+	mu   sync.Mutex
+	keys []string
+}
+
+func (s *txScope) add(keys ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = append(s.keys, keys...)
+}
+
+func inTransaction(ctx context.Context) bool {
+	_, ok := ctx.Value(txScopeKey{}).(*txScope)
+	return ok
+}
+
+type cachedTransactionManager struct {
+	output.TransactionManager
+	gateway *MemberCacheGateway
+}
+
+// WithinTransaction 不論提交或回滾，交易結束後都刪除交易中異動過的 key；巢狀呼叫沿用最外層的 scope
+func (m cachedTransactionManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if inTransaction(ctx) {
+		return m.TransactionManager.WithinTransaction(ctx, fn)
+	}
+	scope := &txScope{}
+	err := m.TransactionManager.WithinTransaction(context.WithValue(ctx, txScopeKey{}, scope), fn)
+	if len(scope.keys) > 0 {
+		m.gateway.deleteKeys(ctx, scope.keys)
+	}
+	return err
+}
+
+func idKey(id int) string {
+	return "member:id:" + strconv.Itoa(id)
+}
+
+func emailKey(normalizedEmail string) string {
+	return "member:email:" + normalizedEmail
+}
+
+// cacheRecord 快取中的會員資料；entity 的 JSON tag 供 API 使用，會略過正規化 Email 等欄位，因此另外定義
+type cacheRecord struct {
+	ID              int       `json:"id"`
+	Name            string    `json:"name"`
+	Email           string    `json:"email"`
+	NormalizedEmail string    `json:"normalized_email"`
+	Status          string    `json:"status"`
+	StatusReason    string    `json:"status_reason"`
+	MergedInto      int       `json:"merged_into"`
+	ReferredBy      int       `json:"referred_by"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+func newCacheRecord(m *entity.Member) cacheRecord {
+	return cacheRecord{
+		ID:              m.ID,
+		Name:            m.Name,
+		Email:           m.Email,
+		NormalizedEmail: m.NormalizedEmail,
+		Status:          string(m.Status),
+		StatusReason:    m.StatusReason,
+		MergedInto:      m.MergedInto,
+		ReferredBy:      m.ReferredBy,
+		CreatedAt:       m.CreatedAt,
+		UpdatedAt:       m.UpdatedAt,
+	}
+}
+
+func (r cacheRecord) toEntity() *entity.Member {
+	return &entity.Member{
+		ID:              r.ID,
+		Name:            r.Name,
+		Email:           r.Email,
+		NormalizedEmail: r.NormalizedEmail,
+		Status:          entity.MemberStatus(r.Status),
+		StatusReason:    r.StatusReason,
+		MergedInto:      r.MergedInto,
+		ReferredBy:      r.ReferredBy,
+		CreatedAt:       r.CreatedAt,
+		UpdatedAt:       r.UpdatedAt,
+	}
+}
+
+// cloneMember singleflight 的結果由多個呼叫端共用，use case 會直接修改回傳的會員，因此各自複製
+func cloneMember(m *entity.Member) *entity.Member {
+	clone := *m
+	clone.Tags = slices.Clone(m.Tags)
+	return &clone
+}
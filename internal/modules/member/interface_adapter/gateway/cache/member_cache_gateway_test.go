@@ -0,0 +1,337 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	gatewaymock "github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/gateway/mock"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/mock"
+	mocklogger "github.com/tomoffice/go-clean-architecture/pkg/logger/mock"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer/adapters/basic"
+)
+
+// mapBackend 以 map 實作 dao.CacheBackend，忽略 TTL
+type mapBackend struct {
+	mu    sync.Mutex
+	items map[string][]byte
+}
+
+func newMapBackend() *mapBackend {
+	return &mapBackend{items: make(map[string][]byte)}
+}
+
+func (b *mapBackend) Get(_ context.Context, key string) ([]byte, bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	value, ok := b.items[key]
+	return value, ok, nil
+}
+
+func (b *mapBackend) Set(_ context.Context, key string, value []byte, _ time.Duration) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.items[key] = value
+	return nil
+}
+
+func (b *mapBackend) Delete(_ context.Context, keys ...string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, key := range keys {
+		delete(b.items, key)
+	}
+	return nil
+}
+
+func (b *mapBackend) has(key string) bool {
+	_, ok, _ := b.Get(context.Background(), key)
+	return ok
+}
+
+func newTestGateway(t *testing.T, backend *mapBackend, operations ...Operation) (*MemberCacheGateway, *mock.MockMemberPersistence) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockLogger := mocklogger.NewMockLogger(ctrl)
+	mockLogger.EXPECT().With(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+	persistence := mock.NewMockMemberPersistence(ctrl)
+	g := NewMemberCacheGateway(persistence, backend, Options{Operations: operations}, mockLogger, basic.NewTracer(basic.NewConfig("test", false)))
+	return g, persistence
+}
+
+func newAlice() *entity.Member {
+	return &entity.Member{
+		ID:              1,
+		Name:            "alice",
+		Email:           "Alice@Example.com",
+		NormalizedEmail: "alice@example.com",
+		Status:          entity.MemberStatusActive,
+		CreatedAt:       time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestMemberCacheGateway_GetByID(t *testing.T) {
+	backend := newMapBackend()
+	g, persistence := newTestGateway(t, backend)
+	ctx := context.Background()
+	persistence.EXPECT().GetByID(gomock.Any(), 1).Return(newAlice(), nil).Times(1)
+	persistence.EXPECT().GetByID(gomock.Any(), 2).Return(nil, usecase.ErrMemberNotFound).Times(2)
+
+	first, err := g.GetByID(ctx, 1)
+	require.NoError(t, err)
+	// use case 修改回傳的會員不影響快取
+	first.Name = "changed"
+	first.Tags = []string{"vip"}
+	second, err := g.GetByID(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, newAlice(), second)
+
+	// 同一筆資料也以 Email key 存放
+	byEmail, err := g.GetByEmail(ctx, "alice@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, newAlice(), byEmail)
+
+	// 查無資料不快取
+	for i := 0; i < 2; i++ {
+		_, err = g.GetByID(ctx, 2)
+		assert.ErrorIs(t, err, usecase.ErrMemberNotFound)
+	}
+	assert.Equal(t, map[Operation]Stats{
+		OperationGetByID:    {Hits: 1, Misses: 3},
+		OperationGetByEmail: {Hits: 1},
+	}, g.Stats())
+}
+
+func TestMemberCacheGateway_Invalidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(ctx context.Context, g *MemberCacheGateway, persistence *mock.MockMemberPersistence) error
+		// extraKey 異動後另外需要失效的 key
+		extraKey string
+	}{
+		{
+			name: "update profile",
+			mutate: func(ctx context.Context, g *MemberCacheGateway, persistence *mock.MockMemberPersistence) error {
+				persistence.EXPECT().UpdateProfile(ctx, gomock.Any()).Return(newAlice(), nil)
+				_, err := g.UpdateProfile(ctx, newAlice())
+				return err
+			},
+		},
+		{
+			name: "update email",
+			mutate: func(ctx context.Context, g *MemberCacheGateway, persistence *mock.MockMemberPersistence) error {
+				persistence.EXPECT().UpdateEmail(ctx, 1, "new@example.com", "new@example.com").Return(nil)
+				return g.UpdateEmail(ctx, 1, "new@example.com", "new@example.com")
+			},
+			extraKey: emailKey("new@example.com"),
+		},
+		{
+			name: "update status",
+			mutate: func(ctx context.Context, g *MemberCacheGateway, persistence *mock.MockMemberPersistence) error {
+				persistence.EXPECT().UpdateStatus(ctx, 1, entity.MemberStatusActive, entity.MemberStatusSuspended, "spam").Return(nil)
+				return g.UpdateStatus(ctx, 1, entity.MemberStatusActive, entity.MemberStatusSuspended, "spam")
+			},
+		},
+		{
+			name: "delete",
+			mutate: func(ctx context.Context, g *MemberCacheGateway, persistence *mock.MockMemberPersistence) error {
+				persistence.EXPECT().Delete(ctx, 1).Return(nil)
+				return g.Delete(ctx, 1)
+			},
+		},
+		{
+			name: "mark merged redirects members",
+			mutate: func(ctx context.Context, g *MemberCacheGateway, persistence *mock.MockMemberPersistence) error {
+				persistence.EXPECT().MarkMerged(ctx, 1, 3).Return(1, nil)
+				persistence.EXPECT().CountAll(ctx, gomock.Any()).Return(2, nil)
+				persistence.EXPECT().GetAll(ctx, gomock.Any(), gomock.Any()).Return([]*entity.Member{
+					newAlice(),
+					{ID: 4, NormalizedEmail: "dave@example.com"},
+				}, nil)
+				_, err := g.MarkMerged(ctx, 1, 3)
+				return err
+			},
+			extraKey: idKey(4),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backend := newMapBackend()
+			g, persistence := newTestGateway(t, backend)
+			ctx := context.Background()
+			persistence.EXPECT().GetByID(gomock.Any(), 1).Return(newAlice(), nil)
+			_, err := g.GetByID(ctx, 1)
+			require.NoError(t, err)
+			g.store(ctx, g.logger, &entity.Member{ID: 4, NormalizedEmail: "dave@example.com"})
+			if tt.extraKey != "" {
+				require.NoError(t, backend.Set(ctx, tt.extraKey, []byte("{}"), time.Minute))
+			}
+
+			require.NoError(t, tt.mutate(ctx, g, persistence))
+			assert.False(t, backend.has(idKey(1)))
+			assert.False(t, backend.has(emailKey("alice@example.com")))
+			if tt.extraKey != "" {
+				assert.False(t, backend.has(tt.extraKey))
+			}
+		})
+	}
+}
+
+func TestMemberCacheGateway_InvalidationLooksUpEmail(t *testing.T) {
+	// 只快取了 Email key 時，異動前查一次資料庫取得要失效的 Email
+	backend := newMapBackend()
+	g, persistence := newTestGateway(t, backend, OperationGetByEmail)
+	ctx := context.Background()
+	persistence.EXPECT().GetByEmail(gomock.Any(), "alice@example.com").Return(newAlice(), nil)
+	_, err := g.GetByEmail(ctx, "alice@example.com")
+	require.NoError(t, err)
+	assert.False(t, backend.has(idKey(1)))
+
+	gomock.InOrder(
+		persistence.EXPECT().GetByID(ctx, 1).Return(newAlice(), nil),
+		persistence.EXPECT().UpdatePassword(ctx, 1, "hashed").Return(nil),
+	)
+	require.NoError(t, g.UpdatePassword(ctx, 1, "hashed"))
+	assert.False(t, backend.has(emailKey("alice@example.com")))
+}
+
+func TestMemberCacheGateway_DisabledOperation(t *testing.T) {
+	backend := newMapBackend()
+	g, persistence := newTestGateway(t, backend, OperationGetByID)
+	ctx := context.Background()
+	persistence.EXPECT().GetByEmail(ctx, "alice@example.com").Return(newAlice(), nil).Times(2)
+	for i := 0; i < 2; i++ {
+		_, err := g.GetByEmail(ctx, "alice@example.com")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, Stats{}, g.Stats()[OperationGetByEmail])
+	assert.Empty(t, backend.items)
+}
+
+func TestMemberCacheGateway_Transaction(t *testing.T) {
+	backend := newMapBackend()
+	g, persistence := newTestGateway(t, backend)
+	ctrl := gomock.NewController(t)
+	txManager := mock.NewMockTransactionManager(ctrl)
+	txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	}).AnyTimes()
+	cachedTx := g.TransactionManager(txManager)
+	ctx := context.Background()
+
+	// 交易中查詢、UpdateStatus 與 Delete 前取得 Email、交易外查詢各一次
+	persistence.EXPECT().GetByID(gomock.Any(), 1).Return(newAlice(), nil).Times(4)
+	err := cachedTx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		// 交易中的查詢不讀也不寫快取
+		_, err := g.GetByID(txCtx, 1)
+		require.NoError(t, err)
+		assert.Empty(t, backend.items)
+
+		persistence.EXPECT().UpdateStatus(txCtx, 1, entity.MemberStatusActive, entity.MemberStatusSuspended, "").Return(nil)
+		require.NoError(t, g.UpdateStatus(txCtx, 1, entity.MemberStatusActive, entity.MemberStatusSuspended, ""))
+
+		// 模擬交易提交前其他請求把舊資料寫回快取
+		_, err = g.GetByID(ctx, 1)
+		require.NoError(t, err)
+		assert.True(t, backend.has(idKey(1)))
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Empty(t, backend.items)
+
+	// 交易失敗時同樣失效，錯誤原樣回傳
+	errRollback := errors.New("rollback")
+	err = cachedTx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		persistence.EXPECT().Delete(txCtx, 1).Return(nil)
+		require.NoError(t, g.Delete(txCtx, 1))
+		require.NoError(t, backend.Set(ctx, idKey(1), []byte("{}"), time.Minute))
+		return errRollback
+	})
+	assert.ErrorIs(t, err, errRollback)
+	assert.False(t, backend.has(idKey(1)))
+}
+
+func TestMemberCacheGateway_SingleFlight(t *testing.T) {
+	backend := newMapBackend()
+	g, persistence := newTestGateway(t, backend)
+	ctx := context.Background()
+	release := make(chan struct{})
+	persistence.EXPECT().GetByID(gomock.Any(), 1).DoAndReturn(func(context.Context, int) (*entity.Member, error) {
+		<-release
+		return newAlice(), nil
+	}).Times(1)
+
+	const callers = 8
+	var wg sync.WaitGroup
+	results := make(chan *entity.Member, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			member, err := g.GetByID(ctx, 1)
+			assert.NoError(t, err)
+			results <- member
+		}()
+	}
+	// 等所有呼叫端都 miss 後才放行資料庫查詢
+	require.Eventually(t, func() bool { return g.Stats()[OperationGetByID].Misses == callers }, time.Second, time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(results)
+
+	seen := map[*entity.Member]bool{}
+	for member := range results {
+		assert.Equal(t, newAlice(), member)
+		seen[member] = true
+	}
+	assert.Len(t, seen, callers, "每個呼叫端拿到各自的副本")
+}
+
+func TestMemberCacheGateway_BackendError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	backend := gatewaymock.NewMockCacheBackend(ctrl)
+	mockLogger := mocklogger.NewMockLogger(ctrl)
+	mockLogger.EXPECT().With(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).Times(3)
+	mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).Times(1)
+	persistence := mock.NewMockMemberPersistence(ctrl)
+	g := NewMemberCacheGateway(persistence, backend, Options{}, mockLogger, basic.NewTracer(basic.NewConfig("test", false)))
+	ctx := context.Background()
+	errBackend := errors.New("connection refused")
+
+	// 後端故障時改查資料庫，不影響回傳結果
+	backend.EXPECT().Get(gomock.Any(), idKey(1)).Return(nil, false, errBackend)
+	backend.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any(), DefaultTTL).Return(errBackend).Times(2)
+	persistence.EXPECT().GetByID(gomock.Any(), 1).Return(newAlice(), nil)
+	member, err := g.GetByID(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, newAlice(), member)
+
+	backend.EXPECT().Get(gomock.Any(), idKey(1)).Return([]byte(`{"id":1,"normalized_email":"alice@example.com"}`), true, nil)
+	backend.EXPECT().Delete(gomock.Any(), idKey(1), emailKey("alice@example.com")).Return(errBackend)
+	persistence.EXPECT().Delete(ctx, 1).Return(nil)
+	assert.NoError(t, g.Delete(ctx, 1))
+}
+
+func TestParseOperations(t *testing.T) {
+	operations, err := ParseOperations(nil)
+	require.NoError(t, err)
+	assert.Equal(t, AllOperations(), operations)
+
+	operations, err = ParseOperations([]string{"get_by_email"})
+	require.NoError(t, err)
+	assert.Equal(t, []Operation{OperationGetByEmail}, operations)
+
+	_, err = ParseOperations([]string{"get_all"})
+	assert.ErrorIs(t, err, ErrUnknownOperation)
+}
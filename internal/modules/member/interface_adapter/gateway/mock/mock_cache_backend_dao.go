@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: cache_backend_dao.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCacheBackend is a mock of CacheBackend interface.
+type MockCacheBackend struct {
+	ctrl     *gomock.Controller
+	recorder *MockCacheBackendMockRecorder
+}
+
+// MockCacheBackendMockRecorder is the mock recorder for MockCacheBackend.
+type MockCacheBackendMockRecorder struct {
+	mock *MockCacheBackend
+}
+
+// NewMockCacheBackend creates a new mock instance.
+func NewMockCacheBackend(ctrl *gomock.Controller) *MockCacheBackend {
+	mock := &MockCacheBackend{ctrl: ctrl}
+	mock.recorder = &MockCacheBackendMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCacheBackend) EXPECT() *MockCacheBackendMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockCacheBackend) Delete(ctx context.Context, keys ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range keys {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Delete", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCacheBackendMockRecorder) Delete(ctx interface{}, keys ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, keys...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCacheBackend)(nil).Delete), varargs...)
+}
+
+// Get mocks base method.
+func (m *MockCacheBackend) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCacheBackendMockRecorder) Get(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCacheBackend)(nil).Get), ctx, key)
+}
+
+// Set mocks base method.
+func (m *MockCacheBackend) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", ctx, key, value, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockCacheBackendMockRecorder) Set(ctx, key, value, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockCacheBackend)(nil).Set), ctx, key, value, ttl)
+}
@@ -0,0 +1,140 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: invitation_dao.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	dao "github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/dao"
+	pagination "github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+)
+
+// MockInvitationDAO is a mock of InvitationDAO interface.
+type MockInvitationDAO struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvitationDAOMockRecorder
+}
+
+// MockInvitationDAOMockRecorder is the mock recorder for MockInvitationDAO.
+type MockInvitationDAOMockRecorder struct {
+	mock *MockInvitationDAO
+}
+
+// NewMockInvitationDAO creates a new mock instance.
+func NewMockInvitationDAO(ctrl *gomock.Controller) *MockInvitationDAO {
+	mock := &MockInvitationDAO{ctrl: ctrl}
+	mock.recorder = &MockInvitationDAOMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvitationDAO) EXPECT() *MockInvitationDAOMockRecorder {
+	return m.recorder
+}
+
+// CountAll mocks base method.
+func (m *MockInvitationDAO) CountAll(ctx context.Context, q dao.InvitationQuery) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAll", ctx, q)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAll indicates an expected call of CountAll.
+func (mr *MockInvitationDAOMockRecorder) CountAll(ctx, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAll", reflect.TypeOf((*MockInvitationDAO)(nil).CountAll), ctx, q)
+}
+
+// Create mocks base method.
+func (m *MockInvitationDAO) Create(ctx context.Context, r *dao.InvitationRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, r)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockInvitationDAOMockRecorder) Create(ctx, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockInvitationDAO)(nil).Create), ctx, r)
+}
+
+// GetAll mocks base method.
+func (m *MockInvitationDAO) GetAll(ctx context.Context, q dao.InvitationQuery, p pagination.Pagination) ([]*dao.InvitationRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, q, p)
+	ret0, _ := ret[0].([]*dao.InvitationRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockInvitationDAOMockRecorder) GetAll(ctx, q, p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockInvitationDAO)(nil).GetAll), ctx, q, p)
+}
+
+// GetByCode mocks base method.
+func (m *MockInvitationDAO) GetByCode(ctx context.Context, code string) (*dao.InvitationRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCode", ctx, code)
+	ret0, _ := ret[0].(*dao.InvitationRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCode indicates an expected call of GetByCode.
+func (mr *MockInvitationDAOMockRecorder) GetByCode(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCode", reflect.TypeOf((*MockInvitationDAO)(nil).GetByCode), ctx, code)
+}
+
+// GetByID mocks base method.
+func (m *MockInvitationDAO) GetByID(ctx context.Context, id int) (*dao.InvitationRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*dao.InvitationRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockInvitationDAOMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockInvitationDAO)(nil).GetByID), ctx, id)
+}
+
+// Redeem mocks base method.
+func (m *MockInvitationDAO) Redeem(ctx context.Context, id, uses int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeem", ctx, id, uses)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Redeem indicates an expected call of Redeem.
+func (mr *MockInvitationDAOMockRecorder) Redeem(ctx, id, uses interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeem", reflect.TypeOf((*MockInvitationDAO)(nil).Redeem), ctx, id, uses)
+}
+
+// Revoke mocks base method.
+func (m *MockInvitationDAO) Revoke(ctx context.Context, id int, revokedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, id, revokedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockInvitationDAOMockRecorder) Revoke(ctx, id, revokedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockInvitationDAO)(nil).Revoke), ctx, id, revokedAt)
+}
@@ -36,19 +36,48 @@ func (m *MockMemberDAO) EXPECT() *MockMemberDAOMockRecorder {
 	return m.recorder
 }
 
+// AddTag mocks base method.
+func (m *MockMemberDAO) AddTag(ctx context.Context, memberID int, tag string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTag", ctx, memberID, tag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTag indicates an expected call of AddTag.
+func (mr *MockMemberDAOMockRecorder) AddTag(ctx, memberID, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTag", reflect.TypeOf((*MockMemberDAO)(nil).AddTag), ctx, memberID, tag)
+}
+
+// AddTagToMembers mocks base method.
+func (m *MockMemberDAO) AddTagToMembers(ctx context.Context, memberIDs []int, tag string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTagToMembers", ctx, memberIDs, tag)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTagToMembers indicates an expected call of AddTagToMembers.
+func (mr *MockMemberDAOMockRecorder) AddTagToMembers(ctx, memberIDs, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTagToMembers", reflect.TypeOf((*MockMemberDAO)(nil).AddTagToMembers), ctx, memberIDs, tag)
+}
+
 // CountAll mocks base method.
-func (m *MockMemberDAO) CountAll(ctx context.Context) (int, error) {
+func (m *MockMemberDAO) CountAll(ctx context.Context, q dao.MemberQuery) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CountAll", ctx)
+	ret := m.ctrl.Call(m, "CountAll", ctx, q)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CountAll indicates an expected call of CountAll.
-func (mr *MockMemberDAOMockRecorder) CountAll(ctx interface{}) *gomock.Call {
+func (mr *MockMemberDAOMockRecorder) CountAll(ctx, q interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAll", reflect.TypeOf((*MockMemberDAO)(nil).CountAll), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAll", reflect.TypeOf((*MockMemberDAO)(nil).CountAll), ctx, q)
 }
 
 // Create mocks base method.
@@ -80,33 +109,33 @@ func (mr *MockMemberDAOMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
 }
 
 // GetAll mocks base method.
-func (m *MockMemberDAO) GetAll(ctx context.Context, p pagination.Pagination) ([]*dao.MemberRecord, error) {
+func (m *MockMemberDAO) GetAll(ctx context.Context, q dao.MemberQuery, p pagination.Pagination) ([]*dao.MemberRecord, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", ctx, p)
+	ret := m.ctrl.Call(m, "GetAll", ctx, q, p)
 	ret0, _ := ret[0].([]*dao.MemberRecord)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockMemberDAOMockRecorder) GetAll(ctx, p interface{}) *gomock.Call {
+func (mr *MockMemberDAOMockRecorder) GetAll(ctx, q, p interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockMemberDAO)(nil).GetAll), ctx, p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockMemberDAO)(nil).GetAll), ctx, q, p)
 }
 
 // GetByEmail mocks base method.
-func (m *MockMemberDAO) GetByEmail(ctx context.Context, email string) (*dao.MemberRecord, error) {
+func (m *MockMemberDAO) GetByEmail(ctx context.Context, normalizedEmail string) (*dao.MemberRecord, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByEmail", ctx, email)
+	ret := m.ctrl.Call(m, "GetByEmail", ctx, normalizedEmail)
 	ret0, _ := ret[0].(*dao.MemberRecord)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByEmail indicates an expected call of GetByEmail.
-func (mr *MockMemberDAOMockRecorder) GetByEmail(ctx, email interface{}) *gomock.Call {
+func (mr *MockMemberDAOMockRecorder) GetByEmail(ctx, normalizedEmail interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEmail", reflect.TypeOf((*MockMemberDAO)(nil).GetByEmail), ctx, email)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEmail", reflect.TypeOf((*MockMemberDAO)(nil).GetByEmail), ctx, normalizedEmail)
 }
 
 // GetByID mocks base method.
@@ -124,18 +153,91 @@ func (mr *MockMemberDAOMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockMemberDAO)(nil).GetByID), ctx, id)
 }
 
+// ListTags mocks base method.
+func (m *MockMemberDAO) ListTags(ctx context.Context, memberID int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTags", ctx, memberID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTags indicates an expected call of ListTags.
+func (mr *MockMemberDAOMockRecorder) ListTags(ctx, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTags", reflect.TypeOf((*MockMemberDAO)(nil).ListTags), ctx, memberID)
+}
+
+// MarkMerged mocks base method.
+func (m *MockMemberDAO) MarkMerged(ctx context.Context, sourceID, targetID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkMerged", ctx, sourceID, targetID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkMerged indicates an expected call of MarkMerged.
+func (mr *MockMemberDAOMockRecorder) MarkMerged(ctx, sourceID, targetID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkMerged", reflect.TypeOf((*MockMemberDAO)(nil).MarkMerged), ctx, sourceID, targetID)
+}
+
+// MergeTags mocks base method.
+func (m *MockMemberDAO) MergeTags(ctx context.Context, sourceID, targetID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeTags", ctx, sourceID, targetID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeTags indicates an expected call of MergeTags.
+func (mr *MockMemberDAOMockRecorder) MergeTags(ctx, sourceID, targetID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeTags", reflect.TypeOf((*MockMemberDAO)(nil).MergeTags), ctx, sourceID, targetID)
+}
+
+// RemoveTag mocks base method.
+func (m *MockMemberDAO) RemoveTag(ctx context.Context, memberID int, tag string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTag", ctx, memberID, tag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveTag indicates an expected call of RemoveTag.
+func (mr *MockMemberDAOMockRecorder) RemoveTag(ctx, memberID, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTag", reflect.TypeOf((*MockMemberDAO)(nil).RemoveTag), ctx, memberID, tag)
+}
+
 // UpdateEmail mocks base method.
-func (m *MockMemberDAO) UpdateEmail(ctx context.Context, id int, newEmail string) error {
+func (m *MockMemberDAO) UpdateEmail(ctx context.Context, id int, newEmail, normalizedEmail string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateEmail", ctx, id, newEmail)
+	ret := m.ctrl.Call(m, "UpdateEmail", ctx, id, newEmail, normalizedEmail)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateEmail indicates an expected call of UpdateEmail.
-func (mr *MockMemberDAOMockRecorder) UpdateEmail(ctx, id, newEmail interface{}) *gomock.Call {
+func (mr *MockMemberDAOMockRecorder) UpdateEmail(ctx, id, newEmail, normalizedEmail interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmail", reflect.TypeOf((*MockMemberDAO)(nil).UpdateEmail), ctx, id, newEmail)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmail", reflect.TypeOf((*MockMemberDAO)(nil).UpdateEmail), ctx, id, newEmail, normalizedEmail)
+}
+
+// UpdateNormalizedEmail mocks base method.
+func (m *MockMemberDAO) UpdateNormalizedEmail(ctx context.Context, id int, normalizedEmail string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNormalizedEmail", ctx, id, normalizedEmail)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNormalizedEmail indicates an expected call of UpdateNormalizedEmail.
+func (mr *MockMemberDAOMockRecorder) UpdateNormalizedEmail(ctx, id, normalizedEmail interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNormalizedEmail", reflect.TypeOf((*MockMemberDAO)(nil).UpdateNormalizedEmail), ctx, id, normalizedEmail)
 }
 
 // UpdatePassword mocks base method.
@@ -166,3 +268,17 @@ func (mr *MockMemberDAOMockRecorder) UpdateProfile(ctx, m interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProfile", reflect.TypeOf((*MockMemberDAO)(nil).UpdateProfile), ctx, m)
 }
+
+// UpdateStatus mocks base method.
+func (m *MockMemberDAO) UpdateStatus(ctx context.Context, id int, from, to, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, id, from, to, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockMemberDAOMockRecorder) UpdateStatus(ctx, id, from, to, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockMemberDAO)(nil).UpdateStatus), ctx, id, from, to, reason)
+}
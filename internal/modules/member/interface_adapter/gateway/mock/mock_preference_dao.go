@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: preference_dao.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	dao "github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/dao"
+)
+
+// MockPreferenceDAO is a mock of PreferenceDAO interface.
+type MockPreferenceDAO struct {
+	ctrl     *gomock.Controller
+	recorder *MockPreferenceDAOMockRecorder
+}
+
+// MockPreferenceDAOMockRecorder is the mock recorder for MockPreferenceDAO.
+type MockPreferenceDAOMockRecorder struct {
+	mock *MockPreferenceDAO
+}
+
+// NewMockPreferenceDAO creates a new mock instance.
+func NewMockPreferenceDAO(ctrl *gomock.Controller) *MockPreferenceDAO {
+	mock := &MockPreferenceDAO{ctrl: ctrl}
+	mock.recorder = &MockPreferenceDAOMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPreferenceDAO) EXPECT() *MockPreferenceDAOMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockPreferenceDAO) Delete(ctx context.Context, memberID int, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, memberID, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPreferenceDAOMockRecorder) Delete(ctx, memberID, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPreferenceDAO)(nil).Delete), ctx, memberID, key)
+}
+
+// DeleteByMember mocks base method.
+func (m *MockPreferenceDAO) DeleteByMember(ctx context.Context, memberID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByMember", ctx, memberID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteByMember indicates an expected call of DeleteByMember.
+func (mr *MockPreferenceDAOMockRecorder) DeleteByMember(ctx, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByMember", reflect.TypeOf((*MockPreferenceDAO)(nil).DeleteByMember), ctx, memberID)
+}
+
+// GetByMember mocks base method.
+func (m *MockPreferenceDAO) GetByMember(ctx context.Context, memberID int) ([]*dao.PreferenceRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByMember", ctx, memberID)
+	ret0, _ := ret[0].([]*dao.PreferenceRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByMember indicates an expected call of GetByMember.
+func (mr *MockPreferenceDAOMockRecorder) GetByMember(ctx, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByMember", reflect.TypeOf((*MockPreferenceDAO)(nil).GetByMember), ctx, memberID)
+}
+
+// Upsert mocks base method.
+func (m *MockPreferenceDAO) Upsert(ctx context.Context, r *dao.PreferenceRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, r)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockPreferenceDAOMockRecorder) Upsert(ctx, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockPreferenceDAO)(nil).Upsert), ctx, r)
+}
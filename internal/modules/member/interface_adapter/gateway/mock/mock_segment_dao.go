@@ -0,0 +1,125 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: segment_dao.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	dao "github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/dao"
+	pagination "github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+)
+
+// MockSegmentDAO is a mock of SegmentDAO interface.
+type MockSegmentDAO struct {
+	ctrl     *gomock.Controller
+	recorder *MockSegmentDAOMockRecorder
+}
+
+// MockSegmentDAOMockRecorder is the mock recorder for MockSegmentDAO.
+type MockSegmentDAOMockRecorder struct {
+	mock *MockSegmentDAO
+}
+
+// NewMockSegmentDAO creates a new mock instance.
+func NewMockSegmentDAO(ctrl *gomock.Controller) *MockSegmentDAO {
+	mock := &MockSegmentDAO{ctrl: ctrl}
+	mock.recorder = &MockSegmentDAOMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSegmentDAO) EXPECT() *MockSegmentDAOMockRecorder {
+	return m.recorder
+}
+
+// CountAll mocks base method.
+func (m *MockSegmentDAO) CountAll(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAll", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAll indicates an expected call of CountAll.
+func (mr *MockSegmentDAOMockRecorder) CountAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAll", reflect.TypeOf((*MockSegmentDAO)(nil).CountAll), ctx)
+}
+
+// Create mocks base method.
+func (m *MockSegmentDAO) Create(ctx context.Context, r *dao.SegmentRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, r)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSegmentDAOMockRecorder) Create(ctx, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSegmentDAO)(nil).Create), ctx, r)
+}
+
+// Delete mocks base method.
+func (m *MockSegmentDAO) Delete(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSegmentDAOMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSegmentDAO)(nil).Delete), ctx, id)
+}
+
+// GetAll mocks base method.
+func (m *MockSegmentDAO) GetAll(ctx context.Context, p pagination.Pagination) ([]*dao.SegmentRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, p)
+	ret0, _ := ret[0].([]*dao.SegmentRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockSegmentDAOMockRecorder) GetAll(ctx, p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockSegmentDAO)(nil).GetAll), ctx, p)
+}
+
+// GetByID mocks base method.
+func (m *MockSegmentDAO) GetByID(ctx context.Context, id int) (*dao.SegmentRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*dao.SegmentRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockSegmentDAOMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockSegmentDAO)(nil).GetByID), ctx, id)
+}
+
+// GetByName mocks base method.
+func (m *MockSegmentDAO) GetByName(ctx context.Context, name string) (*dao.SegmentRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByName", ctx, name)
+	ret0, _ := ret[0].(*dao.SegmentRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByName indicates an expected call of GetByName.
+func (mr *MockSegmentDAOMockRecorder) GetByName(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByName", reflect.TypeOf((*MockSegmentDAO)(nil).GetByName), ctx, name)
+}
@@ -0,0 +1,12 @@
+package outbox
+
+import "errors"
+
+var (
+	// ErrGatewayEventEncodeFailed 領域事件序列化失敗。
+	ErrGatewayEventEncodeFailed = errors.New("gateway: member event encode failed")
+	// ErrGatewayEventEnqueueFailed 呼叫 outbox 模組寫入事件失敗。
+	ErrGatewayEventEnqueueFailed = errors.New("gateway: member event enqueue failed")
+	// ErrGatewayEventRedactFailed 呼叫 outbox 或 webhook 模組遮蔽事件個資失敗。
+	ErrGatewayEventRedactFailed = errors.New("gateway: member event redact failed")
+)
@@ -0,0 +1,120 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
+	outboxentity "github.com/tomoffice/go-clean-architecture/internal/modules/outbox/entity"
+	outboxinputmodel "github.com/tomoffice/go-clean-architecture/internal/modules/outbox/usecase/inputmodel"
+	outboxinput "github.com/tomoffice/go-clean-architecture/internal/modules/outbox/usecase/port/input"
+	webhookinputmodel "github.com/tomoffice/go-clean-architecture/internal/modules/webhook/usecase/inputmodel"
+	webhookinput "github.com/tomoffice/go-clean-architecture/internal/modules/webhook/usecase/port/input"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
+	"strconv"
+)
+
+// aggregateType 會員事件在 outbox 中的 aggregate 類型
+const aggregateType = "member"
+
+// eventPersonalDataFields 個資刪除時需在事件 payload 中遮蔽的欄位（對應會員領域事件的 json tag）
+var eventPersonalDataFields = []string{"name", "email", "old_email", "new_email"}
+
+// MemberOutboxGateway 透過 outbox 模組的 input port 實作 output.EventOutbox；
+// webhook 為 nil 時個資刪除只遮蔽 outbox 事件
+type MemberOutboxGateway struct {
+	outbox  outboxinput.OutboxInputPort
+	webhook webhookinput.WebhookInputPort
+	logger  logger.Logger
+	tracer  tracer.Tracer
+}
+
+func NewMemberOutboxGateway(outbox outboxinput.OutboxInputPort, webhook webhookinput.WebhookInputPort, log logger.Logger, tracer tracer.Tracer) output.EventOutbox {
+	baseLogger := log.With(logger.NewField("layer", "gateway"))
+	return MemberOutboxGateway{
+		outbox:  outbox,
+		webhook: webhook,
+		logger:  baseLogger,
+		tracer:  tracer,
+	}
+}
+
+func (g MemberOutboxGateway) Add(ctx context.Context, events ...entity.DomainEvent) error {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, span := g.tracer.Start(ctx, "Gateway.OutboxAdd")
+	defer span.End()
+	traceLogger := g.logger.WithContext(gatewayCtx)
+
+	outboxEvents := make([]*outboxentity.OutboxEvent, 0, len(events))
+	for _, event := range events {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			traceLogger.Error("會員領域事件序列化失敗",
+				logger.NewField("error", err),
+				logger.NewField("event_type", event.EventName()),
+			)
+			return fmt.Errorf("%w: %w: %v", usecase.ErrMemberEventOutboxError, ErrGatewayEventEncodeFailed, err)
+		}
+		outboxEvents = append(outboxEvents, &outboxentity.OutboxEvent{
+			EventType:     event.EventName(),
+			AggregateType: aggregateType,
+			AggregateID:   strconv.Itoa(event.AggregateID()),
+			Payload:       payload,
+			CreatedAt:     event.OccurredAt(),
+		})
+	}
+	if err := g.outbox.Enqueue(gatewayCtx, outboxEvents...); err != nil {
+		traceLogger.Error("會員領域事件寫入 outbox 失敗",
+			logger.NewField("error", err),
+			logger.NewField("count", len(outboxEvents)),
+		)
+		return fmt.Errorf("%w: %w: %v", usecase.ErrMemberEventOutboxError, ErrGatewayEventEnqueueFailed, err)
+	}
+	traceLogger.Debug("會員領域事件寫入 outbox 成功",
+		logger.NewField("count", len(outboxEvents)),
+	)
+	return nil
+}
+
+func (g MemberOutboxGateway) RedactMember(ctx context.Context, memberID int) error {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, span := g.tracer.Start(ctx, "Gateway.OutboxRedactMember")
+	defer span.End()
+	traceLogger := g.logger.WithContext(gatewayCtx)
+
+	eventIDs, err := g.outbox.RedactAggregate(gatewayCtx, &outboxinputmodel.RedactAggregateInputModel{
+		AggregateType: aggregateType,
+		AggregateID:   strconv.Itoa(memberID),
+		Fields:        eventPersonalDataFields,
+	})
+	if err != nil {
+		traceLogger.Error("會員領域事件遮蔽 outbox 失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", memberID),
+		)
+		return fmt.Errorf("%w: %w: %v", usecase.ErrMemberEventOutboxError, ErrGatewayEventRedactFailed, err)
+	}
+	if g.webhook == nil || len(eventIDs) == 0 {
+		return nil
+	}
+	redacted, err := g.webhook.RedactDeliveries(gatewayCtx, &webhookinputmodel.RedactDeliveriesInputModel{
+		EventIDs: eventIDs,
+		Fields:   eventPersonalDataFields,
+	})
+	if err != nil {
+		traceLogger.Error("會員領域事件遮蔽 webhook 投遞紀錄失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", memberID),
+		)
+		return fmt.Errorf("%w: %w: %v", usecase.ErrMemberEventOutboxError, ErrGatewayEventRedactFailed, err)
+	}
+	traceLogger.Debug("會員領域事件個資遮蔽成功",
+		logger.NewField("member_id", memberID),
+		logger.NewField("events", len(eventIDs)),
+		logger.NewField("redacted_deliveries", redacted),
+	)
+	return nil
+}
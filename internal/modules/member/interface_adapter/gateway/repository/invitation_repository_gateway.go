@@ -0,0 +1,196 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/sqlx/mcsqlite"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/dao"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
+	"time"
+)
+
+type InvitationRepoGateway struct {
+	dao    dao.InvitationDAO
+	logger logger.Logger
+	tracer tracer.Tracer
+}
+
+func NewInvitationRepoGateway(dao dao.InvitationDAO, log logger.Logger, tracer tracer.Tracer) output.InvitationPersistence {
+	baseLogger := log.With(logger.NewField("layer", "gateway"))
+	return InvitationRepoGateway{
+		dao:    dao,
+		logger: baseLogger,
+		tracer: tracer,
+	}
+}
+
+func (g InvitationRepoGateway) Create(ctx context.Context, invitation *entity.Invitation) error {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.CreateInvitation")
+	defer span.End()
+
+	if err := g.dao.Create(gatewayCtx, invitationToRecord(invitation)); err != nil {
+		traceLogger.Error("邀請碼資料庫創建失敗", logger.NewField("error", err), logger.NewField("created_by", invitation.CreatedBy))
+		return mapInvitationInfraError(err)
+	}
+	traceLogger.Debug("邀請碼資料庫創建成功", logger.NewField("created_by", invitation.CreatedBy))
+	return nil
+}
+
+func (g InvitationRepoGateway) GetByID(ctx context.Context, id int) (*entity.Invitation, error) {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.GetInvitationByID")
+	defer span.End()
+
+	record, err := g.dao.GetByID(gatewayCtx, id)
+	if err != nil {
+		traceLogger.Error("邀請碼資料庫查詢(ID)失敗", logger.NewField("error", err), logger.NewField("invitation_id", id))
+		return nil, mapInvitationInfraError(err)
+	}
+	traceLogger.Debug("邀請碼資料庫查詢(ID)成功", logger.NewField("invitation_id", id))
+	return invitationRecordToEntity(record), nil
+}
+
+func (g InvitationRepoGateway) GetByCode(ctx context.Context, code string) (*entity.Invitation, error) {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.GetInvitationByCode")
+	defer span.End()
+
+	// 邀請碼等同密碼，log 不記錄內容
+	record, err := g.dao.GetByCode(gatewayCtx, code)
+	if err != nil {
+		traceLogger.Debug("邀請碼資料庫查詢(Code)失敗", logger.NewField("error", err))
+		return nil, mapInvitationInfraError(err)
+	}
+	traceLogger.Debug("邀請碼資料庫查詢(Code)成功", logger.NewField("invitation_id", record.ID))
+	return invitationRecordToEntity(record), nil
+}
+
+func (g InvitationRepoGateway) GetAll(ctx context.Context, filter output.InvitationFilter, pagination pagination.Pagination) ([]*entity.Invitation, error) {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.GetAllInvitations")
+	defer span.End()
+
+	records, err := g.dao.GetAll(gatewayCtx, dao.InvitationQuery{CreatedBy: filter.CreatedBy}, pagination)
+	if err != nil {
+		traceLogger.Error("邀請碼資料庫列表查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("limit", pagination.Limit),
+			logger.NewField("offset", pagination.Offset),
+		)
+		return nil, mapInvitationInfraError(err)
+	}
+	invitations := make([]*entity.Invitation, 0, len(records))
+	for _, record := range records {
+		invitations = append(invitations, invitationRecordToEntity(record))
+	}
+	traceLogger.Debug("邀請碼資料庫列表查詢成功",
+		logger.NewField("count", len(invitations)),
+	)
+	return invitations, nil
+}
+
+func (g InvitationRepoGateway) CountAll(ctx context.Context, filter output.InvitationFilter) (int, error) {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.CountAllInvitations")
+	defer span.End()
+
+	count, err := g.dao.CountAll(gatewayCtx, dao.InvitationQuery{CreatedBy: filter.CreatedBy})
+	if err != nil {
+		traceLogger.Error("邀請碼資料庫總數查詢失敗", logger.NewField("error", err))
+		return 0, mapInvitationInfraError(err)
+	}
+	traceLogger.Debug("邀請碼資料庫總數查詢成功", logger.NewField("count", count))
+	return count, nil
+}
+
+func (g InvitationRepoGateway) Redeem(ctx context.Context, id, uses int) error {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.RedeemInvitation")
+	defer span.End()
+
+	if err := g.dao.Redeem(gatewayCtx, id, uses); err != nil {
+		traceLogger.Error("邀請碼資料庫使用失敗",
+			logger.NewField("error", err),
+			logger.NewField("invitation_id", id),
+			logger.NewField("uses", uses),
+		)
+		return mapInvitationInfraError(err)
+	}
+	traceLogger.Debug("邀請碼資料庫使用成功", logger.NewField("invitation_id", id))
+	return nil
+}
+
+func (g InvitationRepoGateway) Revoke(ctx context.Context, id int, revokedAt time.Time) error {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.RevokeInvitation")
+	defer span.End()
+
+	if err := g.dao.Revoke(gatewayCtx, id, revokedAt); err != nil {
+		traceLogger.Error("邀請碼資料庫撤銷失敗", logger.NewField("error", err), logger.NewField("invitation_id", id))
+		return mapInvitationInfraError(err)
+	}
+	traceLogger.Debug("邀請碼資料庫撤銷成功", logger.NewField("invitation_id", id))
+	return nil
+}
+
+// mapInvitationInfraError 查無資料與重複鍵轉為邀請碼專屬錯誤，其餘沿用會員的錯誤轉換
+func mapInvitationInfraError(err error) error {
+	switch {
+	case errors.Is(err, mcsqlite.ErrDBRecordNotFound):
+		return usecase.ErrInvitationNotFound
+	case errors.Is(err, mcsqlite.ErrDBDuplicateKey):
+		return usecase.ErrInvitationAlreadyExists
+	}
+	return MapInfraErrorToUsecaseError(err)
+}
+
+func invitationToRecord(invitation *entity.Invitation) *dao.InvitationRecord {
+	return &dao.InvitationRecord{
+		ID:         invitation.ID,
+		Code:       invitation.Code,
+		CreatedBy:  invitation.CreatedBy,
+		ReferrerID: invitation.ReferrerID,
+		Email:      invitation.Email,
+		MaxUses:    invitation.MaxUses,
+		Uses:       invitation.Uses,
+		ExpiresAt:  timeToPtr(invitation.ExpiresAt),
+		RevokedAt:  timeToPtr(invitation.RevokedAt),
+		CreatedAt:  invitation.CreatedAt,
+	}
+}
+
+func invitationRecordToEntity(record *dao.InvitationRecord) *entity.Invitation {
+	return &entity.Invitation{
+		ID:         record.ID,
+		Code:       record.Code,
+		CreatedBy:  record.CreatedBy,
+		ReferrerID: record.ReferrerID,
+		Email:      record.Email,
+		MaxUses:    record.MaxUses,
+		Uses:       record.Uses,
+		ExpiresAt:  ptrToTime(record.ExpiresAt),
+		RevokedAt:  ptrToTime(record.RevokedAt),
+		CreatedAt:  record.CreatedAt,
+	}
+}
+
+// timeToPtr 零值時間表示未設定，轉為 nil
+func timeToPtr(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func ptrToTime(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
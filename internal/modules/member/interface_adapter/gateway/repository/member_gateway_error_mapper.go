@@ -3,6 +3,7 @@ package repository
 import (
 	"errors"
 	"fmt"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/sqlx/mcsqlite"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase"
 )
@@ -16,20 +17,45 @@ func MapInfraErrorToUsecaseError(err error) error {
 	if errors.Is(err, ErrGatewayMemberMappingError) {
 		return usecase.ErrMemberMappingError
 	}
+	// entity 不變條件錯誤（例如 repository 以 NewMember 建立 entity）
+	switch {
+	case errors.Is(err, entity.ErrInvalidEmailFormat):
+		return fmt.Errorf("%w: %v", usecase.ErrMemberInvalidEmail, err)
+	case errors.Is(err, entity.ErrNameTooShort), errors.Is(err, entity.ErrNameTooLong):
+		return fmt.Errorf("%w: %v", usecase.ErrMemberInvalidName, err)
+	}
 	// 先比對 CustomError
 	switch {
 	case errors.Is(err, mcsqlite.ErrDBRecordNotFound):
 		return usecase.ErrMemberNotFound
-	case errors.Is(err, mcsqlite.ErrDBDuplicateKey):
+	case errors.Is(err, mcsqlite.ErrDBDuplicateKey), errors.Is(err, mcsqlite.ErrDBPrimaryKeyConflict):
 		return usecase.ErrMemberAlreadyExists
 	case errors.Is(err, mcsqlite.ErrDBNoEffect):
 		return usecase.ErrMemberNoEffect
+	case errors.Is(err, mcsqlite.ErrDBSerializationFailure):
+		return usecase.ErrMemberConcurrentUpdate
 	}
-	// 再處理 DBError 類型
+	// 再處理 DBError 類型，約束錯誤帶上違反的欄位或約束名稱
 	var dbErr *mcsqlite.DBError
 	if errors.As(err, &dbErr) {
+		switch {
+		case errors.Is(err, mcsqlite.ErrDBForeignKeyViolation):
+			return withConstraint(usecase.ErrMemberReferenceConflict, dbErr)
+		case errors.Is(err, mcsqlite.ErrDBNotNullViolation), errors.Is(err, mcsqlite.ErrDBCheckViolation):
+			return withConstraint(usecase.ErrMemberConstraintViolation, dbErr)
+		case errors.Is(err, mcsqlite.ErrDBBusy), errors.Is(err, mcsqlite.ErrDBReadOnly), errors.Is(err, mcsqlite.ErrDBFull):
+			return fmt.Errorf("%w: %v", usecase.ErrMemberStorageUnavailable, dbErr.RawError)
+		}
 		return fmt.Errorf("%w: %v", usecase.ErrMemberDBError, dbErr.RawError)
 	}
 	// fallback：其他未知錯誤
 	return usecase.ErrMemberUnexpectedError
 }
+
+// withConstraint 在 usecase 錯誤後附上違反的欄位或約束名稱，資料庫沒有提供時只附上原始錯誤
+func withConstraint(usecaseErr error, dbErr *mcsqlite.DBError) error {
+	if dbErr.Constraint == "" {
+		return fmt.Errorf("%w: %v", usecaseErr, dbErr.RawError)
+	}
+	return fmt.Errorf("%w: %s", usecaseErr, dbErr.Constraint)
+}
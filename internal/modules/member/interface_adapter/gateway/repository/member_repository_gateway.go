@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"crypto/subtle"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/dao"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
@@ -30,10 +31,13 @@ func (g MemberRepoGateway) Create(ctx context.Context, m *entity.Member) error {
 	defer span.End()
 
 	record := &dao.MemberRecord{
-		Name:      m.Name,
-		Email:     m.Email,
-		Password:  m.Password,
-		CreatedAt: m.CreatedAt,
+		Name:            m.Name,
+		Email:           m.Email,
+		Password:        m.Password,
+		CreatedAt:       m.CreatedAt,
+		NormalizedEmail: m.NormalizedEmail,
+		Status:          string(m.Status),
+		ReferredBy:      m.ReferredBy,
 	}
 	if err := g.dao.Create(gatewayCtx, record); err != nil {
 		traceLogger.Error("會員資料庫創建失敗", logger.NewField("error", err), logger.NewField("member_email", m.Email))
@@ -53,52 +57,53 @@ func (g MemberRepoGateway) GetByID(ctx context.Context, id int) (*entity.Member,
 		traceLogger.Error("會員資料庫查詢(ID)失敗", logger.NewField("error", err), logger.NewField("member_id", id))
 		return nil, MapInfraErrorToUsecaseError(err)
 	}
-	member := &entity.Member{
-		ID:        record.ID,
-		Name:      record.Name,
-		Email:     record.Email,
-		Password:  "",
-		CreatedAt: record.CreatedAt,
-	}
+	member := recordToEntity(record)
 	traceLogger.Debug("會員資料庫查詢(ID)成功", logger.NewField("member_id", member.ID), logger.NewField("member_email", member.Email))
 	return member, nil
 }
 
-func (g MemberRepoGateway) GetByEmail(ctx context.Context, email string) (*entity.Member, error) {
+func (g MemberRepoGateway) VerifyPassword(ctx context.Context, id int, password string) (bool, error) {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.VerifyPassword")
+	defer span.End()
+
+	record, err := g.dao.GetByID(gatewayCtx, id)
+	if err != nil {
+		traceLogger.Error("會員密碼比對查詢失敗", logger.NewField("error", err), logger.NewField("member_id", id))
+		return false, MapInfraErrorToUsecaseError(err)
+	}
+	return subtle.ConstantTimeCompare([]byte(record.Password), []byte(password)) == 1, nil
+}
+
+func (g MemberRepoGateway) GetByEmail(ctx context.Context, normalizedEmail string) (*entity.Member, error) {
 	// 創建帶有 trace 的 logger 用於追蹤
 	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.GetByEmail")
 	defer span.End()
 
-	record, err := g.dao.GetByEmail(gatewayCtx, email)
+	record, err := g.dao.GetByEmail(gatewayCtx, normalizedEmail)
 	if err != nil {
 		traceLogger.Error("會員資料庫查詢(Email)失敗",
 			logger.NewField("error", err),
-			logger.NewField("member_email", email),
+			logger.NewField("normalized_email", normalizedEmail),
 		)
 		return nil, MapInfraErrorToUsecaseError(err)
 	}
 
-	member := &entity.Member{
-		ID:        record.ID,
-		Name:      record.Name,
-		Email:     record.Email,
-		Password:  "",
-		CreatedAt: record.CreatedAt,
-	}
+	member := recordToEntity(record)
 
 	traceLogger.Debug("會員資料庫查詢(Email)成功",
 		logger.NewField("member_id", member.ID),
-		logger.NewField("member_email", email),
+		logger.NewField("normalized_email", normalizedEmail),
 	)
 	return member, nil
 }
 
-func (g MemberRepoGateway) GetAll(ctx context.Context, pagination pagination.Pagination) ([]*entity.Member, error) {
+func (g MemberRepoGateway) GetAll(ctx context.Context, filter output.MemberFilter, pagination pagination.Pagination) ([]*entity.Member, error) {
 	// 創建帶有 trace 的 logger 用於追蹤
 	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.GetAll")
 	defer span.End()
 
-	records, err := g.dao.GetAll(gatewayCtx, pagination)
+	records, err := g.dao.GetAll(gatewayCtx, filterToQuery(filter), pagination)
 	if err != nil {
 		traceLogger.Error("會員資料庫列表查詢失敗",
 			logger.NewField("error", err),
@@ -109,13 +114,7 @@ func (g MemberRepoGateway) GetAll(ctx context.Context, pagination pagination.Pag
 	}
 	members := make([]*entity.Member, 0, len(records))
 	for _, record := range records {
-		members = append(members, &entity.Member{
-			ID:        record.ID,
-			Name:      record.Name,
-			Email:     record.Email,
-			Password:  "",
-			CreatedAt: record.CreatedAt,
-		})
+		members = append(members, recordToEntity(record))
 	}
 	traceLogger.Debug("會員資料庫列表查詢成功",
 		logger.NewField("count", len(members)),
@@ -152,12 +151,12 @@ func (g MemberRepoGateway) UpdateProfile(ctx context.Context, m *entity.Member)
 	return m, nil
 }
 
-func (g MemberRepoGateway) UpdateEmail(ctx context.Context, id int, newEmail string) error {
+func (g MemberRepoGateway) UpdateEmail(ctx context.Context, id int, newEmail, normalizedEmail string) error {
 	// 創建帶有 trace 的 logger 用於追蹤
 	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.UpdateEmail")
 	defer span.End()
 
-	err := g.dao.UpdateEmail(gatewayCtx, id, newEmail)
+	err := g.dao.UpdateEmail(gatewayCtx, id, newEmail, normalizedEmail)
 	if err != nil {
 		traceLogger.Error("會員資料庫 Email 更新失敗",
 			logger.NewField("error", err),
@@ -174,6 +173,28 @@ func (g MemberRepoGateway) UpdateEmail(ctx context.Context, id int, newEmail str
 	return nil
 }
 
+func (g MemberRepoGateway) UpdateNormalizedEmail(ctx context.Context, id int, normalizedEmail string) error {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.UpdateNormalizedEmail")
+	defer span.End()
+
+	err := g.dao.UpdateNormalizedEmail(gatewayCtx, id, normalizedEmail)
+	if err != nil {
+		traceLogger.Error("會員資料庫正規化 Email 更新失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+			logger.NewField("normalized_email", normalizedEmail),
+		)
+		return MapInfraErrorToUsecaseError(err)
+	}
+
+	traceLogger.Debug("會員資料庫正規化 Email 更新成功",
+		logger.NewField("member_id", id),
+		logger.NewField("normalized_email", normalizedEmail),
+	)
+	return nil
+}
+
 func (g MemberRepoGateway) UpdatePassword(ctx context.Context, id int, newPassword string) error {
 	// 創建帶有 trace 的 logger 用於追蹤
 	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.UpdatePassword")
@@ -194,6 +215,52 @@ func (g MemberRepoGateway) UpdatePassword(ctx context.Context, id int, newPasswo
 	return nil
 }
 
+func (g MemberRepoGateway) UpdateStatus(ctx context.Context, id int, from, to entity.MemberStatus, reason string) error {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.UpdateStatus")
+	defer span.End()
+
+	err := g.dao.UpdateStatus(gatewayCtx, id, string(from), string(to), reason)
+	if err != nil {
+		traceLogger.Error("會員資料庫狀態更新失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+			logger.NewField("from_status", from),
+			logger.NewField("to_status", to),
+		)
+		return MapInfraErrorToUsecaseError(err)
+	}
+
+	traceLogger.Debug("會員資料庫狀態更新成功",
+		logger.NewField("member_id", id),
+		logger.NewField("to_status", to),
+	)
+	return nil
+}
+
+func (g MemberRepoGateway) MarkMerged(ctx context.Context, sourceID, targetID int) (int, error) {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.MarkMerged")
+	defer span.End()
+
+	redirected, err := g.dao.MarkMerged(gatewayCtx, sourceID, targetID)
+	if err != nil {
+		traceLogger.Error("會員資料庫合併標記失敗",
+			logger.NewField("error", err),
+			logger.NewField("source_id", sourceID),
+			logger.NewField("target_id", targetID),
+		)
+		return 0, MapInfraErrorToUsecaseError(err)
+	}
+
+	traceLogger.Debug("會員資料庫合併標記成功",
+		logger.NewField("source_id", sourceID),
+		logger.NewField("target_id", targetID),
+		logger.NewField("redirected", redirected),
+	)
+	return redirected, nil
+}
+
 func (g MemberRepoGateway) Delete(ctx context.Context, id int) error {
 	// 創建帶有 trace 的 logger 用於追蹤
 	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.Delete")
@@ -214,12 +281,12 @@ func (g MemberRepoGateway) Delete(ctx context.Context, id int) error {
 	return nil
 }
 
-func (g MemberRepoGateway) CountAll(ctx context.Context) (int, error) {
+func (g MemberRepoGateway) CountAll(ctx context.Context, filter output.MemberFilter) (int, error) {
 	// 創建帶有 trace 的 logger 用於追蹤
 	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.CountAll")
 	defer span.End()
 
-	count, err := g.dao.CountAll(gatewayCtx)
+	count, err := g.dao.CountAll(gatewayCtx, filterToQuery(filter))
 	if err != nil {
 		traceLogger.Error("會員資料庫總數查詢失敗",
 			logger.NewField("error", err),
@@ -233,6 +300,34 @@ func (g MemberRepoGateway) CountAll(ctx context.Context) (int, error) {
 	return count, nil
 }
 
+// recordToEntity 密碼不離開 gateway，一律清空
+func recordToEntity(record *dao.MemberRecord) *entity.Member {
+	return &entity.Member{
+		ID:              record.ID,
+		Name:            record.Name,
+		Email:           record.Email,
+		Password:        "",
+		NormalizedEmail: record.NormalizedEmail,
+		Status:          entity.MemberStatus(record.Status),
+		StatusReason:    record.StatusReason,
+		MergedInto:      record.MergedInto,
+		ReferredBy:      record.ReferredBy,
+		CreatedAt:       record.CreatedAt,
+		UpdatedAt:       record.UpdatedAt,
+	}
+}
+
+func filterToQuery(filter output.MemberFilter) dao.MemberQuery {
+	return dao.MemberQuery{
+		Status:        string(filter.Status),
+		ReferredBy:    filter.ReferredBy,
+		MergedInto:    filter.MergedInto,
+		IncludeMerged: filter.IncludeMerged,
+		TagsAny:       filter.TagsAny,
+		TagsAll:       filter.TagsAll,
+	}
+}
+
 // createTraceLogger 在 Gateway 層建立帶 Trace 的 Logger
 func createTraceLogger(ctx context.Context, tr tracer.Tracer, log logger.Logger, operationName string) (context.Context, logger.Logger, tracer.Span) {
 	gatewayCtx, span := tr.Start(ctx, operationName)
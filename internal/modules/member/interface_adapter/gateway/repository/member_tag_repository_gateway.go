@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+)
+
+func (g MemberRepoGateway) AddTag(ctx context.Context, memberID int, tag string) error {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.AddTag")
+	defer span.End()
+
+	if err := g.dao.AddTag(gatewayCtx, memberID, tag); err != nil {
+		traceLogger.Error("會員資料庫加標籤失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", memberID),
+			logger.NewField("tag", tag),
+		)
+		return MapInfraErrorToUsecaseError(err)
+	}
+
+	traceLogger.Debug("會員資料庫加標籤成功",
+		logger.NewField("member_id", memberID),
+		logger.NewField("tag", tag),
+	)
+	return nil
+}
+
+func (g MemberRepoGateway) RemoveTag(ctx context.Context, memberID int, tag string) error {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.RemoveTag")
+	defer span.End()
+
+	if err := g.dao.RemoveTag(gatewayCtx, memberID, tag); err != nil {
+		traceLogger.Error("會員資料庫移除標籤失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", memberID),
+			logger.NewField("tag", tag),
+		)
+		return MapInfraErrorToUsecaseError(err)
+	}
+
+	traceLogger.Debug("會員資料庫移除標籤成功",
+		logger.NewField("member_id", memberID),
+		logger.NewField("tag", tag),
+	)
+	return nil
+}
+
+func (g MemberRepoGateway) AddTagToMembers(ctx context.Context, memberIDs []int, tag string) (int, error) {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.AddTagToMembers")
+	defer span.End()
+
+	tagged, err := g.dao.AddTagToMembers(gatewayCtx, memberIDs, tag)
+	if err != nil {
+		traceLogger.Error("會員資料庫批次加標籤失敗",
+			logger.NewField("error", err),
+			logger.NewField("tag", tag),
+			logger.NewField("count", len(memberIDs)),
+		)
+		return 0, MapInfraErrorToUsecaseError(err)
+	}
+
+	traceLogger.Debug("會員資料庫批次加標籤成功",
+		logger.NewField("tag", tag),
+		logger.NewField("tagged", tagged),
+	)
+	return tagged, nil
+}
+
+func (g MemberRepoGateway) ListTags(ctx context.Context, memberID int) ([]string, error) {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.ListTags")
+	defer span.End()
+
+	tags, err := g.dao.ListTags(gatewayCtx, memberID)
+	if err != nil {
+		traceLogger.Error("會員資料庫標籤查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", memberID),
+		)
+		return nil, MapInfraErrorToUsecaseError(err)
+	}
+
+	traceLogger.Debug("會員資料庫標籤查詢成功",
+		logger.NewField("member_id", memberID),
+		logger.NewField("count", len(tags)),
+	)
+	return tags, nil
+}
+
+func (g MemberRepoGateway) MergeTags(ctx context.Context, sourceID, targetID int) (int, error) {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.MergeTags")
+	defer span.End()
+
+	carried, err := g.dao.MergeTags(gatewayCtx, sourceID, targetID)
+	if err != nil {
+		traceLogger.Error("會員資料庫合併標籤失敗",
+			logger.NewField("error", err),
+			logger.NewField("source_id", sourceID),
+			logger.NewField("target_id", targetID),
+		)
+		return 0, MapInfraErrorToUsecaseError(err)
+	}
+
+	traceLogger.Debug("會員資料庫合併標籤成功",
+		logger.NewField("source_id", sourceID),
+		logger.NewField("target_id", targetID),
+		logger.NewField("carried", carried),
+	)
+	return carried, nil
+}
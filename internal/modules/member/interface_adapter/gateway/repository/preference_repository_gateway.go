@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/dao"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
+)
+
+type PreferenceRepoGateway struct {
+	dao    dao.PreferenceDAO
+	logger logger.Logger
+	tracer tracer.Tracer
+}
+
+func NewPreferenceRepoGateway(dao dao.PreferenceDAO, log logger.Logger, tracer tracer.Tracer) output.PreferencePersistence {
+	baseLogger := log.With(logger.NewField("layer", "gateway"))
+	return PreferenceRepoGateway{
+		dao:    dao,
+		logger: baseLogger,
+		tracer: tracer,
+	}
+}
+
+func (g PreferenceRepoGateway) GetByMember(ctx context.Context, memberID int) ([]entity.Preference, error) {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.GetPreferencesByMember")
+	defer span.End()
+
+	records, err := g.dao.GetByMember(gatewayCtx, memberID)
+	if err != nil {
+		traceLogger.Error("偏好設定資料庫查詢失敗", logger.NewField("error", err), logger.NewField("member_id", memberID))
+		return nil, MapInfraErrorToUsecaseError(err)
+	}
+	preferences := make([]entity.Preference, 0, len(records))
+	for _, record := range records {
+		var value any
+		if err := json.Unmarshal([]byte(record.Value), &value); err != nil {
+			traceLogger.Error("偏好設定值轉換失敗",
+				logger.NewField("error", err),
+				logger.NewField("member_id", memberID),
+				logger.NewField("key", record.Key),
+			)
+			return nil, MapInfraErrorToUsecaseError(fmt.Errorf("%w: %v", ErrGatewayMemberMappingError, err))
+		}
+		preferences = append(preferences, entity.Preference{
+			Key:       record.Key,
+			Value:     value,
+			Version:   record.Version,
+			UpdatedAt: record.UpdatedAt,
+		})
+	}
+	traceLogger.Debug("偏好設定資料庫查詢成功", logger.NewField("member_id", memberID), logger.NewField("count", len(preferences)))
+	return preferences, nil
+}
+
+func (g PreferenceRepoGateway) Save(ctx context.Context, memberID int, preference entity.Preference) error {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.SavePreference")
+	defer span.End()
+
+	value, err := json.Marshal(preference.Value)
+	if err != nil {
+		traceLogger.Error("偏好設定值轉換失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", memberID),
+			logger.NewField("key", preference.Key),
+		)
+		return MapInfraErrorToUsecaseError(fmt.Errorf("%w: %v", ErrGatewayMemberMappingError, err))
+	}
+	record := &dao.PreferenceRecord{
+		MemberID:  memberID,
+		Key:       preference.Key,
+		Value:     string(value),
+		UpdatedAt: preference.UpdatedAt,
+	}
+	if err := g.dao.Upsert(gatewayCtx, record); err != nil {
+		traceLogger.Error("偏好設定資料庫寫入失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", memberID),
+			logger.NewField("key", preference.Key),
+		)
+		return MapInfraErrorToUsecaseError(err)
+	}
+	traceLogger.Debug("偏好設定資料庫寫入成功", logger.NewField("member_id", memberID), logger.NewField("key", preference.Key))
+	return nil
+}
+
+func (g PreferenceRepoGateway) Delete(ctx context.Context, memberID int, key string) error {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.DeletePreference")
+	defer span.End()
+
+	if err := g.dao.Delete(gatewayCtx, memberID, key); err != nil {
+		traceLogger.Error("偏好設定資料庫刪除失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", memberID),
+			logger.NewField("key", key),
+		)
+		return MapInfraErrorToUsecaseError(err)
+	}
+	traceLogger.Debug("偏好設定資料庫刪除成功", logger.NewField("member_id", memberID), logger.NewField("key", key))
+	return nil
+}
+
+func (g PreferenceRepoGateway) DeleteByMember(ctx context.Context, memberID int) (int, error) {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.DeletePreferencesByMember")
+	defer span.End()
+
+	deleted, err := g.dao.DeleteByMember(gatewayCtx, memberID)
+	if err != nil {
+		traceLogger.Error("會員偏好設定資料庫刪除失敗", logger.NewField("error", err), logger.NewField("member_id", memberID))
+		return 0, MapInfraErrorToUsecaseError(err)
+	}
+	traceLogger.Debug("會員偏好設定資料庫刪除成功", logger.NewField("member_id", memberID), logger.NewField("deleted", deleted))
+	return deleted, nil
+}
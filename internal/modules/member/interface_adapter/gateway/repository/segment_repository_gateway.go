@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/sqlx/mcsqlite"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/dao"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
+)
+
+type SegmentRepoGateway struct {
+	dao    dao.SegmentDAO
+	logger logger.Logger
+	tracer tracer.Tracer
+}
+
+func NewSegmentRepoGateway(dao dao.SegmentDAO, log logger.Logger, tracer tracer.Tracer) output.SegmentPersistence {
+	baseLogger := log.With(logger.NewField("layer", "gateway"))
+	return SegmentRepoGateway{
+		dao:    dao,
+		logger: baseLogger,
+		tracer: tracer,
+	}
+}
+
+func (g SegmentRepoGateway) Create(ctx context.Context, segment *entity.Segment) error {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.CreateSegment")
+	defer span.End()
+
+	record, err := segmentToRecord(segment)
+	if err != nil {
+		traceLogger.Error("分群條件轉換失敗", logger.NewField("error", err), logger.NewField("segment_name", segment.Name))
+		return MapInfraErrorToUsecaseError(err)
+	}
+	if err := g.dao.Create(gatewayCtx, record); err != nil {
+		traceLogger.Error("分群資料庫創建失敗", logger.NewField("error", err), logger.NewField("segment_name", segment.Name))
+		return mapSegmentInfraError(err)
+	}
+	traceLogger.Debug("分群資料庫創建成功", logger.NewField("segment_name", segment.Name))
+	return nil
+}
+
+func (g SegmentRepoGateway) GetByID(ctx context.Context, id int) (*entity.Segment, error) {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.GetSegmentByID")
+	defer span.End()
+
+	record, err := g.dao.GetByID(gatewayCtx, id)
+	if err != nil {
+		traceLogger.Error("分群資料庫查詢(ID)失敗", logger.NewField("error", err), logger.NewField("segment_id", id))
+		return nil, mapSegmentInfraError(err)
+	}
+	segment, err := segmentRecordToEntity(record)
+	if err != nil {
+		traceLogger.Error("分群條件轉換失敗", logger.NewField("error", err), logger.NewField("segment_id", id))
+		return nil, MapInfraErrorToUsecaseError(err)
+	}
+	traceLogger.Debug("分群資料庫查詢(ID)成功", logger.NewField("segment_id", id))
+	return segment, nil
+}
+
+func (g SegmentRepoGateway) GetByName(ctx context.Context, name string) (*entity.Segment, error) {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.GetSegmentByName")
+	defer span.End()
+
+	record, err := g.dao.GetByName(gatewayCtx, name)
+	if err != nil {
+		traceLogger.Error("分群資料庫查詢(Name)失敗", logger.NewField("error", err), logger.NewField("segment_name", name))
+		return nil, mapSegmentInfraError(err)
+	}
+	segment, err := segmentRecordToEntity(record)
+	if err != nil {
+		traceLogger.Error("分群條件轉換失敗", logger.NewField("error", err), logger.NewField("segment_id", record.ID))
+		return nil, MapInfraErrorToUsecaseError(err)
+	}
+	traceLogger.Debug("分群資料庫查詢(Name)成功", logger.NewField("segment_id", record.ID))
+	return segment, nil
+}
+
+func (g SegmentRepoGateway) GetAll(ctx context.Context, pagination pagination.Pagination) ([]*entity.Segment, error) {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.GetAllSegments")
+	defer span.End()
+
+	records, err := g.dao.GetAll(gatewayCtx, pagination)
+	if err != nil {
+		traceLogger.Error("分群資料庫列表查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("limit", pagination.Limit),
+			logger.NewField("offset", pagination.Offset),
+		)
+		return nil, mapSegmentInfraError(err)
+	}
+	segments := make([]*entity.Segment, 0, len(records))
+	for _, record := range records {
+		segment, err := segmentRecordToEntity(record)
+		if err != nil {
+			traceLogger.Error("分群條件轉換失敗", logger.NewField("error", err), logger.NewField("segment_id", record.ID))
+			return nil, MapInfraErrorToUsecaseError(err)
+		}
+		segments = append(segments, segment)
+	}
+	traceLogger.Debug("分群資料庫列表查詢成功",
+		logger.NewField("count", len(segments)),
+	)
+	return segments, nil
+}
+
+func (g SegmentRepoGateway) CountAll(ctx context.Context) (int, error) {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.CountAllSegments")
+	defer span.End()
+
+	count, err := g.dao.CountAll(gatewayCtx)
+	if err != nil {
+		traceLogger.Error("分群資料庫總數查詢失敗", logger.NewField("error", err))
+		return 0, mapSegmentInfraError(err)
+	}
+	traceLogger.Debug("分群資料庫總數查詢成功", logger.NewField("count", count))
+	return count, nil
+}
+
+func (g SegmentRepoGateway) Delete(ctx context.Context, id int) error {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.DeleteSegment")
+	defer span.End()
+
+	if err := g.dao.Delete(gatewayCtx, id); err != nil {
+		traceLogger.Error("分群資料庫刪除失敗", logger.NewField("error", err), logger.NewField("segment_id", id))
+		return mapSegmentInfraError(err)
+	}
+	traceLogger.Debug("分群資料庫刪除成功", logger.NewField("segment_id", id))
+	return nil
+}
+
+// mapSegmentInfraError 查無資料與重複鍵轉為分群專屬錯誤，其餘沿用會員的錯誤轉換
+func mapSegmentInfraError(err error) error {
+	switch {
+	case errors.Is(err, mcsqlite.ErrDBRecordNotFound):
+		return usecase.ErrSegmentNotFound
+	case errors.Is(err, mcsqlite.ErrDBDuplicateKey):
+		return usecase.ErrSegmentAlreadyExists
+	}
+	return MapInfraErrorToUsecaseError(err)
+}
+
+// segmentToRecord 分群條件以 JSON 儲存
+func segmentToRecord(segment *entity.Segment) (*dao.SegmentRecord, error) {
+	filter, err := json.Marshal(segment.Filter)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrGatewayMemberMappingError, err)
+	}
+	return &dao.SegmentRecord{
+		ID:        segment.ID,
+		Name:      segment.Name,
+		Filter:    string(filter),
+		CreatedBy: segment.CreatedBy,
+		CreatedAt: segment.CreatedAt,
+	}, nil
+}
+
+func segmentRecordToEntity(record *dao.SegmentRecord) (*entity.Segment, error) {
+	var filter entity.SegmentFilter
+	if err := json.Unmarshal([]byte(record.Filter), &filter); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrGatewayMemberMappingError, err)
+	}
+	return &entity.Segment{
+		ID:        record.ID,
+		Name:      record.Name,
+		Filter:    filter,
+		CreatedBy: record.CreatedBy,
+		CreatedAt: record.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package mapper
+
+import (
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"golang.org/x/text/language"
+	"time"
+)
+
+// localizedTimeLayouts 依語系顯示日期時間的格式，先比對完整 tag 再比對語言；
+// time.Format 只有英文月份名稱，其他語系使用數字格式
+var localizedTimeLayouts = map[string]string{
+	"en-US": "Jan 2, 2006 3:04 PM MST",
+	"en":    "2 Jan 2006 15:04 MST",
+	"zh":    "2006年1月2日 15:04 MST",
+	"ja":    "2006年1月2日 15:04 MST",
+	"ko":    "2006년 1월 2일 15:04 MST",
+	"de":    "02.01.2006 15:04 MST",
+	"fr":    "02/01/2006 15:04 MST",
+	"es":    "02/01/2006 15:04 MST",
+}
+
+// defaultLocalizedTimeLayout 沒有對應語系時的顯示格式
+const defaultLocalizedTimeLayout = "2006-01-02 15:04 MST"
+
+// formatMemberTime 以會員時區格式化為 RFC3339，沒有偏好設定時為 UTC
+func formatMemberTime(t time.Time, prefs *entity.MemberPreferences) string {
+	return t.In(prefs.Location()).Format(time.RFC3339)
+}
+
+// formatMemberLocalTime 以會員時區與語系格式化為顯示用的字串
+func formatMemberLocalTime(t time.Time, prefs *entity.MemberPreferences) string {
+	return t.In(prefs.Location()).Format(localizedTimeLayout(prefs.Locale()))
+}
+
+func localizedTimeLayout(locale string) string {
+	if locale == "" {
+		return defaultLocalizedTimeLayout
+	}
+	if layout, ok := localizedTimeLayouts[locale]; ok {
+		return layout
+	}
+	base, _ := language.Make(locale).Base()
+	if layout, ok := localizedTimeLayouts[base.String()]; ok {
+		return layout
+	}
+	return defaultLocalizedTimeLayout
+}
@@ -56,6 +56,19 @@ func ListMemberDTOToPagination(request dto.ListMemberRequestDTO) *pagination.Pag
 		OrderBy: orderBy,
 	}
 }
+
+// ListMemberDTOToInputModel tag_match 未指定時視為 any
+func ListMemberDTOToInputModel(request dto.ListMemberRequestDTO) *inputmodel.ListMembersInputModel {
+	input := &inputmodel.ListMembersInputModel{
+		Status: entity.MemberStatus(request.Status),
+	}
+	if request.TagMatch == "all" {
+		input.TagsAll = request.Tags
+	} else {
+		input.TagsAny = request.Tags
+	}
+	return input
+}
 func UpdateMemberProfileDTOToInputModel(dto dto.UpdateMemberProfileRequestDTO) *inputmodel.PatchUpdateMemberProfileInputModel {
 	return &inputmodel.PatchUpdateMemberProfileInputModel{
 		ID:   dto.ID,
@@ -76,8 +89,98 @@ func UpdateMemberPasswordDTOToInputModel(request dto.UpdateMemberPasswordRequest
 		NewPassword: request.NewPassword,
 	}
 }
+func ChangeMemberStatusDTOToInputModel(request dto.ChangeMemberStatusRequestDTO) *inputmodel.ChangeMemberStatusInputModel {
+	return &inputmodel.ChangeMemberStatusInputModel{
+		ID:     request.ID,
+		Status: entity.MemberStatus(request.Status),
+		Reason: request.Reason,
+	}
+}
+func MergeMembersDTOToInputModel(request dto.MergeMembersRequestDTO) *inputmodel.MergeMembersInputModel {
+	return &inputmodel.MergeMembersInputModel{
+		SourceID: request.ID,
+		TargetID: request.TargetID,
+		Preview:  request.Preview,
+	}
+}
 func DeleteMemberDTOToEntity(request dto.DeleteMemberRequestDTO) *entity.Member {
 	return &entity.Member{
 		ID: request.ID,
 	}
 }
+func StreamMemberChangesDTOToInputModel(request dto.StreamMemberChangesRequestDTO) *inputmodel.StreamMemberChangesInputModel {
+	return &inputmodel.StreamMemberChangesInputModel{
+		Types:       request.Types,
+		LastEventID: request.LastEventID,
+	}
+}
+func PersonalDataDTOToEntity(request dto.PersonalDataRequestDTO) *entity.Member {
+	return &entity.Member{
+		ID: request.ID,
+	}
+}
+
+func CreateInvitationDTOToInputModel(request dto.CreateInvitationRequestDTO) *inputmodel.CreateInvitationInputModel {
+	input := &inputmodel.CreateInvitationInputModel{
+		Email:   request.Email,
+		MaxUses: request.MaxUses,
+	}
+	if request.ExpiresAt != nil {
+		input.ExpiresAt = request.ExpiresAt.UTC()
+	}
+	return input
+}
+
+// ListInvitationsDTOToPagination 邀請碼列表固定由新到舊排序
+func ListInvitationsDTOToPagination(request dto.ListInvitationsRequestDTO) *pagination.Pagination {
+	return &pagination.Pagination{
+		Limit:   request.Limit,
+		Offset:  (request.Page - 1) * request.Limit,
+		SortBy:  "id",
+		OrderBy: enum.OrderByDesc,
+	}
+}
+func BulkTagMembersDTOToInputModel(request dto.BulkTagMembersRequestDTO) *inputmodel.BulkTagMembersInputModel {
+	return &inputmodel.BulkTagMembersInputModel{
+		Tag:       request.Tag,
+		MemberIDs: request.MemberIDs,
+	}
+}
+func CreateSegmentDTOToInputModel(request dto.CreateSegmentRequestDTO) *inputmodel.CreateSegmentInputModel {
+	return &inputmodel.CreateSegmentInputModel{
+		Name: request.Name,
+		Filter: entity.SegmentFilter{
+			Status:     entity.MemberStatus(request.Status),
+			TagsAny:    request.TagsAny,
+			TagsAll:    request.TagsAll,
+			ReferredBy: request.ReferredBy,
+		},
+	}
+}
+
+// ListSegmentsDTOToPagination 分群列表固定由新到舊排序
+func ListSegmentsDTOToPagination(request dto.ListSegmentsRequestDTO) *pagination.Pagination {
+	return &pagination.Pagination{
+		Limit:   request.Limit,
+		Offset:  (request.Page - 1) * request.Limit,
+		SortBy:  "id",
+		OrderBy: enum.OrderByDesc,
+	}
+}
+
+// EvaluateSegmentDTOToPagination 分群會員依 id 排序，分頁結果穩定
+func EvaluateSegmentDTOToPagination(request dto.EvaluateSegmentRequestDTO) *pagination.Pagination {
+	return &pagination.Pagination{
+		Limit:   request.Limit,
+		Offset:  (request.Page - 1) * request.Limit,
+		SortBy:  "id",
+		OrderBy: enum.OrderByAsc,
+	}
+}
+
+func PatchMemberPreferencesDTOToInputModel(request dto.PatchMemberPreferencesRequestDTO) *inputmodel.PatchMemberPreferencesInputModel {
+	return &inputmodel.PatchMemberPreferencesInputModel{
+		ID:      request.ID,
+		Changes: request.Changes,
+	}
+}
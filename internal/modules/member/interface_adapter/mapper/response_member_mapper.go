@@ -3,6 +3,7 @@ package mapper
 import (
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/dto"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
 	"time"
 )
 
@@ -15,27 +16,40 @@ func EntityToCreateMemberResponseDTO(member *entity.Member) dto.RegisterMemberRe
 }
 func EntityToGetMemberByIDResponseDTO(member *entity.Member) dto.GetMemberByIDResponseDTO {
 	return dto.GetMemberByIDResponseDTO{
-		ID:        member.ID,
-		Name:      member.Name,
-		Email:     member.Email,
-		CreatedAt: member.CreatedAt.Format(time.RFC3339),
+		ID:             member.ID,
+		Name:           member.Name,
+		Email:          member.Email,
+		Status:         string(member.Status),
+		ReferredBy:     member.ReferredBy,
+		ReferralCount:  member.ReferralCount,
+		Tags:           tagsOrEmpty(member.Tags),
+		CreatedAt:      formatMemberTime(member.CreatedAt, member.Preferences),
+		CreatedAtLocal: formatMemberLocalTime(member.CreatedAt, member.Preferences),
+		UpdatedAt:      formatMemberTime(member.UpdatedAt, member.Preferences),
 	}
 }
 func EntityToGetMemberByEmailResponseDTO(member *entity.Member) dto.GetMemberByEmailResponseDTO {
 	return dto.GetMemberByEmailResponseDTO{
-		ID:        member.ID,
-		Name:      member.Name,
-		Email:     member.Email,
-		CreatedAt: member.CreatedAt.Format(time.RFC3339),
+		ID:             member.ID,
+		Name:           member.Name,
+		Email:          member.Email,
+		Status:         string(member.Status),
+		ReferredBy:     member.ReferredBy,
+		ReferralCount:  member.ReferralCount,
+		Tags:           tagsOrEmpty(member.Tags),
+		CreatedAt:      formatMemberTime(member.CreatedAt, member.Preferences),
+		CreatedAtLocal: formatMemberLocalTime(member.CreatedAt, member.Preferences),
+		UpdatedAt:      formatMemberTime(member.UpdatedAt, member.Preferences),
 	}
 }
 func EntityToListMemberResponseDTO(members []*entity.Member) dto.ListMemberResponseDTO {
 	items := make([]dto.ListMemberItemDTO, len(members))
 	for i, m := range members {
 		items[i] = dto.ListMemberItemDTO{
-			ID:    m.ID,
-			Name:  m.Name,
-			Email: m.Email,
+			ID:     m.ID,
+			Name:   m.Name,
+			Email:  m.Email,
+			Status: string(m.Status),
 		}
 	}
 	return dto.ListMemberResponseDTO{
@@ -48,6 +62,29 @@ func EntityToUpdateMemberProfileResponseDTO(member *entity.Member) dto.UpdateMem
 		Name: member.Name,
 	}
 }
+func EntityToChangeMemberStatusResponseDTO(member *entity.Member) dto.ChangeMemberStatusResponseDTO {
+	return dto.ChangeMemberStatusResponseDTO{
+		ID:           member.ID,
+		Status:       string(member.Status),
+		StatusReason: member.StatusReason,
+	}
+}
+
+// MergeResultToMergeMembersResponseDTO 預覽時尚未合併，不輸出 merged_at
+func MergeResultToMergeMembersResponseDTO(result *output.MergeResult) dto.MergeMembersResponseDTO {
+	resp := dto.MergeMembersResponseDTO{
+		SourceID:          result.Source.ID,
+		TargetID:          result.Target.ID,
+		Preview:           result.Preview,
+		AuditRecords:      result.AuditRecords,
+		RedirectedMembers: result.RedirectedMembers,
+		CarriedTags:       result.CarriedTags,
+	}
+	if !result.MergedAt.IsZero() {
+		resp.MergedAt = result.MergedAt.Format(time.RFC3339)
+	}
+	return resp
+}
 func EntityToUpdateMemberEmailResponseDTO() dto.UpdateMemberEmailResponseDTO {
 	return dto.UpdateMemberEmailResponseDTO{}
 }
@@ -62,3 +99,170 @@ func EntityToDeleteMemberResponseDTO(member *entity.Member) dto.DeleteMemberResp
 		CreatedAt: member.CreatedAt.Format(time.RFC3339),
 	}
 }
+func EntityToMemberChangeEventResponseDTO(changeType string, member *entity.Member, at time.Time) dto.MemberChangeEventResponseDTO {
+	return dto.MemberChangeEventResponseDTO{
+		Type: changeType,
+		Member: dto.MemberChangeItemDTO{
+			ID:        member.ID,
+			Name:      member.Name,
+			Email:     member.Email,
+			CreatedAt: member.CreatedAt.Format(time.RFC3339),
+		},
+		OccurredAt: at.Format(time.RFC3339Nano),
+	}
+}
+func PersonalDataArchiveToExportResponseDTO(archive *output.PersonalDataArchive) dto.ExportPersonalDataResponseDTO {
+	records := make([]dto.PersonalDataAuditRecordDTO, len(archive.AuditRecords))
+	for i, r := range archive.AuditRecords {
+		changes := make(map[string]dto.PersonalDataAuditChangeDTO, len(r.Changes))
+		for field, change := range r.Changes {
+			changes[field] = dto.PersonalDataAuditChangeDTO{Before: change.Before, After: change.After}
+		}
+		records[i] = dto.PersonalDataAuditRecordDTO{
+			ID:        r.ID,
+			Actor:     r.Actor,
+			Action:    r.Action,
+			Changes:   changes,
+			RequestID: r.RequestID,
+			TraceID:   r.TraceID,
+			IP:        r.IP,
+			CreatedAt: r.CreatedAt.Format(time.RFC3339),
+		}
+	}
+	return dto.ExportPersonalDataResponseDTO{
+		ExportedAt: archive.ExportedAt.Format(time.RFC3339),
+		Profile: dto.PersonalDataProfileDTO{
+			ID:        archive.Member.ID,
+			Name:      archive.Member.Name,
+			Email:     archive.Member.Email,
+			CreatedAt: archive.Member.CreatedAt.Format(time.RFC3339),
+		},
+		Preferences:  preferenceValues(archive.Preferences),
+		AuditRecords: records,
+	}
+}
+func ErasureResultToEraseResponseDTO(result *output.ErasureResult) dto.ErasePersonalDataResponseDTO {
+	return dto.ErasePersonalDataResponseDTO{
+		ID:                   result.Member.ID,
+		AlreadyErased:        result.AlreadyErased,
+		RedactedAuditRecords: result.RedactedAuditRecords,
+		ErasedAt:             result.ErasedAt.Format(time.RFC3339),
+	}
+}
+
+// AccessTokenToIssueMemberTokenResponseDTO token_type 固定為 Bearer
+func AccessTokenToIssueMemberTokenResponseDTO(token *output.AccessToken) dto.IssueMemberTokenResponseDTO {
+	return dto.IssueMemberTokenResponseDTO{
+		AccessToken: token.Token,
+		TokenType:   "Bearer",
+		ExpiresAt:   token.ExpiresAt.Format(time.RFC3339),
+	}
+}
+
+// EntityToInvitationResponseDTO 未設定的到期與撤銷時間不輸出
+func EntityToInvitationResponseDTO(invitation *entity.Invitation) dto.InvitationResponseDTO {
+	resp := dto.InvitationResponseDTO{
+		ID:         invitation.ID,
+		Code:       invitation.Code,
+		CreatedBy:  invitation.CreatedBy,
+		ReferrerID: invitation.ReferrerID,
+		Email:      invitation.Email,
+		MaxUses:    invitation.MaxUses,
+		Uses:       invitation.Uses,
+		CreatedAt:  invitation.CreatedAt.Format(time.RFC3339),
+	}
+	if !invitation.ExpiresAt.IsZero() {
+		resp.ExpiresAt = invitation.ExpiresAt.Format(time.RFC3339)
+	}
+	if invitation.IsRevoked() {
+		resp.RevokedAt = invitation.RevokedAt.Format(time.RFC3339)
+	}
+	return resp
+}
+func EntityToListInvitationsResponseDTO(invitations []*entity.Invitation) dto.ListInvitationsResponseDTO {
+	items := make([]dto.InvitationResponseDTO, 0, len(invitations))
+	for _, invitation := range invitations {
+		items = append(items, EntityToInvitationResponseDTO(invitation))
+	}
+	return dto.ListInvitationsResponseDTO{
+		Invitations: items,
+	}
+}
+
+func EntityToMemberTagsResponseDTO(member *entity.Member) dto.MemberTagsResponseDTO {
+	return dto.MemberTagsResponseDTO{
+		ID:   member.ID,
+		Tags: tagsOrEmpty(member.Tags),
+	}
+}
+func BulkTagResultToResponseDTO(tag string, requested, tagged int) dto.BulkTagMembersResponseDTO {
+	return dto.BulkTagMembersResponseDTO{
+		Tag:       tag,
+		Requested: requested,
+		Tagged:    tagged,
+	}
+}
+func EntityToSegmentResponseDTO(segment *entity.Segment) dto.SegmentResponseDTO {
+	return dto.SegmentResponseDTO{
+		ID:   segment.ID,
+		Name: segment.Name,
+		Filter: dto.SegmentFilterDTO{
+			Status:     string(segment.Filter.Status),
+			TagsAny:    segment.Filter.TagsAny,
+			TagsAll:    segment.Filter.TagsAll,
+			ReferredBy: segment.Filter.ReferredBy,
+		},
+		CreatedBy: segment.CreatedBy,
+		CreatedAt: segment.CreatedAt.Format(time.RFC3339),
+	}
+}
+func EntityToListSegmentsResponseDTO(segments []*entity.Segment) dto.ListSegmentsResponseDTO {
+	items := make([]dto.SegmentResponseDTO, 0, len(segments))
+	for _, segment := range segments {
+		items = append(items, EntityToSegmentResponseDTO(segment))
+	}
+	return dto.ListSegmentsResponseDTO{
+		Segments: items,
+	}
+}
+func SegmentExportToExportSegmentResponseDTO(export *output.SegmentExport) dto.ExportSegmentResponseDTO {
+	members := make([]dto.SegmentMemberDTO, 0, len(export.Members))
+	for _, m := range export.Members {
+		members = append(members, dto.SegmentMemberDTO{
+			ID:        m.ID,
+			Name:      m.Name,
+			Email:     m.Email,
+			Status:    string(m.Status),
+			CreatedAt: m.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return dto.ExportSegmentResponseDTO{
+		ExportedAt: export.ExportedAt.Format(time.RFC3339),
+		Segment:    EntityToSegmentResponseDTO(export.Segment),
+		Members:    members,
+	}
+}
+
+// tagsOrEmpty 沒有標籤時輸出空陣列而不是 null
+func tagsOrEmpty(tags []string) []string {
+	if tags == nil {
+		return []string{}
+	}
+	return tags
+}
+
+func EntityToMemberPreferencesResponseDTO(prefs *entity.MemberPreferences) dto.MemberPreferencesResponseDTO {
+	return dto.MemberPreferencesResponseDTO{
+		MemberID:    prefs.MemberID,
+		Preferences: preferenceValues(prefs),
+		Versions:    prefs.Versions,
+	}
+}
+
+// preferenceValues nil 回傳 nil，讓匯出檔省略偏好設定
+func preferenceValues(prefs *entity.MemberPreferences) map[string]any {
+	if prefs == nil {
+		return nil
+	}
+	return prefs.Values
+}
@@ -13,7 +13,23 @@ type ListMemberResponse = sharedviewmodel.HTTPResponse[dto.ListMemberResponseDTO
 type UpdateMemberProfileResponse = sharedviewmodel.HTTPResponse[dto.UpdateMemberProfileResponseDTO]
 type UpdateMemberEmailResponse = sharedviewmodel.HTTPResponse[dto.UpdateMemberEmailResponseDTO]
 type UpdateMemberPasswordResponse = sharedviewmodel.HTTPResponse[dto.UpdateMemberPasswordResponseDTO]
+type ChangeMemberStatusResponse = sharedviewmodel.HTTPResponse[dto.ChangeMemberStatusResponseDTO]
+type MergeMembersResponse = sharedviewmodel.HTTPResponse[dto.MergeMembersResponseDTO]
 type DeleteMemberResponse = sharedviewmodel.HTTPResponse[dto.DeleteMemberResponseDTO]
+type ExportPersonalDataResponse = sharedviewmodel.HTTPResponse[dto.ExportPersonalDataResponseDTO]
+type ErasePersonalDataResponse = sharedviewmodel.HTTPResponse[dto.ErasePersonalDataResponseDTO]
+type IssueMemberTokenResponse = sharedviewmodel.HTTPResponse[dto.IssueMemberTokenResponseDTO]
+type InvitationResponse = sharedviewmodel.HTTPResponse[dto.InvitationResponseDTO]
+type ListInvitationsResponse = sharedviewmodel.HTTPResponse[dto.ListInvitationsResponseDTO]
+type MemberTagsResponse = sharedviewmodel.HTTPResponse[dto.MemberTagsResponseDTO]
+type BulkTagMembersResponse = sharedviewmodel.HTTPResponse[dto.BulkTagMembersResponseDTO]
+type SegmentResponse = sharedviewmodel.HTTPResponse[dto.SegmentResponseDTO]
+type ListSegmentsResponse = sharedviewmodel.HTTPResponse[dto.ListSegmentsResponseDTO]
+type ExportSegmentResponse = sharedviewmodel.HTTPResponse[dto.ExportSegmentResponseDTO]
+type MemberPreferencesResponse = sharedviewmodel.HTTPResponse[dto.MemberPreferencesResponseDTO]
+
+// SSE 事件直接輸出 data，不包 HTTPResponse 外層
+type MemberChangeEventResponse = dto.MemberChangeEventResponseDTO
 
 // 為 any 的情況也必須別名化
 type ErrorResponse = sharedviewmodel.HTTPResponse[any]
@@ -1,9 +1,12 @@
 package http
 
 import (
+	"errors"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/mapper"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/outputmodel"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
 	sharedenum "github.com/tomoffice/go-clean-architecture/internal/shared/enum"
 	sharedviewmodel "github.com/tomoffice/go-clean-architecture/internal/shared/viewmodel/http"
 	"strconv"
@@ -54,11 +57,88 @@ func (p *MemberPresenter) PresentUpdateMemberPassword() outputmodel.UpdateMember
 
 }
 
+func (p *MemberPresenter) PresentChangeMemberStatus(member *entity.Member) outputmodel.ChangeMemberStatusResponse {
+	respDTO := mapper.EntityToChangeMemberStatusResponseDTO(member)
+	return buildSuccessResponse(respDTO)
+}
+
+func (p *MemberPresenter) PresentMergeMembers(result *output.MergeResult) outputmodel.MergeMembersResponse {
+	respDTO := mapper.MergeResultToMergeMembersResponseDTO(result)
+	return buildSuccessResponse(respDTO)
+}
+
 func (p *MemberPresenter) PresentDeleteMember(member *entity.Member) outputmodel.DeleteMemberResponse {
 	respDTO := mapper.EntityToDeleteMemberResponseDTO(member)
 	return buildSuccessResponse(respDTO)
 }
 
+func (p *MemberPresenter) PresentExportPersonalData(archive *output.PersonalDataArchive) outputmodel.ExportPersonalDataResponse {
+	respDTO := mapper.PersonalDataArchiveToExportResponseDTO(archive)
+	return buildSuccessResponse(respDTO)
+}
+func (p *MemberPresenter) PresentIssueMemberToken(token *output.AccessToken) outputmodel.IssueMemberTokenResponse {
+	respDTO := mapper.AccessTokenToIssueMemberTokenResponseDTO(token)
+	return buildSuccessResponse(respDTO)
+}
+func (p *MemberPresenter) PresentCreateInvitation(invitation *entity.Invitation) outputmodel.InvitationResponse {
+	respDTO := mapper.EntityToInvitationResponseDTO(invitation)
+	return buildSuccessResponse(respDTO)
+}
+
+func (p *MemberPresenter) PresentListInvitations(invitations []*entity.Invitation, total int) outputmodel.ListInvitationsResponse {
+	respDTO := mapper.EntityToListInvitationsResponseDTO(invitations)
+	meta := &sharedviewmodel.MetaPayload{
+		Total: total,
+	}
+	return buildSuccessResponseWithMeta(respDTO, meta)
+}
+
+func (p *MemberPresenter) PresentRevokeInvitation(invitation *entity.Invitation) outputmodel.InvitationResponse {
+	respDTO := mapper.EntityToInvitationResponseDTO(invitation)
+	return buildSuccessResponse(respDTO)
+}
+
+func (p *MemberPresenter) PresentMemberTags(member *entity.Member) outputmodel.MemberTagsResponse {
+	respDTO := mapper.EntityToMemberTagsResponseDTO(member)
+	return buildSuccessResponse(respDTO)
+}
+
+func (p *MemberPresenter) PresentMemberPreferences(prefs *entity.MemberPreferences) outputmodel.MemberPreferencesResponse {
+	respDTO := mapper.EntityToMemberPreferencesResponseDTO(prefs)
+	return buildSuccessResponse(respDTO)
+}
+
+func (p *MemberPresenter) PresentBulkTagMembers(tag string, requested, tagged int) outputmodel.BulkTagMembersResponse {
+	respDTO := mapper.BulkTagResultToResponseDTO(tag, requested, tagged)
+	return buildSuccessResponse(respDTO)
+}
+
+func (p *MemberPresenter) PresentSegment(segment *entity.Segment) outputmodel.SegmentResponse {
+	respDTO := mapper.EntityToSegmentResponseDTO(segment)
+	return buildSuccessResponse(respDTO)
+}
+
+func (p *MemberPresenter) PresentListSegments(segments []*entity.Segment, total int) outputmodel.ListSegmentsResponse {
+	respDTO := mapper.EntityToListSegmentsResponseDTO(segments)
+	meta := &sharedviewmodel.MetaPayload{
+		Total: total,
+	}
+	return buildSuccessResponseWithMeta(respDTO, meta)
+}
+
+func (p *MemberPresenter) PresentExportSegment(export *output.SegmentExport) outputmodel.ExportSegmentResponse {
+	respDTO := mapper.SegmentExportToExportSegmentResponseDTO(export)
+	return buildSuccessResponse(respDTO)
+}
+
+func (p *MemberPresenter) PresentErasePersonalData(result *output.ErasureResult) outputmodel.ErasePersonalDataResponse {
+	respDTO := mapper.ErasureResultToEraseResponseDTO(result)
+	return buildSuccessResponse(respDTO)
+}
+func (p *MemberPresenter) PresentMemberChangeEvent(event output.ChangeEvent) outputmodel.MemberChangeEventResponse {
+	return mapper.EntityToMemberChangeEventResponseDTO(string(event.Type), event.Member, event.At)
+}
+
 func (p *MemberPresenter) PresentBindingError(errCode int, message string) outputmodel.ErrorResponse {
 	return buildFailedResponse(errCode, message)
 }
@@ -70,7 +150,14 @@ func (p *MemberPresenter) PresentValidationError(err error) (int, outputmodel.Er
 
 func (p *MemberPresenter) PresentUseCaseError(err error) (int, outputmodel.ErrorResponse) {
 	errCode, message := MapMemberUseCaseToPresenterError(err)
-	return errCode, buildFailedResponse(errCode, message)
+	resp := buildFailedResponse(errCode, message)
+	var policyErr *usecase.PasswordPolicyError
+	if errors.As(err, &policyErr) {
+		for _, rule := range policyErr.Rules {
+			resp.Error.Details = append(resp.Error.Details, string(rule))
+		}
+	}
+	return errCode, resp
 }
 
 func buildSuccessResponse[T any](data T) sharedviewmodel.HTTPResponse[T] {
@@ -25,6 +25,68 @@ func MapMemberUseCaseToPresenterError(err error) (int, string) {
 		return errorcode.ErrMemberEmailAlreadyExists, usecase.ErrMemberEmailAlreadyExists.Error()
 	case errors.Is(err, usecase.ErrMemberPasswordIncorrect):
 		return errorcode.ErrMemberPasswordIncorrect, usecase.ErrMemberPasswordIncorrect.Error()
+	case errors.Is(err, usecase.ErrMemberChangeStreamUnavailable):
+		return errorcode.ErrMemberChangeStreamUnavailable, usecase.ErrMemberChangeStreamUnavailable.Error()
+	case errors.Is(err, usecase.ErrMemberInvalidEmail):
+		return errorcode.ErrMemberInvalidEmail, usecase.ErrMemberInvalidEmail.Error()
+	case errors.Is(err, usecase.ErrMemberInvalidName):
+		return errorcode.ErrMemberInvalidName, usecase.ErrMemberInvalidName.Error()
+	case errors.Is(err, usecase.ErrMemberEmailPolicyViolation):
+		return errorcode.ErrMemberEmailPolicyViolation, usecase.ErrMemberEmailPolicyViolation.Error()
+	case errors.Is(err, usecase.ErrMemberPasswordPolicyViolation):
+		return errorcode.ErrMemberPasswordPolicyViolation, usecase.ErrMemberPasswordPolicyViolation.Error()
+	case errors.Is(err, usecase.ErrMemberStatusTransitionNotAllowed):
+		return errorcode.ErrMemberStatusTransition, usecase.ErrMemberStatusTransitionNotAllowed.Error()
+	case errors.Is(err, usecase.ErrMemberStatusReasonRequired):
+		return errorcode.ErrMemberStatusReasonRequired, usecase.ErrMemberStatusReasonRequired.Error()
+	case errors.Is(err, usecase.ErrMemberStatusForbidden):
+		return errorcode.ErrMemberStatusForbidden, usecase.ErrMemberStatusForbidden.Error()
+	case errors.Is(err, usecase.ErrMemberNotActive):
+		return errorcode.ErrMemberNotActive, usecase.ErrMemberNotActive.Error()
+	case errors.Is(err, usecase.ErrMemberMerged):
+		return errorcode.ErrMemberMerged, usecase.ErrMemberMerged.Error()
+	case errors.Is(err, usecase.ErrMemberInvalidMerge):
+		return errorcode.ErrMemberInvalidMerge, usecase.ErrMemberInvalidMerge.Error()
+	case errors.Is(err, usecase.ErrMemberMergeForbidden):
+		return errorcode.ErrMemberMergeForbidden, usecase.ErrMemberMergeForbidden.Error()
+	case errors.Is(err, usecase.ErrInvitationNotFound):
+		return errorcode.ErrInvitationNotFound, usecase.ErrInvitationNotFound.Error()
+	case errors.Is(err, usecase.ErrInvitationAlreadyExists):
+		return errorcode.ErrInvitationAlreadyExists, usecase.ErrInvitationAlreadyExists.Error()
+	case errors.Is(err, usecase.ErrInvitationRequired):
+		return errorcode.ErrInvitationRequired, usecase.ErrInvitationRequired.Error()
+	case errors.Is(err, usecase.ErrInvitationInvalid):
+		return errorcode.ErrInvitationInvalid, usecase.ErrInvitationInvalid.Error()
+	case errors.Is(err, usecase.ErrInvitationForbidden):
+		return errorcode.ErrInvitationForbidden, usecase.ErrInvitationForbidden.Error()
+	case errors.Is(err, usecase.ErrMemberInvalidTag):
+		return errorcode.ErrMemberInvalidTag, usecase.ErrMemberInvalidTag.Error()
+	case errors.Is(err, usecase.ErrMemberTagForbidden):
+		return errorcode.ErrMemberTagForbidden, usecase.ErrMemberTagForbidden.Error()
+	case errors.Is(err, usecase.ErrSegmentNotFound):
+		return errorcode.ErrSegmentNotFound, usecase.ErrSegmentNotFound.Error()
+	case errors.Is(err, usecase.ErrSegmentAlreadyExists):
+		return errorcode.ErrSegmentAlreadyExists, usecase.ErrSegmentAlreadyExists.Error()
+	case errors.Is(err, usecase.ErrSegmentInvalid):
+		return errorcode.ErrSegmentInvalid, usecase.ErrSegmentInvalid.Error()
+	case errors.Is(err, usecase.ErrMemberInvalidPreference):
+		return errorcode.ErrMemberInvalidPreference, usecase.ErrMemberInvalidPreference.Error()
+	case errors.Is(err, usecase.ErrMemberConcurrentUpdate):
+		return errorcode.ErrMemberConcurrentUpdate, usecase.ErrMemberConcurrentUpdate.Error()
+	case errors.Is(err, usecase.ErrMemberReferenceConflict):
+		return errorcode.ErrMemberReferenceConflict, usecase.ErrMemberReferenceConflict.Error()
+	case errors.Is(err, usecase.ErrMemberConstraintViolation):
+		return errorcode.ErrMemberConstraintViolation, usecase.ErrMemberConstraintViolation.Error()
+	case errors.Is(err, usecase.ErrMemberStorageUnavailable):
+		return errorcode.ErrMemberStorageUnavailable, usecase.ErrMemberStorageUnavailable.Error()
+	case errors.Is(err, usecase.ErrMemberInvalidCredentials):
+		return errorcode.ErrMemberInvalidCredentials, usecase.ErrMemberInvalidCredentials.Error()
+	case errors.Is(err, usecase.ErrMemberTokenUnavailable):
+		return errorcode.ErrMemberTokenUnavailable, usecase.ErrMemberTokenUnavailable.Error()
+	case errors.Is(err, usecase.ErrMemberPrivacyForbidden):
+		return errorcode.ErrMemberPrivacyForbidden, usecase.ErrMemberPrivacyForbidden.Error()
+	case errors.Is(err, usecase.ErrMemberAuditTrailError):
+		return errorcode.ErrMemberAuditTrailError, usecase.ErrMemberAuditTrailError.Error()
 	default:
 		return errorcode.ErrInternalServer, sharederrors.ErrInternalServer.Error()
 	}
@@ -25,9 +25,31 @@ func (r *MemberRouter) Register() error {
 	r.router.GET("/:id", r.controller.GetByID)
 	r.router.GET("/email/:email", r.controller.GetByEmail)
 	r.router.GET("", r.controller.List)
+	r.router.GET("/stream", r.controller.Stream)
+	r.router.POST("/token", r.controller.IssueToken)
+	r.router.POST("/invitations", r.controller.CreateInvitation)
+	r.router.GET("/invitations", r.controller.ListInvitations)
+	r.router.DELETE("/invitations/:id", r.controller.RevokeInvitation)
+	r.router.POST("/tags/:tag", r.controller.BulkTagMembers)
+	r.router.POST("/segments", r.controller.CreateSegment)
+	r.router.GET("/segments", r.controller.ListSegments)
+	r.router.GET("/segments/:id/members", r.controller.EvaluateSegment)
+	r.router.GET("/segments/:id/export", r.controller.ExportSegment)
+	r.router.DELETE("/segments/:id", r.controller.DeleteSegment)
 	r.router.PATCH("/:id", r.controller.UpdateProfile)
 	r.router.PATCH("/:id/email", r.controller.UpdateEmail)
 	r.router.PATCH("/:id/password", r.controller.UpdatePassword)
 	r.router.DELETE("/:id", r.controller.Delete)
+	r.router.POST("/:id/activate", r.controller.Activate)
+	r.router.POST("/:id/suspend", r.controller.Suspend)
+	r.router.POST("/:id/ban", r.controller.Ban)
+	r.router.POST("/:id/reinstate", r.controller.Reinstate)
+	r.router.POST("/:id/merge", r.controller.Merge)
+	r.router.PUT("/:id/tags/:tag", r.controller.TagMember)
+	r.router.DELETE("/:id/tags/:tag", r.controller.UntagMember)
+	r.router.GET("/:id/preferences", r.controller.GetPreferences)
+	r.router.PATCH("/:id/preferences", r.controller.UpdatePreferences)
+	r.router.GET("/:id/personal-data", r.controller.ExportPersonalData)
+	r.router.POST("/:id/erase", r.controller.ErasePersonalData)
 	return nil
 }
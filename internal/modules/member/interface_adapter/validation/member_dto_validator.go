@@ -62,3 +62,99 @@ func (v *MemberValidator) ValidateDeleteMember(dto dto.DeleteMemberRequestDTO) e
 	}
 	return nil
 }
+func (v *MemberValidator) ValidateStreamMemberChanges(dto dto.StreamMemberChangesRequestDTO) error {
+	if err := v.validator.Struct(dto); err != nil {
+		return err
+	}
+	return nil
+}
+func (v *MemberValidator) ValidatePersonalData(dto dto.PersonalDataRequestDTO) error {
+	if err := v.validator.Struct(dto); err != nil {
+		return err
+	}
+	return nil
+}
+func (v *MemberValidator) ValidateChangeMemberStatus(dto dto.ChangeMemberStatusRequestDTO) error {
+	if err := v.validator.Struct(dto); err != nil {
+		return err
+	}
+	return nil
+}
+func (v *MemberValidator) ValidateMergeMembers(dto dto.MergeMembersRequestDTO) error {
+	if err := v.validator.Struct(dto); err != nil {
+		return err
+	}
+	return nil
+}
+func (v *MemberValidator) ValidateIssueMemberToken(dto dto.IssueMemberTokenRequestDTO) error {
+	if err := v.validator.Struct(dto); err != nil {
+		return err
+	}
+	return nil
+}
+func (v *MemberValidator) ValidateCreateInvitation(dto dto.CreateInvitationRequestDTO) error {
+	if err := v.validator.Struct(dto); err != nil {
+		return err
+	}
+	return nil
+}
+func (v *MemberValidator) ValidateListInvitations(dto dto.ListInvitationsRequestDTO) error {
+	if err := v.validator.Struct(dto); err != nil {
+		return err
+	}
+	return nil
+}
+func (v *MemberValidator) ValidateRevokeInvitation(dto dto.RevokeInvitationRequestDTO) error {
+	if err := v.validator.Struct(dto); err != nil {
+		return err
+	}
+	return nil
+}
+func (v *MemberValidator) ValidateMemberTag(dto dto.MemberTagRequestDTO) error {
+	if err := v.validator.Struct(dto); err != nil {
+		return err
+	}
+	return nil
+}
+func (v *MemberValidator) ValidateBulkTagMembers(dto dto.BulkTagMembersRequestDTO) error {
+	if err := v.validator.Struct(dto); err != nil {
+		return err
+	}
+	return nil
+}
+func (v *MemberValidator) ValidateCreateSegment(dto dto.CreateSegmentRequestDTO) error {
+	if err := v.validator.Struct(dto); err != nil {
+		return err
+	}
+	return nil
+}
+func (v *MemberValidator) ValidateListSegments(dto dto.ListSegmentsRequestDTO) error {
+	if err := v.validator.Struct(dto); err != nil {
+		return err
+	}
+	return nil
+}
+func (v *MemberValidator) ValidateEvaluateSegment(dto dto.EvaluateSegmentRequestDTO) error {
+	if err := v.validator.Struct(dto); err != nil {
+		return err
+	}
+	return nil
+}
+func (v *MemberValidator) ValidateSegment(dto dto.SegmentRequestDTO) error {
+	if err := v.validator.Struct(dto); err != nil {
+		return err
+	}
+	return nil
+}
+func (v *MemberValidator) ValidateMemberPreferences(dto dto.MemberPreferencesRequestDTO) error {
+	if err := v.validator.Struct(dto); err != nil {
+		return err
+	}
+	return nil
+}
+func (v *MemberValidator) ValidatePatchMemberPreferences(dto dto.PatchMemberPreferencesRequestDTO) error {
+	if err := v.validator.Struct(dto); err != nil {
+		return err
+	}
+	return nil
+}
@@ -12,4 +12,20 @@ type Validator interface {
 	ValidateUpdateEmail(dto.UpdateMemberEmailRequestDTO) error
 	ValidateUpdatePassword(dto.UpdateMemberPasswordRequestDTO) error
 	ValidateDeleteMember(dto.DeleteMemberRequestDTO) error
+	ValidateChangeMemberStatus(dto.ChangeMemberStatusRequestDTO) error
+	ValidateMergeMembers(dto.MergeMembersRequestDTO) error
+	ValidateStreamMemberChanges(dto.StreamMemberChangesRequestDTO) error
+	ValidatePersonalData(dto.PersonalDataRequestDTO) error
+	ValidateIssueMemberToken(dto.IssueMemberTokenRequestDTO) error
+	ValidateCreateInvitation(dto.CreateInvitationRequestDTO) error
+	ValidateListInvitations(dto.ListInvitationsRequestDTO) error
+	ValidateRevokeInvitation(dto.RevokeInvitationRequestDTO) error
+	ValidateMemberTag(dto.MemberTagRequestDTO) error
+	ValidateBulkTagMembers(dto.BulkTagMembersRequestDTO) error
+	ValidateCreateSegment(dto.CreateSegmentRequestDTO) error
+	ValidateListSegments(dto.ListSegmentsRequestDTO) error
+	ValidateEvaluateSegment(dto.EvaluateSegmentRequestDTO) error
+	ValidateSegment(dto.SegmentRequestDTO) error
+	ValidateMemberPreferences(dto.MemberPreferencesRequestDTO) error
+	ValidatePatchMemberPreferences(dto.PatchMemberPreferencesRequestDTO) error
 }
@@ -1,27 +1,203 @@
 package member
 
 import (
+	"fmt"
 	"github.com/gin-gonic/gin"
 	"github.com/jmoiron/sqlx"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/validation"
 	"github.com/tomoffice/go-clean-architecture/pkg/logger"
 	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
+	"net"
+	"time"
 
 	"github.com/tomoffice/go-clean-architecture/internal/modules"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/ent/mcent"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/memory/mcmemory"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/sqlx/mcsqlite"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/persistence/sqlx/pgsql"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/breachedpassword"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/emailpolicy"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/stream"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/token"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/cache/lrucache"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxdriver"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxinstrument"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxreplica"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxstmt"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxtx"
+	auditinput "github.com/tomoffice/go-clean-architecture/internal/modules/audit/usecase/port/input"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/controller"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/dao"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/gateway/audit"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/gateway/cache"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/gateway/outbox"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/gateway/repository"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/presenter/http"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/router"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
+	outboxinput "github.com/tomoffice/go-clean-architecture/internal/modules/outbox/usecase/port/input"
+	webhookinput "github.com/tomoffice/go-clean-architecture/internal/modules/webhook/usecase/port/input"
 )
 
+// StreamOptions 會員異動串流設定，零值欄位使用預設值
+type StreamOptions struct {
+	ReplayBufferSize     int
+	SubscriberBufferSize int
+	Heartbeat            time.Duration
+}
+
+// PrivacyOptions 會員個資匯出/刪除設定
+type PrivacyOptions struct {
+	// Officers 可處理任何會員個資的 actor
+	Officers []string
+	// WebhookInput 個資刪除時一併遮蔽 webhook 投遞紀錄，nil 表示沒有 webhook 模組
+	WebhookInput webhookinput.WebhookInputPort
+}
+
+// EmailOptions 會員 Email 正規化設定，零值只做基本正規化（空白、大小寫、IDN）
+type EmailOptions struct {
+	// IgnoreDotsDomains local part 忽略點號的網域，例如 gmail.com
+	IgnoreDotsDomains []string
+	// PlusTagDomains 移除 +tag 子地址的網域
+	PlusTagDomains []string
+	// DomainAliases 網域別名，例如 googlemail.com -> gmail.com
+	DomainAliases map[string]string
+	// Policy 註冊與變更 Email 時的網域政策
+	Policy emailpolicy.Options
+}
+
+const (
+	// DefaultPasswordMinLength 未設定密碼最短長度時的預設值
+	DefaultPasswordMinLength = 8
+	// DefaultPasswordMaxLength 未設定密碼最長長度時的預設值，避免超長密碼拖慢雜湊
+	DefaultPasswordMaxLength = 128
+)
+
+// PasswordOptions 會員密碼政策設定，Policy 的 MinLength/MaxLength 為零時使用預設值
+type PasswordOptions struct {
+	Policy entity.PasswordPolicy
+	// BreachedListDir 外洩密碼前綴檔目錄，空字串表示不檢查
+	BreachedListDir string
+}
+
+// StatusOptions 會員帳號狀態設定
+type StatusOptions struct {
+	// Admins 可變更會員狀態、合併會員的 actor
+	Admins []string
+	// RequireActivation 新會員是否需管理者啟用
+	RequireActivation bool
+}
+
+const (
+	// RegistrationModeOpen 開放註冊（預設），有帶邀請碼時仍會檢查並記錄推薦人
+	RegistrationModeOpen = "open"
+	// RegistrationModeInviteOnly 僅限邀請註冊，註冊必須帶有效的邀請碼
+	RegistrationModeInviteOnly = "invite_only"
+	// DefaultInvitationTTL 建立邀請碼未指定到期時間且未設定有效期間時的預設值
+	DefaultInvitationTTL = 7 * 24 * time.Hour
+)
+
+// RegistrationOptions 會員註冊設定
+type RegistrationOptions struct {
+	// Mode 註冊模式，空字串視為 RegistrationModeOpen
+	Mode string
+	// InvitationTTL 邀請碼未指定到期時間時的有效期間，零值使用 DefaultInvitationTTL
+	InvitationTTL time.Duration
+}
+
+const (
+	// DefaultPreferenceLocale 未設定預設語系時使用
+	DefaultPreferenceLocale = "en"
+	// DefaultPreferenceTimeZone 未設定預設時區時使用
+	DefaultPreferenceTimeZone = "UTC"
+)
+
+// PreferenceOptions 會員偏好設定的預設值
+type PreferenceOptions struct {
+	// DefaultLocale 預設語系，空字串使用 DefaultPreferenceLocale
+	DefaultLocale string
+	// DefaultTimeZone 預設時區，空字串使用 DefaultPreferenceTimeZone
+	DefaultTimeZone string
+}
+
+const (
+	// PersistenceDriverSQLX 以 sqlx 手寫 SQL 存取會員資料（預設）
+	PersistenceDriverSQLX = "sqlx"
+	// PersistenceDriverEnt 以 ent client 存取會員資料
+	PersistenceDriverEnt = "ent"
+	// PersistenceDriverMemory 會員資料只存在記憶體，交易回滾時一併還原；交易、邀請碼等其他資料仍使用 CreateModule 傳入的 db，供本機開發與測試使用
+	PersistenceDriverMemory = "memory"
+)
+
+// PersistenceOptions 會員資料存取設定
+type PersistenceOptions struct {
+	// Driver MemberDAO 的實作，空字串視為 PersistenceDriverSQLX
+	Driver string
+	// SnapshotPath PersistenceDriverMemory 的 JSON 快照檔，空字串表示不保存
+	SnapshotPath string
+	// ReadRouter 把 sqlx MemberDAO 的查詢分流到 replica，nil 表示全部走 CreateModule 傳入的 db；ent 與 memory 不使用
+	ReadRouter *sqlxreplica.Router
+	// Instrumentation sqlx MemberDAO（SQLite）每次 SQL 呼叫的觀測設定，零值使用 sqlxinstrument 的預設值
+	Instrumentation sqlxinstrument.Options
+	// StatementCacheSize sqlx MemberDAO（SQLite）快取的 prepared statement 數量，零值使用 sqlxstmt.DefaultCapacity，
+	// 負值表示不使用 prepared statement
+	StatementCacheSize int
+	// Cache 會員查詢快取，不論 Driver 都包在 gateway 外層
+	Cache CacheOptions
+}
+
+// CacheOptions 會員查詢（GetByID/GetByEmail）快取設定
+type CacheOptions struct {
+	Enabled bool
+	// Size 行程內 LRU 的容量，零值使用 lrucache.DefaultSize
+	Size int
+	// TTL 快取項目的存活時間，零值使用 cache.DefaultTTL
+	TTL time.Duration
+	// Operations 啟用快取的查詢名稱（get_by_id、get_by_email），空值表示全部
+	Operations []string
+	// Backend 快取後端，nil 時使用行程內 LRU；多節點共用快取時可換成 Redis 協定的實作
+	Backend dao.CacheBackend
+}
+
+// AuthOptions 會員 access token 簽發設定，須與 auth middleware 驗證 token 的設定相同
+type AuthOptions struct {
+	// Algorithm JWT 簽名算法，只支援 HS256、HS384、HS512
+	Algorithm string
+	// Secret 簽名密鑰，空字串表示不簽發 token（POST /members/token 回傳 503）
+	Secret string
+	// TokenTTL access token 有效期間
+	TokenTTL time.Duration
+}
+
+// Options 會員模組設定，零值欄位使用各自的預設值
+type Options struct {
+	Auth         AuthOptions
+	Stream       StreamOptions
+	Privacy      PrivacyOptions
+	Email        EmailOptions
+	Password     PasswordOptions
+	Status       StatusOptions
+	Registration RegistrationOptions
+	Preferences  PreferenceOptions
+	Persistence  PersistenceOptions
+}
+
 // Factory 會員模組工廠
-type Factory struct{}
+type Factory struct {
+	auditInput  auditinput.AuditInputPort
+	outboxInput outboxinput.OutboxInputPort
+	options     Options
+}
 
-// NewModuleFactory 創建會員模組工廠
-func NewModuleFactory() modules.ModuleFactory {
-	return &Factory{}
+// NewModuleFactory 創建會員模組工廠，auditInput/outboxInput 為稽核與 outbox 模組的 input port
+func NewModuleFactory(auditInput auditinput.AuditInputPort, outboxInput outboxinput.OutboxInputPort, options Options) modules.ModuleFactory {
+	return &Factory{
+		auditInput:  auditInput,
+		outboxInput: outboxInput,
+		options:     options,
+	}
 }
 
 // CreateModule 創建會員模組，注入 logger 和 tracer 到需要的組件中
@@ -31,13 +207,158 @@ func (f *Factory) CreateModule(db *sqlx.DB, rg *gin.RouterGroup, log logger.Logg
 
 	// 組裝所有組件
 	validator := validation.NewMemberValidator()
-	repo := mcsqlite.NewSqlxMemberSqlite(db, moduleLogger, tracer)
+	// 手寫 SQL 的 DAO 依連線的 driver 選擇 SQL 方言
+	var (
+		repo           dao.MemberDAO
+		invitationRepo dao.InvitationDAO
+		segmentRepo    dao.SegmentDAO
+		preferenceRepo dao.PreferenceDAO
+	)
+	readRouter := f.options.Persistence.ReadRouter
+	if readRouter == nil {
+		readRouter = sqlxreplica.NewRouter(db, nil, sqlxreplica.Options{}, moduleLogger)
+	}
+	var queryInstrument *sqlxinstrument.Instrumenter
+	var stmts *sqlxstmt.Cache
+	// ent 與 memory 不經過 sqlx MemberDAO，只有 sqlx 實作需要 prepared statement 快取
+	usesSqlxMemberDAO := f.options.Persistence.Driver == "" || f.options.Persistence.Driver == PersistenceDriverSQLX
+	switch sqlxdriver.Driver(db.DriverName()) {
+	case sqlxdriver.DriverSQLite:
+		if usesSqlxMemberDAO && f.options.Persistence.StatementCacheSize >= 0 {
+			stmts = sqlxstmt.New(f.options.Persistence.StatementCacheSize)
+		}
+		queryInstrument = mcsqlite.NewInstrumenter(moduleLogger, tracer, f.options.Persistence.Instrumentation)
+		repo = mcsqlite.NewSqlxMemberSqliteWithRouter(readRouter, queryInstrument, stmts, moduleLogger, tracer)
+		invitationRepo = mcsqlite.NewSqlxInvitationSqlite(db, moduleLogger, tracer)
+		segmentRepo = mcsqlite.NewSqlxSegmentSqlite(db, moduleLogger, tracer)
+		preferenceRepo = mcsqlite.NewSqlxPreferenceSqlite(db, moduleLogger, tracer)
+	case sqlxdriver.DriverPostgres:
+		queryInstrument = pgsql.NewInstrumenter(moduleLogger, tracer, f.options.Persistence.Instrumentation)
+		repo = pgsql.NewSqlxMemberPgsqlWithRouter(readRouter, queryInstrument, moduleLogger, tracer)
+		invitationRepo = pgsql.NewSqlxInvitationPgsql(db, moduleLogger, tracer)
+		segmentRepo = pgsql.NewSqlxSegmentPgsql(db, moduleLogger, tracer)
+		preferenceRepo = pgsql.NewSqlxPreferencePgsql(db, moduleLogger, tracer)
+	default:
+		return nil, fmt.Errorf("member: unsupported database driver %q", db.DriverName())
+	}
+	switch f.options.Persistence.Driver {
+	case "", PersistenceDriverSQLX:
+		// 沿用上面依 driver 選出的 sqlx 實作
+	case PersistenceDriverEnt:
+		// ent 與 memory 不經過 sqlx MemberDAO，沒有 SQL 查詢統計
+		queryInstrument = nil
+		repo = mcent.NewEntMember(db, moduleLogger, tracer)
+	case PersistenceDriverMemory:
+		queryInstrument = nil
+		memoryRepo, err := mcmemory.NewMemoryMember(f.options.Persistence.SnapshotPath, moduleLogger, tracer)
+		if err != nil {
+			return nil, err
+		}
+		repo = memoryRepo
+	default:
+		return nil, fmt.Errorf("member: unknown persistence driver %q", f.options.Persistence.Driver)
+	}
 	gateway := repository.NewMemberRepoGateway(repo, moduleLogger, tracer)
-	useCase := usecase.NewMemberUseCase(gateway, moduleLogger, tracer) // UseCase 注入 logger 和 tracer
+	var txManager output.TransactionManager = sqlxtx.NewTxManager(db)
+	var memberCache *cache.MemberCacheGateway
+	if f.options.Persistence.Cache.Enabled {
+		operations, err := cache.ParseOperations(f.options.Persistence.Cache.Operations)
+		if err != nil {
+			return nil, fmt.Errorf("member: %w", err)
+		}
+		backend := f.options.Persistence.Cache.Backend
+		if backend == nil {
+			backend = lrucache.New(f.options.Persistence.Cache.Size)
+		}
+		memberCache = cache.NewMemberCacheGateway(gateway, backend, cache.Options{TTL: f.options.Persistence.Cache.TTL, Operations: operations}, moduleLogger, tracer)
+		// 交易結束後才能確定快取失效，use case 的交易需經過快取
+		gateway = memberCache
+		txManager = memberCache.TransactionManager(txManager)
+	}
+	invitations := repository.NewInvitationRepoGateway(invitationRepo, moduleLogger, tracer)
+	segments := repository.NewSegmentRepoGateway(segmentRepo, moduleLogger, tracer)
+	preferences := repository.NewPreferenceRepoGateway(preferenceRepo, moduleLogger, tracer)
+	auditTrail := audit.NewMemberAuditGateway(f.auditInput, moduleLogger, tracer)
+	eventOutbox := outbox.NewMemberOutboxGateway(f.outboxInput, f.options.Privacy.WebhookInput, moduleLogger, tracer)
+	changeBroker := stream.NewBroker(f.options.Stream.ReplayBufferSize, f.options.Stream.SubscriberBufferSize, moduleLogger)
+	emailNormalizer := entity.NewEmailNormalizer(f.options.Email.IgnoreDotsDomains, f.options.Email.PlusTagDomains, f.options.Email.DomainAliases)
+	emailPolicy, err := emailpolicy.NewDomainPolicy(f.options.Email.Policy, net.DefaultResolver, moduleLogger)
+	if err != nil {
+		return nil, err
+	}
+	passwordPolicy := f.options.Password.Policy
+	if passwordPolicy.MinLength <= 0 {
+		passwordPolicy.MinLength = DefaultPasswordMinLength
+	}
+	if passwordPolicy.MaxLength <= 0 {
+		passwordPolicy.MaxLength = DefaultPasswordMaxLength
+	}
+	var breachedPasswords output.BreachedPasswordChecker
+	if f.options.Password.BreachedListDir != "" {
+		checker, err := breachedpassword.NewPrefixFileChecker(f.options.Password.BreachedListDir)
+		if err != nil {
+			return nil, err
+		}
+		breachedPasswords = checker
+	}
+	var tokenIssuer output.TokenIssuer
+	if f.options.Auth.Secret != "" {
+		issuer, err := token.NewJWTIssuer(f.options.Auth.Algorithm, f.options.Auth.Secret, f.options.Auth.TokenTTL)
+		if err != nil {
+			return nil, err
+		}
+		tokenIssuer = issuer
+	}
+	var inviteOnly bool
+	switch f.options.Registration.Mode {
+	case "", RegistrationModeOpen:
+	case RegistrationModeInviteOnly:
+		inviteOnly = true
+	default:
+		return nil, fmt.Errorf("member: unknown registration mode %q", f.options.Registration.Mode)
+	}
+	invitationTTL := f.options.Registration.InvitationTTL
+	if invitationTTL <= 0 {
+		invitationTTL = DefaultInvitationTTL
+	}
+	defaultLocale := f.options.Preferences.DefaultLocale
+	if defaultLocale == "" {
+		defaultLocale = DefaultPreferenceLocale
+	}
+	defaultTimeZone := f.options.Preferences.DefaultTimeZone
+	if defaultTimeZone == "" {
+		defaultTimeZone = DefaultPreferenceTimeZone
+	}
+	preferenceRegistry, err := entity.NewDefaultPreferenceRegistry(defaultLocale, defaultTimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("member: invalid preference defaults: %w", err)
+	}
+	useCase := usecase.NewMemberUseCase(usecase.Dependencies{
+		Members:            gateway,
+		TxManager:          txManager,
+		EventOutbox:        eventOutbox,
+		AuditTrail:         auditTrail,
+		ChangeFeed:         changeBroker,
+		EmailNormalizer:    emailNormalizer,
+		EmailPolicy:        emailPolicy,
+		BreachedPasswords:  breachedPasswords,
+		Invitations:        invitations,
+		Segments:           segments,
+		Preferences:        preferences,
+		PreferenceRegistry: preferenceRegistry,
+		TokenIssuer:        tokenIssuer,
+	}, usecase.Options{
+		PrivacyOfficers:   f.options.Privacy.Officers,
+		PasswordPolicy:    passwordPolicy,
+		StatusAdmins:      f.options.Status.Admins,
+		RequireActivation: f.options.Status.RequireActivation,
+		InviteOnly:        inviteOnly,
+		InvitationTTL:     invitationTTL,
+	}, moduleLogger, tracer) // UseCase 注入 logger 和 tracer
 	presenter := http.NewMemberPresenter()
-	controller := controller.NewMemberController(useCase, presenter, validator, moduleLogger, tracer) // Controller 注入 logger 和 tracer
+	controller := controller.NewMemberController(useCase, presenter, validator, f.options.Stream.Heartbeat, moduleLogger, tracer) // Controller 注入 logger 和 tracer
 	router := router.NewMemberRouter(controller, rg)
 
 	// 創建並返回模組實例
-	return NewModule(router), nil
+	return NewModule(router, changeBroker, useCase, memberCache, queryInstrument, stmts), nil
 }
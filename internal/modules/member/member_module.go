@@ -1,16 +1,39 @@
 package member
 
-import "github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/router"
+import (
+	"context"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxinstrument"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxstmt"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/framework/stream"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/gateway/cache"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/interface_adapter/router"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/input"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"strconv"
+	"time"
+)
 
 // Module 會員模組 - 具體產品
 type Module struct {
-	router *router.MemberRouter
+	router       *router.MemberRouter
+	changeBroker *stream.Broker
+	inputPort    input.MemberInputPort
+	memberCache  *cache.MemberCacheGateway
+	queries      *sqlxinstrument.Instrumenter
+	stmts        *sqlxstmt.Cache
 }
 
-// NewModule 創建會員模組實例
-func NewModule(router *router.MemberRouter) *Module {
+// NewModule 創建會員模組實例，memberCache 為 nil 表示未啟用查詢快取；queries 為 nil 表示 MemberDAO 不是 sqlx 實作；
+// stmts 為 nil 表示未使用 prepared statement 快取
+func NewModule(router *router.MemberRouter, changeBroker *stream.Broker, inputPort input.MemberInputPort, memberCache *cache.MemberCacheGateway, queries *sqlxinstrument.Instrumenter, stmts *sqlxstmt.Cache) *Module {
 	return &Module{
-		router: router,
+		router:       router,
+		changeBroker: changeBroker,
+		inputPort:    inputPort,
+		memberCache:  memberCache,
+		queries:      queries,
+		stmts:        stmts,
 	}
 }
 
@@ -25,8 +48,78 @@ func (m *Module) Setup() error {
 	return m.router.Register()
 }
 
+// BackfillNormalizedEmails 依目前的正規化規則回填既有會員的正規化 Email，供啟動時呼叫
+func (m *Module) BackfillNormalizedEmails(ctx context.Context) (*output.EmailBackfillReport, error) {
+	return m.inputPort.BackfillNormalizedEmails(ctx)
+}
+
+// AuthenticateSubject 供 auth middleware 驗證 token subject：會員 ID 必須是目前可通過身分驗證的會員，
+// 停權、封鎖、合併或刪除後已簽發的 token 即失效；非數字的 subject（管理者、服務帳號）不屬於會員，直接放行
+func (m *Module) AuthenticateSubject(ctx context.Context, subject string) error {
+	id, err := strconv.Atoi(subject)
+	if err != nil {
+		return nil
+	}
+	_, err = m.inputPort.AuthenticateMember(ctx, id)
+	return err
+}
+
+// CacheStats 回傳會員查詢快取各查詢的命中與未命中次數，未啟用快取時回傳 nil
+func (m *Module) CacheStats() map[cache.Operation]cache.Stats {
+	if m.memberCache == nil {
+		return nil
+	}
+	return m.memberCache.Stats()
+}
+
+// LogCacheStats 定期記錄快取命中統計，直到 ctx 取消；未啟用快取時直接返回
+func (m *Module) LogCacheStats(ctx context.Context, interval time.Duration, log logger.Logger) {
+	if m.memberCache == nil {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			fields := make([]logger.Field, 0, len(cache.AllOperations())*2)
+			stats := m.memberCache.Stats()
+			for _, op := range cache.AllOperations() {
+				fields = append(fields,
+					logger.NewField(string(op)+"_hits", stats[op].Hits),
+					logger.NewField(string(op)+"_misses", stats[op].Misses),
+				)
+			}
+			log.Info("會員查詢快取統計", fields...)
+		}
+	}
+}
+
+// QueryStats 回傳 MemberDAO 每個 SQL 查詢名稱的延遲統計，未使用 sqlx 實作時回傳 nil
+func (m *Module) QueryStats() map[string]sqlxinstrument.QueryStats {
+	if m.queries == nil {
+		return nil
+	}
+	return m.queries.Stats()
+}
+
+// LogQueryStats 定期記錄 SQL 查詢的延遲統計，直到 ctx 取消；未使用 sqlx 實作時直接返回
+func (m *Module) LogQueryStats(ctx context.Context, interval time.Duration) {
+	if m.queries == nil {
+		return
+	}
+	m.queries.LogStats(ctx, interval)
+}
+
 // Shutdown 實現 Module 接口
 func (m *Module) Shutdown() error {
-	// 未來可能需要的清理邏輯
+	// 中斷所有會員異動串流，讓長連線結束
+	m.changeBroker.Close()
+	// 關閉快取的 prepared statement，須在資料庫關閉前呼叫
+	if m.stmts != nil {
+		return m.stmts.Close()
+	}
 	return nil
 }
@@ -2,6 +2,10 @@ package usecase
 
 import (
 	"errors"
+	"fmt"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"strconv"
+	"strings"
 )
 
 // MemberUseCase 錯誤碼
@@ -19,6 +23,20 @@ var (
 	ErrMemberUnexpectedError = errors.New("usecase: member usecase unexpected error")
 	// ErrMemberMappingError 從 repo model 轉換到 entity 時發生錯誤，像是型別不符、時間格式有誤等。
 	ErrMemberMappingError = errors.New("usecase: member mapping repo model to entity failed")
+	// ErrMemberAuditTrailError 稽核紀錄讀寫失敗；一般異動只記 log，個資匯出/刪除則會回傳給呼叫端。
+	ErrMemberAuditTrailError = errors.New("usecase: member audit trail record failed")
+	// ErrMemberEventOutboxError 領域事件寫入 outbox 失敗，整個異動會回滾。
+	ErrMemberEventOutboxError = errors.New("usecase: member event outbox write failed")
+	// ErrMemberConcurrentUpdate 並行交易互相衝突被資料庫中止（例如 Postgres serialization failure），呼叫端可重試。
+	ErrMemberConcurrentUpdate = errors.New("usecase: member concurrent update conflict")
+	// ErrMemberReferenceConflict 違反外鍵，像是參照的會員不存在、或要刪除的資料仍被參照。
+	ErrMemberReferenceConflict = errors.New("usecase: member reference conflict")
+	// ErrMemberConstraintViolation 資料違反資料庫的 NOT NULL 或 CHECK 約束。
+	ErrMemberConstraintViolation = errors.New("usecase: member data violates database constraint")
+	// ErrMemberStorageUnavailable 資料庫暫時無法寫入（被鎖住、唯讀或空間已滿），呼叫端可稍後重試。
+	ErrMemberStorageUnavailable = errors.New("usecase: member storage unavailable")
+	// ErrMemberChangeStreamUnavailable 未啟用會員異動通知或 feed 已關閉，無法訂閱串流。
+	ErrMemberChangeStreamUnavailable = errors.New("usecase: member change stream unavailable")
 
 	// ------- usecase 內部的業務語意 -------
 	// ErrMemberUpdateSameEmail 嘗試改 email 結果新舊 email 一樣。
@@ -29,4 +47,120 @@ var (
 	ErrMemberUpdateSamePassword = errors.New("usecase: member use same password")
 	// ErrMemberPasswordIncorrect 密碼驗證沒過（ex: 修改 email/密碼時比對舊密碼不對）。
 	ErrMemberPasswordIncorrect = errors.New("usecase: member password incorrect")
+	// ErrMemberInvalidEmail Email 無法正規化（缺少 @、網域不是合法的 IDN 等）。
+	ErrMemberInvalidEmail = errors.New("usecase: member email invalid")
+	// ErrMemberInvalidName 會員名稱不符合 entity 的長度規則。
+	ErrMemberInvalidName = errors.New("usecase: member name invalid")
+	// ErrMemberEmailPolicyViolation Email 網域違反政策（不在 allowlist、被封鎖、拋棄式信箱或無法收信）。
+	ErrMemberEmailPolicyViolation = errors.New("usecase: member email domain not allowed")
+	// ErrMemberPasswordPolicyViolation 密碼違反密碼政策，違反的規則見 PasswordPolicyError。
+	ErrMemberPasswordPolicyViolation = errors.New("usecase: member password violates policy")
+	// ErrMemberStatusTransitionNotAllowed 狀態機不允許從目前狀態轉換到目標狀態（例如 pending 直接停權）。
+	ErrMemberStatusTransitionNotAllowed = errors.New("usecase: member status transition not allowed")
+	// ErrMemberStatusReasonRequired 停權或封鎖時沒有附上原因。
+	ErrMemberStatusReasonRequired = errors.New("usecase: member status change reason required")
+	// ErrMemberStatusForbidden 呼叫者不是會員狀態管理者，不能變更會員狀態。
+	ErrMemberStatusForbidden = errors.New("usecase: member status change forbidden")
+	// ErrMemberNotActive 會員不是 active（尚未啟用、停權或封鎖），不能通過身分驗證。
+	ErrMemberNotActive = errors.New("usecase: member is not active")
+	// ErrMemberInvalidCredentials 簽發 token 時 Email 不存在或密碼錯誤，兩者不區分以免洩漏帳號是否存在。
+	ErrMemberInvalidCredentials = errors.New("usecase: member credentials invalid")
+	// ErrMemberTokenUnavailable 未設定 token 簽發器，無法簽發 access token。
+	ErrMemberTokenUnavailable = errors.New("usecase: member token issuer unavailable")
+	// ErrMemberMerged 會員已被合併到其他會員，查詢時應改用 MemberMergedError.TargetID。
+	ErrMemberMerged = errors.New("usecase: member has been merged into another member")
+	// ErrMemberInvalidMerge 合併的來源與目標是同一個會員。
+	ErrMemberInvalidMerge = errors.New("usecase: member cannot be merged into itself")
+	// ErrMemberMergeForbidden 呼叫者不是會員狀態管理者，不能合併會員。
+	ErrMemberMergeForbidden = errors.New("usecase: member merge forbidden")
+	// ErrInvitationNotFound 查無邀請碼。
+	ErrInvitationNotFound = errors.New("usecase: invitation not found")
+	// ErrInvitationAlreadyExists 產生的邀請碼與既有邀請碼重複。
+	ErrInvitationAlreadyExists = errors.New("usecase: invitation code already exists")
+	// ErrInvitationRequired 僅限邀請註冊時未提供邀請碼。
+	ErrInvitationRequired = errors.New("usecase: invitation code required")
+	// ErrInvitationInvalid 邀請碼不存在、已過期、已撤銷、已用完或鎖定其他 Email。
+	ErrInvitationInvalid = errors.New("usecase: invitation code invalid")
+	// ErrInvitationForbidden 呼叫者不是管理者或會員本人，不能建立、查看或撤銷邀請碼。
+	ErrInvitationForbidden = errors.New("usecase: invitation access forbidden")
+	// ErrMemberInvalidTag 標籤不符合規則（空白、過長或含有英數字、底線與連字號以外的字元）。
+	ErrMemberInvalidTag = errors.New("usecase: member tag invalid")
+	// ErrMemberTagForbidden 呼叫者不是會員狀態管理者，不能管理標籤與分群。
+	ErrMemberTagForbidden = errors.New("usecase: member tag management forbidden")
+	// ErrSegmentNotFound 查無分群。
+	ErrSegmentNotFound = errors.New("usecase: segment not found")
+	// ErrSegmentAlreadyExists 分群名稱已存在。
+	ErrSegmentAlreadyExists = errors.New("usecase: segment already exists")
+	// ErrSegmentInvalid 分群名稱不符合規則，或沒有任何條件、條件不合法。
+	ErrSegmentInvalid = errors.New("usecase: segment invalid")
+	// ErrMemberInvalidPreference 偏好設定的 key 不存在，或值的型別、內容不符合 schema。
+	ErrMemberInvalidPreference = errors.New("usecase: member preference invalid")
+	// ErrMemberPrivacyForbidden 呼叫者不是會員本人也不是個資管理者，不能匯出或刪除個資。
+	ErrMemberPrivacyForbidden = errors.New("usecase: member personal data access forbidden")
 )
+
+// PasswordPolicyError 密碼違反的所有規則，errors.Is 視為 ErrMemberPasswordPolicyViolation，
+// presenter 可用 errors.As 取出 Rules 逐條列出
+type PasswordPolicyError struct {
+	Rules []entity.PasswordRule
+}
+
+func (e *PasswordPolicyError) Error() string {
+	rules := make([]string, len(e.Rules))
+	for i, rule := range e.Rules {
+		rules[i] = string(rule)
+	}
+	return ErrMemberPasswordPolicyViolation.Error() + ": " + strings.Join(rules, ", ")
+}
+
+func (e *PasswordPolicyError) Is(target error) bool {
+	return target == ErrMemberPasswordPolicyViolation
+}
+
+// MemberMergedError 查詢的會員已被合併，errors.Is 視為 ErrMemberMerged，
+// controller 可用 errors.As 取出 TargetID 導向保留的會員
+type MemberMergedError struct {
+	TargetID int
+}
+
+func (e *MemberMergedError) Error() string {
+	return ErrMemberMerged.Error() + ": " + strconv.Itoa(e.TargetID)
+}
+
+func (e *MemberMergedError) Is(target error) bool {
+	return target == ErrMemberMerged
+}
+
+// mapEntityError 將 entity 不變條件錯誤轉為 usecase sentinel error，保留原始錯誤說明
+func mapEntityError(err error) error {
+	switch {
+	case errors.Is(err, entity.ErrInvalidEmailFormat):
+		return fmt.Errorf("%w: %v", ErrMemberInvalidEmail, err)
+	case errors.Is(err, entity.ErrNameTooShort), errors.Is(err, entity.ErrNameTooLong):
+		return fmt.Errorf("%w: %v", ErrMemberInvalidName, err)
+	case errors.Is(err, entity.ErrIllegalStatusTransition):
+		return fmt.Errorf("%w: %v", ErrMemberStatusTransitionNotAllowed, err)
+	case errors.Is(err, entity.ErrStatusReasonRequired):
+		return ErrMemberStatusReasonRequired
+	case errors.Is(err, entity.ErrMemberNotActive):
+		return fmt.Errorf("%w: %v", ErrMemberNotActive, err)
+	case errors.Is(err, entity.ErrMemberMerged):
+		return fmt.Errorf("%w: %v", ErrMemberMerged, err)
+	case errors.Is(err, entity.ErrMergeIntoSelf):
+		return fmt.Errorf("%w: %v", ErrMemberInvalidMerge, err)
+	case errors.Is(err, entity.ErrInvitationMaxUsesInvalid),
+		errors.Is(err, entity.ErrInvitationExpired),
+		errors.Is(err, entity.ErrInvitationRevoked),
+		errors.Is(err, entity.ErrInvitationExhausted),
+		errors.Is(err, entity.ErrInvitationEmailMismatch):
+		return fmt.Errorf("%w: %v", ErrInvitationInvalid, err)
+	case errors.Is(err, entity.ErrTagInvalid):
+		return fmt.Errorf("%w: %v", ErrMemberInvalidTag, err)
+	case errors.Is(err, entity.ErrSegmentNameInvalid), errors.Is(err, entity.ErrSegmentFilterInvalid):
+		return fmt.Errorf("%w: %v", ErrSegmentInvalid, err)
+	case errors.Is(err, entity.ErrPreferenceUnknownKey), errors.Is(err, entity.ErrPreferenceInvalidValue):
+		return fmt.Errorf("%w: %v", ErrMemberInvalidPreference, err)
+	default:
+		return ErrMemberUnexpectedError
+	}
+}
@@ -8,6 +8,11 @@
 // 4. 僅作為 UseCase 的 input，嚴禁混用於 Domain/Entity 層
 package inputmodel
 
+import (
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"time"
+)
+
 // PatchUpdateMemberProfileInputModel 為「更新會員資訊」UseCase 的輸入模型。
 //   - 僅用於 UseCase 內部，不對外暴露。
 //   - 支援 PATCH 部分欄位更新，欄位為 nil 表示不更新該欄位。
@@ -23,3 +28,68 @@ type PatchUpdateMemberPasswordInputModel struct {
 	OldPassword string
 	NewPassword string
 }
+
+// StreamMemberChangesInputModel 為「訂閱會員異動串流」UseCase 的輸入模型。
+//   - Types 為空表示訂閱全部異動類型。
+//   - LastEventID 為客戶端斷線前最後收到的事件 ID，用於補送。
+type StreamMemberChangesInputModel struct {
+	Types       []string
+	LastEventID string
+}
+
+// ListMembersInputModel 為「會員列表」UseCase 的篩選條件。
+//   - Status 為空表示不篩選狀態。
+//   - TagsAny 具備任一標籤，TagsAll 須同時具備所有標籤；標籤由 UseCase 正規化。
+type ListMembersInputModel struct {
+	Status  entity.MemberStatus
+	TagsAny []string
+	TagsAll []string
+}
+
+// ChangeMemberStatusInputModel 為「變更會員狀態」UseCase 的輸入模型。
+//   - Status 為目標狀態，是否允許由 entity 的狀態機決定。
+//   - Reason 停權與封鎖時必填。
+type ChangeMemberStatusInputModel struct {
+	ID     int
+	Status entity.MemberStatus
+	Reason string
+}
+
+// MergeMembersInputModel 為「合併會員」UseCase 的輸入模型。
+//   - SourceID 為要合併掉的重複帳號，合併後成為指向 TargetID 的 tombstone。
+//   - Preview 為 true 時只回報會搬移的資料，不寫入任何資料。
+type MergeMembersInputModel struct {
+	SourceID int
+	TargetID int
+	Preview  bool
+}
+
+// CreateInvitationInputModel 為「建立邀請碼」UseCase 的輸入模型。
+//   - Email 不為空時只有該 Email 可以使用。
+//   - MaxUses 為 0 時預設只能使用一次；ExpiresAt 為零值時使用設定的有效期間。
+type CreateInvitationInputModel struct {
+	Email     string
+	MaxUses   int
+	ExpiresAt time.Time
+}
+
+// BulkTagMembersInputModel 為「批次加標籤」UseCase 的輸入模型。
+//   - 不存在或已合併的會員會被略過。
+type BulkTagMembersInputModel struct {
+	Tag       string
+	MemberIDs []int
+}
+
+// CreateSegmentInputModel 為「建立分群」UseCase 的輸入模型。
+//   - Filter 至少要有一個條件，標籤由 entity 正規化。
+type CreateSegmentInputModel struct {
+	Name   string
+	Filter entity.SegmentFilter
+}
+
+// PatchMemberPreferencesInputModel 為「更新偏好設定」UseCase 的輸入模型。
+//   - Changes 為 JSON merge patch：值為 nil 表示回到預設值，未出現的 key 不變更。
+type PatchMemberPreferencesInputModel struct {
+	ID      int
+	Changes map[string]any
+}
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"strconv"
+)
+
+// IssueMemberToken 以 Email 與密碼驗證會員並簽發 access token，非 active 或已合併的會員不簽發
+func (m *MemberUseCase) IssueMemberToken(ctx context.Context, email, password string) (*output.AccessToken, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, m.tracer, m.logger)
+	defer span.End()
+
+	if m.tokenIssuer == nil {
+		contextLogger.Error("會員 token 簽發失敗：未設定簽發器")
+		return nil, ErrMemberTokenUnavailable
+	}
+	normalized, err := m.emailNormalizer.Normalize(email)
+	if err != nil {
+		contextLogger.Warn("會員 token 簽發 Email 正規化失敗",
+			logger.NewField("error", err),
+		)
+		return nil, ErrMemberInvalidCredentials
+	}
+	member, err := m.MemberGateway.GetByEmail(transCtx, normalized)
+	if errors.Is(err, ErrMemberNotFound) {
+		contextLogger.Warn("會員 token 簽發失敗：Email 不存在")
+		return nil, ErrMemberInvalidCredentials
+	}
+	if err != nil {
+		contextLogger.Error("會員 token 簽發查詢會員失敗",
+			logger.NewField("error", err),
+		)
+		return nil, err
+	}
+	matched, err := m.MemberGateway.VerifyPassword(transCtx, member.ID, password)
+	if err != nil {
+		contextLogger.Error("會員 token 簽發密碼比對失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", member.ID),
+		)
+		return nil, err
+	}
+	if !matched {
+		contextLogger.Warn("會員 token 簽發失敗：密碼錯誤",
+			logger.NewField("member_id", member.ID),
+		)
+		return nil, ErrMemberInvalidCredentials
+	}
+	if err := m.ensureCanAuthenticate(contextLogger, member); err != nil {
+		return nil, err
+	}
+	token, err := m.tokenIssuer.Issue(transCtx, strconv.Itoa(member.ID))
+	if err != nil {
+		contextLogger.Error("會員 token 簽發失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", member.ID),
+		)
+		return nil, ErrMemberUnexpectedError
+	}
+
+	contextLogger.Info("會員 token 簽發成功",
+		logger.NewField("member_id", member.ID),
+		logger.NewField("expires_at", token.ExpiresAt),
+	)
+	return token, nil
+}
+
+// AuthenticateMember 驗證 token 時確認會員目前仍可通過身分驗證，停權、封鎖或合併後已簽發的 token 隨即失效
+func (m *MemberUseCase) AuthenticateMember(ctx context.Context, id int) (*entity.Member, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, m.tracer, m.logger)
+	defer span.End()
+
+	member, err := m.MemberGateway.GetByID(transCtx, id)
+	if err != nil {
+		contextLogger.Warn("會員身分驗證查詢會員失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+		)
+		return nil, err
+	}
+	if err := m.ensureCanAuthenticate(contextLogger, member); err != nil {
+		return nil, err
+	}
+	return member, nil
+}
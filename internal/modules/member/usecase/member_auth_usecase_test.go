@@ -0,0 +1,170 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/mock"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
+)
+
+func TestMemberUseCase_IssueMemberToken(t *testing.T) {
+	ctrl, testTime, mockLogger, mockTracer := privacyHelper(t)
+	member := func(status entity.MemberStatus) *entity.Member {
+		return &entity.Member{ID: 7, Name: "ggg", Email: "gg@gmail.com", Status: status, CreatedAt: testTime}
+	}
+	token := &output.AccessToken{Token: "signed", ExpiresAt: testTime.Add(time.Hour)}
+	tests := []struct {
+		name        string
+		email       string
+		password    string
+		noIssuer    bool
+		repoSetup   func(*mock.MockMemberPersistence)
+		issuerSetup func(*mock.MockTokenIssuer)
+		wantErr     error
+	}{
+		{
+			name:     "active member gets token with id subject",
+			email:    " GG@gmail.com ",
+			password: "secret",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByEmail(gomock.Any(), "gg@gmail.com").Return(member(entity.MemberStatusActive), nil)
+				r.EXPECT().VerifyPassword(gomock.Any(), 7, "secret").Return(true, nil)
+			},
+			issuerSetup: func(i *mock.MockTokenIssuer) {
+				i.EXPECT().Issue(gomock.Any(), "7").Return(token, nil)
+			},
+		},
+		{
+			name:     "unknown email is invalid credentials",
+			email:    "gg@gmail.com",
+			password: "secret",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByEmail(gomock.Any(), "gg@gmail.com").Return(nil, ErrMemberNotFound)
+			},
+			wantErr: ErrMemberInvalidCredentials,
+		},
+		{
+			name:     "wrong password is invalid credentials",
+			email:    "gg@gmail.com",
+			password: "wrong",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByEmail(gomock.Any(), "gg@gmail.com").Return(member(entity.MemberStatusActive), nil)
+				r.EXPECT().VerifyPassword(gomock.Any(), 7, "wrong").Return(false, nil)
+			},
+			wantErr: ErrMemberInvalidCredentials,
+		},
+		{
+			name:     "suspended member is not issued",
+			email:    "gg@gmail.com",
+			password: "secret",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByEmail(gomock.Any(), "gg@gmail.com").Return(member(entity.MemberStatusSuspended), nil)
+				r.EXPECT().VerifyPassword(gomock.Any(), 7, "secret").Return(true, nil)
+			},
+			wantErr: ErrMemberNotActive,
+		},
+		{
+			name:     "merged member is not issued",
+			email:    "gg@gmail.com",
+			password: "secret",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				merged := member(entity.MemberStatusActive)
+				merged.MergedInto = 9
+				r.EXPECT().GetByEmail(gomock.Any(), "gg@gmail.com").Return(merged, nil)
+				r.EXPECT().VerifyPassword(gomock.Any(), 7, "secret").Return(true, nil)
+			},
+			wantErr: ErrMemberMerged,
+		},
+		{
+			name:     "issuer failure",
+			email:    "gg@gmail.com",
+			password: "secret",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByEmail(gomock.Any(), "gg@gmail.com").Return(member(entity.MemberStatusActive), nil)
+				r.EXPECT().VerifyPassword(gomock.Any(), 7, "secret").Return(true, nil)
+			},
+			issuerSetup: func(i *mock.MockTokenIssuer) {
+				i.EXPECT().Issue(gomock.Any(), "7").Return(nil, errors.New("sign failed"))
+			},
+			wantErr: ErrMemberUnexpectedError,
+		},
+		{
+			name:      "no issuer configured",
+			email:     "gg@gmail.com",
+			password:  "secret",
+			noIssuer:  true,
+			repoSetup: func(r *mock.MockMemberPersistence) {},
+			wantErr:   ErrMemberTokenUnavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockMemberPersistence(ctrl)
+			mockIssuer := mock.NewMockTokenIssuer(ctrl)
+			tt.repoSetup(mockRepo)
+			if tt.issuerSetup != nil {
+				tt.issuerSetup(mockIssuer)
+			}
+			m := &MemberUseCase{
+				MemberGateway:   mockRepo,
+				emailNormalizer: entity.NewEmailNormalizer(nil, nil, nil),
+				tokenIssuer:     mockIssuer,
+				logger:          mockLogger,
+				tracer:          mockTracer,
+			}
+			if tt.noIssuer {
+				m.tokenIssuer = nil
+			}
+
+			got, err := m.IssueMemberToken(context.Background(), tt.email, tt.password)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, token, got)
+		})
+	}
+}
+
+func TestMemberUseCase_AuthenticateMember(t *testing.T) {
+	ctrl, testTime, mockLogger, mockTracer := privacyHelper(t)
+	tests := []struct {
+		name    string
+		member  *entity.Member
+		repoErr error
+		wantErr error
+	}{
+		{name: "active member", member: &entity.Member{ID: 7, Status: entity.MemberStatusActive, CreatedAt: testTime}},
+		{name: "banned member", member: &entity.Member{ID: 7, Status: entity.MemberStatusBanned, CreatedAt: testTime}, wantErr: ErrMemberNotActive},
+		{name: "merged member", member: &entity.Member{ID: 7, Status: entity.MemberStatusActive, MergedInto: 9, CreatedAt: testTime}, wantErr: ErrMemberMerged},
+		{name: "member not found", repoErr: ErrMemberNotFound, wantErr: ErrMemberNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockMemberPersistence(ctrl)
+			mockRepo.EXPECT().GetByID(gomock.Any(), 7).Return(tt.member, tt.repoErr)
+			m := &MemberUseCase{
+				MemberGateway: mockRepo,
+				logger:        mockLogger,
+				tracer:        mockTracer,
+			}
+
+			got, err := m.AuthenticateMember(context.Background(), 7)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.member, got)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/enum"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+)
+
+// backfillPageSize 回填正規化 Email 時每次讀取的會員數
+const backfillPageSize = 100
+
+func (m *MemberUseCase) BackfillNormalizedEmails(ctx context.Context) (*output.EmailBackfillReport, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, m.tracer, m.logger)
+	defer span.End()
+
+	report := &output.EmailBackfillReport{}
+	// 只改寫 normalized_email，不影響依 id 排序的分頁結果
+	page := pagination.Pagination{Limit: backfillPageSize, SortBy: "id", OrderBy: enum.OrderByAsc}
+	for {
+		members, err := m.MemberGateway.GetAll(transCtx, output.MemberFilter{IncludeMerged: true}, page)
+		if err != nil {
+			contextLogger.Error("正規化 Email 回填讀取會員失敗",
+				logger.NewField("error", err),
+				logger.NewField("offset", page.Offset),
+			)
+			return nil, err
+		}
+		for _, member := range members {
+			report.Scanned++
+			normalized, err := m.emailNormalizer.Normalize(member.Email)
+			if err != nil {
+				contextLogger.Warn("正規化 Email 回填略過無法正規化的 Email",
+					logger.NewField("member_id", member.ID),
+					logger.NewField("member_email", member.Email),
+				)
+				report.InvalidMemberIDs = append(report.InvalidMemberIDs, member.ID)
+				continue
+			}
+			if normalized == member.NormalizedEmail {
+				continue
+			}
+			err = m.MemberGateway.UpdateNormalizedEmail(transCtx, member.ID, normalized)
+			if errors.Is(err, ErrMemberAlreadyExists) {
+				conflict := output.EmailConflict{MemberID: member.ID, Email: member.Email, NormalizedEmail: normalized}
+				if holder, err := m.MemberGateway.GetByEmail(transCtx, normalized); err == nil {
+					conflict.HolderMemberID = holder.ID
+				}
+				contextLogger.Warn("正規化 Email 回填衝突",
+					logger.NewField("member_id", member.ID),
+					logger.NewField("member_email", member.Email),
+					logger.NewField("normalized_email", normalized),
+					logger.NewField("holder_member_id", conflict.HolderMemberID),
+				)
+				report.Conflicts = append(report.Conflicts, conflict)
+				continue
+			}
+			if err != nil {
+				contextLogger.Error("正規化 Email 回填更新失敗",
+					logger.NewField("error", err),
+					logger.NewField("member_id", member.ID),
+				)
+				return nil, err
+			}
+			report.Updated++
+		}
+		if len(members) < page.Limit {
+			break
+		}
+		page.Offset += page.Limit
+	}
+
+	contextLogger.Info("正規化 Email 回填完成",
+		logger.NewField("scanned", report.Scanned),
+		logger.NewField("updated", report.Updated),
+		logger.NewField("conflicts", len(report.Conflicts)),
+		logger.NewField("invalid", len(report.InvalidMemberIDs)),
+	)
+	return report, nil
+}
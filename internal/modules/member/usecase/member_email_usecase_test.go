@@ -0,0 +1,302 @@
+package usecase
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/mock"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/enum"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"testing"
+)
+
+func gmailNormalizer() entity.EmailNormalizer {
+	return entity.NewEmailNormalizer([]string{"Gmail.com"}, []string{"gmail.com"}, map[string]string{"GoogleMail.com": "gmail.com"})
+}
+
+func TestMemberUseCase_EmailNormalization(t *testing.T) {
+	ctrl, ctx, testTime, mockLogger, mockTracer := repoHelper(t)
+	existing := func() *entity.Member {
+		return &entity.Member{ID: 1, Name: "ggg", Email: "Foo.Bar@gmail.com", NormalizedEmail: "foobar@gmail.com", Password: "old", Status: entity.MemberStatusActive, CreatedAt: testTime}
+	}
+	tests := []struct {
+		name       string
+		normalizer entity.EmailNormalizer
+		repoSetup  func(*mock.MockMemberPersistence)
+		call       func(m *MemberUseCase) error
+		wantErr    error
+	}{
+		{
+			name:       "register stores canonical email and looks up normalized email",
+			normalizer: gmailNormalizer(),
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().Create(ctx, &entity.Member{Name: "ggg", Email: "Foo.Bar+news@googlemail.com", NormalizedEmail: "foobar@gmail.com", Password: "old", Status: entity.MemberStatusActive}).Return(nil)
+				r.EXPECT().GetByEmail(ctx, "foobar@gmail.com").Return(existing(), nil)
+			},
+			call: func(m *MemberUseCase) error {
+				_, err := m.RegisterMember(ctx, &entity.Member{Name: "ggg", Email: "  Foo.Bar+news@GoogleMail.COM ", Password: "old"}, "")
+				return err
+			},
+		},
+		{
+			name: "register without provider rules keeps dots and plus tag",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().Create(ctx, &entity.Member{Name: "ggg", Email: "Foo.Bar+news@gmail.com", NormalizedEmail: "foo.bar+news@gmail.com", Password: "old", Status: entity.MemberStatusActive}).Return(nil)
+				r.EXPECT().GetByEmail(ctx, "foo.bar+news@gmail.com").Return(existing(), nil)
+			},
+			call: func(m *MemberUseCase) error {
+				_, err := m.RegisterMember(ctx, &entity.Member{Name: "ggg", Email: "Foo.Bar+news@Gmail.com", Password: "old"}, "")
+				return err
+			},
+		},
+		{
+			name:      "register rejects email without domain",
+			repoSetup: func(r *mock.MockMemberPersistence) {},
+			call: func(m *MemberUseCase) error {
+				_, err := m.RegisterMember(ctx, &entity.Member{Name: "ggg", Email: "foo@", Password: "old"}, "")
+				return err
+			},
+			wantErr: ErrMemberInvalidEmail,
+		},
+		{
+			name: "get by email resolves differently cased address",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByEmail(ctx, "foo@example.com").Return(existing(), nil)
+				r.EXPECT().CountAll(ctx, gomock.Any()).Return(0, nil)
+				r.EXPECT().ListTags(ctx, gomock.Any()).Return(nil, nil)
+			},
+			call: func(m *MemberUseCase) error {
+				_, err := m.GetMemberByEmail(ctx, "Foo@EXAMPLE.com")
+				return err
+			},
+		},
+		{
+			name: "get by email converts IDN domain to punycode",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByEmail(ctx, "user@xn--bcher-kva.example").Return(existing(), nil)
+				r.EXPECT().CountAll(ctx, gomock.Any()).Return(0, nil)
+				r.EXPECT().ListTags(ctx, gomock.Any()).Return(nil, nil)
+			},
+			call: func(m *MemberUseCase) error {
+				_, err := m.GetMemberByEmail(ctx, "user@Bücher.example")
+				return err
+			},
+		},
+		{
+			name:      "get by email rejects invalid IDN domain",
+			repoSetup: func(r *mock.MockMemberPersistence) {},
+			call: func(m *MemberUseCase) error {
+				_, err := m.GetMemberByEmail(ctx, "user@exa mple.com")
+				return err
+			},
+			wantErr: ErrMemberInvalidEmail,
+		},
+		{
+			name:       "update email to same mailbox with provider variant is same email",
+			normalizer: gmailNormalizer(),
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByEmail(ctx, "foobar@gmail.com").Return(existing(), nil)
+			},
+			call: func(m *MemberUseCase) error {
+				return m.UpdateMemberEmail(ctx, 1, "foo.bar+shop@googlemail.com", "old")
+			},
+			wantErr: ErrMemberUpdateSameEmail,
+		},
+		{
+			name: "update email writes canonical and normalized email",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByEmail(ctx, "new@example.com").Return(nil, ErrMemberNotFound)
+				r.EXPECT().GetByID(ctx, 1).Return(existing(), nil)
+				r.EXPECT().VerifyPassword(ctx, 1, "old").Return(true, nil)
+				r.EXPECT().UpdateEmail(ctx, 1, "New@example.com", "new@example.com").Return(nil)
+			},
+			call: func(m *MemberUseCase) error {
+				return m.UpdateMemberEmail(ctx, 1, " New@Example.COM", "old")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockMemberPersistence(ctrl)
+			m := &MemberUseCase{
+				MemberGateway:   mockRepo,
+				emailNormalizer: tt.normalizer,
+				logger:          mockLogger,
+				tracer:          mockTracer,
+			}
+			tt.repoSetup(mockRepo)
+
+			err := tt.call(m)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestMemberUseCase_BackfillNormalizedEmails(t *testing.T) {
+	ctrl, ctx, testTime, mockLogger, mockTracer := repoHelper(t)
+	firstPage := pagination.Pagination{Limit: backfillPageSize, SortBy: "id", OrderBy: enum.OrderByAsc}
+	secondPage := firstPage
+	secondPage.Offset = backfillPageSize
+	member := func(id int, email, normalized string) *entity.Member {
+		return &entity.Member{ID: id, Email: email, NormalizedEmail: normalized, CreatedAt: testTime}
+	}
+	tests := []struct {
+		name      string
+		repoSetup func(*mock.MockMemberPersistence)
+		want      *output.EmailBackfillReport
+		wantErr   error
+	}{
+		{
+			name: "updates stale rows, skips current rows and reports conflicts",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetAll(ctx, output.MemberFilter{IncludeMerged: true}, firstPage).Return([]*entity.Member{
+					member(1, "Foo.Bar@gmail.com", "foo.bar@gmail.com"),
+					member(2, "foobar+x@googlemail.com", ""),
+					member(3, "ok@example.com", "ok@example.com"),
+					member(4, "broken", ""),
+				}, nil)
+				r.EXPECT().UpdateNormalizedEmail(ctx, 1, "foobar@gmail.com").Return(nil)
+				r.EXPECT().UpdateNormalizedEmail(ctx, 2, "foobar@gmail.com").Return(ErrMemberAlreadyExists)
+				r.EXPECT().GetByEmail(ctx, "foobar@gmail.com").Return(member(1, "Foo.Bar@gmail.com", "foobar@gmail.com"), nil)
+			},
+			want: &output.EmailBackfillReport{
+				Scanned: 4,
+				Updated: 1,
+				Conflicts: []output.EmailConflict{
+					{MemberID: 2, Email: "foobar+x@googlemail.com", NormalizedEmail: "foobar@gmail.com", HolderMemberID: 1},
+				},
+				InvalidMemberIDs: []int{4},
+			},
+		},
+		{
+			name: "pages until a short page",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				full := make([]*entity.Member, 0, backfillPageSize)
+				for i := 1; i <= backfillPageSize; i++ {
+					full = append(full, member(i, "same@example.com", "same@example.com"))
+				}
+				r.EXPECT().GetAll(ctx, output.MemberFilter{IncludeMerged: true}, firstPage).Return(full, nil)
+				r.EXPECT().GetAll(ctx, output.MemberFilter{IncludeMerged: true}, secondPage).Return([]*entity.Member{}, nil)
+			},
+			want: &output.EmailBackfillReport{Scanned: backfillPageSize},
+		},
+		{
+			name: "update db error aborts backfill",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetAll(ctx, output.MemberFilter{IncludeMerged: true}, firstPage).Return([]*entity.Member{member(1, "a@example.com", "")}, nil)
+				r.EXPECT().UpdateNormalizedEmail(ctx, 1, "a@example.com").Return(ErrMemberDBError)
+			},
+			wantErr: ErrMemberDBError,
+		},
+		{
+			name: "list db error",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetAll(ctx, output.MemberFilter{IncludeMerged: true}, firstPage).Return(nil, ErrMemberDBError)
+			},
+			wantErr: ErrMemberDBError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockMemberPersistence(ctrl)
+			m := &MemberUseCase{
+				MemberGateway:   mockRepo,
+				emailNormalizer: gmailNormalizer(),
+				logger:          mockLogger,
+				tracer:          mockTracer,
+			}
+			tt.repoSetup(mockRepo)
+
+			got, err := m.BackfillNormalizedEmails(ctx)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "err = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMemberUseCase_EmailPolicy(t *testing.T) {
+	ctrl, ctx, testTime, mockLogger, mockTracer := repoHelper(t)
+	existing := func() *entity.Member {
+		return &entity.Member{ID: 1, Name: "ggg", Email: "gg@example.com", NormalizedEmail: "gg@example.com", Password: "old", Status: entity.MemberStatusActive, CreatedAt: testTime}
+	}
+	violation := &output.EmailPolicyViolation{Domain: "mailinator.com", Reason: output.EmailPolicyReasonDisposable}
+	tests := []struct {
+		name        string
+		policySetup func(*mock.MockEmailPolicy)
+		repoSetup   func(*mock.MockMemberPersistence)
+		call        func(m *MemberUseCase) error
+		wantErr     error
+	}{
+		{
+			name: "register checks normalized domain",
+			policySetup: func(p *mock.MockEmailPolicy) {
+				p.EXPECT().Check(ctx, "xn--bcher-kva.example").Return(nil)
+			},
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				r.EXPECT().GetByEmail(ctx, "gg@xn--bcher-kva.example").Return(existing(), nil)
+			},
+			call: func(m *MemberUseCase) error {
+				_, err := m.RegisterMember(ctx, &entity.Member{Name: "ggg", Email: "gg@Bücher.example", Password: "old"}, "")
+				return err
+			},
+		},
+		{
+			name: "register rejected by policy does not create member",
+			policySetup: func(p *mock.MockEmailPolicy) {
+				p.EXPECT().Check(ctx, "mailinator.com").Return(violation)
+			},
+			repoSetup: func(r *mock.MockMemberPersistence) {},
+			call: func(m *MemberUseCase) error {
+				_, err := m.RegisterMember(ctx, &entity.Member{Name: "ggg", Email: "spam@Mailinator.com", Password: "old"}, "")
+				return err
+			},
+			wantErr: ErrMemberEmailPolicyViolation,
+		},
+		{
+			name: "update email rejected by policy does not touch repository",
+			policySetup: func(p *mock.MockEmailPolicy) {
+				p.EXPECT().Check(ctx, "mailinator.com").Return(violation)
+			},
+			repoSetup: func(r *mock.MockMemberPersistence) {},
+			call: func(m *MemberUseCase) error {
+				return m.UpdateMemberEmail(ctx, 1, "spam@mailinator.com", "old")
+			},
+			wantErr: ErrMemberEmailPolicyViolation,
+		},
+		{
+			name: "policy check failure is unexpected error",
+			policySetup: func(p *mock.MockEmailPolicy) {
+				p.EXPECT().Check(ctx, "example.com").Return(errors.New("boom"))
+			},
+			repoSetup: func(r *mock.MockMemberPersistence) {},
+			call: func(m *MemberUseCase) error {
+				return m.UpdateMemberEmail(ctx, 1, "new@example.com", "old")
+			},
+			wantErr: ErrMemberUnexpectedError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockMemberPersistence(ctrl)
+			mockPolicy := mock.NewMockEmailPolicy(ctrl)
+			m := &MemberUseCase{
+				MemberGateway: mockRepo,
+				emailPolicy:   mockPolicy,
+				logger:        mockLogger,
+				tracer:        mockTracer,
+			}
+			tt.policySetup(mockPolicy)
+			tt.repoSetup(mockRepo)
+
+			err := tt.call(m)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,306 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/inputmodel"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/requestmeta"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"strconv"
+	"time"
+)
+
+// CreateInvitation 建立註冊邀請碼
+//   - 狀態管理者可以建立，新會員沒有推薦人
+//   - 會員（actor 為會員 ID）可以建立，新會員的 ReferredBy 指向他；已合併或不是 active 的會員不能建立
+//   - 匿名請求一律拒絕
+func (m *MemberUseCase) CreateInvitation(ctx context.Context, input *inputmodel.CreateInvitationInputModel) (*entity.Invitation, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, m.tracer, m.logger)
+	defer span.End()
+
+	actor := requestmeta.FromContext(transCtx).Actor
+	var referrerID int
+	if _, ok := m.statusAdmins[actor]; !ok {
+		referrer, err := m.invitingMember(transCtx, contextLogger, actor)
+		if err != nil {
+			return nil, err
+		}
+		referrerID = referrer.ID
+	}
+
+	var email string
+	if input.Email != "" {
+		normalized, err := m.emailNormalizer.Normalize(input.Email)
+		if err != nil {
+			contextLogger.Error("邀請碼建立 Email 正規化失敗",
+				logger.NewField("error", err),
+				logger.NewField("member_email", input.Email),
+			)
+			return nil, mapEntityError(err)
+		}
+		email = normalized
+	}
+	maxUses := input.MaxUses
+	if maxUses == 0 {
+		maxUses = 1
+	}
+	now := time.Now().UTC()
+	expiresAt := input.ExpiresAt
+	if expiresAt.IsZero() && m.invitationTTL > 0 {
+		expiresAt = now.Add(m.invitationTTL)
+	}
+	code, err := m.newInvitationCode()
+	if err != nil {
+		contextLogger.Error("邀請碼產生失敗",
+			logger.NewField("error", err),
+		)
+		return nil, err
+	}
+	invitation, err := entity.NewInvitation(code, actor, referrerID, email, maxUses, expiresAt, now)
+	if err != nil {
+		contextLogger.Warn("邀請碼建立資料不符合規則",
+			logger.NewField("error", err),
+			logger.NewField("max_uses", maxUses),
+			logger.NewField("expires_at", expiresAt),
+		)
+		return nil, mapEntityError(err)
+	}
+	if err := m.invitations.Create(transCtx, invitation); err != nil {
+		contextLogger.Error("邀請碼建立 Gateway 創建失敗",
+			logger.NewField("error", err),
+			logger.NewField("actor", actor),
+		)
+		return nil, err
+	}
+	// 與會員註冊相同，透過唯一欄位查詢回傳完整 entity
+	created, err := m.invitations.GetByCode(transCtx, invitation.Code)
+	if err != nil {
+		contextLogger.Error("邀請碼建立後查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("actor", actor),
+		)
+		return nil, err
+	}
+
+	contextLogger.Info("邀請碼建立成功",
+		logger.NewField("invitation_id", created.ID),
+		logger.NewField("actor", actor),
+		logger.NewField("referrer_id", created.ReferrerID),
+		logger.NewField("max_uses", created.MaxUses),
+	)
+	return created, nil
+}
+
+// ListInvitations 狀態管理者可查看全部邀請碼，其他 actor 只能查看自己建立的邀請碼
+func (m *MemberUseCase) ListInvitations(ctx context.Context, pagination pagination.Pagination) ([]*entity.Invitation, int, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, m.tracer, m.logger)
+	defer span.End()
+
+	actor := requestmeta.FromContext(transCtx).Actor
+	_, isAdmin := m.statusAdmins[actor]
+	if !isAdmin && actor == requestmeta.AnonymousActor {
+		contextLogger.Warn("邀請碼列表被拒：匿名請求")
+		return nil, 0, ErrInvitationForbidden
+	}
+	var filter output.InvitationFilter
+	if !isAdmin {
+		filter.CreatedBy = actor
+	}
+	invitations, err := m.invitations.GetAll(transCtx, filter, pagination)
+	if err != nil {
+		contextLogger.Error("邀請碼列表 Gateway 查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("actor", actor),
+		)
+		return nil, 0, err
+	}
+	total, err := m.invitations.CountAll(transCtx, filter)
+	if err != nil {
+		contextLogger.Error("邀請碼總數 Gateway 查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("actor", actor),
+		)
+		return nil, 0, err
+	}
+
+	contextLogger.Debug("邀請碼列表查詢成功",
+		logger.NewField("count", len(invitations)),
+		logger.NewField("total", total),
+	)
+	return invitations, total, nil
+}
+
+// RevokeInvitation 撤銷邀請碼，僅限狀態管理者或建立者；已撤銷時直接回傳，可重複呼叫
+func (m *MemberUseCase) RevokeInvitation(ctx context.Context, id int) (*entity.Invitation, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, m.tracer, m.logger)
+	defer span.End()
+
+	actor := requestmeta.FromContext(transCtx).Actor
+	_, isAdmin := m.statusAdmins[actor]
+	if !isAdmin && actor == requestmeta.AnonymousActor {
+		contextLogger.Warn("邀請碼撤銷被拒：匿名請求",
+			logger.NewField("invitation_id", id),
+		)
+		return nil, ErrInvitationForbidden
+	}
+	invitation, err := m.invitations.GetByID(transCtx, id)
+	if err != nil {
+		contextLogger.Error("邀請碼撤銷查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("invitation_id", id),
+		)
+		return nil, err
+	}
+	if !isAdmin && invitation.CreatedBy != actor {
+		contextLogger.Warn("邀請碼撤銷被拒：呼叫者不是建立者",
+			logger.NewField("invitation_id", id),
+			logger.NewField("actor", actor),
+		)
+		return nil, ErrInvitationForbidden
+	}
+	if invitation.IsRevoked() {
+		return invitation, nil
+	}
+	if err := invitation.Revoke(time.Now().UTC()); err != nil {
+		return nil, mapEntityError(err)
+	}
+	if err := m.invitations.Revoke(transCtx, id, invitation.RevokedAt); err != nil {
+		// 同時有其他請求撤銷時回傳已撤銷的資料
+		if errors.Is(err, ErrMemberNoEffect) {
+			return m.invitations.GetByID(transCtx, id)
+		}
+		contextLogger.Error("邀請碼撤銷 Gateway 執行失敗",
+			logger.NewField("error", err),
+			logger.NewField("invitation_id", id),
+		)
+		return nil, err
+	}
+
+	contextLogger.Info("邀請碼撤銷成功",
+		logger.NewField("invitation_id", id),
+		logger.NewField("actor", actor),
+	)
+	return invitation, nil
+}
+
+// invitingMember 非狀態管理者建立邀請碼時，actor 必須是可以登入的會員
+func (m *MemberUseCase) invitingMember(ctx context.Context, contextLogger logger.Logger, actor string) (*entity.Member, error) {
+	id, err := strconv.Atoi(actor)
+	if err != nil || id < 1 {
+		contextLogger.Warn("邀請碼建立被拒：呼叫者不是會員或狀態管理者",
+			logger.NewField("actor", actor),
+		)
+		return nil, ErrInvitationForbidden
+	}
+	member, err := m.MemberGateway.GetByID(ctx, id)
+	if errors.Is(err, ErrMemberNotFound) {
+		contextLogger.Warn("邀請碼建立被拒：會員不存在",
+			logger.NewField("actor", actor),
+		)
+		return nil, ErrInvitationForbidden
+	}
+	if err != nil {
+		contextLogger.Error("邀請碼建立查詢會員失敗",
+			logger.NewField("error", err),
+			logger.NewField("actor", actor),
+		)
+		return nil, err
+	}
+	if member.IsMerged() || member.CanAuthenticate() != nil {
+		contextLogger.Warn("邀請碼建立被拒：會員已合併或不是 active",
+			logger.NewField("member_id", member.ID),
+			logger.NewField("status", member.Status),
+			logger.NewField("merged_into", member.MergedInto),
+		)
+		return nil, ErrInvitationForbidden
+	}
+	return member, nil
+}
+
+// resolveInvitation 查詢並檢查註冊用的邀請碼，成功時 member.ReferredBy 指向推薦人；沒有帶邀請碼且不是僅限邀請時回傳 nil
+func (m *MemberUseCase) resolveInvitation(ctx context.Context, contextLogger logger.Logger, code string, member *entity.Member) (*entity.Invitation, error) {
+	code = entity.NormalizeInvitationCode(code)
+	if code == "" {
+		if m.inviteOnly {
+			contextLogger.Warn("會員註冊被拒：僅限邀請註冊但未提供邀請碼",
+				logger.NewField("member_email", member.Email),
+			)
+			return nil, ErrInvitationRequired
+		}
+		return nil, nil
+	}
+	// 邀請碼等同密碼，log 不記錄內容
+	invitation, err := m.invitations.GetByCode(ctx, code)
+	if errors.Is(err, ErrInvitationNotFound) {
+		contextLogger.Warn("會員註冊被拒：邀請碼不存在",
+			logger.NewField("member_email", member.Email),
+		)
+		return nil, ErrInvitationInvalid
+	}
+	if err != nil {
+		contextLogger.Error("會員註冊查詢邀請碼失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_email", member.Email),
+		)
+		return nil, err
+	}
+	if err := invitation.Redeem(member, time.Now().UTC()); err != nil {
+		contextLogger.Warn("會員註冊被拒：邀請碼不可使用",
+			logger.NewField("error", err),
+			logger.NewField("invitation_id", invitation.ID),
+			logger.NewField("member_email", member.Email),
+		)
+		return nil, mapEntityError(err)
+	}
+	return invitation, nil
+}
+
+// redeemInvitation 在註冊交易中以條件式更新使用邀請碼，同時有其他請求用掉最後一次時回傳 ErrInvitationInvalid
+func (m *MemberUseCase) redeemInvitation(ctx context.Context, contextLogger logger.Logger, invitation *entity.Invitation) error {
+	err := m.invitations.Redeem(ctx, invitation.ID, invitation.Uses-1)
+	if errors.Is(err, ErrMemberNoEffect) {
+		contextLogger.Warn("會員註冊被拒：邀請碼已被其他請求使用",
+			logger.NewField("invitation_id", invitation.ID),
+		)
+		return ErrInvitationInvalid
+	}
+	if err != nil {
+		contextLogger.Error("會員註冊使用邀請碼失敗",
+			logger.NewField("error", err),
+			logger.NewField("invitation_id", invitation.ID),
+		)
+		return err
+	}
+	return nil
+}
+
+// fillReferralCount 填入透過此會員邀請碼註冊的會員數，已合併到其他會員的也計入
+func (m *MemberUseCase) fillReferralCount(ctx context.Context, contextLogger logger.Logger, member *entity.Member) error {
+	count, err := m.MemberGateway.CountAll(ctx, output.MemberFilter{ReferredBy: member.ID, IncludeMerged: true})
+	if err != nil {
+		contextLogger.Error("會員推薦人數查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", member.ID),
+		)
+		return err
+	}
+	member.ReferralCount = count
+	return nil
+}
+
+// randomInvitationCode 產生 10 bytes 的隨機值，以不含補位的 base32 表示為 16 個大寫字元
+func randomInvitationCode() (string, error) {
+	buf := make([]byte, 10)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(buf), nil
+}
@@ -0,0 +1,376 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/inputmodel"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/mock"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/requestmeta"
+)
+
+func TestMemberUseCase_CreateInvitation(t *testing.T) {
+	ctrl, testTime, mockLogger, mockTracer := privacyHelper(t)
+	member := func(status entity.MemberStatus) *entity.Member {
+		return &entity.Member{ID: 7, Name: "ggg", Email: "gg@gmail.com", Status: status, CreatedAt: testTime}
+	}
+	tests := []struct {
+		name         string
+		actor        string
+		input        *inputmodel.CreateInvitationInputModel
+		repoSetup    func(*mock.MockMemberPersistence)
+		wantErr      error
+		wantReferrer int
+		wantEmail    string
+		wantMaxUses  int
+	}{
+		{
+			name:      "anonymous is forbidden",
+			actor:     requestmeta.AnonymousActor,
+			input:     &inputmodel.CreateInvitationInputModel{},
+			repoSetup: func(r *mock.MockMemberPersistence) {},
+			wantErr:   ErrInvitationForbidden,
+		},
+		{
+			name:  "unknown member is forbidden",
+			actor: "7",
+			input: &inputmodel.CreateInvitationInputModel{},
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(gomock.Any(), 7).Return(nil, ErrMemberNotFound)
+			},
+			wantErr: ErrInvitationForbidden,
+		},
+		{
+			name:  "suspended member is forbidden",
+			actor: "7",
+			input: &inputmodel.CreateInvitationInputModel{},
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(gomock.Any(), 7).Return(member(entity.MemberStatusSuspended), nil)
+			},
+			wantErr: ErrInvitationForbidden,
+		},
+		{
+			name:  "member invitation records referrer",
+			actor: "7",
+			input: &inputmodel.CreateInvitationInputModel{Email: "Friend@Gmail.com"},
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(gomock.Any(), 7).Return(member(entity.MemberStatusActive), nil)
+			},
+			wantReferrer: 7,
+			wantEmail:    "friend@gmail.com",
+			wantMaxUses:  1,
+		},
+		{
+			name:        "admin invitation has no referrer",
+			actor:       "admin",
+			input:       &inputmodel.CreateInvitationInputModel{MaxUses: 5},
+			repoSetup:   func(r *mock.MockMemberPersistence) {},
+			wantMaxUses: 5,
+		},
+		{
+			name:      "expiry in the past is invalid",
+			actor:     "admin",
+			input:     &inputmodel.CreateInvitationInputModel{ExpiresAt: time.Now().Add(-time.Hour)},
+			repoSetup: func(r *mock.MockMemberPersistence) {},
+			wantErr:   ErrInvitationInvalid,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockMemberPersistence(ctrl)
+			mockInvitations := mock.NewMockInvitationPersistence(ctrl)
+			tt.repoSetup(mockRepo)
+			if tt.wantErr == nil {
+				var created *entity.Invitation
+				mockInvitations.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, invitation *entity.Invitation) error {
+					created = invitation
+					return nil
+				})
+				mockInvitations.EXPECT().GetByCode(gomock.Any(), "ABCD2345").DoAndReturn(func(_ context.Context, _ string) (*entity.Invitation, error) {
+					created.ID = 1
+					return created, nil
+				})
+			}
+			m := &MemberUseCase{
+				MemberGateway:     mockRepo,
+				invitations:       mockInvitations,
+				invitationTTL:     time.Hour,
+				emailNormalizer:   entity.NewEmailNormalizer(nil, nil, nil),
+				statusAdmins:      map[string]struct{}{"admin": {}},
+				newInvitationCode: func() (string, error) { return "abcd2345", nil },
+				logger:            mockLogger,
+				tracer:            mockTracer,
+			}
+			ctx := requestmeta.WithMeta(context.Background(), requestmeta.Meta{Actor: tt.actor})
+
+			got, err := m.CreateInvitation(ctx, tt.input)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "ABCD2345", got.Code)
+			assert.Equal(t, tt.actor, got.CreatedBy)
+			assert.Equal(t, tt.wantReferrer, got.ReferrerID)
+			assert.Equal(t, tt.wantEmail, got.Email)
+			assert.Equal(t, tt.wantMaxUses, got.MaxUses)
+			assert.WithinDuration(t, time.Now().Add(time.Hour), got.ExpiresAt, time.Minute)
+		})
+	}
+}
+
+func TestMemberUseCase_RegisterWithInvitation(t *testing.T) {
+	ctrl, testTime, mockLogger, mockTracer := privacyHelper(t)
+	ctx := context.Background()
+	invitation := func() *entity.Invitation {
+		return &entity.Invitation{ID: 3, Code: "ABCD2345", CreatedBy: "7", ReferrerID: 7, MaxUses: 2, Uses: 1, CreatedAt: testTime}
+	}
+	tests := []struct {
+		name            string
+		inviteOnly      bool
+		code            string
+		invitationSetup func(*mock.MockInvitationPersistence)
+		repoSetup       func(*mock.MockMemberPersistence)
+		wantErr         error
+		wantReferredBy  int
+	}{
+		{
+			name:            "invite only requires a code",
+			inviteOnly:      true,
+			invitationSetup: func(i *mock.MockInvitationPersistence) {},
+			repoSetup:       func(r *mock.MockMemberPersistence) {},
+			wantErr:         ErrInvitationRequired,
+		},
+		{
+			name:       "unknown code is invalid",
+			inviteOnly: true,
+			code:       "nope",
+			invitationSetup: func(i *mock.MockInvitationPersistence) {
+				i.EXPECT().GetByCode(ctx, "NOPE").Return(nil, ErrInvitationNotFound)
+			},
+			repoSetup: func(r *mock.MockMemberPersistence) {},
+			wantErr:   ErrInvitationInvalid,
+		},
+		{
+			name:       "exhausted code is invalid",
+			inviteOnly: true,
+			code:       "abcd2345",
+			invitationSetup: func(i *mock.MockInvitationPersistence) {
+				exhausted := invitation()
+				exhausted.Uses = exhausted.MaxUses
+				i.EXPECT().GetByCode(ctx, "ABCD2345").Return(exhausted, nil)
+			},
+			repoSetup: func(r *mock.MockMemberPersistence) {},
+			wantErr:   ErrInvitationInvalid,
+		},
+		{
+			name:       "code locked to another email is invalid",
+			inviteOnly: true,
+			code:       "abcd2345",
+			invitationSetup: func(i *mock.MockInvitationPersistence) {
+				locked := invitation()
+				locked.Email = "other@gmail.com"
+				i.EXPECT().GetByCode(ctx, "ABCD2345").Return(locked, nil)
+			},
+			repoSetup: func(r *mock.MockMemberPersistence) {},
+			wantErr:   ErrInvitationInvalid,
+		},
+		{
+			name:       "code used up concurrently is invalid",
+			inviteOnly: true,
+			code:       "abcd2345",
+			invitationSetup: func(i *mock.MockInvitationPersistence) {
+				i.EXPECT().GetByCode(ctx, "ABCD2345").Return(invitation(), nil)
+				i.EXPECT().Redeem(ctx, 3, 1).Return(ErrMemberNoEffect)
+			},
+			repoSetup: func(r *mock.MockMemberPersistence) {},
+			wantErr:   ErrInvitationInvalid,
+		},
+		{
+			name:       "valid code is redeemed and records referrer",
+			inviteOnly: true,
+			code:       " abcd2345 ",
+			invitationSetup: func(i *mock.MockInvitationPersistence) {
+				i.EXPECT().GetByCode(ctx, "ABCD2345").Return(invitation(), nil)
+				i.EXPECT().Redeem(ctx, 3, 1).Return(nil)
+			},
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, m *entity.Member) error {
+					assert.Equal(t, 7, m.ReferredBy)
+					return nil
+				})
+				r.EXPECT().GetByEmail(ctx, "gg@gmail.com").Return(&entity.Member{ID: 9, Name: "ggg", Email: "gg@gmail.com", ReferredBy: 7, CreatedAt: testTime}, nil)
+			},
+			wantReferredBy: 7,
+		},
+		{
+			name:            "open mode registers without a code",
+			invitationSetup: func(i *mock.MockInvitationPersistence) {},
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				r.EXPECT().GetByEmail(ctx, "gg@gmail.com").Return(&entity.Member{ID: 9, Name: "ggg", Email: "gg@gmail.com", CreatedAt: testTime}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockMemberPersistence(ctrl)
+			mockInvitations := mock.NewMockInvitationPersistence(ctrl)
+			tt.repoSetup(mockRepo)
+			tt.invitationSetup(mockInvitations)
+			m := &MemberUseCase{
+				MemberGateway:   mockRepo,
+				invitations:     mockInvitations,
+				inviteOnly:      tt.inviteOnly,
+				emailNormalizer: entity.NewEmailNormalizer(nil, nil, nil),
+				logger:          mockLogger,
+				tracer:          mockTracer,
+			}
+
+			got, err := m.RegisterMember(ctx, &entity.Member{Name: "ggg", Email: "gg@gmail.com", Password: "horse-battery-9"}, tt.code)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantReferredBy, got.ReferredBy)
+		})
+	}
+}
+
+func TestMemberUseCase_ListInvitations(t *testing.T) {
+	ctrl, testTime, mockLogger, mockTracer := privacyHelper(t)
+	page := pagination.Pagination{Limit: 10}
+	invitations := []*entity.Invitation{{ID: 1, Code: "ABCD2345", CreatedBy: "7", MaxUses: 1, CreatedAt: testTime}}
+	tests := []struct {
+		name       string
+		actor      string
+		wantFilter *output.InvitationFilter
+		wantErr    error
+	}{
+		{
+			name:    "anonymous is forbidden",
+			actor:   requestmeta.AnonymousActor,
+			wantErr: ErrInvitationForbidden,
+		},
+		{
+			name:       "member sees own invitations",
+			actor:      "7",
+			wantFilter: &output.InvitationFilter{CreatedBy: "7"},
+		},
+		{
+			name:       "admin sees all invitations",
+			actor:      "admin",
+			wantFilter: &output.InvitationFilter{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockInvitations := mock.NewMockInvitationPersistence(ctrl)
+			if tt.wantFilter != nil {
+				mockInvitations.EXPECT().GetAll(gomock.Any(), *tt.wantFilter, page).Return(invitations, nil)
+				mockInvitations.EXPECT().CountAll(gomock.Any(), *tt.wantFilter).Return(1, nil)
+			}
+			m := &MemberUseCase{
+				invitations:  mockInvitations,
+				statusAdmins: map[string]struct{}{"admin": {}},
+				logger:       mockLogger,
+				tracer:       mockTracer,
+			}
+			ctx := requestmeta.WithMeta(context.Background(), requestmeta.Meta{Actor: tt.actor})
+
+			got, total, err := m.ListInvitations(ctx, page)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, invitations, got)
+			assert.Equal(t, 1, total)
+		})
+	}
+}
+
+func TestMemberUseCase_RevokeInvitation(t *testing.T) {
+	ctrl, testTime, mockLogger, mockTracer := privacyHelper(t)
+	invitation := func() *entity.Invitation {
+		return &entity.Invitation{ID: 1, Code: "ABCD2345", CreatedBy: "7", MaxUses: 1, CreatedAt: testTime}
+	}
+	tests := []struct {
+		name            string
+		actor           string
+		invitationSetup func(*mock.MockInvitationPersistence)
+		wantErr         error
+	}{
+		{
+			name:            "anonymous is forbidden",
+			actor:           requestmeta.AnonymousActor,
+			invitationSetup: func(i *mock.MockInvitationPersistence) {},
+			wantErr:         ErrInvitationForbidden,
+		},
+		{
+			name:  "other member is forbidden",
+			actor: "8",
+			invitationSetup: func(i *mock.MockInvitationPersistence) {
+				i.EXPECT().GetByID(gomock.Any(), 1).Return(invitation(), nil)
+			},
+			wantErr: ErrInvitationForbidden,
+		},
+		{
+			name:  "not found",
+			actor: "admin",
+			invitationSetup: func(i *mock.MockInvitationPersistence) {
+				i.EXPECT().GetByID(gomock.Any(), 1).Return(nil, ErrInvitationNotFound)
+			},
+			wantErr: ErrInvitationNotFound,
+		},
+		{
+			name:  "creator revokes",
+			actor: "7",
+			invitationSetup: func(i *mock.MockInvitationPersistence) {
+				i.EXPECT().GetByID(gomock.Any(), 1).Return(invitation(), nil)
+				i.EXPECT().Revoke(gomock.Any(), 1, gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:  "already revoked is returned as is",
+			actor: "admin",
+			invitationSetup: func(i *mock.MockInvitationPersistence) {
+				revoked := invitation()
+				revoked.RevokedAt = testTime
+				i.EXPECT().GetByID(gomock.Any(), 1).Return(revoked, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockInvitations := mock.NewMockInvitationPersistence(ctrl)
+			tt.invitationSetup(mockInvitations)
+			m := &MemberUseCase{
+				invitations:  mockInvitations,
+				statusAdmins: map[string]struct{}{"admin": {}},
+				logger:       mockLogger,
+				tracer:       mockTracer,
+			}
+			ctx := requestmeta.WithMeta(context.Background(), requestmeta.Meta{Actor: tt.actor})
+
+			got, err := m.RevokeInvitation(ctx, 1)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, got.IsRevoked())
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package usecase
+
+import (
+	"context"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/inputmodel"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/requestmeta"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"time"
+)
+
+// MergeMembers 將重複帳號（SourceID）合併到保留的會員（TargetID）
+//   - 來源會員不刪除，改為 merged_into 指向目標的 tombstone，以 ID 查詢時回傳 MemberMergedError 供導向
+//   - 先前已合併到來源的會員一併改指向目標，避免形成合併鏈
+//   - 稽核紀錄只能新增不能改寫，來源會員的歷史透過 merged_into 追溯，結果只回報筆數
+//   - 來源會員的標籤複製到目標，目標已有的標籤不重複加上
+//   - Preview 時只回報會搬移的資料，不寫入任何資料
+func (m *MemberUseCase) MergeMembers(ctx context.Context, input *inputmodel.MergeMembersInputModel) (*output.MergeResult, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, m.tracer, m.logger)
+	defer span.End()
+
+	actor := requestmeta.FromContext(transCtx).Actor
+	if _, ok := m.statusAdmins[actor]; !ok {
+		contextLogger.Warn("會員合併被拒：呼叫者不是狀態管理者",
+			logger.NewField("source_id", input.SourceID),
+			logger.NewField("target_id", input.TargetID),
+			logger.NewField("actor", actor),
+		)
+		return nil, ErrMemberMergeForbidden
+	}
+	source, err := m.MemberGateway.GetByID(transCtx, input.SourceID)
+	if err != nil {
+		contextLogger.Error("會員合併查詢來源會員失敗",
+			logger.NewField("error", err),
+			logger.NewField("source_id", input.SourceID),
+		)
+		return nil, err
+	}
+	target, err := m.MemberGateway.GetByID(transCtx, input.TargetID)
+	if err != nil {
+		contextLogger.Error("會員合併查詢目標會員失敗",
+			logger.NewField("error", err),
+			logger.NewField("target_id", input.TargetID),
+		)
+		return nil, err
+	}
+	before := *source
+	if err := source.MergeInto(target); err != nil {
+		contextLogger.Warn("會員合併不符合條件",
+			logger.NewField("error", err),
+			logger.NewField("source_id", input.SourceID),
+			logger.NewField("target_id", input.TargetID),
+			logger.NewField("merged_into", before.MergedInto),
+		)
+		return nil, mapEntityError(err)
+	}
+
+	result := &output.MergeResult{Source: source, Target: target, Preview: input.Preview}
+	if m.auditTrail != nil {
+		records, err := m.auditTrail.ListByMember(transCtx, source.ID)
+		if err != nil {
+			contextLogger.Error("會員合併查詢稽核紀錄失敗",
+				logger.NewField("error", err),
+				logger.NewField("source_id", source.ID),
+			)
+			return nil, err
+		}
+		result.AuditRecords = len(records)
+	}
+	if input.Preview {
+		redirected, err := m.MemberGateway.CountAll(transCtx, output.MemberFilter{MergedInto: source.ID, IncludeMerged: true})
+		if err != nil {
+			contextLogger.Error("會員合併預覽計算改指向會員數失敗",
+				logger.NewField("error", err),
+				logger.NewField("source_id", source.ID),
+			)
+			return nil, err
+		}
+		result.RedirectedMembers = redirected
+		carried, err := m.carriedTags(transCtx, source.ID, target.ID)
+		if err != nil {
+			contextLogger.Error("會員合併預覽計算標籤失敗",
+				logger.NewField("error", err),
+				logger.NewField("source_id", source.ID),
+				logger.NewField("target_id", target.ID),
+			)
+			return nil, err
+		}
+		result.CarriedTags = carried
+		contextLogger.Info("會員合併預覽",
+			logger.NewField("source_id", source.ID),
+			logger.NewField("target_id", target.ID),
+			logger.NewField("actor", actor),
+			logger.NewField("audit_records", result.AuditRecords),
+			logger.NewField("redirected_members", result.RedirectedMembers),
+			logger.NewField("carried_tags", result.CarriedTags),
+		)
+		return result, nil
+	}
+
+	result.MergedAt = time.Now().UTC()
+	// 標記 tombstone、改指向舊的合併紀錄、複製標籤與 MemberMerged 事件寫在同一個交易
+	err = m.withinTransaction(transCtx, func(txCtx context.Context) error {
+		redirected, err := m.MemberGateway.MarkMerged(txCtx, source.ID, target.ID)
+		if err != nil {
+			contextLogger.Error("會員合併 Gateway 執行失敗",
+				logger.NewField("error", err),
+				logger.NewField("source_id", source.ID),
+				logger.NewField("target_id", target.ID),
+			)
+			return err
+		}
+		result.RedirectedMembers = redirected
+		carried, err := m.MemberGateway.MergeTags(txCtx, source.ID, target.ID)
+		if err != nil {
+			contextLogger.Error("會員合併複製標籤失敗",
+				logger.NewField("error", err),
+				logger.NewField("source_id", source.ID),
+				logger.NewField("target_id", target.ID),
+			)
+			return err
+		}
+		result.CarriedTags = carried
+		return m.addEvents(txCtx, contextLogger, entity.NewMemberMerged(source, actor, result.MergedAt))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	m.recordAudit(transCtx, contextLogger, output.AuditActionMemberMerged, source.ID, &before, source)
+	m.notifyChange(transCtx, output.ChangeTypeDeleted, source)
+
+	contextLogger.Info("會員合併成功",
+		logger.NewField("source_id", source.ID),
+		logger.NewField("target_id", target.ID),
+		logger.NewField("actor", actor),
+		logger.NewField("audit_records", result.AuditRecords),
+		logger.NewField("redirected_members", result.RedirectedMembers),
+		logger.NewField("carried_tags", result.CarriedTags),
+	)
+	return result, nil
+}
+
+// carriedTags 預覽合併時計算來源會員有、目標會員沒有的標籤數
+func (m *MemberUseCase) carriedTags(ctx context.Context, sourceID, targetID int) (int, error) {
+	sourceTags, err := m.MemberGateway.ListTags(ctx, sourceID)
+	if err != nil {
+		return 0, err
+	}
+	targetTags, err := m.MemberGateway.ListTags(ctx, targetID)
+	if err != nil {
+		return 0, err
+	}
+	existing := make(map[string]struct{}, len(targetTags))
+	for _, tag := range targetTags {
+		existing[tag] = struct{}{}
+	}
+	carried := 0
+	for _, tag := range sourceTags {
+		if _, ok := existing[tag]; !ok {
+			carried++
+		}
+	}
+	return carried, nil
+}
@@ -0,0 +1,204 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/inputmodel"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/mock"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/requestmeta"
+)
+
+func TestMemberUseCase_MergeMembers(t *testing.T) {
+	ctrl, testTime, mockLogger, mockTracer := privacyHelper(t)
+	member := func(id int) *entity.Member {
+		return &entity.Member{ID: id, Name: "ggg", Email: "gg@gmail.com", Status: entity.MemberStatusActive, CreatedAt: testTime}
+	}
+	auditRecords := []output.AuditRecord{{ID: 1, Action: "member.registered"}, {ID: 2, Action: "member.updated"}}
+	tests := []struct {
+		name           string
+		actor          string
+		input          *inputmodel.MergeMembersInputModel
+		repoSetup      func(*mock.MockMemberPersistence)
+		wantRedirected int
+		wantCarried    int
+		wantErr        error
+	}{
+		{
+			name:      "non admin is forbidden",
+			actor:     "1",
+			input:     &inputmodel.MergeMembersInputModel{SourceID: 1, TargetID: 2},
+			repoSetup: func(r *mock.MockMemberPersistence) {},
+			wantErr:   ErrMemberMergeForbidden,
+		},
+		{
+			name:  "cannot merge into itself",
+			actor: "admin",
+			input: &inputmodel.MergeMembersInputModel{SourceID: 1, TargetID: 1},
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(member(1), nil).Times(2)
+			},
+			wantErr: ErrMemberInvalidMerge,
+		},
+		{
+			name:  "target already merged",
+			actor: "admin",
+			input: &inputmodel.MergeMembersInputModel{SourceID: 1, TargetID: 2},
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				merged := member(2)
+				merged.MergedInto = 3
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(member(1), nil)
+				r.EXPECT().GetByID(gomock.Any(), 2).Return(merged, nil)
+			},
+			wantErr: ErrMemberMerged,
+		},
+		{
+			name:  "target not found",
+			actor: "admin",
+			input: &inputmodel.MergeMembersInputModel{SourceID: 1, TargetID: 2},
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(member(1), nil)
+				r.EXPECT().GetByID(gomock.Any(), 2).Return(nil, ErrMemberNotFound)
+			},
+			wantErr: ErrMemberNotFound,
+		},
+		{
+			name:  "preview reports without writing",
+			actor: "admin",
+			input: &inputmodel.MergeMembersInputModel{SourceID: 1, TargetID: 2, Preview: true},
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(member(1), nil)
+				r.EXPECT().GetByID(gomock.Any(), 2).Return(member(2), nil)
+				r.EXPECT().CountAll(gomock.Any(), output.MemberFilter{MergedInto: 1, IncludeMerged: true}).Return(3, nil)
+				r.EXPECT().ListTags(gomock.Any(), 1).Return([]string{"beta", "vip"}, nil)
+				r.EXPECT().ListTags(gomock.Any(), 2).Return([]string{"vip"}, nil)
+			},
+			wantRedirected: 3,
+			wantCarried:    1,
+		},
+		{
+			name:  "source merged concurrently",
+			actor: "admin",
+			input: &inputmodel.MergeMembersInputModel{SourceID: 1, TargetID: 2},
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(member(1), nil)
+				r.EXPECT().GetByID(gomock.Any(), 2).Return(member(2), nil)
+				r.EXPECT().MarkMerged(gomock.Any(), 1, 2).Return(0, ErrMemberNoEffect)
+			},
+			wantErr: ErrMemberNoEffect,
+		},
+		{
+			name:  "merge marks source and adds event",
+			actor: "admin",
+			input: &inputmodel.MergeMembersInputModel{SourceID: 1, TargetID: 2},
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(member(1), nil)
+				r.EXPECT().GetByID(gomock.Any(), 2).Return(member(2), nil)
+				r.EXPECT().MarkMerged(gomock.Any(), 1, 2).Return(1, nil)
+				r.EXPECT().MergeTags(gomock.Any(), 1, 2).Return(2, nil)
+			},
+			wantRedirected: 1,
+			wantCarried:    2,
+		},
+		{
+			name:  "merge tags failure aborts merge",
+			actor: "admin",
+			input: &inputmodel.MergeMembersInputModel{SourceID: 1, TargetID: 2},
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(member(1), nil)
+				r.EXPECT().GetByID(gomock.Any(), 2).Return(member(2), nil)
+				r.EXPECT().MarkMerged(gomock.Any(), 1, 2).Return(1, nil)
+				r.EXPECT().MergeTags(gomock.Any(), 1, 2).Return(0, ErrMemberDBError)
+			},
+			wantErr: ErrMemberDBError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockMemberPersistence(ctrl)
+			mockOutbox := mock.NewMockEventOutbox(ctrl)
+			mockAudit := mock.NewMockAuditTrail(ctrl)
+			mockFeed := mock.NewMockChangeFeed(ctrl)
+			tt.repoSetup(mockRepo)
+			if tt.wantErr == nil || tt.wantErr == ErrMemberNoEffect || tt.wantErr == ErrMemberDBError {
+				mockAudit.EXPECT().ListByMember(gomock.Any(), 1).Return(auditRecords, nil)
+			}
+			if tt.wantErr == nil && !tt.input.Preview {
+				mockOutbox.EXPECT().Add(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, events ...entity.DomainEvent) error {
+					assert.Len(t, events, 1)
+					event, ok := events[0].(entity.MemberMerged)
+					assert.True(t, ok)
+					assert.Equal(t, 1, event.MemberID)
+					assert.Equal(t, 2, event.TargetID)
+					assert.Equal(t, "admin", event.Actor)
+					return nil
+				})
+				mockAudit.EXPECT().Record(gomock.Any(), output.AuditActionMemberMerged, 1, gomock.Any(), gomock.Any()).Return(nil)
+				mockFeed.EXPECT().Publish(gomock.Any(), gomock.Any()).Do(func(_ context.Context, change output.MemberChange) {
+					assert.Equal(t, output.ChangeTypeDeleted, change.Type)
+					assert.Equal(t, 2, change.Member.MergedInto)
+				})
+			}
+			m := &MemberUseCase{
+				MemberGateway: mockRepo,
+				eventOutbox:   mockOutbox,
+				auditTrail:    mockAudit,
+				changeFeed:    mockFeed,
+				statusAdmins:  map[string]struct{}{"admin": {}},
+				logger:        mockLogger,
+				tracer:        mockTracer,
+			}
+			ctx := requestmeta.WithMeta(context.Background(), requestmeta.Meta{Actor: tt.actor})
+
+			got, err := m.MergeMembers(ctx, tt.input)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.input.Preview, got.Preview)
+			assert.Equal(t, 2, got.Source.MergedInto)
+			assert.Equal(t, len(auditRecords), got.AuditRecords)
+			assert.Equal(t, tt.wantRedirected, got.RedirectedMembers)
+			assert.Equal(t, tt.wantCarried, got.CarriedTags)
+			assert.Equal(t, tt.input.Preview, got.MergedAt.IsZero())
+		})
+	}
+}
+
+func TestMemberUseCase_GetMergedMember(t *testing.T) {
+	ctrl, ctx, testTime, mockLogger, mockTracer := repoHelper(t)
+	source := &entity.Member{ID: 1, Name: "ggg", Email: "gg@gmail.com", Status: entity.MemberStatusActive, MergedInto: 2, CreatedAt: testTime}
+	target := &entity.Member{ID: 2, Name: "ggg", Email: "gg2@gmail.com", Status: entity.MemberStatusActive, CreatedAt: testTime}
+
+	t.Run("get by id returns redirect target", func(t *testing.T) {
+		mockRepo := mock.NewMockMemberPersistence(ctrl)
+		mockRepo.EXPECT().GetByID(ctx, 1).Return(source, nil)
+		m := &MemberUseCase{MemberGateway: mockRepo, logger: mockLogger, tracer: mockTracer}
+
+		got, err := m.GetMemberByID(ctx, 1)
+		assert.Nil(t, got)
+		assert.ErrorIs(t, err, ErrMemberMerged)
+		var merged *MemberMergedError
+		assert.True(t, errors.As(err, &merged))
+		assert.Equal(t, 2, merged.TargetID)
+	})
+	t.Run("get by email resolves to target", func(t *testing.T) {
+		mockRepo := mock.NewMockMemberPersistence(ctrl)
+		mockRepo.EXPECT().GetByEmail(ctx, "gg@gmail.com").Return(source, nil)
+		mockRepo.EXPECT().GetByID(ctx, 2).Return(target, nil)
+		mockRepo.EXPECT().CountAll(ctx, output.MemberFilter{ReferredBy: 2, IncludeMerged: true}).Return(0, nil)
+		mockRepo.EXPECT().ListTags(ctx, 2).Return(nil, nil)
+		m := &MemberUseCase{MemberGateway: mockRepo, emailNormalizer: entity.NewEmailNormalizer(nil, nil, nil), logger: mockLogger, tracer: mockTracer}
+
+		got, err := m.GetMemberByEmail(ctx, "gg@gmail.com")
+		assert.NoError(t, err)
+		assert.Equal(t, target, got)
+	})
+}
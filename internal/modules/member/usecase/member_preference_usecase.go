@@ -0,0 +1,159 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/inputmodel"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"sort"
+	"time"
+)
+
+// GetMemberPreferences 回傳會員完整的偏好設定，未設定的 key 為預設值；只有會員本人或個資管理者可以查看
+func (m *MemberUseCase) GetMemberPreferences(ctx context.Context, id int) (*entity.MemberPreferences, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, m.tracer, m.logger)
+	defer span.End()
+
+	if err := m.preferenceMember(transCtx, contextLogger, id); err != nil {
+		return nil, err
+	}
+	prefs, err := m.loadPreferences(transCtx, contextLogger, id)
+	if err != nil {
+		return nil, err
+	}
+
+	contextLogger.Debug("會員偏好設定查詢成功",
+		logger.NewField("member_id", id),
+		logger.NewField("stored", len(prefs.Versions)),
+	)
+	return prefs, nil
+}
+
+// UpdateMemberPreferences 以 JSON merge patch 更新偏好設定，所有變更寫在同一個交易；
+// 值沒有變更的 key 不增加版本，回到預設值的 key 會刪除已儲存的值
+func (m *MemberUseCase) UpdateMemberPreferences(ctx context.Context, input *inputmodel.PatchMemberPreferencesInputModel) (*entity.MemberPreferences, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, m.tracer, m.logger)
+	defer span.End()
+
+	patch, err := m.preferenceRegistry.NewPatch(input.Changes)
+	if err != nil {
+		contextLogger.Warn("會員偏好設定不符合 schema",
+			logger.NewField("error", err),
+			logger.NewField("member_id", input.ID),
+		)
+		return nil, mapEntityError(err)
+	}
+	if err := m.preferenceMember(transCtx, contextLogger, input.ID); err != nil {
+		return nil, err
+	}
+
+	changed := make([]string, 0, len(patch.Set)+len(patch.Reset))
+	if !patch.IsEmpty() {
+		now := time.Now().UTC()
+		err = m.withinTransaction(transCtx, func(txCtx context.Context) error {
+			keys := make([]string, 0, len(patch.Set))
+			for key := range patch.Set {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				err := m.preferences.Save(txCtx, input.ID, entity.Preference{Key: key, Value: patch.Set[key], UpdatedAt: now})
+				if errors.Is(err, ErrMemberNoEffect) {
+					continue
+				}
+				if err != nil {
+					contextLogger.Error("會員偏好設定寫入失敗",
+						logger.NewField("error", err),
+						logger.NewField("member_id", input.ID),
+						logger.NewField("key", key),
+					)
+					return err
+				}
+				changed = append(changed, key)
+			}
+			for _, key := range patch.Reset {
+				err := m.preferences.Delete(txCtx, input.ID, key)
+				if errors.Is(err, ErrMemberNoEffect) {
+					continue
+				}
+				if err != nil {
+					contextLogger.Error("會員偏好設定重設失敗",
+						logger.NewField("error", err),
+						logger.NewField("member_id", input.ID),
+						logger.NewField("key", key),
+					)
+					return err
+				}
+				changed = append(changed, key)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	prefs, err := m.loadPreferences(transCtx, contextLogger, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	contextLogger.Info("會員偏好設定更新成功",
+		logger.NewField("member_id", input.ID),
+		logger.NewField("changed", changed),
+	)
+	return prefs, nil
+}
+
+// preferenceMember 檢查權限與會員是否存在；已合併的會員回傳 MemberMergedError
+func (m *MemberUseCase) preferenceMember(ctx context.Context, contextLogger logger.Logger, id int) error {
+	if err := m.authorizePersonalData(ctx, contextLogger, id); err != nil {
+		return err
+	}
+	member, err := m.MemberGateway.GetByID(ctx, id)
+	if err != nil {
+		contextLogger.Error("會員偏好設定查詢會員失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+		)
+		return err
+	}
+	if member.IsMerged() {
+		contextLogger.Warn("會員偏好設定被拒：會員已合併",
+			logger.NewField("member_id", id),
+			logger.NewField("merged_into", member.MergedInto),
+		)
+		return &MemberMergedError{TargetID: member.MergedInto}
+	}
+	return nil
+}
+
+func (m *MemberUseCase) loadPreferences(ctx context.Context, contextLogger logger.Logger, id int) (*entity.MemberPreferences, error) {
+	stored, err := m.preferences.GetByMember(ctx, id)
+	if err != nil {
+		contextLogger.Error("會員偏好設定讀取失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+		)
+		return nil, err
+	}
+	return m.preferenceRegistry.Resolve(id, stored), nil
+}
+
+// fillPreferences 填入會員偏好設定供呈現時格式化；只影響顯示，讀取失敗時記錄後使用預設值
+func (m *MemberUseCase) fillPreferences(ctx context.Context, contextLogger logger.Logger, member *entity.Member) {
+	if m.preferences == nil || m.preferenceRegistry == nil {
+		return
+	}
+	stored, err := m.preferences.GetByMember(ctx, member.ID)
+	if err != nil {
+		contextLogger.Warn("會員偏好設定讀取失敗，使用預設值",
+			logger.NewField("error", err),
+			logger.NewField("member_id", member.ID),
+		)
+		stored = nil
+	}
+	member.Preferences = m.preferenceRegistry.Resolve(member.ID, stored)
+}
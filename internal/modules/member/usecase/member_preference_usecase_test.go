@@ -0,0 +1,222 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/inputmodel"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/mock"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/requestmeta"
+)
+
+func TestMemberUseCase_UpdateMemberPreferences(t *testing.T) {
+	ctrl, testTime, mockLogger, mockTracer := privacyHelper(t)
+	member := func() *entity.Member {
+		return &entity.Member{ID: 1, Name: "ggg", Email: "gg@gmail.com", Status: entity.MemberStatusActive, CreatedAt: testTime}
+	}
+	tests := []struct {
+		name       string
+		actor      string
+		changes    map[string]any
+		repoSetup  func(*mock.MockMemberPersistence)
+		prefsSetup func(*mock.MockPreferencePersistence)
+		wantValues map[string]any
+		wantErr    error
+	}{
+		{
+			name:       "other member is forbidden",
+			actor:      "2",
+			changes:    map[string]any{"locale": "zh-TW"},
+			repoSetup:  func(r *mock.MockMemberPersistence) {},
+			prefsSetup: func(p *mock.MockPreferencePersistence) {},
+			wantErr:    ErrMemberPrivacyForbidden,
+		},
+		{
+			name:       "unknown key",
+			actor:      "1",
+			changes:    map[string]any{"theme": "dark"},
+			repoSetup:  func(r *mock.MockMemberPersistence) {},
+			prefsSetup: func(p *mock.MockPreferencePersistence) {},
+			wantErr:    ErrMemberInvalidPreference,
+		},
+		{
+			name:       "wrong value type",
+			actor:      "1",
+			changes:    map[string]any{"marketing_opt_in": "yes"},
+			repoSetup:  func(r *mock.MockMemberPersistence) {},
+			prefsSetup: func(p *mock.MockPreferencePersistence) {},
+			wantErr:    ErrMemberInvalidPreference,
+		},
+		{
+			name:       "invalid time zone",
+			actor:      "1",
+			changes:    map[string]any{"time_zone": "Mars/Olympus"},
+			repoSetup:  func(r *mock.MockMemberPersistence) {},
+			prefsSetup: func(p *mock.MockPreferencePersistence) {},
+			wantErr:    ErrMemberInvalidPreference,
+		},
+		{
+			name:    "merged member returns redirect",
+			actor:   "1",
+			changes: map[string]any{"locale": "zh-TW"},
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				merged := member()
+				merged.MergedInto = 2
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(merged, nil)
+			},
+			prefsSetup: func(p *mock.MockPreferencePersistence) {},
+			wantErr:    ErrMemberMerged,
+		},
+		{
+			name:    "set and reset in one patch",
+			actor:   "1",
+			changes: map[string]any{"locale": "zh-tw", "notification_channels": []any{"sms", "email", "sms"}, "marketing_opt_in": nil},
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(member(), nil)
+			},
+			prefsSetup: func(p *mock.MockPreferencePersistence) {
+				gomock.InOrder(
+					p.EXPECT().Save(gomock.Any(), 1, gomock.Any()).DoAndReturn(func(_ context.Context, _ int, pref entity.Preference) error {
+						assert.Equal(t, "locale", pref.Key)
+						assert.Equal(t, "zh-TW", pref.Value)
+						return nil
+					}),
+					p.EXPECT().Save(gomock.Any(), 1, gomock.Any()).DoAndReturn(func(_ context.Context, _ int, pref entity.Preference) error {
+						assert.Equal(t, "notification_channels", pref.Key)
+						assert.Equal(t, []string{"email", "sms"}, pref.Value)
+						return nil
+					}),
+					p.EXPECT().Delete(gomock.Any(), 1, "marketing_opt_in").Return(nil),
+					p.EXPECT().GetByMember(gomock.Any(), 1).Return([]entity.Preference{
+						{Key: "locale", Value: "zh-TW", Version: 2},
+						{Key: "notification_channels", Value: []any{"email", "sms"}, Version: 1},
+					}, nil),
+				)
+			},
+			wantValues: map[string]any{"locale": "zh-TW", "time_zone": "UTC", "marketing_opt_in": false, "notification_channels": []string{"email", "sms"}},
+		},
+		{
+			name:    "unchanged values are not an error",
+			actor:   "1",
+			changes: map[string]any{"marketing_opt_in": true, "time_zone": nil},
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(member(), nil)
+			},
+			prefsSetup: func(p *mock.MockPreferencePersistence) {
+				p.EXPECT().Save(gomock.Any(), 1, gomock.Any()).Return(ErrMemberNoEffect)
+				p.EXPECT().Delete(gomock.Any(), 1, "time_zone").Return(ErrMemberNoEffect)
+				p.EXPECT().GetByMember(gomock.Any(), 1).Return([]entity.Preference{{Key: "marketing_opt_in", Value: true, Version: 3}}, nil)
+			},
+			wantValues: map[string]any{"locale": "en", "time_zone": "UTC", "marketing_opt_in": true, "notification_channels": []string{"email"}},
+		},
+		{
+			name:    "privacy officer can update",
+			actor:   "dpo",
+			changes: map[string]any{},
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(member(), nil)
+			},
+			prefsSetup: func(p *mock.MockPreferencePersistence) {
+				p.EXPECT().GetByMember(gomock.Any(), 1).Return(nil, nil)
+			},
+			wantValues: map[string]any{"locale": "en", "time_zone": "UTC", "marketing_opt_in": false, "notification_channels": []string{"email"}},
+		},
+		{
+			name:    "save db error",
+			actor:   "1",
+			changes: map[string]any{"locale": "ja"},
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(member(), nil)
+			},
+			prefsSetup: func(p *mock.MockPreferencePersistence) {
+				p.EXPECT().Save(gomock.Any(), 1, gomock.Any()).Return(ErrMemberDBError)
+			},
+			wantErr: ErrMemberDBError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockMemberPersistence(ctrl)
+			mockPrefs := mock.NewMockPreferencePersistence(ctrl)
+			tt.repoSetup(mockRepo)
+			tt.prefsSetup(mockPrefs)
+			registry, err := entity.NewDefaultPreferenceRegistry("en", "UTC")
+			assert.NoError(t, err)
+			m := &MemberUseCase{
+				MemberGateway:      mockRepo,
+				preferences:        mockPrefs,
+				preferenceRegistry: registry,
+				privacyOfficers:    map[string]struct{}{"dpo": {}},
+				logger:             mockLogger,
+				tracer:             mockTracer,
+			}
+			ctx := requestmeta.WithMeta(context.Background(), requestmeta.Meta{Actor: tt.actor})
+
+			got, err := m.UpdateMemberPreferences(ctx, &inputmodel.PatchMemberPreferencesInputModel{ID: 1, Changes: tt.changes})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantValues, got.Values)
+		})
+	}
+}
+
+func TestMemberUseCase_GetMemberByID_Preferences(t *testing.T) {
+	ctrl, testTime, mockLogger, mockTracer := privacyHelper(t)
+	registry, err := entity.NewDefaultPreferenceRegistry("en", "UTC")
+	assert.NoError(t, err)
+	tests := []struct {
+		name       string
+		stored     []entity.Preference
+		storedErr  error
+		wantLocale string
+		wantZone   string
+	}{
+		{
+			name:       "stored preferences are used",
+			stored:     []entity.Preference{{Key: "locale", Value: "zh-TW", Version: 1}, {Key: "time_zone", Value: "Asia/Taipei", Version: 1}},
+			wantLocale: "zh-TW",
+			wantZone:   "Asia/Taipei",
+		},
+		{
+			name:       "stale stored value falls back to default",
+			stored:     []entity.Preference{{Key: "time_zone", Value: "Mars/Olympus", Version: 1}},
+			wantLocale: "en",
+			wantZone:   "UTC",
+		},
+		{
+			name:       "read error falls back to defaults",
+			storedErr:  ErrMemberDBError,
+			wantLocale: "en",
+			wantZone:   "UTC",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockMemberPersistence(ctrl)
+			mockPrefs := mock.NewMockPreferencePersistence(ctrl)
+			mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&entity.Member{ID: 1, Name: "ggg", Email: "gg@gmail.com", CreatedAt: testTime}, nil)
+			mockRepo.EXPECT().CountAll(gomock.Any(), gomock.Any()).Return(0, nil)
+			mockRepo.EXPECT().ListTags(gomock.Any(), 1).Return(nil, nil)
+			mockPrefs.EXPECT().GetByMember(gomock.Any(), 1).Return(tt.stored, tt.storedErr)
+			m := &MemberUseCase{
+				MemberGateway:      mockRepo,
+				preferences:        mockPrefs,
+				preferenceRegistry: registry,
+				logger:             mockLogger,
+				tracer:             mockTracer,
+			}
+
+			got, err := m.GetMemberByID(context.Background(), 1)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantLocale, got.Preferences.Locale())
+			assert.Equal(t, tt.wantZone, got.Preferences.Location().String())
+		})
+	}
+}
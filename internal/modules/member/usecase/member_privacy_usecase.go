@@ -0,0 +1,207 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/requestmeta"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"strconv"
+	"time"
+)
+
+func (m *MemberUseCase) ExportPersonalData(ctx context.Context, id int) (*output.PersonalDataArchive, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, m.tracer, m.logger)
+	defer span.End()
+
+	if err := m.authorizePersonalData(transCtx, contextLogger, id); err != nil {
+		return nil, err
+	}
+	member, err := m.MemberGateway.GetByID(transCtx, id)
+	if err != nil {
+		contextLogger.Error("會員個資匯出查詢會員失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+		)
+		return nil, err
+	}
+	// 匯出內容必須完整，稽核紀錄讀取失敗時不回傳不完整的檔案
+	records := make([]output.AuditRecord, 0)
+	if m.auditTrail != nil {
+		records, err = m.auditTrail.ListByMember(transCtx, id)
+		if err != nil {
+			contextLogger.Error("會員個資匯出讀取稽核紀錄失敗",
+				logger.NewField("error", err),
+				logger.NewField("member_id", id),
+			)
+			return nil, err
+		}
+	}
+
+	var prefs *entity.MemberPreferences
+	if m.preferences != nil && m.preferenceRegistry != nil {
+		prefs, err = m.loadPreferences(transCtx, contextLogger, id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	m.recordAudit(transCtx, contextLogger, output.AuditActionMemberPersonalDataExported, id, nil, nil)
+
+	contextLogger.Info("會員個資匯出成功",
+		logger.NewField("member_id", id),
+		logger.NewField("audit_record_count", len(records)),
+	)
+	return &output.PersonalDataArchive{
+		Member:       member,
+		AuditRecords: records,
+		Preferences:  prefs,
+		ExportedAt:   time.Now().UTC(),
+	}, nil
+}
+func (m *MemberUseCase) ErasePersonalData(ctx context.Context, id int) (*output.ErasureResult, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, m.tracer, m.logger)
+	defer span.End()
+
+	if err := m.authorizePersonalData(transCtx, contextLogger, id); err != nil {
+		return nil, err
+	}
+	member, err := m.MemberGateway.GetByID(transCtx, id)
+	if err != nil {
+		contextLogger.Error("會員個資刪除查詢會員失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+		)
+		return nil, err
+	}
+
+	result := &output.ErasureResult{Member: member, AlreadyErased: member.IsAnonymized(), ErasedAt: time.Now().UTC()}
+	anonymized := *member
+	if !result.AlreadyErased {
+		token, err := m.newErasureToken()
+		if err != nil {
+			contextLogger.Error("會員個資刪除產生匿名值失敗",
+				logger.NewField("error", err),
+				logger.NewField("member_id", id),
+			)
+			return nil, ErrMemberUnexpectedError
+		}
+		anonymized.Anonymize(token)
+		result.Member = &anonymized
+	}
+
+	// 匿名化、MemberErased 事件、偏好設定刪除、事件 payload 與稽核紀錄遮蔽寫在同一個交易；
+	// 已刪除過的會員仍會重新刪除偏好設定並重新遮蔽，讓中途失敗的刪除可以直接重試
+	err = m.withinTransaction(transCtx, func(txCtx context.Context) error {
+		if !result.AlreadyErased {
+			if err := m.anonymizeMember(txCtx, contextLogger, &anonymized); err != nil {
+				return err
+			}
+			if err := m.addEvents(txCtx, contextLogger, entity.NewMemberErased(id, result.ErasedAt)); err != nil {
+				return err
+			}
+		}
+		if m.eventOutbox != nil {
+			// outbox 事件與 webhook 投遞紀錄保存了註冊、改 Email 時的名稱與 Email
+			if err := m.eventOutbox.RedactMember(txCtx, id); err != nil {
+				contextLogger.Error("會員個資刪除遮蔽領域事件失敗",
+					logger.NewField("error", err),
+					logger.NewField("member_id", id),
+				)
+				return err
+			}
+		}
+		if m.preferences != nil {
+			if _, err := m.preferences.DeleteByMember(txCtx, id); err != nil {
+				contextLogger.Error("會員個資刪除偏好設定失敗",
+					logger.NewField("error", err),
+					logger.NewField("member_id", id),
+				)
+				return err
+			}
+		}
+		if m.auditTrail == nil {
+			return nil
+		}
+		redacted, err := m.auditTrail.RedactMember(txCtx, id)
+		if err != nil {
+			contextLogger.Error("會員個資刪除遮蔽稽核紀錄失敗",
+				logger.NewField("error", err),
+				logger.NewField("member_id", id),
+			)
+			return err
+		}
+		result.RedactedAuditRecords = redacted
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	m.recordAudit(transCtx, contextLogger, output.AuditActionMemberPersonalDataErased, id, nil, nil)
+	if !result.AlreadyErased {
+		m.notifyChange(transCtx, output.ChangeTypeUpdated, &anonymized)
+	}
+
+	contextLogger.Info("會員個資刪除成功",
+		logger.NewField("member_id", id),
+		logger.NewField("already_erased", result.AlreadyErased),
+		logger.NewField("redacted_audit_records", result.RedactedAuditRecords),
+	)
+	return result, nil
+}
+
+// anonymizeMember 將匿名值寫回 members，須在交易 ctx 中呼叫
+func (m *MemberUseCase) anonymizeMember(ctx context.Context, contextLogger logger.Logger, anonymized *entity.Member) error {
+	if _, err := m.MemberGateway.UpdateProfile(ctx, anonymized); err != nil {
+		contextLogger.Error("會員個資刪除更新名稱失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", anonymized.ID),
+		)
+		return err
+	}
+	if err := m.MemberGateway.UpdateEmail(ctx, anonymized.ID, anonymized.Email, anonymized.NormalizedEmail); err != nil {
+		contextLogger.Error("會員個資刪除更新 Email 失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", anonymized.ID),
+		)
+		return err
+	}
+	if err := m.MemberGateway.UpdatePassword(ctx, anonymized.ID, anonymized.Password); err != nil {
+		contextLogger.Error("會員個資刪除更新密碼失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", anonymized.ID),
+		)
+		return err
+	}
+	return nil
+}
+
+// authorizePersonalData 只有會員本人（actor 為會員 ID）或設定中的個資管理者可以匯出/刪除個資
+func (m *MemberUseCase) authorizePersonalData(ctx context.Context, contextLogger logger.Logger, id int) error {
+	actor := requestmeta.FromContext(ctx).Actor
+	if actor == strconv.Itoa(id) {
+		return nil
+	}
+	if _, ok := m.privacyOfficers[actor]; ok {
+		return nil
+	}
+	contextLogger.Warn("會員個資存取被拒",
+		logger.NewField("member_id", id),
+		logger.NewField("actor", actor),
+	)
+	return ErrMemberPrivacyForbidden
+}
+
+// randomErasureToken 產生 16 bytes 的隨機十六進位字串
+func randomErasureToken() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
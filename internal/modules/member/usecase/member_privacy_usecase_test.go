@@ -0,0 +1,343 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/mock"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/requestmeta"
+	mocklogger "github.com/tomoffice/go-clean-architecture/pkg/logger/mock"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
+	mocktracer "github.com/tomoffice/go-clean-architecture/pkg/tracer/mock"
+	"testing"
+	"time"
+)
+
+func TestMemberUseCase_ExportPersonalData(t *testing.T) {
+	ctrl, testTime, mockLogger, mockTracer := privacyHelper(t)
+	existing := &entity.Member{ID: 1, Name: "ggg", Email: "gg@gmail.com", Password: "secret", CreatedAt: testTime}
+	records := []output.AuditRecord{
+		{ID: 10, Actor: "1", Action: "member.registered", Changes: map[string]output.AuditFieldChange{"email": {After: "gg@gmail.com"}}, CreatedAt: testTime},
+	}
+	tests := []struct {
+		name       string
+		actor      string
+		repoSetup  func(*mock.MockMemberPersistence)
+		auditSetup func(*mock.MockAuditTrail)
+		want       *output.PersonalDataArchive
+		wantErr    error
+	}{
+		{
+			name:  "member exports own data",
+			actor: "1",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(existing, nil)
+			},
+			auditSetup: func(a *mock.MockAuditTrail) {
+				gomock.InOrder(
+					a.EXPECT().ListByMember(gomock.Any(), 1).Return(records, nil),
+					a.EXPECT().Record(gomock.Any(), output.AuditActionMemberPersonalDataExported, 1, nil, nil).Return(nil),
+				)
+			},
+			want: &output.PersonalDataArchive{Member: existing, AuditRecords: records},
+		},
+		{
+			name:  "privacy officer exports any member",
+			actor: "dpo",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(existing, nil)
+			},
+			auditSetup: func(a *mock.MockAuditTrail) {
+				a.EXPECT().ListByMember(gomock.Any(), 1).Return(records, nil)
+				a.EXPECT().Record(gomock.Any(), output.AuditActionMemberPersonalDataExported, 1, nil, nil).Return(nil)
+			},
+			want: &output.PersonalDataArchive{Member: existing, AuditRecords: records},
+		},
+		{
+			name:       "other member is forbidden",
+			actor:      "2",
+			repoSetup:  func(r *mock.MockMemberPersistence) {},
+			auditSetup: func(a *mock.MockAuditTrail) {},
+			wantErr:    ErrMemberPrivacyForbidden,
+		},
+		{
+			name:       "anonymous is forbidden",
+			actor:      "",
+			repoSetup:  func(r *mock.MockMemberPersistence) {},
+			auditSetup: func(a *mock.MockAuditTrail) {},
+			wantErr:    ErrMemberPrivacyForbidden,
+		},
+		{
+			name:  "member not found",
+			actor: "dpo",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(nil, ErrMemberNotFound)
+			},
+			auditSetup: func(a *mock.MockAuditTrail) {},
+			wantErr:    ErrMemberNotFound,
+		},
+		{
+			name:  "audit list error returns no partial archive",
+			actor: "1",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(existing, nil)
+			},
+			auditSetup: func(a *mock.MockAuditTrail) {
+				a.EXPECT().ListByMember(gomock.Any(), 1).Return(nil, ErrMemberAuditTrailError)
+			},
+			wantErr: ErrMemberAuditTrailError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockMemberPersistence(ctrl)
+			mockAudit := mock.NewMockAuditTrail(ctrl)
+			tt.repoSetup(mockRepo)
+			tt.auditSetup(mockAudit)
+			m := &MemberUseCase{
+				MemberGateway:   mockRepo,
+				auditTrail:      mockAudit,
+				privacyOfficers: map[string]struct{}{"dpo": {}},
+				logger:          mockLogger,
+				tracer:          mockTracer,
+			}
+			ctx := requestmeta.WithMeta(context.Background(), requestmeta.Meta{Actor: tt.actor})
+			got, err := m.ExportPersonalData(ctx, 1)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ExportPersonalData() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr != nil {
+				assert.Nil(t, got)
+				return
+			}
+			assert.Equal(t, tt.want.Member, got.Member)
+			assert.Equal(t, tt.want.AuditRecords, got.AuditRecords)
+			assert.False(t, got.ExportedAt.IsZero())
+		})
+	}
+}
+
+func TestMemberUseCase_ErasePersonalData(t *testing.T) {
+	ctrl, testTime, mockLogger, mockTracer := privacyHelper(t)
+	existing := func() *entity.Member {
+		return &entity.Member{ID: 1, Name: "ggg", Email: "gg@gmail.com", Password: "secret", CreatedAt: testTime}
+	}
+	anonymized := func() *entity.Member {
+		return &entity.Member{ID: 1, Name: entity.AnonymizedName, Email: "erased-1-tok@anonymized.invalid", NormalizedEmail: "erased-1-tok@anonymized.invalid", Password: "tok", CreatedAt: testTime}
+	}
+	tests := []struct {
+		name        string
+		actor       string
+		tokenErr    error
+		repoSetup   func(*mock.MockMemberPersistence)
+		auditSetup  func(*mock.MockAuditTrail)
+		outboxSetup func(*mock.MockEventOutbox)
+		feedSetup   func(*mock.MockChangeFeed)
+		want        *output.ErasureResult
+		wantErr     error
+	}{
+		{
+			name:  "first erase anonymizes member and redacts events and audit",
+			actor: "1",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(existing(), nil)
+				r.EXPECT().UpdateProfile(gomock.Any(), anonymized()).Return(anonymized(), nil)
+				r.EXPECT().UpdateEmail(gomock.Any(), 1, "erased-1-tok@anonymized.invalid", "erased-1-tok@anonymized.invalid").Return(nil)
+				r.EXPECT().UpdatePassword(gomock.Any(), 1, "tok").Return(nil)
+			},
+			auditSetup: func(a *mock.MockAuditTrail) {
+				gomock.InOrder(
+					a.EXPECT().RedactMember(gomock.Any(), 1).Return(3, nil),
+					a.EXPECT().Record(gomock.Any(), output.AuditActionMemberPersonalDataErased, 1, nil, nil).Return(nil),
+				)
+			},
+			outboxSetup: func(o *mock.MockEventOutbox) {
+				o.EXPECT().Add(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, events ...entity.DomainEvent) error {
+					assert.Len(t, events, 1)
+					assert.Equal(t, entity.EventMemberErased, events[0].EventName())
+					assert.Equal(t, 1, events[0].AggregateID())
+					return nil
+				})
+				o.EXPECT().RedactMember(gomock.Any(), 1).Return(nil)
+			},
+			feedSetup: func(f *mock.MockChangeFeed) {
+				f.EXPECT().Publish(gomock.Any(), gomock.Any()).Do(func(_ context.Context, change output.MemberChange) {
+					assert.Equal(t, output.ChangeTypeUpdated, change.Type)
+					assert.Equal(t, anonymized(), change.Member)
+				})
+			},
+			want: &output.ErasureResult{Member: anonymized(), RedactedAuditRecords: 3},
+		},
+		{
+			name:  "erasing again only re-checks event and audit redaction",
+			actor: "dpo",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(anonymized(), nil)
+			},
+			auditSetup: func(a *mock.MockAuditTrail) {
+				a.EXPECT().RedactMember(gomock.Any(), 1).Return(0, nil)
+				a.EXPECT().Record(gomock.Any(), output.AuditActionMemberPersonalDataErased, 1, nil, nil).Return(nil)
+			},
+			outboxSetup: func(o *mock.MockEventOutbox) {
+				o.EXPECT().RedactMember(gomock.Any(), 1).Return(nil)
+			},
+			feedSetup: func(f *mock.MockChangeFeed) {},
+			want:      &output.ErasureResult{Member: anonymized(), AlreadyErased: true},
+		},
+		{
+			name:        "other member is forbidden",
+			actor:       "2",
+			repoSetup:   func(r *mock.MockMemberPersistence) {},
+			auditSetup:  func(a *mock.MockAuditTrail) {},
+			outboxSetup: func(o *mock.MockEventOutbox) {},
+			feedSetup:   func(f *mock.MockChangeFeed) {},
+			wantErr:     ErrMemberPrivacyForbidden,
+		},
+		{
+			name:  "member not found",
+			actor: "1",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(nil, ErrMemberNotFound)
+			},
+			auditSetup:  func(a *mock.MockAuditTrail) {},
+			outboxSetup: func(o *mock.MockEventOutbox) {},
+			feedSetup:   func(f *mock.MockChangeFeed) {},
+			wantErr:     ErrMemberNotFound,
+		},
+		{
+			name:     "token generation failure",
+			actor:    "1",
+			tokenErr: errors.New("entropy exhausted"),
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(existing(), nil)
+			},
+			auditSetup:  func(a *mock.MockAuditTrail) {},
+			outboxSetup: func(o *mock.MockEventOutbox) {},
+			feedSetup:   func(f *mock.MockChangeFeed) {},
+			wantErr:     ErrMemberUnexpectedError,
+		},
+		{
+			name:  "email update failure aborts erasure",
+			actor: "1",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(existing(), nil)
+				r.EXPECT().UpdateProfile(gomock.Any(), gomock.Any()).Return(anonymized(), nil)
+				r.EXPECT().UpdateEmail(gomock.Any(), 1, gomock.Any(), gomock.Any()).Return(ErrMemberDBError)
+			},
+			auditSetup:  func(a *mock.MockAuditTrail) {},
+			outboxSetup: func(o *mock.MockEventOutbox) {},
+			feedSetup:   func(f *mock.MockChangeFeed) {},
+			wantErr:     ErrMemberDBError,
+		},
+		{
+			name:  "audit redaction failure aborts erasure",
+			actor: "1",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(existing(), nil)
+				r.EXPECT().UpdateProfile(gomock.Any(), gomock.Any()).Return(anonymized(), nil)
+				r.EXPECT().UpdateEmail(gomock.Any(), 1, gomock.Any(), gomock.Any()).Return(nil)
+				r.EXPECT().UpdatePassword(gomock.Any(), 1, gomock.Any()).Return(nil)
+			},
+			auditSetup: func(a *mock.MockAuditTrail) {
+				a.EXPECT().RedactMember(gomock.Any(), 1).Return(0, ErrMemberAuditTrailError)
+			},
+			outboxSetup: func(o *mock.MockEventOutbox) {
+				o.EXPECT().Add(gomock.Any(), gomock.Any()).Return(nil)
+				o.EXPECT().RedactMember(gomock.Any(), 1).Return(nil)
+			},
+			feedSetup: func(f *mock.MockChangeFeed) {},
+			wantErr:   ErrMemberAuditTrailError,
+		},
+		{
+			name:  "event redaction failure aborts erasure",
+			actor: "1",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(existing(), nil)
+				r.EXPECT().UpdateProfile(gomock.Any(), gomock.Any()).Return(anonymized(), nil)
+				r.EXPECT().UpdateEmail(gomock.Any(), 1, gomock.Any(), gomock.Any()).Return(nil)
+				r.EXPECT().UpdatePassword(gomock.Any(), 1, gomock.Any()).Return(nil)
+			},
+			auditSetup: func(a *mock.MockAuditTrail) {},
+			outboxSetup: func(o *mock.MockEventOutbox) {
+				o.EXPECT().Add(gomock.Any(), gomock.Any()).Return(nil)
+				o.EXPECT().RedactMember(gomock.Any(), 1).Return(ErrMemberEventOutboxError)
+			},
+			feedSetup: func(f *mock.MockChangeFeed) {},
+			wantErr:   ErrMemberEventOutboxError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockMemberPersistence(ctrl)
+			mockAudit := mock.NewMockAuditTrail(ctrl)
+			mockOutbox := mock.NewMockEventOutbox(ctrl)
+			mockFeed := mock.NewMockChangeFeed(ctrl)
+			tt.repoSetup(mockRepo)
+			tt.auditSetup(mockAudit)
+			tt.outboxSetup(mockOutbox)
+			tt.feedSetup(mockFeed)
+			m := &MemberUseCase{
+				MemberGateway:   mockRepo,
+				eventOutbox:     mockOutbox,
+				auditTrail:      mockAudit,
+				changeFeed:      mockFeed,
+				privacyOfficers: map[string]struct{}{"dpo": {}},
+				logger:          mockLogger,
+				tracer:          mockTracer,
+				newErasureToken: func() (string, error) { return "tok", tt.tokenErr },
+			}
+			ctx := requestmeta.WithMeta(context.Background(), requestmeta.Meta{Actor: tt.actor})
+			got, err := m.ErasePersonalData(ctx, 1)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ErasePersonalData() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr != nil {
+				assert.Nil(t, got)
+				return
+			}
+			assert.Equal(t, tt.want.Member, got.Member)
+			assert.Equal(t, tt.want.AlreadyErased, got.AlreadyErased)
+			assert.Equal(t, tt.want.RedactedAuditRecords, got.RedactedAuditRecords)
+			assert.True(t, got.Member.IsAnonymized())
+		})
+	}
+}
+
+func TestRandomErasureToken(t *testing.T) {
+	first, err := randomErasureToken()
+	assert.NoError(t, err)
+	second, err := randomErasureToken()
+	assert.NoError(t, err)
+	assert.Len(t, first, 32)
+	assert.NotEqual(t, first, second)
+}
+
+// privacyHelper 與 repoHelper 相同，但 tracer 會沿用傳入的 ctx，讓 requestmeta 的 actor 能傳到授權檢查
+func privacyHelper(t *testing.T) (*gomock.Controller, time.Time, *mocklogger.MockLogger, *mocktracer.MockTracer) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+	testTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockLogger := mocklogger.NewMockLogger(ctrl)
+	mockTracer := mocktracer.NewMockTracer(ctrl)
+
+	mockLogger.EXPECT().With(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	mockSpan := mocktracer.NewMockSpan(ctrl)
+	mockSpan.EXPECT().End().AnyTimes()
+	mockTracer.EXPECT().Start(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, _ string) (context.Context, tracer.Span) {
+		return ctx, mockSpan
+	}).AnyTimes()
+
+	return ctrl, testTime, mockLogger, mockTracer
+}
@@ -0,0 +1,214 @@
+package usecase
+
+import (
+	"context"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/inputmodel"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/enum"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/requestmeta"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"time"
+)
+
+// segmentExportPageSize 匯出分群時每次讀取的會員數
+const segmentExportPageSize = 100
+
+// CreateSegment 儲存分群條件，條件由 entity.NewSegment 檢查與正規化
+func (m *MemberUseCase) CreateSegment(ctx context.Context, input *inputmodel.CreateSegmentInputModel) (*entity.Segment, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, m.tracer, m.logger)
+	defer span.End()
+
+	if err := m.checkTagAdmin(transCtx, contextLogger); err != nil {
+		return nil, err
+	}
+	actor := requestmeta.FromContext(transCtx).Actor
+	segment, err := entity.NewSegment(input.Name, input.Filter, actor, time.Now().UTC())
+	if err != nil {
+		contextLogger.Warn("分群建立資料不符合規則",
+			logger.NewField("error", err),
+			logger.NewField("segment_name", input.Name),
+		)
+		return nil, mapEntityError(err)
+	}
+	if err := m.segments.Create(transCtx, segment); err != nil {
+		contextLogger.Error("分群建立 Gateway 創建失敗",
+			logger.NewField("error", err),
+			logger.NewField("segment_name", segment.Name),
+		)
+		return nil, err
+	}
+	// 與會員註冊相同，透過唯一欄位查詢回傳完整 entity
+	created, err := m.segments.GetByName(transCtx, segment.Name)
+	if err != nil {
+		contextLogger.Error("分群建立後查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("segment_name", segment.Name),
+		)
+		return nil, err
+	}
+
+	contextLogger.Info("分群建立成功",
+		logger.NewField("segment_id", created.ID),
+		logger.NewField("segment_name", created.Name),
+		logger.NewField("actor", actor),
+	)
+	return created, nil
+}
+
+func (m *MemberUseCase) ListSegments(ctx context.Context, pagination pagination.Pagination) ([]*entity.Segment, int, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, m.tracer, m.logger)
+	defer span.End()
+
+	if err := m.checkTagAdmin(transCtx, contextLogger); err != nil {
+		return nil, 0, err
+	}
+	segments, err := m.segments.GetAll(transCtx, pagination)
+	if err != nil {
+		contextLogger.Error("分群列表 Gateway 查詢失敗",
+			logger.NewField("error", err),
+		)
+		return nil, 0, err
+	}
+	total, err := m.segments.CountAll(transCtx)
+	if err != nil {
+		contextLogger.Error("分群總數 Gateway 查詢失敗",
+			logger.NewField("error", err),
+		)
+		return nil, 0, err
+	}
+
+	contextLogger.Debug("分群列表查詢成功",
+		logger.NewField("count", len(segments)),
+		logger.NewField("total", total),
+	)
+	return segments, total, nil
+}
+
+// EvaluateSegment 依儲存的條件即時查詢符合的會員，結果隨會員資料變動
+func (m *MemberUseCase) EvaluateSegment(ctx context.Context, id int, pagination pagination.Pagination) ([]*entity.Member, int, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, m.tracer, m.logger)
+	defer span.End()
+
+	segment, err := m.segmentForEvaluation(transCtx, contextLogger, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	filter := segmentToMemberFilter(segment.Filter)
+	members, err := m.MemberGateway.GetAll(transCtx, filter, pagination)
+	if err != nil {
+		contextLogger.Error("分群會員查詢 Gateway 執行失敗",
+			logger.NewField("error", err),
+			logger.NewField("segment_id", id),
+		)
+		return nil, 0, err
+	}
+	total, err := m.MemberGateway.CountAll(transCtx, filter)
+	if err != nil {
+		contextLogger.Error("分群會員總數 Gateway 執行失敗",
+			logger.NewField("error", err),
+			logger.NewField("segment_id", id),
+		)
+		return nil, 0, err
+	}
+
+	contextLogger.Debug("分群會員查詢成功",
+		logger.NewField("segment_id", id),
+		logger.NewField("count", len(members)),
+		logger.NewField("total", total),
+	)
+	return members, total, nil
+}
+
+// ExportSegment 依 id 順序分頁讀出目前符合條件的所有會員；任何一頁失敗都不回傳不完整的匯出
+func (m *MemberUseCase) ExportSegment(ctx context.Context, id int) (*output.SegmentExport, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, m.tracer, m.logger)
+	defer span.End()
+
+	segment, err := m.segmentForEvaluation(transCtx, contextLogger, id)
+	if err != nil {
+		return nil, err
+	}
+	filter := segmentToMemberFilter(segment.Filter)
+	export := &output.SegmentExport{Segment: segment, Members: make([]*entity.Member, 0)}
+	page := pagination.Pagination{Limit: segmentExportPageSize, SortBy: "id", OrderBy: enum.OrderByAsc}
+	for {
+		members, err := m.MemberGateway.GetAll(transCtx, filter, page)
+		if err != nil {
+			contextLogger.Error("分群匯出讀取會員失敗",
+				logger.NewField("error", err),
+				logger.NewField("segment_id", id),
+				logger.NewField("offset", page.Offset),
+			)
+			return nil, err
+		}
+		export.Members = append(export.Members, members...)
+		if len(members) < page.Limit {
+			break
+		}
+		page.Offset += page.Limit
+	}
+	export.ExportedAt = time.Now().UTC()
+
+	contextLogger.Info("分群匯出成功",
+		logger.NewField("segment_id", id),
+		logger.NewField("count", len(export.Members)),
+		logger.NewField("actor", requestmeta.FromContext(transCtx).Actor),
+	)
+	return export, nil
+}
+
+func (m *MemberUseCase) DeleteSegment(ctx context.Context, id int) (*entity.Segment, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, m.tracer, m.logger)
+	defer span.End()
+
+	segment, err := m.segmentForEvaluation(transCtx, contextLogger, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.segments.Delete(transCtx, id); err != nil {
+		contextLogger.Error("分群刪除 Gateway 執行失敗",
+			logger.NewField("error", err),
+			logger.NewField("segment_id", id),
+		)
+		return nil, err
+	}
+
+	contextLogger.Info("分群刪除成功",
+		logger.NewField("segment_id", id),
+		logger.NewField("actor", requestmeta.FromContext(transCtx).Actor),
+	)
+	return segment, nil
+}
+
+// segmentForEvaluation 檢查權限並查詢分群
+func (m *MemberUseCase) segmentForEvaluation(ctx context.Context, contextLogger logger.Logger, id int) (*entity.Segment, error) {
+	if err := m.checkTagAdmin(ctx, contextLogger); err != nil {
+		return nil, err
+	}
+	segment, err := m.segments.GetByID(ctx, id)
+	if err != nil {
+		contextLogger.Error("分群查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("segment_id", id),
+		)
+		return nil, err
+	}
+	return segment, nil
+}
+
+// segmentToMemberFilter 分群一律排除已合併的會員
+func segmentToMemberFilter(filter entity.SegmentFilter) output.MemberFilter {
+	return output.MemberFilter{
+		Status:     filter.Status,
+		ReferredBy: filter.ReferredBy,
+		TagsAny:    filter.TagsAny,
+		TagsAll:    filter.TagsAll,
+	}
+}
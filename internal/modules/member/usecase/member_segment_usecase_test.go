@@ -0,0 +1,170 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/inputmodel"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/mock"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/enum"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/requestmeta"
+)
+
+func TestMemberUseCase_CreateSegment(t *testing.T) {
+	ctrl, testTime, mockLogger, mockTracer := privacyHelper(t)
+	tests := []struct {
+		name         string
+		actor        string
+		input        *inputmodel.CreateSegmentInputModel
+		segmentSetup func(*mock.MockSegmentPersistence)
+		wantErr      error
+	}{
+		{
+			name:         "non admin is forbidden",
+			actor:        "1",
+			input:        &inputmodel.CreateSegmentInputModel{Name: "vip", Filter: entity.SegmentFilter{TagsAny: []string{"vip"}}},
+			segmentSetup: func(s *mock.MockSegmentPersistence) {},
+			wantErr:      ErrMemberTagForbidden,
+		},
+		{
+			name:         "empty filter is invalid",
+			actor:        "admin",
+			input:        &inputmodel.CreateSegmentInputModel{Name: "everyone"},
+			segmentSetup: func(s *mock.MockSegmentPersistence) {},
+			wantErr:      ErrSegmentInvalid,
+		},
+		{
+			name:         "invalid tag in filter",
+			actor:        "admin",
+			input:        &inputmodel.CreateSegmentInputModel{Name: "vip", Filter: entity.SegmentFilter{TagsAll: []string{"not a tag"}}},
+			segmentSetup: func(s *mock.MockSegmentPersistence) {},
+			wantErr:      ErrMemberInvalidTag,
+		},
+		{
+			name:  "duplicate name",
+			actor: "admin",
+			input: &inputmodel.CreateSegmentInputModel{Name: "vip", Filter: entity.SegmentFilter{TagsAny: []string{"vip"}}},
+			segmentSetup: func(s *mock.MockSegmentPersistence) {
+				s.EXPECT().Create(gomock.Any(), gomock.Any()).Return(ErrSegmentAlreadyExists)
+			},
+			wantErr: ErrSegmentAlreadyExists,
+		},
+		{
+			name:  "normalized filter is stored",
+			actor: "admin",
+			input: &inputmodel.CreateSegmentInputModel{Name: "vip", Filter: entity.SegmentFilter{Status: entity.MemberStatusActive, TagsAny: []string{"VIP", "beta", "vip"}}},
+			segmentSetup: func(s *mock.MockSegmentPersistence) {
+				s.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, segment *entity.Segment) error {
+					assert.Equal(t, []string{"beta", "vip"}, segment.Filter.TagsAny)
+					assert.Equal(t, "admin", segment.CreatedBy)
+					return nil
+				})
+				s.EXPECT().GetByName(gomock.Any(), "vip").Return(&entity.Segment{ID: 3, Name: "vip", CreatedAt: testTime}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSegments := mock.NewMockSegmentPersistence(ctrl)
+			tt.segmentSetup(mockSegments)
+			m := &MemberUseCase{
+				segments:     mockSegments,
+				statusAdmins: map[string]struct{}{"admin": {}},
+				logger:       mockLogger,
+				tracer:       mockTracer,
+			}
+			ctx := requestmeta.WithMeta(context.Background(), requestmeta.Meta{Actor: tt.actor})
+
+			got, err := m.CreateSegment(ctx, tt.input)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, 3, got.ID)
+		})
+	}
+}
+
+func TestMemberUseCase_EvaluateSegment(t *testing.T) {
+	ctrl, testTime, mockLogger, mockTracer := privacyHelper(t)
+	segment := &entity.Segment{ID: 3, Name: "vip", Filter: entity.SegmentFilter{Status: entity.MemberStatusActive, TagsAll: []string{"vip"}}, CreatedAt: testTime}
+	wantFilter := output.MemberFilter{Status: entity.MemberStatusActive, TagsAll: []string{"vip"}}
+	page := pagination.Pagination{Limit: 10, SortBy: "id", OrderBy: enum.OrderByAsc}
+	ctx := requestmeta.WithMeta(context.Background(), requestmeta.Meta{Actor: "admin"})
+
+	t.Run("segment not found", func(t *testing.T) {
+		mockSegments := mock.NewMockSegmentPersistence(ctrl)
+		mockSegments.EXPECT().GetByID(gomock.Any(), 3).Return(nil, ErrSegmentNotFound)
+		m := &MemberUseCase{segments: mockSegments, statusAdmins: map[string]struct{}{"admin": {}}, logger: mockLogger, tracer: mockTracer}
+
+		_, _, err := m.EvaluateSegment(ctx, 3, page)
+		assert.ErrorIs(t, err, ErrSegmentNotFound)
+	})
+	t.Run("filter excludes merged members", func(t *testing.T) {
+		mockRepo := mock.NewMockMemberPersistence(ctrl)
+		mockSegments := mock.NewMockSegmentPersistence(ctrl)
+		mockSegments.EXPECT().GetByID(gomock.Any(), 3).Return(segment, nil)
+		mockRepo.EXPECT().GetAll(gomock.Any(), wantFilter, page).Return([]*entity.Member{{ID: 1}, {ID: 2}}, nil)
+		mockRepo.EXPECT().CountAll(gomock.Any(), wantFilter).Return(2, nil)
+		m := &MemberUseCase{MemberGateway: mockRepo, segments: mockSegments, statusAdmins: map[string]struct{}{"admin": {}}, logger: mockLogger, tracer: mockTracer}
+
+		members, total, err := m.EvaluateSegment(ctx, 3, page)
+		assert.NoError(t, err)
+		assert.Len(t, members, 2)
+		assert.Equal(t, 2, total)
+	})
+}
+
+func TestMemberUseCase_ExportSegment(t *testing.T) {
+	ctrl, testTime, mockLogger, mockTracer := privacyHelper(t)
+	segment := &entity.Segment{ID: 3, Name: "vip", Filter: entity.SegmentFilter{TagsAny: []string{"vip"}}, CreatedAt: testTime}
+	members := func(from, count int) []*entity.Member {
+		result := make([]*entity.Member, 0, count)
+		for i := 0; i < count; i++ {
+			result = append(result, &entity.Member{ID: from + i})
+		}
+		return result
+	}
+	ctx := requestmeta.WithMeta(context.Background(), requestmeta.Meta{Actor: "admin"})
+
+	t.Run("reads every page in id order", func(t *testing.T) {
+		mockRepo := mock.NewMockMemberPersistence(ctrl)
+		mockSegments := mock.NewMockSegmentPersistence(ctrl)
+		mockSegments.EXPECT().GetByID(gomock.Any(), 3).Return(segment, nil)
+		first := pagination.Pagination{Limit: segmentExportPageSize, SortBy: "id", OrderBy: enum.OrderByAsc}
+		second := first
+		second.Offset = segmentExportPageSize
+		gomock.InOrder(
+			mockRepo.EXPECT().GetAll(gomock.Any(), output.MemberFilter{TagsAny: []string{"vip"}}, first).Return(members(1, segmentExportPageSize), nil),
+			mockRepo.EXPECT().GetAll(gomock.Any(), output.MemberFilter{TagsAny: []string{"vip"}}, second).Return(members(segmentExportPageSize+1, 5), nil),
+		)
+		m := &MemberUseCase{MemberGateway: mockRepo, segments: mockSegments, statusAdmins: map[string]struct{}{"admin": {}}, logger: mockLogger, tracer: mockTracer}
+
+		got, err := m.ExportSegment(ctx, 3)
+		assert.NoError(t, err)
+		assert.Len(t, got.Members, segmentExportPageSize+5)
+		assert.Equal(t, segment, got.Segment)
+		assert.False(t, got.ExportedAt.IsZero())
+	})
+	t.Run("page failure returns no partial export", func(t *testing.T) {
+		mockRepo := mock.NewMockMemberPersistence(ctrl)
+		mockSegments := mock.NewMockSegmentPersistence(ctrl)
+		mockSegments.EXPECT().GetByID(gomock.Any(), 3).Return(segment, nil)
+		gomock.InOrder(
+			mockRepo.EXPECT().GetAll(gomock.Any(), gomock.Any(), gomock.Any()).Return(members(1, segmentExportPageSize), nil),
+			mockRepo.EXPECT().GetAll(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, ErrMemberDBError),
+		)
+		m := &MemberUseCase{MemberGateway: mockRepo, segments: mockSegments, statusAdmins: map[string]struct{}{"admin": {}}, logger: mockLogger, tracer: mockTracer}
+
+		got, err := m.ExportSegment(ctx, 3)
+		assert.ErrorIs(t, err, ErrMemberDBError)
+		assert.Nil(t, got)
+	})
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/inputmodel"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/requestmeta"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"time"
+)
+
+func (m *MemberUseCase) ChangeMemberStatus(ctx context.Context, input *inputmodel.ChangeMemberStatusInputModel) (*entity.Member, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, m.tracer, m.logger)
+	defer span.End()
+
+	actor := requestmeta.FromContext(transCtx).Actor
+	if _, ok := m.statusAdmins[actor]; !ok {
+		contextLogger.Warn("會員狀態變更被拒：呼叫者不是狀態管理者",
+			logger.NewField("member_id", input.ID),
+			logger.NewField("actor", actor),
+		)
+		return nil, ErrMemberStatusForbidden
+	}
+	member, err := m.MemberGateway.GetByID(transCtx, input.ID)
+	if err != nil {
+		contextLogger.Error("會員狀態變更查詢會員失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", input.ID),
+		)
+		return nil, err
+	}
+	before := *member
+	change, err := member.ChangeStatus(input.Status, input.Reason, actor, time.Now().UTC())
+	if err != nil {
+		contextLogger.Warn("會員狀態變更不符合狀態機",
+			logger.NewField("error", err),
+			logger.NewField("member_id", input.ID),
+			logger.NewField("actor", actor),
+			logger.NewField("from_status", before.Status),
+			logger.NewField("to_status", input.Status),
+		)
+		return nil, mapEntityError(err)
+	}
+	// 狀態更新與 MemberStatusChanged 事件寫在同一個交易
+	err = m.withinTransaction(transCtx, func(txCtx context.Context) error {
+		if err := m.MemberGateway.UpdateStatus(txCtx, member.ID, change.From, change.To, change.Reason); err != nil {
+			contextLogger.Error("會員狀態變更 Gateway 執行失敗",
+				logger.NewField("error", err),
+				logger.NewField("member_id", member.ID),
+			)
+			return err
+		}
+		return m.addEvents(txCtx, contextLogger, entity.NewMemberStatusChanged(change))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	m.recordAudit(transCtx, contextLogger, output.AuditActionMemberStatusChanged, member.ID, &before, member)
+	m.notifyChange(transCtx, output.ChangeTypeUpdated, member)
+
+	contextLogger.Info("會員狀態變更成功",
+		logger.NewField("member_id", member.ID),
+		logger.NewField("actor", change.Actor),
+		logger.NewField("from_status", change.From),
+		logger.NewField("to_status", change.To),
+		logger.NewField("reason", change.Reason),
+	)
+	return member, nil
+}
+
+// ensureCanAuthenticate 密碼驗證通過後再確認帳號狀態，非 active 的會員不能以密碼執行任何操作
+func (m *MemberUseCase) ensureCanAuthenticate(contextLogger logger.Logger, member *entity.Member) error {
+	if err := member.CanAuthenticate(); err != nil {
+		contextLogger.Warn("會員身分驗證被拒：帳號不是 active",
+			logger.NewField("member_id", member.ID),
+			logger.NewField("status", member.Status),
+		)
+		return mapEntityError(err)
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/inputmodel"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/mock"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/requestmeta"
+)
+
+func TestMemberUseCase_ChangeMemberStatus(t *testing.T) {
+	ctrl, testTime, mockLogger, mockTracer := privacyHelper(t)
+	member := func(status entity.MemberStatus) *entity.Member {
+		return &entity.Member{ID: 1, Name: "ggg", Email: "gg@gmail.com", Password: "old", Status: status, CreatedAt: testTime}
+	}
+	tests := []struct {
+		name        string
+		actor       string
+		input       *inputmodel.ChangeMemberStatusInputModel
+		repoSetup   func(*mock.MockMemberPersistence)
+		outboxSetup func(*mock.MockEventOutbox)
+		wantReason  string
+		wantErr     error
+	}{
+		{
+			name:      "non admin is forbidden",
+			actor:     "1",
+			input:     &inputmodel.ChangeMemberStatusInputModel{ID: 1, Status: entity.MemberStatusSuspended, Reason: "spam"},
+			repoSetup: func(r *mock.MockMemberPersistence) {},
+			wantErr:   ErrMemberStatusForbidden,
+		},
+		{
+			name:  "pending member cannot be suspended",
+			actor: "admin",
+			input: &inputmodel.ChangeMemberStatusInputModel{ID: 1, Status: entity.MemberStatusSuspended, Reason: "spam"},
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(member(entity.MemberStatusPending), nil)
+			},
+			wantErr: ErrMemberStatusTransitionNotAllowed,
+		},
+		{
+			name:  "ban requires reason",
+			actor: "admin",
+			input: &inputmodel.ChangeMemberStatusInputModel{ID: 1, Status: entity.MemberStatusBanned, Reason: "  "},
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(member(entity.MemberStatusActive), nil)
+			},
+			wantErr: ErrMemberStatusReasonRequired,
+		},
+		{
+			name:  "member not found",
+			actor: "admin",
+			input: &inputmodel.ChangeMemberStatusInputModel{ID: 1, Status: entity.MemberStatusActive},
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(nil, ErrMemberNotFound)
+			},
+			wantErr: ErrMemberNotFound,
+		},
+		{
+			name:  "concurrent change has no effect",
+			actor: "admin",
+			input: &inputmodel.ChangeMemberStatusInputModel{ID: 1, Status: entity.MemberStatusSuspended, Reason: "spam"},
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(member(entity.MemberStatusActive), nil)
+				r.EXPECT().UpdateStatus(gomock.Any(), 1, entity.MemberStatusActive, entity.MemberStatusSuspended, "spam").Return(ErrMemberNoEffect)
+			},
+			wantErr: ErrMemberNoEffect,
+		},
+		{
+			name:  "suspend active member stores reason and adds event",
+			actor: "admin",
+			input: &inputmodel.ChangeMemberStatusInputModel{ID: 1, Status: entity.MemberStatusSuspended, Reason: " spam "},
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(member(entity.MemberStatusActive), nil)
+				r.EXPECT().UpdateStatus(gomock.Any(), 1, entity.MemberStatusActive, entity.MemberStatusSuspended, "spam").Return(nil)
+			},
+			outboxSetup: func(o *mock.MockEventOutbox) {
+				o.EXPECT().Add(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, events ...entity.DomainEvent) error {
+					assert.Len(t, events, 1)
+					event, ok := events[0].(entity.MemberStatusChanged)
+					assert.True(t, ok)
+					assert.Equal(t, entity.MemberStatusActive, event.From)
+					assert.Equal(t, entity.MemberStatusSuspended, event.To)
+					assert.Equal(t, "admin", event.Actor)
+					return nil
+				})
+			},
+			wantReason: "spam",
+		},
+		{
+			name:  "reinstate clears reason",
+			actor: "admin",
+			input: &inputmodel.ChangeMemberStatusInputModel{ID: 1, Status: entity.MemberStatusActive},
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				suspended := member(entity.MemberStatusSuspended)
+				suspended.StatusReason = "spam"
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(suspended, nil)
+				r.EXPECT().UpdateStatus(gomock.Any(), 1, entity.MemberStatusSuspended, entity.MemberStatusActive, "").Return(nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockMemberPersistence(ctrl)
+			mockOutbox := mock.NewMockEventOutbox(ctrl)
+			mockAudit := mock.NewMockAuditTrail(ctrl)
+			mockFeed := mock.NewMockChangeFeed(ctrl)
+			tt.repoSetup(mockRepo)
+			if tt.outboxSetup != nil {
+				tt.outboxSetup(mockOutbox)
+			} else if tt.wantErr == nil || tt.wantErr == ErrMemberNoEffect {
+				mockOutbox.EXPECT().Add(gomock.Any(), gomock.Any()).Return(nil).MaxTimes(1)
+			}
+			if tt.wantErr == nil {
+				mockAudit.EXPECT().Record(gomock.Any(), output.AuditActionMemberStatusChanged, 1, gomock.Any(), gomock.Any()).Return(nil)
+				mockFeed.EXPECT().Publish(gomock.Any(), gomock.Any()).Do(func(_ context.Context, change output.MemberChange) {
+					assert.Equal(t, output.ChangeTypeUpdated, change.Type)
+					assert.Equal(t, tt.input.Status, change.Member.Status)
+				})
+			}
+			m := &MemberUseCase{
+				MemberGateway: mockRepo,
+				eventOutbox:   mockOutbox,
+				auditTrail:    mockAudit,
+				changeFeed:    mockFeed,
+				statusAdmins:  map[string]struct{}{"admin": {}},
+				logger:        mockLogger,
+				tracer:        mockTracer,
+			}
+			ctx := requestmeta.WithMeta(context.Background(), requestmeta.Meta{Actor: tt.actor})
+
+			got, err := m.ChangeMemberStatus(ctx, tt.input)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.input.Status, got.Status)
+			assert.Equal(t, tt.wantReason, got.StatusReason)
+		})
+	}
+}
+
+func TestMemberUseCase_InactiveMemberCannotAuthenticate(t *testing.T) {
+	ctrl, ctx, testTime, mockLogger, mockTracer := repoHelper(t)
+	for _, status := range []entity.MemberStatus{entity.MemberStatusPending, entity.MemberStatusSuspended, entity.MemberStatusBanned} {
+		t.Run(string(status), func(t *testing.T) {
+			mockRepo := mock.NewMockMemberPersistence(ctrl)
+			mockRepo.EXPECT().GetByID(ctx, 1).Return(&entity.Member{ID: 1, Name: "ggg", Email: "gg@gmail.com", Status: status, CreatedAt: testTime}, nil)
+			mockRepo.EXPECT().VerifyPassword(ctx, 1, "old").Return(true, nil)
+			m := &MemberUseCase{
+				MemberGateway: mockRepo,
+				logger:        mockLogger,
+				tracer:        mockTracer,
+			}
+
+			err := m.UpdateMemberPassword(ctx, 1, "new-password", "old")
+			assert.ErrorIs(t, err, ErrMemberNotActive)
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/inputmodel"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/requestmeta"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+)
+
+// TagMember 為會員加上標籤，標籤會先正規化；會員已有此標籤時不視為錯誤，回傳目前的標籤
+func (m *MemberUseCase) TagMember(ctx context.Context, id int, tag string) (*entity.Member, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, m.tracer, m.logger)
+	defer span.End()
+
+	member, tag, err := m.taggableMember(transCtx, contextLogger, id, tag)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.MemberGateway.AddTag(transCtx, id, tag); err != nil && !errors.Is(err, ErrMemberNoEffect) {
+		contextLogger.Error("會員加標籤 Gateway 執行失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+			logger.NewField("tag", tag),
+		)
+		return nil, err
+	}
+	if err := m.fillTags(transCtx, contextLogger, member); err != nil {
+		return nil, err
+	}
+
+	contextLogger.Info("會員加標籤成功",
+		logger.NewField("member_id", id),
+		logger.NewField("tag", tag),
+		logger.NewField("actor", requestmeta.FromContext(transCtx).Actor),
+	)
+	return member, nil
+}
+
+// UntagMember 移除會員的標籤；會員沒有此標籤時不視為錯誤，回傳目前的標籤
+func (m *MemberUseCase) UntagMember(ctx context.Context, id int, tag string) (*entity.Member, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, m.tracer, m.logger)
+	defer span.End()
+
+	member, tag, err := m.taggableMember(transCtx, contextLogger, id, tag)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.MemberGateway.RemoveTag(transCtx, id, tag); err != nil && !errors.Is(err, ErrMemberNoEffect) {
+		contextLogger.Error("會員移除標籤 Gateway 執行失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+			logger.NewField("tag", tag),
+		)
+		return nil, err
+	}
+	if err := m.fillTags(transCtx, contextLogger, member); err != nil {
+		return nil, err
+	}
+
+	contextLogger.Info("會員移除標籤成功",
+		logger.NewField("member_id", id),
+		logger.NewField("tag", tag),
+		logger.NewField("actor", requestmeta.FromContext(transCtx).Actor),
+	)
+	return member, nil
+}
+
+// BulkTagMembers 為多位會員加上同一個標籤，不存在、已合併或已有此標籤的會員略過，回傳新加上的數量
+func (m *MemberUseCase) BulkTagMembers(ctx context.Context, input *inputmodel.BulkTagMembersInputModel) (int, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, m.tracer, m.logger)
+	defer span.End()
+
+	if err := m.checkTagAdmin(transCtx, contextLogger); err != nil {
+		return 0, err
+	}
+	tag, err := entity.NormalizeTag(input.Tag)
+	if err != nil {
+		contextLogger.Warn("會員批次加標籤：標籤不符合規則",
+			logger.NewField("error", err),
+			logger.NewField("tag", input.Tag),
+		)
+		return 0, mapEntityError(err)
+	}
+	tagged, err := m.MemberGateway.AddTagToMembers(transCtx, input.MemberIDs, tag)
+	if err != nil {
+		contextLogger.Error("會員批次加標籤 Gateway 執行失敗",
+			logger.NewField("error", err),
+			logger.NewField("tag", tag),
+			logger.NewField("count", len(input.MemberIDs)),
+		)
+		return 0, err
+	}
+
+	contextLogger.Info("會員批次加標籤成功",
+		logger.NewField("tag", tag),
+		logger.NewField("count", len(input.MemberIDs)),
+		logger.NewField("tagged", tagged),
+		logger.NewField("actor", requestmeta.FromContext(transCtx).Actor),
+	)
+	return tagged, nil
+}
+
+// taggableMember 檢查權限、正規化標籤並查詢會員；已合併的會員回傳 MemberMergedError
+func (m *MemberUseCase) taggableMember(ctx context.Context, contextLogger logger.Logger, id int, tag string) (*entity.Member, string, error) {
+	if err := m.checkTagAdmin(ctx, contextLogger); err != nil {
+		return nil, "", err
+	}
+	normalized, err := entity.NormalizeTag(tag)
+	if err != nil {
+		contextLogger.Warn("會員標籤不符合規則",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+			logger.NewField("tag", tag),
+		)
+		return nil, "", mapEntityError(err)
+	}
+	member, err := m.MemberGateway.GetByID(ctx, id)
+	if err != nil {
+		contextLogger.Error("會員標籤查詢會員失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+		)
+		return nil, "", err
+	}
+	if member.IsMerged() {
+		contextLogger.Warn("會員標籤被拒：會員已合併",
+			logger.NewField("member_id", id),
+			logger.NewField("merged_into", member.MergedInto),
+		)
+		return nil, "", &MemberMergedError{TargetID: member.MergedInto}
+	}
+	return member, normalized, nil
+}
+
+// checkTagAdmin 標籤與分群僅限狀態管理者
+func (m *MemberUseCase) checkTagAdmin(ctx context.Context, contextLogger logger.Logger) error {
+	actor := requestmeta.FromContext(ctx).Actor
+	if _, ok := m.statusAdmins[actor]; !ok {
+		contextLogger.Warn("會員標籤/分群操作被拒：呼叫者不是狀態管理者",
+			logger.NewField("actor", actor),
+		)
+		return ErrMemberTagForbidden
+	}
+	return nil
+}
+
+// fillTags 填入會員目前的標籤
+func (m *MemberUseCase) fillTags(ctx context.Context, contextLogger logger.Logger, member *entity.Member) error {
+	tags, err := m.MemberGateway.ListTags(ctx, member.ID)
+	if err != nil {
+		contextLogger.Error("會員標籤查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", member.ID),
+		)
+		return err
+	}
+	member.Tags = tags
+	return nil
+}
+
+// normalizeFilterTags 列表篩選用的標籤，未指定時維持 nil
+func normalizeFilterTags(tags []string) ([]string, error) {
+	if len(tags) == 0 {
+		return nil, nil
+	}
+	return entity.NormalizeTags(tags)
+}
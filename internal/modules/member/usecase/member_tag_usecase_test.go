@@ -0,0 +1,200 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/inputmodel"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/mock"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/requestmeta"
+)
+
+func TestMemberUseCase_TagMember(t *testing.T) {
+	ctrl, testTime, mockLogger, mockTracer := privacyHelper(t)
+	member := func() *entity.Member {
+		return &entity.Member{ID: 1, Name: "ggg", Email: "gg@gmail.com", Status: entity.MemberStatusActive, CreatedAt: testTime}
+	}
+	tests := []struct {
+		name      string
+		actor     string
+		tag       string
+		remove    bool
+		repoSetup func(*mock.MockMemberPersistence)
+		wantTags  []string
+		wantErr   error
+	}{
+		{
+			name:      "non admin is forbidden",
+			actor:     "1",
+			tag:       "vip",
+			repoSetup: func(r *mock.MockMemberPersistence) {},
+			wantErr:   ErrMemberTagForbidden,
+		},
+		{
+			name:      "invalid tag",
+			actor:     "admin",
+			tag:       "not a tag",
+			repoSetup: func(r *mock.MockMemberPersistence) {},
+			wantErr:   ErrMemberInvalidTag,
+		},
+		{
+			name:  "merged member returns redirect",
+			actor: "admin",
+			tag:   "vip",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				merged := member()
+				merged.MergedInto = 2
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(merged, nil)
+			},
+			wantErr: ErrMemberMerged,
+		},
+		{
+			name:  "tag is normalized before adding",
+			actor: "admin",
+			tag:   " VIP ",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(member(), nil)
+				r.EXPECT().AddTag(gomock.Any(), 1, "vip").Return(nil)
+				r.EXPECT().ListTags(gomock.Any(), 1).Return([]string{"vip"}, nil)
+			},
+			wantTags: []string{"vip"},
+		},
+		{
+			name:  "existing tag is not an error",
+			actor: "admin",
+			tag:   "vip",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(member(), nil)
+				r.EXPECT().AddTag(gomock.Any(), 1, "vip").Return(ErrMemberNoEffect)
+				r.EXPECT().ListTags(gomock.Any(), 1).Return([]string{"vip"}, nil)
+			},
+			wantTags: []string{"vip"},
+		},
+		{
+			name:  "add tag db error",
+			actor: "admin",
+			tag:   "vip",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(member(), nil)
+				r.EXPECT().AddTag(gomock.Any(), 1, "vip").Return(ErrMemberDBError)
+			},
+			wantErr: ErrMemberDBError,
+		},
+		{
+			name:   "missing tag removal is not an error",
+			actor:  "admin",
+			tag:    "vip",
+			remove: true,
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(gomock.Any(), 1).Return(member(), nil)
+				r.EXPECT().RemoveTag(gomock.Any(), 1, "vip").Return(ErrMemberNoEffect)
+				r.EXPECT().ListTags(gomock.Any(), 1).Return(nil, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockMemberPersistence(ctrl)
+			tt.repoSetup(mockRepo)
+			m := &MemberUseCase{
+				MemberGateway: mockRepo,
+				statusAdmins:  map[string]struct{}{"admin": {}},
+				logger:        mockLogger,
+				tracer:        mockTracer,
+			}
+			ctx := requestmeta.WithMeta(context.Background(), requestmeta.Meta{Actor: tt.actor})
+
+			changeTag := m.TagMember
+			if tt.remove {
+				changeTag = m.UntagMember
+			}
+			got, err := changeTag(ctx, 1, tt.tag)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantTags, got.Tags)
+		})
+	}
+	t.Run("merged member error carries target", func(t *testing.T) {
+		mockRepo := mock.NewMockMemberPersistence(ctrl)
+		merged := member()
+		merged.MergedInto = 2
+		mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(merged, nil)
+		m := &MemberUseCase{MemberGateway: mockRepo, statusAdmins: map[string]struct{}{"admin": {}}, logger: mockLogger, tracer: mockTracer}
+		ctx := requestmeta.WithMeta(context.Background(), requestmeta.Meta{Actor: "admin"})
+
+		_, err := m.TagMember(ctx, 1, "vip")
+		var mergedErr *MemberMergedError
+		assert.True(t, errors.As(err, &mergedErr))
+		assert.Equal(t, 2, mergedErr.TargetID)
+	})
+}
+
+func TestMemberUseCase_BulkTagMembers(t *testing.T) {
+	ctrl, _, mockLogger, mockTracer := privacyHelper(t)
+	tests := []struct {
+		name       string
+		actor      string
+		input      *inputmodel.BulkTagMembersInputModel
+		repoSetup  func(*mock.MockMemberPersistence)
+		wantTagged int
+		wantErr    error
+	}{
+		{
+			name:      "non admin is forbidden",
+			actor:     "1",
+			input:     &inputmodel.BulkTagMembersInputModel{Tag: "vip", MemberIDs: []int{1, 2}},
+			repoSetup: func(r *mock.MockMemberPersistence) {},
+			wantErr:   ErrMemberTagForbidden,
+		},
+		{
+			name:      "invalid tag",
+			actor:     "admin",
+			input:     &inputmodel.BulkTagMembersInputModel{Tag: "-vip", MemberIDs: []int{1, 2}},
+			repoSetup: func(r *mock.MockMemberPersistence) {},
+			wantErr:   ErrMemberInvalidTag,
+		},
+		{
+			name:  "reports newly tagged members",
+			actor: "admin",
+			input: &inputmodel.BulkTagMembersInputModel{Tag: "VIP", MemberIDs: []int{1, 2, 3}},
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().AddTagToMembers(gomock.Any(), []int{1, 2, 3}, "vip").Return(2, nil)
+			},
+			wantTagged: 2,
+		},
+		{
+			name:  "db error",
+			actor: "admin",
+			input: &inputmodel.BulkTagMembersInputModel{Tag: "vip", MemberIDs: []int{1}},
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().AddTagToMembers(gomock.Any(), []int{1}, "vip").Return(0, ErrMemberDBError)
+			},
+			wantErr: ErrMemberDBError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockMemberPersistence(ctrl)
+			tt.repoSetup(mockRepo)
+			m := &MemberUseCase{
+				MemberGateway: mockRepo,
+				statusAdmins:  map[string]struct{}{"admin": {}},
+				logger:        mockLogger,
+				tracer:        mockTracer,
+			}
+			ctx := requestmeta.WithMeta(context.Background(), requestmeta.Meta{Actor: tt.actor})
+
+			got, err := m.BulkTagMembers(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantTagged, got)
+		})
+	}
+}
@@ -16,49 +16,188 @@ import (
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/input"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
 	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/requestmeta"
 	"github.com/tomoffice/go-clean-architecture/pkg/logger"
 	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
+	"time"
 )
 
 type MemberUseCase struct {
 	MemberGateway output.MemberPersistence
-	logger        logger.Logger
-	tracer        tracer.Tracer
+	txManager     output.TransactionManager
+	eventOutbox   output.EventOutbox
+	auditTrail    output.AuditTrail
+	changeFeed    output.ChangeFeed
+	// privacyOfficers 可匯出/刪除任何會員個資的 actor
+	privacyOfficers map[string]struct{}
+	// emailNormalizer 產生比對身分用的正規化 Email
+	emailNormalizer entity.EmailNormalizer
+	// emailPolicy 註冊與變更 Email 時的網域政策，nil 表示不限制
+	emailPolicy output.EmailPolicy
+	// passwordPolicy 註冊與變更密碼時的密碼規則，breachedPasswords 為 nil 時不檢查外洩密碼
+	passwordPolicy    entity.PasswordPolicy
+	breachedPasswords output.BreachedPasswordChecker
+	// invitations 註冊邀請碼；inviteOnly 為 true 時註冊必須帶有效邀請碼，invitationTTL 為未指定到期時間時的有效期間
+	invitations   output.InvitationPersistence
+	inviteOnly    bool
+	invitationTTL time.Duration
+	// segments 儲存的會員分群條件
+	segments output.SegmentPersistence
+	// preferences 會員偏好設定，值的型別與預設值由 preferenceRegistry 定義
+	preferences        output.PreferencePersistence
+	preferenceRegistry *entity.PreferenceRegistry
+	// statusAdmins 可變更會員狀態的 actor；requireActivation 為 true 時新會員為 pending
+	statusAdmins      map[string]struct{}
+	requireActivation bool
+	// tokenIssuer 為通過身分驗證的會員簽發 access token，nil 表示不簽發
+	tokenIssuer output.TokenIssuer
+	logger            logger.Logger
+	tracer            tracer.Tracer
+	// newErasureToken 產生匿名化用的隨機值，測試時可替換
+	newErasureToken func() (string, error)
+	// newInvitationCode 產生邀請碼，測試時可替換
+	newInvitationCode func() (string, error)
 }
 
-func NewMemberUseCase(memberRepo output.MemberPersistence, log logger.Logger, tracer tracer.Tracer) input.MemberInputPort {
+// Dependencies 會員 use case 依賴的 output port，Members 之外的欄位為 nil 時停用對應功能
+//   - TxManager 為 nil 時不開交易，直接以傳入的 ctx 執行
+//   - EventOutbox/AuditTrail/ChangeFeed 為 nil 時不寫入領域事件、稽核紀錄與異動串流
+//   - EmailPolicy/BreachedPasswords 為 nil 時不檢查 Email 網域與外洩密碼
+//   - Preferences 須與 PreferenceRegistry 一起設定
+//   - TokenIssuer 為 nil 時不簽發 access token
+type Dependencies struct {
+	Members            output.MemberPersistence
+	TxManager          output.TransactionManager
+	EventOutbox        output.EventOutbox
+	AuditTrail         output.AuditTrail
+	ChangeFeed         output.ChangeFeed
+	EmailNormalizer    entity.EmailNormalizer
+	EmailPolicy        output.EmailPolicy
+	BreachedPasswords  output.BreachedPasswordChecker
+	Invitations        output.InvitationPersistence
+	Segments           output.SegmentPersistence
+	Preferences        output.PreferencePersistence
+	PreferenceRegistry *entity.PreferenceRegistry
+	TokenIssuer        output.TokenIssuer
+}
+
+// Options 會員 use case 的業務設定
+//   - PrivacyOfficers 可匯出/刪除任何會員個資的 actor
+//   - StatusAdmins 可變更會員狀態、合併會員、管理標籤與分群的 actor
+//   - RequireActivation 新會員為 pending，需管理者啟用
+//   - InviteOnly 註冊必須帶有效邀請碼；InvitationTTL 為建立邀請碼未指定到期時間時的有效期間
+type Options struct {
+	PrivacyOfficers   []string
+	PasswordPolicy    entity.PasswordPolicy
+	StatusAdmins      []string
+	RequireActivation bool
+	InviteOnly        bool
+	InvitationTTL     time.Duration
+}
+
+func NewMemberUseCase(deps Dependencies, options Options, log logger.Logger, tracer tracer.Tracer) input.MemberInputPort {
 	baseLogger := log.With(logger.NewField("layer", "usecase"))
 	return &MemberUseCase{
-		MemberGateway: memberRepo,
-		logger:        baseLogger,
-		tracer:        tracer,
+		MemberGateway:      deps.Members,
+		txManager:          deps.TxManager,
+		eventOutbox:        deps.EventOutbox,
+		auditTrail:         deps.AuditTrail,
+		changeFeed:         deps.ChangeFeed,
+		privacyOfficers:    actorSet(options.PrivacyOfficers),
+		emailNormalizer:    deps.EmailNormalizer,
+		emailPolicy:        deps.EmailPolicy,
+		passwordPolicy:     options.PasswordPolicy,
+		breachedPasswords:  deps.BreachedPasswords,
+		statusAdmins:       actorSet(options.StatusAdmins),
+		requireActivation:  options.RequireActivation,
+		invitations:        deps.Invitations,
+		inviteOnly:         options.InviteOnly,
+		invitationTTL:      options.InvitationTTL,
+		segments:           deps.Segments,
+		preferences:        deps.Preferences,
+		preferenceRegistry: deps.PreferenceRegistry,
+		tokenIssuer:        deps.TokenIssuer,
+		logger:             baseLogger,
+		tracer:             tracer,
+		newErasureToken:    randomErasureToken,
+		newInvitationCode:  randomInvitationCode,
+	}
+}
+
+// actorSet 把設定中的 actor 清單轉成查詢用的集合；匿名 actor 代表未認證的請求，一律不列入
+func actorSet(actors []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(actors))
+	for _, actor := range actors {
+		if actor == "" || actor == requestmeta.AnonymousActor {
+			continue
+		}
+		set[actor] = struct{}{}
 	}
+	return set
 }
-func (m *MemberUseCase) RegisterMember(ctx context.Context, member *entity.Member) (*entity.Member, error) {
+
+// RegisterMember invitationCode 為空時只在開放註冊模式下允許；有帶邀請碼時一律檢查並使用，新會員的 ReferredBy 指向邀請碼的推薦人
+func (m *MemberUseCase) RegisterMember(ctx context.Context, member *entity.Member, invitationCode string) (*entity.Member, error) {
 	// 創建帶有 context 的 logger 用於追蹤
 	transCtx, contextLogger, span := createTracedLogger(ctx, m.tracer, m.logger)
 	defer span.End()
 
 
-	err := m.MemberGateway.Create(transCtx, member)
+	// 入口傳入的 member 只是輸入資料，一律經 NewMember 檢查不變條件後再使用
+	member, err := entity.NewMember(member.Name, member.Email, member.Password, m.emailNormalizer)
 	if err != nil {
-		contextLogger.Error("會員註冊 Gateway 創建失敗",
+		contextLogger.Error("會員註冊資料不符合規則",
 			logger.NewField("error", err),
-			logger.NewField("member_email", member.Email),
 		)
+		return nil, mapEntityError(err)
+	}
+	if m.requireActivation {
+		member.Status = entity.MemberStatusPending
+	}
+	if err := m.checkEmailPolicy(transCtx, contextLogger, member); err != nil {
 		return nil, err
 	}
-	// 為了通用 repository，無論底層是否會 mutate 傳入 entity，
-	// 一律透過唯一欄位查詢回傳完整 entity，減少 infra 依賴。
-	retrieveMember, err := m.MemberGateway.GetByEmail(transCtx, member.Email)
+	if err := m.checkPasswordPolicy(transCtx, contextLogger, member.Password, member); err != nil {
+		return nil, err
+	}
+	invitation, err := m.resolveInvitation(transCtx, contextLogger, invitationCode, member)
+	if err != nil {
+		return nil, err
+	}
+	var retrieveMember *entity.Member
+	err = m.withinTransaction(transCtx, func(txCtx context.Context) error {
+		if invitation != nil {
+			if err := m.redeemInvitation(txCtx, contextLogger, invitation); err != nil {
+				return err
+			}
+		}
+		err := m.MemberGateway.Create(txCtx, member)
+		if err != nil {
+			contextLogger.Error("會員註冊 Gateway 創建失敗",
+				logger.NewField("error", err),
+				logger.NewField("member_email", member.Email),
+			)
+			return err
+		}
+		// 為了通用 repository，無論底層是否會 mutate 傳入 entity，
+		// 一律透過唯一欄位查詢回傳完整 entity，減少 infra 依賴。
+		retrieveMember, err = m.MemberGateway.GetByEmail(txCtx, member.NormalizedEmail)
+		if err != nil {
+			contextLogger.Error("會員註冊後查詢失敗",
+				logger.NewField("error", err.Error()),
+				logger.NewField("member_email", member.Email),
+			)
+			return err
+		}
+		return m.addEvents(txCtx, contextLogger, entity.NewMemberRegistered(retrieveMember, time.Now().UTC()))
+	})
 	if err != nil {
-		contextLogger.Error("會員註冊後查詢失敗",
-			logger.NewField("error", err.Error()),
-			logger.NewField("member_email", member.Email),
-		)
 		return nil, err
 	}
 
+	m.recordAudit(transCtx, contextLogger, output.AuditActionMemberRegistered, retrieveMember.ID, nil, retrieveMember)
+	m.notifyChange(transCtx, output.ChangeTypeCreated, retrieveMember)
+
 	contextLogger.Info("會員註冊成功",
 		logger.NewField("member_id", retrieveMember.ID),
 		logger.NewField("member_email", retrieveMember.Email),
@@ -79,6 +218,21 @@ func (m *MemberUseCase) GetMemberByID(ctx context.Context, id int) (*entity.Memb
 		)
 		return nil, err
 	}
+	// 已被合併的會員不回傳 tombstone，由呼叫端導向保留的會員
+	if member.IsMerged() {
+		contextLogger.Debug("會員查詢(ID)命中已合併會員",
+			logger.NewField("member_id", id),
+			logger.NewField("merged_into", member.MergedInto),
+		)
+		return nil, &MemberMergedError{TargetID: member.MergedInto}
+	}
+	if err := m.fillReferralCount(transCtx, contextLogger, member); err != nil {
+		return nil, err
+	}
+	if err := m.fillTags(transCtx, contextLogger, member); err != nil {
+		return nil, err
+	}
+	m.fillPreferences(transCtx, contextLogger, member)
 
 	contextLogger.Debug("會員查詢(ID)成功",
 		logger.NewField("member_id", member.ID),
@@ -92,7 +246,15 @@ func (m *MemberUseCase) GetMemberByEmail(ctx context.Context, email string) (*en
 	defer span.End()
 
 
-	member, err := m.MemberGateway.GetByEmail(transCtx, email)
+	normalized, err := m.emailNormalizer.Normalize(email)
+	if err != nil {
+		contextLogger.Error("會員查詢(Email) 正規化失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_email", email),
+		)
+		return nil, mapEntityError(err)
+	}
+	member, err := m.MemberGateway.GetByEmail(transCtx, normalized)
 	if err != nil {
 		contextLogger.Error("會員查詢(Email) Gateway 執行失敗",
 			logger.NewField("error", err.Error()),
@@ -100,6 +262,24 @@ func (m *MemberUseCase) GetMemberByEmail(ctx context.Context, email string) (*en
 		)
 		return nil, err
 	}
+	// Email 仍保留在已合併的 tombstone 上，查詢時直接回傳保留的會員
+	if member.IsMerged() {
+		member, err = m.MemberGateway.GetByID(transCtx, member.MergedInto)
+		if err != nil {
+			contextLogger.Error("會員查詢(Email) 合併目標查詢失敗",
+				logger.NewField("error", err.Error()),
+				logger.NewField("member_email", email),
+			)
+			return nil, err
+		}
+	}
+	if err := m.fillReferralCount(transCtx, contextLogger, member); err != nil {
+		return nil, err
+	}
+	if err := m.fillTags(transCtx, contextLogger, member); err != nil {
+		return nil, err
+	}
+	m.fillPreferences(transCtx, contextLogger, member)
 
 	contextLogger.Debug("會員查詢(Email)成功",
 		logger.NewField("member_id", member.ID),
@@ -107,13 +287,32 @@ func (m *MemberUseCase) GetMemberByEmail(ctx context.Context, email string) (*en
 	)
 	return member, nil
 }
-func (m *MemberUseCase) ListMembers(ctx context.Context, pagination pagination.Pagination) ([]*entity.Member, int, error) {
+func (m *MemberUseCase) ListMembers(ctx context.Context, input *inputmodel.ListMembersInputModel, pagination pagination.Pagination) ([]*entity.Member, int, error) {
 	// 創建帶有 context 的 logger 用於追蹤
 	transCtx, contextLogger, span := createTracedLogger(ctx, m.tracer, m.logger)
 	defer span.End()
 
 
-	members, err := m.MemberGateway.GetAll(transCtx, pagination)
+	var filter output.MemberFilter
+	if input != nil {
+		filter.Status = input.Status
+		var err error
+		if filter.TagsAny, err = normalizeFilterTags(input.TagsAny); err != nil {
+			contextLogger.Warn("會員列表標籤條件不符合規則",
+				logger.NewField("error", err),
+				logger.NewField("tags_any", input.TagsAny),
+			)
+			return nil, 0, mapEntityError(err)
+		}
+		if filter.TagsAll, err = normalizeFilterTags(input.TagsAll); err != nil {
+			contextLogger.Warn("會員列表標籤條件不符合規則",
+				logger.NewField("error", err),
+				logger.NewField("tags_all", input.TagsAll),
+			)
+			return nil, 0, mapEntityError(err)
+		}
+	}
+	members, err := m.MemberGateway.GetAll(transCtx, filter, pagination)
 	if err != nil {
 		contextLogger.Error("會員列表查詢 Gateway 執行失敗",
 			logger.NewField("error", err),
@@ -122,7 +321,7 @@ func (m *MemberUseCase) ListMembers(ctx context.Context, pagination pagination.P
 		)
 		return nil, 0, err
 	}
-	total, err := m.MemberGateway.CountAll(transCtx)
+	total, err := m.MemberGateway.CountAll(transCtx, filter)
 	if err != nil {
 		contextLogger.Error("會員總數查詢 Gateway 執行失敗",
 			logger.NewField("error", err),
@@ -146,8 +345,15 @@ func (m *MemberUseCase) UpdateMemberProfile(ctx context.Context, patch *inputmod
 	if err != nil {
 		return nil, err
 	}
+	before := *member
 	if patch.Name != nil {
-		member.Name = *patch.Name
+		if err := member.Rename(*patch.Name); err != nil {
+			contextLogger.Error("會員資料更新失敗：名稱不符合規則",
+				logger.NewField("error", err),
+				logger.NewField("member_id", patch.ID),
+			)
+			return nil, mapEntityError(err)
+		}
 	}
 	member, err = m.MemberGateway.UpdateProfile(transCtx, member)
 	if err != nil {
@@ -158,6 +364,9 @@ func (m *MemberUseCase) UpdateMemberProfile(ctx context.Context, patch *inputmod
 		return nil, err
 	}
 
+	m.recordAudit(transCtx, contextLogger, output.AuditActionMemberProfileUpdated, member.ID, &before, member)
+	m.notifyChange(transCtx, output.ChangeTypeUpdated, member)
+
 	contextLogger.Debug("會員資料更新成功",
 		logger.NewField("member_id", member.ID),
 		logger.NewField("member_email", member.Email),
@@ -170,8 +379,22 @@ func (m *MemberUseCase) UpdateMemberEmail(ctx context.Context, id int, newEmail,
 	defer span.End()
 
 
+	// 新 email 以正規化形式比對，大小寫或業者規則不同的同一信箱視為相同
+	var changed entity.Member
+	if err := changed.ChangeEmail(newEmail, m.emailNormalizer); err != nil {
+		contextLogger.Error("會員 Email 更新失敗：Email 正規化失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+			logger.NewField("new_email", newEmail),
+		)
+		return mapEntityError(err)
+	}
+	newEmail = changed.Email
+	if err := m.checkEmailPolicy(transCtx, contextLogger, &changed); err != nil {
+		return err
+	}
 	// 先檢查新 email 是否被其他人使用
-	existedMember, err := m.MemberGateway.GetByEmail(transCtx, newEmail)
+	existedMember, err := m.MemberGateway.GetByEmail(transCtx, changed.NormalizedEmail)
 	if err == nil && existedMember.ID != id {
 		// 新 email 已被其他人使用
 		contextLogger.Error("會員 Email 更新失敗：新 Email 已被使用",
@@ -212,25 +435,47 @@ func (m *MemberUseCase) UpdateMemberEmail(ctx context.Context, id int, newEmail,
 		return err
 	}
 	// 驗證密碼與更新 email
-	// 確認密碼是否正確
-	if member.Password != password {
+	// 確認密碼是否正確；gateway 讀出的會員不帶密碼雜湊，由 VerifyPassword 比對
+	matched, err := m.MemberGateway.VerifyPassword(transCtx, member.ID, password)
+	if err != nil {
+		contextLogger.Error("會員 Email 更新密碼比對失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+		)
+		return err
+	}
+	if !matched {
 		contextLogger.Error("會員 Email 更新失敗：密碼錯誤",
 			logger.NewField("member_id", id),
 			logger.NewField("member_email", member.Email),
 		)
 		return ErrMemberPasswordIncorrect
 	}
-	// 執行 email 更新
-	err = m.MemberGateway.UpdateEmail(transCtx, id, newEmail)
+	if err := m.ensureCanAuthenticate(contextLogger, member); err != nil {
+		return err
+	}
+	// 執行 email 更新，與 MemberEmailChanged 事件寫在同一個交易
+	err = m.withinTransaction(transCtx, func(txCtx context.Context) error {
+		if err := m.MemberGateway.UpdateEmail(txCtx, id, newEmail, changed.NormalizedEmail); err != nil {
+			contextLogger.Error("會員 Email 更新 Gateway 執行失敗",
+				logger.NewField("error", err),
+				logger.NewField("member_id", id),
+				logger.NewField("new_email", newEmail),
+			)
+			return err
+		}
+		return m.addEvents(txCtx, contextLogger, entity.NewMemberEmailChanged(member, newEmail, time.Now().UTC()))
+	})
 	if err != nil {
-		contextLogger.Error("會員 Email 更新 Gateway 執行失敗",
-			logger.NewField("error", err),
-			logger.NewField("member_id", id),
-			logger.NewField("new_email", newEmail),
-		)
 		return err
 	}
 
+	after := *member
+	after.Email = newEmail
+	after.NormalizedEmail = changed.NormalizedEmail
+	m.recordAudit(transCtx, contextLogger, output.AuditActionMemberEmailUpdated, id, member, &after)
+	m.notifyChange(transCtx, output.ChangeTypeUpdated, &after)
+
 	contextLogger.Debug("會員 Email 更新成功",
 		logger.NewField("member_id", id),
 		logger.NewField("old_email", member.Email),
@@ -260,14 +505,28 @@ func (m *MemberUseCase) UpdateMemberPassword(ctx context.Context, id int, newPas
 		)
 		return err
 	}
-	// 確認舊密碼是否正確
-	if member.Password != oldPassword {
+	// 確認舊密碼是否正確；gateway 讀出的會員不帶密碼雜湊，由 VerifyPassword 比對
+	matched, err := m.MemberGateway.VerifyPassword(transCtx, member.ID, oldPassword)
+	if err != nil {
+		contextLogger.Error("會員密碼更新密碼比對失敗",
+			logger.NewField("error", err),
+			logger.NewField("member_id", id),
+		)
+		return err
+	}
+	if !matched {
 		contextLogger.Error("會員密碼更新失敗：舊密碼錯誤",
 			logger.NewField("member_id", id),
 			logger.NewField("member_email", member.Email),
 		)
 		return ErrMemberPasswordIncorrect
 	}
+	if err := m.ensureCanAuthenticate(contextLogger, member); err != nil {
+		return err
+	}
+	if err := m.checkPasswordPolicy(transCtx, contextLogger, newPassword, member); err != nil {
+		return err
+	}
 	// 執行密碼更新
 	err = m.MemberGateway.UpdatePassword(ctx, id, newPassword)
 	if err != nil {
@@ -278,6 +537,11 @@ func (m *MemberUseCase) UpdateMemberPassword(ctx context.Context, id int, newPas
 		return err
 	}
 
+	after := *member
+	after.Password = newPassword
+	m.recordAudit(transCtx, contextLogger, output.AuditActionMemberPasswordUpdated, id, member, &after)
+	m.notifyChange(transCtx, output.ChangeTypeUpdated, &after)
+
 	contextLogger.Debug("會員密碼更新成功",
 		logger.NewField("member_id", id),
 		logger.NewField("member_email", member.Email),
@@ -299,22 +563,157 @@ func (m *MemberUseCase) DeleteMember(ctx context.Context, id int) (*entity.Membe
 		return nil, err
 	}
 
-	err = m.MemberGateway.Delete(transCtx, id)
+	// 刪除與 MemberDeleted 事件寫在同一個交易
+	err = m.withinTransaction(transCtx, func(txCtx context.Context) error {
+		if err := m.MemberGateway.Delete(txCtx, id); err != nil {
+			contextLogger.Error("會員刪除 Gateway 執行失敗",
+				logger.NewField("error", err),
+				logger.NewField("member_id", id),
+				logger.NewField("member_email", member.Email),
+			)
+			return err
+		}
+		return m.addEvents(txCtx, contextLogger, entity.NewMemberDeleted(member, time.Now().UTC()))
+	})
 	if err != nil {
-		contextLogger.Error("會員刪除 Gateway 執行失敗",
-			logger.NewField("error", err),
-			logger.NewField("member_id", id),
-			logger.NewField("member_email", member.Email),
-		)
 		return nil, err
 	}
 
+	m.recordAudit(transCtx, contextLogger, output.AuditActionMemberDeleted, id, member, nil)
+	m.notifyChange(transCtx, output.ChangeTypeDeleted, member)
+
 	contextLogger.Debug("會員刪除成功",
 		logger.NewField("member_id", id),
 		logger.NewField("member_email", member.Email),
 	)
 	return member, nil
 }
+func (m *MemberUseCase) StreamMemberChanges(ctx context.Context, input *inputmodel.StreamMemberChangesInputModel) (*output.ChangeSubscription, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	_, contextLogger, span := createTracedLogger(ctx, m.tracer, m.logger)
+	defer span.End()
+
+	if m.changeFeed == nil {
+		contextLogger.Error("會員異動串流訂閱失敗：未啟用異動通知")
+		return nil, ErrMemberChangeStreamUnavailable
+	}
+	filter := output.ChangeFilter{LastEventID: input.LastEventID}
+	for _, t := range input.Types {
+		filter.Types = append(filter.Types, output.ChangeType(t))
+	}
+	// 訂閱生命週期跟隨呼叫端 ctx，不使用 span 的 ctx
+	subscription, err := m.changeFeed.Subscribe(ctx, filter)
+	if err != nil {
+		contextLogger.Error("會員異動串流訂閱失敗",
+			logger.NewField("error", err),
+			logger.NewField("types", input.Types),
+			logger.NewField("last_event_id", input.LastEventID),
+		)
+		return nil, ErrMemberChangeStreamUnavailable
+	}
+
+	contextLogger.Debug("會員異動串流訂閱成功",
+		logger.NewField("types", input.Types),
+		logger.NewField("last_event_id", input.LastEventID),
+		logger.NewField("replay_count", len(subscription.Replay)),
+		logger.NewField("gap", subscription.Gap),
+	)
+	return subscription, nil
+}
+// checkEmailPolicy 以正規化後的網域檢查 Email 政策，未注入 EmailPolicy 時不檢查
+func (m *MemberUseCase) checkEmailPolicy(ctx context.Context, contextLogger logger.Logger, member *entity.Member) error {
+	if m.emailPolicy == nil {
+		return nil
+	}
+	err := m.emailPolicy.Check(ctx, member.EmailDomain())
+	if err == nil {
+		return nil
+	}
+	var violation *output.EmailPolicyViolation
+	if errors.As(err, &violation) {
+		contextLogger.Warn("會員 Email 違反網域政策",
+			logger.NewField("member_email", member.Email),
+			logger.NewField("domain", violation.Domain),
+			logger.NewField("reason", string(violation.Reason)),
+		)
+		return ErrMemberEmailPolicyViolation
+	}
+	contextLogger.Error("會員 Email 網域政策檢查失敗",
+		logger.NewField("error", err),
+		logger.NewField("member_email", member.Email),
+	)
+	return ErrMemberUnexpectedError
+}
+// checkPasswordPolicy 一次檢查所有密碼規則，違反時回傳列出全部規則的 PasswordPolicyError；
+// 外洩密碼清單無法讀取時只記錄警告，不因清單故障擋下註冊或變更密碼
+func (m *MemberUseCase) checkPasswordPolicy(ctx context.Context, contextLogger logger.Logger, password string, member *entity.Member) error {
+	rules := m.passwordPolicy.Violations(password, member)
+	if m.breachedPasswords != nil {
+		breached, err := m.breachedPasswords.IsBreached(ctx, password)
+		if err != nil {
+			contextLogger.Warn("外洩密碼清單檢查失敗，略過檢查",
+				logger.NewField("error", err),
+				logger.NewField("member_id", member.ID),
+			)
+		} else if breached {
+			rules = append(rules, entity.PasswordRuleBreached)
+		}
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+	contextLogger.Warn("會員密碼違反密碼政策",
+		logger.NewField("member_id", member.ID),
+		logger.NewField("rules", rules),
+	)
+	return &PasswordPolicyError{Rules: rules}
+}
+
+// withinTransaction 未注入 TransactionManager 時（例如單元測試）直接執行 fn
+func (m *MemberUseCase) withinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if m.txManager == nil {
+		return fn(ctx)
+	}
+	return m.txManager.WithinTransaction(ctx, fn)
+}
+
+// addEvents 將領域事件寫入 outbox，失敗時回傳錯誤讓交易回滾
+func (m *MemberUseCase) addEvents(ctx context.Context, contextLogger logger.Logger, events ...entity.DomainEvent) error {
+	if m.eventOutbox == nil {
+		return nil
+	}
+	if err := m.eventOutbox.Add(ctx, events...); err != nil {
+		contextLogger.Error("會員領域事件寫入 outbox 失敗",
+			logger.NewField("error", err),
+			logger.NewField("event_type", events[0].EventName()),
+			logger.NewField("member_id", events[0].AggregateID()),
+		)
+		return err
+	}
+	return nil
+}
+
+// recordAudit 在異動成功後寫入稽核軌跡；寫入失敗只記錄錯誤，不回滾已完成的異動
+func (m *MemberUseCase) recordAudit(ctx context.Context, contextLogger logger.Logger, action output.AuditAction, memberID int, before, after *entity.Member) {
+	if m.auditTrail == nil {
+		return
+	}
+	if err := m.auditTrail.Record(ctx, action, memberID, before, after); err != nil {
+		contextLogger.Error("會員稽核紀錄寫入失敗",
+			logger.NewField("error", err),
+			logger.NewField("action", string(action)),
+			logger.NewField("member_id", memberID),
+		)
+	}
+}
+// notifyChange 在異動提交後發佈異動通知，僅供即時推送使用，不保證送達
+func (m *MemberUseCase) notifyChange(ctx context.Context, changeType output.ChangeType, member *entity.Member) {
+	if m.changeFeed == nil {
+		return
+	}
+	snapshot := *member
+	m.changeFeed.Publish(ctx, output.MemberChange{Type: changeType, Member: &snapshot, At: time.Now().UTC()})
+}
 func createTracedLogger(ctx context.Context, tr tracer.Tracer, log logger.Logger) (context.Context, logger.Logger, tracer.Span) {
 	transCtx, span := tr.Start(ctx, "")
 	lg := log.WithContext(transCtx)
@@ -10,6 +10,7 @@ import (
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/mock"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
 	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/requestmeta"
 	mocklogger "github.com/tomoffice/go-clean-architecture/pkg/logger/mock"
 	mocktracer "github.com/tomoffice/go-clean-architecture/pkg/tracer/mock"
 	"reflect"
@@ -46,7 +47,7 @@ func TestMemberUseCase_DeleteMember(t *testing.T) {
 			},
 			want: &entity.Member{
 				ID:        0,
-				Name:      "gg",
+				Name:      "ggg",
 				Email:     "gg@gmail.com",
 				Password:  "",
 				CreatedAt: testTime,
@@ -55,7 +56,7 @@ func TestMemberUseCase_DeleteMember(t *testing.T) {
 				gomock.InOrder(
 					r.EXPECT().GetByID(ctx, gomock.Any()).Return(&entity.Member{
 						ID:        0,
-						Name:      "gg",
+						Name:      "ggg",
 						Email:     "gg@gmail.com",
 						Password:  "",
 						CreatedAt: testTime,
@@ -167,11 +168,11 @@ func TestMemberUseCase_GetMemberByEmail(t *testing.T) {
 			},
 			args: args{
 				ctx:   ctx,
-				email: "",
+				email: "gg@gmail.com",
 			},
 			want: &entity.Member{
 				ID:        0,
-				Name:      "gg",
+				Name:      "ggg",
 				Email:     "gg@gmail.com",
 				Password:  "",
 				CreatedAt: testTime,
@@ -179,11 +180,13 @@ func TestMemberUseCase_GetMemberByEmail(t *testing.T) {
 			repoSetup: func(r *mock.MockMemberPersistence) {
 				r.EXPECT().GetByEmail(ctx, gomock.Any()).Return(&entity.Member{
 					ID:        0,
-					Name:      "gg",
+					Name:      "ggg",
 					Email:     "gg@gmail.com",
 					Password:  "",
 					CreatedAt: testTime,
 				}, nil)
+				r.EXPECT().CountAll(ctx, output.MemberFilter{IncludeMerged: true}).Return(0, nil)
+				r.EXPECT().ListTags(ctx, 0).Return(nil, nil)
 			},
 			wantErr: nil,
 		},
@@ -194,7 +197,7 @@ func TestMemberUseCase_GetMemberByEmail(t *testing.T) {
 			},
 			args: args{
 				ctx:   ctx,
-				email: "",
+				email: "gg@gmail.com",
 			},
 			want: nil,
 			repoSetup: func(r *mock.MockMemberPersistence) {
@@ -209,7 +212,7 @@ func TestMemberUseCase_GetMemberByEmail(t *testing.T) {
 			},
 			args: args{
 				ctx:   ctx,
-				email: "",
+				email: "gg@gmail.com",
 			},
 			want: nil,
 			repoSetup: func(r *mock.MockMemberPersistence) {
@@ -271,20 +274,24 @@ func TestMemberUseCase_GetMemberByID(t *testing.T) {
 				id:  1,
 			},
 			want: &entity.Member{
-				ID:        1,
-				Name:      "gg",
-				Email:     "gg@gmail.com",
-				Password:  "",
-				CreatedAt: testTime,
+				ID:            1,
+				Name:          "ggg",
+				Email:         "gg@gmail.com",
+				Password:      "",
+				CreatedAt:     testTime,
+				ReferralCount: 2,
+				Tags:          []string{"vip"},
 			},
 			repoSetup: func(r *mock.MockMemberPersistence) {
 				r.EXPECT().GetByID(ctx, gomock.Any()).Return(&entity.Member{
 					ID:        1,
-					Name:      "gg",
+					Name:      "ggg",
 					Email:     "gg@gmail.com",
 					Password:  "",
 					CreatedAt: testTime,
 				}, nil)
+				r.EXPECT().CountAll(ctx, output.MemberFilter{ReferredBy: 1, IncludeMerged: true}).Return(2, nil)
+				r.EXPECT().ListTags(ctx, 1).Return([]string{"vip"}, nil)
 			},
 			wantErr: nil,
 		}, {
@@ -365,7 +372,7 @@ func TestMemberUseCase_ListMembers(t *testing.T) {
 			want: []*entity.Member{
 				{
 					ID:        1,
-					Name:      "gg",
+					Name:      "ggg",
 					Email:     "gg@gmail.com",
 					Password:  "",
 					CreatedAt: testTime,
@@ -381,10 +388,10 @@ func TestMemberUseCase_ListMembers(t *testing.T) {
 			wantTotal: 2,
 			setupRepo: func(r *mock.MockMemberPersistence) {
 				gomock.InOrder(
-					r.EXPECT().GetAll(ctx, gomock.Any()).Return([]*entity.Member{
+					r.EXPECT().GetAll(ctx, output.MemberFilter{}, gomock.Any()).Return([]*entity.Member{
 						{
 							ID:        1,
-							Name:      "gg",
+							Name:      "ggg",
 							Email:     "gg@gmail.com",
 							Password:  "",
 							CreatedAt: testTime,
@@ -397,7 +404,7 @@ func TestMemberUseCase_ListMembers(t *testing.T) {
 							CreatedAt: testTime,
 						},
 					}, nil),
-					r.EXPECT().CountAll(ctx).Return(2, nil),
+					r.EXPECT().CountAll(ctx, output.MemberFilter{}).Return(2, nil),
 				)
 			},
 			wantErr: nil,
@@ -414,7 +421,7 @@ func TestMemberUseCase_ListMembers(t *testing.T) {
 			want:      nil,
 			wantTotal: 0,
 			setupRepo: func(r *mock.MockMemberPersistence) {
-				r.EXPECT().GetAll(ctx, gomock.Any()).Return(nil, ErrMemberNotFound)
+				r.EXPECT().GetAll(ctx, output.MemberFilter{}, gomock.Any()).Return(nil, ErrMemberNotFound)
 			},
 			wantErr: ErrMemberNotFound,
 		},
@@ -437,10 +444,10 @@ func TestMemberUseCase_ListMembers(t *testing.T) {
 			wantTotal: 0,
 			setupRepo: func(r *mock.MockMemberPersistence) {
 				gomock.InOrder(
-					r.EXPECT().GetAll(ctx, gomock.Any()).Return([]*entity.Member{
+					r.EXPECT().GetAll(ctx, output.MemberFilter{}, gomock.Any()).Return([]*entity.Member{
 						{
 							ID:        1,
-							Name:      "gg",
+							Name:      "ggg",
 							Email:     "gg@gmail.com",
 							Password:  "",
 							CreatedAt: testTime,
@@ -453,7 +460,7 @@ func TestMemberUseCase_ListMembers(t *testing.T) {
 							CreatedAt: testTime,
 						},
 					}, nil),
-					r.EXPECT().CountAll(ctx).Return(0, ErrMemberDBError),
+					r.EXPECT().CountAll(ctx, output.MemberFilter{}).Return(0, ErrMemberDBError),
 				)
 			},
 			wantErr: ErrMemberDBError,
@@ -468,7 +475,7 @@ func TestMemberUseCase_ListMembers(t *testing.T) {
 				tracer:        mockTracer,
 			}
 			tt.setupRepo(mockRepo)
-			got, gotTotal, err := m.ListMembers(tt.args.ctx, tt.args.pagination)
+			got, gotTotal, err := m.ListMembers(tt.args.ctx, nil, tt.args.pagination)
 			t.Logf("got = %#v, want %#v", got, tt.want)
 			t.Logf("gotTotal = %v, wantTotal %v", gotTotal, tt.wantTotal)
 			t.Logf("err = %v, wantErr %v", err, tt.wantErr)
@@ -511,11 +518,11 @@ func TestMemberUseCase_RegisterMember(t *testing.T) {
 			},
 			args: args{
 				ctx:    ctx,
-				member: &entity.Member{},
+				member: &entity.Member{Name: "ggg", Email: "gg@gmail.com"},
 			},
 			want: &entity.Member{
 				ID:        1,
-				Name:      "gg",
+				Name:      "ggg",
 				Email:     "gg@gmail.com",
 				Password:  "123455",
 				CreatedAt: testTime,
@@ -528,7 +535,7 @@ func TestMemberUseCase_RegisterMember(t *testing.T) {
 					// 第二次利用email取得資料
 					r.EXPECT().GetByEmail(ctx, gomock.Any()).Return(&entity.Member{
 						ID:        1,
-						Name:      "gg",
+						Name:      "ggg",
 						Email:     "gg@gmail.com",
 						Password:  "123455",
 						CreatedAt: testTime,
@@ -544,6 +551,7 @@ func TestMemberUseCase_RegisterMember(t *testing.T) {
 			args: args{
 				ctx:    ctx,
 				member: &entity.Member{
+					Name:  "ggg",
 					Email: "existing@example.com",
 				},
 			},
@@ -560,7 +568,7 @@ func TestMemberUseCase_RegisterMember(t *testing.T) {
 			},
 			args: args{
 				ctx:    ctx,
-				member: &entity.Member{},
+				member: &entity.Member{Name: "ggg", Email: "gg@gmail.com"},
 			},
 			want:    nil,
 			wantErr: ErrMemberNotFound,
@@ -580,7 +588,7 @@ func TestMemberUseCase_RegisterMember(t *testing.T) {
 			},
 			args: args{
 				ctx:    ctx,
-				member: &entity.Member{},
+				member: &entity.Member{Name: "ggg", Email: "gg@gmail.com"},
 			},
 			want:    nil,
 			wantErr: ErrMemberDBError,
@@ -595,7 +603,7 @@ func TestMemberUseCase_RegisterMember(t *testing.T) {
 			},
 			args: args{
 				ctx:    ctx,
-				member: &entity.Member{},
+				member: &entity.Member{Name: "ggg", Email: "gg@gmail.com"},
 			},
 			want:    nil,
 			wantErr: ErrMemberDBError,
@@ -618,7 +626,7 @@ func TestMemberUseCase_RegisterMember(t *testing.T) {
 				tracer:        mockTracer,
 			}
 			tt.setupRepo(mockRepo)
-			got, err := m.RegisterMember(tt.args.ctx, tt.args.member)
+			got, err := m.RegisterMember(tt.args.ctx, tt.args.member, "")
 			t.Logf("got = %v, want %v", got, tt.want)
 			t.Logf("err = %v, wantErr %v", err, tt.wantErr)
 			if !errors.Is(err, tt.wantErr) {
@@ -677,7 +685,7 @@ func TestMemberUseCase_UpdateMemberProfile(t *testing.T) {
 				gomock.InOrder(
 					r.EXPECT().GetByID(ctx, gomock.Any()).Return(&entity.Member{
 						ID:        1,
-						Name:      "gg",
+						Name:      "ggg",
 						Email:     "gg@gmail.com",
 						Password:  "",
 						CreatedAt: testTime,
@@ -701,7 +709,7 @@ func TestMemberUseCase_UpdateMemberProfile(t *testing.T) {
 				ctx: ctx,
 				patch: &inputmodel.PatchUpdateMemberProfileInputModel{
 					ID:   1,
-					Name: stringPtr("gg"),
+					Name: stringPtr("ggg"),
 				},
 			},
 			want:    nil,
@@ -728,7 +736,7 @@ func TestMemberUseCase_UpdateMemberProfile(t *testing.T) {
 				gomock.InOrder(
 					r.EXPECT().GetByID(ctx, gomock.Any()).Return(&entity.Member{
 						ID:        1,
-						Name:      "gg",
+						Name:      "ggg",
 						Email:     "gg@gmail.com",
 						Password:  "",
 						CreatedAt: testTime,
@@ -788,7 +796,7 @@ func TestMemberUseCase_UpdateMemberProfile(t *testing.T) {
 				gomock.InOrder(
 					r.EXPECT().GetByID(ctx, gomock.Any()).Return(&entity.Member{
 						ID:        1,
-						Name:      "gg",
+						Name:      "ggg",
 						Email:     "gg@gmail.com",
 						Password:  "",
 						CreatedAt: testTime,
@@ -863,10 +871,11 @@ func TestMemberUseCase_UpdateMemberEmail(t *testing.T) {
 						ID:        0,
 						Name:      "test",
 						Email:     "test@gmail.com",
-						Password:  "testpassword",
+						Status:    entity.MemberStatusActive,
 						CreatedAt: testTime,
 					}, nil),
-					r.EXPECT().UpdateEmail(ctx, gomock.Any(), gomock.Any()).Return(nil),
+					r.EXPECT().VerifyPassword(ctx, 0, "testpassword").Return(true, nil),
+					r.EXPECT().UpdateEmail(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil),
 				)
 			},
 			wantErr: nil,
@@ -879,7 +888,7 @@ func TestMemberUseCase_UpdateMemberEmail(t *testing.T) {
 			args: args{
 				ctx:      ctx,
 				id:       1,
-				newEmail: "",
+				newEmail: "new@gmail.com",
 				password: "",
 			},
 			setupRepo: func(r *mock.MockMemberPersistence) {
@@ -900,7 +909,7 @@ func TestMemberUseCase_UpdateMemberEmail(t *testing.T) {
 			args: args{
 				ctx:      ctx,
 				id:       1,
-				newEmail: "",
+				newEmail: "new@gmail.com",
 				password: "",
 			},
 			setupRepo: func(r *mock.MockMemberPersistence) {
@@ -939,7 +948,7 @@ func TestMemberUseCase_UpdateMemberEmail(t *testing.T) {
 			args: args{
 				ctx:      ctx,
 				id:       1,
-				newEmail: "",
+				newEmail: "new@gmail.com",
 				password: "",
 			},
 			setupRepo: func(r *mock.MockMemberPersistence) {
@@ -958,6 +967,7 @@ func TestMemberUseCase_UpdateMemberEmail(t *testing.T) {
 			args: args{
 				ctx:      ctx,
 				id:       1,
+				newEmail: "new@gmail.com",
 				password: "testpassword",
 			},
 			setupRepo: func(r *mock.MockMemberPersistence) {
@@ -976,33 +986,60 @@ func TestMemberUseCase_UpdateMemberEmail(t *testing.T) {
 			args: args{
 				ctx:      ctx,
 				id:       1,
+				newEmail: "new@gmail.com",
 				password: "wrongpassword",
 			},
 			setupRepo: func(r *mock.MockMemberPersistence) {
 				gomock.InOrder(
 					r.EXPECT().GetByEmail(ctx, gomock.Any()).Return(nil, ErrMemberNotFound),
 					r.EXPECT().GetByID(ctx, gomock.Any()).Return(&entity.Member{
-						ID:       1,
-						Password: "rightpassword",
+						ID:     1,
+						Status: entity.MemberStatusActive,
 					}, nil),
+					r.EXPECT().VerifyPassword(ctx, 1, "wrongpassword").Return(false, nil),
 				)
 			},
 			wantErr: ErrMemberPasswordIncorrect,
 		},
+		{
+			name: "VerifyPassword error",
+			fields: fields{
+				MemberGateway: mock.NewMockMemberPersistence(ctrl),
+			},
+			args: args{
+				ctx:      ctx,
+				id:       1,
+				newEmail: "new@gmail.com",
+				password: "testpassword",
+			},
+			setupRepo: func(r *mock.MockMemberPersistence) {
+				gomock.InOrder(
+					r.EXPECT().GetByEmail(ctx, gomock.Any()).Return(nil, ErrMemberNotFound),
+					r.EXPECT().GetByID(ctx, gomock.Any()).Return(&entity.Member{
+						ID:     1,
+						Status: entity.MemberStatusActive,
+					}, nil),
+					r.EXPECT().VerifyPassword(ctx, 1, "testpassword").Return(false, ErrMemberDBError),
+				)
+			},
+			wantErr: ErrMemberDBError,
+		},
 		{
 			name: "UpdateEmail error - no effect",
 			fields: fields{
 				MemberGateway: mock.NewMockMemberPersistence(ctrl),
 			},
 			args: args{
-				ctx: ctx,
-				id:  0,
+				ctx:      ctx,
+				id:       0,
+				newEmail: "new@gmail.com",
 			},
 			setupRepo: func(r *mock.MockMemberPersistence) {
 				gomock.InOrder(
 					r.EXPECT().GetByEmail(ctx, gomock.Any()).Return(nil, ErrMemberNotFound),
-					r.EXPECT().GetByID(ctx, gomock.Any()).Return(&entity.Member{}, nil),
-					r.EXPECT().UpdateEmail(ctx, gomock.Any(), gomock.Any()).Return(ErrMemberNoEffect),
+					r.EXPECT().GetByID(ctx, gomock.Any()).Return(&entity.Member{Status: entity.MemberStatusActive}, nil),
+					r.EXPECT().VerifyPassword(ctx, 0, "").Return(true, nil),
+					r.EXPECT().UpdateEmail(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(ErrMemberNoEffect),
 				)
 			},
 
@@ -1014,14 +1051,16 @@ func TestMemberUseCase_UpdateMemberEmail(t *testing.T) {
 				MemberGateway: mock.NewMockMemberPersistence(ctrl),
 			},
 			args: args{
-				ctx: ctx,
-				id:  0,
+				ctx:      ctx,
+				id:       0,
+				newEmail: "new@gmail.com",
 			},
 			setupRepo: func(r *mock.MockMemberPersistence) {
 				gomock.InOrder(
 					r.EXPECT().GetByEmail(ctx, gomock.Any()).Return(nil, ErrMemberNotFound),
-					r.EXPECT().GetByID(ctx, gomock.Any()).Return(&entity.Member{}, nil),
-					r.EXPECT().UpdateEmail(ctx, gomock.Any(), gomock.Any()).Return(ErrMemberDBError),
+					r.EXPECT().GetByID(ctx, gomock.Any()).Return(&entity.Member{Status: entity.MemberStatusActive}, nil),
+					r.EXPECT().VerifyPassword(ctx, 0, "").Return(true, nil),
+					r.EXPECT().UpdateEmail(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(ErrMemberDBError),
 				)
 			},
 			wantErr: ErrMemberDBError,
@@ -1079,9 +1118,10 @@ func TestMemberUseCase_UpdateMemberPassword(t *testing.T) {
 					r.EXPECT().GetByID(ctx, gomock.Any()).Return(&entity.Member{
 						ID:        1,
 						Name:      "test",
-						Password:  "oldpassword",
+						Status:    entity.MemberStatusActive,
 						CreatedAt: testTime,
 					}, nil),
+					r.EXPECT().VerifyPassword(ctx, 1, "oldpassword").Return(true, nil),
 					r.EXPECT().UpdatePassword(ctx, gomock.Any(), gomock.Any()).Return(nil),
 				)
 			},
@@ -1150,13 +1190,36 @@ func TestMemberUseCase_UpdateMemberPassword(t *testing.T) {
 			setupRepo: func(r *mock.MockMemberPersistence) {
 				gomock.InOrder(
 					r.EXPECT().GetByID(ctx, gomock.Any()).Return(&entity.Member{
-						ID:       0,
-						Password: "oldpassword",
+						ID:     0,
+						Status: entity.MemberStatusActive,
 					}, nil),
+					r.EXPECT().VerifyPassword(ctx, 0, "wrongpassword").Return(false, nil),
 				)
 			},
 			wantErr: ErrMemberPasswordIncorrect,
 		},
+		{
+			name: "VerifyPassword error",
+			fields: fields{
+				MemberGateway: mock.NewMockMemberPersistence(ctrl),
+			},
+			args: args{
+				ctx:         ctx,
+				id:          0,
+				newPassword: "newpassword",
+				oldPassword: "oldpassword",
+			},
+			setupRepo: func(r *mock.MockMemberPersistence) {
+				gomock.InOrder(
+					r.EXPECT().GetByID(ctx, gomock.Any()).Return(&entity.Member{
+						ID:     0,
+						Status: entity.MemberStatusActive,
+					}, nil),
+					r.EXPECT().VerifyPassword(ctx, 0, "oldpassword").Return(false, ErrMemberDBError),
+				)
+			},
+			wantErr: ErrMemberDBError,
+		},
 		{
 			name: "UpdatePassword error - no effect",
 			fields: fields{
@@ -1171,9 +1234,10 @@ func TestMemberUseCase_UpdateMemberPassword(t *testing.T) {
 			setupRepo: func(r *mock.MockMemberPersistence) {
 				gomock.InOrder(
 					r.EXPECT().GetByID(ctx, gomock.Any()).Return(&entity.Member{
-						ID:       0,
-						Password: "oldpassword",
+						ID:     0,
+						Status: entity.MemberStatusActive,
 					}, nil),
+					r.EXPECT().VerifyPassword(ctx, 0, "oldpassword").Return(true, nil),
 					r.EXPECT().UpdatePassword(ctx, gomock.Any(), gomock.Any()).Return(ErrMemberNoEffect),
 				)
 			},
@@ -1193,9 +1257,10 @@ func TestMemberUseCase_UpdateMemberPassword(t *testing.T) {
 			setupRepo: func(r *mock.MockMemberPersistence) {
 				gomock.InOrder(
 					r.EXPECT().GetByID(ctx, gomock.Any()).Return(&entity.Member{
-						ID:       0,
-						Password: "oldpassword",
+						ID:     0,
+						Status: entity.MemberStatusActive,
 					}, nil),
+					r.EXPECT().VerifyPassword(ctx, 0, "oldpassword").Return(true, nil),
 					r.EXPECT().UpdatePassword(ctx, gomock.Any(), gomock.Any()).Return(ErrMemberDBError),
 				)
 			},
@@ -1220,6 +1285,165 @@ func TestMemberUseCase_UpdateMemberPassword(t *testing.T) {
 	}
 }
 
+func TestMemberUseCase_EntityInvariants(t *testing.T) {
+	ctrl, ctx, testTime, mockLogger, mockTracer := repoHelper(t)
+	longName := "abcdefghijklmnopqrstu"
+	shortName := " ab "
+	tests := []struct {
+		name      string
+		repoSetup func(*mock.MockMemberPersistence)
+		call      func(m *MemberUseCase) error
+		wantErr   error
+	}{
+		{
+			name:      "register rejects short name before touching repository",
+			repoSetup: func(r *mock.MockMemberPersistence) {},
+			call: func(m *MemberUseCase) error {
+				_, err := m.RegisterMember(ctx, &entity.Member{Name: shortName, Email: "gg@gmail.com", Password: "123455"}, "")
+				return err
+			},
+			wantErr: ErrMemberInvalidName,
+		},
+		{
+			name:      "register rejects malformed email",
+			repoSetup: func(r *mock.MockMemberPersistence) {},
+			call: func(m *MemberUseCase) error {
+				_, err := m.RegisterMember(ctx, &entity.Member{Name: "ggg", Email: "gg@", Password: "123455"}, "")
+				return err
+			},
+			wantErr: ErrMemberInvalidEmail,
+		},
+		{
+			name: "profile update rejects long name",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(ctx, 1).Return(&entity.Member{ID: 1, Name: "ggg", Email: "gg@gmail.com", CreatedAt: testTime}, nil)
+			},
+			call: func(m *MemberUseCase) error {
+				_, err := m.UpdateMemberProfile(ctx, &inputmodel.PatchUpdateMemberProfileInputModel{ID: 1, Name: &longName})
+				return err
+			},
+			wantErr: ErrMemberInvalidName,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockMemberPersistence(ctrl)
+			m := &MemberUseCase{
+				MemberGateway: mockRepo,
+				logger:        mockLogger,
+				tracer:        mockTracer,
+			}
+			tt.repoSetup(mockRepo)
+
+			err := tt.call(m)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestMemberUseCase_PasswordPolicy(t *testing.T) {
+	ctrl, ctx, testTime, mockLogger, mockTracer := repoHelper(t)
+	policy := entity.PasswordPolicy{MinLength: 8, RequireDigit: true, RequireSymbol: true, RejectPersonalInfo: true}
+	existing := func() *entity.Member {
+		return &entity.Member{ID: 1, Name: "alice", Email: "alice@example.com", NormalizedEmail: "alice@example.com", Password: "Old-pass1", Status: entity.MemberStatusActive, CreatedAt: testTime}
+	}
+	tests := []struct {
+		name          string
+		breachedSetup func(*mock.MockBreachedPasswordChecker)
+		repoSetup     func(*mock.MockMemberPersistence)
+		call          func(m *MemberUseCase) error
+		wantRules     []entity.PasswordRule
+	}{
+		{
+			name: "register lists every violated rule",
+			breachedSetup: func(b *mock.MockBreachedPasswordChecker) {
+				b.EXPECT().IsBreached(ctx, "alice").Return(true, nil)
+			},
+			repoSetup: func(r *mock.MockMemberPersistence) {},
+			call: func(m *MemberUseCase) error {
+				_, err := m.RegisterMember(ctx, &entity.Member{Name: "alice", Email: "alice@example.com", Password: "alice"}, "")
+				return err
+			},
+			wantRules: []entity.PasswordRule{
+				entity.PasswordRuleMinLength,
+				entity.PasswordRuleDigit,
+				entity.PasswordRuleSymbol,
+				entity.PasswordRuleContainsName,
+				entity.PasswordRuleContainsEmail,
+				entity.PasswordRuleBreached,
+			},
+		},
+		{
+			name: "register accepts compliant password",
+			breachedSetup: func(b *mock.MockBreachedPasswordChecker) {
+				b.EXPECT().IsBreached(ctx, "horse-battery-9").Return(false, nil)
+			},
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				r.EXPECT().GetByEmail(ctx, "alice@example.com").Return(existing(), nil)
+			},
+			call: func(m *MemberUseCase) error {
+				_, err := m.RegisterMember(ctx, &entity.Member{Name: "alice", Email: "alice@example.com", Password: "horse-battery-9"}, "")
+				return err
+			},
+		},
+		{
+			name: "update password rejects breached password",
+			breachedSetup: func(b *mock.MockBreachedPasswordChecker) {
+				b.EXPECT().IsBreached(ctx, "P@ssw0rd!").Return(true, nil)
+			},
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(ctx, 1).Return(existing(), nil)
+				r.EXPECT().VerifyPassword(ctx, 1, "Old-pass1").Return(true, nil)
+			},
+			call: func(m *MemberUseCase) error {
+				return m.UpdateMemberPassword(ctx, 1, "P@ssw0rd!", "Old-pass1")
+			},
+			wantRules: []entity.PasswordRule{entity.PasswordRuleBreached},
+		},
+		{
+			name: "breached list failure fails open",
+			breachedSetup: func(b *mock.MockBreachedPasswordChecker) {
+				b.EXPECT().IsBreached(ctx, "horse-battery-9").Return(false, errors.New("disk error"))
+			},
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(ctx, 1).Return(existing(), nil)
+				r.EXPECT().VerifyPassword(ctx, 1, "Old-pass1").Return(true, nil)
+				r.EXPECT().UpdatePassword(ctx, 1, "horse-battery-9").Return(nil)
+			},
+			call: func(m *MemberUseCase) error {
+				return m.UpdateMemberPassword(ctx, 1, "horse-battery-9", "Old-pass1")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockMemberPersistence(ctrl)
+			mockBreached := mock.NewMockBreachedPasswordChecker(ctrl)
+			m := &MemberUseCase{
+				MemberGateway:     mockRepo,
+				passwordPolicy:    policy,
+				breachedPasswords: mockBreached,
+				logger:            mockLogger,
+				tracer:            mockTracer,
+			}
+			tt.breachedSetup(mockBreached)
+			tt.repoSetup(mockRepo)
+
+			err := tt.call(m)
+			if tt.wantRules == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, ErrMemberPasswordPolicyViolation)
+			var policyErr *PasswordPolicyError
+			if assert.ErrorAs(t, err, &policyErr) {
+				assert.Equal(t, tt.wantRules, policyErr.Rules)
+			}
+		})
+	}
+}
+
 func TestNewMemberUseCase(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	repo := mock.NewMockMemberPersistence(ctrl)
@@ -1229,7 +1453,39 @@ func TestNewMemberUseCase(t *testing.T) {
 	// 設置預期的 logger.With 調用
 	mockLogger.EXPECT().With(gomock.Any()).Return(mockLogger).Times(1)
 	
-	got := NewMemberUseCase(repo, mockLogger, mockTracer)
+	auditTrail := mock.NewMockAuditTrail(ctrl)
+	txManager := mock.NewMockTransactionManager(ctrl)
+	eventOutbox := mock.NewMockEventOutbox(ctrl)
+	changeFeed := mock.NewMockChangeFeed(ctrl)
+	emailPolicy := mock.NewMockEmailPolicy(ctrl)
+	breachedPasswords := mock.NewMockBreachedPasswordChecker(ctrl)
+	passwordPolicy := entity.PasswordPolicy{MinLength: 8}
+	invitations := mock.NewMockInvitationPersistence(ctrl)
+	segments := mock.NewMockSegmentPersistence(ctrl)
+	preferences := mock.NewMockPreferencePersistence(ctrl)
+	preferenceRegistry, _ := entity.NewDefaultPreferenceRegistry("en", "UTC")
+	deps := Dependencies{
+		Members:            repo,
+		TxManager:          txManager,
+		EventOutbox:        eventOutbox,
+		AuditTrail:         auditTrail,
+		ChangeFeed:         changeFeed,
+		EmailPolicy:        emailPolicy,
+		BreachedPasswords:  breachedPasswords,
+		Invitations:        invitations,
+		Segments:           segments,
+		Preferences:        preferences,
+		PreferenceRegistry: preferenceRegistry,
+	}
+	options := Options{
+		PrivacyOfficers:   []string{"dpo"},
+		PasswordPolicy:    passwordPolicy,
+		StatusAdmins:      []string{"admin", requestmeta.AnonymousActor},
+		RequireActivation: true,
+		InviteOnly:        true,
+		InvitationTTL:     time.Hour,
+	}
+	got := NewMemberUseCase(deps, options, mockLogger, mockTracer)
 	// 確認got不是nil
 	if got == nil {
 		t.Errorf("NewMemberUseCase() = %v, want %v", got, repo)
@@ -1243,6 +1499,451 @@ func TestNewMemberUseCase(t *testing.T) {
 	if usecase.MemberGateway != repo {
 		t.Errorf("NewMemberUseCase() = %v, want %v", usecase.MemberGateway, repo)
 	}
+	if usecase.txManager != txManager || usecase.eventOutbox != eventOutbox {
+		t.Errorf("NewMemberUseCase() txManager/eventOutbox not injected")
+	}
+	if usecase.auditTrail != auditTrail {
+		t.Errorf("NewMemberUseCase() auditTrail = %v, want %v", usecase.auditTrail, auditTrail)
+	}
+	if usecase.changeFeed != changeFeed {
+		t.Errorf("NewMemberUseCase() changeFeed = %v, want %v", usecase.changeFeed, changeFeed)
+	}
+	if _, ok := usecase.privacyOfficers["dpo"]; !ok || usecase.newErasureToken == nil {
+		t.Errorf("NewMemberUseCase() privacyOfficers/newErasureToken not injected")
+	}
+	if usecase.emailPolicy != emailPolicy {
+		t.Errorf("NewMemberUseCase() emailPolicy = %v, want %v", usecase.emailPolicy, emailPolicy)
+	}
+	if usecase.passwordPolicy != passwordPolicy || usecase.breachedPasswords != breachedPasswords {
+		t.Errorf("NewMemberUseCase() passwordPolicy/breachedPasswords not injected")
+	}
+	if _, ok := usecase.statusAdmins["admin"]; !ok || !usecase.requireActivation {
+		t.Errorf("NewMemberUseCase() statusAdmins/requireActivation not injected")
+	}
+	if _, ok := usecase.statusAdmins[requestmeta.AnonymousActor]; ok {
+		t.Errorf("NewMemberUseCase() anonymous actor must not be an admin")
+	}
+	if usecase.invitations != invitations || !usecase.inviteOnly || usecase.invitationTTL != time.Hour || usecase.newInvitationCode == nil {
+		t.Errorf("NewMemberUseCase() invitations/inviteOnly/invitationTTL/newInvitationCode not injected")
+	}
+	if usecase.segments != segments {
+		t.Errorf("NewMemberUseCase() segments = %v, want %v", usecase.segments, segments)
+	}
+	if usecase.preferences != preferences || usecase.preferenceRegistry != preferenceRegistry {
+		t.Errorf("NewMemberUseCase() preferences/preferenceRegistry not injected")
+	}
+}
+
+func TestMemberUseCase_AuditTrail(t *testing.T) {
+	ctrl, ctx, testTime, mockLogger, mockTracer := repoHelper(t)
+	existing := func() *entity.Member {
+		return &entity.Member{ID: 1, Name: "ggg", Email: "gg@gmail.com", Password: "old", Status: entity.MemberStatusActive, CreatedAt: testTime}
+	}
+	newName := "hhh"
+	tests := []struct {
+		name      string
+		repoSetup func(*mock.MockMemberPersistence)
+		auditErr  error
+		call      func(m *MemberUseCase) error
+		wantAudit output.AuditAction
+		check     func(t *testing.T, before, after *entity.Member)
+	}{
+		{
+			name: "register records member.registered without before",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				r.EXPECT().GetByEmail(ctx, "gg@gmail.com").Return(existing(), nil)
+			},
+			call: func(m *MemberUseCase) error {
+				_, err := m.RegisterMember(ctx, &entity.Member{Name: "ggg", Email: "gg@gmail.com", Password: "old"}, "")
+				return err
+			},
+			wantAudit: output.AuditActionMemberRegistered,
+			check: func(t *testing.T, before, after *entity.Member) {
+				assert.Nil(t, before)
+				assert.Equal(t, existing(), after)
+			},
+		},
+		{
+			name: "profile update records before and after name",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(ctx, 1).Return(existing(), nil)
+				r.EXPECT().UpdateProfile(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, m *entity.Member) (*entity.Member, error) {
+					return m, nil
+				})
+			},
+			call: func(m *MemberUseCase) error {
+				_, err := m.UpdateMemberProfile(ctx, &inputmodel.PatchUpdateMemberProfileInputModel{ID: 1, Name: &newName})
+				return err
+			},
+			wantAudit: output.AuditActionMemberProfileUpdated,
+			check: func(t *testing.T, before, after *entity.Member) {
+				assert.Equal(t, "ggg", before.Name)
+				assert.Equal(t, "hhh", after.Name)
+			},
+		},
+		{
+			name: "email update records old and new email",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByEmail(ctx, "new@gmail.com").Return(nil, ErrMemberNotFound)
+				r.EXPECT().GetByID(ctx, 1).Return(existing(), nil)
+				r.EXPECT().VerifyPassword(ctx, 1, "old").Return(true, nil)
+				r.EXPECT().UpdateEmail(ctx, 1, "new@gmail.com", "new@gmail.com").Return(nil)
+			},
+			call: func(m *MemberUseCase) error {
+				return m.UpdateMemberEmail(ctx, 1, "new@gmail.com", "old")
+			},
+			wantAudit: output.AuditActionMemberEmailUpdated,
+			check: func(t *testing.T, before, after *entity.Member) {
+				assert.Equal(t, "gg@gmail.com", before.Email)
+				assert.Equal(t, "new@gmail.com", after.Email)
+			},
+		},
+		{
+			name: "password update records password change",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(ctx, 1).Return(existing(), nil)
+				r.EXPECT().VerifyPassword(ctx, 1, "old").Return(true, nil)
+				r.EXPECT().UpdatePassword(ctx, 1, "new").Return(nil)
+			},
+			call: func(m *MemberUseCase) error {
+				return m.UpdateMemberPassword(ctx, 1, "new", "old")
+			},
+			wantAudit: output.AuditActionMemberPasswordUpdated,
+			check: func(t *testing.T, before, after *entity.Member) {
+				assert.Equal(t, "old", before.Password)
+				assert.Equal(t, "new", after.Password)
+			},
+		},
+		{
+			name: "delete records member.deleted without after",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(ctx, 1).Return(existing(), nil)
+				r.EXPECT().Delete(ctx, 1).Return(nil)
+			},
+			call: func(m *MemberUseCase) error {
+				_, err := m.DeleteMember(ctx, 1)
+				return err
+			},
+			wantAudit: output.AuditActionMemberDeleted,
+			check: func(t *testing.T, before, after *entity.Member) {
+				assert.Equal(t, existing(), before)
+				assert.Nil(t, after)
+			},
+		},
+		{
+			name: "audit failure does not fail the mutation",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(ctx, 1).Return(existing(), nil)
+				r.EXPECT().Delete(ctx, 1).Return(nil)
+			},
+			auditErr: ErrMemberAuditTrailError,
+			call: func(m *MemberUseCase) error {
+				_, err := m.DeleteMember(ctx, 1)
+				return err
+			},
+			wantAudit: output.AuditActionMemberDeleted,
+			check:     func(t *testing.T, before, after *entity.Member) {},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockMemberPersistence(ctrl)
+			mockAudit := mock.NewMockAuditTrail(ctrl)
+			m := &MemberUseCase{
+				MemberGateway: mockRepo,
+				auditTrail:    mockAudit,
+				logger:        mockLogger,
+				tracer:        mockTracer,
+			}
+			tt.repoSetup(mockRepo)
+			mockAudit.EXPECT().Record(ctx, tt.wantAudit, 1, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ output.AuditAction, _ int, before, after *entity.Member) error {
+					tt.check(t, before, after)
+					return tt.auditErr
+				}).Times(1)
+
+			err := tt.call(m)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestMemberUseCase_DomainEvents(t *testing.T) {
+	ctrl, ctx, testTime, mockLogger, mockTracer := repoHelper(t)
+	existing := func() *entity.Member {
+		return &entity.Member{ID: 1, Name: "ggg", Email: "gg@gmail.com", Password: "old", Status: entity.MemberStatusActive, CreatedAt: testTime}
+	}
+	tests := []struct {
+		name      string
+		repoSetup func(*mock.MockMemberPersistence)
+		outboxErr error
+		call      func(m *MemberUseCase) error
+		wantEvent entity.DomainEvent
+		wantErr   error
+	}{
+		{
+			name: "register adds MemberRegistered",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				r.EXPECT().GetByEmail(ctx, "gg@gmail.com").Return(existing(), nil)
+			},
+			call: func(m *MemberUseCase) error {
+				_, err := m.RegisterMember(ctx, &entity.Member{Name: "ggg", Email: "gg@gmail.com", Password: "old"}, "")
+				return err
+			},
+			wantEvent: entity.MemberRegistered{MemberID: 1, Name: "ggg", Email: "gg@gmail.com"},
+		},
+		{
+			name: "email update adds MemberEmailChanged",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByEmail(ctx, "new@gmail.com").Return(nil, ErrMemberNotFound)
+				r.EXPECT().GetByID(ctx, 1).Return(existing(), nil)
+				r.EXPECT().VerifyPassword(ctx, 1, "old").Return(true, nil)
+				r.EXPECT().UpdateEmail(ctx, 1, "new@gmail.com", "new@gmail.com").Return(nil)
+			},
+			call: func(m *MemberUseCase) error {
+				return m.UpdateMemberEmail(ctx, 1, "new@gmail.com", "old")
+			},
+			wantEvent: entity.MemberEmailChanged{MemberID: 1, OldEmail: "gg@gmail.com", NewEmail: "new@gmail.com"},
+		},
+		{
+			name: "delete adds MemberDeleted",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(ctx, 1).Return(existing(), nil)
+				r.EXPECT().Delete(ctx, 1).Return(nil)
+			},
+			call: func(m *MemberUseCase) error {
+				_, err := m.DeleteMember(ctx, 1)
+				return err
+			},
+			wantEvent: entity.MemberDeleted{MemberID: 1, Email: "gg@gmail.com"},
+		},
+		{
+			name: "outbox failure fails the mutation",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(ctx, 1).Return(existing(), nil)
+				r.EXPECT().Delete(ctx, 1).Return(nil)
+			},
+			outboxErr: ErrMemberEventOutboxError,
+			call: func(m *MemberUseCase) error {
+				_, err := m.DeleteMember(ctx, 1)
+				return err
+			},
+			wantEvent: entity.MemberDeleted{MemberID: 1, Email: "gg@gmail.com"},
+			wantErr:   ErrMemberEventOutboxError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockMemberPersistence(ctrl)
+			mockTx := mock.NewMockTransactionManager(ctrl)
+			mockOutbox := mock.NewMockEventOutbox(ctrl)
+			m := &MemberUseCase{
+				MemberGateway: mockRepo,
+				txManager:     mockTx,
+				eventOutbox:   mockOutbox,
+				logger:        mockLogger,
+				tracer:        mockTracer,
+			}
+			tt.repoSetup(mockRepo)
+			// 交易邊界只呼叫一次，錯誤原樣回傳讓呼叫端判斷
+			mockTx.EXPECT().WithinTransaction(ctx, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				}).Times(1)
+			mockOutbox.EXPECT().Add(ctx, gomock.Any()).DoAndReturn(
+				func(_ context.Context, events ...entity.DomainEvent) error {
+					assert.Len(t, events, 1)
+					assert.Equal(t, tt.wantEvent.EventName(), events[0].EventName())
+					assert.Equal(t, tt.wantEvent.AggregateID(), events[0].AggregateID())
+					assert.False(t, events[0].OccurredAt().IsZero())
+					switch e := events[0].(type) {
+					case entity.MemberRegistered:
+						assert.Equal(t, tt.wantEvent, entity.MemberRegistered{MemberID: e.MemberID, Name: e.Name, Email: e.Email})
+					case entity.MemberEmailChanged:
+						assert.Equal(t, tt.wantEvent, entity.MemberEmailChanged{MemberID: e.MemberID, OldEmail: e.OldEmail, NewEmail: e.NewEmail})
+					case entity.MemberDeleted:
+						assert.Equal(t, tt.wantEvent, entity.MemberDeleted{MemberID: e.MemberID, Email: e.Email})
+					}
+					return tt.outboxErr
+				}).Times(1)
+
+			err := tt.call(m)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMemberUseCase_ChangeNotification(t *testing.T) {
+	ctrl, ctx, testTime, mockLogger, mockTracer := repoHelper(t)
+	existing := func() *entity.Member {
+		return &entity.Member{ID: 1, Name: "ggg", Email: "gg@gmail.com", Password: "old", Status: entity.MemberStatusActive, CreatedAt: testTime}
+	}
+	newName := "hhh"
+	tests := []struct {
+		name       string
+		repoSetup  func(*mock.MockMemberPersistence)
+		call       func(m *MemberUseCase) error
+		wantType   output.ChangeType
+		wantMember *entity.Member
+	}{
+		{
+			name: "register publishes member.created",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				r.EXPECT().GetByEmail(ctx, "gg@gmail.com").Return(existing(), nil)
+			},
+			call: func(m *MemberUseCase) error {
+				_, err := m.RegisterMember(ctx, &entity.Member{Name: "ggg", Email: "gg@gmail.com", Password: "old"}, "")
+				return err
+			},
+			wantType:   output.ChangeTypeCreated,
+			wantMember: existing(),
+		},
+		{
+			name: "profile update publishes member.updated with new name",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(ctx, 1).Return(existing(), nil)
+				r.EXPECT().UpdateProfile(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, m *entity.Member) (*entity.Member, error) {
+					return m, nil
+				})
+			},
+			call: func(m *MemberUseCase) error {
+				_, err := m.UpdateMemberProfile(ctx, &inputmodel.PatchUpdateMemberProfileInputModel{ID: 1, Name: &newName})
+				return err
+			},
+			wantType:   output.ChangeTypeUpdated,
+			wantMember: &entity.Member{ID: 1, Name: "hhh", Email: "gg@gmail.com", Password: "old", Status: entity.MemberStatusActive, CreatedAt: testTime},
+		},
+		{
+			name: "email update publishes member.updated with new email",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByEmail(ctx, "new@gmail.com").Return(nil, ErrMemberNotFound)
+				r.EXPECT().GetByID(ctx, 1).Return(existing(), nil)
+				r.EXPECT().VerifyPassword(ctx, 1, "old").Return(true, nil)
+				r.EXPECT().UpdateEmail(ctx, 1, "new@gmail.com", "new@gmail.com").Return(nil)
+			},
+			call: func(m *MemberUseCase) error {
+				return m.UpdateMemberEmail(ctx, 1, "new@gmail.com", "old")
+			},
+			wantType:   output.ChangeTypeUpdated,
+			wantMember: &entity.Member{ID: 1, Name: "ggg", Email: "new@gmail.com", NormalizedEmail: "new@gmail.com", Password: "old", Status: entity.MemberStatusActive, CreatedAt: testTime},
+		},
+		{
+			name: "delete publishes member.deleted with deleted snapshot",
+			repoSetup: func(r *mock.MockMemberPersistence) {
+				r.EXPECT().GetByID(ctx, 1).Return(existing(), nil)
+				r.EXPECT().Delete(ctx, 1).Return(nil)
+			},
+			call: func(m *MemberUseCase) error {
+				_, err := m.DeleteMember(ctx, 1)
+				return err
+			},
+			wantType:   output.ChangeTypeDeleted,
+			wantMember: existing(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockMemberPersistence(ctrl)
+			mockFeed := mock.NewMockChangeFeed(ctrl)
+			m := &MemberUseCase{
+				MemberGateway: mockRepo,
+				changeFeed:    mockFeed,
+				logger:        mockLogger,
+				tracer:        mockTracer,
+			}
+			tt.repoSetup(mockRepo)
+			mockFeed.EXPECT().Publish(ctx, gomock.Any()).Do(func(_ context.Context, change output.MemberChange) {
+				assert.Equal(t, tt.wantType, change.Type)
+				assert.Equal(t, tt.wantMember, change.Member)
+				assert.False(t, change.At.IsZero())
+			}).Times(1)
+
+			err := tt.call(m)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestMemberUseCase_ChangeNotificationSkippedOnFailure(t *testing.T) {
+	ctrl, ctx, _, mockLogger, mockTracer := repoHelper(t)
+	mockRepo := mock.NewMockMemberPersistence(ctrl)
+	mockFeed := mock.NewMockChangeFeed(ctrl)
+	m := &MemberUseCase{
+		MemberGateway: mockRepo,
+		changeFeed:    mockFeed,
+		logger:        mockLogger,
+		tracer:        mockTracer,
+	}
+	mockRepo.EXPECT().GetByID(ctx, 1).Return(&entity.Member{ID: 1}, nil)
+	mockRepo.EXPECT().Delete(ctx, 1).Return(ErrMemberDBError)
+	mockFeed.EXPECT().Publish(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := m.DeleteMember(ctx, 1)
+	assert.ErrorIs(t, err, ErrMemberDBError)
+}
+
+func TestMemberUseCase_StreamMemberChanges(t *testing.T) {
+	ctrl, ctx, _, mockLogger, mockTracer := repoHelper(t)
+	tests := []struct {
+		name       string
+		withFeed   bool
+		feedErr    error
+		input      *inputmodel.StreamMemberChangesInputModel
+		wantFilter output.ChangeFilter
+		wantErr    error
+	}{
+		{
+			name:       "subscribes with converted filter",
+			withFeed:   true,
+			input:      &inputmodel.StreamMemberChangesInputModel{Types: []string{"member.created", "member.deleted"}, LastEventID: "42"},
+			wantFilter: output.ChangeFilter{Types: []output.ChangeType{output.ChangeTypeCreated, output.ChangeTypeDeleted}, LastEventID: "42"},
+		},
+		{
+			name:     "feed not configured",
+			withFeed: false,
+			input:    &inputmodel.StreamMemberChangesInputModel{},
+			wantErr:  ErrMemberChangeStreamUnavailable,
+		},
+		{
+			name:       "feed subscribe failure",
+			withFeed:   true,
+			feedErr:    errors.New("closed"),
+			input:      &inputmodel.StreamMemberChangesInputModel{},
+			wantFilter: output.ChangeFilter{},
+			wantErr:    ErrMemberChangeStreamUnavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &MemberUseCase{
+				logger: mockLogger,
+				tracer: mockTracer,
+			}
+			want := &output.ChangeSubscription{}
+			if tt.withFeed {
+				mockFeed := mock.NewMockChangeFeed(ctrl)
+				m.changeFeed = mockFeed
+				if tt.feedErr != nil {
+					want = nil
+				}
+				mockFeed.EXPECT().Subscribe(ctx, tt.wantFilter).Return(want, tt.feedErr).Times(1)
+			}
+
+			got, err := m.StreamMemberChanges(ctx, tt.input)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Same(t, want, got)
+		})
+	}
 }
 
 func repoHelper(t *testing.T) (*gomock.Controller, context.Context, time.Time, *mocklogger.MockLogger, *mocktracer.MockTracer) {
@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: member_audit_trail.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	output "github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
+)
+
+// MockAuditTrail is a mock of AuditTrail interface.
+type MockAuditTrail struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditTrailMockRecorder
+}
+
+// MockAuditTrailMockRecorder is the mock recorder for MockAuditTrail.
+type MockAuditTrailMockRecorder struct {
+	mock *MockAuditTrail
+}
+
+// NewMockAuditTrail creates a new mock instance.
+func NewMockAuditTrail(ctrl *gomock.Controller) *MockAuditTrail {
+	mock := &MockAuditTrail{ctrl: ctrl}
+	mock.recorder = &MockAuditTrailMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditTrail) EXPECT() *MockAuditTrailMockRecorder {
+	return m.recorder
+}
+
+// ListByMember mocks base method.
+func (m *MockAuditTrail) ListByMember(ctx context.Context, memberID int) ([]output.AuditRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByMember", ctx, memberID)
+	ret0, _ := ret[0].([]output.AuditRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByMember indicates an expected call of ListByMember.
+func (mr *MockAuditTrailMockRecorder) ListByMember(ctx, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByMember", reflect.TypeOf((*MockAuditTrail)(nil).ListByMember), ctx, memberID)
+}
+
+// Record mocks base method.
+func (m *MockAuditTrail) Record(ctx context.Context, action output.AuditAction, memberID int, before, after *entity.Member) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", ctx, action, memberID, before, after)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockAuditTrailMockRecorder) Record(ctx, action, memberID, before, after interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockAuditTrail)(nil).Record), ctx, action, memberID, before, after)
+}
+
+// RedactMember mocks base method.
+func (m *MockAuditTrail) RedactMember(ctx context.Context, memberID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedactMember", ctx, memberID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RedactMember indicates an expected call of RedactMember.
+func (mr *MockAuditTrailMockRecorder) RedactMember(ctx, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedactMember", reflect.TypeOf((*MockAuditTrail)(nil).RedactMember), ctx, memberID)
+}
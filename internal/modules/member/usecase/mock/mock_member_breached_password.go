@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: member_breached_password.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockBreachedPasswordChecker is a mock of BreachedPasswordChecker interface.
+type MockBreachedPasswordChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockBreachedPasswordCheckerMockRecorder
+}
+
+// MockBreachedPasswordCheckerMockRecorder is the mock recorder for MockBreachedPasswordChecker.
+type MockBreachedPasswordCheckerMockRecorder struct {
+	mock *MockBreachedPasswordChecker
+}
+
+// NewMockBreachedPasswordChecker creates a new mock instance.
+func NewMockBreachedPasswordChecker(ctrl *gomock.Controller) *MockBreachedPasswordChecker {
+	mock := &MockBreachedPasswordChecker{ctrl: ctrl}
+	mock.recorder = &MockBreachedPasswordCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBreachedPasswordChecker) EXPECT() *MockBreachedPasswordCheckerMockRecorder {
+	return m.recorder
+}
+
+// IsBreached mocks base method.
+func (m *MockBreachedPasswordChecker) IsBreached(ctx context.Context, password string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBreached", ctx, password)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsBreached indicates an expected call of IsBreached.
+func (mr *MockBreachedPasswordCheckerMockRecorder) IsBreached(ctx, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBreached", reflect.TypeOf((*MockBreachedPasswordChecker)(nil).IsBreached), ctx, password)
+}
@@ -0,0 +1,63 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: member_change_feed.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	output "github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
+)
+
+// MockChangeFeed is a mock of ChangeFeed interface.
+type MockChangeFeed struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangeFeedMockRecorder
+}
+
+// MockChangeFeedMockRecorder is the mock recorder for MockChangeFeed.
+type MockChangeFeedMockRecorder struct {
+	mock *MockChangeFeed
+}
+
+// NewMockChangeFeed creates a new mock instance.
+func NewMockChangeFeed(ctrl *gomock.Controller) *MockChangeFeed {
+	mock := &MockChangeFeed{ctrl: ctrl}
+	mock.recorder = &MockChangeFeedMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangeFeed) EXPECT() *MockChangeFeedMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method.
+func (m *MockChangeFeed) Publish(ctx context.Context, change output.MemberChange) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Publish", ctx, change)
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockChangeFeedMockRecorder) Publish(ctx, change interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockChangeFeed)(nil).Publish), ctx, change)
+}
+
+// Subscribe mocks base method.
+func (m *MockChangeFeed) Subscribe(ctx context.Context, filter output.ChangeFilter) (*output.ChangeSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx, filter)
+	ret0, _ := ret[0].(*output.ChangeSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockChangeFeedMockRecorder) Subscribe(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockChangeFeed)(nil).Subscribe), ctx, filter)
+}
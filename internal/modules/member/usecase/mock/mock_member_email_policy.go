@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: member_email_policy.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockEmailPolicy is a mock of EmailPolicy interface.
+type MockEmailPolicy struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmailPolicyMockRecorder
+}
+
+// MockEmailPolicyMockRecorder is the mock recorder for MockEmailPolicy.
+type MockEmailPolicyMockRecorder struct {
+	mock *MockEmailPolicy
+}
+
+// NewMockEmailPolicy creates a new mock instance.
+func NewMockEmailPolicy(ctrl *gomock.Controller) *MockEmailPolicy {
+	mock := &MockEmailPolicy{ctrl: ctrl}
+	mock.recorder = &MockEmailPolicyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmailPolicy) EXPECT() *MockEmailPolicyMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockEmailPolicy) Check(ctx context.Context, domain string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", ctx, domain)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockEmailPolicyMockRecorder) Check(ctx, domain interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockEmailPolicy)(nil).Check), ctx, domain)
+}
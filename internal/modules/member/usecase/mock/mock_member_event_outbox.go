@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: member_event_outbox.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+)
+
+// MockEventOutbox is a mock of EventOutbox interface.
+type MockEventOutbox struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventOutboxMockRecorder
+}
+
+// MockEventOutboxMockRecorder is the mock recorder for MockEventOutbox.
+type MockEventOutboxMockRecorder struct {
+	mock *MockEventOutbox
+}
+
+// NewMockEventOutbox creates a new mock instance.
+func NewMockEventOutbox(ctrl *gomock.Controller) *MockEventOutbox {
+	mock := &MockEventOutbox{ctrl: ctrl}
+	mock.recorder = &MockEventOutboxMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventOutbox) EXPECT() *MockEventOutboxMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockEventOutbox) Add(ctx context.Context, events ...entity.DomainEvent) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range events {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Add", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockEventOutboxMockRecorder) Add(ctx interface{}, events ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, events...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockEventOutbox)(nil).Add), varargs...)
+}
+
+// RedactMember mocks base method.
+func (m *MockEventOutbox) RedactMember(ctx context.Context, memberID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedactMember", ctx, memberID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RedactMember indicates an expected call of RedactMember.
+func (mr *MockEventOutboxMockRecorder) RedactMember(ctx, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedactMember", reflect.TypeOf((*MockEventOutbox)(nil).RedactMember), ctx, memberID)
+}
@@ -0,0 +1,141 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: member_invitation.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	output "github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
+	pagination "github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+)
+
+// MockInvitationPersistence is a mock of InvitationPersistence interface.
+type MockInvitationPersistence struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvitationPersistenceMockRecorder
+}
+
+// MockInvitationPersistenceMockRecorder is the mock recorder for MockInvitationPersistence.
+type MockInvitationPersistenceMockRecorder struct {
+	mock *MockInvitationPersistence
+}
+
+// NewMockInvitationPersistence creates a new mock instance.
+func NewMockInvitationPersistence(ctrl *gomock.Controller) *MockInvitationPersistence {
+	mock := &MockInvitationPersistence{ctrl: ctrl}
+	mock.recorder = &MockInvitationPersistenceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvitationPersistence) EXPECT() *MockInvitationPersistenceMockRecorder {
+	return m.recorder
+}
+
+// CountAll mocks base method.
+func (m *MockInvitationPersistence) CountAll(ctx context.Context, filter output.InvitationFilter) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAll", ctx, filter)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAll indicates an expected call of CountAll.
+func (mr *MockInvitationPersistenceMockRecorder) CountAll(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAll", reflect.TypeOf((*MockInvitationPersistence)(nil).CountAll), ctx, filter)
+}
+
+// Create mocks base method.
+func (m *MockInvitationPersistence) Create(ctx context.Context, invitation *entity.Invitation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, invitation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockInvitationPersistenceMockRecorder) Create(ctx, invitation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockInvitationPersistence)(nil).Create), ctx, invitation)
+}
+
+// GetAll mocks base method.
+func (m *MockInvitationPersistence) GetAll(ctx context.Context, filter output.InvitationFilter, pagination pagination.Pagination) ([]*entity.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, filter, pagination)
+	ret0, _ := ret[0].([]*entity.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockInvitationPersistenceMockRecorder) GetAll(ctx, filter, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockInvitationPersistence)(nil).GetAll), ctx, filter, pagination)
+}
+
+// GetByCode mocks base method.
+func (m *MockInvitationPersistence) GetByCode(ctx context.Context, code string) (*entity.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCode", ctx, code)
+	ret0, _ := ret[0].(*entity.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCode indicates an expected call of GetByCode.
+func (mr *MockInvitationPersistenceMockRecorder) GetByCode(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCode", reflect.TypeOf((*MockInvitationPersistence)(nil).GetByCode), ctx, code)
+}
+
+// GetByID mocks base method.
+func (m *MockInvitationPersistence) GetByID(ctx context.Context, id int) (*entity.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockInvitationPersistenceMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockInvitationPersistence)(nil).GetByID), ctx, id)
+}
+
+// Redeem mocks base method.
+func (m *MockInvitationPersistence) Redeem(ctx context.Context, id, uses int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeem", ctx, id, uses)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Redeem indicates an expected call of Redeem.
+func (mr *MockInvitationPersistenceMockRecorder) Redeem(ctx, id, uses interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeem", reflect.TypeOf((*MockInvitationPersistence)(nil).Redeem), ctx, id, uses)
+}
+
+// Revoke mocks base method.
+func (m *MockInvitationPersistence) Revoke(ctx context.Context, id int, revokedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, id, revokedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockInvitationPersistenceMockRecorder) Revoke(ctx, id, revokedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockInvitationPersistence)(nil).Revoke), ctx, id, revokedAt)
+}
@@ -10,6 +10,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	entity "github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	output "github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
 	pagination "github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
 )
 
@@ -36,19 +37,48 @@ func (m *MockMemberPersistence) EXPECT() *MockMemberPersistenceMockRecorder {
 	return m.recorder
 }
 
+// AddTag mocks base method.
+func (m *MockMemberPersistence) AddTag(ctx context.Context, memberID int, tag string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTag", ctx, memberID, tag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTag indicates an expected call of AddTag.
+func (mr *MockMemberPersistenceMockRecorder) AddTag(ctx, memberID, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTag", reflect.TypeOf((*MockMemberPersistence)(nil).AddTag), ctx, memberID, tag)
+}
+
+// AddTagToMembers mocks base method.
+func (m *MockMemberPersistence) AddTagToMembers(ctx context.Context, memberIDs []int, tag string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTagToMembers", ctx, memberIDs, tag)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTagToMembers indicates an expected call of AddTagToMembers.
+func (mr *MockMemberPersistenceMockRecorder) AddTagToMembers(ctx, memberIDs, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTagToMembers", reflect.TypeOf((*MockMemberPersistence)(nil).AddTagToMembers), ctx, memberIDs, tag)
+}
+
 // CountAll mocks base method.
-func (m *MockMemberPersistence) CountAll(ctx context.Context) (int, error) {
+func (m *MockMemberPersistence) CountAll(ctx context.Context, filter output.MemberFilter) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CountAll", ctx)
+	ret := m.ctrl.Call(m, "CountAll", ctx, filter)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CountAll indicates an expected call of CountAll.
-func (mr *MockMemberPersistenceMockRecorder) CountAll(ctx interface{}) *gomock.Call {
+func (mr *MockMemberPersistenceMockRecorder) CountAll(ctx, filter interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAll", reflect.TypeOf((*MockMemberPersistence)(nil).CountAll), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAll", reflect.TypeOf((*MockMemberPersistence)(nil).CountAll), ctx, filter)
 }
 
 // Create mocks base method.
@@ -80,33 +110,33 @@ func (mr *MockMemberPersistenceMockRecorder) Delete(ctx, id interface{}) *gomock
 }
 
 // GetAll mocks base method.
-func (m *MockMemberPersistence) GetAll(ctx context.Context, pagination pagination.Pagination) ([]*entity.Member, error) {
+func (m *MockMemberPersistence) GetAll(ctx context.Context, filter output.MemberFilter, pagination pagination.Pagination) ([]*entity.Member, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", ctx, pagination)
+	ret := m.ctrl.Call(m, "GetAll", ctx, filter, pagination)
 	ret0, _ := ret[0].([]*entity.Member)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockMemberPersistenceMockRecorder) GetAll(ctx, pagination interface{}) *gomock.Call {
+func (mr *MockMemberPersistenceMockRecorder) GetAll(ctx, filter, pagination interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockMemberPersistence)(nil).GetAll), ctx, pagination)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockMemberPersistence)(nil).GetAll), ctx, filter, pagination)
 }
 
 // GetByEmail mocks base method.
-func (m *MockMemberPersistence) GetByEmail(ctx context.Context, email string) (*entity.Member, error) {
+func (m *MockMemberPersistence) GetByEmail(ctx context.Context, normalizedEmail string) (*entity.Member, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByEmail", ctx, email)
+	ret := m.ctrl.Call(m, "GetByEmail", ctx, normalizedEmail)
 	ret0, _ := ret[0].(*entity.Member)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByEmail indicates an expected call of GetByEmail.
-func (mr *MockMemberPersistenceMockRecorder) GetByEmail(ctx, email interface{}) *gomock.Call {
+func (mr *MockMemberPersistenceMockRecorder) GetByEmail(ctx, normalizedEmail interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEmail", reflect.TypeOf((*MockMemberPersistence)(nil).GetByEmail), ctx, email)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEmail", reflect.TypeOf((*MockMemberPersistence)(nil).GetByEmail), ctx, normalizedEmail)
 }
 
 // GetByID mocks base method.
@@ -124,18 +154,91 @@ func (mr *MockMemberPersistenceMockRecorder) GetByID(ctx, id interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockMemberPersistence)(nil).GetByID), ctx, id)
 }
 
+// ListTags mocks base method.
+func (m *MockMemberPersistence) ListTags(ctx context.Context, memberID int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTags", ctx, memberID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTags indicates an expected call of ListTags.
+func (mr *MockMemberPersistenceMockRecorder) ListTags(ctx, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTags", reflect.TypeOf((*MockMemberPersistence)(nil).ListTags), ctx, memberID)
+}
+
+// MarkMerged mocks base method.
+func (m *MockMemberPersistence) MarkMerged(ctx context.Context, sourceID, targetID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkMerged", ctx, sourceID, targetID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkMerged indicates an expected call of MarkMerged.
+func (mr *MockMemberPersistenceMockRecorder) MarkMerged(ctx, sourceID, targetID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkMerged", reflect.TypeOf((*MockMemberPersistence)(nil).MarkMerged), ctx, sourceID, targetID)
+}
+
+// MergeTags mocks base method.
+func (m *MockMemberPersistence) MergeTags(ctx context.Context, sourceID, targetID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeTags", ctx, sourceID, targetID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeTags indicates an expected call of MergeTags.
+func (mr *MockMemberPersistenceMockRecorder) MergeTags(ctx, sourceID, targetID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeTags", reflect.TypeOf((*MockMemberPersistence)(nil).MergeTags), ctx, sourceID, targetID)
+}
+
+// RemoveTag mocks base method.
+func (m *MockMemberPersistence) RemoveTag(ctx context.Context, memberID int, tag string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTag", ctx, memberID, tag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveTag indicates an expected call of RemoveTag.
+func (mr *MockMemberPersistenceMockRecorder) RemoveTag(ctx, memberID, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTag", reflect.TypeOf((*MockMemberPersistence)(nil).RemoveTag), ctx, memberID, tag)
+}
+
 // UpdateEmail mocks base method.
-func (m *MockMemberPersistence) UpdateEmail(ctx context.Context, id int, newEmail string) error {
+func (m *MockMemberPersistence) UpdateEmail(ctx context.Context, id int, newEmail, normalizedEmail string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateEmail", ctx, id, newEmail)
+	ret := m.ctrl.Call(m, "UpdateEmail", ctx, id, newEmail, normalizedEmail)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateEmail indicates an expected call of UpdateEmail.
-func (mr *MockMemberPersistenceMockRecorder) UpdateEmail(ctx, id, newEmail interface{}) *gomock.Call {
+func (mr *MockMemberPersistenceMockRecorder) UpdateEmail(ctx, id, newEmail, normalizedEmail interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmail", reflect.TypeOf((*MockMemberPersistence)(nil).UpdateEmail), ctx, id, newEmail)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmail", reflect.TypeOf((*MockMemberPersistence)(nil).UpdateEmail), ctx, id, newEmail, normalizedEmail)
+}
+
+// UpdateNormalizedEmail mocks base method.
+func (m *MockMemberPersistence) UpdateNormalizedEmail(ctx context.Context, id int, normalizedEmail string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNormalizedEmail", ctx, id, normalizedEmail)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNormalizedEmail indicates an expected call of UpdateNormalizedEmail.
+func (mr *MockMemberPersistenceMockRecorder) UpdateNormalizedEmail(ctx, id, normalizedEmail interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNormalizedEmail", reflect.TypeOf((*MockMemberPersistence)(nil).UpdateNormalizedEmail), ctx, id, normalizedEmail)
 }
 
 // UpdatePassword mocks base method.
@@ -166,3 +269,32 @@ func (mr *MockMemberPersistenceMockRecorder) UpdateProfile(ctx, m interface{}) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProfile", reflect.TypeOf((*MockMemberPersistence)(nil).UpdateProfile), ctx, m)
 }
+
+// UpdateStatus mocks base method.
+func (m *MockMemberPersistence) UpdateStatus(ctx context.Context, id int, from, to entity.MemberStatus, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, id, from, to, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockMemberPersistenceMockRecorder) UpdateStatus(ctx, id, from, to, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockMemberPersistence)(nil).UpdateStatus), ctx, id, from, to, reason)
+}
+
+// VerifyPassword mocks base method.
+func (m *MockMemberPersistence) VerifyPassword(ctx context.Context, id int, password string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyPassword", ctx, id, password)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyPassword indicates an expected call of VerifyPassword.
+func (mr *MockMemberPersistenceMockRecorder) VerifyPassword(ctx, id, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyPassword", reflect.TypeOf((*MockMemberPersistence)(nil).VerifyPassword), ctx, id, password)
+}
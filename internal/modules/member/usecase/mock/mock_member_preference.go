@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: member_preference.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+)
+
+// MockPreferencePersistence is a mock of PreferencePersistence interface.
+type MockPreferencePersistence struct {
+	ctrl     *gomock.Controller
+	recorder *MockPreferencePersistenceMockRecorder
+}
+
+// MockPreferencePersistenceMockRecorder is the mock recorder for MockPreferencePersistence.
+type MockPreferencePersistenceMockRecorder struct {
+	mock *MockPreferencePersistence
+}
+
+// NewMockPreferencePersistence creates a new mock instance.
+func NewMockPreferencePersistence(ctrl *gomock.Controller) *MockPreferencePersistence {
+	mock := &MockPreferencePersistence{ctrl: ctrl}
+	mock.recorder = &MockPreferencePersistenceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPreferencePersistence) EXPECT() *MockPreferencePersistenceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockPreferencePersistence) Delete(ctx context.Context, memberID int, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, memberID, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPreferencePersistenceMockRecorder) Delete(ctx, memberID, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPreferencePersistence)(nil).Delete), ctx, memberID, key)
+}
+
+// DeleteByMember mocks base method.
+func (m *MockPreferencePersistence) DeleteByMember(ctx context.Context, memberID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByMember", ctx, memberID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteByMember indicates an expected call of DeleteByMember.
+func (mr *MockPreferencePersistenceMockRecorder) DeleteByMember(ctx, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByMember", reflect.TypeOf((*MockPreferencePersistence)(nil).DeleteByMember), ctx, memberID)
+}
+
+// GetByMember mocks base method.
+func (m *MockPreferencePersistence) GetByMember(ctx context.Context, memberID int) ([]entity.Preference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByMember", ctx, memberID)
+	ret0, _ := ret[0].([]entity.Preference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByMember indicates an expected call of GetByMember.
+func (mr *MockPreferencePersistenceMockRecorder) GetByMember(ctx, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByMember", reflect.TypeOf((*MockPreferencePersistence)(nil).GetByMember), ctx, memberID)
+}
+
+// Save mocks base method.
+func (m *MockPreferencePersistence) Save(ctx context.Context, memberID int, preference entity.Preference) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, memberID, preference)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockPreferencePersistenceMockRecorder) Save(ctx, memberID, preference interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockPreferencePersistence)(nil).Save), ctx, memberID, preference)
+}
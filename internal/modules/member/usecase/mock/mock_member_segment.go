@@ -0,0 +1,125 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: member_segment.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	pagination "github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+)
+
+// MockSegmentPersistence is a mock of SegmentPersistence interface.
+type MockSegmentPersistence struct {
+	ctrl     *gomock.Controller
+	recorder *MockSegmentPersistenceMockRecorder
+}
+
+// MockSegmentPersistenceMockRecorder is the mock recorder for MockSegmentPersistence.
+type MockSegmentPersistenceMockRecorder struct {
+	mock *MockSegmentPersistence
+}
+
+// NewMockSegmentPersistence creates a new mock instance.
+func NewMockSegmentPersistence(ctrl *gomock.Controller) *MockSegmentPersistence {
+	mock := &MockSegmentPersistence{ctrl: ctrl}
+	mock.recorder = &MockSegmentPersistenceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSegmentPersistence) EXPECT() *MockSegmentPersistenceMockRecorder {
+	return m.recorder
+}
+
+// CountAll mocks base method.
+func (m *MockSegmentPersistence) CountAll(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAll", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAll indicates an expected call of CountAll.
+func (mr *MockSegmentPersistenceMockRecorder) CountAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAll", reflect.TypeOf((*MockSegmentPersistence)(nil).CountAll), ctx)
+}
+
+// Create mocks base method.
+func (m *MockSegmentPersistence) Create(ctx context.Context, segment *entity.Segment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, segment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSegmentPersistenceMockRecorder) Create(ctx, segment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSegmentPersistence)(nil).Create), ctx, segment)
+}
+
+// Delete mocks base method.
+func (m *MockSegmentPersistence) Delete(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSegmentPersistenceMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSegmentPersistence)(nil).Delete), ctx, id)
+}
+
+// GetAll mocks base method.
+func (m *MockSegmentPersistence) GetAll(ctx context.Context, pagination pagination.Pagination) ([]*entity.Segment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, pagination)
+	ret0, _ := ret[0].([]*entity.Segment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockSegmentPersistenceMockRecorder) GetAll(ctx, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockSegmentPersistence)(nil).GetAll), ctx, pagination)
+}
+
+// GetByID mocks base method.
+func (m *MockSegmentPersistence) GetByID(ctx context.Context, id int) (*entity.Segment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Segment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockSegmentPersistenceMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockSegmentPersistence)(nil).GetByID), ctx, id)
+}
+
+// GetByName mocks base method.
+func (m *MockSegmentPersistence) GetByName(ctx context.Context, name string) (*entity.Segment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByName", ctx, name)
+	ret0, _ := ret[0].(*entity.Segment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByName indicates an expected call of GetByName.
+func (mr *MockSegmentPersistenceMockRecorder) GetByName(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByName", reflect.TypeOf((*MockSegmentPersistence)(nil).GetByName), ctx, name)
+}
@@ -5,16 +5,60 @@ import (
 	"context"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
 	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/inputmodel"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/usecase/port/output"
 	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
 )
 
 type MemberInputPort interface {
-	RegisterMember(ctx context.Context, member *entity.Member) (*entity.Member, error)
+	// RegisterMember 僅限邀請註冊時 invitationCode 必填，有帶邀請碼時新會員記錄推薦人
+	RegisterMember(ctx context.Context, member *entity.Member, invitationCode string) (*entity.Member, error)
 	GetMemberByID(ctx context.Context, id int) (*entity.Member, error)
 	GetMemberByEmail(ctx context.Context, email string) (*entity.Member, error)
-	ListMembers(ctx context.Context, pagination pagination.Pagination) ([]*entity.Member, int, error)
+	ListMembers(ctx context.Context, input *inputmodel.ListMembersInputModel, pagination pagination.Pagination) ([]*entity.Member, int, error)
 	UpdateMemberProfile(ctx context.Context, patch *inputmodel.PatchUpdateMemberProfileInputModel) (*entity.Member, error)
 	UpdateMemberEmail(ctx context.Context, id int, newEmail, password string) error
 	UpdateMemberPassword(ctx context.Context, id int, oldPassword, newPassword string) error
 	DeleteMember(ctx context.Context, id int) (*entity.Member, error)
+	// ChangeMemberStatus 依狀態機變更會員狀態（啟用、停權、封鎖、恢復），僅限狀態管理者
+	ChangeMemberStatus(ctx context.Context, input *inputmodel.ChangeMemberStatusInputModel) (*entity.Member, error)
+	// MergeMembers 將重複帳號合併到保留的會員，Preview 時只回報會搬移的資料，僅限狀態管理者
+	MergeMembers(ctx context.Context, input *inputmodel.MergeMembersInputModel) (*output.MergeResult, error)
+	// CreateInvitation 建立註冊邀請碼，僅限狀態管理者或可登入的會員
+	CreateInvitation(ctx context.Context, input *inputmodel.CreateInvitationInputModel) (*entity.Invitation, error)
+	// ListInvitations 列出邀請碼，狀態管理者看全部，其他人只看自己建立的
+	ListInvitations(ctx context.Context, pagination pagination.Pagination) ([]*entity.Invitation, int, error)
+	// RevokeInvitation 撤銷邀請碼，僅限狀態管理者或建立者
+	RevokeInvitation(ctx context.Context, id int) (*entity.Invitation, error)
+	// TagMember 為會員加上標籤，已有此標籤時直接回傳，僅限狀態管理者
+	TagMember(ctx context.Context, id int, tag string) (*entity.Member, error)
+	// UntagMember 移除會員的標籤，沒有此標籤時直接回傳，僅限狀態管理者
+	UntagMember(ctx context.Context, id int, tag string) (*entity.Member, error)
+	// BulkTagMembers 為多位會員加上同一個標籤，回傳新加上的數量，僅限狀態管理者
+	BulkTagMembers(ctx context.Context, input *inputmodel.BulkTagMembersInputModel) (int, error)
+	// CreateSegment 儲存會員分群條件，僅限狀態管理者
+	CreateSegment(ctx context.Context, input *inputmodel.CreateSegmentInputModel) (*entity.Segment, error)
+	// ListSegments 列出儲存的分群，僅限狀態管理者
+	ListSegments(ctx context.Context, pagination pagination.Pagination) ([]*entity.Segment, int, error)
+	// EvaluateSegment 依分群條件即時查詢會員，僅限狀態管理者
+	EvaluateSegment(ctx context.Context, id int, pagination pagination.Pagination) ([]*entity.Member, int, error)
+	// ExportSegment 匯出分群目前符合條件的所有會員，僅限狀態管理者
+	ExportSegment(ctx context.Context, id int) (*output.SegmentExport, error)
+	// DeleteSegment 刪除分群，僅限狀態管理者
+	DeleteSegment(ctx context.Context, id int) (*entity.Segment, error)
+	// GetMemberPreferences 查詢會員偏好設定，未設定的 key 為預設值，僅限本人或個資管理者
+	GetMemberPreferences(ctx context.Context, id int) (*entity.MemberPreferences, error)
+	// UpdateMemberPreferences 以 JSON merge patch 更新會員偏好設定，僅限本人或個資管理者
+	UpdateMemberPreferences(ctx context.Context, input *inputmodel.PatchMemberPreferencesInputModel) (*entity.MemberPreferences, error)
+	// StreamMemberChanges 訂閱已提交的會員異動，呼叫端結束時須呼叫 Close
+	StreamMemberChanges(ctx context.Context, input *inputmodel.StreamMemberChangesInputModel) (*output.ChangeSubscription, error)
+	// ExportPersonalData 匯出會員個資，僅限本人或個資管理者
+	ExportPersonalData(ctx context.Context, id int) (*output.PersonalDataArchive, error)
+	// ErasePersonalData 不可逆地匿名化會員個資，僅限本人或個資管理者，可重複呼叫
+	ErasePersonalData(ctx context.Context, id int) (*output.ErasureResult, error)
+	// IssueMemberToken 以 Email 與密碼驗證會員並簽發 access token，僅限 active 且未被合併的會員
+	IssueMemberToken(ctx context.Context, email, password string) (*output.AccessToken, error)
+	// AuthenticateMember 確認會員目前仍可通過身分驗證（驗證 token 時呼叫），不可時回傳 ErrMemberNotActive 或 ErrMemberMerged
+	AuthenticateMember(ctx context.Context, id int) (*entity.Member, error)
+	// BackfillNormalizedEmails 依目前的正規化規則重算所有會員的正規化 Email，衝突列在回傳結果中
+	BackfillNormalizedEmails(ctx context.Context) (*output.EmailBackfillReport, error)
 }
@@ -0,0 +1,53 @@
+package output
+
+//go:generate mockgen -source=member_audit_trail.go -destination=../../mock/mock_member_audit_trail.go -package=mock
+import (
+	"context"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"time"
+)
+
+// AuditAction 會員異動的稽核動作
+type AuditAction string
+
+const (
+	AuditActionMemberRegistered      AuditAction = "member.registered"
+	AuditActionMemberProfileUpdated  AuditAction = "member.profile_updated"
+	AuditActionMemberEmailUpdated    AuditAction = "member.email_updated"
+	AuditActionMemberPasswordUpdated AuditAction = "member.password_updated"
+	AuditActionMemberDeleted         AuditAction = "member.deleted"
+	AuditActionMemberStatusChanged   AuditAction = "member.status_changed"
+	AuditActionMemberMerged          AuditAction = "member.merged"
+	// 個資匯出與刪除只記錄動作本身，不帶欄位異動，避免把個資再寫回稽核紀錄
+	AuditActionMemberPersonalDataExported AuditAction = "member.personal_data_exported"
+	AuditActionMemberPersonalDataErased   AuditAction = "member.personal_data_erased"
+)
+
+// AuditRecord 一筆會員相關的稽核紀錄，供個資匯出使用
+type AuditRecord struct {
+	ID        int
+	Actor     string
+	Action    string
+	Changes   map[string]AuditFieldChange
+	RequestID string
+	TraceID   string
+	IP        string
+	CreatedAt time.Time
+}
+
+// AuditFieldChange 單一欄位的異動前後值
+type AuditFieldChange struct {
+	Before any
+	After  any
+}
+
+// AuditTrail 記錄會員異動的稽核軌跡
+//   - before 為 nil 表示新增，after 為 nil 表示刪除
+//   - 實作端負責補上 actor、request id、trace id、IP 與時間，並遮罩敏感欄位
+//   - ListByMember 回傳該會員的全部稽核紀錄（新到舊）
+//   - RedactMember 遮蔽該會員稽核紀錄中的個資欄位，回傳實際改寫的筆數，可重複呼叫
+type AuditTrail interface {
+	Record(ctx context.Context, action AuditAction, memberID int, before, after *entity.Member) error
+	ListByMember(ctx context.Context, memberID int) ([]AuditRecord, error)
+	RedactMember(ctx context.Context, memberID int) (int, error)
+}
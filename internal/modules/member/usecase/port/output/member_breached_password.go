@@ -0,0 +1,10 @@
+package output
+
+//go:generate mockgen -source=member_breached_password.go -destination=../../mock/mock_member_breached_password.go -package=mock
+import "context"
+
+// BreachedPasswordChecker 檢查密碼是否出現在已知外洩密碼清單
+type BreachedPasswordChecker interface {
+	// IsBreached 回傳錯誤表示清單無法讀取，呼叫端不應據此拒絕密碼
+	IsBreached(ctx context.Context, password string) (bool, error)
+}
@@ -0,0 +1,56 @@
+package output
+
+//go:generate mockgen -source=member_change_feed.go -destination=../../mock/mock_member_change_feed.go -package=mock
+import (
+	"context"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"time"
+)
+
+// ChangeType 會員異動通知類型
+type ChangeType string
+
+const (
+	ChangeTypeCreated ChangeType = "member.created"
+	ChangeTypeUpdated ChangeType = "member.updated"
+	ChangeTypeDeleted ChangeType = "member.deleted"
+)
+
+// MemberChange 已提交的會員異動，Member 為異動後（刪除時為刪除前）的快照
+type MemberChange struct {
+	Type   ChangeType
+	Member *entity.Member
+	At     time.Time
+}
+
+// ChangeEvent 帶序號的會員異動，ID 供客戶端斷線後以 Last-Event-ID 續接
+type ChangeEvent struct {
+	ID string
+	MemberChange
+}
+
+// ChangeFilter 訂閱條件
+//   - Types 為空表示訂閱全部類型
+//   - LastEventID 為客戶端最後收到的事件 ID，空字串表示不補送
+type ChangeFilter struct {
+	Types       []ChangeType
+	LastEventID string
+}
+
+// ChangeSubscription 會員異動訂閱
+type ChangeSubscription struct {
+	// Replay 依 LastEventID 補送的歷史異動
+	Replay []ChangeEvent
+	// Gap 為 true 表示 LastEventID 已不在 replay buffer 內，客戶端需重新取得完整資料
+	Gap bool
+	// Events 即時異動；消費過慢被踢除或 feed 關閉時會被 close
+	Events <-chan ChangeEvent
+	// Close 取消訂閱，可重複呼叫
+	Close func()
+}
+
+// ChangeFeed 會員異動通知：異動提交後由 UseCase 發佈，串流端點透過 Subscribe 訂閱
+type ChangeFeed interface {
+	Publish(ctx context.Context, change MemberChange)
+	Subscribe(ctx context.Context, filter ChangeFilter) (*ChangeSubscription, error)
+}
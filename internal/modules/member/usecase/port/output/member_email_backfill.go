@@ -0,0 +1,21 @@
+package output
+
+// EmailBackfillReport 正規化 Email 回填結果
+//   - Scanned 掃描的會員數，Updated 為實際改寫 normalized_email 的筆數
+//   - Conflicts 正規化後與其他會員相同、無法寫入的會員，需人工合併或更換 Email
+//   - InvalidMemberIDs 現有 Email 無法正規化的會員
+type EmailBackfillReport struct {
+	Scanned          int
+	Updated          int
+	Conflicts        []EmailConflict
+	InvalidMemberIDs []int
+}
+
+// EmailConflict 回填時的正規化 Email 衝突
+type EmailConflict struct {
+	MemberID        int
+	Email           string
+	NormalizedEmail string
+	// HolderMemberID 目前持有該正規化 Email 的會員，查詢失敗時為 0
+	HolderMemberID int
+}
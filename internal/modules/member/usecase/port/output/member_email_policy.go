@@ -0,0 +1,38 @@
+package output
+
+//go:generate mockgen -source=member_email_policy.go -destination=../../mock/mock_member_email_policy.go -package=mock
+import (
+	"context"
+	"fmt"
+)
+
+// EmailPolicyReason Email 網域被拒絕的原因
+type EmailPolicyReason string
+
+const (
+	// EmailPolicyReasonNotAllowed allowlist 模式下網域不在 allowlist 中
+	EmailPolicyReasonNotAllowed EmailPolicyReason = "not_allowed"
+	// EmailPolicyReasonBlocked 網域在 blocklist 中
+	EmailPolicyReasonBlocked EmailPolicyReason = "blocked"
+	// EmailPolicyReasonDisposable 網域為拋棄式信箱
+	EmailPolicyReasonDisposable EmailPolicyReason = "disposable"
+	// EmailPolicyReasonNoMailServer 網域沒有可收信的 MX/A 紀錄
+	EmailPolicyReasonNoMailServer EmailPolicyReason = "no_mail_server"
+)
+
+// EmailPolicyViolation Email 網域違反政策
+type EmailPolicyViolation struct {
+	Domain string
+	Reason EmailPolicyReason
+}
+
+func (v *EmailPolicyViolation) Error() string {
+	return fmt.Sprintf("email policy: domain %q rejected: %s", v.Domain, v.Reason)
+}
+
+// EmailPolicy 註冊與變更 Email 前檢查網域是否允許使用
+type EmailPolicy interface {
+	// Check domain 為正規化後的 ASCII 網域；違反政策時回傳 *EmailPolicyViolation，
+	// 其它錯誤表示檢查本身失敗
+	Check(ctx context.Context, domain string) error
+}
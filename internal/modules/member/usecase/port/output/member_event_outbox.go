@@ -0,0 +1,14 @@
+package output
+
+//go:generate mockgen -source=member_event_outbox.go -destination=../../mock/mock_member_event_outbox.go -package=mock
+import (
+	"context"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+)
+
+// EventOutbox 將會員領域事件寫入 outbox，須在與狀態異動相同的交易 ctx 中呼叫
+type EventOutbox interface {
+	Add(ctx context.Context, events ...entity.DomainEvent) error
+	// RedactMember 遮蔽會員已寫入 outbox 的事件與其 webhook 投遞紀錄中的個資，須在個資刪除的交易 ctx 中呼叫
+	RedactMember(ctx context.Context, memberID int) error
+}
@@ -0,0 +1,27 @@
+package output
+
+//go:generate mockgen -source=member_invitation.go -destination=../../mock/mock_member_invitation.go -package=mock
+import (
+	"context"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"time"
+)
+
+// InvitationFilter 邀請碼列表的查詢條件，零值欄位表示不篩選
+type InvitationFilter struct {
+	CreatedBy string
+}
+
+// InvitationPersistence 註冊邀請碼的儲存
+//   - 查無邀請碼時回傳 ErrInvitationNotFound，邀請碼重複時回傳 ErrInvitationAlreadyExists
+//   - Redeem 只在使用次數仍為 uses、未撤銷且未用完時加一，已被其他請求使用時回傳 ErrMemberNoEffect
+type InvitationPersistence interface {
+	Create(ctx context.Context, invitation *entity.Invitation) error
+	GetByID(ctx context.Context, id int) (*entity.Invitation, error)
+	GetByCode(ctx context.Context, code string) (*entity.Invitation, error)
+	GetAll(ctx context.Context, filter InvitationFilter, pagination pagination.Pagination) ([]*entity.Invitation, error)
+	CountAll(ctx context.Context, filter InvitationFilter) (int, error)
+	Redeem(ctx context.Context, id, uses int) error
+	Revoke(ctx context.Context, id int, revokedAt time.Time) error
+}
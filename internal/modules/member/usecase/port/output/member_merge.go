@@ -0,0 +1,21 @@
+package output
+
+import (
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"time"
+)
+
+// MergeResult 會員合併結果，Preview 為 true 時只是預覽，尚未寫入任何資料
+//   - Source 為合併後（預覽時為預計合併後）的來源會員，MergedInto 指向 Target
+//   - AuditRecords 來源會員的稽核紀錄筆數；稽核紀錄只能新增，合併後透過來源會員的 merged_into 追溯
+//   - RedirectedMembers 先前已合併到來源會員、將改為指向目標會員的會員數
+//   - CarriedTags 來源會員有、目標會員沒有而將複製到目標的標籤數
+type MergeResult struct {
+	Source            *entity.Member
+	Target            *entity.Member
+	Preview           bool
+	AuditRecords      int
+	RedirectedMembers int
+	CarriedTags       int
+	MergedAt          time.Time
+}
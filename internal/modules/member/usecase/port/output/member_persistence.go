@@ -7,14 +7,47 @@ import (
 	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
 )
 
+// MemberFilter 會員列表的查詢條件，零值欄位表示不篩選
+//   - 預設排除已被合併的會員；MergedInto 只查合併到該會員的會員，IncludeMerged 則一併列出
+//   - ReferredBy 只查由該會員推薦註冊的會員
+//   - TagsAny 具備任一標籤，TagsAll 須同時具備所有標籤；標籤須已經過 entity.NormalizeTag
+type MemberFilter struct {
+	Status        entity.MemberStatus
+	ReferredBy    int
+	MergedInto    int
+	IncludeMerged bool
+	TagsAny       []string
+	TagsAll       []string
+}
+
 type MemberPersistence interface {
 	Create(ctx context.Context, m *entity.Member) error
 	GetByID(ctx context.Context, id int) (*entity.Member, error)
-	GetByEmail(ctx context.Context, email string) (*entity.Member, error)
-	GetAll(ctx context.Context, pagination pagination.Pagination) ([]*entity.Member, error)
+	// GetByEmail 以正規化後的 Email（entity.EmailNormalizer）查詢
+	GetByEmail(ctx context.Context, normalizedEmail string) (*entity.Member, error)
+	GetAll(ctx context.Context, filter MemberFilter, pagination pagination.Pagination) ([]*entity.Member, error)
 	UpdateProfile(ctx context.Context, m *entity.Member) (*entity.Member, error)
-	UpdateEmail(ctx context.Context, id int, newEmail string) error
+	UpdateEmail(ctx context.Context, id int, newEmail, normalizedEmail string) error
+	// UpdateNormalizedEmail 只改寫正規化 Email，供既有資料回填使用
+	UpdateNormalizedEmail(ctx context.Context, id int, normalizedEmail string) error
 	UpdatePassword(ctx context.Context, id int, newPassword string) error
+	// VerifyPassword 在 gateway 內比對會員密碼，查詢結果不帶密碼；會員不存在時回傳 ErrMemberNotFound
+	VerifyPassword(ctx context.Context, id int, password string) (bool, error)
+	// UpdateStatus 只在目前狀態仍為 from 時更新，狀態已被其他請求改變時回傳 ErrMemberNoEffect
+	UpdateStatus(ctx context.Context, id int, from, to entity.MemberStatus, reason string) error
+	// MarkMerged 將來源會員標記為合併到目標，並把先前合併到來源的會員改指向目標，回傳改指向的會員數；
+	// 來源已被其他請求合併時回傳 ErrMemberNoEffect
+	MarkMerged(ctx context.Context, sourceID, targetID int) (int, error)
 	Delete(ctx context.Context, id int) error
-	CountAll(ctx context.Context) (int, error)
+	CountAll(ctx context.Context, filter MemberFilter) (int, error)
+	// AddTag 為會員加上標籤，會員已有此標籤時回傳 ErrMemberNoEffect
+	AddTag(ctx context.Context, memberID int, tag string) error
+	// RemoveTag 移除會員的標籤，會員沒有此標籤時回傳 ErrMemberNoEffect
+	RemoveTag(ctx context.Context, memberID int, tag string) error
+	// AddTagToMembers 為多位會員加上標籤，略過不存在、已合併或已有此標籤的會員，回傳新加上的數量
+	AddTagToMembers(ctx context.Context, memberIDs []int, tag string) (int, error)
+	// ListTags 依名稱排序列出會員的標籤
+	ListTags(ctx context.Context, memberID int) ([]string, error)
+	// MergeTags 將來源會員的標籤複製到目標，回傳目標新增的標籤數
+	MergeTags(ctx context.Context, sourceID, targetID int) (int, error)
 }
@@ -0,0 +1,26 @@
+package output
+
+import (
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"time"
+)
+
+// PersonalDataArchive 會員個資匯出內容：我們保存的該會員全部資料
+type PersonalDataArchive struct {
+	Member       *entity.Member
+	AuditRecords []AuditRecord
+	// Preferences 會員的偏好設定，未設定的 key 為預設值
+	Preferences *entity.MemberPreferences
+	ExportedAt  time.Time
+}
+
+// ErasureResult 會員個資刪除結果
+//   - Member 為匿名化後的會員
+//   - AlreadyErased 為 true 表示先前已刪除過，本次只重新確認稽核紀錄已遮蔽
+//   - RedactedAuditRecords 本次實際遮蔽的稽核紀錄筆數
+type ErasureResult struct {
+	Member               *entity.Member
+	AlreadyErased        bool
+	RedactedAuditRecords int
+	ErasedAt             time.Time
+}
@@ -0,0 +1,16 @@
+package output
+
+//go:generate mockgen -source=member_preference.go -destination=../../mock/mock_member_preference.go -package=mock
+import (
+	"context"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+)
+
+// PreferencePersistence 會員偏好設定的儲存，值的型別由 entity.PreferenceRegistry 檢查
+//   - Save 值沒有變更、Delete 設定不存在時回傳 ErrMemberNoEffect
+type PreferencePersistence interface {
+	GetByMember(ctx context.Context, memberID int) ([]entity.Preference, error)
+	Save(ctx context.Context, memberID int, preference entity.Preference) error
+	Delete(ctx context.Context, memberID int, key string) error
+	DeleteByMember(ctx context.Context, memberID int) (int, error)
+}
@@ -14,7 +14,24 @@ type MemberPresenter interface {
 	PresentUpdateMemberProfile(member *entity.Member) outputmodel.UpdateMemberProfileResponse
 	PresentUpdateMemberEmail() outputmodel.UpdateMemberEmailResponse
 	PresentUpdateMemberPassword() outputmodel.UpdateMemberPasswordResponse
+	PresentChangeMemberStatus(member *entity.Member) outputmodel.ChangeMemberStatusResponse
+	PresentMergeMembers(result *MergeResult) outputmodel.MergeMembersResponse
 	PresentDeleteMember(member *entity.Member) outputmodel.DeleteMemberResponse
+	PresentExportPersonalData(archive *PersonalDataArchive) outputmodel.ExportPersonalDataResponse
+	PresentErasePersonalData(result *ErasureResult) outputmodel.ErasePersonalDataResponse
+	PresentIssueMemberToken(token *AccessToken) outputmodel.IssueMemberTokenResponse
+	PresentCreateInvitation(invitation *entity.Invitation) outputmodel.InvitationResponse
+	PresentListInvitations(invitations []*entity.Invitation, total int) outputmodel.ListInvitationsResponse
+	PresentRevokeInvitation(invitation *entity.Invitation) outputmodel.InvitationResponse
+	PresentMemberTags(member *entity.Member) outputmodel.MemberTagsResponse
+	// PresentBulkTagMembers tagged 為實際新加上標籤的會員數
+	PresentBulkTagMembers(tag string, requested, tagged int) outputmodel.BulkTagMembersResponse
+	PresentSegment(segment *entity.Segment) outputmodel.SegmentResponse
+	PresentListSegments(segments []*entity.Segment, total int) outputmodel.ListSegmentsResponse
+	PresentExportSegment(export *SegmentExport) outputmodel.ExportSegmentResponse
+	PresentMemberPreferences(prefs *entity.MemberPreferences) outputmodel.MemberPreferencesResponse
+	// PresentMemberChangeEvent 轉換單筆會員異動為 SSE 事件內容
+	PresentMemberChangeEvent(event ChangeEvent) outputmodel.MemberChangeEventResponse
 	// PresentBindingError 處理輸入綁定錯誤
 	PresentBindingError(errCode int, message string) outputmodel.ErrorResponse
 	// PresentValidationError 處理驗證錯誤
@@ -0,0 +1,27 @@
+package output
+
+//go:generate mockgen -source=member_segment.go -destination=../../mock/mock_member_segment.go -package=mock
+import (
+	"context"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/member/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"time"
+)
+
+// SegmentPersistence 會員分群的儲存
+//   - 查無分群時回傳 ErrSegmentNotFound，名稱重複時回傳 ErrSegmentAlreadyExists
+type SegmentPersistence interface {
+	Create(ctx context.Context, segment *entity.Segment) error
+	GetByID(ctx context.Context, id int) (*entity.Segment, error)
+	GetByName(ctx context.Context, name string) (*entity.Segment, error)
+	GetAll(ctx context.Context, pagination pagination.Pagination) ([]*entity.Segment, error)
+	CountAll(ctx context.Context) (int, error)
+	Delete(ctx context.Context, id int) error
+}
+
+// SegmentExport 分群匯出的內容，Members 為匯出當下符合條件的所有會員
+type SegmentExport struct {
+	Segment    *entity.Segment
+	Members    []*entity.Member
+	ExportedAt time.Time
+}
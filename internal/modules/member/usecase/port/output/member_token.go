@@ -0,0 +1,19 @@
+package output
+
+//go:generate mockgen -source=member_token.go -destination=../../mock/mock_member_token.go -package=mock
+import (
+	"context"
+	"time"
+)
+
+// AccessToken 簽發給會員的 access token
+type AccessToken struct {
+	Token     string
+	ExpiresAt time.Time
+}
+
+// TokenIssuer 為通過身分驗證的會員簽發 access token
+type TokenIssuer interface {
+	// Issue subject 為會員 ID，驗證 token 時即為請求的 actor
+	Issue(ctx context.Context, subject string) (*AccessToken, error)
+}
@@ -0,0 +1,11 @@
+package output
+
+//go:generate mockgen -source=transaction_manager.go -destination=../../mock/mock_transaction_manager.go -package=mock
+import "context"
+
+// TransactionManager 讓 use case 宣告交易邊界，不需要知道底層是哪種 DB
+//   - fn 收到的 ctx 帶有交易，所有 gateway 呼叫都必須使用這個 ctx
+//   - fn 回傳 error 時回滾，否則提交
+type TransactionManager interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
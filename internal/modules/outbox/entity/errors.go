@@ -0,0 +1,8 @@
+package entity
+
+import "errors"
+
+var (
+	ErrEmptyEventType = errors.New("outbox event type is empty")
+	ErrEmptyAggregate = errors.New("outbox event aggregate is empty")
+)
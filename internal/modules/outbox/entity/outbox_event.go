@@ -0,0 +1,60 @@
+package entity
+
+import (
+	"github.com/tomoffice/go-clean-architecture/internal/shared/redaction"
+	"time"
+)
+
+// Status outbox 事件的發佈狀態
+type Status string
+
+const (
+	// StatusPending 等待發佈（包含發佈失敗、等待重試）
+	StatusPending Status = "pending"
+	// StatusPublished 已成功發佈
+	StatusPublished Status = "published"
+	// StatusDead 超過最大重試次數，不再自動重試
+	StatusDead Status = "dead"
+)
+
+// OutboxEvent 與狀態異動寫在同一個交易中的待發佈事件
+//   - EventID : 全域唯一的事件 ID，消費端以此去重（at-least-once）
+//   - EventType : 事件名稱，例如 member.registered
+//   - AggregateType/AggregateID : 事件來源，例如 member / 1
+//   - Payload : JSON 格式的事件內容
+type OutboxEvent struct {
+	ID            int
+	EventID       string
+	EventType     string
+	AggregateType string
+	AggregateID   string
+	Payload       []byte
+	Status        Status
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	CreatedAt     time.Time
+	PublishedAt   *time.Time
+}
+
+// Validate 檢查事件的必要欄位
+func (e *OutboxEvent) Validate() error {
+	if e.EventType == "" {
+		return ErrEmptyEventType
+	}
+	if e.AggregateType == "" || e.AggregateID == "" {
+		return ErrEmptyAggregate
+	}
+	return nil
+}
+
+// RedactPayload 將 payload 中指定欄位的值改為遮蔽值，用於個資刪除；
+// 回傳是否有任何值被改寫，已遮蔽過的事件再次呼叫會回傳 false
+func (e *OutboxEvent) RedactPayload(fields ...string) (bool, error) {
+	payload, changed, err := redaction.JSONFields(e.Payload, fields...)
+	if err != nil || !changed {
+		return false, err
+	}
+	e.Payload = payload
+	return true, nil
+}
@@ -0,0 +1,54 @@
+// Package dispatcher 定期呼叫 outbox use case 發佈到期事件。
+package dispatcher
+
+import (
+	"context"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/usecase/port/input"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"time"
+)
+
+const defaultPollInterval = time.Second
+
+// Dispatcher 背景輪詢 outbox，發佈失敗的事件交由 use case 依重試策略延後
+type Dispatcher struct {
+	usecase  input.OutboxInputPort
+	interval time.Duration
+	logger   logger.Logger
+}
+
+func NewDispatcher(outboxUseCase input.OutboxInputPort, interval time.Duration, log logger.Logger) *Dispatcher {
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+	return &Dispatcher{
+		usecase:  outboxUseCase,
+		interval: interval,
+		logger:   log.With(logger.NewField("layer", "dispatcher")),
+	}
+}
+
+// Run 阻塞直到 ctx 結束，通常以 goroutine 執行
+func (d *Dispatcher) Run(ctx context.Context) {
+	d.logger.Info("outbox dispatcher 啟動", logger.NewField("interval", d.interval.String()))
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			d.logger.Info("outbox dispatcher 停止")
+			return
+		case <-ticker.C:
+			d.RunOnce(ctx)
+		}
+	}
+}
+
+// RunOnce 發佈一批到期事件，錯誤只記錄，下一輪再試
+func (d *Dispatcher) RunOnce(ctx context.Context) int {
+	published, err := d.usecase.DispatchPending(ctx)
+	if err != nil && ctx.Err() == nil {
+		d.logger.Error("outbox dispatcher 發佈失敗", logger.NewField("error", err))
+	}
+	return published
+}
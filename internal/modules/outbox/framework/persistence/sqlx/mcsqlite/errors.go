@@ -0,0 +1,32 @@
+package mcsqlite
+
+import (
+	"errors"
+)
+
+// 公開的錯誤實例，可供外部使用 Is/As 判斷
+var (
+	// ErrDBRecordNotFound 查不到資料。
+	ErrDBRecordNotFound = errors.New("db: record not found")
+
+	// ErrDBNoEffect 有執行 update 但 rows affected = 0。
+	ErrDBNoEffect = errors.New("db: no rows affected")
+
+	// ErrDBDuplicateKey event_id 重複。
+	ErrDBDuplicateKey = errors.New("db: duplicate key")
+
+	// ErrDBContextTimeout context 超時，通常是查太久、或 DB 回不來。
+	ErrDBContextTimeout = errors.New("db: context deadline exceeded")
+
+	// ErrDBContextCanceled context 被取消，像是 dispatcher 關閉時。
+	ErrDBContextCanceled = errors.New("db: context canceled")
+
+	// ErrDBConnectionClosed 連線斷掉了（可能被關閉）。
+	ErrDBConnectionClosed = errors.New("db: connection closed")
+
+	// ErrDBUnexpectedError 不知道怎麼歸類的 DB 錯誤。
+	ErrDBUnexpectedError = errors.New("db: unexpected error")
+
+	// ErrMapperTimeParseFailed 時間格式解析失敗，通常是從 DB 讀取時間時格式不對。
+	ErrMapperTimeParseFailed = errors.New("mapper: time parse failed")
+)
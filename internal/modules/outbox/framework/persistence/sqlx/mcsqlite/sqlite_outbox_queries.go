@@ -0,0 +1,41 @@
+package mcsqlite
+
+import "time"
+
+const (
+	queryInsertOutbox = `INSERT INTO outbox_events (event_id, event_type, aggregate_type, aggregate_id, payload, status, attempts, next_attempt_at, created_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	querySelectDueOutbox = `SELECT * FROM outbox_events
+WHERE status = 'pending' AND next_attempt_at <= ?
+ORDER BY id ASC LIMIT ?`
+	queryMarkOutboxPublished     = `UPDATE outbox_events SET status = 'published', published_at = ?, last_error = '' WHERE id = ?`
+	queryMarkOutboxFailed        = `UPDATE outbox_events SET status = ?, attempts = ?, next_attempt_at = ?, last_error = ? WHERE id = ?`
+	querySelectOutboxBase        = `SELECT * FROM outbox_events`
+	queryCountOutboxBase         = `SELECT COUNT(*) FROM outbox_events`
+	querySelectOutboxByAggregate = `SELECT * FROM outbox_events
+WHERE aggregate_type = ? AND aggregate_id = ?
+ORDER BY id ASC LIMIT ? OFFSET ?`
+	queryUpdateOutboxPayload = `UPDATE outbox_events SET payload = ? WHERE id = ?`
+	// 卡住的事件以最舊的優先顯示
+	queryOutboxOrderAndPage = ` ORDER BY created_at ASC, id ASC LIMIT ? OFFSET ?`
+)
+
+// sqliteTimeLayout 與 CURRENT_TIMESTAMP 格式一致（UTC）
+const sqliteTimeLayout = "2006-01-02 15:04:05"
+
+// sqliteReadTimeLayouts 讀取時接受的格式；go-sqlite3 會把 DATETIME 欄位轉成 time.Time，
+// 掃進 string 時變成 RFC3339，直接讀原始文字時則是 sqliteTimeLayout
+var sqliteReadTimeLayouts = []string{time.RFC3339Nano, sqliteTimeLayout}
+
+// parseSQLiteTime 依序嘗試可接受的格式，一律回傳 UTC
+func parseSQLiteTime(value string) (time.Time, error) {
+	var lastErr error
+	for _, layout := range sqliteReadTimeLayouts {
+		t, err := time.Parse(layout, value)
+		if err == nil {
+			return t.UTC(), nil
+		}
+		lastErr = err
+	}
+	return time.Time{}, lastErr
+}
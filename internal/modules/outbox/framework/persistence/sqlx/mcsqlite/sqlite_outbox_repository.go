@@ -0,0 +1,247 @@
+package mcsqlite
+
+import (
+	"context"
+	"database/sql"
+	"github.com/jmoiron/sqlx"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxtx"
+	sqlx2 "github.com/tomoffice/go-clean-architecture/internal/modules/outbox/framework/persistence/sqlx"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/interface_adapter/dao"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
+	"strings"
+	"time"
+)
+
+// sqlxOutboxSqlite 實作 dao.OutboxDAO
+type sqlxOutboxSqlite struct {
+	db     *sqlx.DB
+	logger logger.Logger
+	tracer tracer.Tracer
+}
+
+func NewSqlxOutboxSqlite(db *sqlx.DB, log logger.Logger, tracer tracer.Tracer) dao.OutboxDAO {
+	baseLogger := log.With(logger.NewField("layer", "repository"))
+	return &sqlxOutboxSqlite{
+		db:     db,
+		logger: baseLogger,
+		tracer: tracer,
+	}
+}
+func (s sqlxOutboxSqlite) Append(ctx context.Context, records []*dao.OutboxRecord) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.Append")
+	defer span.End()
+
+	startTime := time.Now()
+	exec := s.executor(repoCtx)
+	for _, r := range records {
+		_, err := exec.ExecContext(repoCtx, queryInsertOutbox,
+			r.EventID, r.EventType, r.AggregateType, r.AggregateID, r.Payload, r.Status, r.Attempts,
+			r.NextAttemptAt.UTC().Format(sqliteTimeLayout), r.CreatedAt.UTC().Format(sqliteTimeLayout),
+		)
+		if err != nil {
+			contextLogger.Error("SQL outbox 事件插入失敗",
+				logger.NewField("error", err),
+				logger.NewField("event_id", r.EventID),
+				logger.NewField("event_type", r.EventType),
+				logger.NewField("duration_ms", time.Since(startTime).Milliseconds()),
+			)
+			return mapSQLError(err)
+		}
+	}
+
+	contextLogger.Debug("SQL outbox 事件插入成功",
+		logger.NewField("count", len(records)),
+		logger.NewField("duration_ms", time.Since(startTime).Milliseconds()),
+	)
+	return nil
+}
+func (s sqlxOutboxSqlite) GetDue(ctx context.Context, now time.Time, limit int) ([]*dao.OutboxRecord, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.GetDue")
+	defer span.End()
+	startTime := time.Now()
+
+	models := make([]*sqlx2.OutboxSQLXModel, 0)
+	err := s.executor(repoCtx).SelectContext(repoCtx, &models, querySelectDueOutbox, now.UTC().Format(sqliteTimeLayout), limit)
+	duration := time.Since(startTime)
+	if err != nil {
+		contextLogger.Error("SQL outbox 到期事件查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("limit", limit),
+			logger.NewField("duration_ms", duration.Milliseconds()),
+		)
+		return nil, mapSQLError(err)
+	}
+	records, err := modelsToDTO(models)
+	if err != nil {
+		contextLogger.Error("SQL outbox 到期事件 DTO 轉換失敗", logger.NewField("error", err))
+		return nil, mapSQLError(err)
+	}
+	return records, nil
+}
+func (s sqlxOutboxSqlite) MarkPublished(ctx context.Context, id int, publishedAt time.Time) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.MarkPublished")
+	defer span.End()
+
+	result, err := s.executor(repoCtx).ExecContext(repoCtx, queryMarkOutboxPublished, publishedAt.UTC().Format(sqliteTimeLayout), id)
+	if err != nil {
+		contextLogger.Error("SQL outbox 發佈狀態更新失敗", logger.NewField("error", err), logger.NewField("outbox_id", id))
+		return mapSQLError(err)
+	}
+	return checkAffected(result, contextLogger, id)
+}
+func (s sqlxOutboxSqlite) MarkFailed(ctx context.Context, id int, status string, attempts int, nextAttemptAt time.Time, lastError string) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.MarkFailed")
+	defer span.End()
+
+	result, err := s.executor(repoCtx).ExecContext(repoCtx, queryMarkOutboxFailed,
+		status, attempts, nextAttemptAt.UTC().Format(sqliteTimeLayout), lastError, id,
+	)
+	if err != nil {
+		contextLogger.Error("SQL outbox 失敗狀態更新失敗", logger.NewField("error", err), logger.NewField("outbox_id", id))
+		return mapSQLError(err)
+	}
+	return checkAffected(result, contextLogger, id)
+}
+func (s sqlxOutboxSqlite) GetStuck(ctx context.Context, q dao.StuckQuery, p pagination.Pagination) ([]*dao.OutboxRecord, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.GetStuck")
+	defer span.End()
+	startTime := time.Now()
+
+	where, args := buildStuckWhere(q)
+	args = append(args, p.Limit, p.Offset)
+	models := make([]*sqlx2.OutboxSQLXModel, 0)
+	err := s.executor(repoCtx).SelectContext(repoCtx, &models, querySelectOutboxBase+where+queryOutboxOrderAndPage, args...)
+	duration := time.Since(startTime)
+	if err != nil {
+		contextLogger.Error("SQL outbox 卡住事件查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("limit", p.Limit),
+			logger.NewField("offset", p.Offset),
+			logger.NewField("duration_ms", duration.Milliseconds()),
+		)
+		return nil, mapSQLError(err)
+	}
+	records, err := modelsToDTO(models)
+	if err != nil {
+		contextLogger.Error("SQL outbox 卡住事件 DTO 轉換失敗", logger.NewField("error", err))
+		return nil, mapSQLError(err)
+	}
+	contextLogger.Debug("SQL outbox 卡住事件查詢成功",
+		logger.NewField("count", len(records)),
+		logger.NewField("duration_ms", duration.Milliseconds()),
+	)
+	return records, nil
+}
+func (s sqlxOutboxSqlite) CountStuck(ctx context.Context, q dao.StuckQuery) (int, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.CountStuck")
+	defer span.End()
+
+	where, args := buildStuckWhere(q)
+	var count int
+	if err := s.executor(repoCtx).GetContext(repoCtx, &count, queryCountOutboxBase+where, args...); err != nil {
+		contextLogger.Error("SQL outbox 卡住事件總數查詢失敗", logger.NewField("error", err))
+		return 0, mapSQLError(err)
+	}
+	return count, nil
+}
+func (s sqlxOutboxSqlite) GetByAggregate(ctx context.Context, aggregateType, aggregateID string, p pagination.Pagination) ([]*dao.OutboxRecord, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.GetByAggregate")
+	defer span.End()
+	startTime := time.Now()
+
+	models := make([]*sqlx2.OutboxSQLXModel, 0)
+	err := s.executor(repoCtx).SelectContext(repoCtx, &models, querySelectOutboxByAggregate, aggregateType, aggregateID, p.Limit, p.Offset)
+	duration := time.Since(startTime)
+	if err != nil {
+		contextLogger.Error("SQL outbox aggregate 事件查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("aggregate", aggregateType+":"+aggregateID),
+			logger.NewField("duration_ms", duration.Milliseconds()),
+		)
+		return nil, mapSQLError(err)
+	}
+	records, err := modelsToDTO(models)
+	if err != nil {
+		contextLogger.Error("SQL outbox aggregate 事件 DTO 轉換失敗", logger.NewField("error", err))
+		return nil, mapSQLError(err)
+	}
+	return records, nil
+}
+func (s sqlxOutboxSqlite) UpdatePayload(ctx context.Context, id int, payload string) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.UpdatePayload")
+	defer span.End()
+
+	result, err := s.executor(repoCtx).ExecContext(repoCtx, queryUpdateOutboxPayload, payload, id)
+	if err != nil {
+		contextLogger.Error("SQL outbox payload 更新失敗", logger.NewField("error", err), logger.NewField("outbox_id", id))
+		return mapSQLError(err)
+	}
+	return checkAffected(result, contextLogger, id)
+}
+
+// buildStuckWhere 狀態符合，且已失敗過或建立太久仍未發佈
+func buildStuckWhere(q dao.StuckQuery) (string, []any) {
+	args := make([]any, 0, len(q.Statuses)+1)
+	placeholders := make([]string, 0, len(q.Statuses))
+	for _, status := range q.Statuses {
+		placeholders = append(placeholders, "?")
+		args = append(args, status)
+	}
+	conditions := []string{"(attempts > 0 OR created_at <= ?)"}
+	args = append(args, q.CreatedBefore.UTC().Format(sqliteTimeLayout))
+	if len(placeholders) > 0 {
+		conditions = append([]string{"status IN (" + strings.Join(placeholders, ", ") + ")"}, conditions...)
+	} else {
+		conditions = append([]string{"status <> 'published'"}, conditions...)
+	}
+	return " WHERE " + strings.Join(conditions, " AND "), args
+}
+
+func modelsToDTO(models []*sqlx2.OutboxSQLXModel) ([]*dao.OutboxRecord, error) {
+	records := make([]*dao.OutboxRecord, 0, len(models))
+	for _, model := range models {
+		record, err := sqlxModelToDTO(model)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func checkAffected(result sql.Result, contextLogger logger.Logger, id int) error {
+	rows, err := result.RowsAffected()
+	if err != nil {
+		contextLogger.Error("SQL outbox 更新結果檢查失敗", logger.NewField("error", err), logger.NewField("outbox_id", id))
+		return mapSQLError(err)
+	}
+	if rows != 1 {
+		contextLogger.Error("SQL outbox 更新未影響預期行數",
+			logger.NewField("outbox_id", id),
+			logger.NewField("rows_affected", rows),
+		)
+		return mapSQLError(ErrDBNoEffect)
+	}
+	return nil
+}
+
+// executor 有交易時使用 context 中的交易，讓事件與呼叫端的異動寫在同一個交易
+func (s sqlxOutboxSqlite) executor(ctx context.Context) sqlxtx.Executor {
+	return sqlxtx.ExecutorFromContext(ctx, s.db)
+}
+
+func createTracedLogger(ctx context.Context, tr tracer.Tracer, log logger.Logger, operationName string) (context.Context, logger.Logger, tracer.Span) {
+	repoCtx, span := tr.Start(ctx, operationName)
+	lg := log.WithContext(repoCtx)
+	return repoCtx, lg, span
+}
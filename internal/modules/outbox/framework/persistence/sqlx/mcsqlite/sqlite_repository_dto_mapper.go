@@ -0,0 +1,44 @@
+package mcsqlite
+
+import (
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/interface_adapter/dao"
+	"time"
+
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/framework/persistence/sqlx"
+)
+
+func sqlxModelToDTO(model *sqlx.OutboxSQLXModel) (*dao.OutboxRecord, error) {
+	if model == nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	createdAt, err := parseSQLiteTime(model.CreatedAt)
+	if err != nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	nextAttemptAt, err := parseSQLiteTime(model.NextAttemptAt)
+	if err != nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	var publishedAt *time.Time
+	if model.PublishedAt.Valid {
+		t, err := parseSQLiteTime(model.PublishedAt.String)
+		if err != nil {
+			return nil, ErrMapperTimeParseFailed
+		}
+		publishedAt = &t
+	}
+	return &dao.OutboxRecord{
+		ID:            model.ID,
+		EventID:       model.EventID,
+		EventType:     model.EventType,
+		AggregateType: model.AggregateType,
+		AggregateID:   model.AggregateID,
+		Payload:       model.Payload,
+		Status:        model.Status,
+		Attempts:      model.Attempts,
+		NextAttemptAt: nextAttemptAt,
+		LastError:     model.LastError,
+		CreatedAt:     createdAt,
+		PublishedAt:   publishedAt,
+	}, nil
+}
@@ -0,0 +1,18 @@
+package sqlx
+
+import "database/sql"
+
+type OutboxSQLXModel struct {
+	ID            int            `db:"id"`
+	EventID       string         `db:"event_id"`
+	EventType     string         `db:"event_type"`
+	AggregateType string         `db:"aggregate_type"`
+	AggregateID   string         `db:"aggregate_id"`
+	Payload       string         `db:"payload"`
+	Status        string         `db:"status"`
+	Attempts      int            `db:"attempts"`
+	NextAttemptAt string         `db:"next_attempt_at"`
+	LastError     string         `db:"last_error"`
+	CreatedAt     string         `db:"created_at"`
+	PublishedAt   sql.NullString `db:"published_at"`
+}
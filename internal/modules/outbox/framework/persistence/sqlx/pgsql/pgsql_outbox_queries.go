@@ -0,0 +1,19 @@
+package pgsql
+
+const (
+	queryInsertOutbox = `INSERT INTO outbox_events (event_id, event_type, aggregate_type, aggregate_id, payload, status, attempts, next_attempt_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+	querySelectDueOutbox = `SELECT * FROM outbox_events
+WHERE status = 'pending' AND next_attempt_at <= $1
+ORDER BY id ASC LIMIT $2`
+	queryMarkOutboxPublished     = `UPDATE outbox_events SET status = 'published', published_at = $1, last_error = '' WHERE id = $2`
+	queryMarkOutboxFailed        = `UPDATE outbox_events SET status = $1, attempts = $2, next_attempt_at = $3, last_error = $4 WHERE id = $5`
+	querySelectOutboxBase        = `SELECT * FROM outbox_events`
+	queryCountOutboxBase         = `SELECT COUNT(*) FROM outbox_events`
+	querySelectOutboxByAggregate = `SELECT * FROM outbox_events
+WHERE aggregate_type = $1 AND aggregate_id = $2
+ORDER BY id ASC LIMIT $3 OFFSET $4`
+	queryUpdateOutboxPayload = `UPDATE outbox_events SET payload = $1 WHERE id = $2`
+	// 卡住的事件以最舊的優先顯示，%s 為 LIMIT、OFFSET 的 placeholder
+	queryOutboxOrderAndPage = ` ORDER BY created_at ASC, id ASC LIMIT %s OFFSET %s`
+)
@@ -0,0 +1,53 @@
+package pgsql
+
+import (
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/interface_adapter/dao"
+	"time"
+
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/framework/persistence/sqlx"
+)
+
+func sqlxModelToDTO(model *sqlx.OutboxSQLXModel) (*dao.OutboxRecord, error) {
+	if model == nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	createdAt, err := parsePgTime(model.CreatedAt)
+	if err != nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	nextAttemptAt, err := parsePgTime(model.NextAttemptAt)
+	if err != nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	var publishedAt *time.Time
+	if model.PublishedAt.Valid {
+		t, err := parsePgTime(model.PublishedAt.String)
+		if err != nil {
+			return nil, ErrMapperTimeParseFailed
+		}
+		publishedAt = &t
+	}
+	return &dao.OutboxRecord{
+		ID:            model.ID,
+		EventID:       model.EventID,
+		EventType:     model.EventType,
+		AggregateType: model.AggregateType,
+		AggregateID:   model.AggregateID,
+		Payload:       model.Payload,
+		Status:        model.Status,
+		Attempts:      model.Attempts,
+		NextAttemptAt: nextAttemptAt,
+		LastError:     model.LastError,
+		CreatedAt:     createdAt,
+		PublishedAt:   publishedAt,
+	}, nil
+}
+
+// parsePgTime pgx 將 TIMESTAMPTZ 讀成 time.Time，database/sql 掃進 string 時格式為 RFC3339Nano，一律回傳 UTC
+func parsePgTime(value string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.UTC(), nil
+}
@@ -0,0 +1,62 @@
+package publisher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/entity"
+	"sync"
+)
+
+// WildcardEventType 訂閱所有事件
+const WildcardEventType = "*"
+
+// Handler in-process 訂閱者，回傳 error 時整筆事件視為發佈失敗並重試
+type Handler func(ctx context.Context, envelope Envelope) error
+
+// Bus in-process 事件匯流排，讓同一個服務內的其他模組訂閱領域事件
+//   - handler 依訂閱順序同步執行，任一失敗都會讓事件重送，handler 需自行冪等
+type Bus struct {
+	mu       sync.RWMutex
+	handlers map[string][]Handler
+}
+
+func NewBus() *Bus {
+	return &Bus{
+		handlers: make(map[string][]Handler),
+	}
+}
+
+// Subscribe 訂閱特定事件，eventType 為 WildcardEventType 時訂閱全部
+func (b *Bus) Subscribe(eventType string, handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers[eventType] = append(b.handlers[eventType], handler)
+}
+
+func (b *Bus) Publish(ctx context.Context, event *entity.OutboxEvent) error {
+	b.mu.RLock()
+	handlers := make([]Handler, 0, len(b.handlers[event.EventType])+len(b.handlers[WildcardEventType]))
+	handlers = append(handlers, b.handlers[event.EventType]...)
+	handlers = append(handlers, b.handlers[WildcardEventType]...)
+	b.mu.RUnlock()
+
+	envelope := NewEnvelope(event)
+	var errs []error
+	for _, handler := range handlers {
+		if err := invoke(ctx, handler, envelope); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// invoke 隔離 handler 的 panic，避免拖垮 dispatcher
+func invoke(ctx context.Context, handler Handler, envelope Envelope) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: %v", ErrHandlerPanicked, r)
+		}
+	}()
+	return handler(ctx, envelope)
+}
@@ -0,0 +1,35 @@
+package publisher
+
+import (
+	"encoding/json"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/entity"
+	"time"
+)
+
+// Envelope 對外發佈的事件格式，HTTP body 與 bus handler 收到的內容一致
+type Envelope struct {
+	ID            string          `json:"id"`
+	Type          string          `json:"type"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateID   string          `json:"aggregate_id"`
+	OccurredAt    string          `json:"occurred_at"`
+	Attempt       int             `json:"attempt"`
+	Payload       json.RawMessage `json:"payload"`
+}
+
+// NewEnvelope 由 outbox 事件建立對外格式，Attempt 從 1 開始
+func NewEnvelope(event *entity.OutboxEvent) Envelope {
+	payload := json.RawMessage(event.Payload)
+	if !json.Valid(payload) {
+		payload = json.RawMessage("{}")
+	}
+	return Envelope{
+		ID:            event.EventID,
+		Type:          event.EventType,
+		AggregateType: event.AggregateType,
+		AggregateID:   event.AggregateID,
+		OccurredAt:    event.CreatedAt.UTC().Format(time.RFC3339),
+		Attempt:       event.Attempts + 1,
+		Payload:       payload,
+	}
+}
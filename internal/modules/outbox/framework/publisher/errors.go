@@ -0,0 +1,14 @@
+package publisher
+
+import "errors"
+
+var (
+	// ErrUnknownPublisherType 設定的 publisher 類型不存在。
+	ErrUnknownPublisherType = errors.New("publisher: unknown publisher type")
+	// ErrHTTPEndpointRequired http publisher 未設定 endpoint。
+	ErrHTTPEndpointRequired = errors.New("publisher: http endpoint is required")
+	// ErrHTTPUnexpectedStatus 接收端回應非 2xx。
+	ErrHTTPUnexpectedStatus = errors.New("publisher: http unexpected status")
+	// ErrHandlerPanicked in-process handler 發生 panic。
+	ErrHandlerPanicked = errors.New("publisher: handler panicked")
+)
@@ -0,0 +1,50 @@
+package publisher
+
+import (
+	"fmt"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/usecase/port/output"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"time"
+)
+
+// 支援的 publisher 類型
+const (
+	TypeLog  = "log"
+	TypeBus  = "bus"
+	TypeHTTP = "http"
+)
+
+// Options publisher 設定，Type 為空字串時使用 log
+type Options struct {
+	Type        string
+	HTTPURL     string
+	HTTPTimeout time.Duration
+	HTTPHeaders map[string]string
+}
+
+// NewEventPublisher 依設定建立 publisher；有傳入 bus 時事件一律同時送往 bus，
+// 讓服務內的訂閱者（例如 webhook）不受外部 publisher 類型影響
+func NewEventPublisher(opts Options, bus *Bus, log logger.Logger) (output.EventPublisher, error) {
+	var external output.EventPublisher
+	switch opts.Type {
+	case "", TypeLog:
+		external = NewLogPublisher(log)
+	case TypeBus:
+		if bus == nil {
+			bus = NewBus()
+		}
+		return bus, nil
+	case TypeHTTP:
+		httpPublisher, err := NewHTTPPublisher(opts.HTTPURL, opts.HTTPTimeout, opts.HTTPHeaders)
+		if err != nil {
+			return nil, err
+		}
+		external = httpPublisher
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownPublisherType, opts.Type)
+	}
+	if bus == nil {
+		return external, nil
+	}
+	return NewMultiPublisher(external, bus), nil
+}
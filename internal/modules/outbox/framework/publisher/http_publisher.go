@@ -0,0 +1,73 @@
+package publisher
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/entity"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	// HeaderEventID 消費端以此去重
+	HeaderEventID = "X-Event-Id"
+	// HeaderEventType 事件名稱
+	HeaderEventType = "X-Event-Type"
+
+	defaultHTTPTimeout = 5 * time.Second
+	// maxErrorBodyBytes 失敗時只讀取回應的前段寫入 last_error
+	maxErrorBodyBytes = 512
+)
+
+// HTTPPublisher 以 POST 將事件送到外部 endpoint，2xx 視為成功
+type HTTPPublisher struct {
+	endpoint string
+	headers  map[string]string
+	client   *http.Client
+}
+
+func NewHTTPPublisher(endpoint string, timeout time.Duration, headers map[string]string) (*HTTPPublisher, error) {
+	if endpoint == "" {
+		return nil, ErrHTTPEndpointRequired
+	}
+	if timeout <= 0 {
+		timeout = defaultHTTPTimeout
+	}
+	return &HTTPPublisher{
+		endpoint: endpoint,
+		headers:  headers,
+		client:   &http.Client{Timeout: timeout},
+	}, nil
+}
+
+func (p *HTTPPublisher) Publish(ctx context.Context, event *entity.OutboxEvent) error {
+	body, err := json.Marshal(NewEnvelope(event))
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEventID, event.EventID)
+	req.Header.Set(HeaderEventType, event.EventType)
+	for k, v := range p.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		snippet, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyBytes))
+		return fmt.Errorf("%w: %d %s", ErrHTTPUnexpectedStatus, resp.StatusCode, bytes.TrimSpace(snippet))
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
@@ -0,0 +1,29 @@
+package publisher
+
+import (
+	"context"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/usecase/port/output"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+)
+
+// LogPublisher 只把事件寫進 log，適合開發環境或還沒有訂閱者時使用
+type LogPublisher struct {
+	logger logger.Logger
+}
+
+func NewLogPublisher(log logger.Logger) output.EventPublisher {
+	return &LogPublisher{
+		logger: log.With(logger.NewField("layer", "publisher")),
+	}
+}
+
+func (p *LogPublisher) Publish(ctx context.Context, event *entity.OutboxEvent) error {
+	p.logger.WithContext(ctx).Info("發佈領域事件",
+		logger.NewField("event_id", event.EventID),
+		logger.NewField("event_type", event.EventType),
+		logger.NewField("aggregate", event.AggregateType+":"+event.AggregateID),
+		logger.NewField("payload", string(event.Payload)),
+	)
+	return nil
+}
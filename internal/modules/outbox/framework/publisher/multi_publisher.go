@@ -0,0 +1,30 @@
+package publisher
+
+import (
+	"context"
+	"errors"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/usecase/port/output"
+)
+
+// MultiPublisher 依序將事件送往多個 publisher，任一失敗都視為發佈失敗並整筆重送
+//   - 已成功的 publisher 在重送時會再收到一次，下游需以 EventID 去重
+type MultiPublisher struct {
+	publishers []output.EventPublisher
+}
+
+func NewMultiPublisher(publishers ...output.EventPublisher) *MultiPublisher {
+	return &MultiPublisher{
+		publishers: publishers,
+	}
+}
+
+func (p *MultiPublisher) Publish(ctx context.Context, event *entity.OutboxEvent) error {
+	var errs []error
+	for _, publisher := range p.publishers {
+		if err := publisher.Publish(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
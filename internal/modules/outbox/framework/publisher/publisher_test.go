@@ -0,0 +1,124 @@
+package publisher
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/entity"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func testEvent() *entity.OutboxEvent {
+	return &entity.OutboxEvent{
+		ID:            1,
+		EventID:       "evt-1",
+		EventType:     "member.registered",
+		AggregateType: "member",
+		AggregateID:   "1",
+		Payload:       []byte(`{"member_id":1}`),
+		Attempts:      2,
+		CreatedAt:     time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestHTTPPublisher_Publish(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr error
+	}{
+		{name: "2xx is success", status: http.StatusAccepted},
+		{name: "non 2xx is failure", status: http.StatusServiceUnavailable, wantErr: ErrHTTPUnexpectedStatus},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Envelope
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "evt-1", r.Header.Get(HeaderEventID))
+				assert.Equal(t, "member.registered", r.Header.Get(HeaderEventType))
+				assert.Equal(t, "token", r.Header.Get("Authorization"))
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			p, err := NewHTTPPublisher(server.URL, time.Second, map[string]string{"Authorization": "token"})
+			require.NoError(t, err)
+			err = p.Publish(context.Background(), testEvent())
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Publish() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			assert.Equal(t, "evt-1", got.ID)
+			assert.Equal(t, 3, got.Attempt)
+			assert.JSONEq(t, `{"member_id":1}`, string(got.Payload))
+		})
+	}
+}
+
+func TestNewHTTPPublisher_RequiresEndpoint(t *testing.T) {
+	_, err := NewHTTPPublisher("", 0, nil)
+	assert.ErrorIs(t, err, ErrHTTPEndpointRequired)
+}
+
+func TestBus_Publish(t *testing.T) {
+	bus := NewBus()
+	var typed, wildcard int
+	bus.Subscribe("member.registered", func(ctx context.Context, e Envelope) error {
+		typed++
+		return nil
+	})
+	bus.Subscribe(WildcardEventType, func(ctx context.Context, e Envelope) error {
+		wildcard++
+		return nil
+	})
+	bus.Subscribe("member.deleted", func(ctx context.Context, e Envelope) error {
+		t.Fatal("unexpected handler invoked")
+		return nil
+	})
+
+	require.NoError(t, bus.Publish(context.Background(), testEvent()))
+	assert.Equal(t, 1, typed)
+	assert.Equal(t, 1, wildcard)
+}
+
+func TestBus_PublishHandlerFailure(t *testing.T) {
+	bus := NewBus()
+	handlerErr := errors.New("handler failed")
+	bus.Subscribe("member.registered", func(ctx context.Context, e Envelope) error {
+		return handlerErr
+	})
+	bus.Subscribe("member.registered", func(ctx context.Context, e Envelope) error {
+		panic("boom")
+	})
+
+	err := bus.Publish(context.Background(), testEvent())
+	assert.ErrorIs(t, err, handlerErr)
+	assert.ErrorIs(t, err, ErrHandlerPanicked)
+}
+
+func TestNewEventPublisher_AlwaysFansOutToBus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	bus := NewBus()
+	var received int
+	bus.Subscribe(WildcardEventType, func(ctx context.Context, e Envelope) error {
+		received++
+		return nil
+	})
+	p, err := NewEventPublisher(Options{Type: TypeHTTP, HTTPURL: server.URL, HTTPTimeout: time.Second}, bus, nil)
+	require.NoError(t, err)
+
+	// 外部 endpoint 失敗時整筆事件視為失敗，但 bus 訂閱者仍收到事件
+	err = p.Publish(context.Background(), testEvent())
+	assert.ErrorIs(t, err, ErrHTTPUnexpectedStatus)
+	assert.Equal(t, 1, received)
+}
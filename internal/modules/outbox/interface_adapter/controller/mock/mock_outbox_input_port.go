@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: outbox_input_port.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/tomoffice/go-clean-architecture/internal/modules/outbox/entity"
+	inputmodel "github.com/tomoffice/go-clean-architecture/internal/modules/outbox/usecase/inputmodel"
+	pagination "github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+)
+
+// MockOutboxInputPort is a mock of OutboxInputPort interface.
+type MockOutboxInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxInputPortMockRecorder
+}
+
+// MockOutboxInputPortMockRecorder is the mock recorder for MockOutboxInputPort.
+type MockOutboxInputPortMockRecorder struct {
+	mock *MockOutboxInputPort
+}
+
+// NewMockOutboxInputPort creates a new mock instance.
+func NewMockOutboxInputPort(ctrl *gomock.Controller) *MockOutboxInputPort {
+	mock := &MockOutboxInputPort{ctrl: ctrl}
+	mock.recorder = &MockOutboxInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutboxInputPort) EXPECT() *MockOutboxInputPortMockRecorder {
+	return m.recorder
+}
+
+// DispatchPending mocks base method.
+func (m *MockOutboxInputPort) DispatchPending(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DispatchPending", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DispatchPending indicates an expected call of DispatchPending.
+func (mr *MockOutboxInputPortMockRecorder) DispatchPending(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DispatchPending", reflect.TypeOf((*MockOutboxInputPort)(nil).DispatchPending), ctx)
+}
+
+// Enqueue mocks base method.
+func (m *MockOutboxInputPort) Enqueue(ctx context.Context, events ...*entity.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range events {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Enqueue", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockOutboxInputPortMockRecorder) Enqueue(ctx interface{}, events ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, events...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockOutboxInputPort)(nil).Enqueue), varargs...)
+}
+
+// ListStuckEvents mocks base method.
+func (m *MockOutboxInputPort) ListStuckEvents(ctx context.Context, filter *inputmodel.ListStuckEventsInputModel, pagination pagination.Pagination) ([]*entity.OutboxEvent, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStuckEvents", ctx, filter, pagination)
+	ret0, _ := ret[0].([]*entity.OutboxEvent)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListStuckEvents indicates an expected call of ListStuckEvents.
+func (mr *MockOutboxInputPortMockRecorder) ListStuckEvents(ctx, filter, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStuckEvents", reflect.TypeOf((*MockOutboxInputPort)(nil).ListStuckEvents), ctx, filter, pagination)
+}
+
+// RedactAggregate mocks base method.
+func (m *MockOutboxInputPort) RedactAggregate(ctx context.Context, input *inputmodel.RedactAggregateInputModel) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedactAggregate", ctx, input)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RedactAggregate indicates an expected call of RedactAggregate.
+func (mr *MockOutboxInputPortMockRecorder) RedactAggregate(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedactAggregate", reflect.TypeOf((*MockOutboxInputPort)(nil).RedactAggregate), ctx, input)
+}
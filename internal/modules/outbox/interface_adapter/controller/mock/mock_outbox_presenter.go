@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: outbox_presenter.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/tomoffice/go-clean-architecture/internal/modules/outbox/entity"
+	outputmodel "github.com/tomoffice/go-clean-architecture/internal/modules/outbox/interface_adapter/outputmodel"
+	pagination "github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+)
+
+// MockOutboxPresenter is a mock of OutboxPresenter interface.
+type MockOutboxPresenter struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxPresenterMockRecorder
+}
+
+// MockOutboxPresenterMockRecorder is the mock recorder for MockOutboxPresenter.
+type MockOutboxPresenterMockRecorder struct {
+	mock *MockOutboxPresenter
+}
+
+// NewMockOutboxPresenter creates a new mock instance.
+func NewMockOutboxPresenter(ctrl *gomock.Controller) *MockOutboxPresenter {
+	mock := &MockOutboxPresenter{ctrl: ctrl}
+	mock.recorder = &MockOutboxPresenterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutboxPresenter) EXPECT() *MockOutboxPresenterMockRecorder {
+	return m.recorder
+}
+
+// PresentBindingError mocks base method.
+func (m *MockOutboxPresenter) PresentBindingError(errCode int, message string) outputmodel.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentBindingError", errCode, message)
+	ret0, _ := ret[0].(outputmodel.ErrorResponse)
+	return ret0
+}
+
+// PresentBindingError indicates an expected call of PresentBindingError.
+func (mr *MockOutboxPresenterMockRecorder) PresentBindingError(errCode, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentBindingError", reflect.TypeOf((*MockOutboxPresenter)(nil).PresentBindingError), errCode, message)
+}
+
+// PresentListStuckEvents mocks base method.
+func (m *MockOutboxPresenter) PresentListStuckEvents(events []*entity.OutboxEvent, pagination pagination.Pagination, total int) outputmodel.ListStuckEventsResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentListStuckEvents", events, pagination, total)
+	ret0, _ := ret[0].(outputmodel.ListStuckEventsResponse)
+	return ret0
+}
+
+// PresentListStuckEvents indicates an expected call of PresentListStuckEvents.
+func (mr *MockOutboxPresenterMockRecorder) PresentListStuckEvents(events, pagination, total interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentListStuckEvents", reflect.TypeOf((*MockOutboxPresenter)(nil).PresentListStuckEvents), events, pagination, total)
+}
+
+// PresentUseCaseError mocks base method.
+func (m *MockOutboxPresenter) PresentUseCaseError(err error) (int, outputmodel.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentUseCaseError", err)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(outputmodel.ErrorResponse)
+	return ret0, ret1
+}
+
+// PresentUseCaseError indicates an expected call of PresentUseCaseError.
+func (mr *MockOutboxPresenterMockRecorder) PresentUseCaseError(err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentUseCaseError", reflect.TypeOf((*MockOutboxPresenter)(nil).PresentUseCaseError), err)
+}
+
+// PresentValidationError mocks base method.
+func (m *MockOutboxPresenter) PresentValidationError(err error) (int, outputmodel.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentValidationError", err)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(outputmodel.ErrorResponse)
+	return ret0, ret1
+}
+
+// PresentValidationError indicates an expected call of PresentValidationError.
+func (mr *MockOutboxPresenterMockRecorder) PresentValidationError(err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentValidationError", reflect.TypeOf((*MockOutboxPresenter)(nil).PresentValidationError), err)
+}
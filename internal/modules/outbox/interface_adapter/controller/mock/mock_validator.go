@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: validator.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	dto "github.com/tomoffice/go-clean-architecture/internal/modules/outbox/interface_adapter/dto"
+)
+
+// MockValidator is a mock of Validator interface.
+type MockValidator struct {
+	ctrl     *gomock.Controller
+	recorder *MockValidatorMockRecorder
+}
+
+// MockValidatorMockRecorder is the mock recorder for MockValidator.
+type MockValidatorMockRecorder struct {
+	mock *MockValidator
+}
+
+// NewMockValidator creates a new mock instance.
+func NewMockValidator(ctrl *gomock.Controller) *MockValidator {
+	mock := &MockValidator{ctrl: ctrl}
+	mock.recorder = &MockValidatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockValidator) EXPECT() *MockValidatorMockRecorder {
+	return m.recorder
+}
+
+// ValidateListStuckEvents mocks base method.
+func (m *MockValidator) ValidateListStuckEvents(arg0 dto.ListStuckEventsRequestDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateListStuckEvents", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateListStuckEvents indicates an expected call of ValidateListStuckEvents.
+func (mr *MockValidatorMockRecorder) ValidateListStuckEvents(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateListStuckEvents", reflect.TypeOf((*MockValidator)(nil).ValidateListStuckEvents), arg0)
+}
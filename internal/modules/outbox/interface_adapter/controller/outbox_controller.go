@@ -0,0 +1,87 @@
+package controller
+
+import (
+	"context"
+	memberhttp "github.com/tomoffice/go-clean-architecture/internal/interface_adapter/transport/http"
+	"net/http"
+
+	gindto "github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/dto"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/errordefs"
+	ginmapper "github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/mapper"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/interface_adapter/mapper"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/interface_adapter/validation"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/usecase/port/input"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/usecase/port/output"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
+)
+
+type OutboxController struct {
+	usecase      input.OutboxInputPort
+	presenter    output.OutboxPresenter
+	dtoValidator validation.Validator
+	logger       logger.Logger
+	tracer       tracer.Tracer
+}
+
+func NewOutboxController(outboxUseCase input.OutboxInputPort, presenter output.OutboxPresenter, dtoValidator validation.Validator, log logger.Logger, tracer tracer.Tracer) *OutboxController {
+	baseLogger := log.With(logger.NewField("layer", "controller"))
+	return &OutboxController{
+		usecase:      outboxUseCase,
+		presenter:    presenter,
+		dtoValidator: dtoValidator,
+		logger:       baseLogger,
+		tracer:       tracer,
+	}
+}
+
+func (c *OutboxController) ListStuck(ctx memberhttp.Context) {
+	// 創建帶有 context 的 logger 用於追蹤
+	requestCtx, contextLogger, span := createTracedLogger(ctx.RequestCtx(), c.tracer, c.logger)
+	defer span.End()
+
+	var ginReqDTO gindto.GinBindingListStuckEventsQueryRequestDTO
+	if err := ctx.BindQuery(&ginReqDTO); err != nil {
+		contextLogger.Error("卡住事件查詢參數綁定錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("query", ctx.Request().URL.RawQuery),
+		)
+		errCode, errMsg := errordefs.MapGinBindingError(err)
+		resp := c.presenter.PresentBindingError(errCode, errMsg)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	reqDTO := ginmapper.GinDTOToListStuckEventsDTO(ginReqDTO)
+	if err := c.dtoValidator.ValidateListStuckEvents(reqDTO); err != nil {
+		contextLogger.Error("卡住事件查詢參數驗證錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("query", ctx.Request().URL.RawQuery),
+		)
+		errCode, resp := c.presenter.PresentValidationError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	filter := mapper.ListStuckEventsDTOToInputModel(reqDTO)
+	pagination := mapper.ListStuckEventsDTOToPagination(reqDTO)
+	events, total, err := c.usecase.ListStuckEvents(requestCtx, filter, *pagination)
+	if err != nil {
+		contextLogger.Error("卡住事件查詢 UseCase 執行錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("status", reqDTO.Status),
+		)
+		errCode, resp := c.presenter.PresentUseCaseError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	resp := c.presenter.PresentListStuckEvents(events, *pagination, total)
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func createTracedLogger(ctx context.Context, tr tracer.Tracer, log logger.Logger) (context.Context, logger.Logger, tracer.Span) {
+	requestCtx, span := tr.Start(ctx, "")
+	lg := log.WithContext(requestCtx)
+	return requestCtx, lg, span
+}
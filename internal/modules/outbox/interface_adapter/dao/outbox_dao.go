@@ -0,0 +1,42 @@
+package dao
+
+//go:generate mockgen -source=outbox_dao.go -destination=../../interface_adapter/gateway/mock/mock_outbox_dao.go -package=mock
+
+import (
+	"context"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"time"
+)
+
+type OutboxRecord struct {
+	ID            int
+	EventID       string
+	EventType     string
+	AggregateType string
+	AggregateID   string
+	Payload       string // JSON 格式的事件內容
+	Status        string
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	CreatedAt     time.Time
+	PublishedAt   *time.Time
+}
+
+// StuckQuery 卡住事件的查詢條件
+type StuckQuery struct {
+	Statuses      []string
+	CreatedBefore time.Time
+}
+
+type OutboxDAO interface {
+	Append(ctx context.Context, records []*OutboxRecord) error
+	GetDue(ctx context.Context, now time.Time, limit int) ([]*OutboxRecord, error)
+	MarkPublished(ctx context.Context, id int, publishedAt time.Time) error
+	MarkFailed(ctx context.Context, id int, status string, attempts int, nextAttemptAt time.Time, lastError string) error
+	GetStuck(ctx context.Context, q StuckQuery, p pagination.Pagination) ([]*OutboxRecord, error)
+	CountStuck(ctx context.Context, q StuckQuery) (int, error)
+	GetByAggregate(ctx context.Context, aggregateType, aggregateID string, p pagination.Pagination) ([]*OutboxRecord, error)
+	// UpdatePayload 只改寫 payload 欄位，供個資遮蔽使用
+	UpdatePayload(ctx context.Context, id int, payload string) error
+}
@@ -0,0 +1,10 @@
+// Package dto 定義 outbox 模組的資料傳輸物件，負責接收外部查詢參數並以 validator 驗證格式。
+package dto
+
+// ListStuckEventsRequestDTO 查詢卡住的事件
+//   - Status 只接受 pending / dead，空字串表示兩者皆列
+type ListStuckEventsRequestDTO struct {
+	Page   int    `validate:"required,min=1"`
+	Limit  int    `validate:"required,min=1,max=100"`
+	Status string `validate:"omitempty,oneof=pending dead"`
+}
@@ -0,0 +1,19 @@
+package dto
+
+import "encoding/json"
+
+type OutboxEventItemDTO struct {
+	ID            int             `json:"id"`
+	EventID       string          `json:"event_id"`
+	EventType     string          `json:"event_type"`
+	Aggregate     string          `json:"aggregate"`
+	Payload       json.RawMessage `json:"payload"`
+	Status        string          `json:"status"`
+	Attempts      int             `json:"attempts"`
+	NextAttemptAt string          `json:"next_attempt_at"`
+	LastError     string          `json:"last_error"`
+	CreatedAt     string          `json:"created_at"`
+}
+type ListStuckEventsResponseDTO struct {
+	Events []OutboxEventItemDTO `json:"events"`
+}
@@ -0,0 +1,154 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: outbox_dao.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	dao "github.com/tomoffice/go-clean-architecture/internal/modules/outbox/interface_adapter/dao"
+	pagination "github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+)
+
+// MockOutboxDAO is a mock of OutboxDAO interface.
+type MockOutboxDAO struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxDAOMockRecorder
+}
+
+// MockOutboxDAOMockRecorder is the mock recorder for MockOutboxDAO.
+type MockOutboxDAOMockRecorder struct {
+	mock *MockOutboxDAO
+}
+
+// NewMockOutboxDAO creates a new mock instance.
+func NewMockOutboxDAO(ctrl *gomock.Controller) *MockOutboxDAO {
+	mock := &MockOutboxDAO{ctrl: ctrl}
+	mock.recorder = &MockOutboxDAOMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutboxDAO) EXPECT() *MockOutboxDAOMockRecorder {
+	return m.recorder
+}
+
+// Append mocks base method.
+func (m *MockOutboxDAO) Append(ctx context.Context, records []*dao.OutboxRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Append", ctx, records)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Append indicates an expected call of Append.
+func (mr *MockOutboxDAOMockRecorder) Append(ctx, records interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockOutboxDAO)(nil).Append), ctx, records)
+}
+
+// CountStuck mocks base method.
+func (m *MockOutboxDAO) CountStuck(ctx context.Context, q dao.StuckQuery) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountStuck", ctx, q)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountStuck indicates an expected call of CountStuck.
+func (mr *MockOutboxDAOMockRecorder) CountStuck(ctx, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountStuck", reflect.TypeOf((*MockOutboxDAO)(nil).CountStuck), ctx, q)
+}
+
+// GetByAggregate mocks base method.
+func (m *MockOutboxDAO) GetByAggregate(ctx context.Context, aggregateType, aggregateID string, p pagination.Pagination) ([]*dao.OutboxRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByAggregate", ctx, aggregateType, aggregateID, p)
+	ret0, _ := ret[0].([]*dao.OutboxRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByAggregate indicates an expected call of GetByAggregate.
+func (mr *MockOutboxDAOMockRecorder) GetByAggregate(ctx, aggregateType, aggregateID, p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAggregate", reflect.TypeOf((*MockOutboxDAO)(nil).GetByAggregate), ctx, aggregateType, aggregateID, p)
+}
+
+// GetDue mocks base method.
+func (m *MockOutboxDAO) GetDue(ctx context.Context, now time.Time, limit int) ([]*dao.OutboxRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDue", ctx, now, limit)
+	ret0, _ := ret[0].([]*dao.OutboxRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDue indicates an expected call of GetDue.
+func (mr *MockOutboxDAOMockRecorder) GetDue(ctx, now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDue", reflect.TypeOf((*MockOutboxDAO)(nil).GetDue), ctx, now, limit)
+}
+
+// GetStuck mocks base method.
+func (m *MockOutboxDAO) GetStuck(ctx context.Context, q dao.StuckQuery, p pagination.Pagination) ([]*dao.OutboxRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStuck", ctx, q, p)
+	ret0, _ := ret[0].([]*dao.OutboxRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStuck indicates an expected call of GetStuck.
+func (mr *MockOutboxDAOMockRecorder) GetStuck(ctx, q, p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStuck", reflect.TypeOf((*MockOutboxDAO)(nil).GetStuck), ctx, q, p)
+}
+
+// MarkFailed mocks base method.
+func (m *MockOutboxDAO) MarkFailed(ctx context.Context, id int, status string, attempts int, nextAttemptAt time.Time, lastError string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkFailed", ctx, id, status, attempts, nextAttemptAt, lastError)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkFailed indicates an expected call of MarkFailed.
+func (mr *MockOutboxDAOMockRecorder) MarkFailed(ctx, id, status, attempts, nextAttemptAt, lastError interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkFailed", reflect.TypeOf((*MockOutboxDAO)(nil).MarkFailed), ctx, id, status, attempts, nextAttemptAt, lastError)
+}
+
+// MarkPublished mocks base method.
+func (m *MockOutboxDAO) MarkPublished(ctx context.Context, id int, publishedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkPublished", ctx, id, publishedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkPublished indicates an expected call of MarkPublished.
+func (mr *MockOutboxDAOMockRecorder) MarkPublished(ctx, id, publishedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkPublished", reflect.TypeOf((*MockOutboxDAO)(nil).MarkPublished), ctx, id, publishedAt)
+}
+
+// UpdatePayload mocks base method.
+func (m *MockOutboxDAO) UpdatePayload(ctx context.Context, id int, payload string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePayload", ctx, id, payload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePayload indicates an expected call of UpdatePayload.
+func (mr *MockOutboxDAOMockRecorder) UpdatePayload(ctx, id, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePayload", reflect.TypeOf((*MockOutboxDAO)(nil).UpdatePayload), ctx, id, payload)
+}
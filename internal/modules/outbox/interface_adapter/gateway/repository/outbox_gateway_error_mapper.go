@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/framework/persistence/sqlx/mcsqlite"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/usecase"
+)
+
+// MapInfraErrorToUsecaseError 將底層 infra 錯誤直接轉為 usecase 定義的 sentinel error
+func MapInfraErrorToUsecaseError(err error) error {
+	if err == nil {
+		return nil
+	}
+	// 先處理 gateway 層的業務語意錯誤
+	if errors.Is(err, ErrGatewayOutboxMappingError) || errors.Is(err, mcsqlite.ErrMapperTimeParseFailed) {
+		return usecase.ErrOutboxMappingError
+	}
+	// 先比對 CustomError
+	switch {
+	case errors.Is(err, mcsqlite.ErrDBRecordNotFound), errors.Is(err, mcsqlite.ErrDBNoEffect):
+		return usecase.ErrOutboxEventNotFound
+	}
+	// 再處理 DBError 類型
+	var dbErr *mcsqlite.DBError
+	if errors.As(err, &dbErr) {
+		return fmt.Errorf("%w: %v", usecase.ErrOutboxDBError, dbErr.RawError)
+	}
+	// fallback：其他未知錯誤
+	return usecase.ErrOutboxUnexpectedError
+}
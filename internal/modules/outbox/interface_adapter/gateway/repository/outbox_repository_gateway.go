@@ -0,0 +1,197 @@
+package repository
+
+import (
+	"context"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/interface_adapter/dao"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/usecase/port/output"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
+	"time"
+)
+
+type OutboxRepoGateway struct {
+	dao    dao.OutboxDAO
+	logger logger.Logger
+	tracer tracer.Tracer
+}
+
+func NewOutboxRepoGateway(dao dao.OutboxDAO, log logger.Logger, tracer tracer.Tracer) output.OutboxPersistence {
+	baseLogger := log.With(logger.NewField("layer", "gateway"))
+	return OutboxRepoGateway{
+		dao:    dao,
+		logger: baseLogger,
+		tracer: tracer,
+	}
+}
+
+func (g OutboxRepoGateway) Append(ctx context.Context, events []*entity.OutboxEvent) error {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.Append")
+	defer span.End()
+
+	records := make([]*dao.OutboxRecord, 0, len(events))
+	for _, e := range events {
+		records = append(records, entityToRecord(e))
+	}
+	if err := g.dao.Append(gatewayCtx, records); err != nil {
+		traceLogger.Error("outbox 事件資料庫寫入失敗", logger.NewField("error", err), logger.NewField("count", len(records)))
+		return MapInfraErrorToUsecaseError(err)
+	}
+	traceLogger.Debug("outbox 事件資料庫寫入成功", logger.NewField("count", len(records)))
+	return nil
+}
+
+func (g OutboxRepoGateway) GetDue(ctx context.Context, now time.Time, limit int) ([]*entity.OutboxEvent, error) {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.GetDue")
+	defer span.End()
+
+	records, err := g.dao.GetDue(gatewayCtx, now, limit)
+	if err != nil {
+		traceLogger.Error("outbox 到期事件資料庫查詢失敗", logger.NewField("error", err), logger.NewField("limit", limit))
+		return nil, MapInfraErrorToUsecaseError(err)
+	}
+	return recordsToEntities(records), nil
+}
+
+func (g OutboxRepoGateway) MarkPublished(ctx context.Context, id int, publishedAt time.Time) error {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.MarkPublished")
+	defer span.End()
+
+	if err := g.dao.MarkPublished(gatewayCtx, id, publishedAt); err != nil {
+		traceLogger.Error("outbox 事件發佈狀態更新失敗", logger.NewField("error", err), logger.NewField("outbox_id", id))
+		return MapInfraErrorToUsecaseError(err)
+	}
+	return nil
+}
+
+func (g OutboxRepoGateway) MarkFailed(ctx context.Context, id int, status entity.Status, attempts int, nextAttemptAt time.Time, lastError string) error {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.MarkFailed")
+	defer span.End()
+
+	if err := g.dao.MarkFailed(gatewayCtx, id, string(status), attempts, nextAttemptAt, lastError); err != nil {
+		traceLogger.Error("outbox 事件失敗狀態更新失敗", logger.NewField("error", err), logger.NewField("outbox_id", id))
+		return MapInfraErrorToUsecaseError(err)
+	}
+	return nil
+}
+
+func (g OutboxRepoGateway) GetStuck(ctx context.Context, filter output.StuckEventFilter, pagination pagination.Pagination) ([]*entity.OutboxEvent, error) {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.GetStuck")
+	defer span.End()
+
+	records, err := g.dao.GetStuck(gatewayCtx, toStuckQuery(filter), pagination)
+	if err != nil {
+		traceLogger.Error("outbox 卡住事件資料庫查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("limit", pagination.Limit),
+			logger.NewField("offset", pagination.Offset),
+		)
+		return nil, MapInfraErrorToUsecaseError(err)
+	}
+	traceLogger.Debug("outbox 卡住事件資料庫查詢成功", logger.NewField("count", len(records)))
+	return recordsToEntities(records), nil
+}
+
+func (g OutboxRepoGateway) CountStuck(ctx context.Context, filter output.StuckEventFilter) (int, error) {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.CountStuck")
+	defer span.End()
+
+	count, err := g.dao.CountStuck(gatewayCtx, toStuckQuery(filter))
+	if err != nil {
+		traceLogger.Error("outbox 卡住事件資料庫總數查詢失敗", logger.NewField("error", err))
+		return 0, MapInfraErrorToUsecaseError(err)
+	}
+	return count, nil
+}
+
+func (g OutboxRepoGateway) GetByAggregate(ctx context.Context, aggregateType, aggregateID string, pagination pagination.Pagination) ([]*entity.OutboxEvent, error) {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.GetByAggregate")
+	defer span.End()
+
+	records, err := g.dao.GetByAggregate(gatewayCtx, aggregateType, aggregateID, pagination)
+	if err != nil {
+		traceLogger.Error("outbox aggregate 事件資料庫查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("aggregate", aggregateType+":"+aggregateID),
+			logger.NewField("offset", pagination.Offset),
+		)
+		return nil, MapInfraErrorToUsecaseError(err)
+	}
+	return recordsToEntities(records), nil
+}
+
+func (g OutboxRepoGateway) ReplacePayload(ctx context.Context, event *entity.OutboxEvent) error {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.ReplacePayload")
+	defer span.End()
+
+	if err := g.dao.UpdatePayload(gatewayCtx, event.ID, string(event.Payload)); err != nil {
+		traceLogger.Error("outbox 事件 payload 資料庫改寫失敗", logger.NewField("error", err), logger.NewField("outbox_id", event.ID))
+		return MapInfraErrorToUsecaseError(err)
+	}
+	return nil
+}
+
+func entityToRecord(e *entity.OutboxEvent) *dao.OutboxRecord {
+	return &dao.OutboxRecord{
+		ID:            e.ID,
+		EventID:       e.EventID,
+		EventType:     e.EventType,
+		AggregateType: e.AggregateType,
+		AggregateID:   e.AggregateID,
+		Payload:       string(e.Payload),
+		Status:        string(e.Status),
+		Attempts:      e.Attempts,
+		NextAttemptAt: e.NextAttemptAt,
+		LastError:     e.LastError,
+		CreatedAt:     e.CreatedAt,
+		PublishedAt:   e.PublishedAt,
+	}
+}
+
+func recordsToEntities(records []*dao.OutboxRecord) []*entity.OutboxEvent {
+	events := make([]*entity.OutboxEvent, 0, len(records))
+	for _, r := range records {
+		events = append(events, &entity.OutboxEvent{
+			ID:            r.ID,
+			EventID:       r.EventID,
+			EventType:     r.EventType,
+			AggregateType: r.AggregateType,
+			AggregateID:   r.AggregateID,
+			Payload:       []byte(r.Payload),
+			Status:        entity.Status(r.Status),
+			Attempts:      r.Attempts,
+			NextAttemptAt: r.NextAttemptAt,
+			LastError:     r.LastError,
+			CreatedAt:     r.CreatedAt,
+			PublishedAt:   r.PublishedAt,
+		})
+	}
+	return events
+}
+
+func toStuckQuery(filter output.StuckEventFilter) dao.StuckQuery {
+	statuses := make([]string, 0, len(filter.Statuses))
+	for _, s := range filter.Statuses {
+		statuses = append(statuses, string(s))
+	}
+	return dao.StuckQuery{
+		Statuses:      statuses,
+		CreatedBefore: filter.CreatedBefore,
+	}
+}
+
+// createTraceLogger 在 Gateway 層建立帶 Trace 的 Logger
+func createTraceLogger(ctx context.Context, tr tracer.Tracer, log logger.Logger, operationName string) (context.Context, logger.Logger, tracer.Span) {
+	gatewayCtx, span := tr.Start(ctx, operationName)
+	lg := log.WithContext(gatewayCtx)
+	return gatewayCtx, lg, span
+}
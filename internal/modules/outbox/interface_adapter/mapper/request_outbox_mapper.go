@@ -0,0 +1,24 @@
+// Package mapper 負責 outbox 模組 DTO 與 usecase 輸入、entity 與回應 DTO 之間的轉換。
+package mapper
+
+import (
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/interface_adapter/dto"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/usecase/inputmodel"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/enum"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+)
+
+func ListStuckEventsDTOToInputModel(request dto.ListStuckEventsRequestDTO) *inputmodel.ListStuckEventsInputModel {
+	return &inputmodel.ListStuckEventsInputModel{
+		Status: entity.Status(request.Status),
+	}
+}
+func ListStuckEventsDTOToPagination(request dto.ListStuckEventsRequestDTO) *pagination.Pagination {
+	return &pagination.Pagination{
+		Limit:   request.Limit,
+		Offset:  (request.Page - 1) * request.Limit,
+		SortBy:  "created_at",
+		OrderBy: enum.OrderByAsc,
+	}
+}
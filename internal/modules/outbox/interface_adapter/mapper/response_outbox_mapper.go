@@ -0,0 +1,34 @@
+package mapper
+
+import (
+	"encoding/json"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/interface_adapter/dto"
+	"time"
+)
+
+func EntityToListStuckEventsResponseDTO(events []*entity.OutboxEvent) dto.ListStuckEventsResponseDTO {
+	items := make([]dto.OutboxEventItemDTO, len(events))
+	for i, e := range events {
+		payload := json.RawMessage(e.Payload)
+		if !json.Valid(payload) {
+			// payload 理應是 JSON，壞掉時以字串呈現方便排查
+			payload, _ = json.Marshal(string(e.Payload))
+		}
+		items[i] = dto.OutboxEventItemDTO{
+			ID:            e.ID,
+			EventID:       e.EventID,
+			EventType:     e.EventType,
+			Aggregate:     e.AggregateType + ":" + e.AggregateID,
+			Payload:       payload,
+			Status:        string(e.Status),
+			Attempts:      e.Attempts,
+			NextAttemptAt: e.NextAttemptAt.Format(time.RFC3339),
+			LastError:     e.LastError,
+			CreatedAt:     e.CreatedAt.Format(time.RFC3339),
+		}
+	}
+	return dto.ListStuckEventsResponseDTO{
+		Events: items,
+	}
+}
@@ -0,0 +1,12 @@
+package outputmodel
+
+import (
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/interface_adapter/dto"
+	sharedviewmodel "github.com/tomoffice/go-clean-architecture/internal/shared/viewmodel/http"
+)
+
+// mockgen 尚未支援泛型，所以用別名展開所有泛型返回類型
+type ListStuckEventsResponse = sharedviewmodel.HTTPResponse[dto.ListStuckEventsResponseDTO]
+
+// 為 any 的情況也必須別名化
+type ErrorResponse = sharedviewmodel.HTTPResponse[any]
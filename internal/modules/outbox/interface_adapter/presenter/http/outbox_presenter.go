@@ -0,0 +1,63 @@
+package http
+
+import (
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/interface_adapter/mapper"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/interface_adapter/outputmodel"
+	sharedenum "github.com/tomoffice/go-clean-architecture/internal/shared/enum"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	sharedviewmodel "github.com/tomoffice/go-clean-architecture/internal/shared/viewmodel/http"
+	"strconv"
+)
+
+type OutboxPresenter struct{}
+
+func NewOutboxPresenter() *OutboxPresenter {
+	return &OutboxPresenter{}
+}
+
+func (p *OutboxPresenter) PresentListStuckEvents(events []*entity.OutboxEvent, pagination pagination.Pagination, total int) outputmodel.ListStuckEventsResponse {
+	respDTO := mapper.EntityToListStuckEventsResponseDTO(events)
+	page := 1
+	if pagination.Limit > 0 {
+		page = pagination.Offset/pagination.Limit + 1
+	}
+	meta := &sharedviewmodel.MetaPayload{
+		Total:  total,
+		Page:   page,
+		Limit:  pagination.Limit,
+		Offset: pagination.Offset,
+	}
+	return buildSuccessResponseWithMeta(respDTO, meta)
+}
+
+func (p *OutboxPresenter) PresentBindingError(errCode int, message string) outputmodel.ErrorResponse {
+	return buildFailedResponse(errCode, message)
+}
+
+func (p *OutboxPresenter) PresentValidationError(err error) (int, outputmodel.ErrorResponse) {
+	errCode, message := MapOutboxValidationError(err)
+	return errCode, buildFailedResponse(errCode, message)
+}
+
+func (p *OutboxPresenter) PresentUseCaseError(err error) (int, outputmodel.ErrorResponse) {
+	errCode, message := MapOutboxUseCaseToPresenterError(err)
+	return errCode, buildFailedResponse(errCode, message)
+}
+
+func buildSuccessResponseWithMeta[T any](data T, meta *sharedviewmodel.MetaPayload) sharedviewmodel.HTTPResponse[T] {
+	return sharedviewmodel.HTTPResponse[T]{
+		Data:             data,
+		Meta:             meta,
+		BaseHTTPResponse: sharedviewmodel.NewBaseHTTPResponse(sharedenum.APIStatusSuccess),
+	}
+}
+func buildFailedResponse(code int, message string) outputmodel.ErrorResponse {
+	return outputmodel.ErrorResponse{
+		Error: &sharedviewmodel.ErrorPayload{
+			Code:    strconv.Itoa(code),
+			Message: message,
+		},
+		BaseHTTPResponse: sharedviewmodel.NewBaseHTTPResponse(sharedenum.APIStatusFailed),
+	}
+}
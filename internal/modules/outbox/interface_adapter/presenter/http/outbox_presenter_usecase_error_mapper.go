@@ -0,0 +1,23 @@
+package http
+
+import (
+	"errors"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/usecase"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/errorcode"
+	sharederrors "github.com/tomoffice/go-clean-architecture/internal/shared/errordefs"
+)
+
+func MapOutboxUseCaseToPresenterError(err error) (int, string) {
+	switch {
+	case errors.Is(err, usecase.ErrOutboxInvalidEvent):
+		return errorcode.ErrOutboxInvalidEvent, usecase.ErrOutboxInvalidEvent.Error()
+	case errors.Is(err, usecase.ErrOutboxDBError):
+		return errorcode.ErrOutboxDBError, usecase.ErrOutboxDBError.Error()
+	case errors.Is(err, usecase.ErrOutboxMappingError):
+		return errorcode.ErrOutboxMappingError, usecase.ErrOutboxMappingError.Error()
+	case errors.Is(err, usecase.ErrOutboxUnexpectedError):
+		return errorcode.ErrUnexpectedOutboxUseCaseError, usecase.ErrOutboxUnexpectedError.Error()
+	default:
+		return errorcode.ErrInternalServer, sharederrors.ErrInternalServer.Error()
+	}
+}
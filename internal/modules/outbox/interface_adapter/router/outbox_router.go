@@ -0,0 +1,26 @@
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	ginadapter "github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/adapter"
+	memberhttp "github.com/tomoffice/go-clean-architecture/internal/interface_adapter/transport/http"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/interface_adapter/controller"
+)
+
+type OutboxRouter struct {
+	controller *controller.OutboxController
+	router     memberhttp.Router
+}
+
+func NewOutboxRouter(ctrl *controller.OutboxController, routerGroup *gin.RouterGroup) *OutboxRouter {
+	moduleGroup := routerGroup.Group("/admin/outbox")
+	return &OutboxRouter{
+		controller: ctrl,
+		router:     ginadapter.NewRouter(moduleGroup),
+	}
+}
+
+func (r *OutboxRouter) Register() error {
+	r.router.GET("/stuck", r.controller.ListStuck)
+	return nil
+}
@@ -0,0 +1,22 @@
+package validation
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/interface_adapter/dto"
+)
+
+type OutboxValidator struct {
+	validator *validator.Validate
+}
+
+func NewOutboxValidator() *OutboxValidator {
+	return &OutboxValidator{
+		validator: validator.New(),
+	}
+}
+func (v *OutboxValidator) ValidateListStuckEvents(dto dto.ListStuckEventsRequestDTO) error {
+	if err := v.validator.Struct(dto); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package outbox
+
+import (
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
+	"time"
+
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxdriver"
+	"github.com/tomoffice/go-clean-architecture/internal/modules"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/framework/dispatcher"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/framework/persistence/sqlx/mcsqlite"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/framework/persistence/sqlx/pgsql"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/framework/publisher"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/interface_adapter/controller"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/interface_adapter/dao"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/interface_adapter/gateway/repository"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/interface_adapter/presenter/http"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/interface_adapter/router"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/interface_adapter/validation"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/usecase"
+)
+
+// Factory outbox 模組工廠
+type Factory struct {
+	publisherOptions publisher.Options
+	bus              *publisher.Bus
+	policy           usecase.RetryPolicy
+	pollInterval     time.Duration
+}
+
+// NewModuleFactory 創建 outbox 模組工廠
+//   - bus 一律會收到事件，呼叫端可事先註冊訂閱者
+func NewModuleFactory(publisherOptions publisher.Options, bus *publisher.Bus, policy usecase.RetryPolicy, pollInterval time.Duration) modules.ModuleFactory {
+	return &Factory{
+		publisherOptions: publisherOptions,
+		bus:              bus,
+		policy:           policy,
+		pollInterval:     pollInterval,
+	}
+}
+
+// CreateModule 創建 outbox 模組，注入 logger 和 tracer 到需要的組件中
+func (f *Factory) CreateModule(db *sqlx.DB, rg *gin.RouterGroup, log logger.Logger, tracer tracer.Tracer) (modules.Module, error) {
+	// 創建帶有模組標識的子 logger
+	moduleLogger := log.With(logger.NewField("module", "outbox"))
+
+	eventPublisher, err := publisher.NewEventPublisher(f.publisherOptions, f.bus, moduleLogger)
+	if err != nil {
+		return nil, err
+	}
+
+	// 組裝所有組件
+	validator := validation.NewOutboxValidator()
+	var repo dao.OutboxDAO
+	switch sqlxdriver.Driver(db.DriverName()) {
+	case sqlxdriver.DriverSQLite:
+		repo = mcsqlite.NewSqlxOutboxSqlite(db, moduleLogger, tracer)
+	case sqlxdriver.DriverPostgres:
+		repo = pgsql.NewSqlxOutboxPgsql(db, moduleLogger, tracer)
+	default:
+		return nil, fmt.Errorf("outbox: unsupported database driver %q", db.DriverName())
+	}
+	gateway := repository.NewOutboxRepoGateway(repo, moduleLogger, tracer)
+	useCase := usecase.NewOutboxUseCase(gateway, eventPublisher, f.policy, moduleLogger, tracer)
+	presenter := http.NewOutboxPresenter()
+	controller := controller.NewOutboxController(useCase, presenter, validator, moduleLogger, tracer)
+	router := router.NewOutboxRouter(controller, rg)
+	eventDispatcher := dispatcher.NewDispatcher(useCase, f.pollInterval, moduleLogger)
+
+	// 創建並返回模組實例
+	return NewModule(router, useCase, eventDispatcher), nil
+}
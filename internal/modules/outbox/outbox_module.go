@@ -0,0 +1,50 @@
+package outbox
+
+import (
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/framework/dispatcher"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/interface_adapter/router"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/usecase/port/input"
+)
+
+// Module outbox 模組 - 具體產品
+type Module struct {
+	router     *router.OutboxRouter
+	useCase    input.OutboxInputPort
+	dispatcher *dispatcher.Dispatcher
+}
+
+// NewModule 創建 outbox 模組實例
+func NewModule(router *router.OutboxRouter, useCase input.OutboxInputPort, dispatcher *dispatcher.Dispatcher) *Module {
+	return &Module{
+		router:     router,
+		useCase:    useCase,
+		dispatcher: dispatcher,
+	}
+}
+
+// Name 實現 Module 接口
+func (m *Module) Name() string {
+	return "outbox"
+}
+
+// Setup 實現 Module 接口
+func (m *Module) Setup() error {
+	// 純粹的委派，不做任何組裝邏輯
+	return m.router.Register()
+}
+
+// Shutdown 實現 Module 接口
+func (m *Module) Shutdown() error {
+	// dispatcher 由啟動它的 context 控制停止
+	return nil
+}
+
+// InputPort 提供其他模組寫入 outbox 事件用的 input port
+func (m *Module) InputPort() input.OutboxInputPort {
+	return m.useCase
+}
+
+// Dispatcher 背景發佈事件的 dispatcher，由 bootstrap 決定是否啟動
+func (m *Module) Dispatcher() *dispatcher.Dispatcher {
+	return m.dispatcher
+}
@@ -0,0 +1,24 @@
+package usecase
+
+import "errors"
+
+// OutboxUseCase 錯誤碼
+var (
+	//------- gateway error mapping -------
+	// ErrOutboxDBError 遇到 DB 或 infra 技術性問題。
+	ErrOutboxDBError = errors.New("usecase: outbox db operation error")
+	// ErrOutboxMappingError 從 repo model 轉換到 entity 時發生錯誤，像是時間格式有誤等。
+	ErrOutboxMappingError = errors.New("usecase: outbox mapping repo model to entity failed")
+	// ErrOutboxUnexpectedError 不知道怎麼歸類的錯誤就收斂到這個。
+	ErrOutboxUnexpectedError = errors.New("usecase: outbox usecase unexpected error")
+	// ErrOutboxEventNotFound 要更新狀態的事件不存在。
+	ErrOutboxEventNotFound = errors.New("usecase: outbox event not found")
+
+	// ------- usecase 內部的業務語意 -------
+	// ErrOutboxInvalidEvent 事件缺少 type 或 aggregate。
+	ErrOutboxInvalidEvent = errors.New("usecase: outbox event invalid")
+	// ErrOutboxPublishFailed 發佈事件失敗，事件會依重試策略延後再發。
+	ErrOutboxPublishFailed = errors.New("usecase: outbox event publish failed")
+	// ErrOutboxInvalidRedaction 遮蔽條件缺少 aggregate 或欄位。
+	ErrOutboxInvalidRedaction = errors.New("usecase: outbox redaction invalid")
+)
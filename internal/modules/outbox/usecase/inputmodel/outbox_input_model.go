@@ -0,0 +1,18 @@
+package inputmodel
+
+import "github.com/tomoffice/go-clean-architecture/internal/modules/outbox/entity"
+
+// ListStuckEventsInputModel 查詢卡住的事件
+//   - Status : 只看特定狀態（pending / dead），空字串表示兩者皆列
+type ListStuckEventsInputModel struct {
+	Status entity.Status
+}
+
+// RedactAggregateInputModel 遮蔽某個 aggregate 所有事件 payload 中的個資
+//   - AggregateType/AggregateID : 事件來源，例如 member / 1，皆為必填
+//   - Fields : payload 中要遮蔽的欄位名稱，例如 name、email
+type RedactAggregateInputModel struct {
+	AggregateType string
+	AggregateID   string
+	Fields        []string
+}
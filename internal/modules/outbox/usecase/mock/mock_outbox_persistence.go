@@ -0,0 +1,155 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: outbox_persistence.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/tomoffice/go-clean-architecture/internal/modules/outbox/entity"
+	output "github.com/tomoffice/go-clean-architecture/internal/modules/outbox/usecase/port/output"
+	pagination "github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+)
+
+// MockOutboxPersistence is a mock of OutboxPersistence interface.
+type MockOutboxPersistence struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxPersistenceMockRecorder
+}
+
+// MockOutboxPersistenceMockRecorder is the mock recorder for MockOutboxPersistence.
+type MockOutboxPersistenceMockRecorder struct {
+	mock *MockOutboxPersistence
+}
+
+// NewMockOutboxPersistence creates a new mock instance.
+func NewMockOutboxPersistence(ctrl *gomock.Controller) *MockOutboxPersistence {
+	mock := &MockOutboxPersistence{ctrl: ctrl}
+	mock.recorder = &MockOutboxPersistenceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutboxPersistence) EXPECT() *MockOutboxPersistenceMockRecorder {
+	return m.recorder
+}
+
+// Append mocks base method.
+func (m *MockOutboxPersistence) Append(ctx context.Context, events []*entity.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Append", ctx, events)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Append indicates an expected call of Append.
+func (mr *MockOutboxPersistenceMockRecorder) Append(ctx, events interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockOutboxPersistence)(nil).Append), ctx, events)
+}
+
+// CountStuck mocks base method.
+func (m *MockOutboxPersistence) CountStuck(ctx context.Context, filter output.StuckEventFilter) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountStuck", ctx, filter)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountStuck indicates an expected call of CountStuck.
+func (mr *MockOutboxPersistenceMockRecorder) CountStuck(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountStuck", reflect.TypeOf((*MockOutboxPersistence)(nil).CountStuck), ctx, filter)
+}
+
+// GetByAggregate mocks base method.
+func (m *MockOutboxPersistence) GetByAggregate(ctx context.Context, aggregateType, aggregateID string, pagination pagination.Pagination) ([]*entity.OutboxEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByAggregate", ctx, aggregateType, aggregateID, pagination)
+	ret0, _ := ret[0].([]*entity.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByAggregate indicates an expected call of GetByAggregate.
+func (mr *MockOutboxPersistenceMockRecorder) GetByAggregate(ctx, aggregateType, aggregateID, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAggregate", reflect.TypeOf((*MockOutboxPersistence)(nil).GetByAggregate), ctx, aggregateType, aggregateID, pagination)
+}
+
+// GetDue mocks base method.
+func (m *MockOutboxPersistence) GetDue(ctx context.Context, now time.Time, limit int) ([]*entity.OutboxEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDue", ctx, now, limit)
+	ret0, _ := ret[0].([]*entity.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDue indicates an expected call of GetDue.
+func (mr *MockOutboxPersistenceMockRecorder) GetDue(ctx, now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDue", reflect.TypeOf((*MockOutboxPersistence)(nil).GetDue), ctx, now, limit)
+}
+
+// GetStuck mocks base method.
+func (m *MockOutboxPersistence) GetStuck(ctx context.Context, filter output.StuckEventFilter, pagination pagination.Pagination) ([]*entity.OutboxEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStuck", ctx, filter, pagination)
+	ret0, _ := ret[0].([]*entity.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStuck indicates an expected call of GetStuck.
+func (mr *MockOutboxPersistenceMockRecorder) GetStuck(ctx, filter, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStuck", reflect.TypeOf((*MockOutboxPersistence)(nil).GetStuck), ctx, filter, pagination)
+}
+
+// MarkFailed mocks base method.
+func (m *MockOutboxPersistence) MarkFailed(ctx context.Context, id int, status entity.Status, attempts int, nextAttemptAt time.Time, lastError string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkFailed", ctx, id, status, attempts, nextAttemptAt, lastError)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkFailed indicates an expected call of MarkFailed.
+func (mr *MockOutboxPersistenceMockRecorder) MarkFailed(ctx, id, status, attempts, nextAttemptAt, lastError interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkFailed", reflect.TypeOf((*MockOutboxPersistence)(nil).MarkFailed), ctx, id, status, attempts, nextAttemptAt, lastError)
+}
+
+// MarkPublished mocks base method.
+func (m *MockOutboxPersistence) MarkPublished(ctx context.Context, id int, publishedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkPublished", ctx, id, publishedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkPublished indicates an expected call of MarkPublished.
+func (mr *MockOutboxPersistenceMockRecorder) MarkPublished(ctx, id, publishedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkPublished", reflect.TypeOf((*MockOutboxPersistence)(nil).MarkPublished), ctx, id, publishedAt)
+}
+
+// ReplacePayload mocks base method.
+func (m *MockOutboxPersistence) ReplacePayload(ctx context.Context, event *entity.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplacePayload", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplacePayload indicates an expected call of ReplacePayload.
+func (mr *MockOutboxPersistenceMockRecorder) ReplacePayload(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplacePayload", reflect.TypeOf((*MockOutboxPersistence)(nil).ReplacePayload), ctx, event)
+}
@@ -0,0 +1,280 @@
+// Package usecase 定義 outbox 模組的應用層業務邏輯，負責寫入、發佈與查詢待發佈事件。
+//
+// 職責:
+// - 檢查事件必要欄位並補上預設值（event id、狀態、時間）
+// - 依重試策略發佈到期事件並記錄嘗試次數
+// - 列出卡住的事件供管理端查看
+// - 個資刪除時遮蔽事件 payload 中的個資
+// - 不依賴外部框架（如 HTTP、DB）
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/usecase/inputmodel"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/usecase/port/input"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/usecase/port/output"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
+	"time"
+)
+
+// maxLastErrorLength last_error 欄位只保留前段，避免外部回應把表撐大
+const maxLastErrorLength = 1024
+
+type OutboxUseCase struct {
+	OutboxGateway output.OutboxPersistence
+	publisher     output.EventPublisher
+	policy        RetryPolicy
+	logger        logger.Logger
+	tracer        tracer.Tracer
+	now           func() time.Time
+}
+
+func NewOutboxUseCase(outboxRepo output.OutboxPersistence, publisher output.EventPublisher, policy RetryPolicy, log logger.Logger, tracer tracer.Tracer) input.OutboxInputPort {
+	baseLogger := log.With(logger.NewField("layer", "usecase"))
+	return &OutboxUseCase{
+		OutboxGateway: outboxRepo,
+		publisher:     publisher,
+		policy:        policy,
+		logger:        baseLogger,
+		tracer:        tracer,
+		now:           time.Now,
+	}
+}
+
+func (o *OutboxUseCase) Enqueue(ctx context.Context, events ...*entity.OutboxEvent) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, o.tracer, o.logger)
+	defer span.End()
+
+	if len(events) == 0 {
+		return nil
+	}
+	now := o.now().UTC()
+	for _, event := range events {
+		if err := event.Validate(); err != nil {
+			contextLogger.Error("outbox 事件欄位不完整",
+				logger.NewField("error", err),
+				logger.NewField("event_type", event.EventType),
+			)
+			return errors.Join(ErrOutboxInvalidEvent, err)
+		}
+		if event.EventID == "" {
+			event.EventID = uuid.NewString()
+		}
+		if len(event.Payload) == 0 {
+			event.Payload = []byte("{}")
+		}
+		event.Status = entity.StatusPending
+		event.Attempts = 0
+		event.NextAttemptAt = now
+		if event.CreatedAt.IsZero() {
+			event.CreatedAt = now
+		}
+	}
+
+	if err := o.OutboxGateway.Append(transCtx, events); err != nil {
+		contextLogger.Error("outbox 事件寫入 Gateway 執行失敗",
+			logger.NewField("error", err),
+			logger.NewField("count", len(events)),
+		)
+		return err
+	}
+
+	contextLogger.Debug("outbox 事件寫入成功",
+		logger.NewField("count", len(events)),
+		logger.NewField("event_type", events[0].EventType),
+	)
+	return nil
+}
+
+func (o *OutboxUseCase) DispatchPending(ctx context.Context) (int, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, o.tracer, o.logger)
+	defer span.End()
+
+	events, err := o.OutboxGateway.GetDue(transCtx, o.now().UTC(), o.policy.BatchSize)
+	if err != nil {
+		contextLogger.Error("outbox 到期事件查詢 Gateway 執行失敗",
+			logger.NewField("error", err),
+		)
+		return 0, err
+	}
+
+	published := 0
+	for _, event := range events {
+		if transCtx.Err() != nil {
+			// dispatcher 正在關閉，剩下的事件留給下一輪
+			break
+		}
+		publishErr := o.publisher.Publish(transCtx, event)
+		if publishErr == nil {
+			if err := o.OutboxGateway.MarkPublished(transCtx, event.ID, o.now().UTC()); err != nil {
+				// 已送出但狀態未更新，下一輪會重送一次（at-least-once）
+				contextLogger.Error("outbox 事件狀態更新失敗",
+					logger.NewField("error", err),
+					logger.NewField("event_id", event.EventID),
+				)
+				return published, err
+			}
+			published++
+			continue
+		}
+
+		attempts := event.Attempts + 1
+		status := entity.StatusPending
+		if o.policy.Exhausted(attempts) {
+			status = entity.StatusDead
+		}
+		nextAttemptAt := o.now().UTC().Add(o.policy.Backoff(attempts))
+		if err := o.OutboxGateway.MarkFailed(transCtx, event.ID, status, attempts, nextAttemptAt, truncate(publishErr.Error(), maxLastErrorLength)); err != nil {
+			contextLogger.Error("outbox 事件失敗紀錄更新失敗",
+				logger.NewField("error", err),
+				logger.NewField("event_id", event.EventID),
+			)
+			return published, err
+		}
+		if status == entity.StatusDead {
+			contextLogger.Error("outbox 事件超過重試上限，停止發佈",
+				logger.NewField("error", publishErr),
+				logger.NewField("event_id", event.EventID),
+				logger.NewField("event_type", event.EventType),
+				logger.NewField("attempts", attempts),
+			)
+			continue
+		}
+		contextLogger.Warn("outbox 事件發佈失敗，稍後重試",
+			logger.NewField("error", publishErr),
+			logger.NewField("event_id", event.EventID),
+			logger.NewField("event_type", event.EventType),
+			logger.NewField("attempts", attempts),
+			logger.NewField("next_attempt_at", nextAttemptAt),
+		)
+	}
+
+	if len(events) > 0 {
+		contextLogger.Debug("outbox 事件發佈完成",
+			logger.NewField("due", len(events)),
+			logger.NewField("published", published),
+		)
+	}
+	return published, nil
+}
+
+func (o *OutboxUseCase) ListStuckEvents(ctx context.Context, filter *inputmodel.ListStuckEventsInputModel, pagination pagination.Pagination) ([]*entity.OutboxEvent, int, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, o.tracer, o.logger)
+	defer span.End()
+
+	stuckFilter := output.StuckEventFilter{
+		Statuses:      []entity.Status{entity.StatusPending, entity.StatusDead},
+		CreatedBefore: o.now().UTC().Add(-o.policy.StuckAfter),
+	}
+	if filter != nil && filter.Status != "" {
+		stuckFilter.Statuses = []entity.Status{filter.Status}
+	}
+
+	events, err := o.OutboxGateway.GetStuck(transCtx, stuckFilter, pagination)
+	if err != nil {
+		contextLogger.Error("outbox 卡住事件查詢 Gateway 執行失敗",
+			logger.NewField("error", err),
+			logger.NewField("limit", pagination.Limit),
+			logger.NewField("offset", pagination.Offset),
+		)
+		return nil, 0, err
+	}
+	total, err := o.OutboxGateway.CountStuck(transCtx, stuckFilter)
+	if err != nil {
+		contextLogger.Error("outbox 卡住事件總數查詢 Gateway 執行失敗",
+			logger.NewField("error", err),
+		)
+		return nil, 0, err
+	}
+
+	contextLogger.Debug("outbox 卡住事件查詢成功",
+		logger.NewField("count", len(events)),
+		logger.NewField("total", total),
+	)
+	return events, total, nil
+}
+
+// redactPageSize 遮蔽個資時每次讀取的事件筆數
+const redactPageSize = 100
+
+func (o *OutboxUseCase) RedactAggregate(ctx context.Context, input *inputmodel.RedactAggregateInputModel) ([]string, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, o.tracer, o.logger)
+	defer span.End()
+
+	if input.AggregateType == "" || input.AggregateID == "" || len(input.Fields) == 0 {
+		contextLogger.Error("outbox 事件遮蔽條件不完整",
+			logger.NewField("aggregate_type", input.AggregateType),
+			logger.NewField("aggregate_id", input.AggregateID),
+			logger.NewField("fields", input.Fields),
+		)
+		return nil, ErrOutboxInvalidRedaction
+	}
+
+	eventIDs := make([]string, 0)
+	redacted := 0
+	// 只改寫 payload，不影響排序與筆數，offset 分頁在迴圈中保持穩定
+	for offset := 0; ; offset += redactPageSize {
+		events, err := o.OutboxGateway.GetByAggregate(transCtx, input.AggregateType, input.AggregateID, pagination.Pagination{Limit: redactPageSize, Offset: offset})
+		if err != nil {
+			contextLogger.Error("outbox 事件遮蔽查詢 Gateway 執行失敗",
+				logger.NewField("error", err),
+				logger.NewField("offset", offset),
+			)
+			return nil, err
+		}
+		for _, event := range events {
+			eventIDs = append(eventIDs, event.EventID)
+			changed, err := event.RedactPayload(input.Fields...)
+			if err != nil {
+				contextLogger.Error("outbox 事件 payload 解析失敗",
+					logger.NewField("error", err),
+					logger.NewField("event_id", event.EventID),
+				)
+				return nil, errors.Join(ErrOutboxUnexpectedError, err)
+			}
+			if !changed {
+				continue
+			}
+			if err := o.OutboxGateway.ReplacePayload(transCtx, event); err != nil {
+				contextLogger.Error("outbox 事件遮蔽 Gateway 執行失敗",
+					logger.NewField("error", err),
+					logger.NewField("event_id", event.EventID),
+				)
+				return nil, err
+			}
+			redacted++
+		}
+		if len(events) < redactPageSize {
+			break
+		}
+	}
+
+	contextLogger.Info("outbox 事件個資遮蔽完成",
+		logger.NewField("aggregate", input.AggregateType+":"+input.AggregateID),
+		logger.NewField("events", len(eventIDs)),
+		logger.NewField("redacted", redacted),
+	)
+	return eventIDs, nil
+}
+
+func truncate(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	return s[:max]
+}
+
+func createTracedLogger(ctx context.Context, tr tracer.Tracer, log logger.Logger) (context.Context, logger.Logger, tracer.Span) {
+	transCtx, span := tr.Start(ctx, "")
+	lg := log.WithContext(transCtx)
+	return transCtx, lg, span
+}
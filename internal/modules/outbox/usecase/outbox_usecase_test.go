@@ -0,0 +1,388 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/usecase/inputmodel"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/usecase/mock"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/usecase/port/output"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	mocklogger "github.com/tomoffice/go-clean-architecture/pkg/logger/mock"
+	mocktracer "github.com/tomoffice/go-clean-architecture/pkg/tracer/mock"
+	"testing"
+	"time"
+)
+
+func TestOutboxUseCase_Enqueue(t *testing.T) {
+	ctrl, ctx, testTime, mockLogger, mockTracer := repoHelper(t)
+	tests := []struct {
+		name      string
+		events    []*entity.OutboxEvent
+		repoSetup func(*mock.MockOutboxPersistence)
+		wantErr   error
+	}{
+		{
+			name: "normal test fills id, status and schedule",
+			events: []*entity.OutboxEvent{
+				{EventType: "member.registered", AggregateType: "member", AggregateID: "1", Payload: []byte(`{"member_id":1}`)},
+			},
+			repoSetup: func(r *mock.MockOutboxPersistence) {
+				r.EXPECT().Append(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, events []*entity.OutboxEvent) error {
+					e := events[0]
+					assert.NotEmpty(t, e.EventID)
+					assert.Equal(t, entity.StatusPending, e.Status)
+					assert.Equal(t, 0, e.Attempts)
+					assert.Equal(t, testTime, e.NextAttemptAt)
+					assert.Equal(t, testTime, e.CreatedAt)
+					return nil
+				})
+			},
+		},
+		{
+			name:      "no events",
+			events:    nil,
+			repoSetup: func(r *mock.MockOutboxPersistence) {},
+		},
+		{
+			name: "invalid event",
+			events: []*entity.OutboxEvent{
+				{AggregateType: "member", AggregateID: "1"},
+			},
+			repoSetup: func(r *mock.MockOutboxPersistence) {},
+			wantErr:   ErrOutboxInvalidEvent,
+		},
+		{
+			name: "append error",
+			events: []*entity.OutboxEvent{
+				{EventType: "member.deleted", AggregateType: "member", AggregateID: "1"},
+			},
+			repoSetup: func(r *mock.MockOutboxPersistence) {
+				r.EXPECT().Append(ctx, gomock.Any()).Return(ErrOutboxDBError)
+			},
+			wantErr: ErrOutboxDBError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockOutboxPersistence(ctrl)
+			o := &OutboxUseCase{
+				OutboxGateway: mockRepo,
+				policy:        DefaultRetryPolicy(),
+				logger:        mockLogger,
+				tracer:        mockTracer,
+				now:           func() time.Time { return testTime },
+			}
+			tt.repoSetup(mockRepo)
+			err := o.Enqueue(ctx, tt.events...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Enqueue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestOutboxUseCase_DispatchPending(t *testing.T) {
+	ctrl, ctx, testTime, mockLogger, mockTracer := repoHelper(t)
+	policy := RetryPolicy{BatchSize: 10, MaxAttempts: 3, BaseBackoff: time.Second, MaxBackoff: time.Minute}
+	event := func(id, attempts int) *entity.OutboxEvent {
+		return &entity.OutboxEvent{ID: id, EventID: "evt", EventType: "member.deleted", Attempts: attempts}
+	}
+	publishErr := errors.New("connection refused")
+	tests := []struct {
+		name          string
+		setup         func(*mock.MockOutboxPersistence, *mock.MockEventPublisher)
+		wantPublished int
+		wantErr       error
+	}{
+		{
+			name: "publish success marks published",
+			setup: func(r *mock.MockOutboxPersistence, p *mock.MockEventPublisher) {
+				gomock.InOrder(
+					r.EXPECT().GetDue(ctx, testTime, 10).Return([]*entity.OutboxEvent{event(1, 0), event(2, 0)}, nil),
+					p.EXPECT().Publish(ctx, gomock.Any()).Return(nil),
+					r.EXPECT().MarkPublished(ctx, 1, testTime).Return(nil),
+					p.EXPECT().Publish(ctx, gomock.Any()).Return(nil),
+					r.EXPECT().MarkPublished(ctx, 2, testTime).Return(nil),
+				)
+			},
+			wantPublished: 2,
+		},
+		{
+			name: "publish failure schedules retry with backoff",
+			setup: func(r *mock.MockOutboxPersistence, p *mock.MockEventPublisher) {
+				gomock.InOrder(
+					r.EXPECT().GetDue(ctx, testTime, 10).Return([]*entity.OutboxEvent{event(1, 1)}, nil),
+					p.EXPECT().Publish(ctx, gomock.Any()).Return(publishErr),
+					r.EXPECT().MarkFailed(ctx, 1, entity.StatusPending, 2, testTime.Add(2*time.Second), publishErr.Error()).Return(nil),
+				)
+			},
+			wantPublished: 0,
+		},
+		{
+			name: "publish failure at max attempts marks dead",
+			setup: func(r *mock.MockOutboxPersistence, p *mock.MockEventPublisher) {
+				gomock.InOrder(
+					r.EXPECT().GetDue(ctx, testTime, 10).Return([]*entity.OutboxEvent{event(1, 2)}, nil),
+					p.EXPECT().Publish(ctx, gomock.Any()).Return(publishErr),
+					r.EXPECT().MarkFailed(ctx, 1, entity.StatusDead, 3, gomock.Any(), publishErr.Error()).Return(nil),
+				)
+			},
+			wantPublished: 0,
+		},
+		{
+			name: "get due error",
+			setup: func(r *mock.MockOutboxPersistence, p *mock.MockEventPublisher) {
+				r.EXPECT().GetDue(ctx, testTime, 10).Return(nil, ErrOutboxDBError)
+			},
+			wantErr: ErrOutboxDBError,
+		},
+		{
+			name: "mark published error stops the batch",
+			setup: func(r *mock.MockOutboxPersistence, p *mock.MockEventPublisher) {
+				gomock.InOrder(
+					r.EXPECT().GetDue(ctx, testTime, 10).Return([]*entity.OutboxEvent{event(1, 0), event(2, 0)}, nil),
+					p.EXPECT().Publish(ctx, gomock.Any()).Return(nil),
+					r.EXPECT().MarkPublished(ctx, 1, testTime).Return(ErrOutboxDBError),
+				)
+			},
+			wantErr: ErrOutboxDBError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockOutboxPersistence(ctrl)
+			mockPublisher := mock.NewMockEventPublisher(ctrl)
+			o := &OutboxUseCase{
+				OutboxGateway: mockRepo,
+				publisher:     mockPublisher,
+				policy:        policy,
+				logger:        mockLogger,
+				tracer:        mockTracer,
+				now:           func() time.Time { return testTime },
+			}
+			tt.setup(mockRepo, mockPublisher)
+			published, err := o.DispatchPending(ctx)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("DispatchPending() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.wantPublished, published)
+		})
+	}
+}
+
+func TestOutboxUseCase_ListStuckEvents(t *testing.T) {
+	ctrl, ctx, testTime, mockLogger, mockTracer := repoHelper(t)
+	page := pagination.Pagination{Limit: 10, Offset: 0}
+	events := []*entity.OutboxEvent{{ID: 1, Status: entity.StatusDead, Attempts: 10}}
+	tests := []struct {
+		name       string
+		filter     *inputmodel.ListStuckEventsInputModel
+		wantFilter output.StuckEventFilter
+		getErr     error
+		countErr   error
+		wantErr    error
+	}{
+		{
+			name:   "default lists pending and dead",
+			filter: &inputmodel.ListStuckEventsInputModel{},
+			wantFilter: output.StuckEventFilter{
+				Statuses:      []entity.Status{entity.StatusPending, entity.StatusDead},
+				CreatedBefore: testTime.Add(-5 * time.Minute),
+			},
+		},
+		{
+			name:   "status filter",
+			filter: &inputmodel.ListStuckEventsInputModel{Status: entity.StatusDead},
+			wantFilter: output.StuckEventFilter{
+				Statuses:      []entity.Status{entity.StatusDead},
+				CreatedBefore: testTime.Add(-5 * time.Minute),
+			},
+		},
+		{
+			name:    "get stuck error",
+			filter:  &inputmodel.ListStuckEventsInputModel{},
+			getErr:  ErrOutboxDBError,
+			wantErr: ErrOutboxDBError,
+		},
+		{
+			name:     "count stuck error",
+			filter:   &inputmodel.ListStuckEventsInputModel{},
+			countErr: ErrOutboxDBError,
+			wantErr:  ErrOutboxDBError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockOutboxPersistence(ctrl)
+			o := &OutboxUseCase{
+				OutboxGateway: mockRepo,
+				policy:        DefaultRetryPolicy(),
+				logger:        mockLogger,
+				tracer:        mockTracer,
+				now:           func() time.Time { return testTime },
+			}
+			getCall := mockRepo.EXPECT().GetStuck(ctx, gomock.Any(), page).DoAndReturn(
+				func(_ context.Context, f output.StuckEventFilter, _ pagination.Pagination) ([]*entity.OutboxEvent, error) {
+					if tt.wantFilter.Statuses != nil {
+						assert.Equal(t, tt.wantFilter, f)
+					}
+					if tt.getErr != nil {
+						return nil, tt.getErr
+					}
+					return events, nil
+				})
+			if tt.getErr == nil {
+				mockRepo.EXPECT().CountStuck(ctx, gomock.Any()).Return(1, tt.countErr).After(getCall)
+			}
+			got, total, err := o.ListStuckEvents(ctx, tt.filter, page)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ListStuckEvents() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr == nil {
+				assert.Equal(t, events, got)
+				assert.Equal(t, 1, total)
+			}
+		})
+	}
+}
+
+func TestOutboxUseCase_RedactAggregate(t *testing.T) {
+	ctrl, ctx, testTime, mockLogger, mockTracer := repoHelper(t)
+	input := &inputmodel.RedactAggregateInputModel{AggregateType: "member", AggregateID: "1", Fields: []string{"name", "email"}}
+	firstPage := pagination.Pagination{Limit: redactPageSize, Offset: 0}
+	newEvents := func() []*entity.OutboxEvent {
+		return []*entity.OutboxEvent{
+			{ID: 1, EventID: "evt-1", EventType: "member.registered", AggregateType: "member", AggregateID: "1", CreatedAt: testTime,
+				Payload: []byte(`{"member_id":1,"name":"tom","email":"tom@gmail.com"}`)},
+			{ID: 2, EventID: "evt-2", EventType: "member.deleted", AggregateType: "member", AggregateID: "1", CreatedAt: testTime,
+				Payload: []byte(`{"member_id":1}`)},
+		}
+	}
+	tests := []struct {
+		name      string
+		input     *inputmodel.RedactAggregateInputModel
+		repoSetup func(*mock.MockOutboxPersistence)
+		want      []string
+		wantErr   error
+	}{
+		{
+			name:  "normal test only rewrites events with pii",
+			input: input,
+			repoSetup: func(r *mock.MockOutboxPersistence) {
+				r.EXPECT().GetByAggregate(ctx, "member", "1", firstPage).Return(newEvents(), nil)
+				r.EXPECT().ReplacePayload(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, e *entity.OutboxEvent) error {
+					assert.Equal(t, "evt-1", e.EventID)
+					assert.JSONEq(t, `{"member_id":1,"name":"[redacted]","email":"[redacted]"}`, string(e.Payload))
+					return nil
+				})
+			},
+			want: []string{"evt-1", "evt-2"},
+		},
+		{
+			name:  "already redacted is no-op",
+			input: input,
+			repoSetup: func(r *mock.MockOutboxPersistence) {
+				events := newEvents()
+				for _, e := range events {
+					_, _ = e.RedactPayload("name", "email")
+				}
+				r.EXPECT().GetByAggregate(ctx, "member", "1", firstPage).Return(events, nil)
+			},
+			want: []string{"evt-1", "evt-2"},
+		},
+		{
+			name:      "missing fields",
+			input:     &inputmodel.RedactAggregateInputModel{AggregateType: "member", AggregateID: "1"},
+			repoSetup: func(r *mock.MockOutboxPersistence) {},
+			wantErr:   ErrOutboxInvalidRedaction,
+		},
+		{
+			name:  "invalid payload",
+			input: input,
+			repoSetup: func(r *mock.MockOutboxPersistence) {
+				events := newEvents()
+				events[0].Payload = []byte("{")
+				r.EXPECT().GetByAggregate(ctx, "member", "1", firstPage).Return(events, nil)
+			},
+			wantErr: ErrOutboxUnexpectedError,
+		},
+		{
+			name:  "replace payload error",
+			input: input,
+			repoSetup: func(r *mock.MockOutboxPersistence) {
+				r.EXPECT().GetByAggregate(ctx, "member", "1", firstPage).Return(newEvents(), nil)
+				r.EXPECT().ReplacePayload(ctx, gomock.Any()).Return(ErrOutboxDBError)
+			},
+			wantErr: ErrOutboxDBError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockOutboxPersistence(ctrl)
+			o := &OutboxUseCase{
+				OutboxGateway: mockRepo,
+				logger:        mockLogger,
+				tracer:        mockTracer,
+			}
+			tt.repoSetup(mockRepo)
+			got, err := o.RedactAggregate(ctx, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("RedactAggregate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 5, BaseBackoff: time.Second, MaxBackoff: 10 * time.Second}
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 0, want: time.Second},
+		{attempts: 1, want: time.Second},
+		{attempts: 2, want: 2 * time.Second},
+		{attempts: 3, want: 4 * time.Second},
+		{attempts: 4, want: 8 * time.Second},
+		{attempts: 5, want: 10 * time.Second},
+		{attempts: 50, want: 10 * time.Second},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, p.Backoff(tt.attempts), "attempts=%d", tt.attempts)
+	}
+	assert.False(t, p.Exhausted(4))
+	assert.True(t, p.Exhausted(5))
+}
+
+func repoHelper(t *testing.T) (*gomock.Controller, context.Context, time.Time, *mocklogger.MockLogger, *mocktracer.MockTracer) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+	ctx := context.Background()
+	testTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockLogger := mocklogger.NewMockLogger(ctrl)
+	mockTracer := mocktracer.NewMockTracer(ctrl)
+
+	// 設置基本的 mock 行為
+	mockLogger.EXPECT().With(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	mockSpan := mocktracer.NewMockSpan(ctrl)
+	mockSpan.EXPECT().End().AnyTimes()
+	mockTracer.EXPECT().Start(gomock.Any(), gomock.Any()).Return(ctx, mockSpan).AnyTimes()
+
+	return ctrl, ctx, testTime, mockLogger, mockTracer
+}
@@ -0,0 +1,21 @@
+package input
+
+//go:generate mockgen -source=outbox_input_port.go -destination=../../../interface_adapter/controller/mock/mock_outbox_input_port.go -package=mock
+import (
+	"context"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/usecase/inputmodel"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+)
+
+type OutboxInputPort interface {
+	// Enqueue 寫入待發佈事件，ctx 中有交易時與呼叫端的異動同一個交易
+	Enqueue(ctx context.Context, events ...*entity.OutboxEvent) error
+	// DispatchPending 發佈一批到期的事件，回傳成功發佈的數量
+	DispatchPending(ctx context.Context) (int, error)
+	// ListStuckEvents 列出重試中或已放棄的事件
+	ListStuckEvents(ctx context.Context, filter *inputmodel.ListStuckEventsInputModel, pagination pagination.Pagination) ([]*entity.OutboxEvent, int, error)
+	// RedactAggregate 遮蔽 aggregate 所有事件 payload 中的個資，ctx 中有交易時與呼叫端的異動同一個交易；
+	// 回傳該 aggregate 全部事件的 EventID，供下游（例如 webhook 投遞紀錄）一併遮蔽
+	RedactAggregate(ctx context.Context, input *inputmodel.RedactAggregateInputModel) ([]string, error)
+}
@@ -0,0 +1,14 @@
+package output
+
+//go:generate mockgen -source=event_publisher.go -destination=../../mock/mock_event_publisher.go -package=mock
+import (
+	"context"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/entity"
+)
+
+// EventPublisher 將 outbox 事件送往外部（log、in-process bus、HTTP 等）
+//   - 回傳 error 代表這次發佈失敗，dispatcher 會依重試策略再送
+//   - 同一事件可能被送出多次，消費端需以 EventID 去重
+type EventPublisher interface {
+	Publish(ctx context.Context, event *entity.OutboxEvent) error
+}
@@ -0,0 +1,31 @@
+package output
+
+//go:generate mockgen -source=outbox_persistence.go -destination=../../mock/mock_outbox_persistence.go -package=mock
+import (
+	"context"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"time"
+)
+
+// StuckEventFilter 卡住事件的查詢條件
+//   - 狀態在 Statuses 之中，且已嘗試過至少一次或建立時間早於 CreatedBefore
+type StuckEventFilter struct {
+	Statuses      []entity.Status
+	CreatedBefore time.Time
+}
+
+type OutboxPersistence interface {
+	Append(ctx context.Context, events []*entity.OutboxEvent) error
+	// GetDue 取得狀態為 pending 且 next_attempt_at 已到的事件，依 id 由舊到新
+	GetDue(ctx context.Context, now time.Time, limit int) ([]*entity.OutboxEvent, error)
+	MarkPublished(ctx context.Context, id int, publishedAt time.Time) error
+	// MarkFailed 記錄失敗次數、下次重試時間與錯誤訊息，超過上限時 status 為 dead
+	MarkFailed(ctx context.Context, id int, status entity.Status, attempts int, nextAttemptAt time.Time, lastError string) error
+	GetStuck(ctx context.Context, filter StuckEventFilter, pagination pagination.Pagination) ([]*entity.OutboxEvent, error)
+	CountStuck(ctx context.Context, filter StuckEventFilter) (int, error)
+	// GetByAggregate 取得某個 aggregate 的事件，依 id 由舊到新
+	GetByAggregate(ctx context.Context, aggregateType, aggregateID string, pagination pagination.Pagination) ([]*entity.OutboxEvent, error)
+	// ReplacePayload 只改寫 payload，供個資遮蔽使用
+	ReplacePayload(ctx context.Context, event *entity.OutboxEvent) error
+}
@@ -0,0 +1,18 @@
+package output
+
+//go:generate mockgen -source=outbox_presenter.go -destination=../../../interface_adapter/controller/mock/mock_outbox_presenter.go -package=mock
+import (
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/interface_adapter/outputmodel"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+)
+
+type OutboxPresenter interface {
+	PresentListStuckEvents(events []*entity.OutboxEvent, pagination pagination.Pagination, total int) outputmodel.ListStuckEventsResponse
+	// PresentBindingError 處理輸入綁定錯誤
+	PresentBindingError(errCode int, message string) outputmodel.ErrorResponse
+	// PresentValidationError 處理驗證錯誤
+	PresentValidationError(err error) (int, outputmodel.ErrorResponse)
+	// PresentUseCaseError 處理用例錯誤
+	PresentUseCaseError(err error) (int, outputmodel.ErrorResponse)
+}
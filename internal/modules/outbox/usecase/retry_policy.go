@@ -0,0 +1,49 @@
+package usecase
+
+import "time"
+
+// RetryPolicy dispatcher 的批次與重試設定
+//   - 第 n 次失敗後等待 BaseBackoff * 2^(n-1)，最多 MaxBackoff
+//   - 失敗次數達 MaxAttempts 後事件標記為 dead，需人工處理
+//   - 未發佈且建立超過 StuckAfter 的事件視為卡住
+type RetryPolicy struct {
+	BatchSize   int
+	MaxAttempts int
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	StuckAfter  time.Duration
+}
+
+// DefaultRetryPolicy 預設重試策略
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		BatchSize:   50,
+		MaxAttempts: 10,
+		BaseBackoff: time.Second,
+		MaxBackoff:  10 * time.Minute,
+		StuckAfter:  5 * time.Minute,
+	}
+}
+
+// Backoff 回傳第 attempts 次失敗後應等待的時間
+func (p RetryPolicy) Backoff(attempts int) time.Duration {
+	if attempts < 1 {
+		attempts = 1
+	}
+	backoff := p.BaseBackoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= p.MaxBackoff {
+			return p.MaxBackoff
+		}
+	}
+	if backoff > p.MaxBackoff {
+		return p.MaxBackoff
+	}
+	return backoff
+}
+
+// Exhausted 是否已達最大重試次數
+func (p RetryPolicy) Exhausted(attempts int) bool {
+	return p.MaxAttempts > 0 && attempts >= p.MaxAttempts
+}
@@ -0,0 +1,12 @@
+package entity
+
+import "errors"
+
+var (
+	ErrInvalidURL         = errors.New("webhook url must be an absolute https url")
+	ErrForbiddenTarget    = errors.New("webhook url must point to a public address")
+	ErrEmptyEventTypes    = errors.New("webhook event types is empty")
+	ErrEmptySecret        = errors.New("webhook secret is empty")
+	ErrInvalidStatus      = errors.New("webhook subscription status is invalid")
+	ErrDuplicateEventType = errors.New("webhook event types contain duplicates")
+)
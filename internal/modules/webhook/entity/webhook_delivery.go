@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"github.com/tomoffice/go-clean-architecture/internal/shared/redaction"
+	"time"
+)
+
+// DeliveryStatus webhook 投遞狀態
+type DeliveryStatus string
+
+const (
+	// DeliveryStatusPending 等待投遞（包含失敗後等待重試）
+	DeliveryStatusPending DeliveryStatus = "pending"
+	// DeliveryStatusSucceeded 接收端回應 2xx
+	DeliveryStatusSucceeded DeliveryStatus = "succeeded"
+	// DeliveryStatusFailed 超過最大重試次數，不再自動重試
+	DeliveryStatusFailed DeliveryStatus = "failed"
+)
+
+// Delivery 一個事件對一個訂閱的投遞紀錄
+//   - Payload : 送出的 JSON body，重送時沿用同一份內容
+//   - ResponseStatus : 最後一次嘗試的 HTTP 狀態碼，連線失敗時為 0
+//   - RedeliveredFrom : 手動重送時指向原始投遞紀錄
+type Delivery struct {
+	ID              int
+	SubscriptionID  int
+	EventID         string
+	EventType       string
+	Payload         []byte
+	Status          DeliveryStatus
+	Attempts        int
+	NextAttemptAt   time.Time
+	ResponseStatus  int
+	LastError       string
+	DurationMs      int64
+	RedeliveredFrom *int
+	CreatedAt       time.Time
+	DeliveredAt     *time.Time
+}
+
+// RedactPayload 將 payload 中指定欄位的值改為遮蔽值（包含 data 內的事件內容），用於個資刪除；
+// 回傳是否有任何值被改寫，已遮蔽過的紀錄再次呼叫會回傳 false
+func (d *Delivery) RedactPayload(fields ...string) (bool, error) {
+	payload, changed, err := redaction.JSONFields(d.Payload, fields...)
+	if err != nil || !changed {
+		return false, err
+	}
+	d.Payload = payload
+	return true, nil
+}
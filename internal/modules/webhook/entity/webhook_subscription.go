@@ -0,0 +1,78 @@
+package entity
+
+import (
+	"net/url"
+	"time"
+)
+
+// SubscriptionStatus webhook 訂閱狀態
+type SubscriptionStatus string
+
+const (
+	// SubscriptionStatusActive 正常投遞
+	SubscriptionStatusActive SubscriptionStatus = "active"
+	// SubscriptionStatusPaused 使用者暫停，事件仍會排入投遞紀錄，恢復後繼續送
+	SubscriptionStatusPaused SubscriptionStatus = "paused"
+	// SubscriptionStatusDisabled 連續失敗過多被系統停用，需手動改回 active
+	SubscriptionStatusDisabled SubscriptionStatus = "disabled"
+)
+
+// Subscription webhook 訂閱
+//   - EventTypes : 訂閱的事件名稱，例如 member.registered
+//   - Secret : 簽章用的共享金鑰，只在建立時回傳一次
+//   - ConsecutiveFailures : 連續投遞失敗次數，成功一次即歸零
+type Subscription struct {
+	ID                  int
+	URL                 string
+	EventTypes          []string
+	Secret              string
+	Status              SubscriptionStatus
+	ConsecutiveFailures int
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+// Validate 檢查訂閱的必要欄位
+func (s *Subscription) Validate() error {
+	u, err := url.Parse(s.URL)
+	if err != nil || u.Scheme != "https" || u.Hostname() == "" {
+		return ErrInvalidURL
+	}
+	if err := validateTargetHost(u.Hostname()); err != nil {
+		return err
+	}
+	if len(s.EventTypes) == 0 {
+		return ErrEmptyEventTypes
+	}
+	seen := make(map[string]struct{}, len(s.EventTypes))
+	for _, eventType := range s.EventTypes {
+		if _, ok := seen[eventType]; ok {
+			return ErrDuplicateEventType
+		}
+		seen[eventType] = struct{}{}
+	}
+	if s.Secret == "" {
+		return ErrEmptySecret
+	}
+	switch s.Status {
+	case SubscriptionStatusActive, SubscriptionStatusPaused, SubscriptionStatusDisabled:
+	default:
+		return ErrInvalidStatus
+	}
+	return nil
+}
+
+// Subscribes 是否訂閱了指定事件
+func (s *Subscription) Subscribes(eventType string) bool {
+	for _, t := range s.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// IsActive 是否會實際投遞
+func (s *Subscription) IsActive() bool {
+	return s.Status == SubscriptionStatusActive
+}
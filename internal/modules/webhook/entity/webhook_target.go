@@ -0,0 +1,39 @@
+package entity
+
+import (
+	"net/netip"
+	"strings"
+)
+
+// sharedAddressSpace 電信商 NAT（RFC 6598），與私有網段一樣不對外
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// IsPublicAddress 投遞目標是否為公開位址；loopback、link-local、私有網段、未指定與 multicast 位址一律拒絕，
+// 避免訂閱被用來探測或呼叫內部服務（SSRF）
+func IsPublicAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() ||
+		addr.IsLoopback() ||
+		addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() ||
+		addr.IsPrivate() ||
+		addr.IsUnspecified() ||
+		sharedAddressSpace.Contains(addr) {
+		return false
+	}
+	return true
+}
+
+// validateTargetHost 只能檢查 URL 上的字面位址與保留名稱；網域解析到的位址由投遞端連線時再檢查
+func validateTargetHost(host string) error {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return ErrForbiddenTarget
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && !IsPublicAddress(addr) {
+		return ErrForbiddenTarget
+	}
+	return nil
+}
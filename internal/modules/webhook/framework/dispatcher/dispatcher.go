@@ -0,0 +1,54 @@
+// Package dispatcher 定期呼叫 webhook use case 投遞到期的 webhook。
+package dispatcher
+
+import (
+	"context"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/usecase/port/input"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"time"
+)
+
+const defaultPollInterval = time.Second
+
+// Dispatcher 背景輪詢 webhook 投遞紀錄，失敗的投遞交由 use case 依重試策略延後
+type Dispatcher struct {
+	usecase  input.WebhookInputPort
+	interval time.Duration
+	logger   logger.Logger
+}
+
+func NewDispatcher(webhookUseCase input.WebhookInputPort, interval time.Duration, log logger.Logger) *Dispatcher {
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+	return &Dispatcher{
+		usecase:  webhookUseCase,
+		interval: interval,
+		logger:   log.With(logger.NewField("layer", "dispatcher")),
+	}
+}
+
+// Run 阻塞直到 ctx 結束，通常以 goroutine 執行
+func (d *Dispatcher) Run(ctx context.Context) {
+	d.logger.Info("webhook dispatcher 啟動", logger.NewField("interval", d.interval.String()))
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			d.logger.Info("webhook dispatcher 停止")
+			return
+		case <-ticker.C:
+			d.RunOnce(ctx)
+		}
+	}
+}
+
+// RunOnce 投遞一批到期紀錄，錯誤只記錄，下一輪再試
+func (d *Dispatcher) RunOnce(ctx context.Context) int {
+	delivered, err := d.usecase.DeliverPending(ctx)
+	if err != nil && ctx.Err() == nil {
+		d.logger.Error("webhook dispatcher 投遞失敗", logger.NewField("error", err))
+	}
+	return delivered
+}
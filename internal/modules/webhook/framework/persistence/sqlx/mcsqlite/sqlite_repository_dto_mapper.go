@@ -0,0 +1,80 @@
+package mcsqlite
+
+import (
+	"encoding/json"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/interface_adapter/dao"
+	"time"
+
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/framework/persistence/sqlx"
+)
+
+func subscriptionModelToDTO(model *sqlx.SubscriptionSQLXModel) (*dao.SubscriptionRecord, error) {
+	if model == nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	createdAt, err := parseSQLiteTime(model.CreatedAt)
+	if err != nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	updatedAt, err := parseSQLiteTime(model.UpdatedAt)
+	if err != nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	eventTypes := make([]string, 0)
+	if err := json.Unmarshal([]byte(model.EventTypes), &eventTypes); err != nil {
+		return nil, ErrMapperEventTypesDecodeFailed
+	}
+	return &dao.SubscriptionRecord{
+		ID:                  model.ID,
+		URL:                 model.URL,
+		EventTypes:          eventTypes,
+		Secret:              model.Secret,
+		Status:              model.Status,
+		ConsecutiveFailures: model.ConsecutiveFailures,
+		CreatedAt:           createdAt,
+		UpdatedAt:           updatedAt,
+	}, nil
+}
+
+func deliveryModelToDTO(model *sqlx.DeliverySQLXModel) (*dao.DeliveryRecord, error) {
+	if model == nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	createdAt, err := parseSQLiteTime(model.CreatedAt)
+	if err != nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	nextAttemptAt, err := parseSQLiteTime(model.NextAttemptAt)
+	if err != nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	var deliveredAt *time.Time
+	if model.DeliveredAt.Valid {
+		t, err := parseSQLiteTime(model.DeliveredAt.String)
+		if err != nil {
+			return nil, ErrMapperTimeParseFailed
+		}
+		deliveredAt = &t
+	}
+	var redeliveredFrom *int
+	if model.RedeliveredFrom.Valid {
+		id := int(model.RedeliveredFrom.Int64)
+		redeliveredFrom = &id
+	}
+	return &dao.DeliveryRecord{
+		ID:              model.ID,
+		SubscriptionID:  model.SubscriptionID,
+		EventID:         model.EventID,
+		EventType:       model.EventType,
+		Payload:         model.Payload,
+		Status:          model.Status,
+		Attempts:        model.Attempts,
+		NextAttemptAt:   nextAttemptAt,
+		ResponseStatus:  model.ResponseStatus,
+		LastError:       model.LastError,
+		DurationMs:      model.DurationMs,
+		RedeliveredFrom: redeliveredFrom,
+		CreatedAt:       createdAt,
+		DeliveredAt:     deliveredAt,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package mcsqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+)
+
+// mapSQLError 將常見的 SQL 錯誤轉換為結構化錯誤
+func mapSQLError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return wrap(err, ErrDBRecordNotFound)
+	}
+	if errors.Is(err, sql.ErrConnDone) {
+		return wrap(err, ErrDBConnectionClosed)
+	}
+	if errors.Is(err, ErrMapperTimeParseFailed) {
+		return wrap(err, ErrMapperTimeParseFailed)
+	}
+	if errors.Is(err, ErrMapperEventTypesDecodeFailed) {
+		return wrap(err, ErrMapperEventTypesDecodeFailed)
+	}
+	if errors.Is(err, ErrDBNoEffect) {
+		return wrap(err, ErrDBNoEffect)
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return wrap(err, ErrDBContextTimeout)
+	}
+	if errors.Is(err, context.Canceled) {
+		return wrap(err, ErrDBContextCanceled)
+	}
+	// mcsqlite 特有
+	if strings.Contains(err.Error(), "UNIQUE constraint failed") {
+		return wrap(err, ErrDBDuplicateKey)
+	}
+	return wrap(err, ErrDBUnexpectedError)
+}
+func wrap(rawErr, customErr error) *DBError {
+	return &DBError{
+		CustomError: customErr,
+		RawError:    rawErr,
+	}
+}
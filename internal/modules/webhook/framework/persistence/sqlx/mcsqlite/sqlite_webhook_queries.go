@@ -0,0 +1,62 @@
+package mcsqlite
+
+import "time"
+
+const (
+	queryInsertSubscription = `INSERT INTO webhook_subscriptions (url, event_types, secret, status, consecutive_failures, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?)`
+	querySelectSubscriptionByID = `SELECT * FROM webhook_subscriptions WHERE id = ?`
+	querySelectSubscriptions    = `SELECT * FROM webhook_subscriptions ORDER BY id ASC LIMIT ? OFFSET ?`
+	queryCountSubscriptions     = `SELECT COUNT(*) FROM webhook_subscriptions`
+	querySelectSubscriptionBase = `SELECT * FROM webhook_subscriptions`
+	queryUpdateSubscription     = `UPDATE webhook_subscriptions
+SET url = ?, event_types = ?, secret = ?, status = ?, consecutive_failures = ?, updated_at = ?
+WHERE id = ?`
+	// 只有 active 的訂閱會被系統改為 disabled，避免覆蓋使用者剛設定的 paused
+	queryUpdateSubscriptionHealth = `UPDATE webhook_subscriptions
+SET consecutive_failures = ?, status = CASE WHEN status = 'active' THEN ? ELSE status END, updated_at = ?
+WHERE id = ?`
+	queryDeleteSubscription             = `DELETE FROM webhook_subscriptions WHERE id = ?`
+	queryDeleteDeliveriesBySubscription = `DELETE FROM webhook_deliveries WHERE subscription_id = ?`
+
+	// 同一訂閱的同一事件已存在時略過（outbox 可能重送同一事件）
+	queryInsertDeliveryIgnore = `INSERT OR IGNORE INTO webhook_deliveries (subscription_id, event_id, event_type, payload, status, attempts, next_attempt_at, redelivered_from, created_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	queryInsertDelivery = `INSERT INTO webhook_deliveries (subscription_id, event_id, event_type, payload, status, attempts, next_attempt_at, redelivered_from, created_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	querySelectDueDeliveries = `SELECT d.* FROM webhook_deliveries d
+JOIN webhook_subscriptions s ON s.id = d.subscription_id
+WHERE d.status = 'pending' AND d.next_attempt_at <= ? AND s.status = 'active'
+ORDER BY d.id ASC LIMIT ?`
+	querySelectDeliveryByID    = `SELECT * FROM webhook_deliveries WHERE id = ?`
+	querySelectDeliveryBase    = `SELECT * FROM webhook_deliveries`
+	queryCountDeliveryBase     = `SELECT COUNT(*) FROM webhook_deliveries`
+	queryUpdateDeliveryPayload = `UPDATE webhook_deliveries SET payload = ? WHERE id = ?`
+	// 依事件遮蔽時由舊到新走訪，只改寫 payload，offset 分頁保持穩定
+	queryDeliveryByEventOrderAndPage = ` ORDER BY id ASC LIMIT ? OFFSET ?`
+	// 投遞紀錄以最新的優先顯示
+	queryDeliveryOrderAndPage = ` ORDER BY id DESC LIMIT ? OFFSET ?`
+	queryUpdateDeliveryResult = `UPDATE webhook_deliveries
+SET status = ?, attempts = ?, next_attempt_at = ?, response_status = ?, last_error = ?, duration_ms = ?, delivered_at = ?
+WHERE id = ?`
+)
+
+// sqliteTimeLayout 與 CURRENT_TIMESTAMP 格式一致（UTC）
+const sqliteTimeLayout = "2006-01-02 15:04:05"
+
+// sqliteReadTimeLayouts 讀取時接受的格式；go-sqlite3 會把 DATETIME 欄位轉成 time.Time，
+// 掃進 string 時變成 RFC3339，直接讀原始文字時則是 sqliteTimeLayout
+var sqliteReadTimeLayouts = []string{time.RFC3339Nano, sqliteTimeLayout}
+
+// parseSQLiteTime 依序嘗試可接受的格式，一律回傳 UTC
+func parseSQLiteTime(value string) (time.Time, error) {
+	var lastErr error
+	for _, layout := range sqliteReadTimeLayouts {
+		t, err := time.Parse(layout, value)
+		if err == nil {
+			return t.UTC(), nil
+		}
+		lastErr = err
+	}
+	return time.Time{}, lastErr
+}
@@ -0,0 +1,21 @@
+package pgsql
+
+import (
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/framework/persistence/sqlx/mcsqlite"
+)
+
+// 與 mcsqlite 共用同一組錯誤實例，gateway 的錯誤轉換不需要區分資料庫
+var (
+	ErrDBRecordNotFound             = mcsqlite.ErrDBRecordNotFound
+	ErrDBNoEffect                   = mcsqlite.ErrDBNoEffect
+	ErrDBDuplicateKey               = mcsqlite.ErrDBDuplicateKey
+	ErrDBContextTimeout             = mcsqlite.ErrDBContextTimeout
+	ErrDBContextCanceled            = mcsqlite.ErrDBContextCanceled
+	ErrDBConnectionClosed           = mcsqlite.ErrDBConnectionClosed
+	ErrDBUnexpectedError            = mcsqlite.ErrDBUnexpectedError
+	ErrMapperTimeParseFailed        = mcsqlite.ErrMapperTimeParseFailed
+	ErrMapperEventTypesDecodeFailed = mcsqlite.ErrMapperEventTypesDecodeFailed
+)
+
+// DBError 與 mcsqlite.DBError 為同一型別，gateway 以 errors.As 取出原始錯誤
+type DBError = mcsqlite.DBError
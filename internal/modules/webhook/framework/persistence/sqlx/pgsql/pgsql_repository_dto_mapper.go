@@ -0,0 +1,89 @@
+package pgsql
+
+import (
+	"encoding/json"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/interface_adapter/dao"
+	"time"
+
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/framework/persistence/sqlx"
+)
+
+func subscriptionModelToDTO(model *sqlx.SubscriptionSQLXModel) (*dao.SubscriptionRecord, error) {
+	if model == nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	createdAt, err := parsePgTime(model.CreatedAt)
+	if err != nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	updatedAt, err := parsePgTime(model.UpdatedAt)
+	if err != nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	eventTypes := make([]string, 0)
+	if err := json.Unmarshal([]byte(model.EventTypes), &eventTypes); err != nil {
+		return nil, ErrMapperEventTypesDecodeFailed
+	}
+	return &dao.SubscriptionRecord{
+		ID:                  model.ID,
+		URL:                 model.URL,
+		EventTypes:          eventTypes,
+		Secret:              model.Secret,
+		Status:              model.Status,
+		ConsecutiveFailures: model.ConsecutiveFailures,
+		CreatedAt:           createdAt,
+		UpdatedAt:           updatedAt,
+	}, nil
+}
+
+func deliveryModelToDTO(model *sqlx.DeliverySQLXModel) (*dao.DeliveryRecord, error) {
+	if model == nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	createdAt, err := parsePgTime(model.CreatedAt)
+	if err != nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	nextAttemptAt, err := parsePgTime(model.NextAttemptAt)
+	if err != nil {
+		return nil, ErrMapperTimeParseFailed
+	}
+	var deliveredAt *time.Time
+	if model.DeliveredAt.Valid {
+		t, err := parsePgTime(model.DeliveredAt.String)
+		if err != nil {
+			return nil, ErrMapperTimeParseFailed
+		}
+		deliveredAt = &t
+	}
+	var redeliveredFrom *int
+	if model.RedeliveredFrom.Valid {
+		id := int(model.RedeliveredFrom.Int64)
+		redeliveredFrom = &id
+	}
+	return &dao.DeliveryRecord{
+		ID:              model.ID,
+		SubscriptionID:  model.SubscriptionID,
+		EventID:         model.EventID,
+		EventType:       model.EventType,
+		Payload:         model.Payload,
+		Status:          model.Status,
+		Attempts:        model.Attempts,
+		NextAttemptAt:   nextAttemptAt,
+		ResponseStatus:  model.ResponseStatus,
+		LastError:       model.LastError,
+		DurationMs:      model.DurationMs,
+		RedeliveredFrom: redeliveredFrom,
+		CreatedAt:       createdAt,
+		DeliveredAt:     deliveredAt,
+	}, nil
+}
+
+// parsePgTime pgx 將 TIMESTAMPTZ 讀成 time.Time，database/sql 掃進 string 時格式為 RFC3339Nano，一律回傳 UTC
+func parsePgTime(value string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.UTC(), nil
+}
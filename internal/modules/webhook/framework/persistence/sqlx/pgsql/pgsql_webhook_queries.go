@@ -0,0 +1,41 @@
+package pgsql
+
+const (
+	// Postgres 沒有 LastInsertId，以 RETURNING 取回自增 ID
+	queryInsertSubscription = `INSERT INTO webhook_subscriptions (url, event_types, secret, status, consecutive_failures, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id`
+	querySelectSubscriptionByID      = `SELECT * FROM webhook_subscriptions WHERE id = $1`
+	querySelectSubscriptions         = `SELECT * FROM webhook_subscriptions ORDER BY id ASC LIMIT $1 OFFSET $2`
+	queryCountSubscriptions          = `SELECT COUNT(*) FROM webhook_subscriptions`
+	querySelectSubscriptionsByStatus = `SELECT * FROM webhook_subscriptions WHERE status = ANY($1) ORDER BY id ASC`
+	queryUpdateSubscription          = `UPDATE webhook_subscriptions
+SET url = $1, event_types = $2, secret = $3, status = $4, consecutive_failures = $5, updated_at = $6
+WHERE id = $7`
+	// 只有 active 的訂閱會被系統改為 disabled，避免覆蓋使用者剛設定的 paused
+	queryUpdateSubscriptionHealth = `UPDATE webhook_subscriptions
+SET consecutive_failures = $1, status = CASE WHEN status = 'active' THEN $2 ELSE status END, updated_at = $3
+WHERE id = $4`
+	// 投遞紀錄由 webhook_deliveries 的 ON DELETE CASCADE 一併刪除
+	queryDeleteSubscription = `DELETE FROM webhook_subscriptions WHERE id = $1`
+
+	// 同一訂閱的同一事件已存在時略過（outbox 可能重送同一事件）
+	queryInsertDeliveryIgnore = `INSERT INTO webhook_deliveries (subscription_id, event_id, event_type, payload, status, attempts, next_attempt_at, redelivered_from, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) ON CONFLICT DO NOTHING`
+	queryInsertDelivery = `INSERT INTO webhook_deliveries (subscription_id, event_id, event_type, payload, status, attempts, next_attempt_at, redelivered_from, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING id`
+	querySelectDueDeliveries = `SELECT d.* FROM webhook_deliveries d
+JOIN webhook_subscriptions s ON s.id = d.subscription_id
+WHERE d.status = 'pending' AND d.next_attempt_at <= $1 AND s.status = 'active'
+ORDER BY d.id ASC LIMIT $2`
+	querySelectDeliveryByID    = `SELECT * FROM webhook_deliveries WHERE id = $1`
+	querySelectDeliveryBase    = `SELECT * FROM webhook_deliveries`
+	queryCountDeliveryBase     = `SELECT COUNT(*) FROM webhook_deliveries`
+	queryUpdateDeliveryPayload = `UPDATE webhook_deliveries SET payload = $1 WHERE id = $2`
+	// 依事件遮蔽時由舊到新走訪，只改寫 payload，offset 分頁保持穩定
+	querySelectDeliveriesByEventIDs = `SELECT * FROM webhook_deliveries WHERE event_id = ANY($1) ORDER BY id ASC LIMIT $2 OFFSET $3`
+	// 投遞紀錄以最新的優先顯示，%s 為 LIMIT、OFFSET 的 placeholder
+	queryDeliveryOrderAndPage = ` ORDER BY id DESC LIMIT %s OFFSET %s`
+	queryUpdateDeliveryResult = `UPDATE webhook_deliveries
+SET status = $1, attempts = $2, next_attempt_at = $3, response_status = $4, last_error = $5, duration_ms = $6, delivered_at = $7
+WHERE id = $8`
+)
@@ -0,0 +1,462 @@
+package pgsql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxtx"
+	sqlx2 "github.com/tomoffice/go-clean-architecture/internal/modules/webhook/framework/persistence/sqlx"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/interface_adapter/dao"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// sqlxWebhookPgsql 實作 dao.WebhookDAO
+type sqlxWebhookPgsql struct {
+	db        *sqlx.DB
+	txManager *sqlxtx.TxManager
+	logger    logger.Logger
+	tracer    tracer.Tracer
+}
+
+func NewSqlxWebhookPgsql(db *sqlx.DB, log logger.Logger, tracer tracer.Tracer) dao.WebhookDAO {
+	baseLogger := log.With(logger.NewField("layer", "repository"))
+	return &sqlxWebhookPgsql{
+		db:        db,
+		txManager: sqlxtx.NewTxManager(db),
+		logger:    baseLogger,
+		tracer:    tracer,
+	}
+}
+func (s sqlxWebhookPgsql) CreateSubscription(ctx context.Context, record *dao.SubscriptionRecord) (*dao.SubscriptionRecord, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.CreateSubscription")
+	defer span.End()
+	startTime := time.Now()
+
+	eventTypes, err := json.Marshal(record.EventTypes)
+	if err != nil {
+		contextLogger.Error("SQL webhook 訂閱事件類型編碼失敗", logger.NewField("error", err))
+		return nil, mapSQLError(err)
+	}
+	var id int
+	err = s.executor(repoCtx).QueryRowxContext(repoCtx, queryInsertSubscription,
+		record.URL, string(eventTypes), record.Secret, record.Status, record.ConsecutiveFailures,
+		record.CreatedAt.UTC(), record.UpdatedAt.UTC(),
+	).Scan(&id)
+	if err != nil {
+		contextLogger.Error("SQL webhook 訂閱插入失敗",
+			logger.NewField("error", err),
+			logger.NewField("url", record.URL),
+			logger.NewField("duration_ms", time.Since(startTime).Milliseconds()),
+		)
+		return nil, mapSQLError(err)
+	}
+	created := *record
+	created.ID = id
+	contextLogger.Debug("SQL webhook 訂閱插入成功",
+		logger.NewField("subscription_id", created.ID),
+		logger.NewField("duration_ms", time.Since(startTime).Milliseconds()),
+	)
+	return &created, nil
+}
+func (s sqlxWebhookPgsql) GetSubscriptionByID(ctx context.Context, id int) (*dao.SubscriptionRecord, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.GetSubscriptionByID")
+	defer span.End()
+
+	var model sqlx2.SubscriptionSQLXModel
+	if err := s.executor(repoCtx).GetContext(repoCtx, &model, querySelectSubscriptionByID, id); err != nil {
+		contextLogger.Error("SQL webhook 訂閱查詢失敗", logger.NewField("error", err), logger.NewField("subscription_id", id))
+		return nil, mapSQLError(err)
+	}
+	record, err := subscriptionModelToDTO(&model)
+	if err != nil {
+		contextLogger.Error("SQL webhook 訂閱 DTO 轉換失敗", logger.NewField("error", err))
+		return nil, mapSQLError(err)
+	}
+	return record, nil
+}
+func (s sqlxWebhookPgsql) ListSubscriptions(ctx context.Context, p pagination.Pagination) ([]*dao.SubscriptionRecord, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.ListSubscriptions")
+	defer span.End()
+	startTime := time.Now()
+
+	models := make([]*sqlx2.SubscriptionSQLXModel, 0)
+	err := s.executor(repoCtx).SelectContext(repoCtx, &models, querySelectSubscriptions, p.Limit, p.Offset)
+	duration := time.Since(startTime)
+	if err != nil {
+		contextLogger.Error("SQL webhook 訂閱列表查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("limit", p.Limit),
+			logger.NewField("offset", p.Offset),
+			logger.NewField("duration_ms", duration.Milliseconds()),
+		)
+		return nil, mapSQLError(err)
+	}
+	records, err := subscriptionModelsToDTO(models)
+	if err != nil {
+		contextLogger.Error("SQL webhook 訂閱列表 DTO 轉換失敗", logger.NewField("error", err))
+		return nil, mapSQLError(err)
+	}
+	return records, nil
+}
+func (s sqlxWebhookPgsql) CountSubscriptions(ctx context.Context) (int, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.CountSubscriptions")
+	defer span.End()
+
+	var count int
+	if err := s.executor(repoCtx).GetContext(repoCtx, &count, queryCountSubscriptions); err != nil {
+		contextLogger.Error("SQL webhook 訂閱總數查詢失敗", logger.NewField("error", err))
+		return 0, mapSQLError(err)
+	}
+	return count, nil
+}
+func (s sqlxWebhookPgsql) GetSubscriptionsByStatus(ctx context.Context, statuses []string) ([]*dao.SubscriptionRecord, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.GetSubscriptionsByStatus")
+	defer span.End()
+
+	if len(statuses) == 0 {
+		return []*dao.SubscriptionRecord{}, nil
+	}
+	models := make([]*sqlx2.SubscriptionSQLXModel, 0)
+	if err := s.executor(repoCtx).SelectContext(repoCtx, &models, querySelectSubscriptionsByStatus, statuses); err != nil {
+		contextLogger.Error("SQL webhook 訂閱依狀態查詢失敗", logger.NewField("error", err))
+		return nil, mapSQLError(err)
+	}
+	records, err := subscriptionModelsToDTO(models)
+	if err != nil {
+		contextLogger.Error("SQL webhook 訂閱 DTO 轉換失敗", logger.NewField("error", err))
+		return nil, mapSQLError(err)
+	}
+	return records, nil
+}
+func (s sqlxWebhookPgsql) UpdateSubscription(ctx context.Context, record *dao.SubscriptionRecord) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.UpdateSubscription")
+	defer span.End()
+
+	eventTypes, err := json.Marshal(record.EventTypes)
+	if err != nil {
+		contextLogger.Error("SQL webhook 訂閱事件類型編碼失敗", logger.NewField("error", err))
+		return mapSQLError(err)
+	}
+	result, err := s.executor(repoCtx).ExecContext(repoCtx, queryUpdateSubscription,
+		record.URL, string(eventTypes), record.Secret, record.Status, record.ConsecutiveFailures,
+		record.UpdatedAt.UTC(), record.ID,
+	)
+	if err != nil {
+		contextLogger.Error("SQL webhook 訂閱更新失敗", logger.NewField("error", err), logger.NewField("subscription_id", record.ID))
+		return mapSQLError(err)
+	}
+	return checkAffected(result, contextLogger, record.ID)
+}
+func (s sqlxWebhookPgsql) UpdateSubscriptionHealth(ctx context.Context, id int, consecutiveFailures int, status string, updatedAt time.Time) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.UpdateSubscriptionHealth")
+	defer span.End()
+
+	result, err := s.executor(repoCtx).ExecContext(repoCtx, queryUpdateSubscriptionHealth,
+		consecutiveFailures, status, updatedAt.UTC(), id,
+	)
+	if err != nil {
+		contextLogger.Error("SQL webhook 訂閱失敗次數更新失敗", logger.NewField("error", err), logger.NewField("subscription_id", id))
+		return mapSQLError(err)
+	}
+	return checkAffected(result, contextLogger, id)
+}
+func (s sqlxWebhookPgsql) DeleteSubscription(ctx context.Context, id int) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.DeleteSubscription")
+	defer span.End()
+
+	// Postgres 會依外鍵的 ON DELETE CASCADE 刪除投遞紀錄
+	result, err := s.executor(repoCtx).ExecContext(repoCtx, queryDeleteSubscription, id)
+	if err != nil {
+		contextLogger.Error("SQL webhook 訂閱刪除失敗", logger.NewField("error", err), logger.NewField("subscription_id", id))
+		return mapSQLError(err)
+	}
+	return checkAffected(result, contextLogger, id)
+}
+func (s sqlxWebhookPgsql) AddDeliveries(ctx context.Context, records []*dao.DeliveryRecord) (int, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.AddDeliveries")
+	defer span.End()
+	startTime := time.Now()
+
+	added := 0
+	err := s.txManager.WithinTransaction(repoCtx, func(txCtx context.Context) error {
+		exec := s.executor(txCtx)
+		for _, r := range records {
+			result, err := exec.ExecContext(txCtx, queryInsertDeliveryIgnore, deliveryInsertArgs(r)...)
+			if err != nil {
+				contextLogger.Error("SQL webhook 投遞紀錄插入失敗",
+					logger.NewField("error", err),
+					logger.NewField("subscription_id", r.SubscriptionID),
+					logger.NewField("event_id", r.EventID),
+				)
+				return mapSQLError(err)
+			}
+			rows, err := result.RowsAffected()
+			if err != nil {
+				return mapSQLError(err)
+			}
+			added += int(rows)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	contextLogger.Debug("SQL webhook 投遞紀錄插入成功",
+		logger.NewField("count", len(records)),
+		logger.NewField("added", added),
+		logger.NewField("duration_ms", time.Since(startTime).Milliseconds()),
+	)
+	return added, nil
+}
+func (s sqlxWebhookPgsql) CreateDelivery(ctx context.Context, record *dao.DeliveryRecord) (*dao.DeliveryRecord, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.CreateDelivery")
+	defer span.End()
+
+	var id int
+	err := s.executor(repoCtx).QueryRowxContext(repoCtx, queryInsertDelivery, deliveryInsertArgs(record)...).Scan(&id)
+	if err != nil {
+		contextLogger.Error("SQL webhook 投遞紀錄建立失敗", logger.NewField("error", err), logger.NewField("event_id", record.EventID))
+		return nil, mapSQLError(err)
+	}
+	created := *record
+	created.ID = id
+	return &created, nil
+}
+func (s sqlxWebhookPgsql) GetDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*dao.DeliveryRecord, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.GetDueDeliveries")
+	defer span.End()
+	startTime := time.Now()
+
+	models := make([]*sqlx2.DeliverySQLXModel, 0)
+	err := s.executor(repoCtx).SelectContext(repoCtx, &models, querySelectDueDeliveries, now.UTC(), limit)
+	duration := time.Since(startTime)
+	if err != nil {
+		contextLogger.Error("SQL webhook 到期投遞查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("limit", limit),
+			logger.NewField("duration_ms", duration.Milliseconds()),
+		)
+		return nil, mapSQLError(err)
+	}
+	records, err := deliveryModelsToDTO(models)
+	if err != nil {
+		contextLogger.Error("SQL webhook 到期投遞 DTO 轉換失敗", logger.NewField("error", err))
+		return nil, mapSQLError(err)
+	}
+	return records, nil
+}
+func (s sqlxWebhookPgsql) GetDeliveryByID(ctx context.Context, id int) (*dao.DeliveryRecord, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.GetDeliveryByID")
+	defer span.End()
+
+	var model sqlx2.DeliverySQLXModel
+	if err := s.executor(repoCtx).GetContext(repoCtx, &model, querySelectDeliveryByID, id); err != nil {
+		contextLogger.Error("SQL webhook 投遞紀錄查詢失敗", logger.NewField("error", err), logger.NewField("delivery_id", id))
+		return nil, mapSQLError(err)
+	}
+	record, err := deliveryModelToDTO(&model)
+	if err != nil {
+		contextLogger.Error("SQL webhook 投遞紀錄 DTO 轉換失敗", logger.NewField("error", err))
+		return nil, mapSQLError(err)
+	}
+	return record, nil
+}
+func (s sqlxWebhookPgsql) ListDeliveries(ctx context.Context, q dao.DeliveryQuery, p pagination.Pagination) ([]*dao.DeliveryRecord, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.ListDeliveries")
+	defer span.End()
+	startTime := time.Now()
+
+	args := &queryArgs{}
+	where := buildDeliveryWhere(q, args)
+	page := fmt.Sprintf(queryDeliveryOrderAndPage, args.add(p.Limit), args.add(p.Offset))
+	models := make([]*sqlx2.DeliverySQLXModel, 0)
+	err := s.executor(repoCtx).SelectContext(repoCtx, &models, querySelectDeliveryBase+where+page, args.values...)
+	duration := time.Since(startTime)
+	if err != nil {
+		contextLogger.Error("SQL webhook 投遞紀錄列表查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("subscription_id", q.SubscriptionID),
+			logger.NewField("duration_ms", duration.Milliseconds()),
+		)
+		return nil, mapSQLError(err)
+	}
+	records, err := deliveryModelsToDTO(models)
+	if err != nil {
+		contextLogger.Error("SQL webhook 投遞紀錄列表 DTO 轉換失敗", logger.NewField("error", err))
+		return nil, mapSQLError(err)
+	}
+	contextLogger.Debug("SQL webhook 投遞紀錄列表查詢成功",
+		logger.NewField("count", len(records)),
+		logger.NewField("duration_ms", duration.Milliseconds()),
+	)
+	return records, nil
+}
+func (s sqlxWebhookPgsql) CountDeliveries(ctx context.Context, q dao.DeliveryQuery) (int, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.CountDeliveries")
+	defer span.End()
+
+	args := &queryArgs{}
+	where := buildDeliveryWhere(q, args)
+	var count int
+	if err := s.executor(repoCtx).GetContext(repoCtx, &count, queryCountDeliveryBase+where, args.values...); err != nil {
+		contextLogger.Error("SQL webhook 投遞紀錄總數查詢失敗", logger.NewField("error", err))
+		return 0, mapSQLError(err)
+	}
+	return count, nil
+}
+func (s sqlxWebhookPgsql) UpdateDeliveryResult(ctx context.Context, record *dao.DeliveryRecord) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.UpdateDeliveryResult")
+	defer span.End()
+
+	var deliveredAt sql.NullTime
+	if record.DeliveredAt != nil {
+		deliveredAt = sql.NullTime{Time: record.DeliveredAt.UTC(), Valid: true}
+	}
+	result, err := s.executor(repoCtx).ExecContext(repoCtx, queryUpdateDeliveryResult,
+		record.Status, record.Attempts, record.NextAttemptAt.UTC(),
+		record.ResponseStatus, record.LastError, record.DurationMs, deliveredAt, record.ID,
+	)
+	if err != nil {
+		contextLogger.Error("SQL webhook 投遞結果更新失敗", logger.NewField("error", err), logger.NewField("delivery_id", record.ID))
+		return mapSQLError(err)
+	}
+	return checkAffected(result, contextLogger, record.ID)
+}
+func (s sqlxWebhookPgsql) GetDeliveriesByEventIDs(ctx context.Context, eventIDs []string, p pagination.Pagination) ([]*dao.DeliveryRecord, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.GetDeliveriesByEventIDs")
+	defer span.End()
+
+	if len(eventIDs) == 0 {
+		return []*dao.DeliveryRecord{}, nil
+	}
+	models := make([]*sqlx2.DeliverySQLXModel, 0)
+	if err := s.executor(repoCtx).SelectContext(repoCtx, &models, querySelectDeliveriesByEventIDs, eventIDs, p.Limit, p.Offset); err != nil {
+		contextLogger.Error("SQL webhook 事件投遞紀錄查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("event_count", len(eventIDs)),
+		)
+		return nil, mapSQLError(err)
+	}
+	records, err := deliveryModelsToDTO(models)
+	if err != nil {
+		contextLogger.Error("SQL webhook 事件投遞紀錄 DTO 轉換失敗", logger.NewField("error", err))
+		return nil, mapSQLError(err)
+	}
+	return records, nil
+}
+func (s sqlxWebhookPgsql) UpdateDeliveryPayload(ctx context.Context, id int, payload string) error {
+	// 創建帶有 context 的 logger 用於追蹤
+	repoCtx, contextLogger, span := createTracedLogger(ctx, s.tracer, s.logger, "Repository.UpdateDeliveryPayload")
+	defer span.End()
+
+	result, err := s.executor(repoCtx).ExecContext(repoCtx, queryUpdateDeliveryPayload, payload, id)
+	if err != nil {
+		contextLogger.Error("SQL webhook 投遞紀錄 payload 更新失敗", logger.NewField("error", err), logger.NewField("delivery_id", id))
+		return mapSQLError(err)
+	}
+	return checkAffected(result, contextLogger, id)
+}
+
+func deliveryInsertArgs(r *dao.DeliveryRecord) []any {
+	var redeliveredFrom sql.NullInt64
+	if r.RedeliveredFrom != nil {
+		redeliveredFrom = sql.NullInt64{Int64: int64(*r.RedeliveredFrom), Valid: true}
+	}
+	return []any{
+		r.SubscriptionID, r.EventID, r.EventType, r.Payload, r.Status, r.Attempts,
+		r.NextAttemptAt.UTC(), redeliveredFrom, r.CreatedAt.UTC(),
+	}
+}
+
+func buildDeliveryWhere(q dao.DeliveryQuery, args *queryArgs) string {
+	conditions := []string{"subscription_id = " + args.add(q.SubscriptionID)}
+	if q.Status != "" {
+		conditions = append(conditions, "status = "+args.add(q.Status))
+	}
+	return " WHERE " + strings.Join(conditions, " AND ")
+}
+
+// queryArgs 依序收集查詢參數，add 回傳對應的 $n placeholder
+type queryArgs struct {
+	values []any
+}
+
+func (a *queryArgs) add(value any) string {
+	a.values = append(a.values, value)
+	return "$" + strconv.Itoa(len(a.values))
+}
+
+func subscriptionModelsToDTO(models []*sqlx2.SubscriptionSQLXModel) ([]*dao.SubscriptionRecord, error) {
+	records := make([]*dao.SubscriptionRecord, 0, len(models))
+	for _, model := range models {
+		record, err := subscriptionModelToDTO(model)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func deliveryModelsToDTO(models []*sqlx2.DeliverySQLXModel) ([]*dao.DeliveryRecord, error) {
+	records := make([]*dao.DeliveryRecord, 0, len(models))
+	for _, model := range models {
+		record, err := deliveryModelToDTO(model)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func checkAffected(result sql.Result, contextLogger logger.Logger, id int) error {
+	rows, err := result.RowsAffected()
+	if err != nil {
+		contextLogger.Error("SQL webhook 更新結果檢查失敗", logger.NewField("error", err), logger.NewField("id", id))
+		return mapSQLError(err)
+	}
+	if rows != 1 {
+		contextLogger.Error("SQL webhook 更新未影響預期行數",
+			logger.NewField("id", id),
+			logger.NewField("rows_affected", rows),
+		)
+		return mapSQLError(ErrDBNoEffect)
+	}
+	return nil
+}
+
+// executor 有交易時使用 context 中的交易
+func (s sqlxWebhookPgsql) executor(ctx context.Context) sqlxtx.Executor {
+	return sqlxtx.ExecutorFromContext(ctx, s.db)
+}
+
+func createTracedLogger(ctx context.Context, tr tracer.Tracer, log logger.Logger, operationName string) (context.Context, logger.Logger, tracer.Span) {
+	repoCtx, span := tr.Start(ctx, operationName)
+	lg := log.WithContext(repoCtx)
+	return repoCtx, lg, span
+}
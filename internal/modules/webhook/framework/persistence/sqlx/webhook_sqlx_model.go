@@ -0,0 +1,31 @@
+package sqlx
+
+import "database/sql"
+
+type SubscriptionSQLXModel struct {
+	ID                  int    `db:"id"`
+	URL                 string `db:"url"`
+	EventTypes          string `db:"event_types"` // JSON 陣列
+	Secret              string `db:"secret"`
+	Status              string `db:"status"`
+	ConsecutiveFailures int    `db:"consecutive_failures"`
+	CreatedAt           string `db:"created_at"`
+	UpdatedAt           string `db:"updated_at"`
+}
+
+type DeliverySQLXModel struct {
+	ID              int            `db:"id"`
+	SubscriptionID  int            `db:"subscription_id"`
+	EventID         string         `db:"event_id"`
+	EventType       string         `db:"event_type"`
+	Payload         string         `db:"payload"`
+	Status          string         `db:"status"`
+	Attempts        int            `db:"attempts"`
+	NextAttemptAt   string         `db:"next_attempt_at"`
+	ResponseStatus  int            `db:"response_status"`
+	LastError       string         `db:"last_error"`
+	DurationMs      int64          `db:"duration_ms"`
+	RedeliveredFrom sql.NullInt64  `db:"redelivered_from"`
+	CreatedAt       string         `db:"created_at"`
+	DeliveredAt     sql.NullString `db:"delivered_at"`
+}
@@ -0,0 +1,93 @@
+// Package sender 以 HTTP POST 將已簽章的 webhook 送往訂閱者。
+package sender
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/usecase/port/output"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+const (
+	defaultTimeout = 10 * time.Second
+	userAgent      = "go-clean-architecture-webhook/1.0"
+	// maxResponseBodyBytes 只讀取回應的前段寫入投遞紀錄
+	maxResponseBodyBytes = 512
+	// maxDrainBodyBytes 讀完前段後最多再丟棄的長度，讓連線可重用；超過就直接關閉，避免惡意接收端拖住 worker
+	maxDrainBodyBytes = 64 << 10
+)
+
+// ErrForbiddenAddress 連線目標解析後不是公開位址
+var ErrForbiddenAddress = errors.New("sender: webhook target resolves to a non-public address")
+
+// HTTPSender 實作 output.WebhookSender
+//   - 不跟隨重新導向，避免簽章過的內容被送到訂閱時沒有登記的位置
+//   - 在建立連線時檢查 DNS 解析後的位址，訂閱網域事後改指向內部位址（DNS rebinding）也無法送出
+//   - 不使用環境變數的 proxy，位址檢查才會作用在實際的接收端
+type HTTPSender struct {
+	client *http.Client
+}
+
+func NewHTTPSender(timeout time.Duration) *HTTPSender {
+	return newHTTPSender(timeout, entity.IsPublicAddress)
+}
+
+// newHTTPSender allowed 決定可連線的位址，測試以此連到本機的 httptest 接收端
+func newHTTPSender(timeout time.Duration, allowed func(netip.Addr) bool) *HTTPSender {
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil || !allowed(addrPort.Addr()) {
+				return fmt.Errorf("%w: %s", ErrForbiddenAddress, address)
+			}
+			return nil
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &HTTPSender{
+		client: &http.Client{
+			Timeout:   timeout,
+			Transport: transport,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+func (s *HTTPSender) Send(ctx context.Context, request output.WebhookRequest) (*output.WebhookResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, request.URL, bytes.NewReader(request.Body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+	for k, v := range request.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	snippet, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodyBytes))
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxDrainBodyBytes))
+	return &output.WebhookResponse{
+		StatusCode: resp.StatusCode,
+		Body:       string(bytes.TrimSpace(snippet)),
+	}, nil
+}
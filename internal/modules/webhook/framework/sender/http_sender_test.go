@@ -0,0 +1,197 @@
+package sender
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/usecase"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/usecase/mock"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/usecase/port/output"
+	mocklogger "github.com/tomoffice/go-clean-architecture/pkg/logger/mock"
+	mocktracer "github.com/tomoffice/go-clean-architecture/pkg/tracer/mock"
+)
+
+func TestHTTPSender_Send(t *testing.T) {
+	var received *http.Request
+	var receivedBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		receivedBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusBadGateway)
+		_, _ = w.Write([]byte("  upstream down  "))
+	}))
+	defer server.Close()
+
+	s := newHTTPSender(time.Second, allowAnyAddress)
+	resp, err := s.Send(context.Background(), output.WebhookRequest{
+		URL:     server.URL,
+		Body:    []byte(`{"a":1}`),
+		Headers: map[string]string{"Content-Type": "application/json", usecase.HeaderEvent: "member.deleted"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	assert.Equal(t, "upstream down", resp.Body)
+	assert.Equal(t, http.MethodPost, received.Method)
+	assert.Equal(t, "member.deleted", received.Header.Get(usecase.HeaderEvent))
+	assert.Equal(t, userAgent, received.Header.Get("User-Agent"))
+	assert.Equal(t, `{"a":1}`, string(receivedBody))
+}
+
+// allowAnyAddress httptest 接收端在 loopback，測試時放行
+func allowAnyAddress(netip.Addr) bool { return true }
+
+func TestHTTPSender_RejectsNonPublicAddress(t *testing.T) {
+	var called atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called.Store(true)
+	}))
+	defer server.Close()
+
+	// 127.0.0.1 與解析到 loopback 的網域都在連線時被擋下
+	for _, target := range []string{server.URL, strings.Replace(server.URL, "127.0.0.1", "localhost", 1)} {
+		_, err := NewHTTPSender(time.Second).Send(context.Background(), output.WebhookRequest{URL: target})
+		assert.ErrorIs(t, err, ErrForbiddenAddress, target)
+	}
+	assert.False(t, called.Load())
+}
+
+func TestHTTPSender_DrainsLimitedBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(bytes.Repeat([]byte("x"), maxDrainBodyBytes*4))
+	}))
+	defer server.Close()
+
+	resp, err := newHTTPSender(time.Second, allowAnyAddress).Send(context.Background(), output.WebhookRequest{URL: server.URL})
+	require.NoError(t, err)
+	assert.Len(t, resp.Body, maxResponseBodyBytes)
+}
+
+func TestHTTPSender_DoesNotFollowRedirect(t *testing.T) {
+	var redirected atomic.Bool
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		redirected.Store(true)
+	}))
+	defer target.Close()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, target.URL, http.StatusTemporaryRedirect)
+	}))
+	defer server.Close()
+
+	resp, err := newHTTPSender(time.Second, allowAnyAddress).Send(context.Background(), output.WebhookRequest{URL: server.URL})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusTemporaryRedirect, resp.StatusCode)
+	assert.False(t, redirected.Load())
+}
+
+// TestWebhookDelivery_SignedRetryAndDisable 以 httptest 接收端驗證簽章、失敗重試與自動停用
+func TestWebhookDelivery_SignedRetryAndDisable(t *testing.T) {
+	const secret = "whsec_test_secret"
+	var calls atomic.Int32
+	var failing atomic.Bool
+	failing.Store(true)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		body, _ := io.ReadAll(r.Body)
+		ts, err := strconv.ParseInt(r.Header.Get(usecase.HeaderTimestamp), 10, 64)
+		if err != nil || !usecase.VerifySignature(secret, ts, body, r.Header.Get(usecase.HeaderSignature)) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if time.Since(time.Unix(ts, 0)) > 5*time.Minute {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if failing.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	mockLogger := mocklogger.NewMockLogger(ctrl)
+	mockLogger.EXPECT().With(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+	mockTracer := mocktracer.NewMockTracer(ctrl)
+	mockSpan := mocktracer.NewMockSpan(ctrl)
+	mockSpan.EXPECT().End().AnyTimes()
+	mockTracer.EXPECT().Start(gomock.Any(), gomock.Any()).Return(ctx, mockSpan).AnyTimes()
+
+	subscription := &entity.Subscription{ID: 1, URL: server.URL, EventTypes: []string{"member.deleted"}, Secret: secret, Status: entity.SubscriptionStatusActive}
+	delivery := &entity.Delivery{ID: 5, SubscriptionID: 1, EventID: "evt-1", EventType: "member.deleted", Payload: []byte(`{"event_id":"evt-1"}`), Status: entity.DeliveryStatusPending}
+
+	mockRepo := mock.NewMockWebhookPersistence(ctrl)
+	mockRepo.EXPECT().GetDueDeliveries(ctx, gomock.Any(), gomock.Any()).DoAndReturn(func(context.Context, time.Time, int) ([]*entity.Delivery, error) {
+		if delivery.Status != entity.DeliveryStatusPending || subscription.Status != entity.SubscriptionStatusActive {
+			return nil, nil
+		}
+		copied := *delivery
+		return []*entity.Delivery{&copied}, nil
+	}).AnyTimes()
+	mockRepo.EXPECT().GetSubscriptionByID(ctx, 1).DoAndReturn(func(context.Context, int) (*entity.Subscription, error) {
+		copied := *subscription
+		return &copied, nil
+	}).AnyTimes()
+	mockRepo.EXPECT().UpdateDeliveryResult(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, d *entity.Delivery) error {
+		*delivery = *d
+		return nil
+	}).AnyTimes()
+	mockRepo.EXPECT().UpdateSubscriptionHealth(ctx, 1, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ int, failures int, status entity.SubscriptionStatus, _ time.Time) error {
+		subscription.ConsecutiveFailures = failures
+		subscription.Status = status
+		return nil
+	}).AnyTimes()
+
+	policy := usecase.RetryPolicy{BatchSize: 10, MaxAttempts: 10, BaseBackoff: time.Millisecond, MaxBackoff: time.Millisecond, DisableAfter: 3}
+	uc := usecase.NewWebhookUseCase(mockRepo, newHTTPSender(time.Second, allowAnyAddress), policy, nil, mockLogger, mockTracer)
+
+	// 前兩次失敗會排入重試，連續失敗次數累加
+	for i := 0; i < 2; i++ {
+		succeeded, err := uc.DeliverPending(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, succeeded)
+	}
+	assert.Equal(t, entity.DeliveryStatusPending, delivery.Status)
+	assert.Equal(t, 2, delivery.Attempts)
+	assert.Equal(t, http.StatusServiceUnavailable, delivery.ResponseStatus)
+	assert.Equal(t, 2, subscription.ConsecutiveFailures)
+
+	// 接收端恢復後成功，連續失敗次數歸零
+	failing.Store(false)
+	succeeded, err := uc.DeliverPending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, succeeded)
+	assert.Equal(t, entity.DeliveryStatusSucceeded, delivery.Status)
+	assert.Equal(t, 0, subscription.ConsecutiveFailures)
+	assert.Equal(t, int32(3), calls.Load())
+
+	// 再次持續失敗，達門檻後訂閱被停用，不再投遞
+	failing.Store(true)
+	*delivery = entity.Delivery{ID: 6, SubscriptionID: 1, EventID: "evt-2", EventType: "member.deleted", Payload: []byte(`{"event_id":"evt-2"}`), Status: entity.DeliveryStatusPending}
+	for i := 0; i < 5; i++ {
+		_, err := uc.DeliverPending(ctx)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, entity.SubscriptionStatusDisabled, subscription.Status)
+	assert.Equal(t, 3, subscription.ConsecutiveFailures)
+	assert.Equal(t, int32(6), calls.Load())
+}
@@ -0,0 +1,29 @@
+// Package subscriber 訂閱 outbox 的 in-process bus，把領域事件轉成 webhook 投遞紀錄。
+package subscriber
+
+import (
+	"context"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/outbox/framework/publisher"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/usecase/inputmodel"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/usecase/port/input"
+	"time"
+)
+
+// NewOutboxEventHandler 建立 bus handler
+//   - 只負責寫入投遞紀錄，實際送出由 webhook dispatcher 處理，不拖慢 outbox
+//   - 回傳 error 時 outbox 會重送事件，投遞紀錄以 event id 去重
+func NewOutboxEventHandler(webhookInput input.WebhookInputPort) publisher.Handler {
+	return func(ctx context.Context, envelope publisher.Envelope) error {
+		occurredAt, err := time.Parse(time.RFC3339, envelope.OccurredAt)
+		if err != nil {
+			occurredAt = time.Now().UTC()
+		}
+		_, err = webhookInput.EnqueueEvent(ctx, &inputmodel.WebhookEventInputModel{
+			EventID:    envelope.ID,
+			EventType:  envelope.Type,
+			OccurredAt: occurredAt,
+			Data:       envelope.Payload,
+		})
+		return err
+	}
+}
@@ -0,0 +1,133 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: validator.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	dto "github.com/tomoffice/go-clean-architecture/internal/modules/webhook/interface_adapter/dto"
+)
+
+// MockValidator is a mock of Validator interface.
+type MockValidator struct {
+	ctrl     *gomock.Controller
+	recorder *MockValidatorMockRecorder
+}
+
+// MockValidatorMockRecorder is the mock recorder for MockValidator.
+type MockValidatorMockRecorder struct {
+	mock *MockValidator
+}
+
+// NewMockValidator creates a new mock instance.
+func NewMockValidator(ctrl *gomock.Controller) *MockValidator {
+	mock := &MockValidator{ctrl: ctrl}
+	mock.recorder = &MockValidatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockValidator) EXPECT() *MockValidatorMockRecorder {
+	return m.recorder
+}
+
+// ValidateCreateSubscription mocks base method.
+func (m *MockValidator) ValidateCreateSubscription(arg0 dto.CreateSubscriptionRequestDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateCreateSubscription", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateCreateSubscription indicates an expected call of ValidateCreateSubscription.
+func (mr *MockValidatorMockRecorder) ValidateCreateSubscription(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateCreateSubscription", reflect.TypeOf((*MockValidator)(nil).ValidateCreateSubscription), arg0)
+}
+
+// ValidateDeleteSubscription mocks base method.
+func (m *MockValidator) ValidateDeleteSubscription(arg0 dto.DeleteSubscriptionRequestDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateDeleteSubscription", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateDeleteSubscription indicates an expected call of ValidateDeleteSubscription.
+func (mr *MockValidatorMockRecorder) ValidateDeleteSubscription(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateDeleteSubscription", reflect.TypeOf((*MockValidator)(nil).ValidateDeleteSubscription), arg0)
+}
+
+// ValidateGetSubscription mocks base method.
+func (m *MockValidator) ValidateGetSubscription(arg0 dto.GetSubscriptionRequestDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateGetSubscription", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateGetSubscription indicates an expected call of ValidateGetSubscription.
+func (mr *MockValidatorMockRecorder) ValidateGetSubscription(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateGetSubscription", reflect.TypeOf((*MockValidator)(nil).ValidateGetSubscription), arg0)
+}
+
+// ValidateListDeliveries mocks base method.
+func (m *MockValidator) ValidateListDeliveries(arg0 dto.ListDeliveriesRequestDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateListDeliveries", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateListDeliveries indicates an expected call of ValidateListDeliveries.
+func (mr *MockValidatorMockRecorder) ValidateListDeliveries(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateListDeliveries", reflect.TypeOf((*MockValidator)(nil).ValidateListDeliveries), arg0)
+}
+
+// ValidateListSubscriptions mocks base method.
+func (m *MockValidator) ValidateListSubscriptions(arg0 dto.ListSubscriptionsRequestDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateListSubscriptions", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateListSubscriptions indicates an expected call of ValidateListSubscriptions.
+func (mr *MockValidatorMockRecorder) ValidateListSubscriptions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateListSubscriptions", reflect.TypeOf((*MockValidator)(nil).ValidateListSubscriptions), arg0)
+}
+
+// ValidateRedeliver mocks base method.
+func (m *MockValidator) ValidateRedeliver(arg0 dto.RedeliverRequestDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateRedeliver", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateRedeliver indicates an expected call of ValidateRedeliver.
+func (mr *MockValidatorMockRecorder) ValidateRedeliver(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateRedeliver", reflect.TypeOf((*MockValidator)(nil).ValidateRedeliver), arg0)
+}
+
+// ValidateUpdateSubscription mocks base method.
+func (m *MockValidator) ValidateUpdateSubscription(arg0 dto.UpdateSubscriptionRequestDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateUpdateSubscription", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateUpdateSubscription indicates an expected call of ValidateUpdateSubscription.
+func (mr *MockValidatorMockRecorder) ValidateUpdateSubscription(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateUpdateSubscription", reflect.TypeOf((*MockValidator)(nil).ValidateUpdateSubscription), arg0)
+}
@@ -0,0 +1,190 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: webhook_input_port.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/tomoffice/go-clean-architecture/internal/modules/webhook/entity"
+	inputmodel "github.com/tomoffice/go-clean-architecture/internal/modules/webhook/usecase/inputmodel"
+	pagination "github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+)
+
+// MockWebhookInputPort is a mock of WebhookInputPort interface.
+type MockWebhookInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookInputPortMockRecorder
+}
+
+// MockWebhookInputPortMockRecorder is the mock recorder for MockWebhookInputPort.
+type MockWebhookInputPortMockRecorder struct {
+	mock *MockWebhookInputPort
+}
+
+// NewMockWebhookInputPort creates a new mock instance.
+func NewMockWebhookInputPort(ctrl *gomock.Controller) *MockWebhookInputPort {
+	mock := &MockWebhookInputPort{ctrl: ctrl}
+	mock.recorder = &MockWebhookInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookInputPort) EXPECT() *MockWebhookInputPortMockRecorder {
+	return m.recorder
+}
+
+// CreateSubscription mocks base method.
+func (m *MockWebhookInputPort) CreateSubscription(ctx context.Context, input *inputmodel.CreateSubscriptionInputModel) (*entity.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubscription", ctx, input)
+	ret0, _ := ret[0].(*entity.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSubscription indicates an expected call of CreateSubscription.
+func (mr *MockWebhookInputPortMockRecorder) CreateSubscription(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockWebhookInputPort)(nil).CreateSubscription), ctx, input)
+}
+
+// DeleteSubscription mocks base method.
+func (m *MockWebhookInputPort) DeleteSubscription(ctx context.Context, id int) (*entity.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubscription", ctx, id)
+	ret0, _ := ret[0].(*entity.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSubscription indicates an expected call of DeleteSubscription.
+func (mr *MockWebhookInputPortMockRecorder) DeleteSubscription(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockWebhookInputPort)(nil).DeleteSubscription), ctx, id)
+}
+
+// DeliverPending mocks base method.
+func (m *MockWebhookInputPort) DeliverPending(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeliverPending", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeliverPending indicates an expected call of DeliverPending.
+func (mr *MockWebhookInputPortMockRecorder) DeliverPending(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliverPending", reflect.TypeOf((*MockWebhookInputPort)(nil).DeliverPending), ctx)
+}
+
+// EnqueueEvent mocks base method.
+func (m *MockWebhookInputPort) EnqueueEvent(ctx context.Context, event *inputmodel.WebhookEventInputModel) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueEvent", ctx, event)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnqueueEvent indicates an expected call of EnqueueEvent.
+func (mr *MockWebhookInputPortMockRecorder) EnqueueEvent(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueEvent", reflect.TypeOf((*MockWebhookInputPort)(nil).EnqueueEvent), ctx, event)
+}
+
+// GetSubscription mocks base method.
+func (m *MockWebhookInputPort) GetSubscription(ctx context.Context, id int) (*entity.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscription", ctx, id)
+	ret0, _ := ret[0].(*entity.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscription indicates an expected call of GetSubscription.
+func (mr *MockWebhookInputPortMockRecorder) GetSubscription(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscription", reflect.TypeOf((*MockWebhookInputPort)(nil).GetSubscription), ctx, id)
+}
+
+// ListDeliveries mocks base method.
+func (m *MockWebhookInputPort) ListDeliveries(ctx context.Context, filter *inputmodel.ListDeliveriesInputModel, pagination pagination.Pagination) ([]*entity.Delivery, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeliveries", ctx, filter, pagination)
+	ret0, _ := ret[0].([]*entity.Delivery)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListDeliveries indicates an expected call of ListDeliveries.
+func (mr *MockWebhookInputPortMockRecorder) ListDeliveries(ctx, filter, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeliveries", reflect.TypeOf((*MockWebhookInputPort)(nil).ListDeliveries), ctx, filter, pagination)
+}
+
+// ListSubscriptions mocks base method.
+func (m *MockWebhookInputPort) ListSubscriptions(ctx context.Context, pagination pagination.Pagination) ([]*entity.Subscription, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSubscriptions", ctx, pagination)
+	ret0, _ := ret[0].([]*entity.Subscription)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListSubscriptions indicates an expected call of ListSubscriptions.
+func (mr *MockWebhookInputPortMockRecorder) ListSubscriptions(ctx, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscriptions", reflect.TypeOf((*MockWebhookInputPort)(nil).ListSubscriptions), ctx, pagination)
+}
+
+// RedactDeliveries mocks base method.
+func (m *MockWebhookInputPort) RedactDeliveries(ctx context.Context, input *inputmodel.RedactDeliveriesInputModel) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedactDeliveries", ctx, input)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RedactDeliveries indicates an expected call of RedactDeliveries.
+func (mr *MockWebhookInputPortMockRecorder) RedactDeliveries(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedactDeliveries", reflect.TypeOf((*MockWebhookInputPort)(nil).RedactDeliveries), ctx, input)
+}
+
+// Redeliver mocks base method.
+func (m *MockWebhookInputPort) Redeliver(ctx context.Context, input *inputmodel.RedeliverInputModel) (*entity.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeliver", ctx, input)
+	ret0, _ := ret[0].(*entity.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Redeliver indicates an expected call of Redeliver.
+func (mr *MockWebhookInputPortMockRecorder) Redeliver(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeliver", reflect.TypeOf((*MockWebhookInputPort)(nil).Redeliver), ctx, input)
+}
+
+// UpdateSubscription mocks base method.
+func (m *MockWebhookInputPort) UpdateSubscription(ctx context.Context, input *inputmodel.UpdateSubscriptionInputModel) (*entity.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSubscription", ctx, input)
+	ret0, _ := ret[0].(*entity.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSubscription indicates an expected call of UpdateSubscription.
+func (mr *MockWebhookInputPortMockRecorder) UpdateSubscription(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubscription", reflect.TypeOf((*MockWebhookInputPort)(nil).UpdateSubscription), ctx, input)
+}
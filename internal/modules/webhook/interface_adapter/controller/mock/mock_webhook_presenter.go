@@ -0,0 +1,179 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: webhook_presenter.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/tomoffice/go-clean-architecture/internal/modules/webhook/entity"
+	outputmodel "github.com/tomoffice/go-clean-architecture/internal/modules/webhook/interface_adapter/outputmodel"
+	pagination "github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+)
+
+// MockWebhookPresenter is a mock of WebhookPresenter interface.
+type MockWebhookPresenter struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookPresenterMockRecorder
+}
+
+// MockWebhookPresenterMockRecorder is the mock recorder for MockWebhookPresenter.
+type MockWebhookPresenterMockRecorder struct {
+	mock *MockWebhookPresenter
+}
+
+// NewMockWebhookPresenter creates a new mock instance.
+func NewMockWebhookPresenter(ctrl *gomock.Controller) *MockWebhookPresenter {
+	mock := &MockWebhookPresenter{ctrl: ctrl}
+	mock.recorder = &MockWebhookPresenterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookPresenter) EXPECT() *MockWebhookPresenterMockRecorder {
+	return m.recorder
+}
+
+// PresentBindingError mocks base method.
+func (m *MockWebhookPresenter) PresentBindingError(errCode int, message string) outputmodel.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentBindingError", errCode, message)
+	ret0, _ := ret[0].(outputmodel.ErrorResponse)
+	return ret0
+}
+
+// PresentBindingError indicates an expected call of PresentBindingError.
+func (mr *MockWebhookPresenterMockRecorder) PresentBindingError(errCode, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentBindingError", reflect.TypeOf((*MockWebhookPresenter)(nil).PresentBindingError), errCode, message)
+}
+
+// PresentCreateSubscription mocks base method.
+func (m *MockWebhookPresenter) PresentCreateSubscription(subscription *entity.Subscription) outputmodel.CreateSubscriptionResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentCreateSubscription", subscription)
+	ret0, _ := ret[0].(outputmodel.CreateSubscriptionResponse)
+	return ret0
+}
+
+// PresentCreateSubscription indicates an expected call of PresentCreateSubscription.
+func (mr *MockWebhookPresenterMockRecorder) PresentCreateSubscription(subscription interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentCreateSubscription", reflect.TypeOf((*MockWebhookPresenter)(nil).PresentCreateSubscription), subscription)
+}
+
+// PresentDeleteSubscription mocks base method.
+func (m *MockWebhookPresenter) PresentDeleteSubscription(subscription *entity.Subscription) outputmodel.DeleteSubscriptionResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentDeleteSubscription", subscription)
+	ret0, _ := ret[0].(outputmodel.DeleteSubscriptionResponse)
+	return ret0
+}
+
+// PresentDeleteSubscription indicates an expected call of PresentDeleteSubscription.
+func (mr *MockWebhookPresenterMockRecorder) PresentDeleteSubscription(subscription interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentDeleteSubscription", reflect.TypeOf((*MockWebhookPresenter)(nil).PresentDeleteSubscription), subscription)
+}
+
+// PresentGetSubscription mocks base method.
+func (m *MockWebhookPresenter) PresentGetSubscription(subscription *entity.Subscription) outputmodel.GetSubscriptionResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentGetSubscription", subscription)
+	ret0, _ := ret[0].(outputmodel.GetSubscriptionResponse)
+	return ret0
+}
+
+// PresentGetSubscription indicates an expected call of PresentGetSubscription.
+func (mr *MockWebhookPresenterMockRecorder) PresentGetSubscription(subscription interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentGetSubscription", reflect.TypeOf((*MockWebhookPresenter)(nil).PresentGetSubscription), subscription)
+}
+
+// PresentListDeliveries mocks base method.
+func (m *MockWebhookPresenter) PresentListDeliveries(deliveries []*entity.Delivery, pagination pagination.Pagination, total int) outputmodel.ListDeliveriesResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentListDeliveries", deliveries, pagination, total)
+	ret0, _ := ret[0].(outputmodel.ListDeliveriesResponse)
+	return ret0
+}
+
+// PresentListDeliveries indicates an expected call of PresentListDeliveries.
+func (mr *MockWebhookPresenterMockRecorder) PresentListDeliveries(deliveries, pagination, total interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentListDeliveries", reflect.TypeOf((*MockWebhookPresenter)(nil).PresentListDeliveries), deliveries, pagination, total)
+}
+
+// PresentListSubscriptions mocks base method.
+func (m *MockWebhookPresenter) PresentListSubscriptions(subscriptions []*entity.Subscription, pagination pagination.Pagination, total int) outputmodel.ListSubscriptionsResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentListSubscriptions", subscriptions, pagination, total)
+	ret0, _ := ret[0].(outputmodel.ListSubscriptionsResponse)
+	return ret0
+}
+
+// PresentListSubscriptions indicates an expected call of PresentListSubscriptions.
+func (mr *MockWebhookPresenterMockRecorder) PresentListSubscriptions(subscriptions, pagination, total interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentListSubscriptions", reflect.TypeOf((*MockWebhookPresenter)(nil).PresentListSubscriptions), subscriptions, pagination, total)
+}
+
+// PresentRedeliver mocks base method.
+func (m *MockWebhookPresenter) PresentRedeliver(delivery *entity.Delivery) outputmodel.RedeliverResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentRedeliver", delivery)
+	ret0, _ := ret[0].(outputmodel.RedeliverResponse)
+	return ret0
+}
+
+// PresentRedeliver indicates an expected call of PresentRedeliver.
+func (mr *MockWebhookPresenterMockRecorder) PresentRedeliver(delivery interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentRedeliver", reflect.TypeOf((*MockWebhookPresenter)(nil).PresentRedeliver), delivery)
+}
+
+// PresentUpdateSubscription mocks base method.
+func (m *MockWebhookPresenter) PresentUpdateSubscription(subscription *entity.Subscription) outputmodel.UpdateSubscriptionResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentUpdateSubscription", subscription)
+	ret0, _ := ret[0].(outputmodel.UpdateSubscriptionResponse)
+	return ret0
+}
+
+// PresentUpdateSubscription indicates an expected call of PresentUpdateSubscription.
+func (mr *MockWebhookPresenterMockRecorder) PresentUpdateSubscription(subscription interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentUpdateSubscription", reflect.TypeOf((*MockWebhookPresenter)(nil).PresentUpdateSubscription), subscription)
+}
+
+// PresentUseCaseError mocks base method.
+func (m *MockWebhookPresenter) PresentUseCaseError(err error) (int, outputmodel.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentUseCaseError", err)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(outputmodel.ErrorResponse)
+	return ret0, ret1
+}
+
+// PresentUseCaseError indicates an expected call of PresentUseCaseError.
+func (mr *MockWebhookPresenterMockRecorder) PresentUseCaseError(err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentUseCaseError", reflect.TypeOf((*MockWebhookPresenter)(nil).PresentUseCaseError), err)
+}
+
+// PresentValidationError mocks base method.
+func (m *MockWebhookPresenter) PresentValidationError(err error) (int, outputmodel.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentValidationError", err)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(outputmodel.ErrorResponse)
+	return ret0, ret1
+}
+
+// PresentValidationError indicates an expected call of PresentValidationError.
+func (mr *MockWebhookPresenterMockRecorder) PresentValidationError(err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentValidationError", reflect.TypeOf((*MockWebhookPresenter)(nil).PresentValidationError), err)
+}
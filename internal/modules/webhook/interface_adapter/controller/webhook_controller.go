@@ -0,0 +1,373 @@
+package controller
+
+import (
+	"context"
+	memberhttp "github.com/tomoffice/go-clean-architecture/internal/interface_adapter/transport/http"
+	"net/http"
+
+	gindto "github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/dto"
+	"github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/errordefs"
+	ginmapper "github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/mapper"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/interface_adapter/mapper"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/interface_adapter/validation"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/usecase/port/input"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/usecase/port/output"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
+)
+
+type WebhookController struct {
+	usecase      input.WebhookInputPort
+	presenter    output.WebhookPresenter
+	dtoValidator validation.Validator
+	logger       logger.Logger
+	tracer       tracer.Tracer
+}
+
+func NewWebhookController(webhookUseCase input.WebhookInputPort, presenter output.WebhookPresenter, dtoValidator validation.Validator, log logger.Logger, tracer tracer.Tracer) *WebhookController {
+	baseLogger := log.With(logger.NewField("layer", "controller"))
+	return &WebhookController{
+		usecase:      webhookUseCase,
+		presenter:    presenter,
+		dtoValidator: dtoValidator,
+		logger:       baseLogger,
+		tracer:       tracer,
+	}
+}
+
+func (c *WebhookController) Create(ctx memberhttp.Context) {
+	// 創建帶有 context 的 logger 用於追蹤
+	requestCtx, contextLogger, span := createTracedLogger(ctx.RequestCtx(), c.tracer, c.logger)
+	defer span.End()
+
+	var ginReqDTO gindto.GinBindingCreateWebhookRequestDTO
+	if err := ctx.BindJSON(&ginReqDTO); err != nil {
+		contextLogger.Error("webhook 建立參數綁定錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("content_type", ctx.GetHeader("Content-Type")),
+		)
+		errCode, errMsg := errordefs.MapGinBindingError(err)
+		resp := c.presenter.PresentBindingError(errCode, errMsg)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	reqDTO := ginmapper.GinDTOToCreateSubscriptionDTO(ginReqDTO)
+	if err := c.dtoValidator.ValidateCreateSubscription(reqDTO); err != nil {
+		contextLogger.Error("webhook 建立參數驗證錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("url", reqDTO.URL),
+		)
+		errCode, resp := c.presenter.PresentValidationError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	inputModel := mapper.CreateSubscriptionDTOToInputModel(reqDTO)
+	subscription, err := c.usecase.CreateSubscription(requestCtx, inputModel)
+	if err != nil {
+		contextLogger.Error("webhook 建立 UseCase 執行錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("url", reqDTO.URL),
+		)
+		errCode, resp := c.presenter.PresentUseCaseError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	resp := c.presenter.PresentCreateSubscription(subscription)
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (c *WebhookController) GetByID(ctx memberhttp.Context) {
+	// 創建帶有 context 的 logger 用於追蹤
+	requestCtx, contextLogger, span := createTracedLogger(ctx.RequestCtx(), c.tracer, c.logger)
+	defer span.End()
+
+	var ginReqDTO gindto.GinBindingWebhookURIRequestDTO
+	if err := ctx.BindURI(&ginReqDTO); err != nil {
+		contextLogger.Error("webhook 查詢參數綁定錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("uri", ctx.Request().RequestURI),
+		)
+		errCode, errMsg := errordefs.MapGinBindingError(err)
+		resp := c.presenter.PresentBindingError(errCode, errMsg)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	reqDTO := ginmapper.GinDTOToGetSubscriptionDTO(ginReqDTO)
+	if err := c.dtoValidator.ValidateGetSubscription(reqDTO); err != nil {
+		contextLogger.Error("webhook 查詢參數驗證錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("subscription_id", ginReqDTO.ID),
+		)
+		errCode, resp := c.presenter.PresentValidationError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	subscription, err := c.usecase.GetSubscription(requestCtx, reqDTO.ID)
+	if err != nil {
+		contextLogger.Error("webhook 查詢 UseCase 執行錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("subscription_id", reqDTO.ID),
+		)
+		errCode, resp := c.presenter.PresentUseCaseError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	resp := c.presenter.PresentGetSubscription(subscription)
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (c *WebhookController) List(ctx memberhttp.Context) {
+	// 創建帶有 context 的 logger 用於追蹤
+	requestCtx, contextLogger, span := createTracedLogger(ctx.RequestCtx(), c.tracer, c.logger)
+	defer span.End()
+
+	var ginReqDTO gindto.GinBindingListWebhooksQueryRequestDTO
+	if err := ctx.BindQuery(&ginReqDTO); err != nil {
+		contextLogger.Error("webhook 列表參數綁定錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("query", ctx.Request().URL.RawQuery),
+		)
+		errCode, errMsg := errordefs.MapGinBindingError(err)
+		resp := c.presenter.PresentBindingError(errCode, errMsg)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	reqDTO := ginmapper.GinDTOToListSubscriptionsDTO(ginReqDTO)
+	if err := c.dtoValidator.ValidateListSubscriptions(reqDTO); err != nil {
+		contextLogger.Error("webhook 列表參數驗證錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("query", ctx.Request().URL.RawQuery),
+		)
+		errCode, resp := c.presenter.PresentValidationError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	pagination := mapper.ListSubscriptionsDTOToPagination(reqDTO)
+	subscriptions, total, err := c.usecase.ListSubscriptions(requestCtx, *pagination)
+	if err != nil {
+		contextLogger.Error("webhook 列表 UseCase 執行錯誤",
+			logger.NewField("error", err.Error()),
+		)
+		errCode, resp := c.presenter.PresentUseCaseError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	resp := c.presenter.PresentListSubscriptions(subscriptions, *pagination, total)
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (c *WebhookController) Update(ctx memberhttp.Context) {
+	// 創建帶有 context 的 logger 用於追蹤
+	requestCtx, contextLogger, span := createTracedLogger(ctx.RequestCtx(), c.tracer, c.logger)
+	defer span.End()
+
+	var ginURI gindto.GinBindingWebhookURIRequestDTO
+	if err := ctx.BindURI(&ginURI); err != nil {
+		contextLogger.Error("webhook 更新 URI 參數綁定錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("uri", ctx.Request().RequestURI),
+		)
+		errCode, errMsg := errordefs.MapGinBindingError(err)
+		resp := c.presenter.PresentBindingError(errCode, errMsg)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	var ginBody gindto.GinBindingUpdateWebhookBodyRequestDTO
+	if err := ctx.BindJSON(&ginBody); err != nil {
+		contextLogger.Error("webhook 更新 Body 參數綁定錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("content_type", ctx.GetHeader("Content-Type")),
+		)
+		errCode, errMsg := errordefs.MapGinBindingError(err)
+		resp := c.presenter.PresentBindingError(errCode, errMsg)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	reqDTO := ginmapper.GinDTOToUpdateSubscriptionDTO(ginURI, ginBody)
+	if err := c.dtoValidator.ValidateUpdateSubscription(reqDTO); err != nil {
+		contextLogger.Error("webhook 更新參數驗證錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("subscription_id", ginURI.ID),
+		)
+		errCode, resp := c.presenter.PresentValidationError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	inputModel := mapper.UpdateSubscriptionDTOToInputModel(reqDTO)
+	subscription, err := c.usecase.UpdateSubscription(requestCtx, inputModel)
+	if err != nil {
+		contextLogger.Error("webhook 更新 UseCase 執行錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("subscription_id", inputModel.ID),
+		)
+		errCode, resp := c.presenter.PresentUseCaseError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	resp := c.presenter.PresentUpdateSubscription(subscription)
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (c *WebhookController) Delete(ctx memberhttp.Context) {
+	// 創建帶有 context 的 logger 用於追蹤
+	requestCtx, contextLogger, span := createTracedLogger(ctx.RequestCtx(), c.tracer, c.logger)
+	defer span.End()
+
+	var ginReqDTO gindto.GinBindingWebhookURIRequestDTO
+	if err := ctx.BindURI(&ginReqDTO); err != nil {
+		contextLogger.Error("webhook 刪除參數綁定錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("uri", ctx.Request().RequestURI),
+		)
+		errCode, errMsg := errordefs.MapGinBindingError(err)
+		resp := c.presenter.PresentBindingError(errCode, errMsg)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	reqDTO := ginmapper.GinDTOToDeleteSubscriptionDTO(ginReqDTO)
+	if err := c.dtoValidator.ValidateDeleteSubscription(reqDTO); err != nil {
+		contextLogger.Error("webhook 刪除參數驗證錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("subscription_id", ginReqDTO.ID),
+		)
+		errCode, resp := c.presenter.PresentValidationError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	subscription, err := c.usecase.DeleteSubscription(requestCtx, reqDTO.ID)
+	if err != nil {
+		contextLogger.Error("webhook 刪除 UseCase 執行錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("subscription_id", reqDTO.ID),
+		)
+		errCode, resp := c.presenter.PresentUseCaseError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	resp := c.presenter.PresentDeleteSubscription(subscription)
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (c *WebhookController) ListDeliveries(ctx memberhttp.Context) {
+	// 創建帶有 context 的 logger 用於追蹤
+	requestCtx, contextLogger, span := createTracedLogger(ctx.RequestCtx(), c.tracer, c.logger)
+	defer span.End()
+
+	var ginURI gindto.GinBindingWebhookURIRequestDTO
+	if err := ctx.BindURI(&ginURI); err != nil {
+		contextLogger.Error("webhook 投遞紀錄 URI 參數綁定錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("uri", ctx.Request().RequestURI),
+		)
+		errCode, errMsg := errordefs.MapGinBindingError(err)
+		resp := c.presenter.PresentBindingError(errCode, errMsg)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	var ginQuery gindto.GinBindingListWebhookDeliveriesQueryRequestDTO
+	if err := ctx.BindQuery(&ginQuery); err != nil {
+		contextLogger.Error("webhook 投遞紀錄查詢參數綁定錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("query", ctx.Request().URL.RawQuery),
+		)
+		errCode, errMsg := errordefs.MapGinBindingError(err)
+		resp := c.presenter.PresentBindingError(errCode, errMsg)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	reqDTO := ginmapper.GinDTOToListDeliveriesDTO(ginURI, ginQuery)
+	if err := c.dtoValidator.ValidateListDeliveries(reqDTO); err != nil {
+		contextLogger.Error("webhook 投遞紀錄查詢參數驗證錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("subscription_id", ginURI.ID),
+		)
+		errCode, resp := c.presenter.PresentValidationError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	filter := mapper.ListDeliveriesDTOToInputModel(reqDTO)
+	pagination := mapper.ListDeliveriesDTOToPagination(reqDTO)
+	deliveries, total, err := c.usecase.ListDeliveries(requestCtx, filter, *pagination)
+	if err != nil {
+		contextLogger.Error("webhook 投遞紀錄查詢 UseCase 執行錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("subscription_id", reqDTO.SubscriptionID),
+		)
+		errCode, resp := c.presenter.PresentUseCaseError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	resp := c.presenter.PresentListDeliveries(deliveries, *pagination, total)
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (c *WebhookController) Redeliver(ctx memberhttp.Context) {
+	// 創建帶有 context 的 logger 用於追蹤
+	requestCtx, contextLogger, span := createTracedLogger(ctx.RequestCtx(), c.tracer, c.logger)
+	defer span.End()
+
+	var ginReqDTO gindto.GinBindingRedeliverWebhookURIRequestDTO
+	if err := ctx.BindURI(&ginReqDTO); err != nil {
+		contextLogger.Error("webhook 重送參數綁定錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("uri", ctx.Request().RequestURI),
+		)
+		errCode, errMsg := errordefs.MapGinBindingError(err)
+		resp := c.presenter.PresentBindingError(errCode, errMsg)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	reqDTO := ginmapper.GinDTOToRedeliverDTO(ginReqDTO)
+	if err := c.dtoValidator.ValidateRedeliver(reqDTO); err != nil {
+		contextLogger.Error("webhook 重送參數驗證錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("delivery_id", ginReqDTO.DeliveryID),
+		)
+		errCode, resp := c.presenter.PresentValidationError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	inputModel := mapper.RedeliverDTOToInputModel(reqDTO)
+	delivery, err := c.usecase.Redeliver(requestCtx, inputModel)
+	if err != nil {
+		contextLogger.Error("webhook 重送 UseCase 執行錯誤",
+			logger.NewField("error", err.Error()),
+			logger.NewField("delivery_id", inputModel.DeliveryID),
+		)
+		errCode, resp := c.presenter.PresentUseCaseError(err)
+		httpStatus := MapErrorCodeToHTTPStatus(errCode)
+		ctx.JSON(httpStatus, resp)
+		return
+	}
+	// 重送由 dispatcher 非同步送出
+	resp := c.presenter.PresentRedeliver(delivery)
+	ctx.JSON(http.StatusAccepted, resp)
+}
+
+func createTracedLogger(ctx context.Context, tr tracer.Tracer, log logger.Logger) (context.Context, logger.Logger, tracer.Span) {
+	requestCtx, span := tr.Start(ctx, "")
+	lg := log.WithContext(requestCtx)
+	return requestCtx, lg, span
+}
@@ -0,0 +1,65 @@
+package dao
+
+//go:generate mockgen -source=webhook_dao.go -destination=../../interface_adapter/gateway/mock/mock_webhook_dao.go -package=mock
+
+import (
+	"context"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"time"
+)
+
+type SubscriptionRecord struct {
+	ID                  int
+	URL                 string
+	EventTypes          []string
+	Secret              string
+	Status              string
+	ConsecutiveFailures int
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+type DeliveryRecord struct {
+	ID              int
+	SubscriptionID  int
+	EventID         string
+	EventType       string
+	Payload         string // 送出的 JSON body
+	Status          string
+	Attempts        int
+	NextAttemptAt   time.Time
+	ResponseStatus  int
+	LastError       string
+	DurationMs      int64
+	RedeliveredFrom *int
+	CreatedAt       time.Time
+	DeliveredAt     *time.Time
+}
+
+// DeliveryQuery 投遞紀錄的查詢條件，Status 為空字串表示全部
+type DeliveryQuery struct {
+	SubscriptionID int
+	Status         string
+}
+
+type WebhookDAO interface {
+	CreateSubscription(ctx context.Context, record *SubscriptionRecord) (*SubscriptionRecord, error)
+	GetSubscriptionByID(ctx context.Context, id int) (*SubscriptionRecord, error)
+	ListSubscriptions(ctx context.Context, p pagination.Pagination) ([]*SubscriptionRecord, error)
+	CountSubscriptions(ctx context.Context) (int, error)
+	GetSubscriptionsByStatus(ctx context.Context, statuses []string) ([]*SubscriptionRecord, error)
+	UpdateSubscription(ctx context.Context, record *SubscriptionRecord) error
+	UpdateSubscriptionHealth(ctx context.Context, id int, consecutiveFailures int, status string, updatedAt time.Time) error
+	DeleteSubscription(ctx context.Context, id int) error
+
+	AddDeliveries(ctx context.Context, records []*DeliveryRecord) (int, error)
+	CreateDelivery(ctx context.Context, record *DeliveryRecord) (*DeliveryRecord, error)
+	GetDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*DeliveryRecord, error)
+	GetDeliveryByID(ctx context.Context, id int) (*DeliveryRecord, error)
+	ListDeliveries(ctx context.Context, q DeliveryQuery, p pagination.Pagination) ([]*DeliveryRecord, error)
+	CountDeliveries(ctx context.Context, q DeliveryQuery) (int, error)
+	UpdateDeliveryResult(ctx context.Context, record *DeliveryRecord) error
+	GetDeliveriesByEventIDs(ctx context.Context, eventIDs []string, p pagination.Pagination) ([]*DeliveryRecord, error)
+	// UpdateDeliveryPayload 只改寫 payload 欄位，供個資遮蔽使用
+	UpdateDeliveryPayload(ctx context.Context, id int, payload string) error
+}
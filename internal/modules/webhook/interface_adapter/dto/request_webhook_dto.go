@@ -0,0 +1,47 @@
+// Package dto 定義 webhook 模組的資料傳輸物件，負責接收外部請求資料並以 validator 驗證格式。
+package dto
+
+// CreateSubscriptionRequestDTO 建立 webhook 訂閱
+//   - Secret 未提供時由系統產生
+type CreateSubscriptionRequestDTO struct {
+	URL        string   `json:"url" validate:"required,url,max=2048"`
+	EventTypes []string `json:"event_types" validate:"required,min=1,unique,dive,oneof=member.registered member.email_changed member.deleted member.erased member.status_changed member.merged"`
+	Secret     string   `json:"secret" validate:"omitempty,min=16,max=128"`
+}
+
+type GetSubscriptionRequestDTO struct {
+	ID int `validate:"required,gte=1"`
+}
+
+type ListSubscriptionsRequestDTO struct {
+	Page  int `validate:"required,min=1"`
+	Limit int `validate:"required,min=1,max=100"`
+}
+
+// UpdateSubscriptionRequestDTO 更新 webhook 訂閱，未提供的欄位不修改
+//   - Status 只接受 active / paused，disabled 由系統設定
+type UpdateSubscriptionRequestDTO struct {
+	ID         int      `json:"id" validate:"required,gte=1"`
+	URL        *string  `json:"url,omitempty" validate:"omitempty,url,max=2048"`
+	EventTypes []string `json:"event_types,omitempty" validate:"omitempty,min=1,unique,dive,oneof=member.registered member.email_changed member.deleted member.erased member.status_changed member.merged"`
+	Secret     *string  `json:"secret,omitempty" validate:"omitempty,min=16,max=128"`
+	Status     *string  `json:"status,omitempty" validate:"omitempty,oneof=active paused"`
+}
+
+type DeleteSubscriptionRequestDTO struct {
+	ID int `validate:"required,gte=1"`
+}
+
+// ListDeliveriesRequestDTO 查詢訂閱的投遞紀錄
+//   - Status 只接受 pending / succeeded / failed，空字串表示全部
+type ListDeliveriesRequestDTO struct {
+	SubscriptionID int    `validate:"required,gte=1"`
+	Page           int    `validate:"required,min=1"`
+	Limit          int    `validate:"required,min=1,max=100"`
+	Status         string `validate:"omitempty,oneof=pending succeeded failed"`
+}
+
+type RedeliverRequestDTO struct {
+	SubscriptionID int `validate:"required,gte=1"`
+	DeliveryID     int `validate:"required,gte=1"`
+}
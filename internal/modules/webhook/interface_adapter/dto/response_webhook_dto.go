@@ -0,0 +1,46 @@
+package dto
+
+import "encoding/json"
+
+type SubscriptionItemDTO struct {
+	ID                  int      `json:"id"`
+	URL                 string   `json:"url"`
+	EventTypes          []string `json:"event_types"`
+	Status              string   `json:"status"`
+	ConsecutiveFailures int      `json:"consecutive_failures"`
+	CreatedAt           string   `json:"created_at"`
+	UpdatedAt           string   `json:"updated_at"`
+}
+
+// CreateSubscriptionResponseDTO 只有建立時會帶 secret
+type CreateSubscriptionResponseDTO struct {
+	SubscriptionItemDTO
+	Secret string `json:"secret"`
+}
+type GetSubscriptionResponseDTO = SubscriptionItemDTO
+type UpdateSubscriptionResponseDTO = SubscriptionItemDTO
+type DeleteSubscriptionResponseDTO = SubscriptionItemDTO
+type ListSubscriptionsResponseDTO struct {
+	Subscriptions []SubscriptionItemDTO `json:"subscriptions"`
+}
+
+type DeliveryItemDTO struct {
+	ID              int             `json:"id"`
+	SubscriptionID  int             `json:"subscription_id"`
+	EventID         string          `json:"event_id"`
+	EventType       string          `json:"event_type"`
+	Payload         json.RawMessage `json:"payload"`
+	Status          string          `json:"status"`
+	Attempts        int             `json:"attempts"`
+	NextAttemptAt   string          `json:"next_attempt_at"`
+	ResponseStatus  int             `json:"response_status"`
+	LastError       string          `json:"last_error"`
+	DurationMs      int64           `json:"duration_ms"`
+	RedeliveredFrom *int            `json:"redelivered_from,omitempty"`
+	CreatedAt       string          `json:"created_at"`
+	DeliveredAt     *string         `json:"delivered_at,omitempty"`
+}
+type ListDeliveriesResponseDTO struct {
+	Deliveries []DeliveryItemDTO `json:"deliveries"`
+}
+type RedeliverResponseDTO = DeliveryItemDTO
@@ -0,0 +1,288 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: webhook_dao.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	dao "github.com/tomoffice/go-clean-architecture/internal/modules/webhook/interface_adapter/dao"
+	pagination "github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+)
+
+// MockWebhookDAO is a mock of WebhookDAO interface.
+type MockWebhookDAO struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookDAOMockRecorder
+}
+
+// MockWebhookDAOMockRecorder is the mock recorder for MockWebhookDAO.
+type MockWebhookDAOMockRecorder struct {
+	mock *MockWebhookDAO
+}
+
+// NewMockWebhookDAO creates a new mock instance.
+func NewMockWebhookDAO(ctrl *gomock.Controller) *MockWebhookDAO {
+	mock := &MockWebhookDAO{ctrl: ctrl}
+	mock.recorder = &MockWebhookDAOMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookDAO) EXPECT() *MockWebhookDAOMockRecorder {
+	return m.recorder
+}
+
+// AddDeliveries mocks base method.
+func (m *MockWebhookDAO) AddDeliveries(ctx context.Context, records []*dao.DeliveryRecord) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDeliveries", ctx, records)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddDeliveries indicates an expected call of AddDeliveries.
+func (mr *MockWebhookDAOMockRecorder) AddDeliveries(ctx, records interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDeliveries", reflect.TypeOf((*MockWebhookDAO)(nil).AddDeliveries), ctx, records)
+}
+
+// CountDeliveries mocks base method.
+func (m *MockWebhookDAO) CountDeliveries(ctx context.Context, q dao.DeliveryQuery) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountDeliveries", ctx, q)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountDeliveries indicates an expected call of CountDeliveries.
+func (mr *MockWebhookDAOMockRecorder) CountDeliveries(ctx, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDeliveries", reflect.TypeOf((*MockWebhookDAO)(nil).CountDeliveries), ctx, q)
+}
+
+// CountSubscriptions mocks base method.
+func (m *MockWebhookDAO) CountSubscriptions(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSubscriptions", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSubscriptions indicates an expected call of CountSubscriptions.
+func (mr *MockWebhookDAOMockRecorder) CountSubscriptions(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSubscriptions", reflect.TypeOf((*MockWebhookDAO)(nil).CountSubscriptions), ctx)
+}
+
+// CreateDelivery mocks base method.
+func (m *MockWebhookDAO) CreateDelivery(ctx context.Context, record *dao.DeliveryRecord) (*dao.DeliveryRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDelivery", ctx, record)
+	ret0, _ := ret[0].(*dao.DeliveryRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDelivery indicates an expected call of CreateDelivery.
+func (mr *MockWebhookDAOMockRecorder) CreateDelivery(ctx, record interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDelivery", reflect.TypeOf((*MockWebhookDAO)(nil).CreateDelivery), ctx, record)
+}
+
+// CreateSubscription mocks base method.
+func (m *MockWebhookDAO) CreateSubscription(ctx context.Context, record *dao.SubscriptionRecord) (*dao.SubscriptionRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubscription", ctx, record)
+	ret0, _ := ret[0].(*dao.SubscriptionRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSubscription indicates an expected call of CreateSubscription.
+func (mr *MockWebhookDAOMockRecorder) CreateSubscription(ctx, record interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockWebhookDAO)(nil).CreateSubscription), ctx, record)
+}
+
+// DeleteSubscription mocks base method.
+func (m *MockWebhookDAO) DeleteSubscription(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubscription", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSubscription indicates an expected call of DeleteSubscription.
+func (mr *MockWebhookDAOMockRecorder) DeleteSubscription(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockWebhookDAO)(nil).DeleteSubscription), ctx, id)
+}
+
+// GetDeliveriesByEventIDs mocks base method.
+func (m *MockWebhookDAO) GetDeliveriesByEventIDs(ctx context.Context, eventIDs []string, p pagination.Pagination) ([]*dao.DeliveryRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveriesByEventIDs", ctx, eventIDs, p)
+	ret0, _ := ret[0].([]*dao.DeliveryRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeliveriesByEventIDs indicates an expected call of GetDeliveriesByEventIDs.
+func (mr *MockWebhookDAOMockRecorder) GetDeliveriesByEventIDs(ctx, eventIDs, p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveriesByEventIDs", reflect.TypeOf((*MockWebhookDAO)(nil).GetDeliveriesByEventIDs), ctx, eventIDs, p)
+}
+
+// GetDeliveryByID mocks base method.
+func (m *MockWebhookDAO) GetDeliveryByID(ctx context.Context, id int) (*dao.DeliveryRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveryByID", ctx, id)
+	ret0, _ := ret[0].(*dao.DeliveryRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeliveryByID indicates an expected call of GetDeliveryByID.
+func (mr *MockWebhookDAOMockRecorder) GetDeliveryByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveryByID", reflect.TypeOf((*MockWebhookDAO)(nil).GetDeliveryByID), ctx, id)
+}
+
+// GetDueDeliveries mocks base method.
+func (m *MockWebhookDAO) GetDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*dao.DeliveryRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueDeliveries", ctx, now, limit)
+	ret0, _ := ret[0].([]*dao.DeliveryRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDueDeliveries indicates an expected call of GetDueDeliveries.
+func (mr *MockWebhookDAOMockRecorder) GetDueDeliveries(ctx, now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueDeliveries", reflect.TypeOf((*MockWebhookDAO)(nil).GetDueDeliveries), ctx, now, limit)
+}
+
+// GetSubscriptionByID mocks base method.
+func (m *MockWebhookDAO) GetSubscriptionByID(ctx context.Context, id int) (*dao.SubscriptionRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscriptionByID", ctx, id)
+	ret0, _ := ret[0].(*dao.SubscriptionRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscriptionByID indicates an expected call of GetSubscriptionByID.
+func (mr *MockWebhookDAOMockRecorder) GetSubscriptionByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptionByID", reflect.TypeOf((*MockWebhookDAO)(nil).GetSubscriptionByID), ctx, id)
+}
+
+// GetSubscriptionsByStatus mocks base method.
+func (m *MockWebhookDAO) GetSubscriptionsByStatus(ctx context.Context, statuses []string) ([]*dao.SubscriptionRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscriptionsByStatus", ctx, statuses)
+	ret0, _ := ret[0].([]*dao.SubscriptionRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscriptionsByStatus indicates an expected call of GetSubscriptionsByStatus.
+func (mr *MockWebhookDAOMockRecorder) GetSubscriptionsByStatus(ctx, statuses interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptionsByStatus", reflect.TypeOf((*MockWebhookDAO)(nil).GetSubscriptionsByStatus), ctx, statuses)
+}
+
+// ListDeliveries mocks base method.
+func (m *MockWebhookDAO) ListDeliveries(ctx context.Context, q dao.DeliveryQuery, p pagination.Pagination) ([]*dao.DeliveryRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeliveries", ctx, q, p)
+	ret0, _ := ret[0].([]*dao.DeliveryRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeliveries indicates an expected call of ListDeliveries.
+func (mr *MockWebhookDAOMockRecorder) ListDeliveries(ctx, q, p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeliveries", reflect.TypeOf((*MockWebhookDAO)(nil).ListDeliveries), ctx, q, p)
+}
+
+// ListSubscriptions mocks base method.
+func (m *MockWebhookDAO) ListSubscriptions(ctx context.Context, p pagination.Pagination) ([]*dao.SubscriptionRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSubscriptions", ctx, p)
+	ret0, _ := ret[0].([]*dao.SubscriptionRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSubscriptions indicates an expected call of ListSubscriptions.
+func (mr *MockWebhookDAOMockRecorder) ListSubscriptions(ctx, p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscriptions", reflect.TypeOf((*MockWebhookDAO)(nil).ListSubscriptions), ctx, p)
+}
+
+// UpdateDeliveryPayload mocks base method.
+func (m *MockWebhookDAO) UpdateDeliveryPayload(ctx context.Context, id int, payload string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDeliveryPayload", ctx, id, payload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDeliveryPayload indicates an expected call of UpdateDeliveryPayload.
+func (mr *MockWebhookDAOMockRecorder) UpdateDeliveryPayload(ctx, id, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDeliveryPayload", reflect.TypeOf((*MockWebhookDAO)(nil).UpdateDeliveryPayload), ctx, id, payload)
+}
+
+// UpdateDeliveryResult mocks base method.
+func (m *MockWebhookDAO) UpdateDeliveryResult(ctx context.Context, record *dao.DeliveryRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDeliveryResult", ctx, record)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDeliveryResult indicates an expected call of UpdateDeliveryResult.
+func (mr *MockWebhookDAOMockRecorder) UpdateDeliveryResult(ctx, record interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDeliveryResult", reflect.TypeOf((*MockWebhookDAO)(nil).UpdateDeliveryResult), ctx, record)
+}
+
+// UpdateSubscription mocks base method.
+func (m *MockWebhookDAO) UpdateSubscription(ctx context.Context, record *dao.SubscriptionRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSubscription", ctx, record)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSubscription indicates an expected call of UpdateSubscription.
+func (mr *MockWebhookDAOMockRecorder) UpdateSubscription(ctx, record interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubscription", reflect.TypeOf((*MockWebhookDAO)(nil).UpdateSubscription), ctx, record)
+}
+
+// UpdateSubscriptionHealth mocks base method.
+func (m *MockWebhookDAO) UpdateSubscriptionHealth(ctx context.Context, id, consecutiveFailures int, status string, updatedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSubscriptionHealth", ctx, id, consecutiveFailures, status, updatedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSubscriptionHealth indicates an expected call of UpdateSubscriptionHealth.
+func (mr *MockWebhookDAOMockRecorder) UpdateSubscriptionHealth(ctx, id, consecutiveFailures, status, updatedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubscriptionHealth", reflect.TypeOf((*MockWebhookDAO)(nil).UpdateSubscriptionHealth), ctx, id, consecutiveFailures, status, updatedAt)
+}
@@ -0,0 +1,11 @@
+package repository
+
+import "errors"
+
+// 這裡定義的是 gateway 層會往 usecase 丟的錯誤型別，維護時常用 errors.Is 來判斷。
+
+var (
+	// ------- gateway 內部業務語意 -------
+	// ErrGatewayWebhookMappingError repo model 轉 entity 失敗。
+	ErrGatewayWebhookMappingError = errors.New("gateway: mapping webhook repo model to entity failed")
+)
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/framework/persistence/sqlx/mcsqlite"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/usecase"
+)
+
+// MapInfraErrorToUsecaseError 將底層 infra 錯誤直接轉為 usecase 定義的 sentinel error
+//   - notFound 依呼叫的方法決定是訂閱或投遞紀錄不存在
+func MapInfraErrorToUsecaseError(err error, notFound error) error {
+	if err == nil {
+		return nil
+	}
+	// 先處理 gateway 層的業務語意錯誤
+	if errors.Is(err, ErrGatewayWebhookMappingError) || errors.Is(err, mcsqlite.ErrMapperTimeParseFailed) ||
+		errors.Is(err, mcsqlite.ErrMapperEventTypesDecodeFailed) {
+		return usecase.ErrWebhookMappingError
+	}
+	// 先比對 CustomError
+	switch {
+	case errors.Is(err, mcsqlite.ErrDBRecordNotFound), errors.Is(err, mcsqlite.ErrDBNoEffect):
+		return notFound
+	}
+	// 再處理 DBError 類型
+	var dbErr *mcsqlite.DBError
+	if errors.As(err, &dbErr) {
+		return fmt.Errorf("%w: %v", usecase.ErrWebhookDBError, dbErr.RawError)
+	}
+	// fallback：其他未知錯誤
+	return usecase.ErrWebhookUnexpectedError
+}
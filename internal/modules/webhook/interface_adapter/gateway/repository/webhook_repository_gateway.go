@@ -0,0 +1,361 @@
+package repository
+
+import (
+	"context"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/interface_adapter/dao"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/usecase"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/usecase/port/output"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
+	"time"
+)
+
+type WebhookRepoGateway struct {
+	dao    dao.WebhookDAO
+	logger logger.Logger
+	tracer tracer.Tracer
+}
+
+func NewWebhookRepoGateway(dao dao.WebhookDAO, log logger.Logger, tracer tracer.Tracer) output.WebhookPersistence {
+	baseLogger := log.With(logger.NewField("layer", "gateway"))
+	return WebhookRepoGateway{
+		dao:    dao,
+		logger: baseLogger,
+		tracer: tracer,
+	}
+}
+
+func (g WebhookRepoGateway) CreateSubscription(ctx context.Context, subscription *entity.Subscription) (*entity.Subscription, error) {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.CreateSubscription")
+	defer span.End()
+
+	record, err := g.dao.CreateSubscription(gatewayCtx, subscriptionToRecord(subscription))
+	if err != nil {
+		traceLogger.Error("webhook 訂閱資料庫寫入失敗", logger.NewField("error", err), logger.NewField("url", subscription.URL))
+		return nil, MapInfraErrorToUsecaseError(err, usecase.ErrWebhookSubscriptionNotFound)
+	}
+	traceLogger.Debug("webhook 訂閱資料庫寫入成功", logger.NewField("subscription_id", record.ID))
+	return recordToSubscription(record), nil
+}
+
+func (g WebhookRepoGateway) GetSubscriptionByID(ctx context.Context, id int) (*entity.Subscription, error) {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.GetSubscriptionByID")
+	defer span.End()
+
+	record, err := g.dao.GetSubscriptionByID(gatewayCtx, id)
+	if err != nil {
+		traceLogger.Error("webhook 訂閱資料庫查詢失敗", logger.NewField("error", err), logger.NewField("subscription_id", id))
+		return nil, MapInfraErrorToUsecaseError(err, usecase.ErrWebhookSubscriptionNotFound)
+	}
+	return recordToSubscription(record), nil
+}
+
+func (g WebhookRepoGateway) ListSubscriptions(ctx context.Context, pagination pagination.Pagination) ([]*entity.Subscription, error) {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.ListSubscriptions")
+	defer span.End()
+
+	records, err := g.dao.ListSubscriptions(gatewayCtx, pagination)
+	if err != nil {
+		traceLogger.Error("webhook 訂閱列表資料庫查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("limit", pagination.Limit),
+			logger.NewField("offset", pagination.Offset),
+		)
+		return nil, MapInfraErrorToUsecaseError(err, usecase.ErrWebhookSubscriptionNotFound)
+	}
+	return recordsToSubscriptions(records), nil
+}
+
+func (g WebhookRepoGateway) CountSubscriptions(ctx context.Context) (int, error) {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.CountSubscriptions")
+	defer span.End()
+
+	count, err := g.dao.CountSubscriptions(gatewayCtx)
+	if err != nil {
+		traceLogger.Error("webhook 訂閱總數資料庫查詢失敗", logger.NewField("error", err))
+		return 0, MapInfraErrorToUsecaseError(err, usecase.ErrWebhookSubscriptionNotFound)
+	}
+	return count, nil
+}
+
+func (g WebhookRepoGateway) GetSubscriptionsByStatus(ctx context.Context, statuses []entity.SubscriptionStatus) ([]*entity.Subscription, error) {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.GetSubscriptionsByStatus")
+	defer span.End()
+
+	values := make([]string, 0, len(statuses))
+	for _, s := range statuses {
+		values = append(values, string(s))
+	}
+	records, err := g.dao.GetSubscriptionsByStatus(gatewayCtx, values)
+	if err != nil {
+		traceLogger.Error("webhook 訂閱依狀態資料庫查詢失敗", logger.NewField("error", err))
+		return nil, MapInfraErrorToUsecaseError(err, usecase.ErrWebhookSubscriptionNotFound)
+	}
+	return recordsToSubscriptions(records), nil
+}
+
+func (g WebhookRepoGateway) UpdateSubscription(ctx context.Context, subscription *entity.Subscription) error {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.UpdateSubscription")
+	defer span.End()
+
+	if err := g.dao.UpdateSubscription(gatewayCtx, subscriptionToRecord(subscription)); err != nil {
+		traceLogger.Error("webhook 訂閱資料庫更新失敗", logger.NewField("error", err), logger.NewField("subscription_id", subscription.ID))
+		return MapInfraErrorToUsecaseError(err, usecase.ErrWebhookSubscriptionNotFound)
+	}
+	return nil
+}
+
+func (g WebhookRepoGateway) UpdateSubscriptionHealth(ctx context.Context, id int, consecutiveFailures int, status entity.SubscriptionStatus, updatedAt time.Time) error {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.UpdateSubscriptionHealth")
+	defer span.End()
+
+	if err := g.dao.UpdateSubscriptionHealth(gatewayCtx, id, consecutiveFailures, string(status), updatedAt); err != nil {
+		traceLogger.Error("webhook 訂閱失敗次數資料庫更新失敗", logger.NewField("error", err), logger.NewField("subscription_id", id))
+		return MapInfraErrorToUsecaseError(err, usecase.ErrWebhookSubscriptionNotFound)
+	}
+	return nil
+}
+
+func (g WebhookRepoGateway) DeleteSubscription(ctx context.Context, id int) error {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.DeleteSubscription")
+	defer span.End()
+
+	if err := g.dao.DeleteSubscription(gatewayCtx, id); err != nil {
+		traceLogger.Error("webhook 訂閱資料庫刪除失敗", logger.NewField("error", err), logger.NewField("subscription_id", id))
+		return MapInfraErrorToUsecaseError(err, usecase.ErrWebhookSubscriptionNotFound)
+	}
+	return nil
+}
+
+func (g WebhookRepoGateway) AddDeliveries(ctx context.Context, deliveries []*entity.Delivery) (int, error) {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.AddDeliveries")
+	defer span.End()
+
+	records := make([]*dao.DeliveryRecord, 0, len(deliveries))
+	for _, d := range deliveries {
+		records = append(records, deliveryToRecord(d))
+	}
+	added, err := g.dao.AddDeliveries(gatewayCtx, records)
+	if err != nil {
+		traceLogger.Error("webhook 投遞紀錄資料庫寫入失敗", logger.NewField("error", err), logger.NewField("count", len(records)))
+		return 0, MapInfraErrorToUsecaseError(err, usecase.ErrWebhookDeliveryNotFound)
+	}
+	return added, nil
+}
+
+func (g WebhookRepoGateway) CreateDelivery(ctx context.Context, delivery *entity.Delivery) (*entity.Delivery, error) {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.CreateDelivery")
+	defer span.End()
+
+	record, err := g.dao.CreateDelivery(gatewayCtx, deliveryToRecord(delivery))
+	if err != nil {
+		traceLogger.Error("webhook 投遞紀錄資料庫建立失敗", logger.NewField("error", err), logger.NewField("event_id", delivery.EventID))
+		return nil, MapInfraErrorToUsecaseError(err, usecase.ErrWebhookDeliveryNotFound)
+	}
+	return recordToDelivery(record), nil
+}
+
+func (g WebhookRepoGateway) GetDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*entity.Delivery, error) {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.GetDueDeliveries")
+	defer span.End()
+
+	records, err := g.dao.GetDueDeliveries(gatewayCtx, now, limit)
+	if err != nil {
+		traceLogger.Error("webhook 到期投遞資料庫查詢失敗", logger.NewField("error", err), logger.NewField("limit", limit))
+		return nil, MapInfraErrorToUsecaseError(err, usecase.ErrWebhookDeliveryNotFound)
+	}
+	return recordsToDeliveries(records), nil
+}
+
+func (g WebhookRepoGateway) GetDeliveryByID(ctx context.Context, id int) (*entity.Delivery, error) {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.GetDeliveryByID")
+	defer span.End()
+
+	record, err := g.dao.GetDeliveryByID(gatewayCtx, id)
+	if err != nil {
+		traceLogger.Error("webhook 投遞紀錄資料庫查詢失敗", logger.NewField("error", err), logger.NewField("delivery_id", id))
+		return nil, MapInfraErrorToUsecaseError(err, usecase.ErrWebhookDeliveryNotFound)
+	}
+	return recordToDelivery(record), nil
+}
+
+func (g WebhookRepoGateway) ListDeliveries(ctx context.Context, filter output.DeliveryFilter, pagination pagination.Pagination) ([]*entity.Delivery, error) {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.ListDeliveries")
+	defer span.End()
+
+	records, err := g.dao.ListDeliveries(gatewayCtx, toDeliveryQuery(filter), pagination)
+	if err != nil {
+		traceLogger.Error("webhook 投遞紀錄列表資料庫查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("subscription_id", filter.SubscriptionID),
+			logger.NewField("limit", pagination.Limit),
+			logger.NewField("offset", pagination.Offset),
+		)
+		return nil, MapInfraErrorToUsecaseError(err, usecase.ErrWebhookDeliveryNotFound)
+	}
+	traceLogger.Debug("webhook 投遞紀錄列表資料庫查詢成功", logger.NewField("count", len(records)))
+	return recordsToDeliveries(records), nil
+}
+
+func (g WebhookRepoGateway) CountDeliveries(ctx context.Context, filter output.DeliveryFilter) (int, error) {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.CountDeliveries")
+	defer span.End()
+
+	count, err := g.dao.CountDeliveries(gatewayCtx, toDeliveryQuery(filter))
+	if err != nil {
+		traceLogger.Error("webhook 投遞紀錄總數資料庫查詢失敗", logger.NewField("error", err))
+		return 0, MapInfraErrorToUsecaseError(err, usecase.ErrWebhookDeliveryNotFound)
+	}
+	return count, nil
+}
+
+func (g WebhookRepoGateway) UpdateDeliveryResult(ctx context.Context, delivery *entity.Delivery) error {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.UpdateDeliveryResult")
+	defer span.End()
+
+	if err := g.dao.UpdateDeliveryResult(gatewayCtx, deliveryToRecord(delivery)); err != nil {
+		traceLogger.Error("webhook 投遞結果資料庫更新失敗", logger.NewField("error", err), logger.NewField("delivery_id", delivery.ID))
+		return MapInfraErrorToUsecaseError(err, usecase.ErrWebhookDeliveryNotFound)
+	}
+	return nil
+}
+
+func (g WebhookRepoGateway) GetDeliveriesByEventIDs(ctx context.Context, eventIDs []string, pagination pagination.Pagination) ([]*entity.Delivery, error) {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.GetDeliveriesByEventIDs")
+	defer span.End()
+
+	records, err := g.dao.GetDeliveriesByEventIDs(gatewayCtx, eventIDs, pagination)
+	if err != nil {
+		traceLogger.Error("webhook 事件投遞紀錄資料庫查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("event_count", len(eventIDs)),
+			logger.NewField("offset", pagination.Offset),
+		)
+		return nil, MapInfraErrorToUsecaseError(err, usecase.ErrWebhookDeliveryNotFound)
+	}
+	return recordsToDeliveries(records), nil
+}
+
+func (g WebhookRepoGateway) ReplaceDeliveryPayload(ctx context.Context, delivery *entity.Delivery) error {
+	// 創建帶有 trace 的 logger 用於追蹤
+	gatewayCtx, traceLogger, span := createTraceLogger(ctx, g.tracer, g.logger, "Gateway.ReplaceDeliveryPayload")
+	defer span.End()
+
+	if err := g.dao.UpdateDeliveryPayload(gatewayCtx, delivery.ID, string(delivery.Payload)); err != nil {
+		traceLogger.Error("webhook 投遞紀錄 payload 資料庫改寫失敗", logger.NewField("error", err), logger.NewField("delivery_id", delivery.ID))
+		return MapInfraErrorToUsecaseError(err, usecase.ErrWebhookDeliveryNotFound)
+	}
+	return nil
+}
+
+func subscriptionToRecord(s *entity.Subscription) *dao.SubscriptionRecord {
+	return &dao.SubscriptionRecord{
+		ID:                  s.ID,
+		URL:                 s.URL,
+		EventTypes:          s.EventTypes,
+		Secret:              s.Secret,
+		Status:              string(s.Status),
+		ConsecutiveFailures: s.ConsecutiveFailures,
+		CreatedAt:           s.CreatedAt,
+		UpdatedAt:           s.UpdatedAt,
+	}
+}
+
+func recordToSubscription(r *dao.SubscriptionRecord) *entity.Subscription {
+	return &entity.Subscription{
+		ID:                  r.ID,
+		URL:                 r.URL,
+		EventTypes:          r.EventTypes,
+		Secret:              r.Secret,
+		Status:              entity.SubscriptionStatus(r.Status),
+		ConsecutiveFailures: r.ConsecutiveFailures,
+		CreatedAt:           r.CreatedAt,
+		UpdatedAt:           r.UpdatedAt,
+	}
+}
+
+func recordsToSubscriptions(records []*dao.SubscriptionRecord) []*entity.Subscription {
+	subscriptions := make([]*entity.Subscription, 0, len(records))
+	for _, r := range records {
+		subscriptions = append(subscriptions, recordToSubscription(r))
+	}
+	return subscriptions
+}
+
+func deliveryToRecord(d *entity.Delivery) *dao.DeliveryRecord {
+	return &dao.DeliveryRecord{
+		ID:              d.ID,
+		SubscriptionID:  d.SubscriptionID,
+		EventID:         d.EventID,
+		EventType:       d.EventType,
+		Payload:         string(d.Payload),
+		Status:          string(d.Status),
+		Attempts:        d.Attempts,
+		NextAttemptAt:   d.NextAttemptAt,
+		ResponseStatus:  d.ResponseStatus,
+		LastError:       d.LastError,
+		DurationMs:      d.DurationMs,
+		RedeliveredFrom: d.RedeliveredFrom,
+		CreatedAt:       d.CreatedAt,
+		DeliveredAt:     d.DeliveredAt,
+	}
+}
+
+func recordToDelivery(r *dao.DeliveryRecord) *entity.Delivery {
+	return &entity.Delivery{
+		ID:              r.ID,
+		SubscriptionID:  r.SubscriptionID,
+		EventID:         r.EventID,
+		EventType:       r.EventType,
+		Payload:         []byte(r.Payload),
+		Status:          entity.DeliveryStatus(r.Status),
+		Attempts:        r.Attempts,
+		NextAttemptAt:   r.NextAttemptAt,
+		ResponseStatus:  r.ResponseStatus,
+		LastError:       r.LastError,
+		DurationMs:      r.DurationMs,
+		RedeliveredFrom: r.RedeliveredFrom,
+		CreatedAt:       r.CreatedAt,
+		DeliveredAt:     r.DeliveredAt,
+	}
+}
+
+func recordsToDeliveries(records []*dao.DeliveryRecord) []*entity.Delivery {
+	deliveries := make([]*entity.Delivery, 0, len(records))
+	for _, r := range records {
+		deliveries = append(deliveries, recordToDelivery(r))
+	}
+	return deliveries
+}
+
+func toDeliveryQuery(filter output.DeliveryFilter) dao.DeliveryQuery {
+	return dao.DeliveryQuery{
+		SubscriptionID: filter.SubscriptionID,
+		Status:         string(filter.Status),
+	}
+}
+
+// createTraceLogger 在 Gateway 層建立帶 Trace 的 Logger
+func createTraceLogger(ctx context.Context, tr tracer.Tracer, log logger.Logger, operationName string) (context.Context, logger.Logger, tracer.Span) {
+	gatewayCtx, span := tr.Start(ctx, operationName)
+	lg := log.WithContext(gatewayCtx)
+	return gatewayCtx, lg, span
+}
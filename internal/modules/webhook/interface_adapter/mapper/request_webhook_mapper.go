@@ -0,0 +1,60 @@
+// Package mapper 負責 webhook 模組 DTO 與 usecase 輸入、entity 與回應 DTO 之間的轉換。
+package mapper
+
+import (
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/interface_adapter/dto"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/usecase/inputmodel"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/enum"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+)
+
+func CreateSubscriptionDTOToInputModel(request dto.CreateSubscriptionRequestDTO) *inputmodel.CreateSubscriptionInputModel {
+	return &inputmodel.CreateSubscriptionInputModel{
+		URL:        request.URL,
+		EventTypes: request.EventTypes,
+		Secret:     request.Secret,
+	}
+}
+func ListSubscriptionsDTOToPagination(request dto.ListSubscriptionsRequestDTO) *pagination.Pagination {
+	return &pagination.Pagination{
+		Limit:   request.Limit,
+		Offset:  (request.Page - 1) * request.Limit,
+		SortBy:  "id",
+		OrderBy: enum.OrderByAsc,
+	}
+}
+func UpdateSubscriptionDTOToInputModel(request dto.UpdateSubscriptionRequestDTO) *inputmodel.UpdateSubscriptionInputModel {
+	var status *entity.SubscriptionStatus
+	if request.Status != nil {
+		s := entity.SubscriptionStatus(*request.Status)
+		status = &s
+	}
+	return &inputmodel.UpdateSubscriptionInputModel{
+		ID:         request.ID,
+		URL:        request.URL,
+		EventTypes: request.EventTypes,
+		Secret:     request.Secret,
+		Status:     status,
+	}
+}
+func ListDeliveriesDTOToInputModel(request dto.ListDeliveriesRequestDTO) *inputmodel.ListDeliveriesInputModel {
+	return &inputmodel.ListDeliveriesInputModel{
+		SubscriptionID: request.SubscriptionID,
+		Status:         entity.DeliveryStatus(request.Status),
+	}
+}
+func ListDeliveriesDTOToPagination(request dto.ListDeliveriesRequestDTO) *pagination.Pagination {
+	return &pagination.Pagination{
+		Limit:   request.Limit,
+		Offset:  (request.Page - 1) * request.Limit,
+		SortBy:  "id",
+		OrderBy: enum.OrderByDesc,
+	}
+}
+func RedeliverDTOToInputModel(request dto.RedeliverRequestDTO) *inputmodel.RedeliverInputModel {
+	return &inputmodel.RedeliverInputModel{
+		SubscriptionID: request.SubscriptionID,
+		DeliveryID:     request.DeliveryID,
+	}
+}
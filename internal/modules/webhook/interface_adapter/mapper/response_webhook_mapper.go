@@ -0,0 +1,78 @@
+package mapper
+
+import (
+	"encoding/json"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/interface_adapter/dto"
+	"time"
+)
+
+func EntityToCreateSubscriptionResponseDTO(subscription *entity.Subscription) dto.CreateSubscriptionResponseDTO {
+	return dto.CreateSubscriptionResponseDTO{
+		SubscriptionItemDTO: EntityToSubscriptionItemDTO(subscription),
+		Secret:              subscription.Secret,
+	}
+}
+func EntityToListSubscriptionsResponseDTO(subscriptions []*entity.Subscription) dto.ListSubscriptionsResponseDTO {
+	items := make([]dto.SubscriptionItemDTO, len(subscriptions))
+	for i, s := range subscriptions {
+		items[i] = EntityToSubscriptionItemDTO(s)
+	}
+	return dto.ListSubscriptionsResponseDTO{
+		Subscriptions: items,
+	}
+}
+
+// EntityToSubscriptionItemDTO 不包含 secret
+func EntityToSubscriptionItemDTO(subscription *entity.Subscription) dto.SubscriptionItemDTO {
+	eventTypes := subscription.EventTypes
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+	return dto.SubscriptionItemDTO{
+		ID:                  subscription.ID,
+		URL:                 subscription.URL,
+		EventTypes:          eventTypes,
+		Status:              string(subscription.Status),
+		ConsecutiveFailures: subscription.ConsecutiveFailures,
+		CreatedAt:           subscription.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:           subscription.UpdatedAt.Format(time.RFC3339),
+	}
+}
+func EntityToListDeliveriesResponseDTO(deliveries []*entity.Delivery) dto.ListDeliveriesResponseDTO {
+	items := make([]dto.DeliveryItemDTO, len(deliveries))
+	for i, d := range deliveries {
+		items[i] = EntityToDeliveryItemDTO(d)
+	}
+	return dto.ListDeliveriesResponseDTO{
+		Deliveries: items,
+	}
+}
+func EntityToDeliveryItemDTO(delivery *entity.Delivery) dto.DeliveryItemDTO {
+	payload := json.RawMessage(delivery.Payload)
+	if !json.Valid(payload) {
+		// payload 理應是 JSON，壞掉時以字串呈現方便排查
+		payload, _ = json.Marshal(string(delivery.Payload))
+	}
+	var deliveredAt *string
+	if delivery.DeliveredAt != nil {
+		formatted := delivery.DeliveredAt.Format(time.RFC3339)
+		deliveredAt = &formatted
+	}
+	return dto.DeliveryItemDTO{
+		ID:              delivery.ID,
+		SubscriptionID:  delivery.SubscriptionID,
+		EventID:         delivery.EventID,
+		EventType:       delivery.EventType,
+		Payload:         payload,
+		Status:          string(delivery.Status),
+		Attempts:        delivery.Attempts,
+		NextAttemptAt:   delivery.NextAttemptAt.Format(time.RFC3339),
+		ResponseStatus:  delivery.ResponseStatus,
+		LastError:       delivery.LastError,
+		DurationMs:      delivery.DurationMs,
+		RedeliveredFrom: delivery.RedeliveredFrom,
+		CreatedAt:       delivery.CreatedAt.Format(time.RFC3339),
+		DeliveredAt:     deliveredAt,
+	}
+}
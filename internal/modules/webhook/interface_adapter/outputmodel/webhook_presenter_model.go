@@ -0,0 +1,18 @@
+package outputmodel
+
+import (
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/interface_adapter/dto"
+	sharedviewmodel "github.com/tomoffice/go-clean-architecture/internal/shared/viewmodel/http"
+)
+
+// mockgen 尚未支援泛型，所以用別名展開所有泛型返回類型
+type CreateSubscriptionResponse = sharedviewmodel.HTTPResponse[dto.CreateSubscriptionResponseDTO]
+type GetSubscriptionResponse = sharedviewmodel.HTTPResponse[dto.GetSubscriptionResponseDTO]
+type ListSubscriptionsResponse = sharedviewmodel.HTTPResponse[dto.ListSubscriptionsResponseDTO]
+type UpdateSubscriptionResponse = sharedviewmodel.HTTPResponse[dto.UpdateSubscriptionResponseDTO]
+type DeleteSubscriptionResponse = sharedviewmodel.HTTPResponse[dto.DeleteSubscriptionResponseDTO]
+type ListDeliveriesResponse = sharedviewmodel.HTTPResponse[dto.ListDeliveriesResponseDTO]
+type RedeliverResponse = sharedviewmodel.HTTPResponse[dto.RedeliverResponseDTO]
+
+// 為 any 的情況也必須別名化
+type ErrorResponse = sharedviewmodel.HTTPResponse[any]
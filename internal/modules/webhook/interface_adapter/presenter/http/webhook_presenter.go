@@ -0,0 +1,101 @@
+package http
+
+import (
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/interface_adapter/mapper"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/interface_adapter/outputmodel"
+	sharedenum "github.com/tomoffice/go-clean-architecture/internal/shared/enum"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	sharedviewmodel "github.com/tomoffice/go-clean-architecture/internal/shared/viewmodel/http"
+	"strconv"
+)
+
+type WebhookPresenter struct{}
+
+func NewWebhookPresenter() *WebhookPresenter {
+	return &WebhookPresenter{}
+}
+
+func (p *WebhookPresenter) PresentCreateSubscription(subscription *entity.Subscription) outputmodel.CreateSubscriptionResponse {
+	respDTO := mapper.EntityToCreateSubscriptionResponseDTO(subscription)
+	return buildSuccessResponse(respDTO)
+}
+
+func (p *WebhookPresenter) PresentGetSubscription(subscription *entity.Subscription) outputmodel.GetSubscriptionResponse {
+	respDTO := mapper.EntityToSubscriptionItemDTO(subscription)
+	return buildSuccessResponse(respDTO)
+}
+
+func (p *WebhookPresenter) PresentListSubscriptions(subscriptions []*entity.Subscription, pagination pagination.Pagination, total int) outputmodel.ListSubscriptionsResponse {
+	respDTO := mapper.EntityToListSubscriptionsResponseDTO(subscriptions)
+	return buildSuccessResponseWithMeta(respDTO, buildMeta(pagination, total))
+}
+
+func (p *WebhookPresenter) PresentUpdateSubscription(subscription *entity.Subscription) outputmodel.UpdateSubscriptionResponse {
+	respDTO := mapper.EntityToSubscriptionItemDTO(subscription)
+	return buildSuccessResponse(respDTO)
+}
+
+func (p *WebhookPresenter) PresentDeleteSubscription(subscription *entity.Subscription) outputmodel.DeleteSubscriptionResponse {
+	respDTO := mapper.EntityToSubscriptionItemDTO(subscription)
+	return buildSuccessResponse(respDTO)
+}
+
+func (p *WebhookPresenter) PresentListDeliveries(deliveries []*entity.Delivery, pagination pagination.Pagination, total int) outputmodel.ListDeliveriesResponse {
+	respDTO := mapper.EntityToListDeliveriesResponseDTO(deliveries)
+	return buildSuccessResponseWithMeta(respDTO, buildMeta(pagination, total))
+}
+
+func (p *WebhookPresenter) PresentRedeliver(delivery *entity.Delivery) outputmodel.RedeliverResponse {
+	respDTO := mapper.EntityToDeliveryItemDTO(delivery)
+	return buildSuccessResponse(respDTO)
+}
+
+func (p *WebhookPresenter) PresentBindingError(errCode int, message string) outputmodel.ErrorResponse {
+	return buildFailedResponse(errCode, message)
+}
+
+func (p *WebhookPresenter) PresentValidationError(err error) (int, outputmodel.ErrorResponse) {
+	errCode, message := MapWebhookValidationError(err)
+	return errCode, buildFailedResponse(errCode, message)
+}
+
+func (p *WebhookPresenter) PresentUseCaseError(err error) (int, outputmodel.ErrorResponse) {
+	errCode, message := MapWebhookUseCaseToPresenterError(err)
+	return errCode, buildFailedResponse(errCode, message)
+}
+
+func buildMeta(pagination pagination.Pagination, total int) *sharedviewmodel.MetaPayload {
+	page := 1
+	if pagination.Limit > 0 {
+		page = pagination.Offset/pagination.Limit + 1
+	}
+	return &sharedviewmodel.MetaPayload{
+		Total:  total,
+		Page:   page,
+		Limit:  pagination.Limit,
+		Offset: pagination.Offset,
+	}
+}
+func buildSuccessResponse[T any](data T) sharedviewmodel.HTTPResponse[T] {
+	return sharedviewmodel.HTTPResponse[T]{
+		Data:             data,
+		BaseHTTPResponse: sharedviewmodel.NewBaseHTTPResponse(sharedenum.APIStatusSuccess),
+	}
+}
+func buildSuccessResponseWithMeta[T any](data T, meta *sharedviewmodel.MetaPayload) sharedviewmodel.HTTPResponse[T] {
+	return sharedviewmodel.HTTPResponse[T]{
+		Data:             data,
+		Meta:             meta,
+		BaseHTTPResponse: sharedviewmodel.NewBaseHTTPResponse(sharedenum.APIStatusSuccess),
+	}
+}
+func buildFailedResponse(code int, message string) outputmodel.ErrorResponse {
+	return outputmodel.ErrorResponse{
+		Error: &sharedviewmodel.ErrorPayload{
+			Code:    strconv.Itoa(code),
+			Message: message,
+		},
+		BaseHTTPResponse: sharedviewmodel.NewBaseHTTPResponse(sharedenum.APIStatusFailed),
+	}
+}
@@ -0,0 +1,30 @@
+package http
+
+import (
+	"errors"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/usecase"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/errorcode"
+	sharederrors "github.com/tomoffice/go-clean-architecture/internal/shared/errordefs"
+)
+
+func MapWebhookUseCaseToPresenterError(err error) (int, string) {
+	switch {
+	case errors.Is(err, usecase.ErrWebhookSubscriptionNotFound):
+		return errorcode.ErrWebhookSubscriptionNotFound, usecase.ErrWebhookSubscriptionNotFound.Error()
+	case errors.Is(err, usecase.ErrWebhookDeliveryNotFound):
+		return errorcode.ErrWebhookDeliveryNotFound, usecase.ErrWebhookDeliveryNotFound.Error()
+	case errors.Is(err, usecase.ErrWebhookForbidden):
+		return errorcode.ErrWebhookForbidden, usecase.ErrWebhookForbidden.Error()
+	case errors.Is(err, usecase.ErrWebhookInvalidSubscription):
+		// 附上 entity 的原因，例如 URL scheme 不合法
+		return errorcode.ErrWebhookInvalidSubscription, err.Error()
+	case errors.Is(err, usecase.ErrWebhookDBError):
+		return errorcode.ErrWebhookDBError, usecase.ErrWebhookDBError.Error()
+	case errors.Is(err, usecase.ErrWebhookMappingError):
+		return errorcode.ErrWebhookMappingError, usecase.ErrWebhookMappingError.Error()
+	case errors.Is(err, usecase.ErrWebhookSecretGenerateFailed), errors.Is(err, usecase.ErrWebhookUnexpectedError):
+		return errorcode.ErrUnexpectedWebhookUseCaseError, usecase.ErrWebhookUnexpectedError.Error()
+	default:
+		return errorcode.ErrInternalServer, sharederrors.ErrInternalServer.Error()
+	}
+}
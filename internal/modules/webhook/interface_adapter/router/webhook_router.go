@@ -0,0 +1,32 @@
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	ginadapter "github.com/tomoffice/go-clean-architecture/internal/framework/http/gin/adapter"
+	memberhttp "github.com/tomoffice/go-clean-architecture/internal/interface_adapter/transport/http"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/interface_adapter/controller"
+)
+
+type WebhookRouter struct {
+	controller *controller.WebhookController
+	router     memberhttp.Router
+}
+
+func NewWebhookRouter(ctrl *controller.WebhookController, routerGroup *gin.RouterGroup) *WebhookRouter {
+	moduleGroup := routerGroup.Group("/webhooks")
+	return &WebhookRouter{
+		controller: ctrl,
+		router:     ginadapter.NewRouter(moduleGroup),
+	}
+}
+
+func (r *WebhookRouter) Register() error {
+	r.router.POST("", r.controller.Create)
+	r.router.GET("", r.controller.List)
+	r.router.GET("/:id", r.controller.GetByID)
+	r.router.PATCH("/:id", r.controller.Update)
+	r.router.DELETE("/:id", r.controller.Delete)
+	r.router.GET("/:id/deliveries", r.controller.ListDeliveries)
+	r.router.POST("/:id/deliveries/:deliveryId/redeliver", r.controller.Redeliver)
+	return nil
+}
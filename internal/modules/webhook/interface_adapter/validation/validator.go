@@ -0,0 +1,14 @@
+package validation
+
+//go:generate mockgen -source=validator.go -destination=../../interface_adapter/controller/mock/mock_validator.go -package=mock
+import "github.com/tomoffice/go-clean-architecture/internal/modules/webhook/interface_adapter/dto"
+
+type Validator interface {
+	ValidateCreateSubscription(dto.CreateSubscriptionRequestDTO) error
+	ValidateGetSubscription(dto.GetSubscriptionRequestDTO) error
+	ValidateListSubscriptions(dto.ListSubscriptionsRequestDTO) error
+	ValidateUpdateSubscription(dto.UpdateSubscriptionRequestDTO) error
+	ValidateDeleteSubscription(dto.DeleteSubscriptionRequestDTO) error
+	ValidateListDeliveries(dto.ListDeliveriesRequestDTO) error
+	ValidateRedeliver(dto.RedeliverRequestDTO) error
+}
@@ -0,0 +1,58 @@
+package validation
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/interface_adapter/dto"
+)
+
+type WebhookValidator struct {
+	validator *validator.Validate
+}
+
+func NewWebhookValidator() *WebhookValidator {
+	return &WebhookValidator{
+		validator: validator.New(),
+	}
+}
+func (v *WebhookValidator) ValidateCreateSubscription(dto dto.CreateSubscriptionRequestDTO) error {
+	if err := v.validator.Struct(dto); err != nil {
+		return err
+	}
+	return nil
+}
+func (v *WebhookValidator) ValidateGetSubscription(dto dto.GetSubscriptionRequestDTO) error {
+	if err := v.validator.Struct(dto); err != nil {
+		return err
+	}
+	return nil
+}
+func (v *WebhookValidator) ValidateListSubscriptions(dto dto.ListSubscriptionsRequestDTO) error {
+	if err := v.validator.Struct(dto); err != nil {
+		return err
+	}
+	return nil
+}
+func (v *WebhookValidator) ValidateUpdateSubscription(dto dto.UpdateSubscriptionRequestDTO) error {
+	if err := v.validator.Struct(dto); err != nil {
+		return err
+	}
+	return nil
+}
+func (v *WebhookValidator) ValidateDeleteSubscription(dto dto.DeleteSubscriptionRequestDTO) error {
+	if err := v.validator.Struct(dto); err != nil {
+		return err
+	}
+	return nil
+}
+func (v *WebhookValidator) ValidateListDeliveries(dto dto.ListDeliveriesRequestDTO) error {
+	if err := v.validator.Struct(dto); err != nil {
+		return err
+	}
+	return nil
+}
+func (v *WebhookValidator) ValidateRedeliver(dto dto.RedeliverRequestDTO) error {
+	if err := v.validator.Struct(dto); err != nil {
+		return err
+	}
+	return nil
+}
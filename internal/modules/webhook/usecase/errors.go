@@ -0,0 +1,30 @@
+package usecase
+
+import "errors"
+
+// WebhookUseCase 錯誤碼
+var (
+	//------- gateway error mapping -------
+	// ErrWebhookDBError 遇到 DB 或 infra 技術性問題。
+	ErrWebhookDBError = errors.New("usecase: webhook db operation error")
+	// ErrWebhookMappingError 從 repo model 轉換到 entity 時發生錯誤，像是時間格式有誤等。
+	ErrWebhookMappingError = errors.New("usecase: webhook mapping repo model to entity failed")
+	// ErrWebhookUnexpectedError 不知道怎麼歸類的錯誤就收斂到這個。
+	ErrWebhookUnexpectedError = errors.New("usecase: webhook usecase unexpected error")
+	// ErrWebhookSubscriptionNotFound 訂閱不存在。
+	ErrWebhookSubscriptionNotFound = errors.New("usecase: webhook subscription not found")
+	// ErrWebhookDeliveryNotFound 投遞紀錄不存在，或不屬於指定的訂閱。
+	ErrWebhookDeliveryNotFound = errors.New("usecase: webhook delivery not found")
+
+	// ------- usecase 內部的業務語意 -------
+	// ErrWebhookInvalidSubscription 訂閱欄位不合法，例如 URL 不是 http(s)。
+	ErrWebhookInvalidSubscription = errors.New("usecase: webhook subscription invalid")
+	// ErrWebhookSecretGenerateFailed 產生簽章金鑰失敗。
+	ErrWebhookSecretGenerateFailed = errors.New("usecase: webhook secret generate failed")
+	// ErrWebhookForbidden 呼叫者不在 webhook 管理者名單內。
+	ErrWebhookForbidden = errors.New("usecase: webhook operation forbidden")
+	// ErrWebhookInvalidRedaction 遮蔽條件缺少欄位。
+	ErrWebhookInvalidRedaction = errors.New("usecase: webhook redaction invalid")
+	// ErrWebhookDeliveryFailed 投遞失敗，會依重試策略延後再送。
+	ErrWebhookDeliveryFailed = errors.New("usecase: webhook delivery failed")
+)
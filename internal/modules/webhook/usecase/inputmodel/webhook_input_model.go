@@ -0,0 +1,56 @@
+package inputmodel
+
+import (
+	"encoding/json"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/entity"
+	"time"
+)
+
+// CreateSubscriptionInputModel 建立訂閱
+//   - Secret 為空時由系統產生
+type CreateSubscriptionInputModel struct {
+	URL        string
+	EventTypes []string
+	Secret     string
+}
+
+// UpdateSubscriptionInputModel 更新訂閱，nil 欄位表示不修改
+//   - Status 只接受 active / paused，改回 active 時連續失敗次數歸零
+type UpdateSubscriptionInputModel struct {
+	ID         int
+	URL        *string
+	EventTypes []string
+	Secret     *string
+	Status     *entity.SubscriptionStatus
+}
+
+// ListDeliveriesInputModel 查詢訂閱的投遞紀錄
+//   - Status : 只看特定狀態，空字串表示全部
+type ListDeliveriesInputModel struct {
+	SubscriptionID int
+	Status         entity.DeliveryStatus
+}
+
+// RedeliverInputModel 手動重送一筆投遞紀錄
+type RedeliverInputModel struct {
+	SubscriptionID int
+	DeliveryID     int
+}
+
+// WebhookEventInputModel 要分送給訂閱者的領域事件
+//   - EventID : 事件唯一 ID，同一事件重複送入時不會重複建立投遞紀錄
+//   - Data : 事件內容（JSON）
+type WebhookEventInputModel struct {
+	EventID    string
+	EventType  string
+	OccurredAt time.Time
+	Data       json.RawMessage
+}
+
+// RedactDeliveriesInputModel 遮蔽指定事件的投遞紀錄 payload 中的個資
+//   - EventIDs : 要遮蔽的事件 ID，同一事件的所有投遞（包含手動重送）都會處理
+//   - Fields : payload 中要遮蔽的欄位名稱，例如 name、email
+type RedactDeliveriesInputModel struct {
+	EventIDs []string
+	Fields   []string
+}
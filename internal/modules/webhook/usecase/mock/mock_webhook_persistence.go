@@ -0,0 +1,289 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: webhook_persistence.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/tomoffice/go-clean-architecture/internal/modules/webhook/entity"
+	output "github.com/tomoffice/go-clean-architecture/internal/modules/webhook/usecase/port/output"
+	pagination "github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+)
+
+// MockWebhookPersistence is a mock of WebhookPersistence interface.
+type MockWebhookPersistence struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookPersistenceMockRecorder
+}
+
+// MockWebhookPersistenceMockRecorder is the mock recorder for MockWebhookPersistence.
+type MockWebhookPersistenceMockRecorder struct {
+	mock *MockWebhookPersistence
+}
+
+// NewMockWebhookPersistence creates a new mock instance.
+func NewMockWebhookPersistence(ctrl *gomock.Controller) *MockWebhookPersistence {
+	mock := &MockWebhookPersistence{ctrl: ctrl}
+	mock.recorder = &MockWebhookPersistenceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookPersistence) EXPECT() *MockWebhookPersistenceMockRecorder {
+	return m.recorder
+}
+
+// AddDeliveries mocks base method.
+func (m *MockWebhookPersistence) AddDeliveries(ctx context.Context, deliveries []*entity.Delivery) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDeliveries", ctx, deliveries)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddDeliveries indicates an expected call of AddDeliveries.
+func (mr *MockWebhookPersistenceMockRecorder) AddDeliveries(ctx, deliveries interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDeliveries", reflect.TypeOf((*MockWebhookPersistence)(nil).AddDeliveries), ctx, deliveries)
+}
+
+// CountDeliveries mocks base method.
+func (m *MockWebhookPersistence) CountDeliveries(ctx context.Context, filter output.DeliveryFilter) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountDeliveries", ctx, filter)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountDeliveries indicates an expected call of CountDeliveries.
+func (mr *MockWebhookPersistenceMockRecorder) CountDeliveries(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDeliveries", reflect.TypeOf((*MockWebhookPersistence)(nil).CountDeliveries), ctx, filter)
+}
+
+// CountSubscriptions mocks base method.
+func (m *MockWebhookPersistence) CountSubscriptions(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSubscriptions", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSubscriptions indicates an expected call of CountSubscriptions.
+func (mr *MockWebhookPersistenceMockRecorder) CountSubscriptions(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSubscriptions", reflect.TypeOf((*MockWebhookPersistence)(nil).CountSubscriptions), ctx)
+}
+
+// CreateDelivery mocks base method.
+func (m *MockWebhookPersistence) CreateDelivery(ctx context.Context, delivery *entity.Delivery) (*entity.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDelivery", ctx, delivery)
+	ret0, _ := ret[0].(*entity.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDelivery indicates an expected call of CreateDelivery.
+func (mr *MockWebhookPersistenceMockRecorder) CreateDelivery(ctx, delivery interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDelivery", reflect.TypeOf((*MockWebhookPersistence)(nil).CreateDelivery), ctx, delivery)
+}
+
+// CreateSubscription mocks base method.
+func (m *MockWebhookPersistence) CreateSubscription(ctx context.Context, subscription *entity.Subscription) (*entity.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubscription", ctx, subscription)
+	ret0, _ := ret[0].(*entity.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSubscription indicates an expected call of CreateSubscription.
+func (mr *MockWebhookPersistenceMockRecorder) CreateSubscription(ctx, subscription interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockWebhookPersistence)(nil).CreateSubscription), ctx, subscription)
+}
+
+// DeleteSubscription mocks base method.
+func (m *MockWebhookPersistence) DeleteSubscription(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubscription", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSubscription indicates an expected call of DeleteSubscription.
+func (mr *MockWebhookPersistenceMockRecorder) DeleteSubscription(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockWebhookPersistence)(nil).DeleteSubscription), ctx, id)
+}
+
+// GetDeliveriesByEventIDs mocks base method.
+func (m *MockWebhookPersistence) GetDeliveriesByEventIDs(ctx context.Context, eventIDs []string, pagination pagination.Pagination) ([]*entity.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveriesByEventIDs", ctx, eventIDs, pagination)
+	ret0, _ := ret[0].([]*entity.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeliveriesByEventIDs indicates an expected call of GetDeliveriesByEventIDs.
+func (mr *MockWebhookPersistenceMockRecorder) GetDeliveriesByEventIDs(ctx, eventIDs, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveriesByEventIDs", reflect.TypeOf((*MockWebhookPersistence)(nil).GetDeliveriesByEventIDs), ctx, eventIDs, pagination)
+}
+
+// GetDeliveryByID mocks base method.
+func (m *MockWebhookPersistence) GetDeliveryByID(ctx context.Context, id int) (*entity.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveryByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeliveryByID indicates an expected call of GetDeliveryByID.
+func (mr *MockWebhookPersistenceMockRecorder) GetDeliveryByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveryByID", reflect.TypeOf((*MockWebhookPersistence)(nil).GetDeliveryByID), ctx, id)
+}
+
+// GetDueDeliveries mocks base method.
+func (m *MockWebhookPersistence) GetDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*entity.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueDeliveries", ctx, now, limit)
+	ret0, _ := ret[0].([]*entity.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDueDeliveries indicates an expected call of GetDueDeliveries.
+func (mr *MockWebhookPersistenceMockRecorder) GetDueDeliveries(ctx, now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueDeliveries", reflect.TypeOf((*MockWebhookPersistence)(nil).GetDueDeliveries), ctx, now, limit)
+}
+
+// GetSubscriptionByID mocks base method.
+func (m *MockWebhookPersistence) GetSubscriptionByID(ctx context.Context, id int) (*entity.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscriptionByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscriptionByID indicates an expected call of GetSubscriptionByID.
+func (mr *MockWebhookPersistenceMockRecorder) GetSubscriptionByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptionByID", reflect.TypeOf((*MockWebhookPersistence)(nil).GetSubscriptionByID), ctx, id)
+}
+
+// GetSubscriptionsByStatus mocks base method.
+func (m *MockWebhookPersistence) GetSubscriptionsByStatus(ctx context.Context, statuses []entity.SubscriptionStatus) ([]*entity.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscriptionsByStatus", ctx, statuses)
+	ret0, _ := ret[0].([]*entity.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscriptionsByStatus indicates an expected call of GetSubscriptionsByStatus.
+func (mr *MockWebhookPersistenceMockRecorder) GetSubscriptionsByStatus(ctx, statuses interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptionsByStatus", reflect.TypeOf((*MockWebhookPersistence)(nil).GetSubscriptionsByStatus), ctx, statuses)
+}
+
+// ListDeliveries mocks base method.
+func (m *MockWebhookPersistence) ListDeliveries(ctx context.Context, filter output.DeliveryFilter, pagination pagination.Pagination) ([]*entity.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeliveries", ctx, filter, pagination)
+	ret0, _ := ret[0].([]*entity.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeliveries indicates an expected call of ListDeliveries.
+func (mr *MockWebhookPersistenceMockRecorder) ListDeliveries(ctx, filter, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeliveries", reflect.TypeOf((*MockWebhookPersistence)(nil).ListDeliveries), ctx, filter, pagination)
+}
+
+// ListSubscriptions mocks base method.
+func (m *MockWebhookPersistence) ListSubscriptions(ctx context.Context, pagination pagination.Pagination) ([]*entity.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSubscriptions", ctx, pagination)
+	ret0, _ := ret[0].([]*entity.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSubscriptions indicates an expected call of ListSubscriptions.
+func (mr *MockWebhookPersistenceMockRecorder) ListSubscriptions(ctx, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscriptions", reflect.TypeOf((*MockWebhookPersistence)(nil).ListSubscriptions), ctx, pagination)
+}
+
+// ReplaceDeliveryPayload mocks base method.
+func (m *MockWebhookPersistence) ReplaceDeliveryPayload(ctx context.Context, delivery *entity.Delivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceDeliveryPayload", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceDeliveryPayload indicates an expected call of ReplaceDeliveryPayload.
+func (mr *MockWebhookPersistenceMockRecorder) ReplaceDeliveryPayload(ctx, delivery interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceDeliveryPayload", reflect.TypeOf((*MockWebhookPersistence)(nil).ReplaceDeliveryPayload), ctx, delivery)
+}
+
+// UpdateDeliveryResult mocks base method.
+func (m *MockWebhookPersistence) UpdateDeliveryResult(ctx context.Context, delivery *entity.Delivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDeliveryResult", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDeliveryResult indicates an expected call of UpdateDeliveryResult.
+func (mr *MockWebhookPersistenceMockRecorder) UpdateDeliveryResult(ctx, delivery interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDeliveryResult", reflect.TypeOf((*MockWebhookPersistence)(nil).UpdateDeliveryResult), ctx, delivery)
+}
+
+// UpdateSubscription mocks base method.
+func (m *MockWebhookPersistence) UpdateSubscription(ctx context.Context, subscription *entity.Subscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSubscription", ctx, subscription)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSubscription indicates an expected call of UpdateSubscription.
+func (mr *MockWebhookPersistenceMockRecorder) UpdateSubscription(ctx, subscription interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubscription", reflect.TypeOf((*MockWebhookPersistence)(nil).UpdateSubscription), ctx, subscription)
+}
+
+// UpdateSubscriptionHealth mocks base method.
+func (m *MockWebhookPersistence) UpdateSubscriptionHealth(ctx context.Context, id, consecutiveFailures int, status entity.SubscriptionStatus, updatedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSubscriptionHealth", ctx, id, consecutiveFailures, status, updatedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSubscriptionHealth indicates an expected call of UpdateSubscriptionHealth.
+func (mr *MockWebhookPersistenceMockRecorder) UpdateSubscriptionHealth(ctx, id, consecutiveFailures, status, updatedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubscriptionHealth", reflect.TypeOf((*MockWebhookPersistence)(nil).UpdateSubscriptionHealth), ctx, id, consecutiveFailures, status, updatedAt)
+}
@@ -0,0 +1,27 @@
+package input
+
+//go:generate mockgen -source=webhook_input_port.go -destination=../../../interface_adapter/controller/mock/mock_webhook_input_port.go -package=mock
+import (
+	"context"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/usecase/inputmodel"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+)
+
+type WebhookInputPort interface {
+	CreateSubscription(ctx context.Context, input *inputmodel.CreateSubscriptionInputModel) (*entity.Subscription, error)
+	GetSubscription(ctx context.Context, id int) (*entity.Subscription, error)
+	ListSubscriptions(ctx context.Context, pagination pagination.Pagination) ([]*entity.Subscription, int, error)
+	UpdateSubscription(ctx context.Context, input *inputmodel.UpdateSubscriptionInputModel) (*entity.Subscription, error)
+	DeleteSubscription(ctx context.Context, id int) (*entity.Subscription, error)
+	// EnqueueEvent 為訂閱此事件的 active/paused 訂閱建立投遞紀錄，回傳新建立的筆數
+	EnqueueEvent(ctx context.Context, event *inputmodel.WebhookEventInputModel) (int, error)
+	// DeliverPending 投遞一批到期的紀錄，回傳成功的數量
+	DeliverPending(ctx context.Context) (int, error)
+	ListDeliveries(ctx context.Context, filter *inputmodel.ListDeliveriesInputModel, pagination pagination.Pagination) ([]*entity.Delivery, int, error)
+	// Redeliver 以原始內容建立一筆新的投遞紀錄，下一輪 dispatcher 送出
+	Redeliver(ctx context.Context, input *inputmodel.RedeliverInputModel) (*entity.Delivery, error)
+	// RedactDeliveries 遮蔽指定事件投遞紀錄中的個資，回傳改寫的筆數；供其他模組在個資刪除的交易中呼叫，
+	// 授權由呼叫端負責，不檢查 webhook 管理者名單
+	RedactDeliveries(ctx context.Context, input *inputmodel.RedactDeliveriesInputModel) (int, error)
+}
@@ -0,0 +1,45 @@
+package output
+
+//go:generate mockgen -source=webhook_persistence.go -destination=../../mock/mock_webhook_persistence.go -package=mock
+import (
+	"context"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"time"
+)
+
+// DeliveryFilter 投遞紀錄的查詢條件，Status 為空字串表示全部
+type DeliveryFilter struct {
+	SubscriptionID int
+	Status         entity.DeliveryStatus
+}
+
+type WebhookPersistence interface {
+	CreateSubscription(ctx context.Context, subscription *entity.Subscription) (*entity.Subscription, error)
+	GetSubscriptionByID(ctx context.Context, id int) (*entity.Subscription, error)
+	ListSubscriptions(ctx context.Context, pagination pagination.Pagination) ([]*entity.Subscription, error)
+	CountSubscriptions(ctx context.Context) (int, error)
+	// GetSubscriptionsByStatus 取得指定狀態的所有訂閱，用於事件分送
+	GetSubscriptionsByStatus(ctx context.Context, statuses []entity.SubscriptionStatus) ([]*entity.Subscription, error)
+	UpdateSubscription(ctx context.Context, subscription *entity.Subscription) error
+	// UpdateSubscriptionHealth 更新連續失敗次數；status 只在訂閱仍為 active 時套用，避免覆蓋使用者的暫停
+	UpdateSubscriptionHealth(ctx context.Context, id int, consecutiveFailures int, status entity.SubscriptionStatus, updatedAt time.Time) error
+	// DeleteSubscription 刪除訂閱與其投遞紀錄
+	DeleteSubscription(ctx context.Context, id int) error
+
+	// AddDeliveries 寫入投遞紀錄，同一訂閱的同一事件已存在時略過，回傳實際新增的筆數
+	AddDeliveries(ctx context.Context, deliveries []*entity.Delivery) (int, error)
+	// CreateDelivery 寫入單筆投遞紀錄（手動重送），回傳含 ID 的紀錄
+	CreateDelivery(ctx context.Context, delivery *entity.Delivery) (*entity.Delivery, error)
+	// GetDueDeliveries 取得 pending、已到期且訂閱為 active 的紀錄，依 id 由舊到新
+	GetDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*entity.Delivery, error)
+	GetDeliveryByID(ctx context.Context, id int) (*entity.Delivery, error)
+	ListDeliveries(ctx context.Context, filter DeliveryFilter, pagination pagination.Pagination) ([]*entity.Delivery, error)
+	CountDeliveries(ctx context.Context, filter DeliveryFilter) (int, error)
+	// UpdateDeliveryResult 記錄一次投遞嘗試的結果
+	UpdateDeliveryResult(ctx context.Context, delivery *entity.Delivery) error
+	// GetDeliveriesByEventIDs 取得指定事件的投遞紀錄，依 id 由舊到新
+	GetDeliveriesByEventIDs(ctx context.Context, eventIDs []string, pagination pagination.Pagination) ([]*entity.Delivery, error)
+	// ReplaceDeliveryPayload 只改寫 payload，供個資遮蔽使用
+	ReplaceDeliveryPayload(ctx context.Context, delivery *entity.Delivery) error
+}
@@ -0,0 +1,25 @@
+package output
+
+//go:generate mockgen -source=webhook_presenter.go -destination=../../../interface_adapter/controller/mock/mock_webhook_presenter.go -package=mock
+import (
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/interface_adapter/outputmodel"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+)
+
+type WebhookPresenter interface {
+	// PresentCreateSubscription 建立時會回傳 secret，之後的查詢不再顯示
+	PresentCreateSubscription(subscription *entity.Subscription) outputmodel.CreateSubscriptionResponse
+	PresentGetSubscription(subscription *entity.Subscription) outputmodel.GetSubscriptionResponse
+	PresentListSubscriptions(subscriptions []*entity.Subscription, pagination pagination.Pagination, total int) outputmodel.ListSubscriptionsResponse
+	PresentUpdateSubscription(subscription *entity.Subscription) outputmodel.UpdateSubscriptionResponse
+	PresentDeleteSubscription(subscription *entity.Subscription) outputmodel.DeleteSubscriptionResponse
+	PresentListDeliveries(deliveries []*entity.Delivery, pagination pagination.Pagination, total int) outputmodel.ListDeliveriesResponse
+	PresentRedeliver(delivery *entity.Delivery) outputmodel.RedeliverResponse
+	// PresentBindingError 處理輸入綁定錯誤
+	PresentBindingError(errCode int, message string) outputmodel.ErrorResponse
+	// PresentValidationError 處理驗證錯誤
+	PresentValidationError(err error) (int, outputmodel.ErrorResponse)
+	// PresentUseCaseError 處理用例錯誤
+	PresentUseCaseError(err error) (int, outputmodel.ErrorResponse)
+}
@@ -0,0 +1,24 @@
+package output
+
+//go:generate mockgen -source=webhook_sender.go -destination=../../mock/mock_webhook_sender.go -package=mock
+import "context"
+
+// WebhookRequest 要送出的 webhook 請求，header 已包含簽章
+type WebhookRequest struct {
+	URL     string
+	Body    []byte
+	Headers map[string]string
+}
+
+// WebhookResponse 接收端的回應，Body 只保留前段供投遞紀錄排查
+type WebhookResponse struct {
+	StatusCode int
+	Body       string
+}
+
+// WebhookSender 將 webhook 送往訂閱者
+//   - 回傳 error 代表連線層級失敗（逾時、拒絕連線），此時 response 為 nil
+//   - 非 2xx 回應不回傳 error，由 use case 判斷是否成功
+type WebhookSender interface {
+	Send(ctx context.Context, request WebhookRequest) (*WebhookResponse, error)
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import "time"
+
+// RetryPolicy webhook 投遞的批次、重試與自動停用設定
+//   - 第 n 次失敗後等待 BaseBackoff * 2^(n-1)，最多 MaxBackoff
+//   - 單筆投遞失敗次數達 MaxAttempts 後標記為 failed，可手動重送
+//   - 訂閱連續失敗達 DisableAfter 次後自動停用，0 表示不停用
+type RetryPolicy struct {
+	BatchSize    int
+	MaxAttempts  int
+	BaseBackoff  time.Duration
+	MaxBackoff   time.Duration
+	DisableAfter int
+}
+
+// DefaultRetryPolicy 預設重試策略
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		BatchSize:    50,
+		MaxAttempts:  8,
+		BaseBackoff:  5 * time.Second,
+		MaxBackoff:   time.Hour,
+		DisableAfter: 20,
+	}
+}
+
+// Backoff 回傳第 attempts 次失敗後應等待的時間
+func (p RetryPolicy) Backoff(attempts int) time.Duration {
+	if attempts < 1 {
+		attempts = 1
+	}
+	backoff := p.BaseBackoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= p.MaxBackoff {
+			return p.MaxBackoff
+		}
+	}
+	if backoff > p.MaxBackoff {
+		return p.MaxBackoff
+	}
+	return backoff
+}
+
+// Exhausted 是否已達單筆投遞的最大重試次數
+func (p RetryPolicy) Exhausted(attempts int) bool {
+	return p.MaxAttempts > 0 && attempts >= p.MaxAttempts
+}
+
+// ShouldDisable 訂閱連續失敗次數是否已達停用門檻
+func (p RetryPolicy) ShouldDisable(consecutiveFailures int) bool {
+	return p.DisableAfter > 0 && consecutiveFailures >= p.DisableAfter
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+)
+
+// 投遞時附帶的 header，接收端以 HeaderTimestamp 與 body 重算簽章比對 HeaderSignature
+const (
+	HeaderSignature  = "X-Webhook-Signature"
+	HeaderTimestamp  = "X-Webhook-Timestamp"
+	HeaderEvent      = "X-Webhook-Event"
+	HeaderEventID    = "X-Webhook-Event-Id"
+	HeaderDeliveryID = "X-Webhook-Delivery"
+
+	signaturePrefix = "sha256="
+	secretPrefix    = "whsec_"
+	secretBytes     = 32
+)
+
+// Sign 以 HMAC-SHA256 對 "<timestamp>.<body>" 簽章，回傳 "sha256=<hex>"
+//   - 把時間戳記納入簽章，接收端可拒絕過舊的請求以防重放
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature 以固定時間比較簽章，供接收端或測試使用
+func VerifySignature(secret string, timestamp int64, body []byte, signature string) bool {
+	if !strings.HasPrefix(signature, signaturePrefix) {
+		return false
+	}
+	expected := Sign(secret, timestamp, body)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+// generateSecret 產生隨機簽章金鑰
+func generateSecret() (string, error) {
+	buf := make([]byte, secretBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return secretPrefix + hex.EncodeToString(buf), nil
+}
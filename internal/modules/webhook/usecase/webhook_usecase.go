@@ -0,0 +1,665 @@
+// Package usecase 定義 webhook 模組的應用層業務邏輯，負責管理訂閱、分送事件與投遞。
+//
+// 職責:
+// - 檢查訂閱欄位並產生簽章金鑰
+// - 將領域事件分送成每個訂閱一筆的投遞紀錄
+// - 以 HMAC-SHA256 簽章投遞，依重試策略延後失敗的投遞並自動停用持續失敗的訂閱
+// - 查詢投遞紀錄與手動重送
+// - 個資刪除時遮蔽投遞紀錄 payload 中的個資
+// - 不依賴外部框架（如 HTTP、DB）
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/usecase/inputmodel"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/usecase/port/input"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/usecase/port/output"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/requestmeta"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
+	"strconv"
+	"time"
+)
+
+// maxLastErrorLength last_error 欄位只保留前段，避免外部回應把表撐大
+const maxLastErrorLength = 1024
+
+// webhookPayload 送給訂閱者的 body
+type webhookPayload struct {
+	EventID    string          `json:"event_id"`
+	EventType  string          `json:"event_type"`
+	OccurredAt string          `json:"occurred_at"`
+	Data       json.RawMessage `json:"data"`
+}
+
+type WebhookUseCase struct {
+	WebhookGateway output.WebhookPersistence
+	sender         output.WebhookSender
+	policy         RetryPolicy
+	logger         logger.Logger
+	tracer         tracer.Tracer
+	now            func() time.Time
+	newSecret      func() (string, error)
+	// admins 可管理訂閱、查詢與重送投遞紀錄的 actor；投遞內容含會員個資，其他人一律拒絕
+	admins map[string]struct{}
+}
+
+// NewWebhookUseCase admins 為空時沒有人能管理訂閱；匿名 actor 不會被列入
+func NewWebhookUseCase(webhookRepo output.WebhookPersistence, sender output.WebhookSender, policy RetryPolicy, admins []string, log logger.Logger, tracer tracer.Tracer) input.WebhookInputPort {
+	baseLogger := log.With(logger.NewField("layer", "usecase"))
+	adminSet := make(map[string]struct{}, len(admins))
+	for _, admin := range admins {
+		if admin == "" || admin == requestmeta.AnonymousActor {
+			continue
+		}
+		adminSet[admin] = struct{}{}
+	}
+	return &WebhookUseCase{
+		WebhookGateway: webhookRepo,
+		sender:         sender,
+		policy:         policy,
+		admins:         adminSet,
+		logger:         baseLogger,
+		tracer:         tracer,
+		now:            time.Now,
+		newSecret:      generateSecret,
+	}
+}
+
+// authorize 訂閱管理與投遞紀錄只開放給 admins
+func (w *WebhookUseCase) authorize(ctx context.Context, contextLogger logger.Logger, operation string) error {
+	actor := requestmeta.FromContext(ctx).Actor
+	if _, ok := w.admins[actor]; !ok {
+		contextLogger.Warn("webhook 操作被拒：呼叫者不是 webhook 管理者",
+			logger.NewField("operation", operation),
+			logger.NewField("actor", actor),
+		)
+		return ErrWebhookForbidden
+	}
+	return nil
+}
+
+func (w *WebhookUseCase) CreateSubscription(ctx context.Context, in *inputmodel.CreateSubscriptionInputModel) (*entity.Subscription, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, w.tracer, w.logger)
+	defer span.End()
+
+	if err := w.authorize(transCtx, contextLogger, "create_subscription"); err != nil {
+		return nil, err
+	}
+
+	secret := in.Secret
+	if secret == "" {
+		generated, err := w.newSecret()
+		if err != nil {
+			contextLogger.Error("webhook 簽章金鑰產生失敗", logger.NewField("error", err))
+			return nil, errors.Join(ErrWebhookSecretGenerateFailed, err)
+		}
+		secret = generated
+	}
+	now := w.now().UTC()
+	subscription := &entity.Subscription{
+		URL:        in.URL,
+		EventTypes: in.EventTypes,
+		Secret:     secret,
+		Status:     entity.SubscriptionStatusActive,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if err := subscription.Validate(); err != nil {
+		contextLogger.Error("webhook 訂閱欄位不合法",
+			logger.NewField("error", err),
+			logger.NewField("url", in.URL),
+		)
+		return nil, errors.Join(ErrWebhookInvalidSubscription, err)
+	}
+
+	created, err := w.WebhookGateway.CreateSubscription(transCtx, subscription)
+	if err != nil {
+		contextLogger.Error("webhook 訂閱建立 Gateway 執行失敗",
+			logger.NewField("error", err),
+			logger.NewField("url", in.URL),
+		)
+		return nil, err
+	}
+
+	contextLogger.Info("webhook 訂閱建立成功",
+		logger.NewField("subscription_id", created.ID),
+		logger.NewField("event_types", created.EventTypes),
+	)
+	return created, nil
+}
+
+func (w *WebhookUseCase) GetSubscription(ctx context.Context, id int) (*entity.Subscription, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, w.tracer, w.logger)
+	defer span.End()
+
+	if err := w.authorize(transCtx, contextLogger, "get_subscription"); err != nil {
+		return nil, err
+	}
+
+	subscription, err := w.WebhookGateway.GetSubscriptionByID(transCtx, id)
+	if err != nil {
+		contextLogger.Error("webhook 訂閱查詢 Gateway 執行失敗",
+			logger.NewField("error", err),
+			logger.NewField("subscription_id", id),
+		)
+		return nil, err
+	}
+	return subscription, nil
+}
+
+func (w *WebhookUseCase) ListSubscriptions(ctx context.Context, pagination pagination.Pagination) ([]*entity.Subscription, int, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, w.tracer, w.logger)
+	defer span.End()
+
+	if err := w.authorize(transCtx, contextLogger, "list_subscriptions"); err != nil {
+		return nil, 0, err
+	}
+
+	subscriptions, err := w.WebhookGateway.ListSubscriptions(transCtx, pagination)
+	if err != nil {
+		contextLogger.Error("webhook 訂閱列表查詢 Gateway 執行失敗",
+			logger.NewField("error", err),
+			logger.NewField("limit", pagination.Limit),
+			logger.NewField("offset", pagination.Offset),
+		)
+		return nil, 0, err
+	}
+	total, err := w.WebhookGateway.CountSubscriptions(transCtx)
+	if err != nil {
+		contextLogger.Error("webhook 訂閱總數查詢 Gateway 執行失敗", logger.NewField("error", err))
+		return nil, 0, err
+	}
+	return subscriptions, total, nil
+}
+
+func (w *WebhookUseCase) UpdateSubscription(ctx context.Context, in *inputmodel.UpdateSubscriptionInputModel) (*entity.Subscription, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, w.tracer, w.logger)
+	defer span.End()
+
+	if err := w.authorize(transCtx, contextLogger, "update_subscription"); err != nil {
+		return nil, err
+	}
+
+	subscription, err := w.WebhookGateway.GetSubscriptionByID(transCtx, in.ID)
+	if err != nil {
+		contextLogger.Error("webhook 訂閱更新前查詢 Gateway 執行失敗",
+			logger.NewField("error", err),
+			logger.NewField("subscription_id", in.ID),
+		)
+		return nil, err
+	}
+	if in.URL != nil {
+		subscription.URL = *in.URL
+	}
+	if in.EventTypes != nil {
+		subscription.EventTypes = in.EventTypes
+	}
+	if in.Secret != nil {
+		subscription.Secret = *in.Secret
+	}
+	if in.Status != nil {
+		if *in.Status == entity.SubscriptionStatusDisabled {
+			// disabled 只能由系統設定
+			contextLogger.Warn("webhook 訂閱不可手動設為 disabled", logger.NewField("subscription_id", in.ID))
+			return nil, errors.Join(ErrWebhookInvalidSubscription, entity.ErrInvalidStatus)
+		}
+		if *in.Status == entity.SubscriptionStatusActive && subscription.Status != entity.SubscriptionStatusActive {
+			// 重新啟用時從零開始計算連續失敗
+			subscription.ConsecutiveFailures = 0
+		}
+		subscription.Status = *in.Status
+	}
+	subscription.UpdatedAt = w.now().UTC()
+	if err := subscription.Validate(); err != nil {
+		contextLogger.Error("webhook 訂閱欄位不合法",
+			logger.NewField("error", err),
+			logger.NewField("subscription_id", in.ID),
+		)
+		return nil, errors.Join(ErrWebhookInvalidSubscription, err)
+	}
+
+	if err := w.WebhookGateway.UpdateSubscription(transCtx, subscription); err != nil {
+		contextLogger.Error("webhook 訂閱更新 Gateway 執行失敗",
+			logger.NewField("error", err),
+			logger.NewField("subscription_id", in.ID),
+		)
+		return nil, err
+	}
+
+	contextLogger.Info("webhook 訂閱更新成功",
+		logger.NewField("subscription_id", subscription.ID),
+		logger.NewField("status", subscription.Status),
+	)
+	return subscription, nil
+}
+
+func (w *WebhookUseCase) DeleteSubscription(ctx context.Context, id int) (*entity.Subscription, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, w.tracer, w.logger)
+	defer span.End()
+
+	if err := w.authorize(transCtx, contextLogger, "delete_subscription"); err != nil {
+		return nil, err
+	}
+
+	subscription, err := w.WebhookGateway.GetSubscriptionByID(transCtx, id)
+	if err != nil {
+		contextLogger.Error("webhook 訂閱刪除前查詢 Gateway 執行失敗",
+			logger.NewField("error", err),
+			logger.NewField("subscription_id", id),
+		)
+		return nil, err
+	}
+	if err := w.WebhookGateway.DeleteSubscription(transCtx, id); err != nil {
+		contextLogger.Error("webhook 訂閱刪除 Gateway 執行失敗",
+			logger.NewField("error", err),
+			logger.NewField("subscription_id", id),
+		)
+		return nil, err
+	}
+
+	contextLogger.Info("webhook 訂閱刪除成功", logger.NewField("subscription_id", id))
+	return subscription, nil
+}
+
+func (w *WebhookUseCase) EnqueueEvent(ctx context.Context, event *inputmodel.WebhookEventInputModel) (int, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, w.tracer, w.logger)
+	defer span.End()
+
+	subscriptions, err := w.WebhookGateway.GetSubscriptionsByStatus(transCtx, []entity.SubscriptionStatus{
+		entity.SubscriptionStatusActive,
+		entity.SubscriptionStatusPaused,
+	})
+	if err != nil {
+		contextLogger.Error("webhook 訂閱查詢 Gateway 執行失敗",
+			logger.NewField("error", err),
+			logger.NewField("event_type", event.EventType),
+		)
+		return 0, err
+	}
+
+	data := event.Data
+	if !json.Valid(data) {
+		data = json.RawMessage("{}")
+	}
+	payload, err := json.Marshal(webhookPayload{
+		EventID:    event.EventID,
+		EventType:  event.EventType,
+		OccurredAt: event.OccurredAt.UTC().Format(time.RFC3339),
+		Data:       data,
+	})
+	if err != nil {
+		contextLogger.Error("webhook payload 編碼失敗", logger.NewField("error", err))
+		return 0, fmt.Errorf("%w: %v", ErrWebhookUnexpectedError, err)
+	}
+
+	now := w.now().UTC()
+	deliveries := make([]*entity.Delivery, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		if !subscription.Subscribes(event.EventType) {
+			continue
+		}
+		deliveries = append(deliveries, &entity.Delivery{
+			SubscriptionID: subscription.ID,
+			EventID:        event.EventID,
+			EventType:      event.EventType,
+			Payload:        payload,
+			Status:         entity.DeliveryStatusPending,
+			NextAttemptAt:  now,
+			CreatedAt:      now,
+		})
+	}
+	if len(deliveries) == 0 {
+		return 0, nil
+	}
+
+	added, err := w.WebhookGateway.AddDeliveries(transCtx, deliveries)
+	if err != nil {
+		contextLogger.Error("webhook 投遞紀錄寫入 Gateway 執行失敗",
+			logger.NewField("error", err),
+			logger.NewField("event_id", event.EventID),
+		)
+		return 0, err
+	}
+
+	contextLogger.Debug("webhook 事件分送完成",
+		logger.NewField("event_id", event.EventID),
+		logger.NewField("event_type", event.EventType),
+		logger.NewField("deliveries", added),
+	)
+	return added, nil
+}
+
+func (w *WebhookUseCase) DeliverPending(ctx context.Context) (int, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, w.tracer, w.logger)
+	defer span.End()
+
+	deliveries, err := w.WebhookGateway.GetDueDeliveries(transCtx, w.now().UTC(), w.policy.BatchSize)
+	if err != nil {
+		contextLogger.Error("webhook 到期投遞查詢 Gateway 執行失敗", logger.NewField("error", err))
+		return 0, err
+	}
+
+	subscriptions := make(map[int]*entity.Subscription)
+	succeeded := 0
+	for _, delivery := range deliveries {
+		if transCtx.Err() != nil {
+			// dispatcher 正在關閉，剩下的投遞留給下一輪
+			break
+		}
+		subscription, ok := subscriptions[delivery.SubscriptionID]
+		if !ok {
+			subscription, err = w.WebhookGateway.GetSubscriptionByID(transCtx, delivery.SubscriptionID)
+			if err != nil {
+				contextLogger.Error("webhook 投遞前訂閱查詢失敗",
+					logger.NewField("error", err),
+					logger.NewField("delivery_id", delivery.ID),
+				)
+				return succeeded, err
+			}
+			subscriptions[delivery.SubscriptionID] = subscription
+		}
+		if !subscription.IsActive() {
+			// 同一批次中訂閱已被停用，剩下的投遞等重新啟用後再送
+			continue
+		}
+
+		delivered, deliverErr := w.deliver(transCtx, contextLogger, subscription, delivery)
+		if deliverErr != nil {
+			return succeeded, deliverErr
+		}
+		if delivered {
+			succeeded++
+		}
+	}
+
+	if len(deliveries) > 0 {
+		contextLogger.Debug("webhook 投遞完成",
+			logger.NewField("due", len(deliveries)),
+			logger.NewField("succeeded", succeeded),
+		)
+	}
+	return succeeded, nil
+}
+
+// deliver 送出一筆投遞並更新投遞紀錄與訂閱的連續失敗次數；回傳 error 僅代表狀態寫入失敗
+func (w *WebhookUseCase) deliver(ctx context.Context, contextLogger logger.Logger, subscription *entity.Subscription, delivery *entity.Delivery) (bool, error) {
+	timestamp := w.now().UTC().Unix()
+	request := output.WebhookRequest{
+		URL:  subscription.URL,
+		Body: delivery.Payload,
+		Headers: map[string]string{
+			"Content-Type":   "application/json",
+			HeaderSignature:  Sign(subscription.Secret, timestamp, delivery.Payload),
+			HeaderTimestamp:  strconv.FormatInt(timestamp, 10),
+			HeaderEvent:      delivery.EventType,
+			HeaderEventID:    delivery.EventID,
+			HeaderDeliveryID: strconv.Itoa(delivery.ID),
+		},
+	}
+
+	startTime := w.now()
+	response, sendErr := w.sender.Send(ctx, request)
+	delivery.DurationMs = w.now().Sub(startTime).Milliseconds()
+	delivery.Attempts++
+	delivery.ResponseStatus = 0
+	if response != nil {
+		delivery.ResponseStatus = response.StatusCode
+	}
+
+	succeeded := sendErr == nil && response != nil && response.StatusCode >= 200 && response.StatusCode < 300
+	if succeeded {
+		deliveredAt := w.now().UTC()
+		delivery.Status = entity.DeliveryStatusSucceeded
+		delivery.LastError = ""
+		delivery.DeliveredAt = &deliveredAt
+	} else {
+		failure := deliveryFailure(sendErr, response)
+		delivery.LastError = truncate(failure.Error(), maxLastErrorLength)
+		delivery.Status = entity.DeliveryStatusPending
+		if w.policy.Exhausted(delivery.Attempts) {
+			delivery.Status = entity.DeliveryStatusFailed
+		}
+		delivery.NextAttemptAt = w.now().UTC().Add(w.policy.Backoff(delivery.Attempts))
+	}
+	if err := w.WebhookGateway.UpdateDeliveryResult(ctx, delivery); err != nil {
+		contextLogger.Error("webhook 投遞結果更新失敗",
+			logger.NewField("error", err),
+			logger.NewField("delivery_id", delivery.ID),
+		)
+		return false, err
+	}
+
+	if err := w.recordHealth(ctx, contextLogger, subscription, succeeded); err != nil {
+		return false, err
+	}
+	if !succeeded {
+		contextLogger.Warn("webhook 投遞失敗",
+			logger.NewField("error", delivery.LastError),
+			logger.NewField("delivery_id", delivery.ID),
+			logger.NewField("subscription_id", subscription.ID),
+			logger.NewField("attempts", delivery.Attempts),
+			logger.NewField("status", delivery.Status),
+		)
+	}
+	return succeeded, nil
+}
+
+// recordHealth 成功時將連續失敗次數歸零，失敗時累加並在達門檻時停用訂閱
+func (w *WebhookUseCase) recordHealth(ctx context.Context, contextLogger logger.Logger, subscription *entity.Subscription, succeeded bool) error {
+	failures := subscription.ConsecutiveFailures + 1
+	if succeeded {
+		if subscription.ConsecutiveFailures == 0 {
+			return nil
+		}
+		failures = 0
+	}
+	status := subscription.Status
+	if !succeeded && w.policy.ShouldDisable(failures) {
+		status = entity.SubscriptionStatusDisabled
+	}
+	if err := w.WebhookGateway.UpdateSubscriptionHealth(ctx, subscription.ID, failures, status, w.now().UTC()); err != nil {
+		contextLogger.Error("webhook 訂閱失敗次數更新失敗",
+			logger.NewField("error", err),
+			logger.NewField("subscription_id", subscription.ID),
+		)
+		return err
+	}
+	subscription.ConsecutiveFailures = failures
+	if status != subscription.Status {
+		subscription.Status = status
+		contextLogger.Error("webhook 訂閱連續失敗過多，已自動停用",
+			logger.NewField("subscription_id", subscription.ID),
+			logger.NewField("consecutive_failures", failures),
+		)
+	}
+	return nil
+}
+
+func (w *WebhookUseCase) ListDeliveries(ctx context.Context, filter *inputmodel.ListDeliveriesInputModel, pagination pagination.Pagination) ([]*entity.Delivery, int, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, w.tracer, w.logger)
+	defer span.End()
+
+	if err := w.authorize(transCtx, contextLogger, "list_deliveries"); err != nil {
+		return nil, 0, err
+	}
+
+	if _, err := w.WebhookGateway.GetSubscriptionByID(transCtx, filter.SubscriptionID); err != nil {
+		contextLogger.Error("webhook 投遞紀錄查詢前訂閱查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("subscription_id", filter.SubscriptionID),
+		)
+		return nil, 0, err
+	}
+	deliveryFilter := output.DeliveryFilter{
+		SubscriptionID: filter.SubscriptionID,
+		Status:         filter.Status,
+	}
+	deliveries, err := w.WebhookGateway.ListDeliveries(transCtx, deliveryFilter, pagination)
+	if err != nil {
+		contextLogger.Error("webhook 投遞紀錄查詢 Gateway 執行失敗",
+			logger.NewField("error", err),
+			logger.NewField("subscription_id", filter.SubscriptionID),
+		)
+		return nil, 0, err
+	}
+	total, err := w.WebhookGateway.CountDeliveries(transCtx, deliveryFilter)
+	if err != nil {
+		contextLogger.Error("webhook 投遞紀錄總數查詢 Gateway 執行失敗", logger.NewField("error", err))
+		return nil, 0, err
+	}
+	return deliveries, total, nil
+}
+
+func (w *WebhookUseCase) Redeliver(ctx context.Context, in *inputmodel.RedeliverInputModel) (*entity.Delivery, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, w.tracer, w.logger)
+	defer span.End()
+
+	if err := w.authorize(transCtx, contextLogger, "redeliver"); err != nil {
+		return nil, err
+	}
+
+	original, err := w.WebhookGateway.GetDeliveryByID(transCtx, in.DeliveryID)
+	if err != nil {
+		contextLogger.Error("webhook 重送前投遞紀錄查詢失敗",
+			logger.NewField("error", err),
+			logger.NewField("delivery_id", in.DeliveryID),
+		)
+		return nil, err
+	}
+	if original.SubscriptionID != in.SubscriptionID {
+		contextLogger.Warn("webhook 投遞紀錄不屬於指定訂閱",
+			logger.NewField("delivery_id", in.DeliveryID),
+			logger.NewField("subscription_id", in.SubscriptionID),
+		)
+		return nil, ErrWebhookDeliveryNotFound
+	}
+
+	now := w.now().UTC()
+	originalID := original.ID
+	delivery := &entity.Delivery{
+		SubscriptionID:  original.SubscriptionID,
+		EventID:         original.EventID,
+		EventType:       original.EventType,
+		Payload:         original.Payload,
+		Status:          entity.DeliveryStatusPending,
+		NextAttemptAt:   now,
+		RedeliveredFrom: &originalID,
+		CreatedAt:       now,
+	}
+	created, err := w.WebhookGateway.CreateDelivery(transCtx, delivery)
+	if err != nil {
+		contextLogger.Error("webhook 重送紀錄建立 Gateway 執行失敗",
+			logger.NewField("error", err),
+			logger.NewField("delivery_id", in.DeliveryID),
+		)
+		return nil, err
+	}
+
+	contextLogger.Info("webhook 重送已排入",
+		logger.NewField("delivery_id", created.ID),
+		logger.NewField("redelivered_from", originalID),
+	)
+	return created, nil
+}
+
+// redactPageSize 遮蔽個資時每次查詢的事件數與投遞紀錄筆數
+const redactPageSize = 100
+
+func (w *WebhookUseCase) RedactDeliveries(ctx context.Context, in *inputmodel.RedactDeliveriesInputModel) (int, error) {
+	// 創建帶有 context 的 logger 用於追蹤
+	transCtx, contextLogger, span := createTracedLogger(ctx, w.tracer, w.logger)
+	defer span.End()
+
+	if len(in.Fields) == 0 {
+		contextLogger.Error("webhook 投遞紀錄遮蔽條件不完整",
+			logger.NewField("event_count", len(in.EventIDs)),
+		)
+		return 0, ErrWebhookInvalidRedaction
+	}
+
+	redacted := 0
+	// 事件 ID 分批查詢，避免 IN 條件過長
+	for start := 0; start < len(in.EventIDs); start += redactPageSize {
+		eventIDs := in.EventIDs[start:min(start+redactPageSize, len(in.EventIDs))]
+		// 只改寫 payload，不影響排序與筆數，offset 分頁在迴圈中保持穩定
+		for offset := 0; ; offset += redactPageSize {
+			deliveries, err := w.WebhookGateway.GetDeliveriesByEventIDs(transCtx, eventIDs, pagination.Pagination{Limit: redactPageSize, Offset: offset})
+			if err != nil {
+				contextLogger.Error("webhook 投遞紀錄遮蔽查詢 Gateway 執行失敗",
+					logger.NewField("error", err),
+					logger.NewField("offset", offset),
+				)
+				return redacted, err
+			}
+			for _, delivery := range deliveries {
+				changed, err := delivery.RedactPayload(in.Fields...)
+				if err != nil {
+					contextLogger.Error("webhook 投遞紀錄 payload 解析失敗",
+						logger.NewField("error", err),
+						logger.NewField("delivery_id", delivery.ID),
+					)
+					return redacted, fmt.Errorf("%w: %v", ErrWebhookUnexpectedError, err)
+				}
+				if !changed {
+					continue
+				}
+				if err := w.WebhookGateway.ReplaceDeliveryPayload(transCtx, delivery); err != nil {
+					contextLogger.Error("webhook 投遞紀錄遮蔽 Gateway 執行失敗",
+						logger.NewField("error", err),
+						logger.NewField("delivery_id", delivery.ID),
+					)
+					return redacted, err
+				}
+				redacted++
+			}
+			if len(deliveries) < redactPageSize {
+				break
+			}
+		}
+	}
+
+	contextLogger.Info("webhook 投遞紀錄個資遮蔽完成",
+		logger.NewField("event_count", len(in.EventIDs)),
+		logger.NewField("redacted", redacted),
+	)
+	return redacted, nil
+}
+
+// deliveryFailure 整理投遞失敗原因，非 2xx 時附上回應前段方便排查
+func deliveryFailure(sendErr error, response *output.WebhookResponse) error {
+	if sendErr != nil {
+		return fmt.Errorf("%w: %v", ErrWebhookDeliveryFailed, sendErr)
+	}
+	if response == nil {
+		return ErrWebhookDeliveryFailed
+	}
+	return fmt.Errorf("%w: status %d %s", ErrWebhookDeliveryFailed, response.StatusCode, response.Body)
+}
+
+func truncate(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	return s[:max]
+}
+
+func createTracedLogger(ctx context.Context, tr tracer.Tracer, log logger.Logger) (context.Context, logger.Logger, tracer.Span) {
+	transCtx, span := tr.Start(ctx, "")
+	lg := log.WithContext(transCtx)
+	return transCtx, lg, span
+}
@@ -0,0 +1,610 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/entity"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/usecase/inputmodel"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/usecase/mock"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/usecase/port/output"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/pagination"
+	"github.com/tomoffice/go-clean-architecture/internal/shared/requestmeta"
+	mocklogger "github.com/tomoffice/go-clean-architecture/pkg/logger/mock"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
+	mocktracer "github.com/tomoffice/go-clean-architecture/pkg/tracer/mock"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestWebhookUseCase_CreateSubscription(t *testing.T) {
+	ctrl, ctx, testTime, mockLogger, mockTracer := repoHelper(t)
+	tests := []struct {
+		name      string
+		input     *inputmodel.CreateSubscriptionInputModel
+		newSecret func() (string, error)
+		repoSetup func(*mock.MockWebhookPersistence)
+		wantErr   error
+	}{
+		{
+			name:      "normal test generates secret",
+			input:     &inputmodel.CreateSubscriptionInputModel{URL: "https://example.com/hook", EventTypes: []string{"member.registered"}},
+			newSecret: func() (string, error) { return "whsec_generated", nil },
+			repoSetup: func(r *mock.MockWebhookPersistence) {
+				r.EXPECT().CreateSubscription(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, s *entity.Subscription) (*entity.Subscription, error) {
+					assert.Equal(t, "whsec_generated", s.Secret)
+					assert.Equal(t, entity.SubscriptionStatusActive, s.Status)
+					assert.Equal(t, testTime, s.CreatedAt)
+					s.ID = 1
+					return s, nil
+				})
+			},
+		},
+		{
+			name:      "keeps given secret",
+			input:     &inputmodel.CreateSubscriptionInputModel{URL: "https://example.com/hook", EventTypes: []string{"member.deleted"}, Secret: "my-secret-1234567"},
+			newSecret: func() (string, error) { return "", errors.New("should not be called") },
+			repoSetup: func(r *mock.MockWebhookPersistence) {
+				r.EXPECT().CreateSubscription(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, s *entity.Subscription) (*entity.Subscription, error) {
+					assert.Equal(t, "my-secret-1234567", s.Secret)
+					return s, nil
+				})
+			},
+		},
+		{
+			name:      "invalid url scheme",
+			input:     &inputmodel.CreateSubscriptionInputModel{URL: "ftp://example.com", EventTypes: []string{"member.deleted"}},
+			newSecret: func() (string, error) { return "whsec_generated", nil },
+			repoSetup: func(r *mock.MockWebhookPersistence) {},
+			wantErr:   ErrWebhookInvalidSubscription,
+		},
+		{
+			name:      "plain http rejected",
+			input:     &inputmodel.CreateSubscriptionInputModel{URL: "http://example.com/hook", EventTypes: []string{"member.deleted"}},
+			newSecret: func() (string, error) { return "whsec_generated", nil },
+			repoSetup: func(r *mock.MockWebhookPersistence) {},
+			wantErr:   entity.ErrInvalidURL,
+		},
+		{
+			name:      "loopback target rejected",
+			input:     &inputmodel.CreateSubscriptionInputModel{URL: "https://127.0.0.1:8443/hook", EventTypes: []string{"member.deleted"}},
+			newSecret: func() (string, error) { return "whsec_generated", nil },
+			repoSetup: func(r *mock.MockWebhookPersistence) {},
+			wantErr:   entity.ErrForbiddenTarget,
+		},
+		{
+			name:      "private target rejected",
+			input:     &inputmodel.CreateSubscriptionInputModel{URL: "https://10.0.0.5/hook", EventTypes: []string{"member.deleted"}},
+			newSecret: func() (string, error) { return "whsec_generated", nil },
+			repoSetup: func(r *mock.MockWebhookPersistence) {},
+			wantErr:   entity.ErrForbiddenTarget,
+		},
+		{
+			name:      "link-local metadata target rejected",
+			input:     &inputmodel.CreateSubscriptionInputModel{URL: "https://169.254.169.254/latest", EventTypes: []string{"member.deleted"}},
+			newSecret: func() (string, error) { return "whsec_generated", nil },
+			repoSetup: func(r *mock.MockWebhookPersistence) {},
+			wantErr:   entity.ErrForbiddenTarget,
+		},
+		{
+			name:      "localhost name rejected",
+			input:     &inputmodel.CreateSubscriptionInputModel{URL: "https://LocalHost./hook", EventTypes: []string{"member.deleted"}},
+			newSecret: func() (string, error) { return "whsec_generated", nil },
+			repoSetup: func(r *mock.MockWebhookPersistence) {},
+			wantErr:   entity.ErrForbiddenTarget,
+		},
+		{
+			name:      "ipv6 loopback rejected",
+			input:     &inputmodel.CreateSubscriptionInputModel{URL: "https://[::ffff:127.0.0.1]/hook", EventTypes: []string{"member.deleted"}},
+			newSecret: func() (string, error) { return "whsec_generated", nil },
+			repoSetup: func(r *mock.MockWebhookPersistence) {},
+			wantErr:   entity.ErrForbiddenTarget,
+		},
+		{
+			name:      "secret generate failed",
+			input:     &inputmodel.CreateSubscriptionInputModel{URL: "https://example.com/hook", EventTypes: []string{"member.deleted"}},
+			newSecret: func() (string, error) { return "", errors.New("entropy exhausted") },
+			repoSetup: func(r *mock.MockWebhookPersistence) {},
+			wantErr:   ErrWebhookSecretGenerateFailed,
+		},
+		{
+			name:      "db error",
+			input:     &inputmodel.CreateSubscriptionInputModel{URL: "https://example.com/hook", EventTypes: []string{"member.deleted"}},
+			newSecret: func() (string, error) { return "whsec_generated", nil },
+			repoSetup: func(r *mock.MockWebhookPersistence) {
+				r.EXPECT().CreateSubscription(ctx, gomock.Any()).Return(nil, ErrWebhookDBError)
+			},
+			wantErr: ErrWebhookDBError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockWebhookPersistence(ctrl)
+			w := &WebhookUseCase{
+				WebhookGateway: mockRepo,
+				policy:         DefaultRetryPolicy(),
+				admins:         map[string]struct{}{"admin": {}},
+				logger:         mockLogger,
+				tracer:         mockTracer,
+				now:            func() time.Time { return testTime },
+				newSecret:      tt.newSecret,
+			}
+			tt.repoSetup(mockRepo)
+			_, err := w.CreateSubscription(ctx, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("CreateSubscription() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWebhookUseCase_UpdateSubscription(t *testing.T) {
+	ctrl, ctx, testTime, mockLogger, mockTracer := repoHelper(t)
+	active := entity.SubscriptionStatusActive
+	disabled := entity.SubscriptionStatusDisabled
+	existing := func(status entity.SubscriptionStatus, failures int) *entity.Subscription {
+		return &entity.Subscription{ID: 1, URL: "https://example.com/hook", EventTypes: []string{"member.registered"}, Secret: "whsec_x", Status: status, ConsecutiveFailures: failures}
+	}
+	tests := []struct {
+		name      string
+		input     *inputmodel.UpdateSubscriptionInputModel
+		repoSetup func(*mock.MockWebhookPersistence)
+		wantErr   error
+	}{
+		{
+			name:  "reactivate resets failures",
+			input: &inputmodel.UpdateSubscriptionInputModel{ID: 1, Status: &active},
+			repoSetup: func(r *mock.MockWebhookPersistence) {
+				r.EXPECT().GetSubscriptionByID(ctx, 1).Return(existing(entity.SubscriptionStatusDisabled, 20), nil)
+				r.EXPECT().UpdateSubscription(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, s *entity.Subscription) error {
+					assert.Equal(t, entity.SubscriptionStatusActive, s.Status)
+					assert.Equal(t, 0, s.ConsecutiveFailures)
+					assert.Equal(t, testTime, s.UpdatedAt)
+					return nil
+				})
+			},
+		},
+		{
+			name:  "cannot set disabled manually",
+			input: &inputmodel.UpdateSubscriptionInputModel{ID: 1, Status: &disabled},
+			repoSetup: func(r *mock.MockWebhookPersistence) {
+				r.EXPECT().GetSubscriptionByID(ctx, 1).Return(existing(entity.SubscriptionStatusActive, 0), nil)
+			},
+			wantErr: ErrWebhookInvalidSubscription,
+		},
+		{
+			name:  "empty event types",
+			input: &inputmodel.UpdateSubscriptionInputModel{ID: 1, EventTypes: []string{}},
+			repoSetup: func(r *mock.MockWebhookPersistence) {
+				r.EXPECT().GetSubscriptionByID(ctx, 1).Return(existing(entity.SubscriptionStatusActive, 0), nil)
+			},
+			wantErr: ErrWebhookInvalidSubscription,
+		},
+		{
+			name:  "not found",
+			input: &inputmodel.UpdateSubscriptionInputModel{ID: 1},
+			repoSetup: func(r *mock.MockWebhookPersistence) {
+				r.EXPECT().GetSubscriptionByID(ctx, 1).Return(nil, ErrWebhookSubscriptionNotFound)
+			},
+			wantErr: ErrWebhookSubscriptionNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockWebhookPersistence(ctrl)
+			w := &WebhookUseCase{
+				WebhookGateway: mockRepo,
+				policy:         DefaultRetryPolicy(),
+				admins:         map[string]struct{}{"admin": {}},
+				logger:         mockLogger,
+				tracer:         mockTracer,
+				now:            func() time.Time { return testTime },
+			}
+			tt.repoSetup(mockRepo)
+			_, err := w.UpdateSubscription(ctx, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("UpdateSubscription() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWebhookUseCase_EnqueueEvent(t *testing.T) {
+	ctrl, ctx, testTime, mockLogger, mockTracer := repoHelper(t)
+	event := &inputmodel.WebhookEventInputModel{
+		EventID:    "evt-1",
+		EventType:  "member.registered",
+		OccurredAt: testTime,
+		Data:       []byte(`{"member_id":1}`),
+	}
+	tests := []struct {
+		name      string
+		repoSetup func(*mock.MockWebhookPersistence)
+		wantAdded int
+		wantErr   error
+	}{
+		{
+			name: "fan out to matching subscriptions",
+			repoSetup: func(r *mock.MockWebhookPersistence) {
+				r.EXPECT().GetSubscriptionsByStatus(ctx, []entity.SubscriptionStatus{entity.SubscriptionStatusActive, entity.SubscriptionStatusPaused}).Return([]*entity.Subscription{
+					{ID: 1, EventTypes: []string{"member.registered"}},
+					{ID: 2, EventTypes: []string{"member.deleted"}},
+					{ID: 3, EventTypes: []string{"member.deleted", "member.registered"}},
+				}, nil)
+				r.EXPECT().AddDeliveries(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, deliveries []*entity.Delivery) (int, error) {
+					assert.Len(t, deliveries, 2)
+					assert.Equal(t, 1, deliveries[0].SubscriptionID)
+					assert.Equal(t, 3, deliveries[1].SubscriptionID)
+					assert.JSONEq(t, `{"event_id":"evt-1","event_type":"member.registered","occurred_at":"2025-01-01T00:00:00Z","data":{"member_id":1}}`, string(deliveries[0].Payload))
+					assert.Equal(t, entity.DeliveryStatusPending, deliveries[0].Status)
+					assert.Equal(t, testTime, deliveries[0].NextAttemptAt)
+					return 2, nil
+				})
+			},
+			wantAdded: 2,
+		},
+		{
+			name: "no matching subscriptions",
+			repoSetup: func(r *mock.MockWebhookPersistence) {
+				r.EXPECT().GetSubscriptionsByStatus(ctx, gomock.Any()).Return([]*entity.Subscription{
+					{ID: 2, EventTypes: []string{"member.deleted"}},
+				}, nil)
+			},
+		},
+		{
+			name: "db error",
+			repoSetup: func(r *mock.MockWebhookPersistence) {
+				r.EXPECT().GetSubscriptionsByStatus(ctx, gomock.Any()).Return(nil, ErrWebhookDBError)
+			},
+			wantErr: ErrWebhookDBError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockWebhookPersistence(ctrl)
+			w := &WebhookUseCase{
+				WebhookGateway: mockRepo,
+				policy:         DefaultRetryPolicy(),
+				admins:         map[string]struct{}{"admin": {}},
+				logger:         mockLogger,
+				tracer:         mockTracer,
+				now:            func() time.Time { return testTime },
+			}
+			tt.repoSetup(mockRepo)
+			added, err := w.EnqueueEvent(ctx, event)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("EnqueueEvent() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			assert.Equal(t, tt.wantAdded, added)
+		})
+	}
+}
+
+func TestWebhookUseCase_DeliverPending(t *testing.T) {
+	ctrl, ctx, testTime, mockLogger, mockTracer := repoHelper(t)
+	policy := RetryPolicy{BatchSize: 10, MaxAttempts: 3, BaseBackoff: time.Second, MaxBackoff: time.Minute, DisableAfter: 2}
+	delivery := func(id, attempts int) *entity.Delivery {
+		return &entity.Delivery{ID: id, SubscriptionID: 1, EventID: "evt", EventType: "member.deleted", Payload: []byte(`{}`), Attempts: attempts}
+	}
+	subscription := func(failures int) *entity.Subscription {
+		return &entity.Subscription{ID: 1, URL: "https://example.com/hook", Secret: "whsec_x", Status: entity.SubscriptionStatusActive, ConsecutiveFailures: failures}
+	}
+	tests := []struct {
+		name          string
+		setup         func(*mock.MockWebhookPersistence, *mock.MockWebhookSender)
+		wantSucceeded int
+		wantErr       error
+	}{
+		{
+			name: "success signs request and resets failures",
+			setup: func(r *mock.MockWebhookPersistence, s *mock.MockWebhookSender) {
+				gomock.InOrder(
+					r.EXPECT().GetDueDeliveries(ctx, testTime, 10).Return([]*entity.Delivery{delivery(7, 0)}, nil),
+					r.EXPECT().GetSubscriptionByID(ctx, 1).Return(subscription(1), nil),
+					s.EXPECT().Send(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, req output.WebhookRequest) (*output.WebhookResponse, error) {
+						assert.Equal(t, "https://example.com/hook", req.URL)
+						assert.Equal(t, "7", req.Headers[HeaderDeliveryID])
+						ts, _ := strconv.ParseInt(req.Headers[HeaderTimestamp], 10, 64)
+						assert.True(t, VerifySignature("whsec_x", ts, req.Body, req.Headers[HeaderSignature]))
+						return &output.WebhookResponse{StatusCode: 204}, nil
+					}),
+					r.EXPECT().UpdateDeliveryResult(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, d *entity.Delivery) error {
+						assert.Equal(t, entity.DeliveryStatusSucceeded, d.Status)
+						assert.Equal(t, 1, d.Attempts)
+						assert.Equal(t, 204, d.ResponseStatus)
+						assert.NotNil(t, d.DeliveredAt)
+						return nil
+					}),
+					r.EXPECT().UpdateSubscriptionHealth(ctx, 1, 0, entity.SubscriptionStatusActive, testTime).Return(nil),
+				)
+			},
+			wantSucceeded: 1,
+		},
+		{
+			name: "non 2xx schedules retry with backoff",
+			setup: func(r *mock.MockWebhookPersistence, s *mock.MockWebhookSender) {
+				gomock.InOrder(
+					r.EXPECT().GetDueDeliveries(ctx, testTime, 10).Return([]*entity.Delivery{delivery(7, 1)}, nil),
+					r.EXPECT().GetSubscriptionByID(ctx, 1).Return(subscription(0), nil),
+					s.EXPECT().Send(ctx, gomock.Any()).Return(&output.WebhookResponse{StatusCode: 500, Body: "boom"}, nil),
+					r.EXPECT().UpdateDeliveryResult(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, d *entity.Delivery) error {
+						assert.Equal(t, entity.DeliveryStatusPending, d.Status)
+						assert.Equal(t, 2, d.Attempts)
+						assert.Equal(t, testTime.Add(2*time.Second), d.NextAttemptAt)
+						assert.Contains(t, d.LastError, "status 500 boom")
+						return nil
+					}),
+					r.EXPECT().UpdateSubscriptionHealth(ctx, 1, 1, entity.SubscriptionStatusActive, testTime).Return(nil),
+				)
+			},
+		},
+		{
+			name: "exhausted attempts marks failed and disables subscription",
+			setup: func(r *mock.MockWebhookPersistence, s *mock.MockWebhookSender) {
+				gomock.InOrder(
+					r.EXPECT().GetDueDeliveries(ctx, testTime, 10).Return([]*entity.Delivery{delivery(7, 2), delivery(8, 0)}, nil),
+					r.EXPECT().GetSubscriptionByID(ctx, 1).Return(subscription(1), nil),
+					s.EXPECT().Send(ctx, gomock.Any()).Return(nil, errors.New("connection refused")),
+					r.EXPECT().UpdateDeliveryResult(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, d *entity.Delivery) error {
+						assert.Equal(t, entity.DeliveryStatusFailed, d.Status)
+						assert.Equal(t, 0, d.ResponseStatus)
+						return nil
+					}),
+					r.EXPECT().UpdateSubscriptionHealth(ctx, 1, 2, entity.SubscriptionStatusDisabled, testTime).Return(nil),
+					// 訂閱已停用，同批次的第二筆不再送出
+				)
+			},
+		},
+		{
+			name: "query error",
+			setup: func(r *mock.MockWebhookPersistence, s *mock.MockWebhookSender) {
+				r.EXPECT().GetDueDeliveries(ctx, testTime, 10).Return(nil, ErrWebhookDBError)
+			},
+			wantErr: ErrWebhookDBError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockWebhookPersistence(ctrl)
+			mockSender := mock.NewMockWebhookSender(ctrl)
+			w := &WebhookUseCase{
+				WebhookGateway: mockRepo,
+				sender:         mockSender,
+				policy:         policy,
+				logger:         mockLogger,
+				tracer:         mockTracer,
+				now:            func() time.Time { return testTime },
+			}
+			tt.setup(mockRepo, mockSender)
+			succeeded, err := w.DeliverPending(ctx)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("DeliverPending() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			assert.Equal(t, tt.wantSucceeded, succeeded)
+		})
+	}
+}
+
+func TestWebhookUseCase_Redeliver(t *testing.T) {
+	ctrl, ctx, testTime, mockLogger, mockTracer := repoHelper(t)
+	original := &entity.Delivery{ID: 7, SubscriptionID: 1, EventID: "evt", EventType: "member.deleted", Payload: []byte(`{"a":1}`), Status: entity.DeliveryStatusFailed, Attempts: 8}
+	tests := []struct {
+		name      string
+		input     *inputmodel.RedeliverInputModel
+		repoSetup func(*mock.MockWebhookPersistence)
+		wantErr   error
+	}{
+		{
+			name:  "normal test creates new pending delivery",
+			input: &inputmodel.RedeliverInputModel{SubscriptionID: 1, DeliveryID: 7},
+			repoSetup: func(r *mock.MockWebhookPersistence) {
+				r.EXPECT().GetDeliveryByID(ctx, 7).Return(original, nil)
+				r.EXPECT().CreateDelivery(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, d *entity.Delivery) (*entity.Delivery, error) {
+					assert.Equal(t, entity.DeliveryStatusPending, d.Status)
+					assert.Equal(t, 0, d.Attempts)
+					assert.Equal(t, original.Payload, d.Payload)
+					assert.Equal(t, 7, *d.RedeliveredFrom)
+					assert.Equal(t, testTime, d.NextAttemptAt)
+					d.ID = 9
+					return d, nil
+				})
+			},
+		},
+		{
+			name:  "delivery belongs to another subscription",
+			input: &inputmodel.RedeliverInputModel{SubscriptionID: 2, DeliveryID: 7},
+			repoSetup: func(r *mock.MockWebhookPersistence) {
+				r.EXPECT().GetDeliveryByID(ctx, 7).Return(original, nil)
+			},
+			wantErr: ErrWebhookDeliveryNotFound,
+		},
+		{
+			name:  "delivery not found",
+			input: &inputmodel.RedeliverInputModel{SubscriptionID: 1, DeliveryID: 99},
+			repoSetup: func(r *mock.MockWebhookPersistence) {
+				r.EXPECT().GetDeliveryByID(ctx, 99).Return(nil, ErrWebhookDeliveryNotFound)
+			},
+			wantErr: ErrWebhookDeliveryNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockWebhookPersistence(ctrl)
+			w := &WebhookUseCase{
+				WebhookGateway: mockRepo,
+				policy:         DefaultRetryPolicy(),
+				admins:         map[string]struct{}{"admin": {}},
+				logger:         mockLogger,
+				tracer:         mockTracer,
+				now:            func() time.Time { return testTime },
+			}
+			tt.repoSetup(mockRepo)
+			_, err := w.Redeliver(ctx, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Redeliver() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWebhookUseCase_RedactDeliveries(t *testing.T) {
+	ctrl, ctx, _, mockLogger, mockTracer := repoHelper(t)
+	fields := []string{"name", "email"}
+	firstPage := pagination.Pagination{Limit: redactPageSize, Offset: 0}
+	newDeliveries := func() []*entity.Delivery {
+		return []*entity.Delivery{
+			{ID: 1, SubscriptionID: 1, EventID: "evt-1", EventType: "member.registered",
+				Payload: []byte(`{"event_id":"evt-1","data":{"member_id":1,"name":"tom","email":"tom@gmail.com"}}`)},
+			{ID: 2, SubscriptionID: 1, EventID: "evt-2", EventType: "member.deleted",
+				Payload: []byte(`{"event_id":"evt-2","data":{"member_id":1}}`)},
+		}
+	}
+	tests := []struct {
+		name      string
+		input     *inputmodel.RedactDeliveriesInputModel
+		repoSetup func(*mock.MockWebhookPersistence)
+		want      int
+		wantErr   error
+	}{
+		{
+			name:  "normal test rewrites nested event data",
+			input: &inputmodel.RedactDeliveriesInputModel{EventIDs: []string{"evt-1", "evt-2"}, Fields: fields},
+			repoSetup: func(r *mock.MockWebhookPersistence) {
+				r.EXPECT().GetDeliveriesByEventIDs(ctx, []string{"evt-1", "evt-2"}, firstPage).Return(newDeliveries(), nil)
+				r.EXPECT().ReplaceDeliveryPayload(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, d *entity.Delivery) error {
+					assert.Equal(t, 1, d.ID)
+					assert.JSONEq(t, `{"event_id":"evt-1","data":{"member_id":1,"name":"[redacted]","email":"[redacted]"}}`, string(d.Payload))
+					return nil
+				})
+			},
+			want: 1,
+		},
+		{
+			name:      "no events is no-op",
+			input:     &inputmodel.RedactDeliveriesInputModel{Fields: fields},
+			repoSetup: func(r *mock.MockWebhookPersistence) {},
+		},
+		{
+			name:      "missing fields",
+			input:     &inputmodel.RedactDeliveriesInputModel{EventIDs: []string{"evt-1"}},
+			repoSetup: func(r *mock.MockWebhookPersistence) {},
+			wantErr:   ErrWebhookInvalidRedaction,
+		},
+		{
+			name:  "replace payload error",
+			input: &inputmodel.RedactDeliveriesInputModel{EventIDs: []string{"evt-1"}, Fields: fields},
+			repoSetup: func(r *mock.MockWebhookPersistence) {
+				r.EXPECT().GetDeliveriesByEventIDs(ctx, []string{"evt-1"}, firstPage).Return(newDeliveries()[:1], nil)
+				r.EXPECT().ReplaceDeliveryPayload(ctx, gomock.Any()).Return(ErrWebhookDBError)
+			},
+			wantErr: ErrWebhookDBError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mock.NewMockWebhookPersistence(ctrl)
+			// 不設定管理者名單：遮蔽由其他模組在個資刪除時呼叫，不經過管理者授權
+			w := &WebhookUseCase{
+				WebhookGateway: mockRepo,
+				policy:         DefaultRetryPolicy(),
+				logger:         mockLogger,
+				tracer:         mockTracer,
+			}
+			tt.repoSetup(mockRepo)
+			got, err := w.RedactDeliveries(ctx, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("RedactDeliveries() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestWebhookUseCase_RequiresAdmin(t *testing.T) {
+	ctrl, _, _, mockLogger, _ := repoHelper(t)
+	// repoHelper 的 tracer 固定回傳管理者 context，這裡改為沿用呼叫端的 context
+	mockTracer := mocktracer.NewMockTracer(ctrl)
+	mockSpan := mocktracer.NewMockSpan(ctrl)
+	mockSpan.EXPECT().End().AnyTimes()
+	mockTracer.EXPECT().Start(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, _ string) (context.Context, tracer.Span) {
+		return ctx, mockSpan
+	}).AnyTimes()
+	operations := map[string]func(*WebhookUseCase, context.Context) error{
+		"create": func(w *WebhookUseCase, ctx context.Context) error {
+			_, err := w.CreateSubscription(ctx, &inputmodel.CreateSubscriptionInputModel{URL: "https://example.com/hook", EventTypes: []string{"member.deleted"}})
+			return err
+		},
+		"get": func(w *WebhookUseCase, ctx context.Context) error {
+			_, err := w.GetSubscription(ctx, 1)
+			return err
+		},
+		"list": func(w *WebhookUseCase, ctx context.Context) error {
+			_, _, err := w.ListSubscriptions(ctx, pagination.Pagination{Limit: 10})
+			return err
+		},
+		"update": func(w *WebhookUseCase, ctx context.Context) error {
+			_, err := w.UpdateSubscription(ctx, &inputmodel.UpdateSubscriptionInputModel{ID: 1})
+			return err
+		},
+		"delete": func(w *WebhookUseCase, ctx context.Context) error {
+			_, err := w.DeleteSubscription(ctx, 1)
+			return err
+		},
+		"list deliveries": func(w *WebhookUseCase, ctx context.Context) error {
+			_, _, err := w.ListDeliveries(ctx, &inputmodel.ListDeliveriesInputModel{SubscriptionID: 1}, pagination.Pagination{Limit: 10})
+			return err
+		},
+		"redeliver": func(w *WebhookUseCase, ctx context.Context) error {
+			_, err := w.Redeliver(ctx, &inputmodel.RedeliverInputModel{SubscriptionID: 1, DeliveryID: 7})
+			return err
+		},
+	}
+	for _, actor := range []string{requestmeta.AnonymousActor, "42"} {
+		for name, operation := range operations {
+			t.Run(actor+" "+name, func(t *testing.T) {
+				// gateway 不應被呼叫
+				w := NewWebhookUseCase(mock.NewMockWebhookPersistence(ctrl), nil, DefaultRetryPolicy(), []string{"admin", requestmeta.AnonymousActor}, mockLogger, mockTracer).(*WebhookUseCase)
+				ctx := requestmeta.WithMeta(context.Background(), requestmeta.Meta{Actor: actor})
+				assert.ErrorIs(t, operation(w, ctx), ErrWebhookForbidden)
+			})
+		}
+	}
+}
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"event_id":"evt"}`)
+	signature := Sign("secret", 1700000000, body)
+	assert.True(t, VerifySignature("secret", 1700000000, body, signature))
+	assert.False(t, VerifySignature("other", 1700000000, body, signature))
+	assert.False(t, VerifySignature("secret", 1700000001, body, signature))
+	assert.False(t, VerifySignature("secret", 1700000000, body, "deadbeef"))
+}
+
+func repoHelper(t *testing.T) (*gomock.Controller, context.Context, time.Time, *mocklogger.MockLogger, *mocktracer.MockTracer) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+	ctx := requestmeta.WithMeta(context.Background(), requestmeta.Meta{Actor: "admin"})
+	testTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockLogger := mocklogger.NewMockLogger(ctrl)
+	mockTracer := mocktracer.NewMockTracer(ctrl)
+
+	// 設置基本的 mock 行為
+	mockLogger.EXPECT().With(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	mockSpan := mocktracer.NewMockSpan(ctrl)
+	mockSpan.EXPECT().End().AnyTimes()
+	mockTracer.EXPECT().Start(gomock.Any(), gomock.Any()).Return(ctx, mockSpan).AnyTimes()
+
+	return ctrl, ctx, testTime, mockLogger, mockTracer
+}
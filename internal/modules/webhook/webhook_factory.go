@@ -0,0 +1,72 @@
+package webhook
+
+import (
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/tomoffice/go-clean-architecture/pkg/logger"
+	"github.com/tomoffice/go-clean-architecture/pkg/tracer"
+	"time"
+
+	"github.com/tomoffice/go-clean-architecture/internal/framework/database/sqlx/sqlxdriver"
+	"github.com/tomoffice/go-clean-architecture/internal/modules"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/framework/dispatcher"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/framework/persistence/sqlx/mcsqlite"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/framework/persistence/sqlx/pgsql"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/framework/sender"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/interface_adapter/controller"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/interface_adapter/dao"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/interface_adapter/gateway/repository"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/interface_adapter/presenter/http"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/interface_adapter/router"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/interface_adapter/validation"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/usecase"
+)
+
+// Factory webhook 模組工廠
+type Factory struct {
+	policy       usecase.RetryPolicy
+	pollInterval time.Duration
+	timeout      time.Duration
+	admins       []string
+}
+
+// NewModuleFactory 創建 webhook 模組工廠
+//   - timeout 單次投遞的 HTTP 逾時
+//   - admins 可管理訂閱與投遞紀錄的 actor（auth subject），空值表示所有管理 API 都被拒絕
+func NewModuleFactory(policy usecase.RetryPolicy, pollInterval time.Duration, timeout time.Duration, admins []string) modules.ModuleFactory {
+	return &Factory{
+		policy:       policy,
+		pollInterval: pollInterval,
+		timeout:      timeout,
+		admins:       admins,
+	}
+}
+
+// CreateModule 創建 webhook 模組，注入 logger 和 tracer 到需要的組件中
+func (f *Factory) CreateModule(db *sqlx.DB, rg *gin.RouterGroup, log logger.Logger, tracer tracer.Tracer) (modules.Module, error) {
+	// 創建帶有模組標識的子 logger
+	moduleLogger := log.With(logger.NewField("module", "webhook"))
+
+	// 組裝所有組件
+	validator := validation.NewWebhookValidator()
+	var repo dao.WebhookDAO
+	switch sqlxdriver.Driver(db.DriverName()) {
+	case sqlxdriver.DriverSQLite:
+		repo = mcsqlite.NewSqlxWebhookSqlite(db, moduleLogger, tracer)
+	case sqlxdriver.DriverPostgres:
+		repo = pgsql.NewSqlxWebhookPgsql(db, moduleLogger, tracer)
+	default:
+		return nil, fmt.Errorf("webhook: unsupported database driver %q", db.DriverName())
+	}
+	gateway := repository.NewWebhookRepoGateway(repo, moduleLogger, tracer)
+	webhookSender := sender.NewHTTPSender(f.timeout)
+	useCase := usecase.NewWebhookUseCase(gateway, webhookSender, f.policy, f.admins, moduleLogger, tracer)
+	presenter := http.NewWebhookPresenter()
+	controller := controller.NewWebhookController(useCase, presenter, validator, moduleLogger, tracer)
+	router := router.NewWebhookRouter(controller, rg)
+	deliveryDispatcher := dispatcher.NewDispatcher(useCase, f.pollInterval, moduleLogger)
+
+	// 創建並返回模組實例
+	return NewModule(router, useCase, deliveryDispatcher), nil
+}
@@ -0,0 +1,50 @@
+package webhook
+
+import (
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/framework/dispatcher"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/interface_adapter/router"
+	"github.com/tomoffice/go-clean-architecture/internal/modules/webhook/usecase/port/input"
+)
+
+// Module webhook 模組 - 具體產品
+type Module struct {
+	router     *router.WebhookRouter
+	useCase    input.WebhookInputPort
+	dispatcher *dispatcher.Dispatcher
+}
+
+// NewModule 創建 webhook 模組實例
+func NewModule(router *router.WebhookRouter, useCase input.WebhookInputPort, dispatcher *dispatcher.Dispatcher) *Module {
+	return &Module{
+		router:     router,
+		useCase:    useCase,
+		dispatcher: dispatcher,
+	}
+}
+
+// Name 實現 Module 接口
+func (m *Module) Name() string {
+	return "webhook"
+}
+
+// Setup 實現 Module 接口
+func (m *Module) Setup() error {
+	// 純粹的委派，不做任何組裝邏輯
+	return m.router.Register()
+}
+
+// Shutdown 實現 Module 接口
+func (m *Module) Shutdown() error {
+	// dispatcher 由啟動它的 context 控制停止
+	return nil
+}
+
+// InputPort 提供事件來源寫入投遞紀錄用的 input port
+func (m *Module) InputPort() input.WebhookInputPort {
+	return m.useCase
+}
+
+// Dispatcher 背景投遞 webhook 的 dispatcher，由 bootstrap 決定是否啟動
+func (m *Module) Dispatcher() *dispatcher.Dispatcher {
+	return m.dispatcher
+}
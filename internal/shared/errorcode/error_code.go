@@ -15,16 +15,76 @@ const (
 
 // UseCase 層相關業務錯誤
 const (
-	ErrMemberNotFound               = 3000 // 會員不存在
-	ErrMemberAlreadyExists          = 3001 // 會員已存在
-	ErrMemberNoEffect               = 3002 // 更新/刪除無影響
-	ErrMemberDBError                = 3003 // DB 錯誤
-	ErrMemberGatewayError           = 3005 // Gateway 層錯誤
-	ErrUnexpectedMemberUseCaseError = 3006 // 非預期 UseCase 錯誤
-	ErrMemberUpdateSameEmail        = 3007 // 嘗試更新為同一 Email
-	ErrMemberEmailAlreadyExists     = 3008 // Email 已被佔用
-	ErrMemberPasswordIncorrect      = 3010 // 密碼錯誤
-	ErrMemberUpdateSamePassword     = 3009 // 嘗試更新為同一密碼
+	ErrMemberNotFound                = 3000 // 會員不存在
+	ErrMemberAlreadyExists           = 3001 // 會員已存在
+	ErrMemberNoEffect                = 3002 // 更新/刪除無影響
+	ErrMemberDBError                 = 3003 // DB 錯誤
+	ErrMemberGatewayError            = 3005 // Gateway 層錯誤
+	ErrUnexpectedMemberUseCaseError  = 3006 // 非預期 UseCase 錯誤
+	ErrMemberUpdateSameEmail         = 3007 // 嘗試更新為同一 Email
+	ErrMemberEmailAlreadyExists      = 3008 // Email 已被佔用
+	ErrMemberPasswordIncorrect       = 3010 // 密碼錯誤
+	ErrMemberUpdateSamePassword      = 3009 // 嘗試更新為同一密碼
+	ErrMemberChangeStreamUnavailable = 3011 // 會員異動串流不可用
+	ErrMemberPrivacyForbidden        = 3012 // 無權存取或刪除會員個資
+	ErrMemberAuditTrailError         = 3013 // 會員稽核紀錄讀寫失敗
+	ErrMemberInvalidEmail            = 3014 // Email 無法正規化
+	ErrMemberEmailPolicyViolation    = 3015 // Email 網域違反政策
+	ErrMemberPasswordPolicyViolation = 3016 // 密碼違反密碼政策
+	ErrMemberInvalidName             = 3017 // 會員名稱不符合規則
+	ErrMemberStatusTransition        = 3018 // 會員狀態不允許此轉換
+	ErrMemberStatusReasonRequired    = 3019 // 停權或封鎖缺少原因
+	ErrMemberStatusForbidden         = 3020 // 無權變更會員狀態
+	ErrMemberNotActive               = 3021 // 會員帳號不是 active
+	ErrMemberMerged                  = 3022 // 會員已被合併到其他會員
+	ErrMemberInvalidMerge            = 3023 // 會員不能合併到自己
+	ErrMemberMergeForbidden          = 3024 // 無權合併會員
+	ErrInvitationNotFound            = 3025 // 查無邀請碼
+	ErrInvitationAlreadyExists       = 3026 // 邀請碼重複
+	ErrInvitationRequired            = 3027 // 僅限邀請註冊但未提供邀請碼
+	ErrInvitationInvalid             = 3028 // 邀請碼不可使用
+	ErrInvitationForbidden           = 3029 // 無權建立、查看或撤銷邀請碼
+	ErrMemberInvalidTag              = 3030 // 會員標籤格式錯誤
+	ErrMemberTagForbidden            = 3031 // 無權管理標籤與分群
+	ErrSegmentNotFound               = 3032 // 查無分群
+	ErrSegmentAlreadyExists          = 3033 // 分群名稱重複
+	ErrSegmentInvalid                = 3034 // 分群名稱或條件不合法
+	ErrMemberInvalidPreference       = 3035 // 偏好設定 key 不存在或值不符合 schema
+	ErrMemberConcurrentUpdate        = 3036 // 並行交易衝突，可重試
+	ErrMemberReferenceConflict       = 3037 // 違反外鍵約束
+	ErrMemberConstraintViolation     = 3038 // 違反 NOT NULL 或 CHECK 約束
+	ErrMemberStorageUnavailable      = 3039 // 資料庫被鎖住、唯讀或空間已滿
+	ErrMemberInvalidCredentials      = 3040 // Email 或密碼錯誤
+	ErrMemberTokenUnavailable        = 3041 // 未設定 token 簽發器
+)
+
+// Audit UseCase 層相關業務錯誤
+const (
+	ErrAuditDBError                = 3100 // DB 錯誤
+	ErrAuditMappingError           = 3101 // 資料轉換錯誤
+	ErrUnexpectedAuditUseCaseError = 3102 // 非預期 UseCase 錯誤
+	ErrAuditInvalidEntry           = 3103 // 稽核紀錄欄位不完整
+	ErrAuditInvalidTimeRange       = 3104 // 查詢區間錯誤
+	ErrAuditInvalidRedaction       = 3105 // 個資遮蔽條件不完整
+)
+
+// Outbox UseCase 層相關業務錯誤
+const (
+	ErrOutboxDBError                = 3200 // DB 錯誤
+	ErrOutboxMappingError           = 3201 // 資料轉換錯誤
+	ErrUnexpectedOutboxUseCaseError = 3202 // 非預期 UseCase 錯誤
+	ErrOutboxInvalidEvent           = 3203 // 事件欄位不完整
+)
+
+// Webhook UseCase 層相關業務錯誤
+const (
+	ErrWebhookDBError                = 3300 // DB 錯誤
+	ErrWebhookMappingError           = 3301 // 資料轉換錯誤
+	ErrUnexpectedWebhookUseCaseError = 3302 // 非預期 UseCase 錯誤
+	ErrWebhookSubscriptionNotFound   = 3303 // 訂閱不存在
+	ErrWebhookDeliveryNotFound       = 3304 // 投遞紀錄不存在
+	ErrWebhookInvalidSubscription    = 3305 // 訂閱欄位不合法
+	ErrWebhookForbidden              = 3306 // 呼叫者不是 webhook 管理者
 )
 
 // 系統錯誤
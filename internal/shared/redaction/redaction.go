@@ -0,0 +1,66 @@
+// Package redaction 提供個資遮蔽共用的工具，讓各模組以相同的遮蔽值改寫已保存的 JSON 內容
+// （例如 outbox 事件與 webhook 投遞紀錄的 payload）。
+package redaction
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Value 遮蔽後的值，與稽核紀錄 changes 的遮蔽值一致
+const Value = "[redacted]"
+
+// JSONFields 將 payload 中所有名稱在 fields 內的欄位值改為 Value，巢狀物件與陣列一併處理；
+// null 維持 null，已遮蔽過的欄位不再改寫。回傳改寫後的內容與是否有任何值被改寫，
+// 沒有改寫時原樣回傳 payload
+func JSONFields(payload []byte, fields ...string) ([]byte, bool, error) {
+	if len(payload) == 0 || len(fields) == 0 {
+		return payload, false, nil
+	}
+	targets := make(map[string]struct{}, len(fields))
+	for _, field := range fields {
+		targets[field] = struct{}{}
+	}
+
+	// UseNumber 讓數字原樣寫回，不因轉成 float64 失真
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	var doc any
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, false, err
+	}
+	if !redactValue(doc, targets) {
+		return payload, false, nil
+	}
+	redacted, err := json.Marshal(doc)
+	if err != nil {
+		return nil, false, err
+	}
+	return redacted, true, nil
+}
+
+func redactValue(value any, targets map[string]struct{}) bool {
+	changed := false
+	switch v := value.(type) {
+	case map[string]any:
+		for key, child := range v {
+			if _, ok := targets[key]; ok {
+				if child != nil && child != Value {
+					v[key] = Value
+					changed = true
+				}
+				continue
+			}
+			if redactValue(child, targets) {
+				changed = true
+			}
+		}
+	case []any:
+		for _, child := range v {
+			if redactValue(child, targets) {
+				changed = true
+			}
+		}
+	}
+	return changed
+}
@@ -0,0 +1,38 @@
+// Package requestmeta 保存單一請求的呼叫端資訊（actor、request id、trace id、IP），
+// 由 HTTP middleware 寫入 context，讓內層不需依賴 HTTP 框架即可取得。
+package requestmeta
+
+import "context"
+
+// AnonymousActor 未通過認證（或沒有 claims）時使用的 actor 名稱
+const AnonymousActor = "anonymous"
+
+// Meta 單一請求的呼叫端資訊
+//   - Actor : 發起請求者，來自 auth claims 的 subject，沒有則為 anonymous
+//   - RequestID : 請求識別碼（X-Request-Id）
+//   - TraceID : 追蹤識別碼
+//   - IP : 用戶端 IP
+//   - UserAgent : 用戶端 User-Agent
+type Meta struct {
+	Actor     string
+	RequestID string
+	TraceID   string
+	IP        string
+	UserAgent string
+}
+
+type metaKey struct{}
+
+// WithMeta 將 Meta 放入 context
+func WithMeta(ctx context.Context, meta Meta) context.Context {
+	return context.WithValue(ctx, metaKey{}, meta)
+}
+
+// FromContext 從 context 取出 Meta，沒有的話回傳只帶 anonymous actor 的 Meta
+func FromContext(ctx context.Context) Meta {
+	meta, _ := ctx.Value(metaKey{}).(Meta)
+	if meta.Actor == "" {
+		meta.Actor = AnonymousActor
+	}
+	return meta
+}
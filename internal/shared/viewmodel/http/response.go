@@ -29,6 +29,8 @@ type HTTPResponse[T any] struct {
 type ErrorPayload struct {
 	Code    string `json:"code"`
 	Message string `json:"message"`
+	// Details 同一錯誤的多個細項，例如違反的所有密碼規則
+	Details []string `json:"details,omitempty"`
 }
 
 type MetaPayload struct {
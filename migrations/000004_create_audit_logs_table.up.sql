@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS audit_logs
+(
+    id          INTEGER PRIMARY KEY AUTOINCREMENT,
+    actor       TEXT     NOT NULL,
+    action      TEXT     NOT NULL,
+    target_type TEXT     NOT NULL,
+    target_id   TEXT     NOT NULL,
+    changes     TEXT     NOT NULL DEFAULT '{}',
+    request_id  TEXT     NOT NULL DEFAULT '',
+    trace_id    TEXT     NOT NULL DEFAULT '',
+    ip          TEXT     NOT NULL DEFAULT '',
+    created_at  DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_target ON audit_logs (target_type, target_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_action ON audit_logs (action, created_at);
+
+/* 稽核紀錄只能新增，禁止更新與刪除 */
+CREATE TRIGGER IF NOT EXISTS audit_logs_no_update
+    BEFORE UPDATE
+    ON audit_logs
+BEGIN
+    SELECT RAISE(ABORT, 'audit_logs is append-only');
+END;
+CREATE TRIGGER IF NOT EXISTS audit_logs_no_delete
+    BEFORE DELETE
+    ON audit_logs
+BEGIN
+    SELECT RAISE(ABORT, 'audit_logs is append-only');
+END;
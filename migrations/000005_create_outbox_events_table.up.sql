@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS outbox_events (
+    id              INTEGER PRIMARY KEY AUTOINCREMENT,
+    event_id        TEXT     NOT NULL UNIQUE,
+    event_type      TEXT     NOT NULL,
+    aggregate_type  TEXT     NOT NULL,
+    aggregate_id    TEXT     NOT NULL,
+    payload         TEXT     NOT NULL DEFAULT '{}',
+    status          TEXT     NOT NULL DEFAULT 'pending',
+    attempts        INTEGER  NOT NULL DEFAULT 0,
+    next_attempt_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_error      TEXT     NOT NULL DEFAULT '',
+    created_at      DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    published_at    DATETIME
+);
+
+-- dispatcher 依狀態與下次嘗試時間撈取待發佈事件
+CREATE INDEX IF NOT EXISTS idx_outbox_events_due ON outbox_events (status, next_attempt_at, id);
@@ -0,0 +1,35 @@
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    id                   INTEGER PRIMARY KEY AUTOINCREMENT,
+    url                  TEXT     NOT NULL,
+    event_types          TEXT     NOT NULL DEFAULT '[]',
+    secret               TEXT     NOT NULL,
+    status               TEXT     NOT NULL DEFAULT 'active',
+    consecutive_failures INTEGER  NOT NULL DEFAULT 0,
+    created_at           DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at           DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id               INTEGER PRIMARY KEY AUTOINCREMENT,
+    subscription_id  INTEGER  NOT NULL REFERENCES webhook_subscriptions (id) ON DELETE CASCADE,
+    event_id         TEXT     NOT NULL,
+    event_type       TEXT     NOT NULL,
+    payload          TEXT     NOT NULL DEFAULT '{}',
+    status           TEXT     NOT NULL DEFAULT 'pending',
+    attempts         INTEGER  NOT NULL DEFAULT 0,
+    next_attempt_at  DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    response_status  INTEGER  NOT NULL DEFAULT 0,
+    last_error       TEXT     NOT NULL DEFAULT '',
+    duration_ms      INTEGER  NOT NULL DEFAULT 0,
+    redelivered_from INTEGER,
+    created_at       DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    delivered_at     DATETIME
+);
+
+-- 同一事件對同一訂閱只建立一筆投遞（outbox 重送時不重複），手動重送不受限制
+CREATE UNIQUE INDEX IF NOT EXISTS idx_webhook_deliveries_event
+    ON webhook_deliveries (subscription_id, event_id) WHERE redelivered_from IS NULL;
+-- dispatcher 依狀態與下次嘗試時間撈取待投遞紀錄
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due ON webhook_deliveries (status, next_attempt_at, id);
+-- 投遞紀錄查詢
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_subscription ON webhook_deliveries (subscription_id, id);
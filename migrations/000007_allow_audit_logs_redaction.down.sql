@@ -0,0 +1,7 @@
+DROP TRIGGER IF EXISTS audit_logs_no_update;
+CREATE TRIGGER IF NOT EXISTS audit_logs_no_update
+    BEFORE UPDATE
+    ON audit_logs
+BEGIN
+    SELECT RAISE(ABORT, 'audit_logs is append-only');
+END;
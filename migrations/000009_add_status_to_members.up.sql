@@ -0,0 +1,11 @@
+-- status 會員帳號狀態：pending 尚未啟用、active 正常、suspended 停權、banned 封鎖；
+-- 既有會員一律視為 active，狀態轉換規則由應用程式的狀態機維護
+ALTER TABLE members
+    ADD COLUMN status TEXT NOT NULL DEFAULT 'active'
+        CHECK (status IN ('pending', 'active', 'suspended', 'banned'));
+
+-- status_reason 最近一次狀態轉換的原因，完整歷程見稽核紀錄
+ALTER TABLE members
+    ADD COLUMN status_reason TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_members_status ON members (status);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_members_merged_into;
+
+ALTER TABLE members
+DROP COLUMN merged_into;
@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS member_invitations (
+    id          INTEGER PRIMARY KEY AUTOINCREMENT,
+    code        TEXT     NOT NULL UNIQUE,
+    created_by  TEXT     NOT NULL,
+    referrer_id INTEGER REFERENCES members (id),
+    -- email 鎖定可使用的正規化 Email，空字串表示不限
+    email       TEXT     NOT NULL DEFAULT '',
+    max_uses    INTEGER  NOT NULL DEFAULT 1,
+    uses        INTEGER  NOT NULL DEFAULT 0,
+    expires_at  DATETIME,
+    revoked_at  DATETIME,
+    created_at  DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (uses <= max_uses)
+);
+
+-- 會員列出自己建立的邀請碼
+CREATE INDEX IF NOT EXISTS idx_member_invitations_created_by ON member_invitations (created_by, id);
+
+-- referred_by 以邀請碼註冊時的推薦人（邀請碼建立者）
+ALTER TABLE members
+    ADD COLUMN referred_by INTEGER REFERENCES members (id);
+
+CREATE INDEX IF NOT EXISTS idx_members_referred_by ON members (referred_by);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS member_segments;
+
+DROP INDEX IF EXISTS idx_member_tags_tag_id;
+DROP TABLE IF EXISTS member_tags;
+DROP TABLE IF EXISTS tags;
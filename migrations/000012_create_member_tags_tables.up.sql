@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS tags (
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    -- name 正規化後的標籤，見 entity.NormalizeTag
+    name       TEXT     NOT NULL UNIQUE,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS member_tags (
+    member_id  INTEGER  NOT NULL REFERENCES members (id) ON DELETE CASCADE,
+    tag_id     INTEGER  NOT NULL REFERENCES tags (id) ON DELETE CASCADE,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (member_id, tag_id)
+);
+
+-- 依標籤篩選會員
+CREATE INDEX IF NOT EXISTS idx_member_tags_tag_id ON member_tags (tag_id, member_id);
+
+CREATE TABLE IF NOT EXISTS member_segments (
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    name       TEXT     NOT NULL UNIQUE,
+    -- filter 分群條件，JSON 格式，見 entity.SegmentFilter
+    filter     TEXT     NOT NULL,
+    created_by TEXT     NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS member_preferences (
+    member_id  INTEGER  NOT NULL REFERENCES members (id) ON DELETE CASCADE,
+    -- pref_key 偏好設定名稱，見 entity.PreferenceRegistry
+    pref_key   TEXT     NOT NULL,
+    -- value 偏好設定值，JSON 格式
+    value      TEXT     NOT NULL,
+    -- version 從 1 開始，值有變更時加一
+    version    INTEGER  NOT NULL DEFAULT 1,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (member_id, pref_key)
+);
@@ -0,0 +1,2 @@
+ALTER TABLE members
+DROP COLUMN updated_at;
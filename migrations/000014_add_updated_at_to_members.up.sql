@@ -0,0 +1,9 @@
+-- updated_at 最近一次更新的時間（UTC），應用程式在每次 UPDATE 時一併改寫；
+-- SQLite 的 ALTER TABLE 不能加上 CURRENT_TIMESTAMP 這類非常數預設值，因此允許 NULL，
+-- 新增會員時由應用程式寫入，既有資料以 created_at 回填，讀取時 NULL 視同 created_at
+ALTER TABLE members
+    ADD COLUMN updated_at DATETIME;
+
+UPDATE members
+SET updated_at = created_at
+WHERE updated_at IS NULL;
@@ -0,0 +1,17 @@
+/* 個資刪除時來源 IP 同屬個資，放寬 update trigger：允許改寫 changes 與 ip 欄位，
+   其餘欄位與 DELETE 仍然禁止 */
+DROP TRIGGER IF EXISTS audit_logs_no_update;
+CREATE TRIGGER IF NOT EXISTS audit_logs_no_update
+    BEFORE UPDATE
+    ON audit_logs
+    WHEN NEW.id IS NOT OLD.id
+        OR NEW.actor IS NOT OLD.actor
+        OR NEW.action IS NOT OLD.action
+        OR NEW.target_type IS NOT OLD.target_type
+        OR NEW.target_id IS NOT OLD.target_id
+        OR NEW.request_id IS NOT OLD.request_id
+        OR NEW.trace_id IS NOT OLD.trace_id
+        OR NEW.created_at IS NOT OLD.created_at
+BEGIN
+    SELECT RAISE(ABORT, 'audit_logs is append-only');
+END;
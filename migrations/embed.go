@@ -0,0 +1,28 @@
+// Package migrations 以 embed.FS 內嵌資料庫遷移腳本，執行檔不需要另外帶著 migrations 目錄
+package migrations
+
+import (
+	"embed"
+	"io/fs"
+)
+
+//go:embed *.sql
+var sqliteFS embed.FS
+
+//go:embed postgres/*.sql
+var postgresFS embed.FS
+
+// SQLite 回傳 SQLite 的遷移腳本
+func SQLite() fs.FS {
+	return sqliteFS
+}
+
+// Postgres 回傳 PostgreSQL 的遷移腳本，根目錄即為 postgres/
+func Postgres() fs.FS {
+	sub, err := fs.Sub(postgresFS, "postgres")
+	if err != nil {
+		// 目錄名稱是常數，只有在程式寫錯時才會發生
+		panic(err)
+	}
+	return sub
+}
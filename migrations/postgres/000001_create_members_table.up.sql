@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS members
+(
+    id         INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    name       TEXT        NOT NULL,
+    email      TEXT UNIQUE NOT NULL,
+    password   TEXT        NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
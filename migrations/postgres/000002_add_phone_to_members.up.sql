@@ -0,0 +1 @@
+ALTER TABLE members ADD COLUMN phone TEXT;
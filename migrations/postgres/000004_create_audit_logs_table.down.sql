@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS audit_logs_no_delete ON audit_logs;
+DROP TRIGGER IF EXISTS audit_logs_no_update ON audit_logs;
+DROP FUNCTION IF EXISTS audit_logs_append_only();
+DROP TABLE IF EXISTS audit_logs;
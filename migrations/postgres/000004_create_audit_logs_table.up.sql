@@ -0,0 +1,34 @@
+CREATE TABLE IF NOT EXISTS audit_logs
+(
+    id          INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    actor       TEXT        NOT NULL,
+    action      TEXT        NOT NULL,
+    target_type TEXT        NOT NULL,
+    target_id   TEXT        NOT NULL,
+    changes     TEXT        NOT NULL DEFAULT '{}',
+    request_id  TEXT        NOT NULL DEFAULT '',
+    trace_id    TEXT        NOT NULL DEFAULT '',
+    ip          TEXT        NOT NULL DEFAULT '',
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_target ON audit_logs (target_type, target_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_action ON audit_logs (action, created_at);
+
+/* 稽核紀錄只能新增，禁止更新與刪除 */
+CREATE OR REPLACE FUNCTION audit_logs_append_only() RETURNS trigger AS
+$$
+BEGIN
+    RAISE EXCEPTION 'audit_logs is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_logs_no_update
+    BEFORE UPDATE
+    ON audit_logs
+    FOR EACH ROW
+EXECUTE FUNCTION audit_logs_append_only();
+CREATE TRIGGER audit_logs_no_delete
+    BEFORE DELETE
+    ON audit_logs
+    FOR EACH ROW
+EXECUTE FUNCTION audit_logs_append_only();
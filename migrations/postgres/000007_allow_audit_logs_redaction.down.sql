@@ -0,0 +1,6 @@
+DROP TRIGGER IF EXISTS audit_logs_no_update ON audit_logs;
+CREATE TRIGGER audit_logs_no_update
+    BEFORE UPDATE
+    ON audit_logs
+    FOR EACH ROW
+EXECUTE FUNCTION audit_logs_append_only();
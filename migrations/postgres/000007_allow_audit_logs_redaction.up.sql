@@ -0,0 +1,17 @@
+/* 個資刪除（GDPR erase）需要把稽核紀錄中的個資改寫為 [redacted]，
+   因此放寬 update trigger：只允許改寫 changes 欄位，其餘欄位與 DELETE 仍然禁止 */
+DROP TRIGGER IF EXISTS audit_logs_no_update ON audit_logs;
+CREATE TRIGGER audit_logs_no_update
+    BEFORE UPDATE
+    ON audit_logs
+    FOR EACH ROW
+    WHEN (NEW.id IS DISTINCT FROM OLD.id
+        OR NEW.actor IS DISTINCT FROM OLD.actor
+        OR NEW.action IS DISTINCT FROM OLD.action
+        OR NEW.target_type IS DISTINCT FROM OLD.target_type
+        OR NEW.target_id IS DISTINCT FROM OLD.target_id
+        OR NEW.request_id IS DISTINCT FROM OLD.request_id
+        OR NEW.trace_id IS DISTINCT FROM OLD.trace_id
+        OR NEW.ip IS DISTINCT FROM OLD.ip
+        OR NEW.created_at IS DISTINCT FROM OLD.created_at)
+EXECUTE FUNCTION audit_logs_append_only();
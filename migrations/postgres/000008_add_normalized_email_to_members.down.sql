@@ -0,0 +1,6 @@
+DROP VIEW IF EXISTS member_email_conflicts;
+
+DROP INDEX IF EXISTS idx_members_normalized_email;
+
+ALTER TABLE members
+DROP COLUMN normalized_email;
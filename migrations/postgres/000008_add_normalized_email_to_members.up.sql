@@ -0,0 +1,25 @@
+-- normalized_email 為比對身分用的正規化 Email（去除空白、轉小寫、IDN 轉 punycode），
+-- 避免 Foo@Example.com 與 foo@example.com 註冊成兩個會員
+ALTER TABLE members
+    ADD COLUMN normalized_email TEXT;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_members_normalized_email ON members (normalized_email);
+
+-- 回填：同一個正規化 Email 只有最早註冊的會員取得該值，其餘保留 NULL 等待人工處理；
+-- 信箱業者規則（Gmail 點號、+tag）與 IDN 由應用程式啟動時的 backfill 依設定重新計算
+UPDATE members
+SET normalized_email = lower(trim(email))
+WHERE id IN (SELECT min(id)
+             FROM members
+             GROUP BY lower(trim(email)));
+
+-- member_email_conflicts 列出回填時與其他會員衝突、normalized_email 仍為 NULL 的會員
+CREATE OR REPLACE VIEW member_email_conflicts AS
+SELECT m.id                 AS member_id,
+       m.email              AS email,
+       lower(trim(m.email)) AS normalized_email,
+       holder.id            AS conflicting_member_id,
+       holder.email         AS conflicting_email
+FROM members m
+         JOIN members holder ON holder.normalized_email = lower(trim(m.email))
+WHERE m.normalized_email IS NULL;
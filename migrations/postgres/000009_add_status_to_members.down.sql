@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_members_status;
+
+ALTER TABLE members
+DROP COLUMN status_reason;
+
+ALTER TABLE members
+DROP COLUMN status;
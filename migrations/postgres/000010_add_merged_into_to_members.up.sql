@@ -0,0 +1,6 @@
+-- merged_into 重複帳號合併後指向保留的會員；非 NULL 的會員為 tombstone，
+-- 查詢舊 ID 時導向 merged_into，列表預設不顯示
+ALTER TABLE members
+    ADD COLUMN merged_into INTEGER REFERENCES members (id);
+
+CREATE INDEX IF NOT EXISTS idx_members_merged_into ON members (merged_into);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_members_referred_by;
+
+ALTER TABLE members
+DROP COLUMN referred_by;
+
+DROP INDEX IF EXISTS idx_member_invitations_created_by;
+DROP TABLE IF EXISTS member_invitations;
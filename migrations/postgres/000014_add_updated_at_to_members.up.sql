@@ -0,0 +1,6 @@
+-- updated_at 最近一次更新的時間，應用程式在每次 UPDATE 時一併改寫；既有資料以 created_at 回填
+ALTER TABLE members
+    ADD COLUMN updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP;
+
+UPDATE members
+SET updated_at = created_at;
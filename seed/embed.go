@@ -0,0 +1,28 @@
+// Package seed 以 embed.FS 內嵌種子資料
+package seed
+
+import (
+	"embed"
+	"io/fs"
+)
+
+//go:embed *.sql
+var sqliteFS embed.FS
+
+//go:embed postgres/*.sql
+var postgresFS embed.FS
+
+// SQLite 回傳 SQLite 的種子資料
+func SQLite() fs.FS {
+	return sqliteFS
+}
+
+// Postgres 回傳 PostgreSQL 的種子資料，根目錄即為 postgres/
+func Postgres() fs.FS {
+	sub, err := fs.Sub(postgresFS, "postgres")
+	if err != nil {
+		// 目錄名稱是常數，只有在程式寫錯時才會發生
+		panic(err)
+	}
+	return sub
+}
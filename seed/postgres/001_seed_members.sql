@@ -0,0 +1,18 @@
+/*塞入假資料*/
+INSERT INTO members (name, email, password)
+VALUES ('王小明', 'xiaoming@example.com', '123456'),
+       ('陳美麗', 'meili.chen@example.com', 'abcdef'),
+       ('李大仁', 'daren.lee@example.com', 'qwerty'),
+       ('張小英', 'xiaoying.zhang@example.com', 'pass123'),
+       ('林佳慧', 'jiahui.lin@example.com', 'hello88'),
+       ('黃志強', 'chichi.huang@example.com', 'pw0001'),
+       ('吳宗憲', 'zongxian.wu@example.com', 'secret'),
+       ('曾小花', 'xiaohua.tseng@example.com', 'flower7'),
+       ('鄭文婷', 'wenting.zheng@example.com', 'sunshine'),
+       ('賴冠霖', 'guanlin.lai@example.com', 'guangguang'),
+       ('蔡依林', 'jolin.tsai@example.com', 'dancing'),
+       ('周杰倫', 'jay.chou@example.com', 'musicboy'),
+       ('方文山', 'wenshan.fang@example.com', 'poet99'),
+       ('蕭敬騰', 'jam.hsiao@example.com', 'rainman'),
+       ('王力宏', 'leehom.wang@example.com', 'lovemusic')
+ON CONFLICT DO NOTHING;